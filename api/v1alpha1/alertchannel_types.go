@@ -7,7 +7,7 @@ import (
 // AlertChannelSpec defines the desired state of AlertChannel
 type AlertChannelSpec struct {
 	// Type of alert channel
-	// +kubebuilder:validation:Enum=slack;pagerduty;webhook;email
+	// +kubebuilder:validation:Enum=slack;pagerduty;webhook;email;telegram;sns;pubsub;eventgrid;ntfy;pushover
 	Type string `json:"type"`
 
 	// Slack configuration
@@ -26,10 +26,55 @@ type AlertChannelSpec struct {
 	// +optional
 	Email *EmailConfig `json:"email,omitempty"`
 
+	// Telegram configuration
+	// +optional
+	Telegram *TelegramConfig `json:"telegram,omitempty"`
+
+	// AWS SNS configuration
+	// +optional
+	SNS *SNSConfig `json:"sns,omitempty"`
+
+	// GCP Pub/Sub configuration
+	// +optional
+	PubSub *PubSubConfig `json:"pubsub,omitempty"`
+
+	// Azure Event Grid configuration
+	// +optional
+	EventGrid *EventGridConfig `json:"eventGrid,omitempty"`
+
+	// ntfy configuration
+	// +optional
+	Ntfy *NtfyConfig `json:"ntfy,omitempty"`
+
+	// Pushover configuration
+	// +optional
+	Pushover *PushoverConfig `json:"pushover,omitempty"`
+
 	// RateLimiting prevents alert storms
 	// +optional
 	RateLimiting *RateLimitConfig `json:"rateLimiting,omitempty"`
 
+	// SendTimeout bounds each attempt to send an alert to the channel (default: 30s)
+	// +optional
+	SendTimeout *metav1.Duration `json:"sendTimeout,omitempty"`
+
+	// RetryCount is how many times a failed send is retried before the alert
+	// is queued for later delivery (default: 0)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	RetryCount *int32 `json:"retryCount,omitempty"`
+
+	// RetryBackoff is the wait before the first retry, doubled for each
+	// further retry up to 30s (default: 1s)
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+
+	// HTTP configures the proxy and CAs used for the channel's requests,
+	// overriding the operator's channel-http defaults. Email ignores it.
+	// +optional
+	HTTP *ChannelHTTPConfig `json:"http,omitempty"`
+
 	// TestOnSave sends a test alert when saved (default: false)
 	// +optional
 	TestOnSave bool `json:"testOnSave,omitempty"`
@@ -47,6 +92,14 @@ type SlackConfig struct {
 	// MessageTemplate is a Go template for message formatting
 	// +optional
 	MessageTemplate string `json:"messageTemplate,omitempty"`
+
+	// Interactive sends Block Kit messages with Acknowledge, Retry now and
+	// Suspend CronJob buttons. The Slack app's interactivity request URL must
+	// point at /api/v1/integrations/slack/interactions and the operator must be
+	// configured with the app's signing secret. A "View in dashboard" button
+	// is added when the operator's ui.external-url is set.
+	// +optional
+	Interactive bool `json:"interactive,omitempty"`
 }
 
 // PagerDutyConfig configures PagerDuty notifications
@@ -94,9 +147,170 @@ type EmailConfig struct {
 	// +optional
 	SubjectTemplate string `json:"subjectTemplate,omitempty"`
 
-	// BodyTemplate is a Go template for body
+	// BodyTemplate is a Go template for body. With format html it is rendered
+	// with html/template, so values are escaped.
 	// +optional
 	BodyTemplate string `json:"bodyTemplate,omitempty"`
+
+	// Format of the email body (default: text)
+	// +kubebuilder:validation:Enum=text;html
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// Digest sends one summary email per period instead of an email per alert.
+	// Test alerts are still sent immediately.
+	// +optional
+	Digest *EmailDigestConfig `json:"digest,omitempty"`
+
+	// SLAReport sends an SLA report of the previous week or month, alongside
+	// alerts or digests
+	// +optional
+	SLAReport *EmailSLAReportConfig `json:"slaReport,omitempty"`
+}
+
+// EmailDigestConfig configures periodic digest emails
+type EmailDigestConfig struct {
+	// Schedule is how often the digest is sent
+	// +kubebuilder:validation:Enum=daily;weekly
+	Schedule string `json:"schedule"`
+
+	// Time of day the digest is sent, in HH:MM (default: 09:00)
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	Time string `json:"time,omitempty"`
+
+	// Weekday the weekly digest is sent (default: Monday)
+	// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+	// +optional
+	Weekday string `json:"weekday,omitempty"`
+
+	// Timezone for Time and Weekday (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// EmailSLAReportConfig configures periodic SLA report emails
+type EmailSLAReportConfig struct {
+	// Schedule is the period each report covers. Weekly reports are sent on
+	// Monday, monthly reports on the 1st.
+	// +kubebuilder:validation:Enum=weekly;monthly
+	Schedule string `json:"schedule"`
+
+	// Team limits the report to the CronJobs the team owns. Without it the
+	// report covers the CronJobs of monitors routing alerts to the channel.
+	// +optional
+	Team string `json:"team,omitempty"`
+
+	// AttachPDF attaches the report as a PDF to the HTML email
+	// +optional
+	AttachPDF bool `json:"attachPDF,omitempty"`
+
+	// Time of day the report is sent, in HH:MM (default: 09:00)
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	Time string `json:"time,omitempty"`
+
+	// Timezone for Time and the period boundaries (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// TelegramConfig configures Telegram notifications via the Bot API
+type TelegramConfig struct {
+	// SecretRef references Secret with bot-token and chat-id keys
+	SecretRef NamespacedSecretRef `json:"secretRef"`
+
+	// MessageTemplate is a Go template for the message, sent with MarkdownV2
+	// formatting. Use the escape and escapeCode functions for values.
+	// +optional
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+
+	// SilentInfo sends info-severity alerts without a notification sound
+	// +optional
+	SilentInfo bool `json:"silentInfo,omitempty"`
+}
+
+// SNSConfig configures publishing alerts to an AWS SNS topic
+type SNSConfig struct {
+	// TopicARN is the ARN of the topic to publish to
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:sns:[a-z0-9-]+:[0-9]+:.+$`
+	TopicARN string `json:"topicARN"`
+
+	// CredentialsSecretRef references Secret with access-key-id and secret-access-key
+	CredentialsSecretRef NamespacedSecretRef `json:"credentialsSecretRef"`
+
+	// Endpoint overrides the SNS endpoint (e.g., a VPC endpoint or LocalStack)
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// PubSubConfig configures publishing alerts to a GCP Pub/Sub topic
+type PubSubConfig struct {
+	// Topic is the full topic name, projects/{project}/topics/{topic}
+	// +kubebuilder:validation:Pattern=`^projects/[^/]+/topics/[^/]+$`
+	Topic string `json:"topic"`
+
+	// CredentialsSecretRef references the key holding a service account JSON key
+	CredentialsSecretRef NamespacedSecretKeyRef `json:"credentialsSecretRef"`
+
+	// Endpoint overrides the Pub/Sub API endpoint (e.g., a private endpoint)
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// EventGridConfig configures publishing alerts to an Azure Event Grid topic
+type EventGridConfig struct {
+	// TopicEndpoint is the topic's endpoint, e.g. https://my-topic.westeurope-1.eventgrid.azure.net/api/events
+	// +kubebuilder:validation:Pattern=`^https?://`
+	TopicEndpoint string `json:"topicEndpoint"`
+
+	// AccessKeySecretRef references the Secret key holding a topic access key
+	AccessKeySecretRef NamespacedSecretKeyRef `json:"accessKeySecretRef"`
+}
+
+// NtfyConfig configures push notifications through an ntfy server
+type NtfyConfig struct {
+	// ServerURL is the ntfy server (default: https://ntfy.sh)
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// Topic to publish to. Anyone who knows a topic on a public server can
+	// subscribe to it, so pick one that is hard to guess or protect it.
+	// +kubebuilder:validation:Pattern=`^[-_A-Za-z0-9]{1,64}$`
+	Topic string `json:"topic"`
+
+	// AuthSecretRef references Secret with a token key, or username and
+	// password keys, for protected topics
+	// +optional
+	AuthSecretRef *NamespacedSecretRef `json:"authSecretRef,omitempty"`
+
+	// MessageTemplate is a Go template for the notification body
+	// +optional
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+}
+
+// PushoverConfig configures Pushover notifications
+type PushoverConfig struct {
+	// SecretRef references Secret with app-token and user-key keys
+	SecretRef NamespacedSecretRef `json:"secretRef"`
+
+	// Devices limits delivery to these device names (default: all of the user's devices)
+	// +optional
+	Devices []string `json:"devices,omitempty"`
+
+	// Sound overrides the user's default notification sound
+	// +optional
+	Sound string `json:"sound,omitempty"`
+
+	// EmergencyCritical sends critical alerts with emergency priority, which
+	// repeats the notification every 5 minutes for an hour until acknowledged
+	// +optional
+	EmergencyCritical bool `json:"emergencyCritical,omitempty"`
+
+	// MessageTemplate is a Go template for the notification body
+	// +optional
+	MessageTemplate string `json:"messageTemplate,omitempty"`
 }
 
 // NamespacedSecretKeyRef references a key in a namespaced Secret
@@ -112,6 +326,61 @@ type NamespacedSecretRef struct {
 	Namespace string `json:"namespace"`
 }
 
+// NamespacedConfigMapKeyRef references a key in a namespaced ConfigMap
+type NamespacedConfigMapKeyRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+}
+
+// ChannelHTTPConfig configures the HTTP client of a channel
+type ChannelHTTPConfig struct {
+	// ProxyURL is the forward proxy for the channel's requests, e.g.
+	// http://proxy.corp.example:3128
+	// +kubebuilder:validation:Pattern=`^(https?|socks5)://`
+	// +optional
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// NoProxy sends the channel's requests directly, ignoring the operator's
+	// default proxy
+	// +optional
+	NoProxy bool `json:"noProxy,omitempty"`
+
+	// CABundle holds PEM encoded CA certificates trusted for the channel's
+	// endpoints, in addition to the system and operator CAs
+	// +optional
+	CABundle *CABundleRef `json:"caBundle,omitempty"`
+
+	// DisableKeepAlives opens a new connection for every request instead of
+	// reusing idle ones
+	// +optional
+	DisableKeepAlives bool `json:"disableKeepAlives,omitempty"`
+
+	// IPFamily restricts the channel's connections to IPv4 or IPv6 (default:
+	// both)
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	IPFamily string `json:"ipFamily,omitempty"`
+
+	// Nameserver is the DNS server resolving the channel's hosts instead of
+	// the system resolver, as host or host:port (default port: 53)
+	// +optional
+	Nameserver string `json:"nameserver,omitempty"`
+}
+
+// CABundleRef references PEM encoded CA certificates in a Secret or a
+// ConfigMap. Exactly one of them must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.secretRef) != has(self.configMapRef)",message="exactly one of secretRef and configMapRef must be set"
+type CABundleRef struct {
+	// SecretRef references a Secret key holding the CA bundle
+	// +optional
+	SecretRef *NamespacedSecretKeyRef `json:"secretRef,omitempty"`
+
+	// ConfigMapRef references a ConfigMap key holding the CA bundle
+	// +optional
+	ConfigMapRef *NamespacedConfigMapKeyRef `json:"configMapRef,omitempty"`
+}
+
 // RateLimitConfig configures rate limiting
 type RateLimitConfig struct {
 	// MaxAlertsPerHour limits alerts per hour (default: 100)
@@ -165,6 +434,14 @@ type AlertChannelStatus struct {
 	// Resets to 0 on successful send
 	ConsecutiveFailures int32 `json:"consecutiveFailures"`
 
+	// LastDigestTime is when the last email digest was sent
+	// +optional
+	LastDigestTime *metav1.Time `json:"lastDigestTime,omitempty"`
+
+	// LastSLAReportTime is when the last SLA report was sent
+	// +optional
+	LastSLAReportTime *metav1.Time `json:"lastSLAReportTime,omitempty"`
+
 	// Conditions represent latest observations
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
@@ -172,6 +449,7 @@ type AlertChannelStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
 // +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
 // +kubebuilder:printcolumn:name="Last Alert",type=date,JSONPath=`.status.lastAlertTime`
@@ -0,0 +1,167 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertRouteSpec routes alerts to channels by what they are about, for every
+// monitor in the cluster
+type AlertRouteSpec struct {
+	// Match selects the alerts the route applies to. All set fields must
+	// match; an empty match selects every alert.
+	// +optional
+	Match AlertRouteMatch `json:"match,omitempty"`
+
+	// ChannelRefs are the channels matching alerts are sent to, in addition
+	// to the channels of the alert's monitor
+	// +optional
+	ChannelRefs []ChannelRef `json:"channelRefs,omitempty"`
+
+	// Priority orders routes (higher = evaluated first, default: 0).
+	// Routes with the same priority are evaluated by name.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Continue evaluates lower priority routes after this one matches.
+	// By default the first matching route ends evaluation.
+	// +optional
+	Continue bool `json:"continue,omitempty"`
+
+	// OverrideMonitorChannels sends matching alerts only to the channels of
+	// matching routes, instead of also to the monitor's channels
+	// +optional
+	OverrideMonitorChannels bool `json:"overrideMonitorChannels,omitempty"`
+
+	// Group batches matching alerts into one notification per group on the
+	// route's channels
+	// +optional
+	Group *AlertGrouping `json:"group,omitempty"`
+
+	// Inhibit mutes matching alerts while a related alert is active. Inhibit
+	// rules of every matching route apply, whether or not an earlier route
+	// ended routing.
+	// +optional
+	Inhibit []InhibitRule `json:"inhibit,omitempty"`
+
+	// InhibitOnly makes the route only apply its inhibit rules: it sends
+	// nothing and does not end routing. Without it, a matching route ends
+	// routing unless it sets continue, even without channelRefs.
+	// +optional
+	InhibitOnly bool `json:"inhibitOnly,omitempty"`
+}
+
+// AlertRouteMatch selects alerts. Lists match if they contain the alert's value.
+type AlertRouteMatch struct {
+	// Namespaces of the alerted CronJobs. Supports glob patterns such as "team-*".
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// CronJobSelector matches the labels of the alerted CronJob
+	// +optional
+	CronJobSelector *metav1.LabelSelector `json:"cronJobSelector,omitempty"`
+
+	// Types are alert types, e.g. JobFailed or SLABreached
+	// +optional
+	Types []string `json:"types,omitempty"`
+
+	// Severities of the alerts
+	// +kubebuilder:validation:items:Enum=critical;warning;info
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+
+	// Teams owning the alerted CronJobs, from the ownership configuration
+	// +optional
+	Teams []string `json:"teams,omitempty"`
+}
+
+// AlertGrouping batches alerts the way Alertmanager groups do: the first
+// alert of a group waits for others, and alerts added later are sent
+// together at most once per interval
+type AlertGrouping struct {
+	// By lists the alert fields that make up a group (default: namespace)
+	// +kubebuilder:validation:items:Enum=namespace;cronjob;type;severity;team;monitor;cluster
+	// +optional
+	By []string `json:"by,omitempty"`
+
+	// Wait is how long a new group collects alerts before it is sent (default: 30s)
+	// +optional
+	Wait *metav1.Duration `json:"wait,omitempty"`
+
+	// Interval is how long a group that was sent collects new alerts before
+	// they are sent (default: 5m)
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// InhibitRule mutes an alert while another active alert matches the source
+// and shares the Equal fields, e.g. missed schedule alerts of a CronJob that
+// is suspended too long
+type InhibitRule struct {
+	// SourceTypes are the alert types that mute (empty = any)
+	// +optional
+	SourceTypes []string `json:"sourceTypes,omitempty"`
+
+	// SourceSeverities are the alert severities that mute (empty = any)
+	// +kubebuilder:validation:items:Enum=critical;warning;info
+	// +optional
+	SourceSeverities []string `json:"sourceSeverities,omitempty"`
+
+	// Equal lists the fields the muting alert must share (default: namespace, cronjob)
+	// +kubebuilder:validation:items:Enum=namespace;cronjob;team;monitor;cluster
+	// +optional
+	Equal []string `json:"equal,omitempty"`
+
+	// MinSources is how many active source alerts are needed to mute (default: 1).
+	// With equal: [namespace], a value above 1 treats several failing CronJobs
+	// in a namespace as a namespace-wide outage and mutes the rest.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinSources *int32 `json:"minSources,omitempty"`
+}
+
+// AlertRouteStatus defines the observed state of AlertRoute
+type AlertRouteStatus struct {
+	// Ready indicates the route is valid and in use
+	Ready bool `json:"ready"`
+
+	// ObservedGeneration is the last generation validated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=ar
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Continue",type=boolean,JSONPath=`.spec.continue`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AlertRoute is the Schema for the alertroutes API.
+// It routes, groups and inhibits alerts of every monitor in the cluster
+// from one place, so routing policy does not have to be repeated in the
+// channelRefs of each CronJobMonitor.
+type AlertRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertRouteSpec   `json:"spec,omitempty"`
+	Status AlertRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertRouteList contains a list of AlertRoute.
+type AlertRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertRoute{}, &AlertRouteList{})
+}
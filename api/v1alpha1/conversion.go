@@ -0,0 +1,10 @@
+package v1alpha1
+
+// v1alpha1 is the storage version and the hub every other version of
+// CronJobMonitor and AlertChannel converts through.
+
+// Hub marks this type as a conversion hub.
+func (*CronJobMonitor) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*AlertChannel) Hub() {}
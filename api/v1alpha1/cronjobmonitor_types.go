@@ -1,6 +1,10 @@
 package v1alpha1
 
 import (
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,6 +22,16 @@ type CronJobMonitorSpec struct {
 	// +optional
 	SLA *SLAConfig `json:"sla,omitempty"`
 
+	// StuckJobs alerts on Jobs that are still running long after they
+	// should have finished
+	// +optional
+	StuckJobs *StuckJobConfig `json:"stuckJobs,omitempty"`
+
+	// Recommendations configures the activeDeadlineSeconds and backoffLimit
+	// recommended for each CronJob from its execution history
+	// +optional
+	Recommendations *RecommendationConfig `json:"recommendations,omitempty"`
+
 	// SuspendedHandling configures behavior for suspended CronJobs
 	// +optional
 	SuspendedHandling *SuspendedHandlingConfig `json:"suspendedHandling,omitempty"`
@@ -26,6 +40,35 @@ type CronJobMonitorSpec struct {
 	// +optional
 	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
 
+	// Mode is enforce (default) to send alerts, or observe to record
+	// executions, compute SLAs and show everything in the UI without ever
+	// sending an alert, e.g. while a new team rolls the monitor out
+	// +kubebuilder:validation:Enum=observe;enforce
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// SilencedUntil silences all alerts of the monitor until this time, e.g.
+	// while an incident is being worked on. It expires on its own.
+	// +optional
+	SilencedUntil *metav1.Time `json:"silencedUntil,omitempty"`
+
+	// Dependencies declares job chains, where a CronJob consumes the output
+	// of other CronJobs. Failures of a CronJob whose upstream failed are
+	// reported as downstream failures, and a ChainBroken alert is sent when
+	// a CronJob will run before its failed upstream had a chance to recover.
+	// +optional
+	Dependencies []CronJobDependency `json:"dependencies,omitempty"`
+
+	// HealthcheckPings report runs of the monitored CronJobs to external
+	// ping-based monitoring services such as Healthchecks.io or Cronitor
+	// +optional
+	HealthcheckPings []HealthcheckPing `json:"healthcheckPings,omitempty"`
+
+	// AutomationHooks POST an event to a URL when alerts are sent, e.g. to
+	// trigger custom remediation, without setting up an AlertChannel
+	// +optional
+	AutomationHooks []AutomationHook `json:"automationHooks,omitempty"`
+
 	// Alerting configures alert channels and behavior
 	// +optional
 	Alerting *AlertingConfig `json:"alerting,omitempty"`
@@ -33,6 +76,81 @@ type CronJobMonitorSpec struct {
 	// DataRetention configures data lifecycle management
 	// +optional
 	DataRetention *DataRetentionConfig `json:"dataRetention,omitempty"`
+
+	// FailureClassification classifies failures by matching pod logs
+	// +optional
+	FailureClassification *FailureClassificationConfig `json:"failureClassification,omitempty"`
+
+	// SuccessCriteria decides whether a run succeeded from its main container
+	// instead of the Job's status
+	// +optional
+	SuccessCriteria *SuccessCriteriaConfig `json:"successCriteria,omitempty"`
+
+	// Output tracks the size of each run's output, such as rows written or
+	// bytes uploaded, and alerts when it drops well below recent runs
+	// +optional
+	Output *OutputConfig `json:"output,omitempty"`
+}
+
+// SuccessCriteriaConfig decides success from the job's main container, for
+// pods with sidecars whose failures do not mean the job failed
+type SuccessCriteriaConfig struct {
+	// MainContainer is the container whose exit code decides whether a run
+	// succeeded. A run whose main container exited 0 succeeded, even if a
+	// sidecar such as istio-proxy failed and failed the Job.
+	// +kubebuilder:validation:MinLength=1
+	MainContainer string `json:"mainContainer"`
+}
+
+// OutputConfig tracks the output size reported by each run, to catch runs
+// that succeed but produce much less than usual
+type OutputConfig struct {
+	// Annotation is the annotation a job sets on its Job or pod with its output
+	// size, e.g. "1.5Gi" or "120000". A container may instead report it as an
+	// "<annotation>=<size>" line in its termination message.
+	// (default: guardian.illenium.net/output-size)
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+
+	// DropThresholdPercent alerts with OutputDropped when a successful run's
+	// output is this many percent below the median of recent runs (default: 50)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	DropThresholdPercent *int32 `json:"dropThresholdPercent,omitempty"`
+
+	// BaselineRuns is the number of recent successful runs the median is taken
+	// over (default: 10). No alert is sent before 3 runs reported an output size.
+	// +kubebuilder:validation:Minimum=3
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BaselineRuns *int32 `json:"baselineRuns,omitempty"`
+}
+
+// FailureClassificationConfig classifies failed executions by regexes against
+// their pod logs, for jobs whose exit codes are too coarse to tell failures apart
+type FailureClassificationConfig struct {
+	// Rules are checked in order; the first rule whose pattern matches sets the classification
+	// +kubebuilder:validation:MinItems=1
+	Rules []ClassificationRule `json:"rules"`
+
+	// LogLines is the number of trailing log lines scanned when logs are not stored (default: 200)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	LogLines *int32 `json:"logLines,omitempty"`
+}
+
+// ClassificationRule maps a log pattern to a failure classification
+type ClassificationRule struct {
+	// Classification recorded when the pattern matches (e.g., "dependency-failure")
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Classification string `json:"classification"`
+
+	// LogPattern is a regex matched against the pod logs
+	// +kubebuilder:validation:MinLength=1
+	LogPattern string `json:"logPattern"`
 }
 
 // CronJobSelector specifies which CronJobs to monitor.
@@ -128,6 +246,75 @@ type SLAConfig struct {
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	DurationBaselineWindowDays *int32 `json:"durationBaselineWindowDays,omitempty"`
+
+	// ScheduleBudgetPercent alerts when the P95 duration reaches this
+	// percentage of the shortest interval between scheduled runs, as runs
+	// that long soon overlap or miss their schedule (default: 90, 0 disables)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ScheduleBudgetPercent *int32 `json:"scheduleBudgetPercent,omitempty"`
+}
+
+// StuckJobConfig configures detection of hung Jobs. A running Job is stuck
+// when its runtime exceeds MaxRuntime or P95Multiplier times the CronJob's
+// historical P95 duration, whichever is lower. At least one must be set.
+type StuckJobConfig struct {
+	// Enabled turns on stuck job detection (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxRuntime is the absolute runtime after which a Job is stuck
+	// +optional
+	MaxRuntime *metav1.Duration `json:"maxRuntime,omitempty"`
+
+	// P95Multiplier marks a Job stuck when it runs longer than this multiple
+	// of the P95 duration of recent runs
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	P95Multiplier *float64 `json:"p95Multiplier,omitempty"`
+
+	// BaselineWindowDays is the history the P95 duration is taken from (default: 14)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BaselineWindowDays *int32 `json:"baselineWindowDays,omitempty"`
+
+	// MinRuns is the number of runs in the baseline window needed before
+	// P95Multiplier applies (default: 5)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinRuns *int32 `json:"minRuns,omitempty"`
+}
+
+// RecommendationConfig configures Job limit recommendations. The recommended
+// activeDeadlineSeconds is the P99 duration times DeadlineMultiplier; the
+// recommended backoffLimit is the number of retries needed to bring the chance
+// of a failed Job under 1% at the observed failure rate.
+type RecommendationConfig struct {
+	// Enabled turns on recommendations (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// DeadlineMultiplier is applied to the P99 duration (default: 1.5)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DeadlineMultiplier *float64 `json:"deadlineMultiplier,omitempty"`
+
+	// WindowDays is the history recommendations are computed from (default: 30)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WindowDays *int32 `json:"windowDays,omitempty"`
+
+	// MinRuns is the number of runs in the window needed before a
+	// recommendation is made (default: 10)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinRuns *int32 `json:"minRuns,omitempty"`
+
+	// Apply writes the recommended values to the job template of each
+	// monitored CronJob (default: false)
+	// +optional
+	Apply bool `json:"apply,omitempty"`
 }
 
 // SuspendedHandlingConfig configures behavior for suspended CronJobs
@@ -139,6 +326,26 @@ type SuspendedHandlingConfig struct {
 	// AlertIfSuspendedFor alerts if suspended longer than this duration
 	// +optional
 	AlertIfSuspendedFor *metav1.Duration `json:"alertIfSuspendedFor,omitempty"`
+
+	// IntentionallySuspended lists CronJobs that are expected to stay
+	// suspended, as name or namespace/name. They never get SuspendedTooLong
+	// alerts.
+	// +optional
+	IntentionallySuspended []string `json:"intentionallySuspended,omitempty"`
+}
+
+// IsIntentionallySuspended returns true if the CronJob is on the
+// intentionally suspended list
+func (c *SuspendedHandlingConfig) IsIntentionallySuspended(namespace, name string) bool {
+	if c == nil {
+		return false
+	}
+	for _, entry := range c.IntentionallySuspended {
+		if entry == name || entry == namespace+"/"+name {
+			return true
+		}
+	}
+	return false
 }
 
 // MaintenanceWindow defines a scheduled maintenance period
@@ -161,6 +368,99 @@ type MaintenanceWindow struct {
 	SuppressAlerts *bool `json:"suppressAlerts,omitempty"`
 }
 
+// CronJobDependency declares the upstream CronJobs a CronJob depends on
+type CronJobDependency struct {
+	// CronJob is the downstream CronJob
+	CronJob CronJobReference `json:"cronJob"`
+
+	// DependsOn lists the upstream CronJobs that must succeed before it runs
+	// +kubebuilder:validation:MinItems=1
+	DependsOn []CronJobReference `json:"dependsOn"`
+}
+
+// CronJobReference references a CronJob
+type CronJobReference struct {
+	// Name of the CronJob
+	Name string `json:"name"`
+
+	// Namespace of the CronJob (default: the monitor's namespace)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// HealthcheckPing pings an external monitoring service when a CronJob runs
+type HealthcheckPing struct {
+	// CronJobs limits the pings to these CronJobs by name (default: all monitored CronJobs)
+	// +optional
+	CronJobs []string `json:"cronJobs,omitempty"`
+
+	// Provider selects how run outcomes are reported (default: custom).
+	// healthchecks appends /fail for failures, cronitor sets the state
+	// query parameter, custom pings FailureURL for failures.
+	// +kubebuilder:validation:Enum=healthchecks;cronitor;custom
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// URL pinged after a successful run. It is a Go template with
+	// {{ .Namespace }}, {{ .Name }} and {{ .JobName }} available, so one
+	// entry can serve several CronJobs (e.g. Healthchecks.io slug URLs).
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads URL from a Secret in the monitor's namespace,
+	// for ping URLs that embed credentials
+	// +optional
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// FailureURL is pinged after a failed run when ReportFailures is set
+	// (custom provider only; same template variables as URL)
+	// +optional
+	FailureURL string `json:"failureURL,omitempty"`
+
+	// ReportFailures also pings for failed runs (default: false)
+	// +optional
+	ReportFailures bool `json:"reportFailures,omitempty"`
+}
+
+// AutomationHook posts a structured event to a URL when the monitor sends an
+// alert, before or after it is delivered to the alert's channels
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.urlSecretRef)",message="exactly one of url and urlSecretRef must be set"
+type AutomationHook struct {
+	// Name identifies the hook in events and logs
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// AlertTypes limits the hook to alerts of these types, e.g. JobFailed
+	// or JobStuck (default: all alert types)
+	// +optional
+	AlertTypes []string `json:"alertTypes,omitempty"`
+
+	// Phase is when the hook is called (default: after). before calls it
+	// before the alert is sent to its channels, holding them back until the
+	// hook answers or times out; after calls it once they were sent, with
+	// the channels that were notified.
+	// +kubebuilder:validation:Enum=before;after
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// URL receives the POST
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads URL from a Secret in the monitor's namespace,
+	// for URLs that embed credentials
+	// +optional
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Headers are set on every request, e.g. to identify the sender
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout bounds each call (default: 10s)
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
 // AlertingConfig configures alerting behavior
 type AlertingConfig struct {
 	// Enabled turns on alerting (default: true)
@@ -171,6 +471,12 @@ type AlertingConfig struct {
 	// +optional
 	ChannelRefs []ChannelRef `json:"channelRefs,omitempty"`
 
+	// Routing picks different channels by time of day and day of week,
+	// e.g. Slack during business hours and PagerDuty after hours.
+	// ChannelRefs is used when no routing rule matches.
+	// +optional
+	Routing *AlertRoutingConfig `json:"routing,omitempty"`
+
 	// IncludeContext specifies what context to include in alerts
 	// +optional
 	IncludeContext *AlertContext `json:"includeContext,omitempty"`
@@ -186,9 +492,37 @@ type AlertingConfig struct {
 	// +optional
 	AlertDelay *metav1.Duration `json:"alertDelay,omitempty"`
 
-	// SeverityOverrides customizes severity for alert types
+	// RateLimiting caps the alerts this monitor can send, so a noisy monitor
+	// cannot exhaust the global alert budget (default: no per-monitor limit)
+	// +optional
+	RateLimiting *RateLimitConfig `json:"rateLimiting,omitempty"`
+
+	// FailureStreak holds JobFailed alerts until a CronJob has failed several
+	// times in a row and escalates them to critical on longer streaks
 	// +optional
-	SeverityOverrides *SeverityOverrides `json:"severityOverrides,omitempty"`
+	FailureStreak *FailureStreakConfig `json:"failureStreak,omitempty"`
+
+	// LongSuccessStreak highlights a failure that ends a run of successes
+	// lasting at least this long, e.g. the first failure in 60 days
+	// (default: 720h, 0s disables)
+	// +optional
+	LongSuccessStreak *metav1.Duration `json:"longSuccessStreak,omitempty"`
+
+	// FlapDetection replaces the alerts of a CronJob that keeps alternating
+	// between success and failure with one Flapping alert
+	// +optional
+	FlapDetection *FlapDetectionConfig `json:"flapDetection,omitempty"`
+
+	// SeverityOverrides customizes severity per alert type
+	// +optional
+	SeverityOverrides SeverityOverrides `json:"severityOverrides,omitempty"`
+
+	// RunbookURLs links alert types to remediation docs, e.g.
+	// deadManTriggered: https://runbooks.example.com/deadman. The CronJob's
+	// guardian.illenium.net/runbook-url annotation is used for alert types
+	// not listed here.
+	// +optional
+	RunbookURLs RunbookURLs `json:"runbookURLs,omitempty"`
 
 	// SuggestedFixPatterns defines custom fix patterns for this monitor
 	// These are merged with built-in patterns, with custom patterns taking priority
@@ -196,6 +530,103 @@ type AlertingConfig struct {
 	SuggestedFixPatterns []SuggestedFixPattern `json:"suggestedFixPatterns,omitempty"`
 }
 
+// AllChannelRefs returns the channels referenced by ChannelRefs and by the
+// routing rules, without duplicates
+func (c *AlertingConfig) AllChannelRefs() []ChannelRef {
+	refs := make([]ChannelRef, 0, len(c.ChannelRefs))
+	seen := make(map[string]bool)
+	add := func(list []ChannelRef) {
+		for _, ref := range list {
+			if !seen[ref.Name] {
+				seen[ref.Name] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	add(c.ChannelRefs)
+	if c.Routing != nil {
+		for _, rule := range c.Routing.Rules {
+			add(rule.ChannelRefs)
+		}
+	}
+	return refs
+}
+
+// FailureStreakConfig alerts on consecutive failures instead of the first
+// failure, for inherently flaky jobs. A success resets the streak.
+type FailureStreakConfig struct {
+	// AlertAfter is the number of consecutive failures that send a JobFailed
+	// alert (default: 1)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	AlertAfter *int32 `json:"alertAfter,omitempty"`
+
+	// CriticalAfter is the number of consecutive failures that raise the
+	// JobFailed alert to critical (default: no escalation)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CriticalAfter *int32 `json:"criticalAfter,omitempty"`
+}
+
+// FlapDetectionConfig detects CronJobs whose runs alternate between success
+// and failure. While a CronJob is flapping, its JobFailed alerts and their
+// resolution are held and a single Flapping alert is sent instead, until the
+// state changes within the window drop below the threshold.
+type FlapDetectionConfig struct {
+	// Enabled turns on flap detection (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Threshold is the number of state changes within the window that make a
+	// CronJob flapping (default: 4)
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	Threshold *int32 `json:"threshold,omitempty"`
+
+	// Window state changes are counted in (default: 1h)
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// AlertRoutingConfig routes alerts to channels based on when they are sent
+type AlertRoutingConfig struct {
+	// Timezone the rules are evaluated in (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Rules are evaluated in order; the first rule whose window contains the
+	// current time selects the channels
+	// +optional
+	Rules []RoutingRule `json:"rules,omitempty"`
+}
+
+// RoutingRule sends alerts to its channels during a weekly time window
+type RoutingRule struct {
+	// Name identifies this rule
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Days the window starts on (default: every day)
+	// +kubebuilder:validation:items:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// Start of the window in HH:MM (default: 00:00)
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	Start string `json:"start,omitempty"`
+
+	// End of the window in HH:MM, exclusive (default: end of day).
+	// A window that ends before it starts runs past midnight into the next day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	End string `json:"end,omitempty"`
+
+	// ChannelRefs are the channels alerts are sent to during the window
+	// +kubebuilder:validation:MinItems=1
+	ChannelRefs []ChannelRef `json:"channelRefs"`
+}
+
 // ChannelRef references an AlertChannel CR
 type ChannelRef struct {
 	// Name of the AlertChannel CR
@@ -237,26 +668,74 @@ type AlertContext struct {
 	// SuggestedFixes includes fix suggestions (default: true)
 	// +optional
 	SuggestedFixes *bool `json:"suggestedFixes,omitempty"`
-}
 
-// SeverityOverrides customizes alert severities
-// Only critical and warning are valid - alerts are actionable notifications
-type SeverityOverrides struct {
-	// +kubebuilder:validation:Enum=critical;warning
+	// Labels lists CronJob label keys copied into alerts (e.g. team, service),
+	// so receivers can route by them and templates can show them
+	// +kubebuilder:validation:MaxItems=20
 	// +optional
-	MissedSchedule string `json:"missedSchedule,omitempty"`
-	// +kubebuilder:validation:Enum=critical;warning
-	// +optional
-	JobFailed string `json:"jobFailed,omitempty"`
-	// +kubebuilder:validation:Enum=critical;warning
-	// +optional
-	SLABreached string `json:"slaBreached,omitempty"`
-	// +kubebuilder:validation:Enum=critical;warning
-	// +optional
-	DeadManTriggered string `json:"deadManTriggered,omitempty"`
-	// +kubebuilder:validation:Enum=critical;warning
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations lists CronJob annotation keys copied into alerts
+	// (e.g. runbook-url), so templates can link to them
+	// +kubebuilder:validation:MaxItems=20
 	// +optional
-	DurationRegression string `json:"durationRegression,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// SeverityOverrides maps alert types to the severity they are sent with, such
+// as deadManTriggered: critical. Keys are matched to alert types ignoring
+// case, so jobFailed and JobFailed are the same. Only critical and warning
+// are valid - alerts are actionable notifications.
+// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] == 'critical' || self[k] == 'warning')",message="severity overrides must be critical or warning"
+type SeverityOverrides map[string]string
+
+// RunbookURLs maps alert types to runbook URLs. Keys are matched to alert
+// types ignoring case, like SeverityOverrides.
+// +kubebuilder:validation:XValidation:rule="self.all(k, self[k].startsWith('https://') || self[k].startsWith('http://'))",message="runbook URLs must be http or https URLs"
+type RunbookURLs map[string]string
+
+// Severity returns the override for an alert type, or def if there is none
+func (o SeverityOverrides) Severity(alertType, def string) string {
+	if v := lookupAlertType(o, alertType); v != "" {
+		return v
+	}
+	return def
+}
+
+// URL returns the runbook URL for an alert type, or "" if there is none
+func (u RunbookURLs) URL(alertType string) string {
+	return lookupAlertType(u, alertType)
+}
+
+// RunbookURLFor returns the runbook URL configured for an alert type
+func (a *AlertingConfig) RunbookURLFor(alertType string) string {
+	if a == nil {
+		return ""
+	}
+	return a.RunbookURLs.URL(alertType)
+}
+
+// lookupAlertType returns the value for an alert type in a map keyed by
+// alert type, preferring an exact key over one differing only in case
+func lookupAlertType(m map[string]string, alertType string) string {
+	if v := m[alertType]; v != "" {
+		return v
+	}
+	for k, v := range m {
+		if v != "" && strings.EqualFold(k, alertType) {
+			return v
+		}
+	}
+	return ""
+}
+
+// SeverityFor returns the severity for an alert type, applying any override
+// from the alerting config to the default
+func (a *AlertingConfig) SeverityFor(alertType, def string) string {
+	if a == nil {
+		return def
+	}
+	return a.SeverityOverrides.Severity(alertType, def)
 }
 
 // SuggestedFixPattern defines a pattern for suggesting fixes based on failure context
@@ -275,10 +754,21 @@ type SuggestedFixPattern struct {
 	// Built-in patterns use priorities 1-100, use >100 to override
 	// +optional
 	Priority *int32 `json:"priority,omitempty"`
+
+	// Severity overrides the JobFailed alert severity when this pattern matches
+	// +kubebuilder:validation:Enum=critical;warning
+	// +optional
+	Severity string `json:"severity,omitempty"`
 }
 
 // PatternMatch defines what to match against for suggested fixes
 type PatternMatch struct {
+	// Container makes exitCode, exitCodeRange, reason and reasonPattern match
+	// the named container, init containers included, instead of the
+	// execution's exit code and reason
+	// +optional
+	Container string `json:"container,omitempty"`
+
 	// ExitCode matches specific exit codes (e.g., 137 for OOM)
 	// +optional
 	ExitCode *int32 `json:"exitCode,omitempty"`
@@ -357,6 +847,11 @@ type DataRetentionConfig struct {
 	// If nil, uses global --storage.event-storage-enabled setting
 	// +optional
 	StoreEvents *bool `json:"storeEvents,omitempty"`
+
+	// LegalHold exempts the history and logs of the selected CronJobs from
+	// pruning, overriding retentionDays and logRetentionDays
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
 }
 
 // CronJobMonitorStatus defines the observed state of CronJobMonitor
@@ -382,11 +877,34 @@ type CronJobMonitorStatus struct {
 	// +optional
 	CronJobs []CronJobStatus `json:"cronJobs,omitempty"`
 
-	// Conditions represent the latest observations
+	// Conditions represent the latest observations: Ready, Degraded,
+	// AlertingHealthy and StoreHealthy
 	// +optional
+	// +listType=map
+	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// CronJobMonitor modes
+const (
+	// MonitorModeEnforce sends alerts (default)
+	MonitorModeEnforce = "enforce"
+	// MonitorModeObserve records and evaluates everything but sends no alerts
+	MonitorModeObserve = "observe"
+)
+
+// CronJobMonitor condition types
+const (
+	// MonitorConditionReady is true once the monitor has been reconciled
+	MonitorConditionReady = "Ready"
+	// MonitorConditionDegraded is true while any monitored CronJob is warning or critical
+	MonitorConditionDegraded = "Degraded"
+	// MonitorConditionAlertingHealthy is true when every referenced alert channel is ready and delivering
+	MonitorConditionAlertingHealthy = "AlertingHealthy"
+	// MonitorConditionStoreHealthy is true when the execution history store is reachable
+	MonitorConditionStoreHealthy = "StoreHealthy"
+)
+
 // MonitorSummary provides aggregate counts
 type MonitorSummary struct {
 	TotalCronJobs int32 `json:"totalCronJobs"`
@@ -413,6 +931,11 @@ type CronJobStatus struct {
 	// Suspended indicates if the CronJob is suspended
 	Suspended bool `json:"suspended"`
 
+	// IntentionallySuspended indicates the CronJob is suspended and on the
+	// monitor's intentionally suspended list
+	// +optional
+	IntentionallySuspended bool `json:"intentionallySuspended,omitempty"`
+
 	// LastSuccessfulTime is when the last Job succeeded
 	// +optional
 	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
@@ -421,6 +944,10 @@ type CronJobStatus struct {
 	// +optional
 	LastFailedTime *metav1.Time `json:"lastFailedTime,omitempty"`
 
+	// ConsecutiveFailures is the number of Jobs that failed since the last success
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
 	// LastRunDuration is the duration of the last completed Job
 	// +optional
 	LastRunDuration *metav1.Duration `json:"lastRunDuration,omitempty"`
@@ -429,6 +956,11 @@ type CronJobStatus struct {
 	// +optional
 	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty"`
 
+	// UpcomingRuns are the next scheduled times, in the CronJob's time zone.
+	// Empty while the CronJob is suspended.
+	// +optional
+	UpcomingRuns []metav1.Time `json:"upcomingRuns,omitempty"`
+
 	// Metrics contains SLA metrics
 	// +optional
 	Metrics *CronJobMetrics `json:"metrics,omitempty"`
@@ -440,6 +972,50 @@ type CronJobStatus struct {
 	// ActiveAlerts lists current alerts for this CronJob
 	// +optional
 	ActiveAlerts []ActiveAlert `json:"activeAlerts,omitempty"`
+
+	// Recommendation contains the Job limits recommended from execution history
+	// +optional
+	Recommendation *LimitRecommendation `json:"recommendation,omitempty"`
+
+	// Drift lists where the CronJob diverges from the state its
+	// guardian.illenium.net/expected-image and expected-suspend annotations
+	// declare, e.g. after a manual kubectl edit
+	// +optional
+	Drift []DriftStatus `json:"drift,omitempty"`
+}
+
+// DriftStatus is a field of a CronJob that diverges from its expected state
+type DriftStatus struct {
+	// Field is the drifted field, e.g. spec.suspend or containers[backup].image
+	Field string `json:"field"`
+
+	// Expected is the value declared by the annotation
+	Expected string `json:"expected"`
+
+	// Actual is the CronJob's value (empty if no container runs the expected image)
+	// +optional
+	Actual string `json:"actual,omitempty"`
+
+	// Manager is the field manager that last changed the field, e.g. kubectl-edit,
+	// when it is known
+	// +optional
+	Manager string `json:"manager,omitempty"`
+}
+
+// LimitRecommendation contains recommended Job limits for a CronJob
+type LimitRecommendation struct {
+	// ActiveDeadlineSeconds is the recommended spec.activeDeadlineSeconds
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds"`
+
+	// BackoffLimit is the recommended spec.backoffLimit
+	BackoffLimit int32 `json:"backoffLimit"`
+
+	// BasedOnRuns is the number of runs the recommendation was computed from
+	BasedOnRuns int32 `json:"basedOnRuns"`
+
+	// Applied indicates the recommendation was written to the CronJob
+	// +optional
+	Applied bool `json:"applied,omitempty"`
 }
 
 // CronJobMetrics contains SLA metrics for a CronJob
@@ -457,6 +1033,15 @@ type CronJobMetrics struct {
 	P95DurationSeconds float64 `json:"p95DurationSeconds,omitempty"`
 	// +optional
 	P99DurationSeconds float64 `json:"p99DurationSeconds,omitempty"`
+	// Incidents is the number of failure streaks in the window
+	// +optional
+	Incidents int32 `json:"incidents,omitempty"`
+	// MTTRSeconds is the mean time from a failure to the next success
+	// +optional
+	MTTRSeconds float64 `json:"mttrSeconds,omitempty"`
+	// MTBFSeconds is the mean time between the starts of consecutive incidents
+	// +optional
+	MTBFSeconds float64 `json:"mtbfSeconds,omitempty"`
 }
 
 // ActiveAlert represents an active alert
@@ -488,6 +1073,19 @@ type ActiveAlert struct {
 	// SuggestedFix provides actionable guidance for resolving the alert
 	// +optional
 	SuggestedFix string `json:"suggestedFix,omitempty"`
+
+	// RunbookURL links to the remediation docs for the alert
+	// +optional
+	RunbookURL string `json:"runbookURL,omitempty"`
+
+	// SuccessStreakRuns is the number of successful runs the failure ended,
+	// set when they lasted longer than the monitor's longSuccessStreak
+	// +optional
+	SuccessStreakRuns int32 `json:"successStreakRuns,omitempty"`
+
+	// SuccessStreakSince is when the success streak the failure ended began
+	// +optional
+	SuccessStreakSince *metav1.Time `json:"successStreakSince,omitempty"`
 }
 
 // ActiveJob represents a currently running job
@@ -517,11 +1115,15 @@ type ActiveJob struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,priority=1
 // +kubebuilder:printcolumn:name="CronJobs",type=integer,JSONPath=`.status.summary.totalCronJobs`
 // +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.summary.healthy`
 // +kubebuilder:printcolumn:name="Warning",type=integer,JSONPath=`.status.summary.warning`
 // +kubebuilder:printcolumn:name="Critical",type=integer,JSONPath=`.status.summary.critical`
 // +kubebuilder:printcolumn:name="Alerts",type=integer,JSONPath=`.status.summary.activeAlerts`
+// +kubebuilder:printcolumn:name="Silenced Until",type=date,JSONPath=`.spec.silencedUntil`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // CronJobMonitor is the Schema for the cronjobmonitors API.
@@ -533,6 +1135,17 @@ type CronJobMonitor struct {
 	Status CronJobMonitorStatus `json:"status,omitempty"`
 }
 
+// IsObserveOnly returns true if the monitor is in observe mode and must
+// never send alerts
+func (m *CronJobMonitor) IsObserveOnly() bool {
+	return m.Spec.Mode == MonitorModeObserve
+}
+
+// IsSilenced returns true if the monitor's alerts are silenced at the given time
+func (m *CronJobMonitor) IsSilenced(now time.Time) bool {
+	return m.Spec.SilencedUntil != nil && now.Before(m.Spec.SilencedUntil.Time)
+}
+
 // +kubebuilder:object:root=true
 
 // CronJobMonitorList contains a list of CronJobMonitor.
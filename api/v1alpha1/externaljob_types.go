@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalJobSpec registers a cron job running outside Kubernetes (crontab, VM, CI)
+// that reports its runs to guardian through the inbound ping endpoint
+type ExternalJobSpec struct {
+	// Schedule is the job's cron schedule, used for dead-man's switch
+	// auto-detection and shown in the dashboard
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Timezone the schedule is evaluated in (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// TokenSecretRef references the secret key holding the ping token.
+	// The secret must be in the ExternalJob's namespace.
+	// Pings are sent to /api/v1/pings/<token>/start|success|fail
+	TokenSecretRef corev1.SecretKeySelector `json:"tokenSecretRef"`
+
+	// DeadManSwitch alerts when the job stops reporting successful runs
+	// +optional
+	DeadManSwitch *DeadManSwitchConfig `json:"deadManSwitch,omitempty"`
+
+	// Alerting configures where JobFailed and DeadManTriggered alerts are sent
+	// +optional
+	Alerting *AlertingConfig `json:"alerting,omitempty"`
+}
+
+// ExternalJobStatus defines the observed state of ExternalJob
+type ExternalJobStatus struct {
+	// LastStartTime is when the last start ping was received
+	// +optional
+	LastStartTime *metav1.Time `json:"lastStartTime,omitempty"`
+
+	// LastSuccessTime is when the last success ping was received
+	// +optional
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// LastFailureTime is when the last fail ping was received
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// Running is true between a start ping and the matching success or fail ping
+	// +optional
+	Running bool `json:"running,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=extjob
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Running",type=boolean,JSONPath=`.status.running`
+// +kubebuilder:printcolumn:name="Last Success",type=date,JSONPath=`.status.lastSuccessTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ExternalJob is the Schema for the externaljobs API.
+// It lets cron jobs running outside the cluster report runs into guardian's
+// store so they appear alongside CronJobs and get dead-man's switch monitoring.
+type ExternalJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalJobSpec   `json:"spec,omitempty"`
+	Status ExternalJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalJobList contains a list of ExternalJob.
+type ExternalJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ExternalJob{}, &ExternalJobList{})
+}
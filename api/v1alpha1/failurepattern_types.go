@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FailurePatternSpec defines a suggested fix pattern shared across monitors
+type FailurePatternSpec struct {
+	// Selector limits which CronJobs the pattern applies to.
+	// If not set, the pattern applies to all CronJobs in its own namespace.
+	// +optional
+	Selector *CronJobSelector `json:"selector,omitempty"`
+
+	// Match criteria - at least one must be specified
+	Match PatternMatch `json:"match"`
+
+	// Suggestion is the fix text (supports Go templates)
+	// Available variables: {{.Namespace}}, {{.Name}}, {{.ExitCode}}, {{.Reason}}, {{.JobName}}
+	Suggestion string `json:"suggestion"`
+
+	// Priority determines order (higher = checked first, default: 0)
+	// Built-in patterns use priorities 1-100, use >100 to override
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Severity overrides the JobFailed alert severity when this pattern matches
+	// +kubebuilder:validation:Enum=critical;warning
+	// +optional
+	Severity string `json:"severity,omitempty"`
+}
+
+// FailurePatternStatus defines the observed state of FailurePattern
+type FailurePatternStatus struct {
+	// Ready indicates the pattern is valid and in use
+	Ready bool `json:"ready"`
+
+	// ObservedGeneration is the last generation validated
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=fp
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Severity",type=string,JSONPath=`.spec.severity`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FailurePattern is the Schema for the failurepatterns API.
+// It adds a suggested fix pattern to every matching CronJob without
+// redeploying the operator or editing each CronJobMonitor.
+type FailurePattern struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FailurePatternSpec   `json:"spec,omitempty"`
+	Status FailurePatternStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FailurePatternList contains a list of FailurePattern.
+type FailurePatternList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FailurePattern `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FailurePattern{}, &FailurePatternList{})
+}
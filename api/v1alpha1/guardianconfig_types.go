@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuardianConfigName is the name of the GuardianConfig the operator reads.
+// Settings are cluster-wide, so there is only one.
+const GuardianConfigName = "default"
+
+// GuardianConfigSpec overrides operator settings at runtime.
+// Unset fields keep the value from flags or the config file.
+type GuardianConfigSpec struct {
+	// RateLimits overrides the global alert rate limits
+	// +optional
+	RateLimits *GuardianRateLimits `json:"rateLimits,omitempty"`
+
+	// Retention overrides how long execution history and logs are kept
+	// +optional
+	Retention *GuardianRetention `json:"retention,omitempty"`
+
+	// StartupGracePeriod overrides how long alerts are held back after the
+	// operator starts. It is counted from the start of the operator.
+	// +optional
+	StartupGracePeriod *metav1.Duration `json:"startupGracePeriod,omitempty"`
+
+	// IgnoredNamespaces are namespaces whose CronJobs are never monitored,
+	// whatever the monitors select. Supports glob patterns such as "kube-*".
+	// Replaces the ignored namespaces of the config file when set.
+	// +optional
+	IgnoredNamespaces []string `json:"ignoredNamespaces,omitempty"`
+}
+
+// GuardianRateLimits configures global alert rate limits
+type GuardianRateLimits struct {
+	// MaxAlertsPerMinute across all channels
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAlertsPerMinute *int32 `json:"maxAlertsPerMinute,omitempty"`
+
+	// BurstLimit is the maximum burst of alerts allowed
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BurstLimit *int32 `json:"burstLimit,omitempty"`
+
+	// DefaultSuppressDuplicatesFor is how long duplicate alerts are suppressed
+	// for monitors that do not set suppressDuplicatesFor
+	// +optional
+	DefaultSuppressDuplicatesFor *metav1.Duration `json:"defaultSuppressDuplicatesFor,omitempty"`
+}
+
+// GuardianRetention configures how long history is kept
+type GuardianRetention struct {
+	// HistoryDays is how long execution history is kept
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	HistoryDays *int32 `json:"historyDays,omitempty"`
+
+	// LogDays is how long stored logs are kept (0 = as long as history)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	LogDays *int32 `json:"logDays,omitempty"`
+}
+
+// GuardianConfigStatus defines the observed state of GuardianConfig
+type GuardianConfigStatus struct {
+	// Ready indicates the settings are valid and applied
+	Ready bool `json:"ready"`
+
+	// ObservedGeneration is the last generation applied
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent latest observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=gc
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="GuardianConfig must be named default"
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GuardianConfig is the Schema for the guardianconfigs API.
+// It changes operator settings such as rate limits and retention while the
+// operator runs, without editing the config file or restarting it.
+type GuardianConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuardianConfigSpec   `json:"spec,omitempty"`
+	Status GuardianConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuardianConfigList contains a list of GuardianConfig.
+type GuardianConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GuardianConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GuardianConfig{}, &GuardianConfigList{})
+}
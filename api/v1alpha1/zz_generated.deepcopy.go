@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -17,6 +18,10 @@ func (in *ActiveAlert) DeepCopyInto(out *ActiveAlert) {
 		in, out := &in.LastNotified, &out.LastNotified
 		*out = (*in).DeepCopy()
 	}
+	if in.SuccessStreakSince != nil {
+		in, out := &in.SuccessStreakSince, &out.SuccessStreakSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveAlert.
@@ -132,11 +137,61 @@ func (in *AlertChannelSpec) DeepCopyInto(out *AlertChannelSpec) {
 		*out = new(EmailConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Telegram != nil {
+		in, out := &in.Telegram, &out.Telegram
+		*out = new(TelegramConfig)
+		**out = **in
+	}
+	if in.SNS != nil {
+		in, out := &in.SNS, &out.SNS
+		*out = new(SNSConfig)
+		**out = **in
+	}
+	if in.PubSub != nil {
+		in, out := &in.PubSub, &out.PubSub
+		*out = new(PubSubConfig)
+		**out = **in
+	}
+	if in.EventGrid != nil {
+		in, out := &in.EventGrid, &out.EventGrid
+		*out = new(EventGridConfig)
+		**out = **in
+	}
+	if in.Ntfy != nil {
+		in, out := &in.Ntfy, &out.Ntfy
+		*out = new(NtfyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pushover != nil {
+		in, out := &in.Pushover, &out.Pushover
+		*out = new(PushoverConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RateLimiting != nil {
 		in, out := &in.RateLimiting, &out.RateLimiting
 		*out = new(RateLimitConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SendTimeout != nil {
+		in, out := &in.SendTimeout, &out.SendTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryCount != nil {
+		in, out := &in.RetryCount, &out.RetryCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ChannelHTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertChannelSpec.
@@ -164,6 +219,14 @@ func (in *AlertChannelStatus) DeepCopyInto(out *AlertChannelStatus) {
 		in, out := &in.LastFailedTime, &out.LastFailedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastDigestTime != nil {
+		in, out := &in.LastDigestTime, &out.LastDigestTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSLAReportTime != nil {
+		in, out := &in.LastSLAReportTime, &out.LastSLAReportTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -216,6 +279,16 @@ func (in *AlertContext) DeepCopyInto(out *AlertContext) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertContext.
@@ -229,121 +302,37 @@ func (in *AlertContext) DeepCopy() *AlertContext {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AlertingConfig) DeepCopyInto(out *AlertingConfig) {
+func (in *AlertGrouping) DeepCopyInto(out *AlertGrouping) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ChannelRefs != nil {
-		in, out := &in.ChannelRefs, &out.ChannelRefs
-		*out = make([]ChannelRef, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.IncludeContext != nil {
-		in, out := &in.IncludeContext, &out.IncludeContext
-		*out = new(AlertContext)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SuppressDuplicatesFor != nil {
-		in, out := &in.SuppressDuplicatesFor, &out.SuppressDuplicatesFor
-		*out = new(v1.Duration)
-		**out = **in
+	if in.By != nil {
+		in, out := &in.By, &out.By
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.AlertDelay != nil {
-		in, out := &in.AlertDelay, &out.AlertDelay
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.SeverityOverrides != nil {
-		in, out := &in.SeverityOverrides, &out.SeverityOverrides
-		*out = new(SeverityOverrides)
-		**out = **in
-	}
-	if in.SuggestedFixPatterns != nil {
-		in, out := &in.SuggestedFixPatterns, &out.SuggestedFixPatterns
-		*out = make([]SuggestedFixPattern, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingConfig.
-func (in *AlertingConfig) DeepCopy() *AlertingConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(AlertingConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AutoScheduleConfig) DeepCopyInto(out *AutoScheduleConfig) {
-	*out = *in
-	if in.Buffer != nil {
-		in, out := &in.Buffer, &out.Buffer
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.MissedScheduleThreshold != nil {
-		in, out := &in.MissedScheduleThreshold, &out.MissedScheduleThreshold
-		*out = new(int32)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScheduleConfig.
-func (in *AutoScheduleConfig) DeepCopy() *AutoScheduleConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(AutoScheduleConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChannelRef) DeepCopyInto(out *ChannelRef) {
-	*out = *in
-	if in.Severities != nil {
-		in, out := &in.Severities, &out.Severities
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRef.
-func (in *ChannelRef) DeepCopy() *ChannelRef {
-	if in == nil {
-		return nil
-	}
-	out := new(ChannelRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobMetrics) DeepCopyInto(out *CronJobMetrics) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMetrics.
-func (in *CronJobMetrics) DeepCopy() *CronJobMetrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertGrouping.
+func (in *AlertGrouping) DeepCopy() *AlertGrouping {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobMetrics)
+	out := new(AlertGrouping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobMonitor) DeepCopyInto(out *CronJobMonitor) {
+func (in *AlertRoute) DeepCopyInto(out *AlertRoute) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -351,18 +340,18 @@ func (in *CronJobMonitor) DeepCopyInto(out *CronJobMonitor) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitor.
-func (in *CronJobMonitor) DeepCopy() *CronJobMonitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoute.
+func (in *AlertRoute) DeepCopy() *AlertRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobMonitor)
+	out := new(AlertRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CronJobMonitor) DeepCopyObject() runtime.Object {
+func (in *AlertRoute) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -370,31 +359,31 @@ func (in *CronJobMonitor) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobMonitorList) DeepCopyInto(out *CronJobMonitorList) {
+func (in *AlertRouteList) DeepCopyInto(out *AlertRouteList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CronJobMonitor, len(*in))
+		*out = make([]AlertRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorList.
-func (in *CronJobMonitorList) DeepCopy() *CronJobMonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRouteList.
+func (in *AlertRouteList) DeepCopy() *AlertRouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobMonitorList)
+	out := new(AlertRouteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CronJobMonitorList) DeepCopyObject() runtime.Object {
+func (in *AlertRouteList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -402,76 +391,88 @@ func (in *CronJobMonitorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobMonitorSpec) DeepCopyInto(out *CronJobMonitorSpec) {
+func (in *AlertRouteMatch) DeepCopyInto(out *AlertRouteMatch) {
 	*out = *in
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(CronJobSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DeadManSwitch != nil {
-		in, out := &in.DeadManSwitch, &out.DeadManSwitch
-		*out = new(DeadManSwitchConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SLA != nil {
-		in, out := &in.SLA, &out.SLA
-		*out = new(SLAConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SuspendedHandling != nil {
-		in, out := &in.SuspendedHandling, &out.SuspendedHandling
-		*out = new(SuspendedHandlingConfig)
+	if in.CronJobSelector != nil {
+		in, out := &in.CronJobSelector, &out.CronJobSelector
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MaintenanceWindows != nil {
-		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
-		*out = make([]MaintenanceWindow, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Alerting != nil {
-		in, out := &in.Alerting, &out.Alerting
-		*out = new(AlertingConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.DataRetention != nil {
-		in, out := &in.DataRetention, &out.DataRetention
-		*out = new(DataRetentionConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorSpec.
-func (in *CronJobMonitorSpec) DeepCopy() *CronJobMonitorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRouteMatch.
+func (in *AlertRouteMatch) DeepCopy() *AlertRouteMatch {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobMonitorSpec)
+	out := new(AlertRouteMatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobMonitorStatus) DeepCopyInto(out *CronJobMonitorStatus) {
+func (in *AlertRouteSpec) DeepCopyInto(out *AlertRouteSpec) {
 	*out = *in
-	if in.LastReconcileTime != nil {
-		in, out := &in.LastReconcileTime, &out.LastReconcileTime
-		*out = (*in).DeepCopy()
+	in.Match.DeepCopyInto(&out.Match)
+	if in.ChannelRefs != nil {
+		in, out := &in.ChannelRefs, &out.ChannelRefs
+		*out = make([]ChannelRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Summary != nil {
-		in, out := &in.Summary, &out.Summary
-		*out = new(MonitorSummary)
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
 		**out = **in
 	}
-	if in.CronJobs != nil {
-		in, out := &in.CronJobs, &out.CronJobs
-		*out = make([]CronJobStatus, len(*in))
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(AlertGrouping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inhibit != nil {
+		in, out := &in.Inhibit, &out.Inhibit
+		*out = make([]InhibitRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRouteSpec.
+func (in *AlertRouteSpec) DeepCopy() *AlertRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRouteStatus) DeepCopyInto(out *AlertRouteStatus) {
+	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -481,56 +482,558 @@ func (in *CronJobMonitorStatus) DeepCopyInto(out *CronJobMonitorStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorStatus.
-func (in *CronJobMonitorStatus) DeepCopy() *CronJobMonitorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRouteStatus.
+func (in *AlertRouteStatus) DeepCopy() *AlertRouteStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobMonitorStatus)
+	out := new(AlertRouteStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJobSelector) DeepCopyInto(out *CronJobSelector) {
+func (in *AlertRoutingConfig) DeepCopyInto(out *AlertRoutingConfig) {
 	*out = *in
-	if in.MatchLabels != nil {
-		in, out := &in.MatchLabels, &out.MatchLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.MatchExpressions != nil {
-		in, out := &in.MatchExpressions, &out.MatchExpressions
-		*out = make([]v1.LabelSelectorRequirement, len(*in))
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RoutingRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.MatchNames != nil {
-		in, out := &in.MatchNames, &out.MatchNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Namespaces != nil {
-		in, out := &in.Namespaces, &out.Namespaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobSelector.
-func (in *CronJobSelector) DeepCopy() *CronJobSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoutingConfig.
+func (in *AlertRoutingConfig) DeepCopy() *AlertRoutingConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobSelector)
+	out := new(AlertRoutingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertingConfig) DeepCopyInto(out *AlertingConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChannelRefs != nil {
+		in, out := &in.ChannelRefs, &out.ChannelRefs
+		*out = make([]ChannelRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(AlertRoutingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeContext != nil {
+		in, out := &in.IncludeContext, &out.IncludeContext
+		*out = new(AlertContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuppressDuplicatesFor != nil {
+		in, out := &in.SuppressDuplicatesFor, &out.SuppressDuplicatesFor
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AlertDelay != nil {
+		in, out := &in.AlertDelay, &out.AlertDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RateLimiting != nil {
+		in, out := &in.RateLimiting, &out.RateLimiting
+		*out = new(RateLimitConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureStreak != nil {
+		in, out := &in.FailureStreak, &out.FailureStreak
+		*out = new(FailureStreakConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LongSuccessStreak != nil {
+		in, out := &in.LongSuccessStreak, &out.LongSuccessStreak
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FlapDetection != nil {
+		in, out := &in.FlapDetection, &out.FlapDetection
+		*out = new(FlapDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeverityOverrides != nil {
+		in, out := &in.SeverityOverrides, &out.SeverityOverrides
+		*out = make(SeverityOverrides, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RunbookURLs != nil {
+		in, out := &in.RunbookURLs, &out.RunbookURLs
+		*out = make(RunbookURLs, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SuggestedFixPatterns != nil {
+		in, out := &in.SuggestedFixPatterns, &out.SuggestedFixPatterns
+		*out = make([]SuggestedFixPattern, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertingConfig.
+func (in *AlertingConfig) DeepCopy() *AlertingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScheduleConfig) DeepCopyInto(out *AutoScheduleConfig) {
+	*out = *in
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MissedScheduleThreshold != nil {
+		in, out := &in.MissedScheduleThreshold, &out.MissedScheduleThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScheduleConfig.
+func (in *AutoScheduleConfig) DeepCopy() *AutoScheduleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScheduleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomationHook) DeepCopyInto(out *AutomationHook) {
+	*out = *in
+	if in.AlertTypes != nil {
+		in, out := &in.AlertTypes, &out.AlertTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomationHook.
+func (in *AutomationHook) DeepCopy() *AutomationHook {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomationHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleRef) DeepCopyInto(out *CABundleRef) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(NamespacedSecretKeyRef)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(NamespacedConfigMapKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleRef.
+func (in *CABundleRef) DeepCopy() *CABundleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelHTTPConfig) DeepCopyInto(out *ChannelHTTPConfig) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(CABundleRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelHTTPConfig.
+func (in *ChannelHTTPConfig) DeepCopy() *ChannelHTTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelHTTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelRef) DeepCopyInto(out *ChannelRef) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelRef.
+func (in *ChannelRef) DeepCopy() *ChannelRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClassificationRule) DeepCopyInto(out *ClassificationRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassificationRule.
+func (in *ClassificationRule) DeepCopy() *ClassificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ClassificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobDependency) DeepCopyInto(out *CronJobDependency) {
+	*out = *in
+	out.CronJob = in.CronJob
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]CronJobReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobDependency.
+func (in *CronJobDependency) DeepCopy() *CronJobDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobMetrics) DeepCopyInto(out *CronJobMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMetrics.
+func (in *CronJobMetrics) DeepCopy() *CronJobMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobMonitor) DeepCopyInto(out *CronJobMonitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitor.
+func (in *CronJobMonitor) DeepCopy() *CronJobMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronJobMonitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobMonitorList) DeepCopyInto(out *CronJobMonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CronJobMonitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorList.
+func (in *CronJobMonitorList) DeepCopy() *CronJobMonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobMonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronJobMonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobMonitorSpec) DeepCopyInto(out *CronJobMonitorSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(CronJobSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadManSwitch != nil {
+		in, out := &in.DeadManSwitch, &out.DeadManSwitch
+		*out = new(DeadManSwitchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SLA != nil {
+		in, out := &in.SLA, &out.SLA
+		*out = new(SLAConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StuckJobs != nil {
+		in, out := &in.StuckJobs, &out.StuckJobs
+		*out = new(StuckJobConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = new(RecommendationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuspendedHandling != nil {
+		in, out := &in.SuspendedHandling, &out.SuspendedHandling
+		*out = new(SuspendedHandlingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SilencedUntil != nil {
+		in, out := &in.SilencedUntil, &out.SilencedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]CronJobDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HealthcheckPings != nil {
+		in, out := &in.HealthcheckPings, &out.HealthcheckPings
+		*out = make([]HealthcheckPing, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutomationHooks != nil {
+		in, out := &in.AutomationHooks, &out.AutomationHooks
+		*out = make([]AutomationHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(AlertingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataRetention != nil {
+		in, out := &in.DataRetention, &out.DataRetention
+		*out = new(DataRetentionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureClassification != nil {
+		in, out := &in.FailureClassification, &out.FailureClassification
+		*out = new(FailureClassificationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuccessCriteria != nil {
+		in, out := &in.SuccessCriteria, &out.SuccessCriteria
+		*out = new(SuccessCriteriaConfig)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorSpec.
+func (in *CronJobMonitorSpec) DeepCopy() *CronJobMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobMonitorStatus) DeepCopyInto(out *CronJobMonitorStatus) {
+	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(MonitorSummary)
+		**out = **in
+	}
+	if in.CronJobs != nil {
+		in, out := &in.CronJobs, &out.CronJobs
+		*out = make([]CronJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobMonitorStatus.
+func (in *CronJobMonitorStatus) DeepCopy() *CronJobMonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobMonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobReference) DeepCopyInto(out *CronJobReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobReference.
+func (in *CronJobReference) DeepCopy() *CronJobReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJobSelector) DeepCopyInto(out *CronJobSelector) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]v1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchNames != nil {
+		in, out := &in.MatchNames, &out.MatchNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobSelector.
+func (in *CronJobSelector) DeepCopy() *CronJobSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobSelector)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -538,161 +1041,794 @@ func (in *CronJobSelector) DeepCopy() *CronJobSelector {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronJobStatus) DeepCopyInto(out *CronJobStatus) {
 	*out = *in
-	if in.LastSuccessfulTime != nil {
-		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailedTime != nil {
+		in, out := &in.LastFailedTime, &out.LastFailedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRunDuration != nil {
+		in, out := &in.LastRunDuration, &out.LastRunDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NextScheduledTime != nil {
+		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpcomingRuns != nil {
+		in, out := &in.UpcomingRuns, &out.UpcomingRuns
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(CronJobMetrics)
+		**out = **in
+	}
+	if in.ActiveJobs != nil {
+		in, out := &in.ActiveJobs, &out.ActiveJobs
+		*out = make([]ActiveJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActiveAlerts != nil {
+		in, out := &in.ActiveAlerts, &out.ActiveAlerts
+		*out = make([]ActiveAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Recommendation != nil {
+		in, out := &in.Recommendation, &out.Recommendation
+		*out = new(LimitRecommendation)
+		**out = **in
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]DriftStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobStatus.
+func (in *CronJobStatus) DeepCopy() *CronJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataRetentionConfig) DeepCopyInto(out *DataRetentionConfig) {
+	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PurgeAfterDays != nil {
+		in, out := &in.PurgeAfterDays, &out.PurgeAfterDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StoreLogs != nil {
+		in, out := &in.StoreLogs, &out.StoreLogs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogRetentionDays != nil {
+		in, out := &in.LogRetentionDays, &out.LogRetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLogSizeKB != nil {
+		in, out := &in.MaxLogSizeKB, &out.MaxLogSizeKB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StoreEvents != nil {
+		in, out := &in.StoreEvents, &out.StoreEvents
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataRetentionConfig.
+func (in *DataRetentionConfig) DeepCopy() *DataRetentionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DataRetentionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadManSwitchConfig) DeepCopyInto(out *DeadManSwitchConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxTimeSinceLastSuccess != nil {
+		in, out := &in.MaxTimeSinceLastSuccess, &out.MaxTimeSinceLastSuccess
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AutoFromSchedule != nil {
+		in, out := &in.AutoFromSchedule, &out.AutoFromSchedule
+		*out = new(AutoScheduleConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadManSwitchConfig.
+func (in *DeadManSwitchConfig) DeepCopy() *DeadManSwitchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadManSwitchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftStatus) DeepCopyInto(out *DriftStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftStatus.
+func (in *DriftStatus) DeepCopy() *DriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
+	*out = *in
+	out.SMTPSecretRef = in.SMTPSecretRef
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
+		*out = new(EmailDigestConfig)
+		**out = **in
+	}
+	if in.SLAReport != nil {
+		in, out := &in.SLAReport, &out.SLAReport
+		*out = new(EmailSLAReportConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailConfig.
+func (in *EmailConfig) DeepCopy() *EmailConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailDigestConfig) DeepCopyInto(out *EmailDigestConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailDigestConfig.
+func (in *EmailDigestConfig) DeepCopy() *EmailDigestConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailDigestConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSLAReportConfig) DeepCopyInto(out *EmailSLAReportConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSLAReportConfig.
+func (in *EmailSLAReportConfig) DeepCopy() *EmailSLAReportConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSLAReportConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventGridConfig) DeepCopyInto(out *EventGridConfig) {
+	*out = *in
+	out.AccessKeySecretRef = in.AccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventGridConfig.
+func (in *EventGridConfig) DeepCopy() *EventGridConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventGridConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExitCodeRange) DeepCopyInto(out *ExitCodeRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExitCodeRange.
+func (in *ExitCodeRange) DeepCopy() *ExitCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(ExitCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalJob) DeepCopyInto(out *ExternalJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalJob.
+func (in *ExternalJob) DeepCopy() *ExternalJob {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalJobList) DeepCopyInto(out *ExternalJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalJobList.
+func (in *ExternalJobList) DeepCopy() *ExternalJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalJobSpec) DeepCopyInto(out *ExternalJobSpec) {
+	*out = *in
+	in.TokenSecretRef.DeepCopyInto(&out.TokenSecretRef)
+	if in.DeadManSwitch != nil {
+		in, out := &in.DeadManSwitch, &out.DeadManSwitch
+		*out = new(DeadManSwitchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(AlertingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalJobSpec.
+func (in *ExternalJobSpec) DeepCopy() *ExternalJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalJobStatus) DeepCopyInto(out *ExternalJobStatus) {
+	*out = *in
+	if in.LastStartTime != nil {
+		in, out := &in.LastStartTime, &out.LastStartTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastFailedTime != nil {
-		in, out := &in.LastFailedTime, &out.LastFailedTime
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastRunDuration != nil {
-		in, out := &in.LastRunDuration, &out.LastRunDuration
-		*out = new(v1.Duration)
-		**out = **in
-	}
-	if in.NextScheduledTime != nil {
-		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
 		*out = (*in).DeepCopy()
 	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = new(CronJobMetrics)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalJobStatus.
+func (in *ExternalJobStatus) DeepCopy() *ExternalJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureClassificationConfig) DeepCopyInto(out *FailureClassificationConfig) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ClassificationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogLines != nil {
+		in, out := &in.LogLines, &out.LogLines
+		*out = new(int32)
 		**out = **in
 	}
-	if in.ActiveJobs != nil {
-		in, out := &in.ActiveJobs, &out.ActiveJobs
-		*out = make([]ActiveJob, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureClassificationConfig.
+func (in *FailureClassificationConfig) DeepCopy() *FailureClassificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureClassificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePattern) DeepCopyInto(out *FailurePattern) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePattern.
+func (in *FailurePattern) DeepCopy() *FailurePattern {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FailurePattern) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePatternList) DeepCopyInto(out *FailurePatternList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FailurePattern, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ActiveAlerts != nil {
-		in, out := &in.ActiveAlerts, &out.ActiveAlerts
-		*out = make([]ActiveAlert, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePatternList.
+func (in *FailurePatternList) DeepCopy() *FailurePatternList {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePatternList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FailurePatternList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePatternSpec) DeepCopyInto(out *FailurePatternSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(CronJobSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePatternSpec.
+func (in *FailurePatternSpec) DeepCopy() *FailurePatternSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePatternSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePatternStatus) DeepCopyInto(out *FailurePatternStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobStatus.
-func (in *CronJobStatus) DeepCopy() *CronJobStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePatternStatus.
+func (in *FailurePatternStatus) DeepCopy() *FailurePatternStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CronJobStatus)
+	out := new(FailurePatternStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataRetentionConfig) DeepCopyInto(out *DataRetentionConfig) {
+func (in *FailureStreakConfig) DeepCopyInto(out *FailureStreakConfig) {
 	*out = *in
-	if in.RetentionDays != nil {
-		in, out := &in.RetentionDays, &out.RetentionDays
+	if in.AlertAfter != nil {
+		in, out := &in.AlertAfter, &out.AlertAfter
 		*out = new(int32)
 		**out = **in
 	}
-	if in.PurgeAfterDays != nil {
-		in, out := &in.PurgeAfterDays, &out.PurgeAfterDays
+	if in.CriticalAfter != nil {
+		in, out := &in.CriticalAfter, &out.CriticalAfter
 		*out = new(int32)
 		**out = **in
 	}
-	if in.StoreLogs != nil {
-		in, out := &in.StoreLogs, &out.StoreLogs
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureStreakConfig.
+func (in *FailureStreakConfig) DeepCopy() *FailureStreakConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureStreakConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlapDetectionConfig) DeepCopyInto(out *FlapDetectionConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LogRetentionDays != nil {
-		in, out := &in.LogRetentionDays, &out.LogRetentionDays
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
 		*out = new(int32)
 		**out = **in
 	}
-	if in.MaxLogSizeKB != nil {
-		in, out := &in.MaxLogSizeKB, &out.MaxLogSizeKB
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlapDetectionConfig.
+func (in *FlapDetectionConfig) DeepCopy() *FlapDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FlapDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianConfig) DeepCopyInto(out *GuardianConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianConfig.
+func (in *GuardianConfig) DeepCopy() *GuardianConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuardianConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianConfigList) DeepCopyInto(out *GuardianConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GuardianConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianConfigList.
+func (in *GuardianConfigList) DeepCopy() *GuardianConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuardianConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianConfigSpec) DeepCopyInto(out *GuardianConfigSpec) {
+	*out = *in
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = new(GuardianRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(GuardianRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupGracePeriod != nil {
+		in, out := &in.StartupGracePeriod, &out.StartupGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IgnoredNamespaces != nil {
+		in, out := &in.IgnoredNamespaces, &out.IgnoredNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianConfigSpec.
+func (in *GuardianConfigSpec) DeepCopy() *GuardianConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianConfigStatus) DeepCopyInto(out *GuardianConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianConfigStatus.
+func (in *GuardianConfigStatus) DeepCopy() *GuardianConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianRateLimits) DeepCopyInto(out *GuardianRateLimits) {
+	*out = *in
+	if in.MaxAlertsPerMinute != nil {
+		in, out := &in.MaxAlertsPerMinute, &out.MaxAlertsPerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BurstLimit != nil {
+		in, out := &in.BurstLimit, &out.BurstLimit
 		*out = new(int32)
 		**out = **in
 	}
-	if in.StoreEvents != nil {
-		in, out := &in.StoreEvents, &out.StoreEvents
-		*out = new(bool)
+	if in.DefaultSuppressDuplicatesFor != nil {
+		in, out := &in.DefaultSuppressDuplicatesFor, &out.DefaultSuppressDuplicatesFor
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataRetentionConfig.
-func (in *DataRetentionConfig) DeepCopy() *DataRetentionConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianRateLimits.
+func (in *GuardianRateLimits) DeepCopy() *GuardianRateLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(DataRetentionConfig)
+	out := new(GuardianRateLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeadManSwitchConfig) DeepCopyInto(out *DeadManSwitchConfig) {
+func (in *GuardianRetention) DeepCopyInto(out *GuardianRetention) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.HistoryDays != nil {
+		in, out := &in.HistoryDays, &out.HistoryDays
+		*out = new(int32)
 		**out = **in
 	}
-	if in.MaxTimeSinceLastSuccess != nil {
-		in, out := &in.MaxTimeSinceLastSuccess, &out.MaxTimeSinceLastSuccess
-		*out = new(v1.Duration)
+	if in.LogDays != nil {
+		in, out := &in.LogDays, &out.LogDays
+		*out = new(int32)
 		**out = **in
 	}
-	if in.AutoFromSchedule != nil {
-		in, out := &in.AutoFromSchedule, &out.AutoFromSchedule
-		*out = new(AutoScheduleConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianRetention.
+func (in *GuardianRetention) DeepCopy() *GuardianRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthcheckPing) DeepCopyInto(out *HealthcheckPing) {
+	*out = *in
+	if in.CronJobs != nil {
+		in, out := &in.CronJobs, &out.CronJobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadManSwitchConfig.
-func (in *DeadManSwitchConfig) DeepCopy() *DeadManSwitchConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthcheckPing.
+func (in *HealthcheckPing) DeepCopy() *HealthcheckPing {
 	if in == nil {
 		return nil
 	}
-	out := new(DeadManSwitchConfig)
+	out := new(HealthcheckPing)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EmailConfig) DeepCopyInto(out *EmailConfig) {
+func (in *InhibitRule) DeepCopyInto(out *InhibitRule) {
 	*out = *in
-	out.SMTPSecretRef = in.SMTPSecretRef
-	if in.To != nil {
-		in, out := &in.To, &out.To
+	if in.SourceTypes != nil {
+		in, out := &in.SourceTypes, &out.SourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSeverities != nil {
+		in, out := &in.SourceSeverities, &out.SourceSeverities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Equal != nil {
+		in, out := &in.Equal, &out.Equal
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MinSources != nil {
+		in, out := &in.MinSources, &out.MinSources
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailConfig.
-func (in *EmailConfig) DeepCopy() *EmailConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InhibitRule.
+func (in *InhibitRule) DeepCopy() *InhibitRule {
 	if in == nil {
 		return nil
 	}
-	out := new(EmailConfig)
+	out := new(InhibitRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExitCodeRange) DeepCopyInto(out *ExitCodeRange) {
+func (in *LimitRecommendation) DeepCopyInto(out *LimitRecommendation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExitCodeRange.
-func (in *ExitCodeRange) DeepCopy() *ExitCodeRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitRecommendation.
+func (in *LimitRecommendation) DeepCopy() *LimitRecommendation {
 	if in == nil {
 		return nil
 	}
-	out := new(ExitCodeRange)
+	out := new(LimitRecommendation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -733,6 +1869,21 @@ func (in *MonitorSummary) DeepCopy() *MonitorSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedConfigMapKeyRef) DeepCopyInto(out *NamespacedConfigMapKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedConfigMapKeyRef.
+func (in *NamespacedConfigMapKeyRef) DeepCopy() *NamespacedConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedSecretKeyRef) DeepCopyInto(out *NamespacedSecretKeyRef) {
 	*out = *in
@@ -763,6 +1914,51 @@ func (in *NamespacedSecretRef) DeepCopy() *NamespacedSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NtfyConfig) DeepCopyInto(out *NtfyConfig) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(NamespacedSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NtfyConfig.
+func (in *NtfyConfig) DeepCopy() *NtfyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NtfyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputConfig) DeepCopyInto(out *OutputConfig) {
+	*out = *in
+	if in.DropThresholdPercent != nil {
+		in, out := &in.DropThresholdPercent, &out.DropThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BaselineRuns != nil {
+		in, out := &in.BaselineRuns, &out.BaselineRuns
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputConfig.
+func (in *OutputConfig) DeepCopy() *OutputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerDutyConfig) DeepCopyInto(out *PagerDutyConfig) {
 	*out = *in
@@ -804,6 +2000,43 @@ func (in *PatternMatch) DeepCopy() *PatternMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PubSubConfig) DeepCopyInto(out *PubSubConfig) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PubSubConfig.
+func (in *PubSubConfig) DeepCopy() *PubSubConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PubSubConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushoverConfig) DeepCopyInto(out *PushoverConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushoverConfig.
+func (in *PushoverConfig) DeepCopy() *PushoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PushoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitConfig) DeepCopyInto(out *RateLimitConfig) {
 	*out = *in
@@ -829,6 +2062,89 @@ func (in *RateLimitConfig) DeepCopy() *RateLimitConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecommendationConfig) DeepCopyInto(out *RecommendationConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeadlineMultiplier != nil {
+		in, out := &in.DeadlineMultiplier, &out.DeadlineMultiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.WindowDays != nil {
+		in, out := &in.WindowDays, &out.WindowDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRuns != nil {
+		in, out := &in.MinRuns, &out.MinRuns
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecommendationConfig.
+func (in *RecommendationConfig) DeepCopy() *RecommendationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RecommendationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChannelRefs != nil {
+		in, out := &in.ChannelRefs, &out.ChannelRefs
+		*out = make([]ChannelRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RunbookURLs) DeepCopyInto(out *RunbookURLs) {
+	{
+		in := &in
+		*out = make(RunbookURLs, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunbookURLs.
+func (in RunbookURLs) DeepCopy() RunbookURLs {
+	if in == nil {
+		return nil
+	}
+	out := new(RunbookURLs)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SLAConfig) DeepCopyInto(out *SLAConfig) {
 	*out = *in
@@ -862,6 +2178,11 @@ func (in *SLAConfig) DeepCopyInto(out *SLAConfig) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ScheduleBudgetPercent != nil {
+		in, out := &in.ScheduleBudgetPercent, &out.ScheduleBudgetPercent
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLAConfig.
@@ -875,18 +2196,40 @@ func (in *SLAConfig) DeepCopy() *SLAConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeverityOverrides) DeepCopyInto(out *SeverityOverrides) {
+func (in *SNSConfig) DeepCopyInto(out *SNSConfig) {
 	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSConfig.
+func (in *SNSConfig) DeepCopy() *SNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SeverityOverrides) DeepCopyInto(out *SeverityOverrides) {
+	{
+		in := &in
+		*out = make(SeverityOverrides, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeverityOverrides.
-func (in *SeverityOverrides) DeepCopy() *SeverityOverrides {
+func (in SeverityOverrides) DeepCopy() SeverityOverrides {
 	if in == nil {
 		return nil
 	}
 	out := new(SeverityOverrides)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -905,6 +2248,61 @@ func (in *SlackConfig) DeepCopy() *SlackConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckJobConfig) DeepCopyInto(out *StuckJobConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxRuntime != nil {
+		in, out := &in.MaxRuntime, &out.MaxRuntime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.P95Multiplier != nil {
+		in, out := &in.P95Multiplier, &out.P95Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.BaselineWindowDays != nil {
+		in, out := &in.BaselineWindowDays, &out.BaselineWindowDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRuns != nil {
+		in, out := &in.MinRuns, &out.MinRuns
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckJobConfig.
+func (in *StuckJobConfig) DeepCopy() *StuckJobConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckJobConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuccessCriteriaConfig) DeepCopyInto(out *SuccessCriteriaConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuccessCriteriaConfig.
+func (in *SuccessCriteriaConfig) DeepCopy() *SuccessCriteriaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SuccessCriteriaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuggestedFixPattern) DeepCopyInto(out *SuggestedFixPattern) {
 	*out = *in
@@ -939,6 +2337,11 @@ func (in *SuspendedHandlingConfig) DeepCopyInto(out *SuspendedHandlingConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.IntentionallySuspended != nil {
+		in, out := &in.IntentionallySuspended, &out.IntentionallySuspended
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuspendedHandlingConfig.
@@ -951,6 +2354,22 @@ func (in *SuspendedHandlingConfig) DeepCopy() *SuspendedHandlingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelegramConfig) DeepCopyInto(out *TelegramConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelegramConfig.
+func (in *TelegramConfig) DeepCopy() *TelegramConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TelegramConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
@@ -0,0 +1,231 @@
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// ConvertTo converts this AlertChannel to the hub version (v1alpha1)
+func (src *AlertChannel) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.AlertChannel)
+	dst.ObjectMeta = src.ObjectMeta
+
+	in := &src.Spec
+	dst.Spec = v1alpha1.AlertChannelSpec{
+		Type:         in.Type,
+		RateLimiting: (*v1alpha1.RateLimitConfig)(in.RateLimiting),
+		SendTimeout:  in.SendTimeout,
+		RetryCount:   in.RetryCount,
+		RetryBackoff: in.RetryBackoff,
+		TestOnSave:   in.TestOnSave,
+	}
+	if c := in.Slack; c != nil {
+		dst.Spec.Slack = &v1alpha1.SlackConfig{
+			WebhookSecretRef: v1alpha1.NamespacedSecretKeyRef(c.WebhookSecretRef),
+			DefaultChannel:   c.DefaultChannel,
+			MessageTemplate:  c.MessageTemplate,
+			Interactive:      c.Interactive,
+		}
+	}
+	if c := in.PagerDuty; c != nil {
+		dst.Spec.PagerDuty = &v1alpha1.PagerDutyConfig{
+			RoutingKeySecretRef: v1alpha1.NamespacedSecretKeyRef(c.RoutingKeySecretRef),
+			Severity:            c.Severity,
+		}
+	}
+	if c := in.Webhook; c != nil {
+		dst.Spec.Webhook = &v1alpha1.WebhookConfig{
+			URLSecretRef:    v1alpha1.NamespacedSecretKeyRef(c.URLSecretRef),
+			Method:          c.Method,
+			Headers:         c.Headers,
+			PayloadTemplate: c.PayloadTemplate,
+		}
+	}
+	if c := in.Email; c != nil {
+		dst.Spec.Email = &v1alpha1.EmailConfig{
+			SMTPSecretRef:   v1alpha1.NamespacedSecretRef(c.SMTPSecretRef),
+			From:            c.From,
+			To:              c.To,
+			SubjectTemplate: c.SubjectTemplate,
+			BodyTemplate:    c.BodyTemplate,
+			Format:          c.Format,
+			Digest:          (*v1alpha1.EmailDigestConfig)(c.Digest),
+			SLAReport:       (*v1alpha1.EmailSLAReportConfig)(c.SLAReport),
+		}
+	}
+	if c := in.Telegram; c != nil {
+		dst.Spec.Telegram = &v1alpha1.TelegramConfig{
+			SecretRef:       v1alpha1.NamespacedSecretRef(c.SecretRef),
+			MessageTemplate: c.MessageTemplate,
+			SilentInfo:      c.SilentInfo,
+		}
+	}
+	if c := in.SNS; c != nil {
+		dst.Spec.SNS = &v1alpha1.SNSConfig{
+			TopicARN:             c.TopicARN,
+			CredentialsSecretRef: v1alpha1.NamespacedSecretRef(c.CredentialsSecretRef),
+			Endpoint:             c.Endpoint,
+		}
+	}
+	if c := in.PubSub; c != nil {
+		dst.Spec.PubSub = &v1alpha1.PubSubConfig{
+			Topic:                c.Topic,
+			CredentialsSecretRef: v1alpha1.NamespacedSecretKeyRef(c.CredentialsSecretRef),
+			Endpoint:             c.Endpoint,
+		}
+	}
+	if c := in.EventGrid; c != nil {
+		dst.Spec.EventGrid = &v1alpha1.EventGridConfig{
+			TopicEndpoint:      c.TopicEndpoint,
+			AccessKeySecretRef: v1alpha1.NamespacedSecretKeyRef(c.AccessKeySecretRef),
+		}
+	}
+	if c := in.Ntfy; c != nil {
+		dst.Spec.Ntfy = &v1alpha1.NtfyConfig{
+			ServerURL:       c.ServerURL,
+			Topic:           c.Topic,
+			AuthSecretRef:   (*v1alpha1.NamespacedSecretRef)(c.AuthSecretRef),
+			MessageTemplate: c.MessageTemplate,
+		}
+	}
+	if c := in.Pushover; c != nil {
+		dst.Spec.Pushover = &v1alpha1.PushoverConfig{
+			SecretRef:         v1alpha1.NamespacedSecretRef(c.SecretRef),
+			Devices:           c.Devices,
+			Sound:             c.Sound,
+			EmergencyCritical: c.EmergencyCritical,
+			MessageTemplate:   c.MessageTemplate,
+		}
+	}
+	if c := in.HTTP; c != nil {
+		dst.Spec.HTTP = &v1alpha1.ChannelHTTPConfig{
+			ProxyURL:          c.ProxyURL,
+			NoProxy:           c.NoProxy,
+			DisableKeepAlives: c.DisableKeepAlives,
+			IPFamily:          c.IPFamily,
+			Nameserver:        c.Nameserver,
+		}
+		if b := c.CABundle; b != nil {
+			dst.Spec.HTTP.CABundle = &v1alpha1.CABundleRef{
+				SecretRef:    (*v1alpha1.NamespacedSecretKeyRef)(b.SecretRef),
+				ConfigMapRef: (*v1alpha1.NamespacedConfigMapKeyRef)(b.ConfigMapRef),
+			}
+		}
+	}
+
+	dst.Status = v1alpha1.AlertChannelStatus(src.Status)
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this AlertChannel
+func (dst *AlertChannel) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.AlertChannel)
+	dst.ObjectMeta = src.ObjectMeta
+
+	in := &src.Spec
+	dst.Spec = AlertChannelSpec{
+		Type:         in.Type,
+		RateLimiting: (*RateLimitConfig)(in.RateLimiting),
+		SendTimeout:  in.SendTimeout,
+		RetryCount:   in.RetryCount,
+		RetryBackoff: in.RetryBackoff,
+		TestOnSave:   in.TestOnSave,
+	}
+	if c := in.Slack; c != nil {
+		dst.Spec.Slack = &SlackConfig{
+			WebhookSecretRef: NamespacedSecretKeyRef(c.WebhookSecretRef),
+			DefaultChannel:   c.DefaultChannel,
+			MessageTemplate:  c.MessageTemplate,
+			Interactive:      c.Interactive,
+		}
+	}
+	if c := in.PagerDuty; c != nil {
+		dst.Spec.PagerDuty = &PagerDutyConfig{
+			RoutingKeySecretRef: NamespacedSecretKeyRef(c.RoutingKeySecretRef),
+			Severity:            c.Severity,
+		}
+	}
+	if c := in.Webhook; c != nil {
+		dst.Spec.Webhook = &WebhookConfig{
+			URLSecretRef:    NamespacedSecretKeyRef(c.URLSecretRef),
+			Method:          c.Method,
+			Headers:         c.Headers,
+			PayloadTemplate: c.PayloadTemplate,
+		}
+	}
+	if c := in.Email; c != nil {
+		dst.Spec.Email = &EmailConfig{
+			SMTPSecretRef:   NamespacedSecretRef(c.SMTPSecretRef),
+			From:            c.From,
+			To:              c.To,
+			SubjectTemplate: c.SubjectTemplate,
+			BodyTemplate:    c.BodyTemplate,
+			Format:          c.Format,
+			Digest:          (*EmailDigestConfig)(c.Digest),
+			SLAReport:       (*EmailSLAReportConfig)(c.SLAReport),
+		}
+	}
+	if c := in.Telegram; c != nil {
+		dst.Spec.Telegram = &TelegramConfig{
+			SecretRef:       NamespacedSecretRef(c.SecretRef),
+			MessageTemplate: c.MessageTemplate,
+			SilentInfo:      c.SilentInfo,
+		}
+	}
+	if c := in.SNS; c != nil {
+		dst.Spec.SNS = &SNSConfig{
+			TopicARN:             c.TopicARN,
+			CredentialsSecretRef: NamespacedSecretRef(c.CredentialsSecretRef),
+			Endpoint:             c.Endpoint,
+		}
+	}
+	if c := in.PubSub; c != nil {
+		dst.Spec.PubSub = &PubSubConfig{
+			Topic:                c.Topic,
+			CredentialsSecretRef: NamespacedSecretKeyRef(c.CredentialsSecretRef),
+			Endpoint:             c.Endpoint,
+		}
+	}
+	if c := in.EventGrid; c != nil {
+		dst.Spec.EventGrid = &EventGridConfig{
+			TopicEndpoint:      c.TopicEndpoint,
+			AccessKeySecretRef: NamespacedSecretKeyRef(c.AccessKeySecretRef),
+		}
+	}
+	if c := in.Ntfy; c != nil {
+		dst.Spec.Ntfy = &NtfyConfig{
+			ServerURL:       c.ServerURL,
+			Topic:           c.Topic,
+			AuthSecretRef:   (*NamespacedSecretRef)(c.AuthSecretRef),
+			MessageTemplate: c.MessageTemplate,
+		}
+	}
+	if c := in.Pushover; c != nil {
+		dst.Spec.Pushover = &PushoverConfig{
+			SecretRef:         NamespacedSecretRef(c.SecretRef),
+			Devices:           c.Devices,
+			Sound:             c.Sound,
+			EmergencyCritical: c.EmergencyCritical,
+			MessageTemplate:   c.MessageTemplate,
+		}
+	}
+	if c := in.HTTP; c != nil {
+		dst.Spec.HTTP = &ChannelHTTPConfig{
+			ProxyURL:          c.ProxyURL,
+			NoProxy:           c.NoProxy,
+			DisableKeepAlives: c.DisableKeepAlives,
+			IPFamily:          c.IPFamily,
+			Nameserver:        c.Nameserver,
+		}
+		if b := c.CABundle; b != nil {
+			dst.Spec.HTTP.CABundle = &CABundleRef{
+				SecretRef:    (*NamespacedSecretKeyRef)(b.SecretRef),
+				ConfigMapRef: (*NamespacedConfigMapKeyRef)(b.ConfigMapRef),
+			}
+		}
+	}
+
+	dst.Status = AlertChannelStatus(src.Status)
+	return nil
+}
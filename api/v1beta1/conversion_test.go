@@ -0,0 +1,155 @@
+package v1beta1
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// fill sets every exported field reachable from v to a non-zero value, so a
+// field missed by a conversion shows up as a round-trip difference
+func fill(v reflect.Value, counter *int) {
+	*counter++
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), counter)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(metav1.Time{}) {
+			v.Set(reflect.ValueOf(metav1.NewTime(time.Unix(int64(1700000000+*counter), 0))))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), counter)
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), counter)
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		key := reflect.New(v.Type().Key()).Elem()
+		fill(key, counter)
+		val := reflect.New(v.Type().Elem()).Elem()
+		fill(val, counter)
+		m.SetMapIndex(key, val)
+		v.Set(m)
+	case reflect.String:
+		v.SetString(fmt.Sprintf("value-%d", *counter))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(*counter))
+	case reflect.Float64:
+		v.SetFloat(float64(*counter) + 0.5)
+	}
+}
+
+func filled[T any]() *T {
+	obj := new(T)
+	counter := 0
+	fill(reflect.ValueOf(obj).Elem(), &counter)
+	return obj
+}
+
+func TestCronJobMonitorConversion_RoundTrip(t *testing.T) {
+	t.Run("v1beta1 to hub and back", func(t *testing.T) {
+		src := filled[CronJobMonitor]()
+		src.TypeMeta = metav1.TypeMeta{}
+
+		hub := &v1alpha1.CronJobMonitor{}
+		require.NoError(t, src.ConvertTo(hub))
+		back := &CronJobMonitor{}
+		require.NoError(t, back.ConvertFrom(hub))
+
+		assert.Equal(t, src, back)
+	})
+
+	t.Run("hub to v1beta1 and back", func(t *testing.T) {
+		hub := filled[v1alpha1.CronJobMonitor]()
+		hub.TypeMeta = metav1.TypeMeta{}
+
+		spoke := &CronJobMonitor{}
+		require.NoError(t, spoke.ConvertFrom(hub))
+		back := &v1alpha1.CronJobMonitor{}
+		require.NoError(t, spoke.ConvertTo(back))
+
+		assert.Equal(t, hub, back)
+	})
+}
+
+func TestCronJobMonitorConversion_RenamedFields(t *testing.T) {
+	threshold := int32(80)
+	src := &CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "backups", Namespace: "default"},
+		Spec: CronJobMonitorSpec{
+			DeadManSwitch: &DeadManSwitchConfig{MaxInterval: &metav1.Duration{Duration: 25 * time.Hour}},
+			SLA:           &SLAConfig{DurationRegression: &DurationRegressionConfig{ThresholdPercent: &threshold}},
+			Suspension: &SuspensionConfig{
+				AlertAfter:  &metav1.Duration{Duration: 72 * time.Hour},
+				Intentional: []string{"legacy-export"},
+			},
+			Alerting: &AlertingConfig{
+				Context:     &AlertContext{Logs: ptr(true)},
+				DedupWindow: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		},
+	}
+
+	hub := &v1alpha1.CronJobMonitor{}
+	require.NoError(t, src.ConvertTo(hub))
+
+	assert.Equal(t, "backups", hub.Name)
+	assert.Equal(t, 25*time.Hour, hub.Spec.DeadManSwitch.MaxTimeSinceLastSuccess.Duration)
+	assert.Equal(t, &threshold, hub.Spec.SLA.DurationRegressionThreshold)
+	assert.Nil(t, hub.Spec.SLA.DurationBaselineWindowDays)
+	assert.Equal(t, 72*time.Hour, hub.Spec.SuspendedHandling.AlertIfSuspendedFor.Duration)
+	assert.True(t, hub.Spec.SuspendedHandling.IsIntentionallySuspended("default", "legacy-export"))
+	assert.True(t, *hub.Spec.Alerting.IncludeContext.Logs)
+	assert.Equal(t, 30*time.Minute, hub.Spec.Alerting.SuppressDuplicatesFor.Duration)
+
+	// An SLA without regression settings gets no durationRegression block
+	hub.Spec.SLA.DurationRegressionThreshold = nil
+	back := &CronJobMonitor{}
+	require.NoError(t, back.ConvertFrom(hub))
+	require.NotNil(t, back.Spec.SLA)
+	assert.Nil(t, back.Spec.SLA.DurationRegression)
+}
+
+func TestAlertChannelConversion_RoundTrip(t *testing.T) {
+	t.Run("v1beta1 to hub and back", func(t *testing.T) {
+		src := filled[AlertChannel]()
+		src.TypeMeta = metav1.TypeMeta{}
+
+		hub := &v1alpha1.AlertChannel{}
+		require.NoError(t, src.ConvertTo(hub))
+		back := &AlertChannel{}
+		require.NoError(t, back.ConvertFrom(hub))
+
+		assert.Equal(t, src, back)
+	})
+
+	t.Run("hub to v1beta1 and back", func(t *testing.T) {
+		hub := filled[v1alpha1.AlertChannel]()
+		hub.TypeMeta = metav1.TypeMeta{}
+
+		spoke := &AlertChannel{}
+		require.NoError(t, spoke.ConvertFrom(hub))
+		back := &v1alpha1.AlertChannel{}
+		require.NoError(t, spoke.ConvertTo(back))
+
+		assert.Equal(t, hub, back)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,329 @@
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// Structs whose fields are the same in both versions are converted directly;
+// the ones below them are converted field by field because a field was
+// renamed or they embed other API types.
+
+// ConvertTo converts this CronJobMonitor to the hub version (v1alpha1)
+func (src *CronJobMonitor) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.CronJobMonitor)
+	dst.ObjectMeta = src.ObjectMeta
+
+	in := &src.Spec
+	dst.Spec = v1alpha1.CronJobMonitorSpec{
+		Selector:              (*v1alpha1.CronJobSelector)(in.Selector),
+		StuckJobs:             (*v1alpha1.StuckJobConfig)(in.StuckJobs),
+		Recommendations:       (*v1alpha1.RecommendationConfig)(in.Recommendations),
+		MaintenanceWindows:    convertSlice(in.MaintenanceWindows, func(w MaintenanceWindow) v1alpha1.MaintenanceWindow { return v1alpha1.MaintenanceWindow(w) }),
+		Mode:                  in.Mode,
+		SilencedUntil:         in.SilencedUntil,
+		Dependencies:          convertSlice(in.Dependencies, dependencyToHub),
+		HealthcheckPings:      convertSlice(in.HealthcheckPings, func(p HealthcheckPing) v1alpha1.HealthcheckPing { return v1alpha1.HealthcheckPing(p) }),
+		AutomationHooks:       convertSlice(in.AutomationHooks, func(h AutomationHook) v1alpha1.AutomationHook { return v1alpha1.AutomationHook(h) }),
+		DataRetention:         (*v1alpha1.DataRetentionConfig)(in.DataRetention),
+		FailureClassification: failureClassificationToHub(in.FailureClassification),
+		SuccessCriteria:       (*v1alpha1.SuccessCriteriaConfig)(in.SuccessCriteria),
+		Output:                (*v1alpha1.OutputConfig)(in.Output),
+	}
+	if d := in.DeadManSwitch; d != nil {
+		dst.Spec.DeadManSwitch = &v1alpha1.DeadManSwitchConfig{
+			Enabled:                 d.Enabled,
+			MaxTimeSinceLastSuccess: d.MaxInterval,
+			AutoFromSchedule:        (*v1alpha1.AutoScheduleConfig)(d.AutoFromSchedule),
+		}
+	}
+	if sla := in.SLA; sla != nil {
+		dst.Spec.SLA = &v1alpha1.SLAConfig{
+			Enabled:        sla.Enabled,
+			MinSuccessRate: sla.MinSuccessRate,
+			WindowDays:     sla.WindowDays,
+			MaxDuration:    sla.MaxDuration,
+		}
+		if r := sla.DurationRegression; r != nil {
+			dst.Spec.SLA.DurationRegressionThreshold = r.ThresholdPercent
+			dst.Spec.SLA.DurationBaselineWindowDays = r.BaselineWindowDays
+		}
+		if b := sla.ScheduleBudget; b != nil {
+			dst.Spec.SLA.ScheduleBudgetPercent = b.ThresholdPercent
+		}
+	}
+	if s := in.Suspension; s != nil {
+		dst.Spec.SuspendedHandling = &v1alpha1.SuspendedHandlingConfig{
+			PauseMonitoring:        s.PauseMonitoring,
+			AlertIfSuspendedFor:    s.AlertAfter,
+			IntentionallySuspended: s.Intentional,
+		}
+	}
+	if a := in.Alerting; a != nil {
+		dst.Spec.Alerting = &v1alpha1.AlertingConfig{
+			Enabled:               a.Enabled,
+			ChannelRefs:           convertSlice(a.ChannelRefs, channelRefToHub),
+			IncludeContext:        (*v1alpha1.AlertContext)(a.Context),
+			SuppressDuplicatesFor: a.DedupWindow,
+			AlertDelay:            a.AlertDelay,
+			RateLimiting:          (*v1alpha1.RateLimitConfig)(a.RateLimiting),
+			FailureStreak:         (*v1alpha1.FailureStreakConfig)(a.FailureStreak),
+			LongSuccessStreak:     a.LongSuccessStreak,
+			FlapDetection:         (*v1alpha1.FlapDetectionConfig)(a.FlapDetection),
+			SeverityOverrides:     v1alpha1.SeverityOverrides(a.SeverityOverrides),
+			RunbookURLs:           v1alpha1.RunbookURLs(a.RunbookURLs),
+			SuggestedFixPatterns:  convertSlice(a.SuggestedFixPatterns, suggestedFixPatternToHub),
+		}
+		if r := a.Routing; r != nil {
+			dst.Spec.Alerting.Routing = &v1alpha1.AlertRoutingConfig{
+				Timezone: r.Timezone,
+				Rules: convertSlice(r.Rules, func(rule RoutingRule) v1alpha1.RoutingRule {
+					return v1alpha1.RoutingRule{
+						Name:        rule.Name,
+						Days:        rule.Days,
+						Start:       rule.Start,
+						End:         rule.End,
+						ChannelRefs: convertSlice(rule.ChannelRefs, channelRefToHub),
+					}
+				}),
+			}
+		}
+	}
+
+	dst.Status = v1alpha1.CronJobMonitorStatus{
+		ObservedGeneration: src.Status.ObservedGeneration,
+		Phase:              src.Status.Phase,
+		LastReconcileTime:  src.Status.LastReconcileTime,
+		Summary:            (*v1alpha1.MonitorSummary)(src.Status.Summary),
+		CronJobs:           convertSlice(src.Status.CronJobs, cronJobStatusToHub),
+		Conditions:         src.Status.Conditions,
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this CronJobMonitor
+func (dst *CronJobMonitor) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.CronJobMonitor)
+	dst.ObjectMeta = src.ObjectMeta
+
+	in := &src.Spec
+	dst.Spec = CronJobMonitorSpec{
+		Selector:              (*CronJobSelector)(in.Selector),
+		StuckJobs:             (*StuckJobConfig)(in.StuckJobs),
+		Recommendations:       (*RecommendationConfig)(in.Recommendations),
+		MaintenanceWindows:    convertSlice(in.MaintenanceWindows, func(w v1alpha1.MaintenanceWindow) MaintenanceWindow { return MaintenanceWindow(w) }),
+		Mode:                  in.Mode,
+		SilencedUntil:         in.SilencedUntil,
+		Dependencies:          convertSlice(in.Dependencies, dependencyFromHub),
+		HealthcheckPings:      convertSlice(in.HealthcheckPings, func(p v1alpha1.HealthcheckPing) HealthcheckPing { return HealthcheckPing(p) }),
+		AutomationHooks:       convertSlice(in.AutomationHooks, func(h v1alpha1.AutomationHook) AutomationHook { return AutomationHook(h) }),
+		DataRetention:         (*DataRetentionConfig)(in.DataRetention),
+		FailureClassification: failureClassificationFromHub(in.FailureClassification),
+		SuccessCriteria:       (*SuccessCriteriaConfig)(in.SuccessCriteria),
+		Output:                (*OutputConfig)(in.Output),
+	}
+	if d := in.DeadManSwitch; d != nil {
+		dst.Spec.DeadManSwitch = &DeadManSwitchConfig{
+			Enabled:          d.Enabled,
+			MaxInterval:      d.MaxTimeSinceLastSuccess,
+			AutoFromSchedule: (*AutoScheduleConfig)(d.AutoFromSchedule),
+		}
+	}
+	if sla := in.SLA; sla != nil {
+		dst.Spec.SLA = &SLAConfig{
+			Enabled:        sla.Enabled,
+			MinSuccessRate: sla.MinSuccessRate,
+			WindowDays:     sla.WindowDays,
+			MaxDuration:    sla.MaxDuration,
+		}
+		if sla.DurationRegressionThreshold != nil || sla.DurationBaselineWindowDays != nil {
+			dst.Spec.SLA.DurationRegression = &DurationRegressionConfig{
+				ThresholdPercent:   sla.DurationRegressionThreshold,
+				BaselineWindowDays: sla.DurationBaselineWindowDays,
+			}
+		}
+		if sla.ScheduleBudgetPercent != nil {
+			dst.Spec.SLA.ScheduleBudget = &ScheduleBudgetConfig{ThresholdPercent: sla.ScheduleBudgetPercent}
+		}
+	}
+	if s := in.SuspendedHandling; s != nil {
+		dst.Spec.Suspension = &SuspensionConfig{
+			PauseMonitoring: s.PauseMonitoring,
+			AlertAfter:      s.AlertIfSuspendedFor,
+			Intentional:     s.IntentionallySuspended,
+		}
+	}
+	if a := in.Alerting; a != nil {
+		dst.Spec.Alerting = &AlertingConfig{
+			Enabled:              a.Enabled,
+			ChannelRefs:          convertSlice(a.ChannelRefs, channelRefFromHub),
+			Context:              (*AlertContext)(a.IncludeContext),
+			DedupWindow:          a.SuppressDuplicatesFor,
+			AlertDelay:           a.AlertDelay,
+			RateLimiting:         (*RateLimitConfig)(a.RateLimiting),
+			FailureStreak:        (*FailureStreakConfig)(a.FailureStreak),
+			LongSuccessStreak:    a.LongSuccessStreak,
+			FlapDetection:        (*FlapDetectionConfig)(a.FlapDetection),
+			SeverityOverrides:    SeverityOverrides(a.SeverityOverrides),
+			RunbookURLs:          RunbookURLs(a.RunbookURLs),
+			SuggestedFixPatterns: convertSlice(a.SuggestedFixPatterns, suggestedFixPatternFromHub),
+		}
+		if r := a.Routing; r != nil {
+			dst.Spec.Alerting.Routing = &AlertRoutingConfig{
+				Timezone: r.Timezone,
+				Rules: convertSlice(r.Rules, func(rule v1alpha1.RoutingRule) RoutingRule {
+					return RoutingRule{
+						Name:        rule.Name,
+						Days:        rule.Days,
+						Start:       rule.Start,
+						End:         rule.End,
+						ChannelRefs: convertSlice(rule.ChannelRefs, channelRefFromHub),
+					}
+				}),
+			}
+		}
+	}
+
+	dst.Status = CronJobMonitorStatus{
+		ObservedGeneration: src.Status.ObservedGeneration,
+		Phase:              src.Status.Phase,
+		LastReconcileTime:  src.Status.LastReconcileTime,
+		Summary:            (*MonitorSummary)(src.Status.Summary),
+		CronJobs:           convertSlice(src.Status.CronJobs, cronJobStatusFromHub),
+		Conditions:         src.Status.Conditions,
+	}
+	return nil
+}
+
+func failureClassificationToHub(in *FailureClassificationConfig) *v1alpha1.FailureClassificationConfig {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha1.FailureClassificationConfig{
+		Rules:    convertSlice(in.Rules, func(r ClassificationRule) v1alpha1.ClassificationRule { return v1alpha1.ClassificationRule(r) }),
+		LogLines: in.LogLines,
+	}
+}
+
+func failureClassificationFromHub(in *v1alpha1.FailureClassificationConfig) *FailureClassificationConfig {
+	if in == nil {
+		return nil
+	}
+	return &FailureClassificationConfig{
+		Rules:    convertSlice(in.Rules, func(r v1alpha1.ClassificationRule) ClassificationRule { return ClassificationRule(r) }),
+		LogLines: in.LogLines,
+	}
+}
+
+func dependencyToHub(in CronJobDependency) v1alpha1.CronJobDependency {
+	return v1alpha1.CronJobDependency{
+		CronJob:   v1alpha1.CronJobReference(in.CronJob),
+		DependsOn: convertSlice(in.DependsOn, func(r CronJobReference) v1alpha1.CronJobReference { return v1alpha1.CronJobReference(r) }),
+	}
+}
+
+func dependencyFromHub(in v1alpha1.CronJobDependency) CronJobDependency {
+	return CronJobDependency{
+		CronJob:   CronJobReference(in.CronJob),
+		DependsOn: convertSlice(in.DependsOn, func(r v1alpha1.CronJobReference) CronJobReference { return CronJobReference(r) }),
+	}
+}
+
+func channelRefToHub(in ChannelRef) v1alpha1.ChannelRef {
+	return v1alpha1.ChannelRef(in)
+}
+
+func channelRefFromHub(in v1alpha1.ChannelRef) ChannelRef {
+	return ChannelRef(in)
+}
+
+func suggestedFixPatternToHub(in SuggestedFixPattern) v1alpha1.SuggestedFixPattern {
+	return v1alpha1.SuggestedFixPattern{
+		Name: in.Name,
+		Match: v1alpha1.PatternMatch{
+			Container:     in.Match.Container,
+			ExitCode:      in.Match.ExitCode,
+			ExitCodeRange: (*v1alpha1.ExitCodeRange)(in.Match.ExitCodeRange),
+			Reason:        in.Match.Reason,
+			ReasonPattern: in.Match.ReasonPattern,
+			LogPattern:    in.Match.LogPattern,
+			EventPattern:  in.Match.EventPattern,
+		},
+		Suggestion: in.Suggestion,
+		Priority:   in.Priority,
+		Severity:   in.Severity,
+	}
+}
+
+func suggestedFixPatternFromHub(in v1alpha1.SuggestedFixPattern) SuggestedFixPattern {
+	return SuggestedFixPattern{
+		Name: in.Name,
+		Match: PatternMatch{
+			Container:     in.Match.Container,
+			ExitCode:      in.Match.ExitCode,
+			ExitCodeRange: (*ExitCodeRange)(in.Match.ExitCodeRange),
+			Reason:        in.Match.Reason,
+			ReasonPattern: in.Match.ReasonPattern,
+			LogPattern:    in.Match.LogPattern,
+			EventPattern:  in.Match.EventPattern,
+		},
+		Suggestion: in.Suggestion,
+		Priority:   in.Priority,
+		Severity:   in.Severity,
+	}
+}
+
+func cronJobStatusToHub(in CronJobStatus) v1alpha1.CronJobStatus {
+	return v1alpha1.CronJobStatus{
+		Name:                   in.Name,
+		Namespace:              in.Namespace,
+		Status:                 in.Status,
+		Suspended:              in.Suspended,
+		IntentionallySuspended: in.IntentionallySuspended,
+		LastSuccessfulTime:     in.LastSuccessfulTime,
+		LastFailedTime:         in.LastFailedTime,
+		ConsecutiveFailures:    in.ConsecutiveFailures,
+		LastRunDuration:        in.LastRunDuration,
+		NextScheduledTime:      in.NextScheduledTime,
+		UpcomingRuns:           in.UpcomingRuns,
+		Metrics:                (*v1alpha1.CronJobMetrics)(in.Metrics),
+		ActiveJobs:             convertSlice(in.ActiveJobs, func(j ActiveJob) v1alpha1.ActiveJob { return v1alpha1.ActiveJob(j) }),
+		ActiveAlerts:           convertSlice(in.ActiveAlerts, func(a ActiveAlert) v1alpha1.ActiveAlert { return v1alpha1.ActiveAlert(a) }),
+		Recommendation:         (*v1alpha1.LimitRecommendation)(in.Recommendation),
+		Drift:                  convertSlice(in.Drift, func(d DriftStatus) v1alpha1.DriftStatus { return v1alpha1.DriftStatus(d) }),
+	}
+}
+
+func cronJobStatusFromHub(in v1alpha1.CronJobStatus) CronJobStatus {
+	return CronJobStatus{
+		Name:                   in.Name,
+		Namespace:              in.Namespace,
+		Status:                 in.Status,
+		Suspended:              in.Suspended,
+		IntentionallySuspended: in.IntentionallySuspended,
+		LastSuccessfulTime:     in.LastSuccessfulTime,
+		LastFailedTime:         in.LastFailedTime,
+		ConsecutiveFailures:    in.ConsecutiveFailures,
+		LastRunDuration:        in.LastRunDuration,
+		NextScheduledTime:      in.NextScheduledTime,
+		UpcomingRuns:           in.UpcomingRuns,
+		Metrics:                (*CronJobMetrics)(in.Metrics),
+		ActiveJobs:             convertSlice(in.ActiveJobs, func(j v1alpha1.ActiveJob) ActiveJob { return ActiveJob(j) }),
+		ActiveAlerts:           convertSlice(in.ActiveAlerts, func(a v1alpha1.ActiveAlert) ActiveAlert { return ActiveAlert(a) }),
+		Recommendation:         (*LimitRecommendation)(in.Recommendation),
+		Drift:                  convertSlice(in.Drift, func(d v1alpha1.DriftStatus) DriftStatus { return DriftStatus(d) }),
+	}
+}
+
+// convertSlice converts each element of a slice, keeping nil slices nil
+func convertSlice[In, Out any](in []In, convert func(In) Out) []Out {
+	if in == nil {
+		return nil
+	}
+	out := make([]Out, len(in))
+	for i := range in {
+		out[i] = convert(in[i])
+	}
+	return out
+}
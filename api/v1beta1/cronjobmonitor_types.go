@@ -0,0 +1,1058 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CronJobMonitorSpec defines the desired state of CronJobMonitor
+type CronJobMonitorSpec struct {
+	// Selector specifies which CronJobs to monitor
+	// +optional
+	Selector *CronJobSelector `json:"selector,omitempty"`
+
+	// DeadManSwitch configures dead-man's switch alerting
+	// +optional
+	DeadManSwitch *DeadManSwitchConfig `json:"deadManSwitch,omitempty"`
+
+	// SLA configures SLA tracking and alerting
+	// +optional
+	SLA *SLAConfig `json:"sla,omitempty"`
+
+	// StuckJobs alerts on Jobs that are still running long after they
+	// should have finished
+	// +optional
+	StuckJobs *StuckJobConfig `json:"stuckJobs,omitempty"`
+
+	// Recommendations configures the activeDeadlineSeconds and backoffLimit
+	// recommended for each CronJob from its execution history
+	// +optional
+	Recommendations *RecommendationConfig `json:"recommendations,omitempty"`
+
+	// Suspension configures behavior for suspended CronJobs
+	// +optional
+	Suspension *SuspensionConfig `json:"suspension,omitempty"`
+
+	// MaintenanceWindows defines scheduled maintenance periods
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// Mode is enforce (default) to send alerts, or observe to record
+	// executions, compute SLAs and show everything in the UI without ever
+	// sending an alert, e.g. while a new team rolls the monitor out
+	// +kubebuilder:validation:Enum=observe;enforce
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// SilencedUntil silences all alerts of the monitor until this time, e.g.
+	// while an incident is being worked on. It expires on its own.
+	// +optional
+	SilencedUntil *metav1.Time `json:"silencedUntil,omitempty"`
+
+	// Dependencies declares job chains, where a CronJob consumes the output
+	// of other CronJobs. Failures of a CronJob whose upstream failed are
+	// reported as downstream failures, and a ChainBroken alert is sent when
+	// a CronJob will run before its failed upstream had a chance to recover.
+	// +optional
+	Dependencies []CronJobDependency `json:"dependencies,omitempty"`
+
+	// HealthcheckPings report runs of the monitored CronJobs to external
+	// ping-based monitoring services such as Healthchecks.io or Cronitor
+	// +optional
+	HealthcheckPings []HealthcheckPing `json:"healthcheckPings,omitempty"`
+
+	// AutomationHooks POST an event to a URL when alerts are sent, e.g. to
+	// trigger custom remediation, without setting up an AlertChannel
+	// +optional
+	AutomationHooks []AutomationHook `json:"automationHooks,omitempty"`
+
+	// Alerting configures alert channels and behavior
+	// +optional
+	Alerting *AlertingConfig `json:"alerting,omitempty"`
+
+	// DataRetention configures data lifecycle management
+	// +optional
+	DataRetention *DataRetentionConfig `json:"dataRetention,omitempty"`
+
+	// FailureClassification classifies failures by matching pod logs
+	// +optional
+	FailureClassification *FailureClassificationConfig `json:"failureClassification,omitempty"`
+
+	// SuccessCriteria decides whether a run succeeded from its main container
+	// instead of the Job's status
+	// +optional
+	SuccessCriteria *SuccessCriteriaConfig `json:"successCriteria,omitempty"`
+
+	// Output tracks the size of each run's output, such as rows written or
+	// bytes uploaded, and alerts when it drops well below recent runs
+	// +optional
+	Output *OutputConfig `json:"output,omitempty"`
+}
+
+// SuccessCriteriaConfig decides success from the job's main container, for
+// pods with sidecars whose failures do not mean the job failed
+type SuccessCriteriaConfig struct {
+	// MainContainer is the container whose exit code decides whether a run
+	// succeeded. A run whose main container exited 0 succeeded, even if a
+	// sidecar such as istio-proxy failed and failed the Job.
+	// +kubebuilder:validation:MinLength=1
+	MainContainer string `json:"mainContainer"`
+}
+
+// OutputConfig tracks the output size reported by each run, to catch runs
+// that succeed but produce much less than usual
+type OutputConfig struct {
+	// Annotation is the annotation a job sets on its Job or pod with its output
+	// size, e.g. "1.5Gi" or "120000". A container may instead report it as an
+	// "<annotation>=<size>" line in its termination message.
+	// (default: guardian.illenium.net/output-size)
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+
+	// DropThresholdPercent alerts with OutputDropped when a successful run's
+	// output is this many percent below the median of recent runs (default: 50)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	DropThresholdPercent *int32 `json:"dropThresholdPercent,omitempty"`
+
+	// BaselineRuns is the number of recent successful runs the median is taken
+	// over (default: 10). No alert is sent before 3 runs reported an output size.
+	// +kubebuilder:validation:Minimum=3
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BaselineRuns *int32 `json:"baselineRuns,omitempty"`
+}
+
+// FailureClassificationConfig classifies failed executions by regexes against
+// their pod logs, for jobs whose exit codes are too coarse to tell failures apart
+type FailureClassificationConfig struct {
+	// Rules are checked in order; the first rule whose pattern matches sets the classification
+	// +kubebuilder:validation:MinItems=1
+	Rules []ClassificationRule `json:"rules"`
+
+	// LogLines is the number of trailing log lines scanned when logs are not stored (default: 200)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	LogLines *int32 `json:"logLines,omitempty"`
+}
+
+// ClassificationRule maps a log pattern to a failure classification
+type ClassificationRule struct {
+	// Classification recorded when the pattern matches (e.g., "dependency-failure")
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Classification string `json:"classification"`
+
+	// LogPattern is a regex matched against the pod logs
+	// +kubebuilder:validation:MinLength=1
+	LogPattern string `json:"logPattern"`
+}
+
+// CronJobSelector specifies which CronJobs to monitor.
+// An empty selector matches all CronJobs in the monitor's namespace.
+type CronJobSelector struct {
+	// MatchLabels selects CronJobs by labels
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// MatchExpressions selects CronJobs by label expressions
+	// +optional
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+
+	// MatchNames explicitly lists CronJob names to monitor (only valid when watching a single namespace)
+	// +optional
+	MatchNames []string `json:"matchNames,omitempty"`
+
+	// Namespaces explicitly lists namespaces to watch for CronJobs.
+	// If empty and namespaceSelector is not set, watches only the monitor's namespace.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects namespaces by labels.
+	// CronJobs in matching namespaces will be monitored.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllNamespaces watches CronJobs in all namespaces (except globally ignored ones).
+	// Takes precedence over namespaces and namespaceSelector.
+	// +optional
+	AllNamespaces bool `json:"allNamespaces,omitempty"`
+}
+
+// DeadManSwitchConfig configures dead-man's switch behavior
+type DeadManSwitchConfig struct {
+	// Enabled turns on dead-man's switch monitoring (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxInterval alerts if no success within this duration
+	// Example: "25h" for daily jobs with 1h buffer
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+
+	// AutoFromSchedule auto-calculates expected interval from cron schedule
+	// +optional
+	AutoFromSchedule *AutoScheduleConfig `json:"autoFromSchedule,omitempty"`
+}
+
+// AutoScheduleConfig configures automatic schedule detection
+type AutoScheduleConfig struct {
+	// Enabled turns on auto-detection (default: false)
+	Enabled bool `json:"enabled"`
+
+	// Buffer adds extra time to expected interval (default: 1h)
+	// +optional
+	Buffer *metav1.Duration `json:"buffer,omitempty"`
+
+	// MissedScheduleThreshold alerts after this many missed schedules (default: 1)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MissedScheduleThreshold *int32 `json:"missedScheduleThreshold,omitempty"`
+}
+
+// SLAConfig configures SLA tracking
+type SLAConfig struct {
+	// Enabled turns on SLA tracking (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinSuccessRate is minimum acceptable success rate percentage (default: 95)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinSuccessRate *float64 `json:"minSuccessRate,omitempty"`
+
+	// WindowDays is the rolling window for success rate calculation (default: 7)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WindowDays *int32 `json:"windowDays,omitempty"`
+
+	// MaxDuration alerts if job exceeds this duration
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// DurationRegression alerts when the P95 duration grows against its baseline
+	// +optional
+	DurationRegression *DurationRegressionConfig `json:"durationRegression,omitempty"`
+
+	// ScheduleBudget alerts when runs take up most of the time between
+	// scheduled runs
+	// +optional
+	ScheduleBudget *ScheduleBudgetConfig `json:"scheduleBudget,omitempty"`
+}
+
+// DurationRegressionConfig configures duration regression detection
+type DurationRegressionConfig struct {
+	// ThresholdPercent alerts if P95 increases by this percentage (default: 50)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	ThresholdPercent *int32 `json:"thresholdPercent,omitempty"`
+
+	// BaselineWindowDays for baseline calculation (default: 14)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BaselineWindowDays *int32 `json:"baselineWindowDays,omitempty"`
+}
+
+// ScheduleBudgetConfig configures schedule budget detection
+type ScheduleBudgetConfig struct {
+	// ThresholdPercent alerts when the P95 duration reaches this percentage
+	// of the shortest interval between scheduled runs (default: 90, 0 disables)
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ThresholdPercent *int32 `json:"thresholdPercent,omitempty"`
+}
+
+// StuckJobConfig configures detection of hung Jobs. A running Job is stuck
+// when its runtime exceeds MaxRuntime or P95Multiplier times the CronJob's
+// historical P95 duration, whichever is lower. At least one must be set.
+type StuckJobConfig struct {
+	// Enabled turns on stuck job detection (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxRuntime is the absolute runtime after which a Job is stuck
+	// +optional
+	MaxRuntime *metav1.Duration `json:"maxRuntime,omitempty"`
+
+	// P95Multiplier marks a Job stuck when it runs longer than this multiple
+	// of the P95 duration of recent runs
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	P95Multiplier *float64 `json:"p95Multiplier,omitempty"`
+
+	// BaselineWindowDays is the history the P95 duration is taken from (default: 14)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BaselineWindowDays *int32 `json:"baselineWindowDays,omitempty"`
+
+	// MinRuns is the number of runs in the baseline window needed before
+	// P95Multiplier applies (default: 5)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinRuns *int32 `json:"minRuns,omitempty"`
+}
+
+// RecommendationConfig configures Job limit recommendations. The recommended
+// activeDeadlineSeconds is the P99 duration times DeadlineMultiplier; the
+// recommended backoffLimit is the number of retries needed to bring the chance
+// of a failed Job under 1% at the observed failure rate.
+type RecommendationConfig struct {
+	// Enabled turns on recommendations (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// DeadlineMultiplier is applied to the P99 duration (default: 1.5)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	DeadlineMultiplier *float64 `json:"deadlineMultiplier,omitempty"`
+
+	// WindowDays is the history recommendations are computed from (default: 30)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WindowDays *int32 `json:"windowDays,omitempty"`
+
+	// MinRuns is the number of runs in the window needed before a
+	// recommendation is made (default: 10)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinRuns *int32 `json:"minRuns,omitempty"`
+
+	// Apply writes the recommended values to the job template of each
+	// monitored CronJob (default: false)
+	// +optional
+	Apply bool `json:"apply,omitempty"`
+}
+
+// SuspensionConfig configures behavior for suspended CronJobs
+type SuspensionConfig struct {
+	// PauseMonitoring pauses monitoring when CronJob is suspended (default: true)
+	// +optional
+	PauseMonitoring *bool `json:"pauseMonitoring,omitempty"`
+
+	// AlertAfter alerts if suspended longer than this duration
+	// +optional
+	AlertAfter *metav1.Duration `json:"alertAfter,omitempty"`
+
+	// Intentional lists CronJobs that are expected to stay suspended, as
+	// name or namespace/name. They never get SuspendedTooLong alerts.
+	// +optional
+	Intentional []string `json:"intentional,omitempty"`
+}
+
+// MaintenanceWindow defines a scheduled maintenance period
+type MaintenanceWindow struct {
+	// Name identifies this maintenance window
+	Name string `json:"name"`
+
+	// Schedule is a cron expression for when window starts
+	Schedule string `json:"schedule"`
+
+	// Duration of the maintenance window
+	Duration metav1.Duration `json:"duration"`
+
+	// Timezone for the schedule (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// SuppressAlerts during this window (default: true)
+	// +optional
+	SuppressAlerts *bool `json:"suppressAlerts,omitempty"`
+}
+
+// CronJobDependency declares the upstream CronJobs a CronJob depends on
+type CronJobDependency struct {
+	// CronJob is the downstream CronJob
+	CronJob CronJobReference `json:"cronJob"`
+
+	// DependsOn lists the upstream CronJobs that must succeed before it runs
+	// +kubebuilder:validation:MinItems=1
+	DependsOn []CronJobReference `json:"dependsOn"`
+}
+
+// CronJobReference references a CronJob
+type CronJobReference struct {
+	// Name of the CronJob
+	Name string `json:"name"`
+
+	// Namespace of the CronJob (default: the monitor's namespace)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// HealthcheckPing pings an external monitoring service when a CronJob runs
+type HealthcheckPing struct {
+	// CronJobs limits the pings to these CronJobs by name (default: all monitored CronJobs)
+	// +optional
+	CronJobs []string `json:"cronJobs,omitempty"`
+
+	// Provider selects how run outcomes are reported (default: custom).
+	// healthchecks appends /fail for failures, cronitor sets the state
+	// query parameter, custom pings FailureURL for failures.
+	// +kubebuilder:validation:Enum=healthchecks;cronitor;custom
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// URL pinged after a successful run. It is a Go template with
+	// {{ .Namespace }}, {{ .Name }} and {{ .JobName }} available, so one
+	// entry can serve several CronJobs (e.g. Healthchecks.io slug URLs).
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads URL from a Secret in the monitor's namespace,
+	// for ping URLs that embed credentials
+	// +optional
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// FailureURL is pinged after a failed run when ReportFailures is set
+	// (custom provider only; same template variables as URL)
+	// +optional
+	FailureURL string `json:"failureURL,omitempty"`
+
+	// ReportFailures also pings for failed runs (default: false)
+	// +optional
+	ReportFailures bool `json:"reportFailures,omitempty"`
+}
+
+// AutomationHook posts a structured event to a URL when the monitor sends an
+// alert, before or after it is delivered to the alert's channels
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.urlSecretRef)",message="exactly one of url and urlSecretRef must be set"
+type AutomationHook struct {
+	// Name identifies the hook in events and logs
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// AlertTypes limits the hook to alerts of these types, e.g. JobFailed
+	// or JobStuck (default: all alert types)
+	// +optional
+	AlertTypes []string `json:"alertTypes,omitempty"`
+
+	// Phase is when the hook is called (default: after). before calls it
+	// before the alert is sent to its channels, holding them back until the
+	// hook answers or times out; after calls it once they were sent, with
+	// the channels that were notified.
+	// +kubebuilder:validation:Enum=before;after
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// URL receives the POST
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads URL from a Secret in the monitor's namespace,
+	// for URLs that embed credentials
+	// +optional
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Headers are set on every request, e.g. to identify the sender
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout bounds each call (default: 10s)
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// AlertingConfig configures alerting behavior
+type AlertingConfig struct {
+	// Enabled turns on alerting (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ChannelRefs references cluster-scoped AlertChannel CRs
+	// +optional
+	ChannelRefs []ChannelRef `json:"channelRefs,omitempty"`
+
+	// Routing picks different channels by time of day and day of week,
+	// e.g. Slack during business hours and PagerDuty after hours.
+	// ChannelRefs is used when no routing rule matches.
+	// +optional
+	Routing *AlertRoutingConfig `json:"routing,omitempty"`
+
+	// Context specifies what context to include in alerts
+	// +optional
+	Context *AlertContext `json:"context,omitempty"`
+
+	// DedupWindow prevents re-alerting within this window (default: 1h)
+	// +optional
+	DedupWindow *metav1.Duration `json:"dedupWindow,omitempty"`
+
+	// AlertDelay delays alert dispatch to allow transient issues to resolve.
+	// If the issue resolves (e.g., next job succeeds) before the delay expires,
+	// the alert is cancelled and never sent. Useful for flaky jobs.
+	// Example: "5m" waits 5 minutes before sending failure alerts.
+	// +optional
+	AlertDelay *metav1.Duration `json:"alertDelay,omitempty"`
+
+	// RateLimiting caps the alerts this monitor can send, so a noisy monitor
+	// cannot exhaust the global alert budget (default: no per-monitor limit)
+	// +optional
+	RateLimiting *RateLimitConfig `json:"rateLimiting,omitempty"`
+
+	// FailureStreak holds JobFailed alerts until a CronJob has failed several
+	// times in a row and escalates them to critical on longer streaks
+	// +optional
+	FailureStreak *FailureStreakConfig `json:"failureStreak,omitempty"`
+
+	// LongSuccessStreak highlights a failure that ends a run of successes
+	// lasting at least this long, e.g. the first failure in 60 days
+	// (default: 720h, 0s disables)
+	// +optional
+	LongSuccessStreak *metav1.Duration `json:"longSuccessStreak,omitempty"`
+
+	// FlapDetection replaces the alerts of a CronJob that keeps alternating
+	// between success and failure with one Flapping alert
+	// +optional
+	FlapDetection *FlapDetectionConfig `json:"flapDetection,omitempty"`
+
+	// SeverityOverrides customizes severity per alert type
+	// +optional
+	SeverityOverrides SeverityOverrides `json:"severityOverrides,omitempty"`
+
+	// RunbookURLs links alert types to remediation docs, e.g.
+	// deadManTriggered: https://runbooks.example.com/deadman. The CronJob's
+	// guardian.illenium.net/runbook-url annotation is used for alert types
+	// not listed here.
+	// +optional
+	RunbookURLs RunbookURLs `json:"runbookURLs,omitempty"`
+
+	// SuggestedFixPatterns defines custom fix patterns for this monitor
+	// These are merged with built-in patterns, with custom patterns taking priority
+	// +optional
+	SuggestedFixPatterns []SuggestedFixPattern `json:"suggestedFixPatterns,omitempty"`
+}
+
+// FailureStreakConfig alerts on consecutive failures instead of the first
+// failure, for inherently flaky jobs. A success resets the streak.
+type FailureStreakConfig struct {
+	// AlertAfter is the number of consecutive failures that send a JobFailed
+	// alert (default: 1)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	AlertAfter *int32 `json:"alertAfter,omitempty"`
+
+	// CriticalAfter is the number of consecutive failures that raise the
+	// JobFailed alert to critical (default: no escalation)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CriticalAfter *int32 `json:"criticalAfter,omitempty"`
+}
+
+// FlapDetectionConfig detects CronJobs whose runs alternate between success
+// and failure. While a CronJob is flapping, its JobFailed alerts and their
+// resolution are held and a single Flapping alert is sent instead, until the
+// state changes within the window drop below the threshold.
+type FlapDetectionConfig struct {
+	// Enabled turns on flap detection (default: true)
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Threshold is the number of state changes within the window that make a
+	// CronJob flapping (default: 4)
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	Threshold *int32 `json:"threshold,omitempty"`
+
+	// Window state changes are counted in (default: 1h)
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// AlertRoutingConfig routes alerts to channels based on when they are sent
+type AlertRoutingConfig struct {
+	// Timezone the rules are evaluated in (default: UTC)
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Rules are evaluated in order; the first rule whose window contains the
+	// current time selects the channels
+	// +optional
+	Rules []RoutingRule `json:"rules,omitempty"`
+}
+
+// RoutingRule sends alerts to its channels during a weekly time window
+type RoutingRule struct {
+	// Name identifies this rule
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Days the window starts on (default: every day)
+	// +kubebuilder:validation:items:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// Start of the window in HH:MM (default: 00:00)
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	Start string `json:"start,omitempty"`
+
+	// End of the window in HH:MM, exclusive (default: end of day).
+	// A window that ends before it starts runs past midnight into the next day.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +optional
+	End string `json:"end,omitempty"`
+
+	// ChannelRefs are the channels alerts are sent to during the window
+	// +kubebuilder:validation:MinItems=1
+	ChannelRefs []ChannelRef `json:"channelRefs"`
+}
+
+// ChannelRef references an AlertChannel CR
+type ChannelRef struct {
+	// Name of the AlertChannel CR
+	Name string `json:"name"`
+
+	// Severities to send to this channel (empty = all)
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+}
+
+// AlertContext specifies what context to include in alerts
+type AlertContext struct {
+	// Logs includes pod logs (default: true)
+	// +optional
+	Logs *bool `json:"logs,omitempty"`
+
+	// LogLines is number of log lines to include (default: 50)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	LogLines *int32 `json:"logLines,omitempty"`
+
+	// LogContainerName specifies container for logs (default: first container)
+	// +optional
+	LogContainerName string `json:"logContainerName,omitempty"`
+
+	// IncludeInitContainerLogs includes init container logs (default: false)
+	// +optional
+	IncludeInitContainerLogs *bool `json:"includeInitContainerLogs,omitempty"`
+
+	// Events includes Kubernetes events (default: true)
+	// +optional
+	Events *bool `json:"events,omitempty"`
+
+	// PodStatus includes pod status details (default: true)
+	// +optional
+	PodStatus *bool `json:"podStatus,omitempty"`
+
+	// SuggestedFixes includes fix suggestions (default: true)
+	// +optional
+	SuggestedFixes *bool `json:"suggestedFixes,omitempty"`
+
+	// Labels lists CronJob label keys copied into alerts (e.g. team, service),
+	// so receivers can route by them and templates can show them
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Annotations lists CronJob annotation keys copied into alerts
+	// (e.g. runbook-url), so templates can link to them
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// SeverityOverrides maps alert types to the severity they are sent with, such
+// as deadManTriggered: critical. Keys are matched to alert types ignoring
+// case, so jobFailed and JobFailed are the same. Only critical and warning
+// are valid - alerts are actionable notifications.
+// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] == 'critical' || self[k] == 'warning')",message="severity overrides must be critical or warning"
+type SeverityOverrides map[string]string
+
+// RunbookURLs maps alert types to runbook URLs. Keys are matched to alert
+// types ignoring case, like SeverityOverrides.
+// +kubebuilder:validation:XValidation:rule="self.all(k, self[k].startsWith('https://') || self[k].startsWith('http://'))",message="runbook URLs must be http or https URLs"
+type RunbookURLs map[string]string
+
+// SuggestedFixPattern defines a pattern for suggesting fixes based on failure context
+type SuggestedFixPattern struct {
+	// Name identifies this pattern (for overriding built-ins like "oom-killed")
+	Name string `json:"name"`
+
+	// Match criteria - at least one must be specified
+	Match PatternMatch `json:"match"`
+
+	// Suggestion is the fix text (supports Go templates)
+	// Available variables: {{.Namespace}}, {{.Name}}, {{.ExitCode}}, {{.Reason}}, {{.JobName}}
+	Suggestion string `json:"suggestion"`
+
+	// Priority determines order (higher = checked first, default: 0)
+	// Built-in patterns use priorities 1-100, use >100 to override
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Severity overrides the JobFailed alert severity when this pattern matches
+	// +kubebuilder:validation:Enum=critical;warning
+	// +optional
+	Severity string `json:"severity,omitempty"`
+}
+
+// PatternMatch defines what to match against for suggested fixes
+type PatternMatch struct {
+	// Container makes exitCode, exitCodeRange, reason and reasonPattern match
+	// the named container, init containers included, instead of the
+	// execution's exit code and reason
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// ExitCode matches specific exit codes (e.g., 137 for OOM)
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// ExitCodeRange matches a range [min, max] inclusive
+	// +optional
+	ExitCodeRange *ExitCodeRange `json:"exitCodeRange,omitempty"`
+
+	// Reason matches container termination reason (exact match, case-insensitive)
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// ReasonPattern matches reason using regex
+	// +optional
+	ReasonPattern string `json:"reasonPattern,omitempty"`
+
+	// LogPattern matches log content using regex
+	// +optional
+	LogPattern string `json:"logPattern,omitempty"`
+
+	// EventPattern matches event messages using regex
+	// +optional
+	EventPattern string `json:"eventPattern,omitempty"`
+}
+
+// ExitCodeRange defines a range of exit codes [Min, Max] inclusive
+type ExitCodeRange struct {
+	Min int32 `json:"min"`
+	Max int32 `json:"max"`
+}
+
+// DataRetentionConfig configures data lifecycle management for this monitor
+// +kubebuilder:validation:XValidation:rule="self.onCronJobDeletion != 'purge-after-days' || has(self.purgeAfterDays)",message="purgeAfterDays is required when onCronJobDeletion is 'purge-after-days'"
+type DataRetentionConfig struct {
+	// RetentionDays overrides global retention for this monitor's execution history
+	// If not set, uses global history-retention.default-days setting
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+
+	// OnCronJobDeletion defines behavior when a monitored CronJob is deleted
+	// +kubebuilder:validation:Enum=retain;purge;purge-after-days
+	// +optional
+	OnCronJobDeletion string `json:"onCronJobDeletion,omitempty"`
+
+	// PurgeAfterDays specifies how long to wait before purging data
+	// Only used when onCronJobDeletion is "purge-after-days"
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PurgeAfterDays *int32 `json:"purgeAfterDays,omitempty"`
+
+	// OnRecreation defines behavior when a CronJob is recreated (detected via UID change)
+	// "retain" keeps old history, "reset" deletes history from the old UID
+	// +kubebuilder:validation:Enum=retain;reset
+	// +optional
+	OnRecreation string `json:"onRecreation,omitempty"`
+
+	// StoreLogs enables storing job logs in the database
+	// If nil, uses global --storage.log-storage-enabled setting
+	// +optional
+	StoreLogs *bool `json:"storeLogs,omitempty"`
+
+	// LogRetentionDays specifies how long to keep stored logs
+	// If not set, uses the same value as retentionDays
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LogRetentionDays *int32 `json:"logRetentionDays,omitempty"`
+
+	// MaxLogSizeKB is the maximum log size to store per execution in KB
+	// If not set, uses global --storage.max-log-size-kb setting
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxLogSizeKB *int32 `json:"maxLogSizeKB,omitempty"`
+
+	// StoreEvents enables storing Kubernetes events in the database
+	// If nil, uses global --storage.event-storage-enabled setting
+	// +optional
+	StoreEvents *bool `json:"storeEvents,omitempty"`
+
+	// LegalHold exempts the history and logs of the selected CronJobs from
+	// pruning, overriding retentionDays and logRetentionDays
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
+// CronJobMonitorStatus defines the observed state of CronJobMonitor
+type CronJobMonitorStatus struct {
+	// ObservedGeneration is the generation last processed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase indicates the monitor's operational state
+	// +kubebuilder:validation:Enum=Initializing;Active;Degraded;Error
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// LastReconcileTime is when the controller last reconciled
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// Summary provides aggregate counts
+	// +optional
+	Summary *MonitorSummary `json:"summary,omitempty"`
+
+	// CronJobs contains per-CronJob status
+	// +optional
+	CronJobs []CronJobStatus `json:"cronJobs,omitempty"`
+
+	// Conditions represent the latest observations: Ready, Degraded,
+	// AlertingHealthy and StoreHealthy
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// MonitorSummary provides aggregate counts
+type MonitorSummary struct {
+	TotalCronJobs int32 `json:"totalCronJobs"`
+	Healthy       int32 `json:"healthy"`
+	Warning       int32 `json:"warning"`
+	Critical      int32 `json:"critical"`
+	Suspended     int32 `json:"suspended"`
+	Running       int32 `json:"running"`
+	ActiveAlerts  int32 `json:"activeAlerts"`
+}
+
+// CronJobStatus contains status for a single CronJob
+type CronJobStatus struct {
+	// Name of the CronJob
+	Name string `json:"name"`
+
+	// Namespace of the CronJob
+	Namespace string `json:"namespace"`
+
+	// Status indicates health
+	// +kubebuilder:validation:Enum=healthy;warning;critical;suspended;unknown
+	Status string `json:"status"`
+
+	// Suspended indicates if the CronJob is suspended
+	Suspended bool `json:"suspended"`
+
+	// IntentionallySuspended indicates the CronJob is suspended and on the
+	// monitor's suspension.intentional list
+	// +optional
+	IntentionallySuspended bool `json:"intentionallySuspended,omitempty"`
+
+	// LastSuccessfulTime is when the last Job succeeded
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// LastFailedTime is when the last Job failed
+	// +optional
+	LastFailedTime *metav1.Time `json:"lastFailedTime,omitempty"`
+
+	// ConsecutiveFailures is the number of Jobs that failed since the last success
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// LastRunDuration is the duration of the last completed Job
+	// +optional
+	LastRunDuration *metav1.Duration `json:"lastRunDuration,omitempty"`
+
+	// NextScheduledTime is when the next Job will be created
+	// +optional
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty"`
+
+	// UpcomingRuns are the next scheduled times, in the CronJob's time zone.
+	// Empty while the CronJob is suspended.
+	// +optional
+	UpcomingRuns []metav1.Time `json:"upcomingRuns,omitempty"`
+
+	// Metrics contains SLA metrics
+	// +optional
+	Metrics *CronJobMetrics `json:"metrics,omitempty"`
+
+	// ActiveJobs lists currently running jobs for this CronJob
+	// +optional
+	ActiveJobs []ActiveJob `json:"activeJobs,omitempty"`
+
+	// ActiveAlerts lists current alerts for this CronJob
+	// +optional
+	ActiveAlerts []ActiveAlert `json:"activeAlerts,omitempty"`
+
+	// Recommendation contains the Job limits recommended from execution history
+	// +optional
+	Recommendation *LimitRecommendation `json:"recommendation,omitempty"`
+
+	// Drift lists where the CronJob diverges from the state its
+	// guardian.illenium.net/expected-image and expected-suspend annotations
+	// declare, e.g. after a manual kubectl edit
+	// +optional
+	Drift []DriftStatus `json:"drift,omitempty"`
+}
+
+// DriftStatus is a field of a CronJob that diverges from its expected state
+type DriftStatus struct {
+	// Field is the drifted field, e.g. spec.suspend or containers[backup].image
+	Field string `json:"field"`
+
+	// Expected is the value declared by the annotation
+	Expected string `json:"expected"`
+
+	// Actual is the CronJob's value (empty if no container runs the expected image)
+	// +optional
+	Actual string `json:"actual,omitempty"`
+
+	// Manager is the field manager that last changed the field, e.g. kubectl-edit,
+	// when it is known
+	// +optional
+	Manager string `json:"manager,omitempty"`
+}
+
+// LimitRecommendation contains recommended Job limits for a CronJob
+type LimitRecommendation struct {
+	// ActiveDeadlineSeconds is the recommended spec.activeDeadlineSeconds
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds"`
+
+	// BackoffLimit is the recommended spec.backoffLimit
+	BackoffLimit int32 `json:"backoffLimit"`
+
+	// BasedOnRuns is the number of runs the recommendation was computed from
+	BasedOnRuns int32 `json:"basedOnRuns"`
+
+	// Applied indicates the recommendation was written to the CronJob
+	// +optional
+	Applied bool `json:"applied,omitempty"`
+}
+
+// CronJobMetrics contains SLA metrics for a CronJob
+type CronJobMetrics struct {
+	SuccessRate    float64 `json:"successRate"`
+	TotalRuns      int32   `json:"totalRuns"`
+	SuccessfulRuns int32   `json:"successfulRuns"`
+	FailedRuns     int32   `json:"failedRuns"`
+	// Duration in seconds
+	// +optional
+	AvgDurationSeconds float64 `json:"avgDurationSeconds,omitempty"`
+	// +optional
+	P50DurationSeconds float64 `json:"p50DurationSeconds,omitempty"`
+	// +optional
+	P95DurationSeconds float64 `json:"p95DurationSeconds,omitempty"`
+	// +optional
+	P99DurationSeconds float64 `json:"p99DurationSeconds,omitempty"`
+	// Incidents is the number of failure streaks in the window
+	// +optional
+	Incidents int32 `json:"incidents,omitempty"`
+	// MTTRSeconds is the mean time from a failure to the next success
+	// +optional
+	MTTRSeconds float64 `json:"mttrSeconds,omitempty"`
+	// MTBFSeconds is the mean time between the starts of consecutive incidents
+	// +optional
+	MTBFSeconds float64 `json:"mtbfSeconds,omitempty"`
+}
+
+// ActiveAlert represents an active alert
+type ActiveAlert struct {
+	// Type of alert
+	Type string `json:"type"`
+
+	// Severity of alert
+	Severity string `json:"severity"`
+
+	// Message describes the alert
+	Message string `json:"message"`
+
+	// Since is when the alert became active
+	Since metav1.Time `json:"since"`
+
+	// LastNotified is when the alert was last sent
+	// +optional
+	LastNotified *metav1.Time `json:"lastNotified,omitempty"`
+
+	// ExitCode from the failed container (for JobFailed alerts)
+	// +optional
+	ExitCode int32 `json:"exitCode,omitempty"`
+
+	// Reason for the failure (e.g., OOMKilled, Error)
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// SuggestedFix provides actionable guidance for resolving the alert
+	// +optional
+	SuggestedFix string `json:"suggestedFix,omitempty"`
+
+	// RunbookURL links to the remediation docs for the alert
+	// +optional
+	RunbookURL string `json:"runbookURL,omitempty"`
+
+	// SuccessStreakRuns is the number of successful runs the failure ended,
+	// set when they lasted longer than the monitor's longSuccessStreak
+	// +optional
+	SuccessStreakRuns int32 `json:"successStreakRuns,omitempty"`
+
+	// SuccessStreakSince is when the success streak the failure ended began
+	// +optional
+	SuccessStreakSince *metav1.Time `json:"successStreakSince,omitempty"`
+}
+
+// ActiveJob represents a currently running job
+type ActiveJob struct {
+	// Name of the Job
+	Name string `json:"name"`
+
+	// StartTime is when the Job started
+	StartTime metav1.Time `json:"startTime"`
+
+	// RunningDuration is how long the job has been running
+	// +optional
+	RunningDuration *metav1.Duration `json:"runningDuration,omitempty"`
+
+	// PodPhase is the current phase of the job's pod (Pending, Running, etc.)
+	// +optional
+	PodPhase string `json:"podPhase,omitempty"`
+
+	// PodName is the name of the pod running the job
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// Ready indicates how many pods are ready vs total
+	// +optional
+	Ready string `json:"ready,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].status`,priority=1
+// +kubebuilder:printcolumn:name="CronJobs",type=integer,JSONPath=`.status.summary.totalCronJobs`
+// +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.summary.healthy`
+// +kubebuilder:printcolumn:name="Warning",type=integer,JSONPath=`.status.summary.warning`
+// +kubebuilder:printcolumn:name="Critical",type=integer,JSONPath=`.status.summary.critical`
+// +kubebuilder:printcolumn:name="Alerts",type=integer,JSONPath=`.status.summary.activeAlerts`
+// +kubebuilder:printcolumn:name="Silenced Until",type=date,JSONPath=`.spec.silencedUntil`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CronJobMonitor is the Schema for the cronjobmonitors API.
+type CronJobMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CronJobMonitorSpec   `json:"spec,omitempty"`
+	Status CronJobMonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CronJobMonitorList contains a list of CronJobMonitor.
+type CronJobMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CronJobMonitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CronJobMonitor{}, &CronJobMonitorList{})
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/api"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/backup"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// command is a subcommand that runs once against the configured store and
+// exits instead of starting the operator
+type command struct {
+	bindFlags func(flags *pflag.FlagSet)
+	// ownStores means the command opens its own stores, so run gets a nil
+	// store instead of the configured one
+	ownStores bool
+	// run returns the process exit code
+	run func(st store.Store, cfg *config.Config, args []string) int
+}
+
+var restoreOverwrite bool
+
+var migrateStorageFlags struct {
+	from      string
+	to        string
+	batchSize int
+}
+
+var commands = map[string]*command{
+	"backup": {
+		bindFlags: func(*pflag.FlagSet) {},
+		run:       runBackup,
+	},
+	"restore": {
+		bindFlags: func(flags *pflag.FlagSet) {
+			flags.BoolVar(&restoreOverwrite, "overwrite", false, "Restore into a store that already has executions")
+		},
+		run: runRestore,
+	},
+	"migrate-storage": {
+		bindFlags: func(flags *pflag.FlagSet) {
+			flags.StringVar(&migrateStorageFlags.from, "from", "", "Source store as <type>:<dsn>, e.g. sqlite:/data/guardian.db")
+			flags.StringVar(&migrateStorageFlags.to, "to", "", "Destination store as <type>:<dsn>, e.g. postgres:host=db user=guardian dbname=guardian")
+			flags.IntVar(&migrateStorageFlags.batchSize, "batch-size", 1000, "Rows copied per transaction")
+		},
+		ownStores: true,
+		run:       runMigrateStorage,
+	},
+}
+
+// lookupCommand returns the subcommand named by the first argument, if any
+func lookupCommand(args []string) *command {
+	if len(args) == 0 {
+		return nil
+	}
+	return commands[args[0]]
+}
+
+// runBackup writes a backup archive to the file given as the only argument
+// ("-" for stdout)
+func runBackup(st store.Store, cfg *config.Config, args []string) int {
+	defer func() { _ = st.Close() }()
+	if len(args) != 1 {
+		setupLog.Error(nil, "usage: manager backup [flags] <file|->")
+		return 2
+	}
+
+	var out io.Writer = os.Stdout
+	if args[0] != "-" {
+		f, err := os.Create(args[0])
+		if err != nil {
+			setupLog.Error(err, "unable to create backup file")
+			return 1
+		}
+		defer func() { _ = f.Close() }()
+		out = f
+	}
+
+	stats, err := backup.Write(context.Background(), st, backup.Manifest{
+		CreatedAt:       time.Now().UTC(),
+		OperatorVersion: api.Version,
+		StorageType:     cfg.Storage.Type,
+	}, out)
+	if err != nil {
+		setupLog.Error(err, "backup failed")
+		return 1
+	}
+	setupLog.Info("backup written", "file", args[0],
+		"executions", stats.Executions, "alertHistory", stats.AlertHistory, "channelStats", stats.ChannelStats)
+	return 0
+}
+
+// runRestore loads the backup archive given as the only argument ("-" for
+// stdin) into the configured store
+func runRestore(st store.Store, _ *config.Config, args []string) int {
+	defer func() { _ = st.Close() }()
+	if len(args) != 1 {
+		setupLog.Error(nil, "usage: manager restore [--overwrite] [flags] <file|->")
+		return 2
+	}
+
+	var in io.Reader = os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			setupLog.Error(err, "unable to open backup file")
+			return 1
+		}
+		defer func() { _ = f.Close() }()
+		in = f
+	}
+
+	manifest, stats, err := backup.Restore(context.Background(), st, in, restoreOverwrite)
+	if err != nil {
+		setupLog.Error(err, "restore failed", "restored", fmt.Sprintf("%+v", stats))
+		return 1
+	}
+	setupLog.Info("backup restored", "createdAt", manifest.CreatedAt, "from", manifest.StorageType,
+		"executions", stats.Executions, "alertHistory", stats.AlertHistory, "channelStats", stats.ChannelStats)
+	return 0
+}
+
+// runMigrateStorage copies all tables from the --from store to the --to store
+func runMigrateStorage(_ store.Store, cfg *config.Config, args []string) int {
+	if len(args) != 0 || migrateStorageFlags.from == "" || migrateStorageFlags.to == "" {
+		setupLog.Error(nil, "usage: manager migrate-storage --from <type>:<dsn> --to <type>:<dsn> [--batch-size n]")
+		return 2
+	}
+	ctx := context.Background()
+
+	src, err := openStoreSpec(migrateStorageFlags.from, false)
+	if err != nil {
+		setupLog.Error(err, "unable to open source store")
+		return 1
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := openStoreSpec(migrateStorageFlags.to, cfg.Storage.PostgreSQL.Partitioning)
+	if err != nil {
+		setupLog.Error(err, "unable to open destination store")
+		return 1
+	}
+	defer func() { _ = dst.Close() }()
+
+	start := time.Now()
+	copied, err := store.CopyStore(ctx, src, dst, store.CopyOptions{
+		BatchSize: migrateStorageFlags.batchSize,
+		Progress: func(table string, n, total int64) {
+			setupLog.Info("copying", "table", table, "copied", n, "total", total)
+		},
+	})
+	if err != nil {
+		setupLog.Error(err, "storage migration failed", "copied", copied)
+		return 1
+	}
+	setupLog.Info("storage migrated", "copied", copied, "duration", time.Since(start).Round(time.Second))
+	return 0
+}
+
+// openStoreSpec opens and initializes a store given as <type>:<dsn>. Tables
+// are copied with SQL, so only the built-in backends can be migrated.
+func openStoreSpec(spec string, partitioning bool) (*store.GormStore, error) {
+	dialect, dsn, ok := strings.Cut(spec, ":")
+	if !ok || dsn == "" {
+		return nil, fmt.Errorf("store %q must be <type>:<dsn>", spec)
+	}
+	backend, err := store.Open(dialect, store.BackendOptions{DSN: dsn, Partitioning: partitioning})
+	if err != nil {
+		return nil, err
+	}
+	st, ok := backend.(*store.GormStore)
+	if !ok {
+		_ = backend.Close()
+		return nil, fmt.Errorf("storage type %q does not support migrate-storage", dialect)
+	}
+	if err := st.Init(); err != nil {
+		_ = st.Close()
+		return nil, err
+	}
+	return st, nil
+}
@@ -7,9 +7,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
-	"github.com/go-logr/zerologr"
 	"github.com/rs/zerolog"
 	"github.com/spf13/pflag"
 
@@ -23,19 +24,32 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	guardianv1beta1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1beta1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/api"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/controller"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/dbsecret"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/disruption"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/eventbus"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/grafana"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/logging"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/objectstore"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/ping"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/redisstate"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/scheduler"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	guardianwebhook "github.com/iLLeniumStudios/cronjob-guardian/internal/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -51,6 +65,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(guardianv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(guardianv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -60,8 +75,17 @@ func main() {
 	flags := pflag.NewFlagSet("cronjob-guardian", pflag.ExitOnError)
 	config.BindFlags(flags)
 
+	// A leading subcommand (backup, restore, migrate-storage) runs once
+	// against the store instead of starting the operator
+	args := os.Args[1:]
+	cmd := lookupCommand(args)
+	if cmd != nil {
+		args = args[1:]
+		cmd.bindFlags(flags)
+	}
+
 	// Parse flags
-	if err := flags.Parse(os.Args[1:]); err != nil {
+	if err := flags.Parse(args); err != nil {
 		setupLog.Error(err, "failed to parse flags")
 		os.Exit(1)
 	}
@@ -72,19 +96,34 @@ func main() {
 		setupLog.Error(err, "failed to load configuration")
 		os.Exit(1)
 	}
+	guardianShard, err := shard.New(cfg.ShardIndex, cfg.ShardCount)
+	if err != nil {
+		setupLog.Error(err, "invalid shard configuration")
+		os.Exit(1)
+	}
+	if guardianShard.Enabled() {
+		setupLog.Info("monitoring namespaces of one shard", "shard", guardianShard.String())
+		if cfg.Storage.Type == "sqlite" {
+			setupLog.Info("shards cannot share a SQLite database, use postgres or mysql so the API shows all shards")
+		}
+	}
+	ignoredNamespaces, err := controller.NewIgnoredNamespaces(cfg.IgnoredNamespaces)
+	if err != nil {
+		setupLog.Error(err, "invalid ignored namespaces")
+		os.Exit(1)
+	}
 
-	// Set up zerolog with configured log level
-	level, err := zerolog.ParseLevel(cfg.LogLevel)
+	// Set up zerolog with configured log level; the admin API changes it at runtime
+	level, err := logging.ParseLevel(cfg.LogLevel)
 	if err != nil {
 		level = zerolog.InfoLevel
 	}
-	zerolog.SetGlobalLevel(level)
+	logLevels := logging.NewLevels(level)
 	zl := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).
 		With().
 		Timestamp().
 		Logger()
-	logger := zerologr.New(&zl)
-	ctrl.SetLogger(logger)
+	ctrl.SetLogger(logging.NewLogger(&zl, logLevels))
 
 	// Re-initialize setupLog with the configured logger
 	setupLog = ctrl.Log.WithName("setup")
@@ -94,6 +133,105 @@ func main() {
 		setupLog.Info("no config file found, using defaults and flags", "level", cfg.LogLevel)
 	}
 
+	if cmd != nil && cmd.ownStores {
+		os.Exit(cmd.run(nil, cfg, flags.Args()))
+	}
+
+	// Read the database password from a Secret, so it can be rotated
+	passwordSecret := dbsecret.Ref{
+		Namespace: cfg.Storage.PasswordSecret.Namespace,
+		Name:      cfg.Storage.PasswordSecret.Name,
+		Key:       cfg.Storage.PasswordSecret.Key,
+	}
+	if passwordSecret.Name != "" {
+		secretReader, err := client.New(ctrl.GetConfigOrDie(), client.Options{})
+		if err != nil {
+			setupLog.Error(err, "unable to create client for the password secret")
+			os.Exit(1)
+		}
+		password, err := dbsecret.Read(context.Background(), secretReader, passwordSecret)
+		if err != nil {
+			setupLog.Error(err, "unable to read database password")
+			os.Exit(1)
+		}
+		if err := setStoragePassword(&cfg.Storage, password); err != nil {
+			setupLog.Error(err, "invalid storage configuration")
+			os.Exit(1)
+		}
+	}
+
+	// Initialize the storage backend
+	dsn, err := storageDSN(cfg.Storage)
+	if err != nil {
+		setupLog.Error(err, "invalid storage configuration")
+		os.Exit(1)
+	}
+
+	// Get connection pool config based on storage type
+	var poolCfg store.ConnectionPoolConfig
+	switch cfg.Storage.Type {
+	case "postgres", "timescale":
+		poolCfg = store.ConnectionPoolConfig{
+			MaxIdleConns:    cfg.Storage.PostgreSQL.ConnectionPool.MaxIdleConns,
+			MaxOpenConns:    cfg.Storage.PostgreSQL.ConnectionPool.MaxOpenConns,
+			ConnMaxLifetime: cfg.Storage.PostgreSQL.ConnectionPool.ConnMaxLifetime,
+			ConnMaxIdleTime: cfg.Storage.PostgreSQL.ConnectionPool.ConnMaxIdleTime,
+		}
+	case "mysql":
+		poolCfg = store.ConnectionPoolConfig{
+			MaxIdleConns:    cfg.Storage.MySQL.ConnectionPool.MaxIdleConns,
+			MaxOpenConns:    cfg.Storage.MySQL.ConnectionPool.MaxOpenConns,
+			ConnMaxLifetime: cfg.Storage.MySQL.ConnectionPool.ConnMaxLifetime,
+			ConnMaxIdleTime: cfg.Storage.MySQL.ConnectionPool.ConnMaxIdleTime,
+		}
+	}
+
+	// Migrations run on the primary only
+	var replica store.ReplicaConfig
+	if !cfg.MigrateOnly {
+		if replica.DSN, err = storageReplicaDSN(cfg.Storage); err != nil {
+			setupLog.Error(err, "invalid storage configuration")
+			os.Exit(1)
+		}
+		replica.MaxLag = cfg.Storage.PostgreSQL.ReadReplica.MaxLag
+	}
+
+	backend, err := store.Open(cfg.Storage.Type, store.BackendOptions{
+		DSN:          dsn,
+		Pool:         poolCfg,
+		Partitioning: cfg.Storage.PostgreSQL.Partitioning,
+		Replica:      replica,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create store")
+		os.Exit(1)
+	}
+
+	// In migrate-only mode, bring the schema to the requested version and exit
+	// before anything talks to the cluster
+	if cfg.MigrateOnly {
+		os.Exit(runMigrations(backend, cfg.Storage.MigrateTo))
+	}
+	if cfg.Storage.MigrateTo != 0 {
+		setupLog.Error(nil, "storage.migrate-to is only used with --migrate-only")
+		os.Exit(1)
+	}
+
+	if err := backend.Init(); err != nil {
+		setupLog.Error(err, "unable to initialize store")
+		os.Exit(1)
+	}
+	defer func() { _ = backend.Close() }()
+	partitioned := false
+	if gormStore, ok := backend.(*store.GormStore); ok {
+		partitioned = gormStore.Partitioned()
+	}
+	setupLog.Info("initialized store", "type", cfg.Storage.Type, "partitioned", partitioned)
+
+	if cmd != nil {
+		os.Exit(cmd.run(backend, cfg, flags.Args()))
+	}
+
 	// TLS options
 	var tlsOpts []func(*tls.Config)
 
@@ -202,7 +340,7 @@ func main() {
 			WebhookServer:          webhookServer,
 			HealthProbeBindAddress: cfg.Probes.BindAddress,
 			LeaderElection:         cfg.LeaderElection.Enabled,
-			LeaderElectionID:       "59ab3636.illenium.net",
+			LeaderElectionID:       guardianShard.LeaderElectionID("59ab3636.illenium.net"),
 			// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 			// when the Manager ends. This requires the binary to immediately end when the
 			// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -221,61 +359,108 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Initialize the storage backend
-	var dsn string
-	switch cfg.Storage.Type {
-	case "sqlite":
-		dsn = cfg.Storage.SQLite.Path + "?_journal_mode=WAL&_busy_timeout=5000"
-	case "postgres":
-		dsn = fmt.Sprintf(
-			"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-			cfg.Storage.PostgreSQL.Host, cfg.Storage.PostgreSQL.Port,
-			cfg.Storage.PostgreSQL.Username, cfg.Storage.PostgreSQL.Password,
-			cfg.Storage.PostgreSQL.Database, cfg.Storage.PostgreSQL.SSLMode,
-		)
-	case "mysql":
-		dsn = fmt.Sprintf(
-			"%s:%s@tcp(%s:%d)/%s?parseTime=true",
-			cfg.Storage.MySQL.Username, cfg.Storage.MySQL.Password,
-			cfg.Storage.MySQL.Host, cfg.Storage.MySQL.Port,
-			cfg.Storage.MySQL.Database,
-		)
-	default:
-		setupLog.Error(nil, "unsupported storage type", "type", cfg.Storage.Type)
-		os.Exit(1)
+	// Reconnect with the new password when the password Secret rotates
+	if passwordSecret.Name != "" {
+		reconnector, ok := backend.(store.Reconnector)
+		if !ok {
+			setupLog.Error(nil, "storage.password-secret is not supported for storage type", "type", cfg.Storage.Type)
+			os.Exit(1)
+		}
+		watcher := dbsecret.NewWatcher(mgr.GetAPIReader(), passwordSecret, cfg.Storage.PasswordSecret.RefreshInterval,
+			storagePassword(cfg.Storage), func(ctx context.Context, password string) error {
+				storage := cfg.Storage
+				if err := setStoragePassword(&storage, password); err != nil {
+					return err
+				}
+				dsn, err := storageDSN(storage)
+				if err != nil {
+					return err
+				}
+				if err := reconnector.Reconnect(ctx, dsn); err != nil {
+					return err
+				}
+				replicaDSN, err := storageReplicaDSN(storage)
+				if err != nil || replicaDSN == "" {
+					return err
+				}
+				return backend.(*store.GormStore).ReconnectReplica(ctx, replicaDSN)
+			})
+		if err := mgr.Add(watcher); err != nil {
+			setupLog.Error(err, "unable to add password secret watcher")
+			os.Exit(1)
+		}
+		setupLog.Info("watching database password secret", "secret", passwordSecret.Name,
+			"interval", cfg.Storage.PasswordSecret.RefreshInterval)
 	}
 
-	// Get connection pool config based on storage type
-	var poolCfg store.ConnectionPoolConfig
-	switch cfg.Storage.Type {
-	case "postgres":
-		poolCfg = store.ConnectionPoolConfig{
-			MaxIdleConns:    cfg.Storage.PostgreSQL.ConnectionPool.MaxIdleConns,
-			MaxOpenConns:    cfg.Storage.PostgreSQL.ConnectionPool.MaxOpenConns,
-			ConnMaxLifetime: cfg.Storage.PostgreSQL.ConnectionPool.ConnMaxLifetime,
-			ConnMaxIdleTime: cfg.Storage.PostgreSQL.ConnectionPool.ConnMaxIdleTime,
+	// Move reads back to the read replica once it is reachable and caught up
+	if replica.DSN != "" {
+		monitor := store.NewReplicaMonitor(backend.(*store.GormStore), cfg.Storage.PostgreSQL.ReadReplica.CheckInterval)
+		if err := mgr.Add(monitor); err != nil {
+			setupLog.Error(err, "unable to add read replica monitor")
+			os.Exit(1)
 		}
-	case "mysql":
-		poolCfg = store.ConnectionPoolConfig{
-			MaxIdleConns:    cfg.Storage.MySQL.ConnectionPool.MaxIdleConns,
-			MaxOpenConns:    cfg.Storage.MySQL.ConnectionPool.MaxOpenConns,
-			ConnMaxLifetime: cfg.Storage.MySQL.ConnectionPool.ConnMaxLifetime,
-			ConnMaxIdleTime: cfg.Storage.MySQL.ConnectionPool.ConnMaxIdleTime,
+		setupLog.Info("serving reads from replica", "host", cfg.Storage.PostgreSQL.ReadReplica.Host,
+			"maxLag", cfg.Storage.PostgreSQL.ReadReplica.MaxLag)
+	}
+
+	// Offload large logs to object storage
+	var logBucket *objectstore.S3
+	if offload := cfg.Storage.LogOffload; offload.Enabled {
+		bucket, err := objectstore.NewS3(objectstore.Config{
+			Provider:        offload.Provider,
+			Endpoint:        offload.Endpoint,
+			Region:          offload.Region,
+			Bucket:          offload.Bucket,
+			AccessKeyID:     offload.AccessKeyID,
+			SecretAccessKey: offload.SecretAccessKey,
+			PathStyle:       offload.PathStyle,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to configure log offload")
+			os.Exit(1)
 		}
+		logBucket = bucket
+		setupLog.Info("enabled log offload", "provider", offload.Provider, "bucket", offload.Bucket, "thresholdKB", offload.ThresholdKB)
 	}
 
-	dataStore, err := store.NewGormStoreWithPool(cfg.Storage.Type, dsn, poolCfg)
+	// layerStore wraps the store of a cluster with query metrics and the slow
+	// query log, retries of calls that fail with a transient database error, an
+	// in-memory cache of hot read queries (metrics, success rate, last
+	// execution) and log offload
+	layerStore := func(dataStore store.Store) store.Store {
+		dataStore = store.NewInstrumentedStore(dataStore, cfg.Storage.SlowQueryThreshold)
+		if cfg.Storage.Retry.MaxRetries > 0 {
+			dataStore = store.NewRetryStore(dataStore, store.RetryConfig{
+				MaxRetries:     cfg.Storage.Retry.MaxRetries,
+				InitialBackoff: cfg.Storage.Retry.InitialBackoff,
+				MaxBackoff:     cfg.Storage.Retry.MaxBackoff,
+			})
+		}
+		if cfg.Storage.CacheTTL > 0 {
+			dataStore = store.NewCachedStore(dataStore, cfg.Storage.CacheTTL)
+		}
+		if logBucket != nil {
+			dataStore = store.NewLogOffloadStore(dataStore, logBucket, cfg.Storage.LogOffload.Prefix, cfg.Storage.LogOffload.ThresholdKB*1024)
+		}
+		return dataStore
+	}
+	dataStore := layerStore(backend)
+	if cfg.Storage.CacheTTL > 0 {
+		setupLog.Info("enabled store cache", "ttl", cfg.Storage.CacheTTL)
+	}
+
+	// Connect to the clusters monitored through kubeconfigs
+	remoteClusters, err := connectRemoteClusters(cfg)
 	if err != nil {
-		setupLog.Error(err, "unable to create store")
+		setupLog.Error(err, "invalid remote cluster configuration")
 		os.Exit(1)
 	}
-
-	if err := dataStore.Init(); err != nil {
-		setupLog.Error(err, "unable to initialize store")
+	clusterScoper, ok := backend.(store.ClusterScoper)
+	if len(remoteClusters) > 0 && !ok {
+		setupLog.Error(nil, "remote clusters are not supported for storage type", "type", cfg.Storage.Type)
 		os.Exit(1)
 	}
-	defer func() { _ = dataStore.Close() }()
-	setupLog.Info("initialized store", "type", cfg.Storage.Type)
 
 	// Initialize SLA analyzer (required for all SLA features)
 	slaAnalyzer := analyzer.NewSLAAnalyzer(dataStore)
@@ -290,23 +475,46 @@ func main() {
 		setupLog.Info("leader election enabled, schedulers will wait for leadership")
 	}
 
-	// Initialize and add history pruner to manager
-	historyPruner := scheduler.NewHistoryPruner(dataStore, cfg.HistoryRetention.DefaultDays)
-	historyPruner.SetInterval(cfg.Scheduler.PruneInterval)
-	historyPruner.SetElected(elected)
-	if cfg.Storage.LogRetentionDays > 0 {
-		historyPruner.SetLogRetentionDays(cfg.Storage.LogRetentionDays)
+	// Schedulers by name, reported by the diagnostics endpoint
+	schedulers := make(map[string]api.LastRunReporter)
+
+	// Initialize and add history pruner to manager. The database is shared
+	// by all shards, so only the primary shard prunes it.
+	var historyPruner *scheduler.HistoryPruner
+	if guardianShard.Primary() {
+		historyPruner = scheduler.NewHistoryPruner(dataStore, cfg.HistoryRetention.DefaultDays)
+		historyPruner.SetInterval(cfg.Scheduler.PruneInterval)
+		historyPruner.SetElected(elected)
+		historyPruner.SetClient(mgr.GetClient())
+		historyPruner.SetUndoWindow(cfg.HistoryRetention.UndoWindow)
+		if cfg.Storage.LogRetentionDays > 0 {
+			historyPruner.SetLogRetentionDays(cfg.Storage.LogRetentionDays)
+		}
+		if err := mgr.Add(historyPruner); err != nil {
+			setupLog.Error(err, "unable to add history pruner to manager")
+			os.Exit(1)
+		}
+		schedulers["history-pruner"] = historyPruner
+		setupLog.Info(
+			"initialized history pruner",
+			"retentionDays", cfg.HistoryRetention.DefaultDays,
+			"logRetentionDays", cfg.Storage.LogRetentionDays,
+			"interval", cfg.Scheduler.PruneInterval,
+		)
 	}
-	if err := mgr.Add(historyPruner); err != nil {
-		setupLog.Error(err, "unable to add history pruner to manager")
-		os.Exit(1)
+
+	// Daily roll-ups keep long-range trends after raw history is pruned.
+	// Like pruning, only the primary shard writes them.
+	if guardianShard.Primary() && cfg.HistoryRetention.RollupDays > 0 {
+		rollupScheduler := scheduler.NewRollupScheduler(dataStore, cfg.HistoryRetention.RollupDays, cfg.HistoryRetention.DefaultDays)
+		rollupScheduler.SetElected(elected)
+		if err := mgr.Add(rollupScheduler); err != nil {
+			setupLog.Error(err, "unable to add roll-up scheduler to manager")
+			os.Exit(1)
+		}
+		schedulers["rollups"] = rollupScheduler
+		setupLog.Info("initialized roll-up scheduler", "rollupDays", cfg.HistoryRetention.RollupDays)
 	}
-	setupLog.Info(
-		"initialized history pruner",
-		"retentionDays", cfg.HistoryRetention.DefaultDays,
-		"logRetentionDays", cfg.Storage.LogRetentionDays,
-		"interval", cfg.Scheduler.PruneInterval,
-	)
 
 	// Create clientset for controllers that need raw API access
 	clientset, err := kubernetes.NewForConfig(ctrl.GetConfigOrDie())
@@ -315,20 +523,146 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Publish alert and execution events to Kafka, NATS, an HTTP CloudEvents
+	// sink, Splunk or Elasticsearch
+	executionStore := dataStore
+	var eventBus *eventbus.Bus
+	if cfg.EventBus.Enabled {
+		publisher, err := eventbus.NewPublisher(eventbus.Config{
+			Type: cfg.EventBus.Type,
+			Kafka: eventbus.KafkaConfig{
+				Brokers:      cfg.EventBus.Kafka.Brokers,
+				ClientID:     cfg.EventBus.Kafka.ClientID,
+				RequiredAcks: cfg.EventBus.Kafka.RequiredAcks,
+				TLS:          cfg.EventBus.Kafka.TLS,
+				SASLUsername: cfg.EventBus.Kafka.SASLUsername,
+				SASLPassword: cfg.EventBus.Kafka.SASLPassword,
+			},
+			NATS: eventbus.NATSConfig{
+				URL:      cfg.EventBus.NATS.URL,
+				Username: cfg.EventBus.NATS.Username,
+				Password: cfg.EventBus.NATS.Password,
+				Token:    cfg.EventBus.NATS.Token,
+			},
+			HTTP: eventbus.HTTPConfig{
+				URL:   cfg.EventBus.HTTP.URL,
+				Token: cfg.EventBus.HTTP.Token,
+			},
+			Splunk: eventbus.SplunkConfig{
+				URL:    cfg.EventBus.Splunk.URL,
+				Token:  cfg.EventBus.Splunk.Token,
+				Index:  cfg.EventBus.Splunk.Index,
+				Source: cfg.EventBus.Splunk.Source,
+			},
+			Elasticsearch: eventbus.ElasticsearchConfig{
+				URL:              cfg.EventBus.Elasticsearch.URL,
+				Username:         cfg.EventBus.Elasticsearch.Username,
+				Password:         cfg.EventBus.Elasticsearch.Password,
+				APIKey:           cfg.EventBus.Elasticsearch.APIKey,
+				InstallTemplates: cfg.EventBus.Elasticsearch.InstallTemplates,
+			},
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to configure event bus")
+			os.Exit(1)
+		}
+		format, err := eventbus.ResolveFormat(cfg.EventBus.Type, cfg.EventBus.Format)
+		if err != nil {
+			setupLog.Error(err, "unable to configure event bus")
+			os.Exit(1)
+		}
+		eventBus = eventbus.NewBus(publisher, cfg.EventBus.AlertTopic, cfg.EventBus.ExecutionTopic, cfg.EventBus.QueueSize)
+		if format == eventbus.FormatCloudEvents {
+			eventBus.UseCloudEvents(cfg.EventBus.Source)
+		}
+		if err := mgr.Add(eventBus); err != nil {
+			setupLog.Error(err, "unable to add event bus")
+			os.Exit(1)
+		}
+		executionStore = eventbus.NewStore(dataStore, eventBus)
+		setupLog.Info("enabled event bus",
+			"type", cfg.EventBus.Type,
+			"format", format,
+			"alertTopic", cfg.EventBus.AlertTopic,
+			"executionTopic", cfg.EventBus.ExecutionTopic,
+		)
+	}
+
 	// Create alert dispatcher and wire up the store
+	if err := alerting.ValidateCoalescing(cfg.AlertCoalescing); err != nil {
+		setupLog.Error(err, "invalid alert coalescing configuration")
+		os.Exit(1)
+	}
+	if err := alerting.ValidateChannelHTTP(cfg.ChannelHTTP); err != nil {
+		setupLog.Error(err, "invalid channel HTTP configuration")
+		os.Exit(1)
+	}
 	dispatcherCfg := alerting.DispatcherConfig{
 		StartupGracePeriod:           cfg.Scheduler.StartupGracePeriod,
+		StartupGraceOverrides:        cfg.Scheduler.StartupGraceOverrides,
 		MaxAlertsPerMinute:           cfg.RateLimits.MaxAlertsPerMinute,
 		BurstLimit:                   cfg.RateLimits.BurstLimit,
 		DefaultSuppressDuplicatesFor: cfg.RateLimits.DefaultSuppressDuplicatesFor,
+		Ownership:                    cfg.Ownership,
+		ClusterClients:               remoteClients(remoteClusters),
+		Coalescing:                   cfg.AlertCoalescing,
+		ChannelHTTP:                  cfg.ChannelHTTP,
+		DashboardURL:                 cfg.UI.ExternalURL,
+	}
+	var alertSinks []alerting.AlertSink
+	if eventBus != nil {
+		alertSinks = append(alertSinks, eventBus)
+	}
+	// Annotate Grafana dashboards when alerts fire and resolve
+	if cfg.Grafana.URL != "" {
+		alertSinks = append(alertSinks, grafana.NewAnnotator(cfg.Grafana))
+		setupLog.Info("enabled Grafana annotations", "url", cfg.Grafana.URL)
+	}
+	dispatcherCfg.AlertSink = alerting.MultiSink(alertSinks...)
+	// Share suppression state, rate limits and delayed alerts between replicas through Redis
+	if cfg.Redis.Enabled {
+		redisClient, err := redisstate.NewClient(redisstate.ClientConfig{
+			Address:  cfg.Redis.Address,
+			Username: cfg.Redis.Username,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+			TLS:      cfg.Redis.TLS,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to configure redis")
+			os.Exit(1)
+		}
+		sharedState := redisstate.NewState(redisClient, cfg.Redis.KeyPrefix)
+		pingCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err = sharedState.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			setupLog.Error(err, "unable to connect to redis", "address", cfg.Redis.Address)
+			os.Exit(1)
+		}
+		dispatcherCfg.SharedState = sharedState
+		setupLog.Info("using redis for shared alerting state", "address", cfg.Redis.Address, "db", cfg.Redis.DB)
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		dispatcherCfg.Identity = hostname
 	}
 	alertDispatcher := alerting.NewDispatcher(mgr.GetClient(), dataStore, dispatcherCfg)
+	alertDispatcher.SetElected(elected)
 	setupLog.Info("initialized alert dispatcher",
 		"startupGracePeriod", cfg.Scheduler.StartupGracePeriod,
+		"startupGraceOverrides", cfg.Scheduler.StartupGraceOverrides,
 		"maxAlertsPerMinute", cfg.RateLimits.MaxAlertsPerMinute,
 		"burstLimit", cfg.RateLimits.BurstLimit,
+		"alertCoalescing", cfg.AlertCoalescing.Enabled,
 	)
 
+	// Reload alert suppression state on becoming leader, so alerts already
+	// sent by the previous leader are not re-sent after failover
+	if err := mgr.Add(&dispatcherStateLoader{dispatcher: alertDispatcher}); err != nil {
+		setupLog.Error(err, "unable to add alert state loader")
+		os.Exit(1)
+	}
+
 	// Register shutdown hook for alert dispatcher cleanup
 	if err := mgr.Add(&dispatcherShutdown{dispatcher: alertDispatcher}); err != nil {
 		setupLog.Error(err, "unable to add alert dispatcher shutdown hook")
@@ -343,6 +677,8 @@ func main() {
 		Config:          cfg,
 		Analyzer:        slaAnalyzer,
 		AlertDispatcher: alertDispatcher,
+		Shard:           guardianShard,
+		Ignored:         ignoredNamespaces,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CronJobMonitor")
 		os.Exit(1)
@@ -357,44 +693,240 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Job handler watches for Job completions to record executions
-	if err := (&controller.JobReconciler{
+	if err := (&controller.FailurePatternReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("controllers").WithName("FailurePattern"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "FailurePattern")
+		os.Exit(1)
+	}
+
+	if err := (&controller.AlertRouteReconciler{
+		Client: mgr.GetClient(),
+		Log:    ctrl.Log.WithName("controllers").WithName("AlertRoute"),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AlertRoute")
+		os.Exit(1)
+	}
+
+	// GuardianConfig overrides rate limits, retention and ignored namespaces at runtime
+	guardianConfigReconciler := &controller.GuardianConfigReconciler{
 		Client:          mgr.GetClient(),
-		Log:             ctrl.Log.WithName("controllers").WithName("JobHandler"),
+		Log:             ctrl.Log.WithName("controllers").WithName("GuardianConfig"),
 		Scheme:          mgr.GetScheme(),
-		Clientset:       clientset,
-		Store:           dataStore,
-		Config:          cfg,
+		Defaults:        cfg.Runtime(),
 		AlertDispatcher: alertDispatcher,
-	}).SetupWithManager(mgr); err != nil {
+		HistoryPruner:   historyPruner,
+		Ignored:         ignoredNamespaces,
+	}
+	if err := guardianConfigReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GuardianConfig")
+		os.Exit(1)
+	}
+
+	// Apply runtime settings from config file changes without a restart
+	if cfg.ConfigFileUsed() != "" {
+		configWatcher := config.NewWatcher(cfg.ConfigFileUsed(), cfg,
+			func() (*config.Config, error) { return config.Load(flags) },
+			func(_, updated *config.Config) {
+				if level, err := logging.ParseLevel(updated.LogLevel); err == nil {
+					logLevels.SetLevel(level)
+				}
+				if err := guardianConfigReconciler.SetDefaults(updated.Runtime()); err != nil {
+					setupLog.Error(err, "failed to apply reloaded configuration")
+				}
+			})
+		if err := mgr.Add(configWatcher); err != nil {
+			setupLog.Error(err, "unable to add config file watcher")
+			os.Exit(1)
+		}
+	}
+
+	// Buffer execution writes so bursts of completed Jobs are stored in batches
+	var executionBatcher *store.ExecutionBatcher
+	if cfg.Storage.BatchSize > 0 {
+		executionBatcher = store.NewExecutionBatcher(executionStore, cfg.Storage.BatchSize, cfg.Storage.BatchFlushInterval)
+		if err := mgr.Add(executionBatcher); err != nil {
+			setupLog.Error(err, "unable to add execution batcher")
+			os.Exit(1)
+		}
+	}
+
+	// Detect node drains and cluster upgrades to tell the failures they cause apart
+	var disruptionDetector *disruption.Detector
+	if cfg.ClusterDisruption.Enabled {
+		disruptionDetector = disruption.NewDetector(mgr.GetClient(), cfg.ClusterDisruption)
+		if err := mgr.Add(disruptionDetector); err != nil {
+			setupLog.Error(err, "unable to add cluster disruption detector")
+			os.Exit(1)
+		}
+		setupLog.Info("initialized cluster disruption detector",
+			"interval", cfg.ClusterDisruption.Interval, "suppressAlerts", cfg.ClusterDisruption.SuppressAlerts)
+	}
+
+	// Job handler watches for Job completions to record executions
+	jobHandler := &controller.JobReconciler{
+		Client:           mgr.GetClient(),
+		Log:              ctrl.Log.WithName("controllers").WithName("JobHandler"),
+		Scheme:           mgr.GetScheme(),
+		Clientset:        clientset,
+		Store:            executionStore,
+		Config:           cfg,
+		AlertDispatcher:  alertDispatcher,
+		ExecutionBatcher: executionBatcher,
+		Shard:            guardianShard,
+		Pinger:           ping.NewPinger(mgr.GetClient()),
+		Ignored:          ignoredNamespaces,
+		Disruption:       disruptionDetector,
+	}
+	if err := jobHandler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "JobHandler")
 		os.Exit(1)
 	}
+	if cfg.Scheduler.BackfillOnStartup {
+		if err := mgr.Add(manager.RunnableFunc(jobHandler.Backfill)); err != nil {
+			setupLog.Error(err, "unable to add job backfill")
+			os.Exit(1)
+		}
+	}
 
 	// Create and register DeadManScheduler for periodic dead-man's switch checks
 	deadManScheduler := scheduler.NewDeadManScheduler(mgr.GetClient(), slaAnalyzer, alertDispatcher)
-	deadManScheduler.SetStartupDelay(cfg.Scheduler.StartupGracePeriod)
+	// Alerts with a shorter grace period are checked for once it ends
+	deadManScheduler.SetStartupDelay(cfg.Scheduler.ShortestStartupGrace())
 	deadManScheduler.SetInterval(cfg.Scheduler.DeadManSwitchInterval)
 	deadManScheduler.SetElected(elected)
+	deadManScheduler.SetShard(guardianShard)
 	if err := mgr.Add(deadManScheduler); err != nil {
 		setupLog.Error(err, "unable to add dead-man scheduler")
 		os.Exit(1)
 	}
+	schedulers["dead-man-switch"] = deadManScheduler
 	setupLog.Info(
 		"initialized dead-man scheduler",
 		"interval", cfg.Scheduler.DeadManSwitchInterval,
-		"startupDelay", cfg.Scheduler.StartupGracePeriod,
+		"startupDelay", cfg.Scheduler.ShortestStartupGrace(),
 	)
 
 	// Create and register SLARecalcScheduler for periodic SLA recalculation
 	slaRecalcScheduler := scheduler.NewSLARecalcScheduler(mgr.GetClient(), dataStore, slaAnalyzer, alertDispatcher)
 	slaRecalcScheduler.SetElected(elected)
+	slaRecalcScheduler.SetShard(guardianShard)
 	if err := mgr.Add(slaRecalcScheduler); err != nil {
 		setupLog.Error(err, "unable to add SLA recalc scheduler")
 		os.Exit(1)
 	}
+	schedulers["sla-recalc"] = slaRecalcScheduler
 	setupLog.Info("initialized SLA recalc scheduler", "interval", "5m")
 
+	// Create and register StuckJobScheduler for running Jobs that exceed their runtime limit
+	stuckJobScheduler := scheduler.NewStuckJobScheduler(mgr.GetClient(), dataStore, alertDispatcher)
+	stuckJobScheduler.SetElected(elected)
+	stuckJobScheduler.SetShard(guardianShard)
+	if err := mgr.Add(stuckJobScheduler); err != nil {
+		setupLog.Error(err, "unable to add stuck job scheduler")
+		os.Exit(1)
+	}
+	schedulers["stuck-jobs"] = stuckJobScheduler
+	setupLog.Info("initialized stuck job scheduler", "interval", "1m")
+
+	// Create and register PodStartScheduler for running Jobs that cannot start
+	if err := scheduler.IndexJobEvents(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		setupLog.Error(err, "unable to index job events")
+		os.Exit(1)
+	}
+	podStartScheduler := scheduler.NewPodStartScheduler(mgr.GetClient(), dataStore, alertDispatcher)
+	podStartScheduler.SetElected(elected)
+	podStartScheduler.SetShard(guardianShard)
+	podStartScheduler.SetUnschedulableAfter(cfg.Scheduler.UnschedulableAfter)
+	if err := mgr.Add(podStartScheduler); err != nil {
+		setupLog.Error(err, "unable to add pod start scheduler")
+		os.Exit(1)
+	}
+	schedulers["pod-start"] = podStartScheduler
+	setupLog.Info("initialized pod start scheduler", "interval", "30s")
+
+	// Create and register DigestScheduler for email digest channels. Digests
+	// cover all shards, so only the primary shard sends them.
+	if guardianShard.Primary() {
+		digestScheduler := scheduler.NewDigestScheduler(mgr.GetClient(), dataStore)
+		digestScheduler.SetElected(elected)
+		digestScheduler.SetDashboardURL(cfg.UI.ExternalURL)
+		if err := mgr.Add(digestScheduler); err != nil {
+			setupLog.Error(err, "unable to add digest scheduler")
+			os.Exit(1)
+		}
+		schedulers["email-digest"] = digestScheduler
+		setupLog.Info("initialized digest scheduler", "interval", "1m")
+	}
+
+	// Create and register SLAReportScheduler for email channels with scheduled
+	// SLA reports. Like digests, reports cover all shards.
+	if guardianShard.Primary() {
+		slaReportScheduler := scheduler.NewSLAReportScheduler(mgr.GetClient(), dataStore, cfg.Ownership)
+		slaReportScheduler.SetElected(elected)
+		slaReportScheduler.SetDashboardURL(cfg.UI.ExternalURL)
+		if err := mgr.Add(slaReportScheduler); err != nil {
+			setupLog.Error(err, "unable to add SLA report scheduler")
+			os.Exit(1)
+		}
+		schedulers["sla-reports"] = slaReportScheduler
+		setupLog.Info("initialized SLA report scheduler", "interval", "1m")
+	}
+
+	// Send heartbeats so something outside the cluster notices when guardian stops
+	if cfg.Heartbeat.Enabled() {
+		grouping := map[string]string{}
+		if cfg.ClusterName != "" {
+			grouping["cluster"] = cfg.ClusterName
+		}
+		if guardianShard.Enabled() {
+			grouping["shard"] = guardianShard.String()
+		}
+		heartbeatScheduler := scheduler.NewHeartbeatScheduler(cfg.Heartbeat, dataStore, grouping)
+		heartbeatScheduler.SetElected(elected)
+		if err := mgr.Add(heartbeatScheduler); err != nil {
+			setupLog.Error(err, "unable to add heartbeat scheduler")
+			os.Exit(1)
+		}
+		schedulers["heartbeat"] = heartbeatScheduler
+		setupLog.Info("initialized heartbeat scheduler", "interval", cfg.Heartbeat.Interval)
+	}
+
+	// Run the controllers and schedulers of remote clusters. Alerts go through
+	// the local alert channels; history and SLAs share the local database.
+	remoteSetup := remoteClusterSetup{
+		cfg:        cfg,
+		backend:    clusterScoper,
+		layerStore: layerStore,
+		eventBus:   eventBus,
+		dispatcher: alertDispatcher,
+		shard:      guardianShard,
+		ignored:    ignoredNamespaces,
+		elected:    elected,
+	}
+	clusterBackends := make(map[string]api.ClusterBackend, len(remoteClusters))
+	for _, remote := range remoteClusters {
+		backend, err := remoteSetup.add(mgr, remote, schedulers)
+		if err != nil {
+			setupLog.Error(err, "unable to set up remote cluster", "cluster", remote.Name)
+			os.Exit(1)
+		}
+		clusterBackends[remote.Name] = backend
+		setupLog.Info("monitoring remote cluster", "cluster", remote.Name, "host", remote.GetConfig().Host)
+	}
+
+	// Serve conversion between the v1alpha1 and v1beta1 API versions
+	if cfg.Webhook.Enabled {
+		if err := guardianwebhook.SetupConversionWebhooks(mgr); err != nil {
+			setupLog.Error(err, "unable to set up conversion webhooks")
+			os.Exit(1)
+		}
+		setupLog.Info("enabled conversion webhooks")
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -426,6 +958,19 @@ func main() {
 	if cfg.UI.Enabled {
 		api.UIAssets = uiAssets
 
+		schedulersRunning := []string{"dead-man-switch", "sla-recalc"}
+		if guardianShard.Primary() {
+			schedulersRunning = append(schedulersRunning, "history-pruner", "email-digest", "sla-reports")
+		}
+
+		certWatchers := make(map[string]api.CertificateProvider)
+		if metricsCertWatcher != nil {
+			certWatchers["metrics"] = metricsCertWatcher
+		}
+		if webhookCertWatcher != nil {
+			certWatchers["webhook"] = webhookCertWatcher
+		}
+
 		// Create leader election check function
 		var leaderElectionCheck func() bool
 		if cfg.LeaderElection.Enabled {
@@ -450,7 +995,11 @@ func main() {
 				Port:                cfg.UI.Port,
 				LeaderElectionCheck: leaderElectionCheck,
 				AnalyzerEnabled:     true, // Analyzer is always enabled (required dependency)
-				SchedulersRunning:   []string{"dead-man-switch", "sla-recalc", "history-pruner"},
+				SchedulersRunning:   schedulersRunning,
+				Schedulers:          schedulers,
+				CertWatchers:        certWatchers,
+				Clusters:            clusterBackends,
+				LogLevels:           logLevels,
 			},
 		)
 
@@ -468,6 +1017,18 @@ func main() {
 	}
 }
 
+// dispatcherStateLoader implements manager.Runnable to reload the alert
+// dispatcher's suppression state once this replica is the leader.
+type dispatcherStateLoader struct {
+	dispatcher alerting.Dispatcher
+}
+
+// Start reloads the persisted alert state and returns.
+func (d *dispatcherStateLoader) Start(ctx context.Context) error {
+	d.dispatcher.ReloadAlertState(ctx)
+	return nil
+}
+
 // dispatcherShutdown implements manager.Runnable to gracefully shutdown the alert dispatcher.
 type dispatcherShutdown struct {
 	dispatcher alerting.Dispatcher
@@ -478,3 +1039,109 @@ func (d *dispatcherShutdown) Start(ctx context.Context) error {
 	<-ctx.Done()
 	return d.dispatcher.Stop()
 }
+
+// runMigrations migrates the store schema to target (0 = latest) for
+// --migrate-only and returns the process exit code.
+func runMigrations(backend store.Store, target int) int {
+	defer func() { _ = backend.Close() }()
+	ctx := context.Background()
+
+	migrator, versioned := backend.(store.Migrator)
+	if target != 0 && !versioned {
+		setupLog.Error(nil, "storage.migrate-to is not supported by this storage type")
+		return 1
+	}
+
+	var err error
+	if target == 0 {
+		// Init also baselines pre-migration databases and sets up
+		// partitioning and TimescaleDB
+		err = backend.Init()
+	} else {
+		err = migrator.Migrate(ctx, target)
+	}
+	if err != nil {
+		setupLog.Error(err, "schema migration failed")
+		return 1
+	}
+	if !versioned {
+		setupLog.Info("schema migrated")
+		return 0
+	}
+
+	version, err := migrator.SchemaVersion(ctx)
+	if err != nil {
+		setupLog.Error(err, "unable to read schema version")
+		return 1
+	}
+	setupLog.Info("schema migrated", "version", version)
+	return 0
+}
+
+// storageDSN builds the database connection string for the configured backend
+func storageDSN(storage config.StorageConfig) (string, error) {
+	switch storage.Type {
+	case "sqlite":
+		return storage.SQLite.Path + "?_journal_mode=WAL&_busy_timeout=5000", nil
+	case "postgres", "timescale":
+		return fmt.Sprintf(
+			"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+			storage.PostgreSQL.Host, storage.PostgreSQL.Port,
+			storage.PostgreSQL.Username, storage.PostgreSQL.Password,
+			storage.PostgreSQL.Database, storage.PostgreSQL.SSLMode,
+		), nil
+	case "mysql":
+		return fmt.Sprintf(
+			"%s:%s@tcp(%s:%d)/%s?parseTime=true",
+			storage.MySQL.Username, storage.MySQL.Password,
+			storage.MySQL.Host, storage.MySQL.Port,
+			storage.MySQL.Database,
+		), nil
+	}
+	// Backends compiled in with a build tag take their connection string as is
+	if !slices.Contains(store.Backends(), storage.Type) {
+		return "", fmt.Errorf("unsupported storage type %q (registered: %s)", storage.Type, strings.Join(store.Backends(), ", "))
+	}
+	if storage.DSN == "" {
+		return "", fmt.Errorf("storage.dsn is required for storage type %q", storage.Type)
+	}
+	return storage.DSN, nil
+}
+
+// storageReplicaDSN builds the connection string of the configured read
+// replica, or returns "" if there is none
+func storageReplicaDSN(storage config.StorageConfig) (string, error) {
+	replica := storage.PostgreSQL.ReadReplica
+	if replica.Host == "" {
+		return "", nil
+	}
+	if storage.Type != "postgres" && storage.Type != "timescale" {
+		return "", fmt.Errorf("storage.postgres.read-replica is not supported for storage type %q", storage.Type)
+	}
+	storage.PostgreSQL.Host = replica.Host
+	if replica.Port != 0 {
+		storage.PostgreSQL.Port = replica.Port
+	}
+	return storageDSN(storage)
+}
+
+// storagePassword returns the password of the configured backend
+func storagePassword(storage config.StorageConfig) string {
+	if storage.Type == "mysql" {
+		return storage.MySQL.Password
+	}
+	return storage.PostgreSQL.Password
+}
+
+// setStoragePassword sets the password of the configured backend
+func setStoragePassword(storage *config.StorageConfig, password string) error {
+	switch storage.Type {
+	case "postgres", "timescale":
+		storage.PostgreSQL.Password = password
+	case "mysql":
+		storage.MySQL.Password = password
+	default:
+		return fmt.Errorf("storage.password-secret is not supported for storage type %q", storage.Type)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/api"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/controller"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/eventbus"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/ping"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/scheduler"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// connectRemoteClusters opens a connection to every configured remote
+// cluster. The connections start with the manager they are added to.
+func connectRemoteClusters(cfg *config.Config) ([]*controller.RemoteCluster, error) {
+	seen := make(map[string]bool, len(cfg.RemoteClusters))
+	remotes := make([]*controller.RemoteCluster, 0, len(cfg.RemoteClusters))
+	for _, rc := range cfg.RemoteClusters {
+		if errs := validation.IsDNS1123Label(rc.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("remote cluster name %q: %s", rc.Name, strings.Join(errs, ", "))
+		}
+		if rc.Name == cfg.ClusterName || seen[rc.Name] {
+			return nil, fmt.Errorf("remote cluster name %q is used more than once", rc.Name)
+		}
+		seen[rc.Name] = true
+		if rc.Kubeconfig == "" {
+			return nil, fmt.Errorf("remote cluster %q: kubeconfig is required", rc.Name)
+		}
+
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: rc.Kubeconfig},
+			&clientcmd.ConfigOverrides{CurrentContext: rc.Context},
+		).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("remote cluster %q: loading kubeconfig: %w", rc.Name, err)
+		}
+		c, err := cluster.New(restConfig, func(o *cluster.Options) {
+			o.Scheme = scheme
+		})
+		if err != nil {
+			return nil, fmt.Errorf("remote cluster %q: %w", rc.Name, err)
+		}
+		remotes = append(remotes, &controller.RemoteCluster{Name: rc.Name, Cluster: c})
+	}
+	return remotes, nil
+}
+
+// remoteClients returns the clients of the remote clusters by name, for the
+// alert dispatcher to look up their CronJobs and monitors
+func remoteClients(remotes []*controller.RemoteCluster) map[string]client.Client {
+	if len(remotes) == 0 {
+		return nil
+	}
+	clients := make(map[string]client.Client, len(remotes))
+	for _, remote := range remotes {
+		clients[remote.Name] = remote.GetClient()
+	}
+	return clients
+}
+
+// remoteScheduler is a scheduler run for a remote cluster
+type remoteScheduler interface {
+	manager.Runnable
+	api.LastRunReporter
+}
+
+// remoteClusterSetup is what the controllers and schedulers of remote
+// clusters share with the local ones
+type remoteClusterSetup struct {
+	cfg        *config.Config
+	backend    store.ClusterScoper
+	layerStore func(store.Store) store.Store // adds retries, cache and log offload
+	eventBus   *eventbus.Bus                 // nil = disabled
+	dispatcher alerting.Dispatcher
+	shard      shard.Shard
+	ignored    *controller.IgnoredNamespaces
+	elected    <-chan struct{}
+}
+
+// add runs the controllers and schedulers of a remote cluster in mgr, registers
+// its schedulers for diagnostics and returns what the API reads for it
+func (s remoteClusterSetup) add(mgr ctrl.Manager, remote *controller.RemoteCluster, schedulers map[string]api.LastRunReporter) (api.ClusterBackend, error) {
+	if err := mgr.Add(remote); err != nil {
+		return api.ClusterBackend{}, fmt.Errorf("adding cluster: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(remote.GetConfig())
+	if err != nil {
+		return api.ClusterBackend{}, fmt.Errorf("creating clientset: %w", err)
+	}
+
+	dataStore := s.layerStore(s.backend.ForCluster(remote.Name))
+	executionStore := dataStore
+	if s.eventBus != nil {
+		executionStore = eventbus.NewStore(dataStore, s.eventBus)
+	}
+	dispatcher := alerting.ForCluster(s.dispatcher, remote.Name)
+	slaAnalyzer := analyzer.NewSLAAnalyzer(dataStore)
+	log := ctrl.Log.WithName("controllers").WithValues("cluster", remote.Name)
+
+	if err := (&controller.CronJobMonitorReconciler{
+		Client:          remote.GetClient(),
+		Log:             log.WithName("CronJobMonitor"),
+		Scheme:          remote.GetScheme(),
+		Store:           dataStore,
+		Config:          s.cfg,
+		Analyzer:        slaAnalyzer,
+		AlertDispatcher: dispatcher,
+		Shard:           s.shard,
+		Ignored:         s.ignored,
+		Remote:          remote,
+	}).SetupWithManager(mgr); err != nil {
+		return api.ClusterBackend{}, fmt.Errorf("creating CronJobMonitor controller: %w", err)
+	}
+
+	var executionBatcher *store.ExecutionBatcher
+	if s.cfg.Storage.BatchSize > 0 {
+		executionBatcher = store.NewExecutionBatcher(executionStore, s.cfg.Storage.BatchSize, s.cfg.Storage.BatchFlushInterval)
+		if err := mgr.Add(executionBatcher); err != nil {
+			return api.ClusterBackend{}, fmt.Errorf("adding execution batcher: %w", err)
+		}
+	}
+	jobHandler := &controller.JobReconciler{
+		Client:           remote.GetClient(),
+		Log:              log.WithName("JobHandler"),
+		Scheme:           remote.GetScheme(),
+		Clientset:        clientset,
+		Store:            executionStore,
+		Config:           s.cfg,
+		AlertDispatcher:  dispatcher,
+		ExecutionBatcher: executionBatcher,
+		Shard:            s.shard,
+		Pinger:           ping.NewPinger(remote.GetClient()),
+		Ignored:          s.ignored,
+		Remote:           remote,
+	}
+	if err := jobHandler.SetupWithManager(mgr); err != nil {
+		return api.ClusterBackend{}, fmt.Errorf("creating JobHandler controller: %w", err)
+	}
+	if s.cfg.Scheduler.BackfillOnStartup {
+		if err := mgr.Add(manager.RunnableFunc(jobHandler.Backfill)); err != nil {
+			return api.ClusterBackend{}, fmt.Errorf("adding job backfill: %w", err)
+		}
+	}
+
+	deadManScheduler := scheduler.NewDeadManScheduler(remote.GetClient(), slaAnalyzer, dispatcher)
+	deadManScheduler.SetStartupDelay(s.cfg.Scheduler.StartupGracePeriod)
+	deadManScheduler.SetInterval(s.cfg.Scheduler.DeadManSwitchInterval)
+	deadManScheduler.SetElected(s.elected)
+	deadManScheduler.SetShard(s.shard)
+
+	slaRecalcScheduler := scheduler.NewSLARecalcScheduler(remote.GetClient(), dataStore, slaAnalyzer, dispatcher)
+	slaRecalcScheduler.SetElected(s.elected)
+	slaRecalcScheduler.SetShard(s.shard)
+
+	stuckJobScheduler := scheduler.NewStuckJobScheduler(remote.GetClient(), dataStore, dispatcher)
+	stuckJobScheduler.SetElected(s.elected)
+	stuckJobScheduler.SetShard(s.shard)
+
+	if err := scheduler.IndexJobEvents(context.Background(), remote.GetFieldIndexer()); err != nil {
+		return api.ClusterBackend{}, fmt.Errorf("indexing job events: %w", err)
+	}
+	podStartScheduler := scheduler.NewPodStartScheduler(remote.GetClient(), dataStore, dispatcher)
+	podStartScheduler.SetElected(s.elected)
+	podStartScheduler.SetShard(s.shard)
+	podStartScheduler.SetUnschedulableAfter(s.cfg.Scheduler.UnschedulableAfter)
+
+	for name, sched := range map[string]remoteScheduler{
+		"dead-man-switch": deadManScheduler,
+		"sla-recalc":      slaRecalcScheduler,
+		"stuck-jobs":      stuckJobScheduler,
+		"pod-start":       podStartScheduler,
+	} {
+		if err := mgr.Add(sched); err != nil {
+			return api.ClusterBackend{}, fmt.Errorf("adding %s scheduler: %w", name, err)
+		}
+		schedulers[name+"/"+remote.Name] = sched
+	}
+
+	return api.ClusterBackend{
+		Client:    remote.GetClient(),
+		Clientset: clientset,
+		Store:     dataStore,
+	}, nil
+}
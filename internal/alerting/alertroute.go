@@ -0,0 +1,532 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// Group timing used when an AlertRoute's group does not set it
+const (
+	DefaultGroupWait     = 30 * time.Second
+	DefaultGroupInterval = 5 * time.Minute
+)
+
+// Alert fields AlertRoutes group by and inhibit rules compare
+const (
+	routeFieldNamespace = "namespace"
+	routeFieldCronJob   = "cronjob"
+	routeFieldType      = "type"
+	routeFieldSeverity  = "severity"
+	routeFieldTeam      = "team"
+	routeFieldMonitor   = "monitor"
+	routeFieldCluster   = "cluster"
+)
+
+// groupedAlertType is the type of a notification for alerts of several types
+const groupedAlertType = "Grouped"
+
+var (
+	groupByFields = []string{routeFieldNamespace, routeFieldCronJob, routeFieldType, routeFieldSeverity, routeFieldTeam, routeFieldMonitor, routeFieldCluster}
+	equalFields   = []string{routeFieldNamespace, routeFieldCronJob, routeFieldTeam, routeFieldMonitor, routeFieldCluster}
+
+	defaultGroupBy = []string{routeFieldNamespace}
+	defaultEqual   = []string{routeFieldNamespace, routeFieldCronJob}
+)
+
+// severityRank orders severities, so grouped notifications take the highest
+// and escalated alerts are not suppressed as duplicates
+var severityRank = map[string]int{"info": 1, "warning": 2, "critical": 3}
+
+// ValidateAlertRoute checks an AlertRoute's match rules, grouping and inhibit rules
+func ValidateAlertRoute(spec v1alpha1.AlertRouteSpec) error {
+	if len(spec.ChannelRefs) == 0 && len(spec.Inhibit) == 0 && !spec.OverrideMonitorChannels {
+		return fmt.Errorf("at least one of channelRefs, inhibit or overrideMonitorChannels is required")
+	}
+	if spec.InhibitOnly {
+		if len(spec.Inhibit) == 0 {
+			return fmt.Errorf("inhibitOnly requires inhibit")
+		}
+		if len(spec.ChannelRefs) > 0 || spec.Group != nil || spec.OverrideMonitorChannels || spec.Continue {
+			return fmt.Errorf("inhibitOnly cannot be combined with channelRefs, group, overrideMonitorChannels or continue")
+		}
+	}
+	for _, ns := range spec.Match.Namespaces {
+		if _, err := path.Match(ns, ""); err != nil {
+			return fmt.Errorf("invalid namespace pattern %q: %w", ns, err)
+		}
+	}
+	if spec.Match.CronJobSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Match.CronJobSelector); err != nil {
+			return fmt.Errorf("invalid cronJobSelector: %w", err)
+		}
+	}
+	if err := validateSeverities("match.severities", spec.Match.Severities); err != nil {
+		return err
+	}
+
+	if g := spec.Group; g != nil {
+		if len(spec.ChannelRefs) == 0 {
+			return fmt.Errorf("group requires channelRefs")
+		}
+		for _, by := range g.By {
+			if !slices.Contains(groupByFields, by) {
+				return fmt.Errorf("invalid group.by field %q", by)
+			}
+		}
+		if g.Wait != nil && g.Wait.Duration <= 0 {
+			return fmt.Errorf("group.wait must be positive")
+		}
+		if g.Interval != nil && g.Interval.Duration <= 0 {
+			return fmt.Errorf("group.interval must be positive")
+		}
+	}
+
+	for i, rule := range spec.Inhibit {
+		if len(rule.SourceTypes) == 0 && len(rule.SourceSeverities) == 0 {
+			return fmt.Errorf("inhibit[%d] requires sourceTypes or sourceSeverities", i)
+		}
+		if err := validateSeverities(fmt.Sprintf("inhibit[%d].sourceSeverities", i), rule.SourceSeverities); err != nil {
+			return err
+		}
+		if rule.MinSources != nil && *rule.MinSources < 1 {
+			return fmt.Errorf("inhibit[%d].minSources must be at least 1", i)
+		}
+		for _, eq := range rule.Equal {
+			if !slices.Contains(equalFields, eq) {
+				return fmt.Errorf("invalid inhibit[%d].equal field %q", i, eq)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSeverities(field string, severities []string) error {
+	for _, s := range severities {
+		if _, ok := severityRank[s]; !ok {
+			return fmt.Errorf("invalid %s value %q", field, s)
+		}
+	}
+	return nil
+}
+
+// routing is what the AlertRoutes matching an alert do with it
+type routing struct {
+	inhibits []v1alpha1.AlertRoute // Matching routes with inhibit rules
+	refs     []v1alpha1.ChannelRef // Channels of matching routes without grouping
+	grouped  []v1alpha1.AlertRoute // Matching routes with grouping
+	override bool                  // Drop the monitor's channels
+}
+
+// alertRoutes returns the valid AlertRoutes in evaluation order: highest
+// priority first, then by name
+func (d *dispatcher) alertRoutes(ctx context.Context) []v1alpha1.AlertRoute {
+	if d.client == nil {
+		return nil
+	}
+	list := &v1alpha1.AlertRouteList{}
+	if err := d.client.List(ctx, list); err != nil {
+		loggerFor(ctx).V(1).Info("failed to list alert routes", "error", err)
+		return nil
+	}
+
+	routes := make([]v1alpha1.AlertRoute, 0, len(list.Items))
+	for _, route := range list.Items {
+		if ValidateAlertRoute(route.Spec) == nil {
+			routes = append(routes, route)
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		pi, pj := routePriority(&routes[i]), routePriority(&routes[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return routes[i].Name < routes[j].Name
+	})
+	return routes
+}
+
+func routePriority(route *v1alpha1.AlertRoute) int32 {
+	if route.Spec.Priority == nil {
+		return 0
+	}
+	return *route.Spec.Priority
+}
+
+// routeAlert evaluates the AlertRoutes for an alert. Routing stops at the
+// first matching route unless it sets continue or inhibitOnly; inhibit rules
+// of all matching routes are collected regardless.
+func (d *dispatcher) routeAlert(ctx context.Context, alert Alert) routing {
+	var r routing
+	var cronJobLabels map[string]string
+	labelsLoaded := false
+	lookupLabels := func() map[string]string {
+		if !labelsLoaded {
+			labelsLoaded = true
+			if cronJob := d.alertCronJob(ctx, alert); cronJob != nil {
+				cronJobLabels = cronJob.GetLabels()
+			}
+		}
+		return cronJobLabels
+	}
+
+	stopped := false
+	for _, route := range d.alertRoutes(ctx) {
+		if !routeMatches(route.Spec.Match, alert, lookupLabels) {
+			continue
+		}
+		if len(route.Spec.Inhibit) > 0 {
+			r.inhibits = append(r.inhibits, route)
+		}
+		if stopped || route.Spec.InhibitOnly {
+			continue
+		}
+		r.override = r.override || route.Spec.OverrideMonitorChannels
+		if route.Spec.Group != nil {
+			r.grouped = append(r.grouped, route)
+		} else {
+			r.refs = append(r.refs, route.Spec.ChannelRefs...)
+		}
+		stopped = !route.Spec.Continue
+	}
+	return r
+}
+
+// routeMatches reports whether an alert matches all set fields of a route
+// match. cronJobLabels is only called when the match has a CronJob selector.
+func routeMatches(m v1alpha1.AlertRouteMatch, alert Alert, cronJobLabels func() map[string]string) bool {
+	if len(m.Namespaces) > 0 && !slices.ContainsFunc(m.Namespaces, func(p string) bool {
+		ok, _ := path.Match(p, alert.CronJob.Namespace)
+		return ok
+	}) {
+		return false
+	}
+	if len(m.Types) > 0 && !slices.Contains(m.Types, alert.Type) {
+		return false
+	}
+	if len(m.Severities) > 0 && !slices.Contains(m.Severities, alert.Severity) {
+		return false
+	}
+	if len(m.Teams) > 0 && !slices.Contains(m.Teams, alert.Team) {
+		return false
+	}
+	if m.CronJobSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(m.CronJobSelector)
+		if err != nil || !selector.Matches(labels.Set(cronJobLabels())) {
+			return false
+		}
+	}
+	return true
+}
+
+// inhibitedBy describes the active alerts that mute alert under the inhibit
+// rules of the matching routes, or returns "" if none do
+func (d *dispatcher) inhibitedBy(r routing, alert Alert) string {
+	d.alertMu.RLock()
+	defer d.alertMu.RUnlock()
+
+	for _, route := range r.inhibits {
+		for _, rule := range route.Spec.Inhibit {
+			equal := rule.Equal
+			if len(equal) == 0 {
+				equal = defaultEqual
+			}
+			minSources := 1
+			if rule.MinSources != nil {
+				minSources = int(*rule.MinSources)
+			}
+			var sources []string
+			for key, source := range d.activeAlerts {
+				if key == alert.Key {
+					continue
+				}
+				if len(rule.SourceTypes) > 0 && !slices.Contains(rule.SourceTypes, source.Type) {
+					continue
+				}
+				if len(rule.SourceSeverities) > 0 && !slices.Contains(rule.SourceSeverities, source.Severity) {
+					continue
+				}
+				if routeFieldsEqual(equal, source, alert) {
+					sources = append(sources, key)
+				}
+			}
+			if len(sources) >= minSources {
+				sort.Strings(sources)
+				if len(sources) == 1 {
+					return sources[0]
+				}
+				return fmt.Sprintf("%d alerts (%s, ...)", len(sources), sources[0])
+			}
+		}
+	}
+	return ""
+}
+
+// routeField returns the value of an alert field named in a route
+func routeField(field string, alert Alert) string {
+	switch field {
+	case routeFieldNamespace:
+		return alert.CronJob.Namespace
+	case routeFieldCronJob:
+		return alert.CronJob.String()
+	case routeFieldType:
+		return alert.Type
+	case routeFieldSeverity:
+		return alert.Severity
+	case routeFieldTeam:
+		return alert.Team
+	case routeFieldMonitor:
+		return alert.MonitorRef.String()
+	case routeFieldCluster:
+		return alert.Cluster
+	}
+	return ""
+}
+
+func routeFieldsEqual(fields []string, a, b Alert) bool {
+	for _, f := range fields {
+		if routeField(f, a) != routeField(f, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendRouteChannels adds the channels of route refs that take the severity
+// and are not targeted yet
+func (d *dispatcher) appendRouteChannels(targets []Channel, refs []v1alpha1.ChannelRef, severity string) []Channel {
+	d.channelMu.RLock()
+	defer d.channelMu.RUnlock()
+
+	for _, ref := range refs {
+		ch, ok := d.channels[ref.Name]
+		if !ok || (len(ref.Severities) > 0 && !contains(ref.Severities, severity)) {
+			continue
+		}
+		if !slices.ContainsFunc(targets, func(t Channel) bool { return t.Name() == ref.Name }) {
+			targets = append(targets, ch)
+		}
+	}
+	return targets
+}
+
+// groupedAlert is an alert waiting in a group
+type groupedAlert struct {
+	alert    Alert
+	recorded bool // Already stored in history by the immediate dispatch
+}
+
+// alertGroup collects the alerts of one AlertRoute group between sends
+type alertGroup struct {
+	route    string
+	label    string
+	refs     []v1alpha1.ChannelRef
+	interval time.Duration
+	pending  []groupedAlert
+	timer    *time.Timer
+}
+
+// addToGroups queues an alert in its group of each grouping route. A new
+// group is sent after the route's wait; later alerts wait for its interval.
+func (d *dispatcher) addToGroups(routes []v1alpha1.AlertRoute, alert Alert, recorded bool) {
+	d.groupMu.Lock()
+	defer d.groupMu.Unlock()
+
+	for _, route := range routes {
+		by := route.Spec.Group.By
+		if len(by) == 0 {
+			by = defaultGroupBy
+		}
+		parts := make([]string, 0, len(by))
+		for _, field := range by {
+			parts = append(parts, field+"="+routeField(field, alert))
+		}
+		label := strings.Join(parts, ", ")
+		key := route.Name + "|" + label
+
+		g, ok := d.groups[key]
+		if !ok {
+			wait, interval := DefaultGroupWait, DefaultGroupInterval
+			if route.Spec.Group.Wait != nil {
+				wait = route.Spec.Group.Wait.Duration
+			}
+			if route.Spec.Group.Interval != nil {
+				interval = route.Spec.Group.Interval.Duration
+			}
+			g = &alertGroup{route: route.Name, label: label, interval: interval}
+			g.timer = time.AfterFunc(wait, func() { d.flushGroup(key) })
+			d.groups[key] = g
+		}
+		// The route may have changed since the group was created
+		g.refs = route.Spec.ChannelRefs
+		g.pending = slices.DeleteFunc(g.pending, func(p groupedAlert) bool { return p.alert.Key == alert.Key })
+		g.pending = append(g.pending, groupedAlert{alert: alert, recorded: recorded})
+	}
+}
+
+// flushGroup sends the pending alerts of a group and re-arms it for the
+// interval. A group with nothing to send is dropped.
+func (d *dispatcher) flushGroup(key string) {
+	d.groupMu.Lock()
+	g, ok := d.groups[key]
+	if !ok {
+		d.groupMu.Unlock()
+		return
+	}
+	pending := g.pending
+	g.pending = nil
+	if len(pending) == 0 {
+		delete(d.groups, key)
+		d.groupMu.Unlock()
+		return
+	}
+	g.timer = time.AfterFunc(g.interval, func() { d.flushGroup(key) })
+	route, label, refs := g.route, g.label, g.refs
+	d.groupMu.Unlock()
+
+	if !d.isLeader() {
+		return
+	}
+	d.sendGroup(context.Background(), route, label, refs, pending)
+}
+
+// sendGroup sends a group's alerts to the route's channels, one notification
+// per channel with the alerts of the severities the channel takes
+func (d *dispatcher) sendGroup(ctx context.Context, route, label string, refs []v1alpha1.ChannelRef, pending []groupedAlert) {
+	logger := loggerFor(ctx).WithValues("alertRoute", route, "group", label)
+	delivered := make(map[string][]string) // alert key -> channels
+
+	for _, ref := range refs {
+		d.channelMu.RLock()
+		ch, ok := d.channels[ref.Name]
+		d.channelMu.RUnlock()
+		if !ok {
+			logger.V(1).Info("alert route channel not found", "channel", ref.Name)
+			continue
+		}
+
+		var alerts []Alert
+		for _, p := range pending {
+			if len(ref.Severities) == 0 || contains(ref.Severities, p.alert.Severity) {
+				alerts = append(alerts, p.alert)
+			}
+		}
+		if len(alerts) == 0 {
+			continue
+		}
+		if !d.allowChannel(ch.Name()) {
+			logger.Info("grouped alerts rate limited by channel", "channel", ch.Name(), "alerts", len(alerts))
+			continue
+		}
+
+		notification := groupNotification(route, label, alerts)
+		logger.Info("sending grouped alerts", "channel", ch.Name(), "provider", ch.Type(), "alerts", len(alerts))
+		if err := d.send(ctx, ch, notification); err != nil {
+			logger.Error(err, "failed to send grouped alerts", "channel", ch.Name(), "provider", ch.Type())
+			d.recordChannelFailure(ch.Name(), err)
+			for _, a := range alerts {
+				metrics.RecordAlertFailed(a.CronJob.Namespace, a.CronJob.Name, a.Type, a.Severity, ch.Name())
+			}
+			d.enqueueDeliveries(ctx, notification, []channelFailure{{channel: ch, err: err}}, false)
+			continue
+		}
+		d.recordChannelSuccess(ch.Name())
+		for _, a := range alerts {
+			metrics.RecordAlert(a.CronJob.Namespace, a.CronJob.Name, a.Type, a.Severity, ch.Name())
+			delivered[a.Key] = append(delivered[a.Key], ch.Name())
+		}
+	}
+
+	for _, p := range pending {
+		if names := delivered[p.alert.Key]; len(names) > 0 && !p.recorded {
+			d.storeAlertHistory(ctx, p.alert, names)
+		}
+	}
+}
+
+// groupNotification combines the alerts of a group into one alert. A single
+// alert is sent as is. Fields all alerts share are kept; the severity is the
+// highest of the alerts. The combined alert gets its own tracking ID.
+func groupNotification(route, label string, alerts []Alert) Alert {
+	if len(alerts) == 1 {
+		return alerts[0]
+	}
+
+	n := Alert{
+		ID:         uuid.NewString(),
+		Key:        "alertroute/" + route + "/" + label,
+		Type:       alerts[0].Type,
+		Severity:   alerts[0].Severity,
+		CronJob:    alerts[0].CronJob,
+		MonitorRef: alerts[0].MonitorRef,
+		Team:       alerts[0].Team,
+		Cluster:    alerts[0].Cluster,
+		Timestamp:  alerts[0].Timestamp,
+	}
+	lines := make([]string, 0, len(alerts))
+	for _, a := range alerts {
+		if a.Type != n.Type {
+			n.Type = groupedAlertType
+		}
+		if severityRank[a.Severity] > severityRank[n.Severity] {
+			n.Severity = a.Severity
+		}
+		if a.CronJob != n.CronJob {
+			n.CronJob.Name = ""
+			if a.CronJob.Namespace != n.CronJob.Namespace {
+				n.CronJob.Namespace = ""
+			}
+		}
+		if a.MonitorRef != n.MonitorRef {
+			n.MonitorRef.Name = ""
+			n.MonitorRef.Namespace = ""
+		}
+		if a.Team != n.Team {
+			n.Team = ""
+		}
+		if a.Cluster != n.Cluster {
+			n.Cluster = ""
+		}
+		if a.Timestamp.After(n.Timestamp) {
+			n.Timestamp = a.Timestamp
+		}
+		lines = append(lines, fmt.Sprintf("- [%s] %s: %s", a.Severity, a.CronJob, a.Title))
+	}
+	n.Title = fmt.Sprintf("%d alerts (%s)", len(alerts), label)
+	n.Message = strings.Join(lines, "\n")
+	return n
+}
+
+// ungroup drops cleared alerts from the groups they wait in
+func (d *dispatcher) ungroup(keys ...string) {
+	d.groupMu.Lock()
+	defer d.groupMu.Unlock()
+
+	for _, g := range d.groups {
+		g.pending = slices.DeleteFunc(g.pending, func(p groupedAlert) bool {
+			return slices.Contains(keys, p.alert.Key)
+		})
+	}
+}
+
+// stopGroups stops the group timers; pending grouped alerts are not sent
+func (d *dispatcher) stopGroups() {
+	d.groupMu.Lock()
+	defer d.groupMu.Unlock()
+
+	for key, g := range d.groups {
+		g.timer.Stop()
+		delete(d.groups, key)
+	}
+}
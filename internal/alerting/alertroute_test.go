@@ -0,0 +1,316 @@
+package alerting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func testRoute(name string, spec v1alpha1.AlertRouteSpec) *v1alpha1.AlertRoute {
+	return &v1alpha1.AlertRoute{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+}
+
+// routeDispatcher returns a test dispatcher whose client holds the objects
+func routeDispatcher(t *testing.T, objs ...client.Object) *dispatcher {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	d := testDispatcher(newMockStore())
+	d.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	t.Cleanup(d.stopGroups)
+	return d
+}
+
+func TestValidateAlertRoute(t *testing.T) {
+	valid := v1alpha1.AlertRouteSpec{
+		Match: v1alpha1.AlertRouteMatch{
+			Namespaces:      []string{"payments-*"},
+			CronJobSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+			Severities:      []string{"critical"},
+		},
+		ChannelRefs: []v1alpha1.ChannelRef{{Name: "pagerduty"}},
+		Group:       &v1alpha1.AlertGrouping{By: []string{"namespace", "type"}},
+		Inhibit:     []v1alpha1.InhibitRule{{SourceTypes: []string{"SuspendedTooLong"}, Equal: []string{"cronjob"}}},
+	}
+	require.NoError(t, ValidateAlertRoute(valid))
+
+	tests := []struct {
+		name   string
+		modify func(*v1alpha1.AlertRouteSpec)
+		errMsg string
+	}{
+		{"no action", func(s *v1alpha1.AlertRouteSpec) { s.ChannelRefs, s.Inhibit, s.Group = nil, nil, nil }, "at least one of"},
+		{"bad glob", func(s *v1alpha1.AlertRouteSpec) { s.Match.Namespaces = []string{"[team"} }, "namespace pattern"},
+		{"bad selector", func(s *v1alpha1.AlertRouteSpec) {
+			s.Match.CronJobSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}}
+		}, "cronJobSelector"},
+		{"bad severity", func(s *v1alpha1.AlertRouteSpec) { s.Match.Severities = []string{"fatal"} }, "match.severities"},
+		{"bad group by", func(s *v1alpha1.AlertRouteSpec) { s.Group.By = []string{"node"} }, "group.by"},
+		{"bad wait", func(s *v1alpha1.AlertRouteSpec) { s.Group.Wait = &metav1.Duration{} }, "group.wait"},
+		{"group without channels", func(s *v1alpha1.AlertRouteSpec) { s.ChannelRefs = nil }, "group requires channelRefs"},
+		{"empty inhibit source", func(s *v1alpha1.AlertRouteSpec) { s.Inhibit = []v1alpha1.InhibitRule{{}} }, "inhibit[0] requires"},
+		{"bad inhibit equal", func(s *v1alpha1.AlertRouteSpec) { s.Inhibit[0].Equal = []string{"severity"} }, "inhibit[0].equal"},
+		{"bad min sources", func(s *v1alpha1.AlertRouteSpec) { s.Inhibit[0].MinSources = ptr.To[int32](0) }, "inhibit[0].minSources"},
+		{"inhibit only without inhibit", func(s *v1alpha1.AlertRouteSpec) {
+			s.ChannelRefs, s.Group, s.Inhibit, s.OverrideMonitorChannels, s.InhibitOnly = nil, nil, nil, true, true
+		}, "inhibitOnly requires inhibit"},
+		{"inhibit only with channels", func(s *v1alpha1.AlertRouteSpec) { s.InhibitOnly = true }, "inhibitOnly cannot be combined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := *valid.DeepCopy()
+			tt.modify(&spec)
+			err := ValidateAlertRoute(spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestDispatcher_AlertRoute_AddsChannels(t *testing.T) {
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{
+		Name: "billing", Namespace: "payments-eu", Labels: map[string]string{"tier": "gold"},
+	}}
+	route := testRoute("payments", v1alpha1.AlertRouteSpec{
+		Match: v1alpha1.AlertRouteMatch{
+			Namespaces:      []string{"payments-*"},
+			CronJobSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+			Severities:      []string{"critical"},
+		},
+		ChannelRefs: []v1alpha1.ChannelRef{{Name: "pagerduty"}, {Name: "slack-main"}},
+	})
+	d := routeDispatcher(t, cronJob, route)
+	slack := newMockChannel("slack-main", "slack")
+	pagerduty := newMockChannel("pagerduty", "pagerduty")
+	d.channels["slack-main"] = slack
+	d.channels["pagerduty"] = pagerduty
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("payments-eu", "billing", "JobFailed", "critical"), cfg))
+	assert.Len(t, slack.GetSentAlerts(), 1, "monitor and route channels are not sent twice")
+	assert.Len(t, pagerduty.GetSentAlerts(), 1)
+
+	// Warnings do not match the route
+	require.NoError(t, d.Dispatch(ctx, testAlert("payments-eu", "billing", "SLABreached", "warning"), cfg))
+	assert.Len(t, slack.GetSentAlerts(), 2)
+	assert.Len(t, pagerduty.GetSentAlerts(), 1)
+
+	// Other namespaces do not match the route
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "billing", "JobFailed", "critical"), cfg))
+	assert.Len(t, pagerduty.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_AlertRoute_PriorityAndContinue(t *testing.T) {
+	d := routeDispatcher(t,
+		testRoute("catch-all", v1alpha1.AlertRouteSpec{ChannelRefs: []v1alpha1.ChannelRef{{Name: "email"}}}),
+		testRoute("critical", v1alpha1.AlertRouteSpec{
+			Match:       v1alpha1.AlertRouteMatch{Severities: []string{"critical"}},
+			ChannelRefs: []v1alpha1.ChannelRef{{Name: "pagerduty"}},
+			Priority:    ptr.To[int32](10),
+		}),
+	)
+	email := newMockChannel("email", "email")
+	pagerduty := newMockChannel("pagerduty", "pagerduty")
+	d.channels["email"] = email
+	d.channels["pagerduty"] = pagerduty
+
+	ctx := context.Background()
+	cfg := testAlertingConfig()
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "JobFailed", "critical"), cfg))
+	assert.Len(t, pagerduty.GetSentAlerts(), 1)
+	assert.Empty(t, email.GetSentAlerts(), "the higher priority route stops evaluation")
+
+	route := &v1alpha1.AlertRoute{}
+	require.NoError(t, d.client.Get(ctx, client.ObjectKey{Name: "critical"}, route))
+	route.Spec.Continue = true
+	require.NoError(t, d.client.Update(ctx, route))
+
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "JobFailed", "critical"), cfg))
+	assert.Len(t, pagerduty.GetSentAlerts(), 2)
+	assert.Len(t, email.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_AlertRoute_OverrideMonitorChannels(t *testing.T) {
+	d := routeDispatcher(t, testRoute("central", v1alpha1.AlertRouteSpec{
+		ChannelRefs:             []v1alpha1.ChannelRef{{Name: "sre"}},
+		OverrideMonitorChannels: true,
+	}))
+	team := newMockChannel("team", "slack")
+	sre := newMockChannel("sre", "slack")
+	d.channels["team"] = team
+	d.channels["sre"] = sre
+
+	require.NoError(t, d.Dispatch(context.Background(), testAlert("default", "a", "JobFailed", "critical"), testAlertingConfig("team")))
+	assert.Empty(t, team.GetSentAlerts())
+	assert.Len(t, sre.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_AlertRoute_Inhibit(t *testing.T) {
+	d := routeDispatcher(t, testRoute("suspended", v1alpha1.AlertRouteSpec{
+		Match:   v1alpha1.AlertRouteMatch{Types: []string{"DeadManTriggered"}},
+		Inhibit: []v1alpha1.InhibitRule{{SourceTypes: []string{"SuspendedTooLong"}}},
+	}))
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "SuspendedTooLong", "warning"), cfg))
+	require.Len(t, ch.GetSentAlerts(), 1)
+
+	// Muted while the suspension alert of the same CronJob is active
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "DeadManTriggered", "critical"), cfg))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+	assert.Equal(t, int64(1), d.suppressedCount)
+
+	// Other CronJobs are not muted
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "DeadManTriggered", "critical"), cfg))
+	assert.Len(t, ch.GetSentAlerts(), 2)
+
+	// Sent once the suspension alert clears
+	require.NoError(t, d.ClearAlert(ctx, "default/a/SuspendedTooLong"))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "DeadManTriggered", "critical"), cfg))
+	assert.Len(t, ch.GetSentAlerts(), 3)
+}
+
+func TestDispatcher_AlertRoute_InhibitOnlyRouteDoesNotEndRouting(t *testing.T) {
+	d := routeDispatcher(t,
+		testRoute("dead-man-mutes-sla", v1alpha1.AlertRouteSpec{
+			Match:       v1alpha1.AlertRouteMatch{Types: []string{"SLABreached"}},
+			Inhibit:     []v1alpha1.InhibitRule{{SourceTypes: []string{"DeadManTriggered"}}},
+			InhibitOnly: true,
+			Priority:    ptr.To[int32](100),
+		}),
+		testRoute("sre", v1alpha1.AlertRouteSpec{ChannelRefs: []v1alpha1.ChannelRef{{Name: "sre"}}}),
+	)
+	sre := newMockChannel("sre", "slack")
+	d.channels["sre"] = sre
+
+	ctx := context.Background()
+	cfg := testAlertingConfig()
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "SLABreached", "warning"), cfg))
+	assert.Len(t, sre.GetSentAlerts(), 1, "the inhibit-only route does not end routing")
+
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "DeadManTriggered", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "SLABreached", "warning"), cfg))
+	assert.Len(t, sre.GetSentAlerts(), 2, "SLABreached is muted by the dead-man alert of the same CronJob")
+}
+
+func TestDispatcher_AlertRoute_RouteWithoutChannelsEndsRouting(t *testing.T) {
+	d := routeDispatcher(t,
+		testRoute("mute-sla", v1alpha1.AlertRouteSpec{
+			Match:    v1alpha1.AlertRouteMatch{Types: []string{"SLABreached"}},
+			Inhibit:  []v1alpha1.InhibitRule{{SourceTypes: []string{"DeadManTriggered"}}},
+			Priority: ptr.To[int32](100),
+		}),
+		testRoute("sre", v1alpha1.AlertRouteSpec{ChannelRefs: []v1alpha1.ChannelRef{{Name: "sre"}}}),
+	)
+	sre := newMockChannel("sre", "slack")
+	d.channels["sre"] = sre
+
+	require.NoError(t, d.Dispatch(context.Background(), testAlert("default", "b", "SLABreached", "warning"), testAlertingConfig()))
+	assert.Empty(t, sre.GetSentAlerts(), "a matching route ends routing unless it is inhibitOnly")
+}
+
+func TestDispatcher_AlertRoute_InhibitMinSources(t *testing.T) {
+	d := routeDispatcher(t, testRoute("namespace-outage", v1alpha1.AlertRouteSpec{
+		Match: v1alpha1.AlertRouteMatch{Types: []string{"JobFailed"}},
+		Inhibit: []v1alpha1.InhibitRule{{
+			SourceTypes: []string{"JobFailed"},
+			Equal:       []string{"namespace"},
+			MinSources:  ptr.To[int32](2),
+		}},
+	}))
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "c", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "d", "JobFailed", "critical"), cfg))
+	assert.Len(t, ch.GetSentAlerts(), 2, "failures after the second in the namespace are muted")
+
+	other := testAlert("default", "e", "JobFailed", "critical")
+	other.CronJob.Namespace = "billing"
+	other.Key = "billing/e/JobFailed"
+	require.NoError(t, d.Dispatch(ctx, other, cfg))
+	assert.Len(t, ch.GetSentAlerts(), 3)
+}
+
+func TestDispatcher_AlertRoute_Group(t *testing.T) {
+	d := routeDispatcher(t, testRoute("grouped", v1alpha1.AlertRouteSpec{
+		ChannelRefs:             []v1alpha1.ChannelRef{{Name: "slack-main"}},
+		OverrideMonitorChannels: true,
+		Group: &v1alpha1.AlertGrouping{
+			Wait:     &metav1.Duration{Duration: 50 * time.Millisecond},
+			Interval: &metav1.Duration{Duration: 50 * time.Millisecond},
+		},
+	}))
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("team")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "JobFailed", "warning"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "c", "DeadManTriggered", "warning"), cfg))
+	assert.Empty(t, ch.GetSentAlerts(), "grouped alerts wait for the group")
+
+	require.Eventually(t, func() bool { return len(ch.GetSentAlerts()) == 1 }, time.Second, 10*time.Millisecond)
+	sent := ch.GetSentAlerts()[0]
+	assert.Equal(t, groupedAlertType, sent.Type)
+	assert.Equal(t, "critical", sent.Severity)
+	assert.Equal(t, "default", sent.CronJob.Namespace)
+	assert.Empty(t, sent.CronJob.Name)
+	assert.Contains(t, sent.Title, "3 alerts")
+	assert.Contains(t, sent.Message, "- [critical] default/b: Test Alert")
+
+	// Each grouped alert is recorded in history
+	history, _, err := d.store.ListAlertHistory(ctx, store.AlertHistoryQuery{})
+	require.NoError(t, err)
+	assert.Len(t, history, 3)
+
+	// A cleared alert is dropped from the group; a single alert is sent as is
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "d", "JobFailed", "warning"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "e", "JobFailed", "warning"), cfg))
+	require.NoError(t, d.ClearAlert(ctx, "default/e/JobFailed"))
+	require.Eventually(t, func() bool { return len(ch.GetSentAlerts()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "default/d/JobFailed", ch.GetSentAlerts()[1].Key)
+
+	// The group is dropped after an interval without alerts
+	require.Eventually(t, func() bool {
+		d.groupMu.Lock()
+		defer d.groupMu.Unlock()
+		return len(d.groups) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDispatcher_Preview_IncludesRouteChannels(t *testing.T) {
+	d := routeDispatcher(t, testRoute("central", v1alpha1.AlertRouteSpec{
+		ChannelRefs: []v1alpha1.ChannelRef{{Name: "sre"}},
+	}))
+	d.channels["team"] = newMockChannel("team", "slack")
+	d.channels["sre"] = newMockChannel("sre", "slack")
+
+	previews := d.Preview(context.Background(), testAlert("default", "a", "JobFailed", "critical"), testAlertingConfig("team"))
+	require.Len(t, previews, 2)
+	assert.Equal(t, "team", previews[0].Channel)
+	assert.Equal(t, "sre", previews[1].Channel)
+}
@@ -0,0 +1,96 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// alertStateRetention is how long sent alerts are remembered for duplicate
+// suppression, in memory and in the store
+const alertStateRetention = 24 * time.Hour
+
+// persistAlertState saves the suppression state of an alert so it survives
+// restarts and leader failover
+func (d *dispatcher) persistAlertState(ctx context.Context, alert Alert, sentAt time.Time, acknowledgedBy string) {
+	if d.stateStore == nil {
+		return
+	}
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		loggerFor(ctx).Error(err, "failed to encode alert state", "alertKey", alert.Key)
+		return
+	}
+	state := store.AlertState{
+		AlertKey:       alert.Key,
+		Payload:        string(payload),
+		LastSentAt:     sentAt,
+		AcknowledgedBy: acknowledgedBy,
+	}
+	if err := d.stateStore.SaveAlertState(ctx, state); err != nil {
+		loggerFor(ctx).Error(err, "failed to persist alert state", "alertKey", alert.Key)
+	}
+}
+
+// forgetAlertStates removes the persisted suppression state and dedup claims
+// of cleared alerts, so they can fire again
+func (d *dispatcher) forgetAlertStates(ctx context.Context, alertKeys []string) {
+	if d.stateStore == nil || len(alertKeys) == 0 {
+		return
+	}
+	if err := d.stateStore.DeleteAlertStates(ctx, alertKeys); err != nil {
+		loggerFor(ctx).Error(err, "failed to delete alert state", "alertKeys", alertKeys)
+	}
+	if err := d.stateStore.DeleteAlertClaims(ctx, alertKeys); err != nil {
+		loggerFor(ctx).Error(err, "failed to delete alert claims", "alertKeys", alertKeys)
+	}
+}
+
+// ReloadAlertState replaces the in-memory suppression state with the state
+// persisted in the store or shared state, and schedules delayed alerts
+// queued in shared state. Until any state has been persisted (e.g. right
+// after upgrading), unresolved alerts from history are used instead.
+func (d *dispatcher) ReloadAlertState(ctx context.Context) {
+	if d.stateStore == nil {
+		return
+	}
+	logger := loggerFor(ctx)
+	d.restorePendingAlerts(ctx)
+
+	states, err := d.stateStore.ListAlertStates(ctx, time.Now().Add(-alertStateRetention))
+	if err != nil {
+		logger.Error(err, "failed to load alert state, falling back to alert history")
+		d.loadRecentAlerts()
+		return
+	}
+	if len(states) == 0 {
+		d.loadRecentAlerts()
+		return
+	}
+
+	sentAlerts := make(map[string]time.Time, len(states))
+	activeAlerts := make(map[string]Alert, len(states))
+	acknowledged := make(map[string]string)
+	for _, state := range states {
+		sentAlerts[state.AlertKey] = state.LastSentAt
+		var alert Alert
+		if err := json.Unmarshal([]byte(state.Payload), &alert); err == nil {
+			activeAlerts[state.AlertKey] = alert
+		}
+		if state.AcknowledgedBy != "" {
+			acknowledged[state.AlertKey] = state.AcknowledgedBy
+		}
+	}
+
+	d.alertMu.Lock()
+	d.sentAlerts = sentAlerts
+	d.activeAlerts = activeAlerts
+	d.acknowledged = acknowledged
+	d.alertCount24h = int32(len(sentAlerts))
+	d.alertMu.Unlock()
+
+	logger.Info("loaded alert state for duplicate suppression",
+		"count", len(states), "acknowledged", len(acknowledged))
+}
@@ -0,0 +1,171 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// Automation hook phases
+const (
+	HookPhaseBefore = "before"
+	HookPhaseAfter  = "after"
+)
+
+// defaultHookTimeout bounds a hook call without a timeout of its own
+const defaultHookTimeout = 10 * time.Second
+
+// AutomationEvent is the JSON document POSTed to a monitor's automation hooks
+type AutomationEvent struct {
+	// Hook is the name of the called hook
+	Hook string `json:"hook"`
+	// Phase is before or after
+	Phase string       `json:"phase"`
+	Alert AlertPayload `json:"alert"`
+	// Delivery is the result of sending the alert to its channels, set for
+	// after hooks only
+	Delivery *HookDelivery `json:"delivery,omitempty"`
+}
+
+// HookDelivery lists the channels an alert was sent to and failed to be sent to
+type HookDelivery struct {
+	Notified []string `json:"notified"`
+	Failed   []string `json:"failed,omitempty"`
+}
+
+// monitorHooks returns the automation hooks of the alert's monitor that apply
+// to its type. Lookup errors return none: hooks never hold back an alert.
+func (d *dispatcher) monitorHooks(ctx context.Context, alert Alert) []v1alpha1.AutomationHook {
+	c := d.clientFor(alert.Cluster)
+	if c == nil || alert.MonitorRef.Name == "" {
+		return nil
+	}
+	monitor := &v1alpha1.CronJobMonitor{}
+	if err := c.Get(ctx, alert.MonitorRef, monitor); err != nil {
+		return nil
+	}
+	var hooks []v1alpha1.AutomationHook
+	for _, hook := range monitor.Spec.AutomationHooks {
+		if HookApplies(hook, alert.Type) {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+// HookApplies reports whether a hook is called for alerts of a type. Alert
+// types are matched ignoring case, like severity overrides.
+func HookApplies(hook v1alpha1.AutomationHook, alertType string) bool {
+	return len(hook.AlertTypes) == 0 || slices.ContainsFunc(hook.AlertTypes, func(t string) bool {
+		return strings.EqualFold(t, alertType)
+	})
+}
+
+// hookPhase returns when a hook is called
+func hookPhase(hook v1alpha1.AutomationHook) string {
+	if hook.Phase == HookPhaseBefore {
+		return HookPhaseBefore
+	}
+	return HookPhaseAfter
+}
+
+// callHooks calls the hooks of a phase with the alert. Before hooks are
+// called together and waited for, so they answer before the channels are
+// sent to; after hooks are called in the background.
+func (d *dispatcher) callHooks(ctx context.Context, hooks []v1alpha1.AutomationHook, phase string, alert Alert, delivery *HookDelivery) {
+	var wg sync.WaitGroup
+	for _, hook := range hooks {
+		if hookPhase(hook) != phase {
+			continue
+		}
+		event := AutomationEvent{Hook: hook.Name, Phase: phase, Alert: NewAlertPayload(alert), Delivery: delivery}
+		if phase == HookPhaseAfter {
+			go d.callHook(context.WithoutCancel(ctx), alert, hook, event)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.callHook(ctx, alert, hook, event)
+		}()
+	}
+	wg.Wait()
+}
+
+// callHook POSTs an event to a hook and records the result
+func (d *dispatcher) callHook(ctx context.Context, alert Alert, hook v1alpha1.AutomationHook, event AutomationEvent) {
+	timeout := defaultHookTimeout
+	if hook.Timeout != nil && hook.Timeout.Duration > 0 {
+		timeout = hook.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	logger := loggerFor(ctx).WithValues("hook", hook.Name, "phase", event.Phase, "alertId", alert.ID, "alertKey", alert.Key)
+
+	result := "success"
+	if err := d.postHook(ctx, alert, hook, event); err != nil {
+		result = "failed"
+		logger.Error(err, "failed to call automation hook")
+	} else {
+		logger.V(1).Info("called automation hook")
+	}
+	metrics.RecordAutomationHook(alert.MonitorRef.Namespace, alert.MonitorRef.Name, hook.Name, result)
+}
+
+func (d *dispatcher) postHook(ctx context.Context, alert Alert, hook v1alpha1.AutomationHook, event AutomationEvent) error {
+	target := hook.URL
+	if ref := hook.URLSecretRef; ref != nil {
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{Namespace: alert.MonitorRef.Namespace, Name: ref.Name}
+		if err := d.clientFor(alert.Cluster).Get(ctx, key, secret); err != nil {
+			return fmt.Errorf("failed to get hook URL secret: %w", err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return fmt.Errorf("hook URL secret missing '%s' key", ref.Key)
+		}
+		target = strings.TrimSpace(string(value))
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode hook event: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range hook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	httpClient := d.channelHTTP.client
+	if httpClient == nil {
+		httpClient = channelHTTPClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// The URL may embed credentials, so it is left out of the error
+		return fmt.Errorf("hook request failed")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("hook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
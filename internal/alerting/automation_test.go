@@ -0,0 +1,146 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// hookReceiver records the events POSTed to an automation hook
+type hookReceiver struct {
+	mu     sync.Mutex
+	events []AutomationEvent
+	header http.Header
+	// onEvent is called with each event before it is recorded
+	onEvent func(AutomationEvent)
+}
+
+func (h *hookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var event AutomationEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if h.onEvent != nil {
+		h.onEvent(event)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, event)
+	h.header = r.Header.Clone()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *hookReceiver) Events() []AutomationEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]AutomationEvent(nil), h.events...)
+}
+
+// hookDispatcher returns a test dispatcher whose client has a monitor of
+// cron-a with the given hooks
+func hookDispatcher(t *testing.T, hooks []v1alpha1.AutomationHook, objs ...client.Object) *dispatcher {
+	monitor := &v1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cron-a-monitor", Namespace: "default"},
+		Spec:       v1alpha1.CronJobMonitorSpec{AutomationHooks: hooks},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	d := testDispatcher(newMockStore())
+	d.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, monitor)...).Build()
+	return d
+}
+
+func TestDispatcher_AutomationHooks(t *testing.T) {
+	ch := newMockChannel("slack-main", "slack")
+	before := &hookReceiver{}
+	before.onEvent = func(AutomationEvent) {
+		assert.Empty(t, ch.GetSentAlerts(), "before hooks are called before the channels are sent to")
+	}
+	after := &hookReceiver{}
+	beforeSrv, afterSrv := httptest.NewServer(before), httptest.NewServer(after)
+	defer beforeSrv.Close()
+	defer afterSrv.Close()
+
+	d := hookDispatcher(t, []v1alpha1.AutomationHook{
+		{Name: "scale-db", Phase: HookPhaseBefore, URL: beforeSrv.URL, Headers: map[string]string{"X-Source": "guardian"}},
+		{Name: "ticket", URL: afterSrv.URL},
+	})
+	d.channels["slack-main"] = ch
+
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(context.Background(), alert, testAlertingConfig("slack-main")))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+
+	require.Len(t, before.Events(), 1)
+	event := before.Events()[0]
+	assert.Equal(t, "scale-db", event.Hook)
+	assert.Equal(t, HookPhaseBefore, event.Phase)
+	assert.Equal(t, "JobFailed", event.Alert.Type)
+	assert.Equal(t, PayloadResourceRef{Namespace: "default", Name: "cron-a"}, event.Alert.CronJob)
+	assert.Equal(t, PayloadResourceRef{Namespace: "default", Name: "cron-a-monitor"}, event.Alert.Monitor)
+	assert.Nil(t, event.Delivery)
+	assert.Equal(t, "guardian", before.header.Get("X-Source"))
+
+	require.Eventually(t, func() bool { return len(after.Events()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	event = after.Events()[0]
+	assert.Equal(t, "ticket", event.Hook)
+	assert.Equal(t, HookPhaseAfter, event.Phase)
+	require.NotNil(t, event.Delivery)
+	assert.Equal(t, []string{"slack-main"}, event.Delivery.Notified)
+	assert.Empty(t, event.Delivery.Failed)
+}
+
+func TestDispatcher_AutomationHooksWithoutChannels(t *testing.T) {
+	receiver := &hookReceiver{}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "hook-url", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte(srv.URL + "\n")},
+	}
+	d := hookDispatcher(t, []v1alpha1.AutomationHook{{
+		Name:         "restart-db",
+		AlertTypes:   []string{"jobfailed"},
+		Phase:        HookPhaseBefore,
+		URLSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "hook-url"}, Key: "url"},
+	}}, secret)
+
+	ctx := context.Background()
+	cfg := testAlertingConfig()
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+	require.Len(t, receiver.Events(), 1)
+	assert.Equal(t, "restart-db", receiver.Events()[0].Hook)
+
+	// The alert is marked as sent, so duplicates do not call the hook again
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+	assert.Len(t, receiver.Events(), 1)
+
+	// Other alert types are not sent to the hook
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "SLABreached", "warning"), cfg))
+	assert.Len(t, receiver.Events(), 1)
+}
+
+func TestHookApplies(t *testing.T) {
+	assert.True(t, HookApplies(v1alpha1.AutomationHook{}, "JobFailed"))
+	assert.True(t, HookApplies(v1alpha1.AutomationHook{AlertTypes: []string{"JobStuck", "JobFailed"}}, "JobFailed"))
+	assert.True(t, HookApplies(v1alpha1.AutomationHook{AlertTypes: []string{"jobFailed"}}, "JobFailed"))
+	assert.False(t, HookApplies(v1alpha1.AutomationHook{AlertTypes: []string{"JobStuck"}}, "JobFailed"))
+}
@@ -0,0 +1,272 @@
+package alerting
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+// channelHTTPClient sends the requests of channels without a client of their
+// own. Unlike AlertHTTPClient it has no overall or response timeout: the
+// dispatcher bounds each send with the channel's sendTimeout.
+var channelHTTPClient = newChannelHTTPClient(transportOptions{proxy: http.ProxyFromEnvironment})
+
+// transportOptions tune the transport of a channel HTTP client
+type transportOptions struct {
+	proxy             func(*http.Request) (*url.URL, error)
+	rootCAs           *x509.CertPool // nil = system CAs
+	disableKeepAlives bool
+	network           string // Dial network: tcp4 or tcp6 (empty = tcp)
+	nameserver        string // DNS server as host:port (empty = system resolver)
+}
+
+// newChannelHTTPClient returns an HTTP client for alert channels
+func newChannelHTTPClient(opts transportOptions) *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if opts.nameserver != "" {
+		dialer.Resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, opts.nameserver)
+			},
+		}
+	}
+	transport := &http.Transport{
+		Proxy: opts.proxy,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if opts.network != "" {
+				network = opts.network
+			}
+			return dialer.DialContext(ctx, network, addr)
+		},
+		DisableKeepAlives:   opts.disableKeepAlives,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if opts.rootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: opts.rootCAs, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport}
+}
+
+// httpSender is embedded by channels sending HTTP requests, so the
+// dispatcher can give them a client tuned by their spec.http
+type httpSender struct {
+	httpClient *http.Client
+}
+
+func (s *httpSender) do(req *http.Request) (*http.Response, error) {
+	if s.httpClient == nil {
+		return channelHTTPClient.Do(req)
+	}
+	return s.httpClient.Do(req)
+}
+
+// sendClient returns the client requests are sent with, for SDK clients that
+// take an *http.Client instead of sending through do
+func (s *httpSender) sendClient() *http.Client {
+	if s.httpClient == nil {
+		return channelHTTPClient
+	}
+	return s.httpClient
+}
+
+func (s *httpSender) setHTTPClient(c *http.Client) {
+	s.httpClient = c
+}
+
+// httpChannel is implemented by channels embedding httpSender
+type httpChannel interface {
+	setHTTPClient(c *http.Client)
+}
+
+// channelHTTP is the operator's default proxy and CAs for channel requests
+type channelHTTP struct {
+	proxy   func(*http.Request) (*url.URL, error)
+	rootCAs *x509.CertPool // nil = system CAs
+	client  *http.Client   // Client of channels without spec.http
+}
+
+// ValidateChannelHTTP checks the channel HTTP configuration: the proxy URL
+// and the certificates of the CA file
+func ValidateChannelHTTP(cfg config.ChannelHTTPConfig) error {
+	_, err := newChannelHTTP(cfg)
+	return err
+}
+
+// newChannelHTTP reads the channel HTTP configuration. Without a proxy URL
+// the proxy environment variables are used.
+func newChannelHTTP(cfg config.ChannelHTTPConfig) (channelHTTP, error) {
+	h := channelHTTP{proxy: http.ProxyFromEnvironment}
+	if cfg.ProxyURL != "" {
+		u, err := parseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			return h, fmt.Errorf("channel-http.proxy-url: %w", err)
+		}
+		h.proxy = http.ProxyURL(u)
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return h, fmt.Errorf("channel-http.ca-file: %w", err)
+		}
+		if h.rootCAs, err = appendCAs(nil, pem); err != nil {
+			return h, fmt.Errorf("channel-http.ca-file: %w", err)
+		}
+	}
+	h.client = newChannelHTTPClient(transportOptions{proxy: h.proxy, rootCAs: h.rootCAs})
+	return h, nil
+}
+
+// parseProxyURL parses an http, https or socks5 proxy URL
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", raw)
+	}
+	return u, nil
+}
+
+// appendCAs returns a copy of roots (nil = system CAs) with the PEM encoded
+// certificates added
+func appendCAs(roots *x509.CertPool, pem []byte) (*x509.CertPool, error) {
+	var pool *x509.CertPool
+	if roots != nil {
+		pool = roots.Clone()
+	} else if system, err := x509.SystemCertPool(); err == nil {
+		pool = system
+	} else {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificates found")
+	}
+	return pool, nil
+}
+
+// httpClientFor returns the HTTP client for a channel: the operator default,
+// or one tuned by the channel's spec.http
+func (d *dispatcher) httpClientFor(ctx context.Context, ac *v1alpha1.AlertChannel) (*http.Client, error) {
+	spec := ac.Spec.HTTP
+	if spec == nil {
+		return d.channelHTTP.client, nil
+	}
+
+	opts := transportOptions{
+		proxy:             d.channelHTTP.proxy,
+		rootCAs:           d.channelHTTP.rootCAs,
+		disableKeepAlives: spec.DisableKeepAlives,
+	}
+	if opts.proxy == nil {
+		opts.proxy = http.ProxyFromEnvironment
+	}
+	switch {
+	case spec.NoProxy:
+		opts.proxy = nil
+	case spec.ProxyURL != "":
+		u, err := parseProxyURL(spec.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		opts.proxy = http.ProxyURL(u)
+	}
+
+	switch spec.IPFamily {
+	case "":
+	case "IPv4":
+		opts.network = "tcp4"
+	case "IPv6":
+		opts.network = "tcp6"
+	default:
+		return nil, fmt.Errorf("invalid ipFamily %q: must be IPv4 or IPv6", spec.IPFamily)
+	}
+
+	if spec.Nameserver != "" {
+		nameserver, err := nameserverAddress(spec.Nameserver)
+		if err != nil {
+			return nil, err
+		}
+		opts.nameserver = nameserver
+	}
+
+	if spec.CABundle != nil {
+		pem, err := readCABundle(ctx, d.client, spec.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		if opts.rootCAs, err = appendCAs(opts.rootCAs, pem); err != nil {
+			return nil, fmt.Errorf("caBundle: %w", err)
+		}
+	}
+	return newChannelHTTPClient(opts), nil
+}
+
+// nameserverAddress returns the host:port of a DNS server given as host or
+// host:port
+func nameserverAddress(raw string) (string, error) {
+	host, port, err := net.SplitHostPort(raw)
+	if err != nil {
+		// No port; brackets of an IPv6 address are optional then
+		host, port = strings.Trim(raw, "[]"), "53"
+	}
+	if host == "" || strings.ContainsAny(host, "/ ") {
+		return "", fmt.Errorf("invalid nameserver %q: must be host or host:port", raw)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", fmt.Errorf("invalid nameserver %q: bad port", raw)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// readCABundle reads the PEM encoded CA bundle from its Secret or ConfigMap
+func readCABundle(ctx context.Context, c client.Client, ref *v1alpha1.CABundleRef) ([]byte, error) {
+	switch {
+	case ref.SecretRef != nil:
+		value, err := getValueFromSecret(ctx, c, *ref.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("caBundle: %w", err)
+		}
+		return []byte(value), nil
+	case ref.ConfigMapRef != nil:
+		cm := &corev1.ConfigMap{}
+		key := types.NamespacedName{Namespace: ref.ConfigMapRef.Namespace, Name: ref.ConfigMapRef.Name}
+		if err := c.Get(ctx, key, cm); err != nil {
+			return nil, fmt.Errorf("caBundle: failed to get configmap: %w", err)
+		}
+		if value, ok := cm.Data[ref.ConfigMapRef.Key]; ok {
+			return []byte(value), nil
+		}
+		if value, ok := cm.BinaryData[ref.ConfigMapRef.Key]; ok {
+			return value, nil
+		}
+		return nil, fmt.Errorf("caBundle: key %s not found in configmap", ref.ConfigMapRef.Key)
+	default:
+		return nil, fmt.Errorf("caBundle: secretRef or configMapRef is required")
+	}
+}
@@ -0,0 +1,248 @@
+package alerting
+
+import (
+	"context"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+// serverCA returns the PEM encoded certificate of a TLS test server
+func serverCA(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func httpDispatcher(t *testing.T, cfg config.ChannelHTTPConfig, objs ...client.Object) *dispatcher {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	d := testDispatcher(newMockStore())
+	d.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	var err error
+	d.channelHTTP, err = newChannelHTTP(cfg)
+	require.NoError(t, err)
+	return d
+}
+
+func TestValidateChannelHTTP(t *testing.T) {
+	require.NoError(t, ValidateChannelHTTP(config.ChannelHTTPConfig{}))
+	require.NoError(t, ValidateChannelHTTP(config.ChannelHTTPConfig{ProxyURL: "http://proxy.corp.example:3128"}))
+
+	assert.ErrorContains(t, ValidateChannelHTTP(config.ChannelHTTPConfig{ProxyURL: "ftp://proxy"}), "scheme must be")
+	assert.ErrorContains(t, ValidateChannelHTTP(config.ChannelHTTPConfig{ProxyURL: "http://"}), "missing host")
+	assert.ErrorContains(t, ValidateChannelHTTP(config.ChannelHTTPConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")}), "ca-file")
+
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+	assert.ErrorContains(t, ValidateChannelHTTP(config.ChannelHTTPConfig{CAFile: notPEM}), "no PEM encoded certificates")
+}
+
+func TestChannelHTTP_CAFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, serverCA(server), 0o600))
+
+	d := httpDispatcher(t, config.ChannelHTTPConfig{CAFile: caFile})
+	httpClient, err := d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	_, err = channelHTTPClient.Get(server.URL)
+	assert.ErrorContains(t, err, "certificate", "the server is not trusted without the CA file")
+}
+
+func TestChannelHTTP_CABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal-ca", Namespace: "guardian"},
+		Data:       map[string]string{"ca.crt": string(serverCA(server))},
+	}
+	d := httpDispatcher(t, config.ChannelHTTPConfig{}, cm)
+
+	ac := &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: &v1alpha1.ChannelHTTPConfig{
+		CABundle: &v1alpha1.CABundleRef{
+			ConfigMapRef: &v1alpha1.NamespacedConfigMapKeyRef{Name: "internal-ca", Namespace: "guardian", Key: "ca.crt"},
+		},
+	}}}
+	httpClient, err := d.httpClientFor(context.Background(), ac)
+	require.NoError(t, err)
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	ac.Spec.HTTP.CABundle.ConfigMapRef.Key = "missing"
+	_, err = d.httpClientFor(context.Background(), ac)
+	assert.ErrorContains(t, err, "key missing not found in configmap")
+}
+
+func TestChannelHTTP_Proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.Host)
+	}))
+	defer proxy.Close()
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer target.Close()
+
+	d := httpDispatcher(t, config.ChannelHTTPConfig{ProxyURL: proxy.URL})
+	get := func(spec *v1alpha1.ChannelHTTPConfig, url string) {
+		t.Helper()
+		httpClient, err := d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: spec}})
+		require.NoError(t, err)
+		resp, err := httpClient.Get(url)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	get(nil, "http://hooks.example.com/alert")
+	assert.Equal(t, []string{"hooks.example.com"}, proxied, "the operator proxy is the default")
+
+	get(&v1alpha1.ChannelHTTPConfig{NoProxy: true}, target.URL)
+	assert.Len(t, proxied, 1, "noProxy bypasses the operator proxy")
+
+	channelProxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, "channel:"+r.Host)
+	}))
+	defer channelProxy.Close()
+	get(&v1alpha1.ChannelHTTPConfig{ProxyURL: channelProxy.URL}, "http://hooks.example.com/alert")
+	assert.Equal(t, []string{"hooks.example.com", "channel:hooks.example.com"}, proxied)
+}
+
+func TestDispatcher_RegisterChannel_HTTPClient(t *testing.T) {
+	d := httpDispatcher(t, config.ChannelHTTPConfig{})
+	ac := &v1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "hook"},
+		Spec: v1alpha1.AlertChannelSpec{
+			Type: "webhook",
+			Webhook: &v1alpha1.WebhookConfig{
+				URLSecretRef: v1alpha1.NamespacedSecretKeyRef{Name: "hook", Namespace: "guardian", Key: "url"},
+			},
+			HTTP: &v1alpha1.ChannelHTTPConfig{
+				CABundle: &v1alpha1.CABundleRef{
+					SecretRef: &v1alpha1.NamespacedSecretKeyRef{Name: "missing-ca", Namespace: "guardian", Key: "ca.crt"},
+				},
+			},
+		},
+	}
+	assert.ErrorContains(t, d.RegisterChannel(ac), "caBundle")
+
+	ac.Spec.HTTP = &v1alpha1.ChannelHTTPConfig{ProxyURL: "http://proxy.corp.example:3128"}
+	require.NoError(t, d.RegisterChannel(ac))
+	assert.NotNil(t, d.channels["hook"].(*webhookChannel).httpClient)
+}
+
+func TestNameserverAddress(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.10":        "10.0.0.10:53",
+		"10.0.0.10:5353":   "10.0.0.10:5353",
+		"dns.corp.example": "dns.corp.example:53",
+		"fd00::10":         "[fd00::10]:53",
+		"[fd00::10]:5353":  "[fd00::10]:5353",
+	}
+	for raw, want := range tests {
+		got, err := nameserverAddress(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, got, raw)
+	}
+
+	for _, raw := range []string{"", "10.0.0.10:dns", "10.0.0.10:70000", "dns server"} {
+		_, err := nameserverAddress(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestChannelHTTP_Transport(t *testing.T) {
+	d := httpDispatcher(t, config.ChannelHTTPConfig{})
+	httpClient, err := d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: &v1alpha1.ChannelHTTPConfig{
+		DisableKeepAlives: true,
+	}}})
+	require.NoError(t, err)
+	assert.True(t, httpClient.Transport.(*http.Transport).DisableKeepAlives)
+
+	_, err = d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: &v1alpha1.ChannelHTTPConfig{
+		IPFamily: "IPv5",
+	}}})
+	assert.ErrorContains(t, err, "ipFamily")
+}
+
+func TestChannelHTTP_IPFamily(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	url := "http://localhost:" + port
+
+	d := httpDispatcher(t, config.ChannelHTTPConfig{})
+	get := func(family string) error {
+		httpClient, err := d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: &v1alpha1.ChannelHTTPConfig{
+			NoProxy:  true,
+			IPFamily: family,
+		}}})
+		require.NoError(t, err)
+		resp, err := httpClient.Get(url)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, get("IPv4"), "the test server listens on 127.0.0.1")
+	assert.Error(t, get("IPv6"), "IPv6 connections do not reach the IPv4 test server")
+}
+
+func TestChannelHTTP_Nameserver(t *testing.T) {
+	dns, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = dns.Close() }()
+	queried := make(chan struct{}, 1)
+	go func() {
+		buf := make([]byte, 512)
+		if _, _, err := dns.ReadFrom(buf); err == nil {
+			queried <- struct{}{}
+		}
+	}()
+
+	d := httpDispatcher(t, config.ChannelHTTPConfig{})
+	httpClient, err := d.httpClientFor(context.Background(), &v1alpha1.AlertChannel{Spec: v1alpha1.AlertChannelSpec{HTTP: &v1alpha1.ChannelHTTPConfig{
+		NoProxy:    true,
+		Nameserver: dns.LocalAddr().String(),
+	}}})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://hooks.corp.example/alert", nil)
+	require.NoError(t, err)
+	_, err = httpClient.Do(req)
+	assert.Error(t, err, "the test nameserver never answers")
+
+	select {
+	case <-queried:
+	case <-time.After(time.Second):
+		t.Fatal("the channel's nameserver was not queried")
+	}
+}
@@ -2,10 +2,17 @@ package alerting
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 // createTestSecret creates a Secret for testing
@@ -52,6 +60,7 @@ func createTestAlertChannel(name, chanType string) *v1alpha1.AlertChannel {
 // createTestAlertForChannel creates an Alert for channel testing
 func createTestAlertForChannel() Alert {
 	return Alert{
+		ID:       "6f1c2a64-59d4-4f34-9d8e-1b0f6a2c7e51",
 		Key:      "test/cronjob/JobFailed",
 		Type:     "JobFailed",
 		Severity: "critical",
@@ -63,6 +72,7 @@ func createTestAlertForChannel() Alert {
 			Name:      "monitor",
 		},
 		Context: AlertContext{
+			JobName:      "cronjob-29384720",
 			ExitCode:     137,
 			Reason:       "OOMKilled",
 			SuggestedFix: "Increase memory limits",
@@ -256,6 +266,109 @@ func TestSlackChannel_DefaultTemplate(t *testing.T) {
 	assert.Contains(t, receivedBody, "Suggested Fix")
 }
 
+func TestSlackChannel_InteractiveBlocks(t *testing.T) {
+	var payload struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type     string `json:"type"`
+			Elements []struct {
+				ActionID string `json:"action_id"`
+				Value    string `json:"value"`
+				URL      string `json:"url"`
+			} `json:"elements"`
+		} `json:"blocks"`
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "slack-webhook", "url", server.URL)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("slack-test", "slack")
+	ac.Spec.Slack = &v1alpha1.SlackConfig{
+		WebhookSecretRef: v1alpha1.NamespacedSecretKeyRef{
+			Namespace: "default",
+			Name:      "slack-webhook",
+			Key:       "url",
+		},
+		Interactive: true,
+	}
+
+	ch, err := NewSlackChannel(fakeClient, ac)
+	require.NoError(t, err)
+	ch.(dashboardChannel).setDashboardURL("https://guardian.example.com/")
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Contains(t, payload.Text, "Job Failed", "text is kept as the notification fallback")
+	require.Len(t, payload.Blocks, 2)
+	assert.Equal(t, "section", payload.Blocks[0].Type)
+	assert.Equal(t, "actions", payload.Blocks[1].Type)
+
+	elements := payload.Blocks[1].Elements
+	require.Len(t, elements, 4)
+	assert.Equal(t, SlackActionAcknowledge, elements[0].ActionID)
+	assert.Equal(t, SlackActionRetry, elements[1].ActionID)
+	assert.Equal(t, SlackActionSuspend, elements[2].ActionID)
+	assert.Equal(t, SlackActionViewDashboard, elements[3].ActionID)
+	assert.Equal(t, "https://guardian.example.com/cronjob/test/cronjob", elements[3].URL)
+
+	var value SlackActionValue
+	require.NoError(t, json.Unmarshal([]byte(elements[0].Value), &value))
+	assert.Equal(t, SlackActionValue{AlertKey: "test/cronjob/JobFailed", Namespace: "test", Name: "cronjob"}, value)
+
+	alert := createTestAlertForChannel()
+	alert.RunbookURL = "https://runbooks.example.com/cronjob"
+	require.NoError(t, ch.Send(context.Background(), alert))
+	assert.Contains(t, payload.Text, "<https://runbooks.example.com/cronjob>")
+	elements = payload.Blocks[1].Elements
+	require.Len(t, elements, 5)
+	assert.Equal(t, SlackActionOpenRunbook, elements[4].ActionID)
+	assert.Equal(t, "https://runbooks.example.com/cronjob", elements[4].URL)
+}
+
+func TestSlackChannel_NotInteractiveByDefault(t *testing.T) {
+	var receivedBody string
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				receivedBody = string(body)
+				w.WriteHeader(http.StatusOK)
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "slack-webhook", "url", server.URL)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("slack-test", "slack")
+	ac.Spec.Slack = &v1alpha1.SlackConfig{
+		WebhookSecretRef: v1alpha1.NamespacedSecretKeyRef{
+			Namespace: "default",
+			Name:      "slack-webhook",
+			Key:       "url",
+		},
+	}
+
+	ch, err := NewSlackChannel(fakeClient, ac)
+	require.NoError(t, err)
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.NotContains(t, receivedBody, "blocks")
+}
+
 func TestSlackChannel_Test(t *testing.T) {
 	called := false
 	server := httptest.NewServer(
@@ -427,6 +540,11 @@ func TestWebhookChannel_Send_POST(t *testing.T) {
 
 	assert.Equal(t, "POST", receivedMethod)
 	assert.Contains(t, receivedBody, "JobFailed")
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(receivedBody), &payload))
+	assert.Equal(t, alert.ID, payload["id"])
+	assert.Equal(t, "cronjob-29384720", payload["context"].(map[string]interface{})["job_name"])
 }
 
 func TestWebhookChannel_Send_PUT(t *testing.T) {
@@ -786,6 +904,54 @@ func TestPagerDutyChannel_NameType(t *testing.T) {
 	assert.Equal(t, "pagerduty", ch.Type())
 }
 
+func TestPagerDutyChannel_SendAndResolve(t *testing.T) {
+	var events []map[string]interface{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				var event map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&event)
+				events = append(events, event)
+				w.WriteHeader(http.StatusAccepted)
+			},
+		),
+	)
+	defer server.Close()
+	oldURL := pagerDutyEventsURL
+	pagerDutyEventsURL = server.URL
+	t.Cleanup(func() { pagerDutyEventsURL = oldURL })
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(createTestSecret("default", "pd-routing-key", "key", "routing-123")).Build()
+	ac := createTestAlertChannel("pagerduty-test", "pagerduty")
+	ac.Spec.PagerDuty = &v1alpha1.PagerDutyConfig{
+		RoutingKeySecretRef: v1alpha1.NamespacedSecretKeyRef{Namespace: "default", Name: "pd-routing-key", Key: "key"},
+	}
+	ch, err := NewPagerDutyChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	alert := createTestAlertForChannel()
+	alert.Severity = "warning"
+	require.NoError(t, ch.Send(ctx, alert))
+	require.NoError(t, ch.(Resolver).Resolve(ctx, Alert{Key: alert.Key}))
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "trigger", events[0]["event_action"])
+	assert.Equal(t, "test/cronjob/JobFailed", events[0]["dedup_key"])
+	assert.Equal(t, "warning", events[0]["payload"].(map[string]interface{})["severity"])
+	details := events[0]["payload"].(map[string]interface{})["custom_details"].(map[string]interface{})
+	assert.Equal(t, alert.ID, details["alert_id"])
+	assert.Equal(t, "cronjob-29384720", details["job_name"])
+	assert.Equal(t, map[string]interface{}{
+		"routing_key":  "routing-123",
+		"event_action": "resolve",
+		"dedup_key":    "test/cronjob/JobFailed",
+	}, events[1])
+}
+
 func TestPagerDutyChannel_MissingConfig(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
@@ -798,6 +964,693 @@ func TestPagerDutyChannel_MissingConfig(t *testing.T) {
 	assert.Contains(t, err.Error(), "pagerduty config required")
 }
 
+// ==================== Telegram Channel Tests ====================
+
+// newTestTelegramChannel creates a Telegram channel whose Bot API calls go to serverURL
+func newTestTelegramChannel(t *testing.T, serverURL string, cfg *v1alpha1.TelegramConfig) Channel {
+	t.Helper()
+	oldURL := telegramAPIURL
+	telegramAPIURL = serverURL
+	t.Cleanup(func() { telegramAPIURL = oldURL })
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "telegram", TelegramBotTokenKey, "123:abc")
+	secret.Data[TelegramChatIDKey] = []byte("-100987")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("telegram-test", "telegram")
+	cfg.SecretRef = v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "telegram"}
+	ac.Spec.Telegram = cfg
+
+	ch, err := NewTelegramChannel(fakeClient, ac)
+	require.NoError(t, err)
+	return ch
+}
+
+func TestTelegramChannel_Send_Success(t *testing.T) {
+	var path string
+	var payload map[string]interface{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestTelegramChannel(t, server.URL, &v1alpha1.TelegramConfig{})
+	alert := createTestAlertForChannel()
+	alert.Message = "Job test-cron-123 failed (exit 137)."
+
+	require.NoError(t, ch.Send(context.Background(), alert))
+
+	assert.Equal(t, "/bot123:abc/sendMessage", path)
+	assert.Equal(t, "-100987", payload["chat_id"])
+	assert.Equal(t, "MarkdownV2", payload["parse_mode"])
+	assert.Equal(t, false, payload["disable_notification"])
+	text := payload["text"].(string)
+	assert.Contains(t, text, `Job test\-cron\-123 failed \(exit 137\)\.`)
+	assert.Contains(t, text, "`test/cronjob`")
+	assert.Contains(t, text, "```\nError: Out of memory\n```")
+}
+
+func TestTelegramChannel_SilentInfo(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestTelegramChannel(t, server.URL, &v1alpha1.TelegramConfig{SilentInfo: true})
+
+	alert := createTestAlertForChannel()
+	require.NoError(t, ch.Send(context.Background(), alert))
+	assert.Equal(t, false, payload["disable_notification"], "critical alerts must notify")
+
+	alert.Severity = "info"
+	require.NoError(t, ch.Send(context.Background(), alert))
+	assert.Equal(t, true, payload["disable_notification"])
+}
+
+func TestTelegramChannel_Send_APIError(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"ok":false,"description":"Bad Request: chat not found"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestTelegramChannel(t, server.URL, &v1alpha1.TelegramConfig{})
+
+	err := ch.Send(context.Background(), createTestAlertForChannel())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chat not found")
+	assert.NotContains(t, err.Error(), "123:abc", "bot token must not leak into errors")
+}
+
+func TestTelegramChannel_MissingSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ac := createTestAlertChannel("telegram-test", "telegram")
+	ac.Spec.Telegram = &v1alpha1.TelegramConfig{
+		SecretRef: v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "nonexistent"},
+	}
+
+	ch, err := NewTelegramChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	err = ch.Send(context.Background(), createTestAlertForChannel())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "secret")
+}
+
+func TestTelegramChannel_MissingConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ac := createTestAlertChannel("telegram-test", "telegram")
+
+	_, err := NewTelegramChannel(fakeClient, ac)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "telegram config required")
+}
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	assert.Equal(t, `a\_b\*c\.d\!`, escapeMarkdownV2("a_b*c.d!"))
+	assert.Equal(t, "x\\`y", escapeMarkdownV2Code("x`y"))
+}
+
+// ==================== ntfy Channel Tests ====================
+
+func TestNtfyChannel_Send_Success(t *testing.T) {
+	var auth string
+	var payload map[string]interface{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				auth = r.Header.Get("Authorization")
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				_, _ = w.Write([]byte(`{"id":"abc"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "ntfy", NtfyTokenKey, "tk_secret\n")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("ntfy-test", "ntfy")
+	ac.Spec.Ntfy = &v1alpha1.NtfyConfig{
+		ServerURL:     server.URL + "/",
+		Topic:         "guardian-alerts",
+		AuthSecretRef: &v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "ntfy"},
+	}
+	ch, err := NewNtfyChannel(fakeClient, ac)
+	require.NoError(t, err)
+	ch.(dashboardChannel).setDashboardURL("https://guardian.example.com/")
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Equal(t, "Bearer tk_secret", auth)
+	assert.Equal(t, "guardian-alerts", payload["topic"])
+	assert.Equal(t, "Job Failed", payload["title"])
+	assert.Equal(t, float64(5), payload["priority"])
+	assert.Equal(t, []interface{}{"rotating_light", "JobFailed"}, payload["tags"])
+	assert.Equal(t, "https://guardian.example.com/cronjob/test/cronjob", payload["click"])
+	message := payload["message"].(string)
+	assert.Contains(t, message, "CronJob: test/cronjob")
+	assert.Contains(t, message, "Exit code: 137")
+	assert.Contains(t, message, "Error: Out of memory")
+}
+
+func TestNtfyChannel_BasicAuthAndErrors(t *testing.T) {
+	var user, pass string
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				user, pass, _ = r.BasicAuth()
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"code":40301,"http":403,"error":"forbidden"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "ntfy", NtfyUsernameKey, "guardian")
+	secret.Data[NtfyPasswordKey] = []byte("s3cr3t")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("ntfy-test", "ntfy")
+	ac.Spec.Ntfy = &v1alpha1.NtfyConfig{
+		ServerURL:     server.URL,
+		Topic:         "guardian-alerts",
+		AuthSecretRef: &v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "ntfy"},
+	}
+	ch, err := NewNtfyChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	err = ch.Send(context.Background(), createTestAlertForChannel())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ntfy returned status 403: forbidden")
+	assert.Equal(t, "guardian", user)
+	assert.Equal(t, "s3cr3t", pass)
+}
+
+func TestNtfyChannel_MissingConfig(t *testing.T) {
+	_, err := NewNtfyChannel(nil, createTestAlertChannel("ntfy-test", "ntfy"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ntfy config required")
+}
+
+// ==================== Pushover Channel Tests ====================
+
+// newTestPushoverChannel creates a Pushover channel whose API calls go to serverURL
+func newTestPushoverChannel(t *testing.T, serverURL string, cfg *v1alpha1.PushoverConfig) Channel {
+	t.Helper()
+	oldURL := pushoverAPIURL
+	pushoverAPIURL = serverURL
+	t.Cleanup(func() { pushoverAPIURL = oldURL })
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "pushover", PushoverAppTokenKey, "app123")
+	secret.Data[PushoverUserKeyKey] = []byte("user456")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("pushover-test", "pushover")
+	cfg.SecretRef = v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "pushover"}
+	ac.Spec.Pushover = cfg
+
+	ch, err := NewPushoverChannel(fakeClient, ac)
+	require.NoError(t, err)
+	return ch
+}
+
+func TestPushoverChannel_Send_Success(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				form = r.PostForm
+				_, _ = w.Write([]byte(`{"status":1,"request":"abc"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestPushoverChannel(t, server.URL, &v1alpha1.PushoverConfig{
+		Devices: []string{"phone", "tablet"},
+		Sound:   "siren",
+	})
+	ch.(dashboardChannel).setDashboardURL("https://guardian.example.com")
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Equal(t, "app123", form.Get("token"))
+	assert.Equal(t, "user456", form.Get("user"))
+	assert.Equal(t, "Job Failed", form.Get("title"))
+	assert.Equal(t, "1", form.Get("priority"))
+	assert.Empty(t, form.Get("retry"))
+	assert.Equal(t, "phone,tablet", form.Get("device"))
+	assert.Equal(t, "siren", form.Get("sound"))
+	assert.Equal(t, "https://guardian.example.com/cronjob/test/cronjob", form.Get("url"))
+	assert.Contains(t, form.Get("message"), "Reason: OOMKilled")
+
+	alert := createTestAlertForChannel()
+	alert.Severity = "info"
+	alert.Context.Logs = strings.Repeat("x", 2000)
+	require.NoError(t, ch.Send(context.Background(), alert))
+	assert.Equal(t, "-1", form.Get("priority"))
+	assert.LessOrEqual(t, len(form.Get("message")), 1024)
+}
+
+func TestPushoverChannel_EmergencyCritical(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				form = r.PostForm
+				_, _ = w.Write([]byte(`{"status":1,"receipt":"r1"}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestPushoverChannel(t, server.URL, &v1alpha1.PushoverConfig{EmergencyCritical: true})
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+	assert.Equal(t, "2", form.Get("priority"))
+	assert.Equal(t, "300", form.Get("retry"))
+	assert.Equal(t, "3600", form.Get("expire"))
+
+	alert := createTestAlertForChannel()
+	alert.Severity = "warning"
+	require.NoError(t, ch.Send(context.Background(), alert))
+	assert.Equal(t, "0", form.Get("priority"))
+}
+
+func TestPushoverChannel_Send_APIError(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"user":"invalid","errors":["user identifier is invalid"],"status":0}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	ch := newTestPushoverChannel(t, server.URL, &v1alpha1.PushoverConfig{})
+
+	err := ch.Send(context.Background(), createTestAlertForChannel())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user identifier is invalid")
+}
+
+func TestPushoverChannel_Preview_RedactsCredentials(t *testing.T) {
+	ch := newTestPushoverChannel(t, "http://unused", &v1alpha1.PushoverConfig{})
+
+	preview, err := ch.(Previewer).Preview(context.Background(), createTestAlertForChannel())
+	require.NoError(t, err)
+	assert.Contains(t, preview.Payload, "token="+redacted)
+	assert.Contains(t, preview.Payload, "user="+redacted)
+	assert.NotContains(t, preview.Payload, "app123")
+}
+
+// ==================== Cloud Pub-Sub Channel Tests ====================
+
+func TestSNSChannel_Send_Success(t *testing.T) {
+	var form url.Values
+	var auth string
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				auth = r.Header.Get("Authorization")
+				_ = r.ParseForm()
+				form = r.PostForm
+				w.Header().Set("Content-Type", "text/xml")
+				_, _ = w.Write([]byte(`<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult></PublishResponse>`))
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "aws", AWSAccessKeyIDKey, "AKIDEXAMPLE")
+	secret.Data[AWSSecretAccessKeyKey] = []byte("secret")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("sns-test", "sns")
+	ac.Spec.SNS = &v1alpha1.SNSConfig{
+		TopicARN:             "arn:aws:sns:eu-west-1:123456789012:alerts.fifo",
+		CredentialsSecretRef: v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "aws"},
+		Endpoint:             server.URL,
+	}
+	ch, err := NewSNSChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Contains(t, auth, "Credential=AKIDEXAMPLE/")
+	assert.Contains(t, auth, "/eu-west-1/sns/aws4_request")
+	assert.Equal(t, "Publish", form.Get("Action"))
+	assert.Equal(t, "arn:aws:sns:eu-west-1:123456789012:alerts.fifo", form.Get("TopicArn"))
+	attrs := map[string]string{}
+	for i := 1; form.Get(fmt.Sprintf("MessageAttributes.entry.%d.Name", i)) != ""; i++ {
+		prefix := fmt.Sprintf("MessageAttributes.entry.%d.", i)
+		attrs[form.Get(prefix+"Name")] = form.Get(prefix + "Value.StringValue")
+	}
+	assert.Equal(t, "JobFailed", attrs["type"])
+	assert.Equal(t, "critical", attrs["severity"])
+	assert.Equal(t, "test/cronjob", form.Get("MessageGroupId"))
+	assert.NotEmpty(t, form.Get("MessageDeduplicationId"))
+
+	var msg AlertPayload
+	require.NoError(t, json.Unmarshal([]byte(form.Get("Message")), &msg))
+	assert.Equal(t, "test/cronjob/JobFailed", msg.Key)
+	assert.Equal(t, "cronjob", msg.CronJob.Name)
+	assert.Equal(t, int32(137), msg.Context.ExitCode)
+}
+
+func TestSNSChannel_InvalidTopicARN(t *testing.T) {
+	ac := createTestAlertChannel("sns-test", "sns")
+	ac.Spec.SNS = &v1alpha1.SNSConfig{TopicARN: "arn:aws:sqs:eu-west-1:123456789012:queue"}
+
+	_, err := NewSNSChannel(nil, ac)
+	assert.Error(t, err)
+}
+
+func TestSNSChannel_MissingSecretKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "aws", AWSAccessKeyIDKey, "AKIDEXAMPLE")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("sns-test", "sns")
+	ac.Spec.SNS = &v1alpha1.SNSConfig{
+		TopicARN:             "arn:aws:sns:us-east-1:123456789012:alerts",
+		CredentialsSecretRef: v1alpha1.NamespacedSecretRef{Namespace: "default", Name: "aws"},
+	}
+	ch, err := NewSNSChannel(fakeClient, ac)
+	require.NoError(t, err)
+	assert.Empty(t, ch.(*snsChannel).endpoint, "the SDK resolves the endpoint from the region")
+
+	err = ch.Send(context.Background(), createTestAlertForChannel())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), AWSSecretAccessKeyKey)
+}
+
+// testServiceAccountJSON returns a service account key whose token_uri is tokenURI
+func testServiceAccountJSON(t *testing.T, tokenURI string) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	sa, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "guardian@project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    tokenURI,
+	})
+	require.NoError(t, err)
+	return string(sa)
+}
+
+func TestPubSubChannel_Send_Success(t *testing.T) {
+	tokenRequests := 0
+	var publishPath, auth string
+	var body struct {
+		Messages []struct {
+			Data       string            `json:"data"`
+			Attributes map[string]string `json:"attributes"`
+		} `json:"messages"`
+	}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/token" {
+					tokenRequests++
+					_ = r.ParseForm()
+					assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+					assert.Len(t, strings.Split(r.PostForm.Get("assertion"), "."), 3)
+					_, _ = w.Write([]byte(`{"access_token":"ya29.token","expires_in":3600}`))
+					return
+				}
+				publishPath = r.URL.Path
+				auth = r.Header.Get("Authorization")
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				_, _ = w.Write([]byte(`{"messageIds":["1"]}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "gcp", "credentials.json", testServiceAccountJSON(t, server.URL+"/token"))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("pubsub-test", "pubsub")
+	ac.Spec.PubSub = &v1alpha1.PubSubConfig{
+		Topic: "projects/my-project/topics/guardian-alerts",
+		CredentialsSecretRef: v1alpha1.NamespacedSecretKeyRef{
+			Namespace: "default", Name: "gcp", Key: "credentials.json",
+		},
+		Endpoint: server.URL,
+	}
+	ch, err := NewPubSubChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Equal(t, 1, tokenRequests, "access token should be cached")
+	assert.Equal(t, "/v1/projects/my-project/topics/guardian-alerts:publish", publishPath)
+	assert.Equal(t, "Bearer ya29.token", auth)
+	require.Len(t, body.Messages, 1)
+	assert.Equal(t, "critical", body.Messages[0].Attributes["severity"])
+
+	data, err := base64.StdEncoding.DecodeString(body.Messages[0].Data)
+	require.NoError(t, err)
+	var msg AlertPayload
+	require.NoError(t, json.Unmarshal(data, &msg))
+	assert.Equal(t, "JobFailed", msg.Type)
+}
+
+func TestParseServiceAccountKey_Invalid(t *testing.T) {
+	_, err := ParseServiceAccountKey([]byte(`not json`))
+	assert.Error(t, err)
+
+	_, err = ParseServiceAccountKey([]byte(`{"client_email":"a@b.c","private_key":"nope"}`))
+	assert.Error(t, err)
+}
+
+func TestEventGridChannel_Send_Success(t *testing.T) {
+	var key, apiVersion string
+	var events []map[string]interface{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				key = r.Header.Get("aeg-sas-key")
+				apiVersion = r.URL.Query().Get("api-version")
+				_ = json.NewDecoder(r.Body).Decode(&events)
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "eventgrid", "access-key", "sas-key")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("eventgrid-test", "eventgrid")
+	ac.Spec.EventGrid = &v1alpha1.EventGridConfig{
+		TopicEndpoint:      server.URL + "/api/events",
+		AccessKeySecretRef: v1alpha1.NamespacedSecretKeyRef{Namespace: "default", Name: "eventgrid", Key: "access-key"},
+	}
+	ch, err := NewEventGridChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	require.NoError(t, ch.Send(context.Background(), createTestAlertForChannel()))
+
+	assert.Equal(t, "sas-key", key)
+	assert.Equal(t, eventGridAPIVersion, apiVersion)
+	require.Len(t, events, 1)
+	assert.Equal(t, "CronJobGuardian.JobFailed", events[0]["eventType"])
+	assert.Equal(t, "namespaces/test/cronjobs/cronjob", events[0]["subject"])
+	assert.Equal(t, "1.0", events[0]["dataVersion"])
+	assert.NotEmpty(t, events[0]["id"])
+	data := events[0]["data"].(map[string]interface{})
+	assert.Equal(t, "test/cronjob/JobFailed", data["key"])
+}
+
+func TestEventGridChannel_Send_Error(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"message":"Invalid key"}}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	secret := createTestSecret("default", "eventgrid", "access-key", "wrong")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	ac := createTestAlertChannel("eventgrid-test", "eventgrid")
+	ac.Spec.EventGrid = &v1alpha1.EventGridConfig{
+		TopicEndpoint:      server.URL,
+		AccessKeySecretRef: v1alpha1.NamespacedSecretKeyRef{Namespace: "default", Name: "eventgrid", Key: "access-key"},
+	}
+	ch, err := NewEventGridChannel(fakeClient, ac)
+	require.NoError(t, err)
+
+	err = ch.Send(context.Background(), createTestAlertForChannel())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	assert.Contains(t, err.Error(), "Invalid key")
+}
+
+// ==================== Email Channel Tests ====================
+
+func newTestEmailChannel(t *testing.T, st store.Store, cfg *v1alpha1.EmailConfig) *emailChannel {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ac := createTestAlertChannel("email-test", "email")
+	cfg.SMTPSecretRef = v1alpha1.NamespacedSecretRef{Name: "smtp", Namespace: "default"}
+	cfg.From = "guardian@example.com"
+	cfg.To = []string{"ops@example.com"}
+	ac.Spec.Email = cfg
+
+	ch, err := NewEmailChannel(fakeClient, st, ac)
+	require.NoError(t, err)
+	return ch.(*emailChannel)
+}
+
+func TestEmailChannel_TextBodyByDefault(t *testing.T) {
+	ec := newTestEmailChannel(t, nil, &v1alpha1.EmailConfig{})
+
+	subject, body, err := ec.renderAlert(context.Background(), createTestAlertForChannel())
+	require.NoError(t, err)
+
+	assert.Equal(t, "[CRITICAL] Job Failed", subject)
+	assert.Contains(t, body, "CronJob: test/cronjob")
+	assert.NotContains(t, body, "<html>")
+}
+
+func TestEmailChannel_HTMLBody(t *testing.T) {
+	st := &mockStore{executions: []store.Execution{
+		{JobName: "cronjob-29000001", StartTime: time.Now().Add(-time.Hour), ExitCode: 137, Reason: "OOMKilled"},
+		{JobName: "cronjob-29000000", StartTime: time.Now().Add(-2 * time.Hour), ExitCode: 1, Reason: "Error", Classification: "db-timeout"},
+	}}
+	ec := newTestEmailChannel(t, st, &v1alpha1.EmailConfig{Format: "html"})
+	ec.setDashboardURL("https://guardian.example.com/")
+
+	alert := createTestAlertForChannel()
+	alert.Message = "Job <failed> & exited"
+	_, body, err := ec.renderAlert(context.Background(), alert)
+	require.NoError(t, err)
+
+	assert.Contains(t, body, "<html>")
+	assert.Contains(t, body, "Job &lt;failed&gt; &amp; exited", "values must be HTML-escaped")
+	assert.Contains(t, body, "Recent failures")
+	assert.Contains(t, body, "cronjob-29000001")
+	assert.Contains(t, body, "Error (db-timeout)")
+	assert.Contains(t, body, `href="https://guardian.example.com/cronjob/test/cronjob"`)
+}
+
+func TestEmailChannel_HTMLBodyWithoutStore(t *testing.T) {
+	ec := newTestEmailChannel(t, nil, &v1alpha1.EmailConfig{Format: "html"})
+
+	_, body, err := ec.renderAlert(context.Background(), createTestAlertForChannel())
+	require.NoError(t, err)
+
+	assert.NotContains(t, body, "Recent failures")
+	assert.NotContains(t, body, "View in dashboard")
+}
+
+func TestEmailChannel_DigestModeSkipsAlerts(t *testing.T) {
+	ec := newTestEmailChannel(t, nil, &v1alpha1.EmailConfig{
+		Digest: &v1alpha1.EmailDigestConfig{Schedule: "daily"},
+	})
+
+	// No SMTP secret exists, so a real send would fail
+	assert.NoError(t, ec.Send(context.Background(), createTestAlertForChannel()))
+	assert.Error(t, ec.Test(context.Background()))
+}
+
+func TestEmailChannel_InvalidDigest(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ac := createTestAlertChannel("email-test", "email")
+	ac.Spec.Email = &v1alpha1.EmailConfig{
+		Digest: &v1alpha1.EmailDigestConfig{Schedule: "daily", Timezone: "Mars/Olympus_Mons"},
+	}
+
+	_, err := NewEmailChannel(fakeClient, nil, ac)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid digest config")
+}
+
+func TestEmailChannel_RenderDigest(t *testing.T) {
+	digest := Digest{
+		Schedule: "daily",
+		Start:    time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		End:      time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		CronJobs: []DigestCronJob{
+			{Namespace: "prod", Name: "backup", Status: "critical", Runs: 4, Failures: 2, Alerts: 1},
+			{Namespace: "prod", Name: "report", Status: "healthy", Runs: 1},
+		},
+	}
+	assert.Equal(t, "[CronJob Guardian] Daily digest: 1 of 2 CronJobs failing", digest.Subject())
+
+	text := newTestEmailChannel(t, nil, &v1alpha1.EmailConfig{})
+	body, err := text.renderDigest(digest)
+	require.NoError(t, err)
+	assert.Contains(t, body, "2 CronJobs, 5 runs, 2 failures")
+	assert.Contains(t, body, "prod/backup: critical")
+	assert.Contains(t, body, "success rate: 50.0%")
+
+	html := newTestEmailChannel(t, nil, &v1alpha1.EmailConfig{Format: "html"})
+	digest.DashboardURL = "https://guardian.example.com"
+	body, err = html.renderDigest(digest)
+	require.NoError(t, err)
+	assert.Contains(t, body, `<a href="https://guardian.example.com/cronjob/prod/backup">prod/backup</a>`)
+	assert.Contains(t, body, "100.0%")
+}
+
 // ==================== Rate Limiter Tests for Channels ====================
 
 func TestChannelRateLimiting(t *testing.T) {
@@ -832,16 +1685,19 @@ func TestChannelRateLimiting(t *testing.T) {
 		BurstLimit:       &burstLimit,
 	}
 
-	ch, err := NewSlackChannel(fakeClient, ac)
-	require.NoError(t, err)
+	// Channel rate limits are enforced by the dispatcher only, so one send
+	// takes one token and the first send fits a burst of 1
+	d := testDispatcher(nil)
+	d.client = fakeClient
+	require.NoError(t, d.RegisterChannel(ac))
 
 	ctx := context.Background()
 	alert := createTestAlertForChannel()
 
-	err = ch.Send(ctx, alert)
+	err := d.SendToChannel(ctx, "slack-test", alert)
 	require.NoError(t, err)
 
-	err = ch.Send(ctx, alert)
+	err = d.SendToChannel(ctx, "slack-test", alert)
 	assert.Error(t, err)
 	assert.Contains(t, strings.ToLower(err.Error()), "rate limit")
 
@@ -0,0 +1,25 @@
+package alerting
+
+import (
+	"regexp"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// ClassifyLogs returns the classification of the first rule whose log pattern
+// matches logs, or "" if none match. Rules with invalid regexes are skipped.
+func ClassifyLogs(rules []v1alpha1.ClassificationRule, logs string) string {
+	if logs == "" {
+		return ""
+	}
+	for _, rule := range rules {
+		re, err := regexp.Compile(rule.LogPattern)
+		if err != nil {
+			continue
+		}
+		if re.MatchString(logs) {
+			return rule.Classification
+		}
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package alerting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestClassifyLogs(t *testing.T) {
+	rules := []v1alpha1.ClassificationRule{
+		{Classification: "broken", LogPattern: "[invalid(regex"},
+		{Classification: "dependency-failure", LogPattern: "(?i)connection refused|timed out"},
+		{Classification: "db-issue", LogPattern: "deadlock detected"},
+		{Classification: "catch-all", LogPattern: "Traceback"},
+	}
+
+	tests := []struct {
+		name string
+		logs string
+		want string
+	}{
+		{"first matching rule wins", "Traceback\npsycopg2.errors.DeadlockDetected: deadlock detected", "db-issue"},
+		{"case insensitive pattern", "Error: Connection Refused by upstream", "dependency-failure"},
+		{"falls through to later rule", "Traceback (most recent call last):\nValueError", "catch-all"},
+		{"no match", "exit status 1", ""},
+		{"empty logs", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClassifyLogs(rules, tt.logs))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package alerting
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// AlertPayload is the JSON document published by the cloud pub-sub channels
+// (SNS, Pub/Sub, Event Grid) and the event bus. Its fields mirror the default
+// webhook payload.
+type AlertPayload struct {
+	ID         string              `json:"id,omitempty"`
+	Key        string              `json:"key"`
+	Type       string              `json:"type"`
+	Severity   string              `json:"severity"`
+	Title      string              `json:"title"`
+	Message    string              `json:"message"`
+	CronJob    PayloadResourceRef  `json:"cronjob"`
+	Monitor    PayloadResourceRef  `json:"monitor"`
+	Timestamp  time.Time           `json:"timestamp"`
+	Context    AlertPayloadContext `json:"context"`
+	RunbookURL string              `json:"runbook_url,omitempty"`
+	Team       string              `json:"team,omitempty"`
+	Cluster    string              `json:"cluster,omitempty"`
+}
+
+// PayloadResourceRef identifies a namespaced resource in an AlertPayload
+type PayloadResourceRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// PayloadStreak is the long success streak a failure ended
+type PayloadStreak struct {
+	Runs  int       `json:"runs"`
+	Since time.Time `json:"since"`
+}
+
+// AlertPayloadContext carries the diagnostic context of an AlertPayload
+type AlertPayloadContext struct {
+	JobName          string            `json:"job_name,omitempty"`
+	SuggestedFix     string            `json:"suggested_fix,omitempty"`
+	SuccessRate      float64           `json:"success_rate"`
+	ExitCode         int32             `json:"exit_code"`
+	Reason           string            `json:"reason,omitempty"`
+	Classification   string            `json:"classification,omitempty"`
+	Node             string            `json:"node,omitempty"`
+	Images           []string          `json:"images,omitempty"`
+	Logs             string            `json:"logs,omitempty"`
+	UpstreamFailures []string          `json:"upstream_failures,omitempty"`
+	SuccessStreak    *PayloadStreak    `json:"success_streak,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	Annotations      map[string]string `json:"annotations,omitempty"`
+}
+
+// NewAlertPayload converts an alert to its published form
+func NewAlertPayload(alert Alert) AlertPayload {
+	return AlertPayload{
+		ID:        alert.ID,
+		Key:       alert.Key,
+		Type:      alert.Type,
+		Severity:  alert.Severity,
+		Title:     alert.Title,
+		Message:   alert.Message,
+		CronJob:   PayloadResourceRef{Namespace: alert.CronJob.Namespace, Name: alert.CronJob.Name},
+		Monitor:   PayloadResourceRef{Namespace: alert.MonitorRef.Namespace, Name: alert.MonitorRef.Name},
+		Timestamp: alert.Timestamp.UTC(),
+		Context: AlertPayloadContext{
+			JobName:          alert.Context.JobName,
+			SuggestedFix:     alert.Context.SuggestedFix,
+			SuccessRate:      alert.Context.SuccessRate,
+			ExitCode:         alert.Context.ExitCode,
+			Reason:           alert.Context.Reason,
+			Classification:   alert.Context.Classification,
+			Node:             alert.Context.NodeName,
+			Images:           alert.Context.Images,
+			Logs:             alert.Context.Logs,
+			UpstreamFailures: alert.Context.UpstreamFailures,
+			SuccessStreak:    payloadStreak(alert.Context),
+			Labels:           alert.Context.Labels,
+			Annotations:      alert.Context.Annotations,
+		},
+		RunbookURL: alert.RunbookURL,
+		Team:       alert.Team,
+		Cluster:    alert.Cluster,
+	}
+}
+
+// payloadStreak returns the success streak an alert's failure ended, nil if
+// it was not highlighted
+func payloadStreak(c AlertContext) *PayloadStreak {
+	if c.SuccessStreakRuns == 0 {
+		return nil
+	}
+	return &PayloadStreak{Runs: c.SuccessStreakRuns, Since: c.SuccessStreakSince.UTC()}
+}
+
+// AlertEventID returns a stable ID for an alert occurrence, so publishes
+// retried by the dispatcher are deduplicated by the receiving service
+func AlertEventID(alert Alert) string {
+	sum := sha256.Sum256([]byte(alert.Key + "|" + alert.Timestamp.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:])
+}
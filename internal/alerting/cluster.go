@@ -0,0 +1,100 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// ClusterKey returns the deduplication key of an alert from the given
+// cluster. Keys of the local cluster (empty name) are left as they are;
+// remote keys are prefixed with "<cluster>:", which namespaces cannot
+// contain, so the same CronJob in two clusters never shares a key.
+func ClusterKey(cluster, key string) string {
+	if cluster == "" {
+		return key
+	}
+	return cluster + ":" + key
+}
+
+// clusterDispatcher tags the alerts of one remote cluster with its name and
+// scopes their keys to it. Everything else is the shared dispatcher's.
+type clusterDispatcher struct {
+	Dispatcher
+	cluster string
+}
+
+// ForCluster returns a dispatcher for the controllers and schedulers of a
+// remote cluster. It sends through d's channels, rate limits and suppression
+// state; alerts get the cluster's name and keys built by ClusterKey, so
+// callers keep using the "<namespace>/<name>/<type>" keys they use locally.
+func ForCluster(d Dispatcher, cluster string) Dispatcher {
+	if cluster == "" {
+		return d
+	}
+	return &clusterDispatcher{Dispatcher: d, cluster: cluster}
+}
+
+// tag sets the cluster and cluster-scoped key of an alert
+func (c *clusterDispatcher) tag(alert Alert) Alert {
+	alert.Cluster = c.cluster
+	if alert.Key == "" {
+		alert.Key = fmt.Sprintf("%s/%s/%s", alert.CronJob.Namespace, alert.CronJob.Name, alert.Type)
+	}
+	alert.Key = ClusterKey(c.cluster, alert.Key)
+	return alert
+}
+
+func (c *clusterDispatcher) Dispatch(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) error {
+	return c.Dispatcher.Dispatch(ctx, c.tag(alert), alertCfg)
+}
+
+func (c *clusterDispatcher) SendToChannel(ctx context.Context, channelName string, alert Alert) error {
+	return c.Dispatcher.SendToChannel(ctx, channelName, c.tag(alert))
+}
+
+func (c *clusterDispatcher) Preview(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) []ChannelPreview {
+	return c.Dispatcher.Preview(ctx, c.tag(alert), alertCfg)
+}
+
+func (c *clusterDispatcher) IsSuppressed(alert Alert, alertCfg *v1alpha1.AlertingConfig) (bool, string) {
+	return c.Dispatcher.IsSuppressed(c.tag(alert), alertCfg)
+}
+
+func (c *clusterDispatcher) ClearAlert(ctx context.Context, alertKey string) error {
+	return c.Dispatcher.ClearAlert(ctx, ClusterKey(c.cluster, alertKey))
+}
+
+// ClearAlertsForMonitor clears by key prefix, so the cluster prefix goes in
+// front of the namespace
+func (c *clusterDispatcher) ClearAlertsForMonitor(namespace, name string) {
+	c.Dispatcher.ClearAlertsForMonitor(ClusterKey(c.cluster, namespace), name)
+}
+
+func (c *clusterDispatcher) Acknowledge(alertKey, by string) bool {
+	return c.Dispatcher.Acknowledge(ClusterKey(c.cluster, alertKey), by)
+}
+
+func (c *clusterDispatcher) ActiveAlertID(alertKey string) string {
+	return c.Dispatcher.ActiveAlertID(ClusterKey(c.cluster, alertKey))
+}
+
+func (c *clusterDispatcher) CancelPendingAlert(alertKey string) bool {
+	return c.Dispatcher.CancelPendingAlert(ClusterKey(c.cluster, alertKey))
+}
+
+func (c *clusterDispatcher) CancelPendingAlertsForCronJob(namespace, name string) int {
+	return c.Dispatcher.CancelPendingAlertsForCronJob(ClusterKey(c.cluster, namespace), name)
+}
+
+// clientFor returns the client of the cluster an alert comes from, nil if
+// it is unknown
+func (d *dispatcher) clientFor(cluster string) client.Client {
+	if cluster == "" {
+		return d.client
+	}
+	return d.clusterClients[cluster]
+}
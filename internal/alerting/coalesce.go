@@ -0,0 +1,315 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// Coalescing key fields
+const (
+	coalesceFieldImage  = "image"
+	coalesceFieldReason = "reason"
+)
+
+const (
+	// DefaultCoalescingWindow is how long alerts are held to collect others with the same key
+	DefaultCoalescingWindow = time.Minute
+
+	// DefaultCoalescingMinCronJobs is the number of CronJobs sent as one roll-up alert
+	DefaultCoalescingMinCronJobs = 3
+)
+
+// ValidateCoalescing checks the alert coalescing configuration
+func ValidateCoalescing(cfg config.AlertCoalescingConfig) error {
+	for _, field := range cfg.By {
+		if field != coalesceFieldImage && field != coalesceFieldReason {
+			return fmt.Errorf("alert-coalescing.by: unknown field %q (image or reason)", field)
+		}
+	}
+	if cfg.Window < 0 {
+		return fmt.Errorf("alert-coalescing.window must not be negative")
+	}
+	if cfg.MinCronJobs == 1 || cfg.MinCronJobs < 0 {
+		return fmt.Errorf("alert-coalescing.min-cronjobs must be at least 2")
+	}
+	return nil
+}
+
+// coalescingDefaults fills in the unset coalescing settings
+func coalescingDefaults(cfg config.AlertCoalescingConfig) config.AlertCoalescingConfig {
+	if len(cfg.By) == 0 {
+		cfg.By = []string{coalesceFieldImage, coalesceFieldReason}
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultCoalescingWindow
+	}
+	if cfg.MinCronJobs < 2 {
+		cfg.MinCronJobs = DefaultCoalescingMinCronJobs
+	}
+	return cfg
+}
+
+// coalescingLabel returns the coalescing key fields of an alert, e.g.
+// "image=registry/app:1.2, reason=Error", or "" if the alert lacks one of them
+func coalescingLabel(by []string, alert Alert) string {
+	parts := make([]string, 0, len(by))
+	for _, field := range by {
+		var value string
+		switch field {
+		case coalesceFieldImage:
+			value = strings.Join(slices.Sorted(slices.Values(alert.Context.Images)), ",")
+		case coalesceFieldReason:
+			value = alert.Context.Reason
+		}
+		if value == "" {
+			return ""
+		}
+		parts = append(parts, field+"="+value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// coalescedAlert is an alert held in a burst, with what it needs to be sent on its own
+type coalescedAlert struct {
+	alert   Alert
+	cfg     *v1alpha1.AlertingConfig
+	routing routing
+}
+
+// alertBurst collects the alerts sharing a coalescing key within the window
+type alertBurst struct {
+	alertType string
+	label     string
+	held      []coalescedAlert
+	cronJobs  map[string]bool // Every CronJob alerted in the burst, by cluster/namespace/name
+	rolledUp  bool            // A roll-up alert was sent; later alerts are added to it
+	timer     *time.Timer
+}
+
+// coalesce holds an alert in the burst of its coalescing key. It returns
+// false if the alert is not coalesced and should be sent now.
+func (d *dispatcher) coalesce(alert Alert, alertCfg *v1alpha1.AlertingConfig, r routing) bool {
+	if !d.coalescing.Enabled {
+		return false
+	}
+	label := coalescingLabel(d.coalescing.By, alert)
+	if label == "" {
+		return false
+	}
+	key := alert.Type + "|" + label
+
+	d.burstMu.Lock()
+	defer d.burstMu.Unlock()
+
+	b, ok := d.bursts[key]
+	if !ok {
+		b = &alertBurst{alertType: alert.Type, label: label, cronJobs: make(map[string]bool)}
+		b.timer = time.AfterFunc(d.coalescing.Window, func() { d.flushBurst(key) })
+		d.bursts[key] = b
+	}
+	b.held = slices.DeleteFunc(b.held, func(h coalescedAlert) bool { return h.alert.Key == alert.Key })
+	b.held = append(b.held, coalescedAlert{alert: alert, cfg: alertCfg, routing: r})
+	b.cronJobs[alert.Cluster+"/"+alert.CronJob.String()] = true
+	return true
+}
+
+// flushBurst sends the alerts held in a burst: as one roll-up alert once
+// enough CronJobs share the key, else each on its own. A rolled up burst
+// stays open for another window, and alerts added to it are sent as an
+// update of the roll-up; it is dropped after a window without alerts.
+func (d *dispatcher) flushBurst(key string) {
+	d.burstMu.Lock()
+	b, ok := d.bursts[key]
+	if !ok {
+		d.burstMu.Unlock()
+		return
+	}
+	held := b.held
+	b.held = nil
+	if len(held) == 0 || (!b.rolledUp && len(b.cronJobs) < d.coalescing.MinCronJobs) {
+		delete(d.bursts, key)
+		d.burstMu.Unlock()
+
+		if d.isLeader() {
+			for _, h := range held {
+				_ = d.deliverAlert(context.Background(), h.alert, h.cfg, h.routing)
+			}
+		}
+		return
+	}
+	update := b.rolledUp
+	b.rolledUp = true
+	b.timer = time.AfterFunc(d.coalescing.Window, func() { d.flushBurst(key) })
+	alertType, label, total := b.alertType, b.label, len(b.cronJobs)
+	d.burstMu.Unlock()
+
+	if !d.isLeader() {
+		return
+	}
+	d.sendBurst(context.Background(), alertType, label, total, update, held)
+}
+
+// sendBurst sends a roll-up of the held alerts, one notification per
+// channel with the alerts routed to it. The alerts become active as if sent
+// on their own, so each clears and resolves with its CronJob. Like grouped
+// notifications, roll-ups are only limited by their channels.
+func (d *dispatcher) sendBurst(ctx context.Context, alertType, label string, total int, update bool, held []coalescedAlert) {
+	logger := loggerFor(ctx).WithValues("coalescingKey", label)
+
+	type channelAlerts struct {
+		ch     Channel
+		alerts []Alert
+	}
+	var order []string
+	byChannel := make(map[string]*channelAlerts)
+	sentAt := time.Now()
+	var sent []Alert
+
+	targets := make([][]Channel, len(held))
+	for i, h := range held {
+		if !h.routing.override {
+			targets[i] = d.resolveChannels(h.cfg, h.alert.Severity)
+		}
+		targets[i] = d.appendRouteChannels(targets[i], h.routing.refs, h.alert.Severity)
+	}
+
+	d.alertMu.Lock()
+	for i, h := range held {
+		if len(targets[i]) == 0 {
+			continue
+		}
+		if suppressed, reason := d.isSuppressedLocked(h.alert, h.cfg); suppressed {
+			logger.V(1).Info("alert suppressed", "key", h.alert.Key, "reason", reason)
+			continue
+		}
+		d.sentAlerts[h.alert.Key] = sentAt
+		d.activeAlerts[h.alert.Key] = h.alert
+		d.resolvers[h.alert.Key] = namesOf(targets[i])
+		d.alertCount24h++
+		sent = append(sent, h.alert)
+
+		for _, ch := range targets[i] {
+			c, ok := byChannel[ch.Name()]
+			if !ok {
+				c = &channelAlerts{ch: ch}
+				byChannel[ch.Name()] = c
+				order = append(order, ch.Name())
+			}
+			c.alerts = append(c.alerts, h.alert)
+		}
+	}
+	d.alertMu.Unlock()
+
+	for _, alert := range sent {
+		d.persistAlertState(ctx, alert, sentAt, "")
+		if d.alertSink != nil {
+			d.alertSink.PublishAlert(ctx, alert)
+		}
+	}
+
+	delivered := make(map[string][]string) // alert key -> channels
+	for _, name := range order {
+		c := byChannel[name]
+		if !d.allowChannel(c.ch.Name()) {
+			logger.Info("coalesced alerts rate limited by channel", "channel", c.ch.Name(), "alerts", len(c.alerts))
+			continue
+		}
+
+		notification := rollUpNotification(alertType, label, total, update, c.alerts)
+		logger.Info("sending coalesced alerts", "channel", c.ch.Name(), "provider", c.ch.Type(), "alerts", len(c.alerts))
+		if err := d.send(ctx, c.ch, notification); err != nil {
+			logger.Error(err, "failed to send coalesced alerts", "channel", c.ch.Name(), "provider", c.ch.Type())
+			d.recordChannelFailure(c.ch.Name(), err)
+			for _, a := range c.alerts {
+				metrics.RecordAlertFailed(a.CronJob.Namespace, a.CronJob.Name, a.Type, a.Severity, c.ch.Name())
+			}
+			d.enqueueDeliveries(ctx, notification, []channelFailure{{channel: c.ch, err: err}}, false)
+			continue
+		}
+		d.recordChannelSuccess(c.ch.Name())
+		for _, a := range c.alerts {
+			metrics.RecordAlert(a.CronJob.Namespace, a.CronJob.Name, a.Type, a.Severity, c.ch.Name())
+			delivered[a.Key] = append(delivered[a.Key], c.ch.Name())
+		}
+	}
+
+	for _, alert := range sent {
+		if names := delivered[alert.Key]; len(names) > 0 {
+			d.storeAlertHistory(ctx, alert, names)
+		}
+	}
+}
+
+// rollUpNotification combines alerts sharing a coalescing key into one alert
+// listing the affected CronJobs. total is the number of CronJobs in the burst
+// so far, including those of earlier roll-ups when update is set.
+func rollUpNotification(alertType, label string, total int, update bool, alerts []Alert) Alert {
+	n := groupNotification("", label, alerts)
+	if len(alerts) == 1 && !update {
+		return n
+	}
+
+	n.Key = "coalesced/" + alertType + "/" + label
+	if len(alerts) == 1 {
+		// An update listing one new alert is still a roll-up of its own
+		n.ID = uuid.NewString()
+	}
+	n.Context = AlertContext{
+		Reason: alerts[0].Context.Reason,
+		Images: alerts[0].Context.Images,
+	}
+	for _, a := range alerts[1:] {
+		if a.Context.Reason != n.Context.Reason {
+			n.Context.Reason = ""
+		}
+		if !slices.Equal(a.Context.Images, n.Context.Images) {
+			n.Context.Images = nil
+		}
+	}
+
+	cronJobs := make(map[string]bool)
+	lines := make([]string, 0, len(alerts))
+	for _, a := range alerts {
+		cronJobs[a.Cluster+"/"+a.CronJob.String()] = true
+		lines = append(lines, fmt.Sprintf("- [%s] %s: %s", a.Severity, a.CronJob, a.Title))
+	}
+	if update {
+		n.Title = fmt.Sprintf("%s in %d more CronJob(s) (%s), %d in total", alertType, len(cronJobs), label, total)
+	} else {
+		n.Title = fmt.Sprintf("%s in %d CronJobs (%s)", alertType, len(cronJobs), label)
+	}
+	n.Message = "The same failure hit these CronJobs:\n" + strings.Join(lines, "\n")
+	return n
+}
+
+// uncoalesce drops cleared alerts from the bursts they are held in
+func (d *dispatcher) uncoalesce(keys ...string) {
+	d.burstMu.Lock()
+	defer d.burstMu.Unlock()
+
+	for _, b := range d.bursts {
+		b.held = slices.DeleteFunc(b.held, func(h coalescedAlert) bool {
+			return slices.Contains(keys, h.alert.Key)
+		})
+	}
+}
+
+// stopBursts stops the burst timers; held alerts are not sent
+func (d *dispatcher) stopBursts() {
+	d.burstMu.Lock()
+	defer d.burstMu.Unlock()
+
+	for key, b := range d.bursts {
+		b.timer.Stop()
+		delete(d.bursts, key)
+	}
+}
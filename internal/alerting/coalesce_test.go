@@ -0,0 +1,126 @@
+package alerting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// coalescingDispatcher returns a test dispatcher that coalesces alerts
+// within 50ms and rolls up 3 CronJobs or more, sending to the "team" channel
+func coalescingDispatcher(t *testing.T) (*dispatcher, *mockChannel) {
+	t.Helper()
+	d := routeDispatcher(t)
+	d.coalescing = coalescingDefaults(config.AlertCoalescingConfig{Enabled: true, Window: 50 * time.Millisecond})
+	d.bursts = make(map[string]*alertBurst)
+	t.Cleanup(d.stopBursts)
+
+	ch := newMockChannel("team", "slack")
+	d.channels["team"] = ch
+	return d, ch
+}
+
+func coalescedTestAlert(namespace, name, image, reason string) Alert {
+	alert := testAlert(namespace, name, "JobFailed", "critical")
+	alert.Context.Images = []string{image}
+	alert.Context.Reason = reason
+	return alert
+}
+
+func TestValidateCoalescing(t *testing.T) {
+	require.NoError(t, ValidateCoalescing(config.AlertCoalescingConfig{}))
+	require.NoError(t, ValidateCoalescing(config.AlertCoalescingConfig{By: []string{"image"}, Window: time.Minute, MinCronJobs: 2}))
+
+	assert.ErrorContains(t, ValidateCoalescing(config.AlertCoalescingConfig{By: []string{"node"}}), "unknown field")
+	assert.ErrorContains(t, ValidateCoalescing(config.AlertCoalescingConfig{Window: -time.Second}), "window")
+	assert.ErrorContains(t, ValidateCoalescing(config.AlertCoalescingConfig{MinCronJobs: 1}), "min-cronjobs")
+}
+
+func TestCoalescingLabel(t *testing.T) {
+	alert := coalescedTestAlert("default", "a", "app:1.2", "Error")
+	alert.Context.Images = []string{"sidecar:1", "app:1.2"}
+
+	assert.Equal(t, "image=app:1.2,sidecar:1, reason=Error", coalescingLabel([]string{"image", "reason"}, alert))
+	assert.Equal(t, "reason=Error", coalescingLabel([]string{"reason"}, alert))
+
+	alert.Context.Reason = ""
+	assert.Empty(t, coalescingLabel([]string{"image", "reason"}, alert), "alerts missing a key field are not coalesced")
+}
+
+func TestDispatcher_Coalesce_RollUp(t *testing.T) {
+	d, ch := coalescingDispatcher(t)
+	ctx := context.Background()
+	cfg := testAlertingConfig("team")
+
+	for _, ns := range []string{"team-a", "team-b", "team-c"} {
+		require.NoError(t, d.Dispatch(ctx, coalescedTestAlert(ns, "report", "lib:2.0", "Error"), cfg))
+	}
+	require.NoError(t, d.Dispatch(ctx, coalescedTestAlert("team-d", "report", "other:1", "Error"), cfg))
+	assert.Empty(t, ch.GetSentAlerts(), "coalesced alerts are held for the window")
+
+	require.Eventually(t, func() bool { return len(ch.GetSentAlerts()) == 2 }, time.Second, 10*time.Millisecond)
+	var rollUp, single Alert
+	for _, sent := range ch.GetSentAlerts() {
+		if sent.Key == "team-d/report/JobFailed" {
+			single = sent
+		} else {
+			rollUp = sent
+		}
+	}
+	assert.Equal(t, "Test Alert", single.Title, "a key shared by too few CronJobs is sent on its own")
+	assert.Equal(t, "JobFailed", rollUp.Type)
+	assert.Equal(t, "JobFailed in 3 CronJobs (image=lib:2.0, reason=Error)", rollUp.Title)
+	assert.Contains(t, rollUp.Message, "- [critical] team-b/report: Test Alert")
+	assert.Equal(t, "Error", rollUp.Context.Reason)
+	assert.Equal(t, []string{"lib:2.0"}, rollUp.Context.Images)
+
+	// Each rolled up alert is active and recorded on its own
+	d.alertMu.RLock()
+	assert.Contains(t, d.activeAlerts, "team-a/report/JobFailed")
+	assert.Contains(t, d.activeAlerts, "team-c/report/JobFailed")
+	d.alertMu.RUnlock()
+	history, _, err := d.store.ListAlertHistory(ctx, store.AlertHistoryQuery{})
+	require.NoError(t, err)
+	assert.Len(t, history, 4)
+
+	// A CronJob failing the same way later updates the roll-up
+	require.NoError(t, d.Dispatch(ctx, coalescedTestAlert("team-e", "report", "lib:2.0", "Error"), cfg))
+	require.Eventually(t, func() bool { return len(ch.GetSentAlerts()) == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "JobFailed in 1 more CronJob(s) (image=lib:2.0, reason=Error), 4 in total", ch.GetSentAlerts()[2].Title)
+
+	// The burst is dropped after a window without alerts
+	require.Eventually(t, func() bool {
+		d.burstMu.Lock()
+		defer d.burstMu.Unlock()
+		return len(d.bursts) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDispatcher_Coalesce_ClearedWhileHeld(t *testing.T) {
+	d, ch := coalescingDispatcher(t)
+	ctx := context.Background()
+	cfg := testAlertingConfig("team")
+
+	require.NoError(t, d.Dispatch(ctx, coalescedTestAlert("team-a", "report", "lib:2.0", "Error"), cfg))
+	require.NoError(t, d.Dispatch(ctx, coalescedTestAlert("team-b", "report", "lib:2.0", "Error"), cfg))
+	require.NoError(t, d.ClearAlert(ctx, "team-a/report/JobFailed"))
+
+	require.Eventually(t, func() bool { return len(ch.GetSentAlerts()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "team-b/report/JobFailed", ch.GetSentAlerts()[0].Key)
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, ch.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_Coalesce_Disabled(t *testing.T) {
+	d, ch := coalescingDispatcher(t)
+	d.coalescing.Enabled = false
+
+	require.NoError(t, d.Dispatch(context.Background(), coalescedTestAlert("team-a", "report", "lib:2.0", "Error"), testAlertingConfig("team")))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+}
@@ -0,0 +1,38 @@
+package alerting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// dashboardLinks is embedded by channels linking alerts to the dashboard, so
+// the dispatcher can give them the operator's external dashboard URL
+// (ui.external-url)
+type dashboardLinks struct {
+	dashboardURL string // Without trailing slash; empty = no links
+}
+
+func (l *dashboardLinks) setDashboardURL(base string) {
+	l.dashboardURL = strings.TrimSuffix(base, "/")
+}
+
+// cronJobURL returns the dashboard page for a CronJob, or "" without a dashboard URL
+func (l *dashboardLinks) cronJobURL(cronJob types.NamespacedName) string {
+	return dashboardCronJobURL(l.dashboardURL, cronJob)
+}
+
+// dashboardChannel is implemented by channels embedding dashboardLinks
+type dashboardChannel interface {
+	setDashboardURL(base string)
+}
+
+// dashboardCronJobURL returns the dashboard page for a CronJob, or "" without a base URL
+func dashboardCronJobURL(base string, cronJob types.NamespacedName) string {
+	if base == "" || cronJob.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/cronjob/%s/%s", base, url.PathEscape(cronJob.Namespace), url.PathEscape(cronJob.Name))
+}
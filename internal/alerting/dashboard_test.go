@@ -0,0 +1,42 @@
+package alerting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+func TestDispatcher_RegisterChannel_DashboardURL(t *testing.T) {
+	d := httpDispatcher(t, config.ChannelHTTPConfig{})
+	d.dashboardURL = "https://guardian.example.com/"
+
+	ac := &v1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "ops-slack"},
+		Spec: v1alpha1.AlertChannelSpec{
+			Type: "slack",
+			Slack: &v1alpha1.SlackConfig{
+				WebhookSecretRef: v1alpha1.NamespacedSecretKeyRef{Name: "slack", Namespace: "guardian", Key: "url"},
+			},
+		},
+	}
+	require.NoError(t, d.RegisterChannel(ac))
+
+	links := d.channels["ops-slack"].(*slackChannel).dashboardLinks
+	assert.Equal(t, "https://guardian.example.com", links.dashboardURL)
+	assert.Equal(t, "https://guardian.example.com/cronjob/prod/nightly%20backup",
+		links.cronJobURL(types.NamespacedName{Namespace: "prod", Name: "nightly backup"}))
+}
+
+func TestDashboardLinks_NoURL(t *testing.T) {
+	var links dashboardLinks
+	assert.Empty(t, links.cronJobURL(types.NamespacedName{Namespace: "prod", Name: "backup"}))
+
+	links.setDashboardURL("https://guardian.example.com")
+	assert.Empty(t, links.cronJobURL(types.NamespacedName{}), "alerts without a CronJob have no page")
+}
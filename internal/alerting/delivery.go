@@ -0,0 +1,220 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Delivery retry queue settings
+const (
+	// DeliveryRetryInterval is how often the retry worker polls for due deliveries
+	DeliveryRetryInterval = 30 * time.Second
+	// DeliveryInitialBackoff is the wait before the first retry of a failed delivery
+	DeliveryInitialBackoff = 30 * time.Second
+	// DeliveryMaxBackoff caps the exponential backoff between retries
+	DeliveryMaxBackoff = 1 * time.Hour
+	// DeliveryMaxAttempts is the total number of sends (including the original) before giving up
+	DeliveryMaxAttempts = 8
+
+	deliveryBatchSize = 100
+)
+
+// channelFailure records a failed send to a channel during dispatch
+type channelFailure struct {
+	channel Channel
+	err     error
+}
+
+// deliveryBackoff returns the delay before the next attempt after the given number of attempts
+func deliveryBackoff(attempts int32) time.Duration {
+	backoff := DeliveryInitialBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= DeliveryMaxBackoff {
+			return DeliveryMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// enqueueDeliveries persists failed channel sends so the retry worker can redeliver them.
+// recordHistory marks whether a successful retry should write the alert to history
+// (true when no channel accepted the alert on the original dispatch); only the
+// first of the alert's deliveries to succeed does.
+func (d *dispatcher) enqueueDeliveries(ctx context.Context, alert Alert, failures []channelFailure, recordHistory bool) {
+	if d.store == nil || len(failures) == 0 {
+		return
+	}
+	logger := loggerFor(ctx)
+
+	payload, err := json.Marshal(alert)
+	if err != nil {
+		logger.Error(err, "failed to encode alert for retry queue", "alertKey", alert.Key)
+		return
+	}
+
+	now := time.Now()
+	for _, f := range failures {
+		delivery := store.AlertDelivery{
+			AlertKey:         alert.Key,
+			AlertID:          alert.ID,
+			AlertType:        alert.Type,
+			Severity:         alert.Severity,
+			CronJobNamespace: alert.CronJob.Namespace,
+			CronJobName:      alert.CronJob.Name,
+			ChannelName:      f.channel.Name(),
+			Payload:          string(payload),
+			RecordHistory:    recordHistory,
+			Status:           store.DeliveryStatusPending,
+			Attempts:         1,
+			NextAttemptAt:    now.Add(deliveryBackoff(1)),
+			LastError:        f.err.Error(),
+		}
+		if err := d.store.EnqueueDelivery(ctx, delivery); err != nil {
+			logger.Error(err, "failed to queue alert delivery for retry",
+				"alertId", alert.ID, "alertKey", alert.Key, "channel", f.channel.Name())
+			continue
+		}
+		logger.V(1).Info("queued alert delivery for retry",
+			"alertId", alert.ID, "alertKey", alert.Key, "channel", f.channel.Name(), "nextAttempt", delivery.NextAttemptAt)
+	}
+}
+
+// startDeliveryRetry starts a background goroutine that retries queued deliveries
+// until the dispatcher is stopped.
+func (d *dispatcher) startDeliveryRetry() {
+	if d.store == nil {
+		return
+	}
+
+	ticker := time.NewTicker(DeliveryRetryInterval)
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				d.retryDueDeliveries(context.Background())
+			case <-d.cleanupDone:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// retryDueDeliveries attempts every queued delivery whose backoff has elapsed
+func (d *dispatcher) retryDueDeliveries(ctx context.Context) {
+	logger := loggerFor(ctx)
+
+	if !d.isLeader() {
+		return
+	}
+
+	due, err := d.store.GetDueDeliveries(ctx, time.Now(), deliveryBatchSize)
+	if err != nil {
+		logger.Error(err, "failed to load due alert deliveries")
+		return
+	}
+
+	for _, delivery := range due {
+		d.retryDelivery(ctx, delivery)
+	}
+}
+
+// retryDelivery makes one more attempt at a queued delivery and saves the outcome
+func (d *dispatcher) retryDelivery(ctx context.Context, delivery store.AlertDelivery) {
+	logger := loggerFor(ctx)
+
+	var alert Alert
+	if err := json.Unmarshal([]byte(delivery.Payload), &alert); err != nil {
+		delivery.Status = store.DeliveryStatusFailed
+		delivery.LastError = fmt.Sprintf("invalid payload: %v", err)
+		d.saveDelivery(ctx, delivery)
+		return
+	}
+
+	d.channelMu.RLock()
+	ch, ok := d.channels[delivery.ChannelName]
+	d.channelMu.RUnlock()
+	if !ok {
+		delivery.Status = store.DeliveryStatusFailed
+		delivery.LastError = fmt.Sprintf("channel %s not found", delivery.ChannelName)
+		d.saveDelivery(ctx, delivery)
+		return
+	}
+
+	// Leave the delivery due until the channel's rate limit allows it
+	if !d.allowChannel(delivery.ChannelName) {
+		logger.V(1).Info("alert delivery retry rate limited",
+			"alertId", alert.ID, "alertKey", delivery.AlertKey, "channel", delivery.ChannelName)
+		return
+	}
+
+	// The queue has its own backoff, so the channel's retries are not used
+	delivery.Attempts++
+	if err := sendOnce(ctx, ch, alert, d.sendSettingsOf(ch.Name()).timeout); err != nil {
+		d.recordChannelFailure(ch.Name(), err)
+		metrics.RecordAlertFailed(alert.CronJob.Namespace, alert.CronJob.Name, alert.Type, alert.Severity, ch.Name())
+
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= DeliveryMaxAttempts {
+			delivery.Status = store.DeliveryStatusFailed
+			logger.Info("giving up on alert delivery",
+				"alertId", alert.ID, "alertKey", delivery.AlertKey, "channel", delivery.ChannelName, "attempts", delivery.Attempts)
+		} else {
+			delivery.NextAttemptAt = time.Now().Add(deliveryBackoff(delivery.Attempts))
+			logger.V(1).Info("alert delivery retry failed",
+				"alertId", alert.ID, "alertKey", delivery.AlertKey, "channel", delivery.ChannelName,
+				"attempts", delivery.Attempts, "nextAttempt", delivery.NextAttemptAt)
+		}
+		d.saveDelivery(ctx, delivery)
+		return
+	}
+
+	d.recordChannelSuccess(ch.Name())
+	metrics.RecordAlert(alert.CronJob.Namespace, alert.CronJob.Name, alert.Type, alert.Severity, ch.Name())
+
+	now := time.Now()
+	delivery.Status = store.DeliveryStatusDelivered
+	delivery.DeliveredAt = &now
+	delivery.LastError = ""
+	d.saveDelivery(ctx, delivery)
+
+	logger.Info("alert delivered on retry",
+		"alertId", alert.ID, "alertKey", delivery.AlertKey, "channel", delivery.ChannelName, "attempts", delivery.Attempts)
+
+	if delivery.RecordHistory && d.claimDeliveryHistory(ctx, delivery) {
+		d.storeAlertHistory(ctx, alert, []string{ch.Name()})
+	}
+}
+
+// claimDeliveryHistory reports whether a successful retry should write its
+// alert to history, clearing the flag on the alert's other deliveries so
+// their retries do not record it again
+func (d *dispatcher) claimDeliveryHistory(ctx context.Context, delivery store.AlertDelivery) bool {
+	// Deliveries queued before alert IDs were stored cannot be matched up
+	if delivery.AlertID == "" {
+		return true
+	}
+	claimed, err := d.store.ClaimDeliveryHistory(ctx, delivery.AlertID)
+	if err != nil {
+		// A duplicate history row is better than losing the alert from history
+		loggerFor(ctx).Error(err, "failed to claim alert history for delivery",
+			"alertId", delivery.AlertID, "alertKey", delivery.AlertKey, "channel", delivery.ChannelName)
+		return true
+	}
+	return claimed
+}
+
+// saveDelivery persists delivery state, logging rather than returning errors
+func (d *dispatcher) saveDelivery(ctx context.Context, delivery store.AlertDelivery) {
+	if err := d.store.UpdateDelivery(ctx, delivery); err != nil {
+		loggerFor(ctx).Error(err, "failed to update alert delivery",
+			"alertKey", delivery.AlertKey, "channel", delivery.ChannelName)
+	}
+}
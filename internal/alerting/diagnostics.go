@@ -0,0 +1,89 @@
+package alerting
+
+import (
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DispatcherDiagnostics is a point-in-time snapshot of the dispatcher's
+// internal state, used by the diagnostics endpoint
+type DispatcherDiagnostics struct {
+	Channels             int                  `json:"channels"`
+	ActiveAlerts         int                  `json:"activeAlerts"`
+	PendingAlerts        int                  `json:"pendingAlerts"`
+	AcknowledgedAlerts   int                  `json:"acknowledgedAlerts"`
+	SuppressedAlerts     int64                `json:"suppressedAlerts"` // suppressed since startup
+	SuppressionKeys      int                  `json:"suppressionKeys"`  // keys in the duplicate-suppression window
+	InStartupGracePeriod bool                 `json:"inStartupGracePeriod"`
+	RateLimits           RateLimitDiagnostics `json:"rateLimits"`
+}
+
+// RateLimitDiagnostics reports the state of the dispatcher's rate limiters
+type RateLimitDiagnostics struct {
+	Global   LimiterState            `json:"global"`
+	Channels map[string]LimiterState `json:"channels,omitempty"`
+	Monitors map[string]LimiterState `json:"monitors,omitempty"`
+}
+
+// LimiterState is the configuration and remaining budget of a rate limiter
+type LimiterState struct {
+	PerMinute float64 `json:"perMinute"`
+	Burst     int     `json:"burst"`
+	Tokens    float64 `json:"tokens"`
+}
+
+func limiterState(lim *rate.Limiter, now time.Time) LimiterState {
+	return LimiterState{
+		PerMinute: float64(lim.Limit()) * 60,
+		Burst:     lim.Burst(),
+		Tokens:    lim.TokensAt(now),
+	}
+}
+
+// recordSuppressed counts an alert dropped before delivery
+func (d *dispatcher) recordSuppressed() {
+	atomic.AddInt64(&d.suppressedCount, 1)
+}
+
+// Diagnostics returns a snapshot of the dispatcher's state
+func (d *dispatcher) Diagnostics() DispatcherDiagnostics {
+	now := time.Now()
+	diag := DispatcherDiagnostics{
+		SuppressedAlerts:     atomic.LoadInt64(&d.suppressedCount),
+		InStartupGracePeriod: now.Before(d.readyTime()),
+	}
+
+	d.channelMu.RLock()
+	diag.Channels = len(d.channels)
+	d.channelMu.RUnlock()
+
+	d.alertMu.RLock()
+	diag.ActiveAlerts = len(d.activeAlerts)
+	diag.AcknowledgedAlerts = len(d.acknowledged)
+	diag.SuppressionKeys = len(d.sentAlerts)
+	d.alertMu.RUnlock()
+
+	d.pendingMu.RLock()
+	diag.PendingAlerts = len(d.pendingAlerts)
+	d.pendingMu.RUnlock()
+
+	d.limiterMu.Lock()
+	diag.RateLimits.Global = limiterState(d.globalLimiter, now)
+	if len(d.channelLimiters) > 0 {
+		diag.RateLimits.Channels = make(map[string]LimiterState, len(d.channelLimiters))
+		for name, lim := range d.channelLimiters {
+			diag.RateLimits.Channels[name] = limiterState(lim, now)
+		}
+	}
+	if len(d.monitorLimiters) > 0 {
+		diag.RateLimits.Monitors = make(map[string]LimiterState, len(d.monitorLimiters))
+		for key, lim := range d.monitorLimiters {
+			diag.RateLimits.Monitors[key] = limiterState(lim, now)
+		}
+	}
+	d.limiterMu.Unlock()
+
+	return diag
+}
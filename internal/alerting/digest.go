@@ -0,0 +1,211 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+const (
+	digestScheduleWeekly = "weekly"
+	defaultDigestTime    = "09:00"
+	defaultDigestWeekday = time.Monday
+)
+
+// DigestSender is implemented by channels that can deliver periodic digests
+type DigestSender interface {
+	// SendDigest delivers a digest summarising a period
+	SendDigest(ctx context.Context, digest Digest) error
+}
+
+// Digest summarises the monitored CronJobs of a channel over a period
+type Digest struct {
+	// Schedule is daily or weekly
+	Schedule string
+	Start    time.Time
+	End      time.Time
+	CronJobs []DigestCronJob
+	// DashboardURL is the external dashboard URL without trailing slash
+	// (ui.external-url; empty = no links)
+	DashboardURL string
+}
+
+// DigestCronJob is one CronJob's summary in a digest
+type DigestCronJob struct {
+	Namespace   string
+	Name        string
+	Status      string // healthy, warning, critical, unknown
+	Suspended   bool
+	Runs        int
+	Failures    int
+	Alerts      int
+	LastSuccess time.Time // zero if unknown
+}
+
+// SuccessRate returns the percentage of successful runs in the period
+func (c DigestCronJob) SuccessRate() float64 {
+	if c.Runs == 0 {
+		return 100
+	}
+	return float64(c.Runs-c.Failures) / float64(c.Runs) * 100
+}
+
+// TotalRuns returns the runs of all CronJobs in the period
+func (d Digest) TotalRuns() int {
+	total := 0
+	for _, cj := range d.CronJobs {
+		total += cj.Runs
+	}
+	return total
+}
+
+// TotalFailures returns the failures of all CronJobs in the period
+func (d Digest) TotalFailures() int {
+	total := 0
+	for _, cj := range d.CronJobs {
+		total += cj.Failures
+	}
+	return total
+}
+
+// Failing returns the number of CronJobs with at least one failure in the period
+func (d Digest) Failing() int {
+	failing := 0
+	for _, cj := range d.CronJobs {
+		if cj.Failures > 0 {
+			failing++
+		}
+	}
+	return failing
+}
+
+// Subject returns the digest email subject
+func (d Digest) Subject() string {
+	period := "Daily"
+	if d.Schedule == digestScheduleWeekly {
+		period = "Weekly"
+	}
+	return fmt.Sprintf("[CronJob Guardian] %s digest: %d of %d CronJobs failing", period, d.Failing(), len(d.CronJobs))
+}
+
+// CronJobURL returns the dashboard page for a CronJob in the digest
+func (d Digest) CronJobURL(cj DigestCronJob) string {
+	return dashboardCronJobURL(d.DashboardURL, types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name})
+}
+
+// DigestWindow returns the period covered by the most recent digest at or before now
+func DigestWindow(cfg *v1alpha1.EmailDigestConfig, now time.Time) (start, end time.Time, err error) {
+	loc := time.UTC
+	if cfg.Timezone != "" {
+		if loc, err = time.LoadLocation(cfg.Timezone); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+		}
+	}
+
+	hour, minute, err := parseDigestTime(cfg.Time)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	local := now.In(loc)
+	end = time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, loc)
+
+	if cfg.Schedule == digestScheduleWeekly {
+		weekday := defaultDigestWeekday
+		if cfg.Weekday != "" {
+			if weekday, err = parseWeekday(cfg.Weekday); err != nil {
+				return time.Time{}, time.Time{}, err
+			}
+		}
+		end = end.AddDate(0, 0, -((int(local.Weekday()) - int(weekday) + 7) % 7))
+		if end.After(now) {
+			end = end.AddDate(0, 0, -7)
+		}
+		return end.AddDate(0, 0, -7), end, nil
+	}
+
+	if end.After(now) {
+		end = end.AddDate(0, 0, -1)
+	}
+	return end.AddDate(0, 0, -1), end, nil
+}
+
+// parseDigestTime parses the digest's HH:MM time of day
+func parseDigestTime(s string) (int, int, error) {
+	if s == "" {
+		s = defaultDigestTime
+	}
+	return parseTimeOfDay(s)
+}
+
+// parseTimeOfDay parses an HH:MM time of day
+func parseTimeOfDay(s string) (int, int, error) {
+	hourStr, minuteStr, ok := strings.Cut(s, ":")
+	hour, hourErr := strconv.Atoi(hourStr)
+	minute, minuteErr := strconv.Atoi(minuteStr)
+	if !ok || hourErr != nil || minuteErr != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return hour, minute, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+var digestTextTemplate = template.Must(template.New("digest").Funcs(templateFuncs).Parse(`CronJob Guardian {{ .Schedule }} digest
+{{ formatTime .Start "2006-01-02 15:04 MST" }} - {{ formatTime .End "2006-01-02 15:04 MST" }}
+
+{{ len .CronJobs }} CronJobs, {{ .TotalRuns }} runs, {{ .TotalFailures }} failures
+{{ range .CronJobs }}
+{{ .Namespace }}/{{ .Name }}: {{ .Status }}{{ if .Suspended }} (suspended){{ end }}
+  Runs: {{ .Runs }}, failures: {{ .Failures }}, success rate: {{ printf "%.1f" .SuccessRate }}%, alerts: {{ .Alerts }}
+{{- with $.CronJobURL . }}
+  {{ . }}
+{{- end }}
+{{ end }}
+--
+CronJob Guardian
+`))
+
+var digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest").Funcs(htmltemplate.FuncMap(templateFuncs)).Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; color: #1f2937; margin: 0; padding: 24px;">
+<h2 style="margin: 0 0 4px 0;">CronJob Guardian {{ .Schedule }} digest</h2>
+<p style="margin: 0 0 16px 0; color: #6b7280;">{{ formatTime .Start "2006-01-02 15:04 MST" }} &ndash; {{ formatTime .End "2006-01-02 15:04 MST" }}</p>
+<p>{{ len .CronJobs }} CronJobs &middot; {{ .TotalRuns }} runs &middot; {{ .TotalFailures }} failures &middot; {{ .Failing }} failing</p>
+<table cellpadding="6" style="border-collapse: collapse; border: 1px solid #e5e7eb;">
+<tr style="background: #f3f4f6; text-align: left;"><th>CronJob</th><th>Status</th><th>Runs</th><th>Failures</th><th>Success rate</th><th>Alerts</th><th>Last success</th></tr>
+{{- range .CronJobs }}
+<tr style="border-top: 1px solid #e5e7eb;">
+{{- $url := $.CronJobURL . }}
+<td>{{ if $url }}<a href="{{ $url }}">{{ .Namespace }}/{{ .Name }}</a>{{ else }}{{ .Namespace }}/{{ .Name }}{{ end }}</td>
+<td style="color: {{ if eq .Status "critical" }}#dc2626{{ else if eq .Status "warning" }}#d97706{{ else if eq .Status "healthy" }}#16a34a{{ else }}#6b7280{{ end }};">{{ .Status }}{{ if .Suspended }} (suspended){{ end }}</td>
+<td>{{ .Runs }}</td>
+<td>{{ .Failures }}</td>
+<td>{{ printf "%.1f" .SuccessRate }}%</td>
+<td>{{ .Alerts }}</td>
+<td>{{ if .LastSuccess.IsZero }}-{{ else }}{{ formatTime .LastSuccess "2006-01-02 15:04 MST" }}{{ end }}</td>
+</tr>
+{{- end }}
+</table>
+{{- if .DashboardURL }}
+<p><a href="{{ .DashboardURL }}">Open dashboard</a></p>
+{{- end }}
+<p style="color: #9ca3af; font-size: 12px;">CronJob Guardian</p>
+</body>
+</html>
+`))
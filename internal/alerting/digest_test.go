@@ -0,0 +1,77 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestDigestWindow(t *testing.T) {
+	// Wednesday
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		cfg       v1alpha1.EmailDigestConfig
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "daily default time already passed today",
+			cfg:       v1alpha1.EmailDigestConfig{Schedule: "daily"},
+			wantStart: time.Date(2024, 1, 9, 9, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily time later today uses yesterday",
+			cfg:       v1alpha1.EmailDigestConfig{Schedule: "daily", Time: "18:30"},
+			wantStart: time.Date(2024, 1, 8, 18, 30, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 9, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly default Monday",
+			cfg:       v1alpha1.EmailDigestConfig{Schedule: "weekly"},
+			wantStart: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly on today but later goes back a week",
+			cfg:       v1alpha1.EmailDigestConfig{Schedule: "weekly", Weekday: "Wednesday", Time: "13:00"},
+			wantStart: time.Date(2023, 12, 27, 13, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 3, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			// 12:00 UTC is 07:00 in New York, before today's digest
+			name:      "timezone",
+			cfg:       v1alpha1.EmailDigestConfig{Schedule: "daily", Time: "09:00", Timezone: "America/New_York"},
+			wantStart: time.Date(2024, 1, 8, 14, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 1, 9, 14, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := DigestWindow(&tt.cfg, now)
+			require.NoError(t, err)
+			assert.True(t, tt.wantStart.Equal(start), "start = %s, want %s", start, tt.wantStart)
+			assert.True(t, tt.wantEnd.Equal(end), "end = %s, want %s", end, tt.wantEnd)
+		})
+	}
+}
+
+func TestDigestWindow_Invalid(t *testing.T) {
+	now := time.Now()
+
+	_, _, err := DigestWindow(&v1alpha1.EmailDigestConfig{Schedule: "daily", Time: "25:00"}, now)
+	assert.Error(t, err)
+
+	_, _, err = DigestWindow(&v1alpha1.EmailDigestConfig{Schedule: "weekly", Weekday: "Someday"}, now)
+	assert.Error(t, err)
+
+	_, _, err = DigestWindow(&v1alpha1.EmailDigestConfig{Schedule: "daily", Timezone: "Nowhere/Land"}, now)
+	assert.Error(t, err)
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"golang.org/x/time/rate"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,67 +22,207 @@ import (
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
+// loggerName names the alerting logs, so their level can be changed on its own
+const loggerName = "alerting"
+
+// packageLog is used where no context is at hand
+var packageLog = log.Log.WithName(loggerName)
+
+// loggerFor returns the logger of ctx named for alerting
+func loggerFor(ctx context.Context) logr.Logger {
+	return log.FromContext(ctx).WithName(loggerName)
+}
+
 type dispatcher struct {
 	channels                     map[string]Channel       // name -> channel
 	channelStats                 map[string]*ChannelStats // name -> stats
+	sendSettings                 map[string]sendSettings  // channel name -> send timeout and retries, guarded by channelMu
 	sentAlerts                   map[string]time.Time     // alertKey -> lastSent
 	activeAlerts                 map[string]Alert         // alertKey -> alert
+	acknowledged                 map[string]string        // alertKey -> who acknowledged it
+	resolvers                    map[string][]string      // alertKey -> channels the alert was routed to, resolved when it clears
 	pendingAlerts                map[string]*PendingAlert // alertKey -> pending alert (delayed)
 	globalLimiter                *rate.Limiter
+	channelLimiters              map[string]*rate.Limiter // channel name -> rate limiter
+	monitorLimiters              map[string]*rate.Limiter // monitor namespace/name -> rate limiter
+	limiterMu                    sync.Mutex
 	channelMu                    sync.RWMutex
 	alertMu                      sync.RWMutex
 	statsMu                      sync.RWMutex
 	pendingMu                    sync.RWMutex
 	alertCount24h                int32
+	suppressedCount              int64 // alerts suppressed since startup, accessed atomically
 	client                       client.Client
-	store                        store.Store   // Store for persisting alerts
-	cleanupDone                  chan struct{} // Signal channel for cleanup goroutine shutdown
-	startupGracePeriod           time.Duration // Grace period after startup to suppress alerts
-	readyAt                      time.Time     // Time when dispatcher becomes ready (after grace period)
-	defaultSuppressDuplicatesFor time.Duration // Default duration to suppress duplicate alerts
+	store                        store.Store              // Store for persisting alerts
+	stateStore                   AlertStateStore          // Suppression state and claims (shared state or store)
+	shared                       SharedState              // Shared rate limits and delayed alerts (nil = in memory)
+	cleanupDone                  chan struct{}            // Signal channel for cleanup goroutine shutdown
+	startupGracePeriod           time.Duration            // Grace period after startup to suppress alerts
+	graceOverrides               map[string]time.Duration // Alert type or severity -> grace period replacing startupGracePeriod
+	startedAt                    time.Time                // Time the dispatcher was created
+	readyAt                      time.Time                // Time when dispatcher becomes ready (after grace period)
+	defaultSuppressDuplicatesFor time.Duration            // Default duration to suppress duplicate alerts
+	settingsMu                   sync.RWMutex             // Guards settings changed at runtime (readyAt, defaultSuppressDuplicatesFor)
+	alertSink                    AlertSink                // Receives every dispatched alert (nil = disabled)
+	identity                     string                   // Replica identity recorded on alert claims
+	ownership                    config.OwnershipConfig   // Maps CronJobs to owning teams
+	clusterClients               map[string]client.Client // Remote cluster name -> client
+	elected                      <-chan struct{}          // Leader election signal (nil = always leading)
+	electedMu                    sync.RWMutex
+	groups                       map[string]*alertGroup // AlertRoute group key -> alerts waiting to be sent together
+	groupMu                      sync.Mutex
+	coalescing                   config.AlertCoalescingConfig // Rolls up alerts sharing a cause across CronJobs
+	bursts                       map[string]*alertBurst       // Coalescing key -> alerts held to be rolled up
+	burstMu                      sync.Mutex
+	channelHTTP                  channelHTTP // Default proxy and CAs of channel requests
+	dashboardURL                 string      // External dashboard URL channels link alerts to
+}
+
+// AlertSink receives every alert the dispatcher sends, independent of the
+// channels it is delivered to. PublishAlert must not block.
+type AlertSink interface {
+	PublishAlert(ctx context.Context, alert Alert)
+}
+
+// AlertResolveSink is implemented by alert sinks that also want to know when
+// a sent alert is cleared. ResolveAlert must not block.
+type AlertResolveSink interface {
+	ResolveAlert(ctx context.Context, alert Alert)
+}
+
+// multiSink fans alerts out to several sinks
+type multiSink []AlertSink
+
+// MultiSink combines alert sinks into one. Nil sinks are skipped; it returns
+// nil when no sink is left.
+func MultiSink(sinks ...AlertSink) AlertSink {
+	var m multiSink
+	for _, s := range sinks {
+		if s != nil {
+			m = append(m, s)
+		}
+	}
+	switch len(m) {
+	case 0:
+		return nil
+	case 1:
+		return m[0]
+	}
+	return m
+}
+
+func (m multiSink) PublishAlert(ctx context.Context, alert Alert) {
+	for _, s := range m {
+		s.PublishAlert(ctx, alert)
+	}
+}
+
+func (m multiSink) ResolveAlert(ctx context.Context, alert Alert) {
+	for _, s := range m {
+		if r, ok := s.(AlertResolveSink); ok {
+			r.ResolveAlert(ctx, alert)
+		}
+	}
+}
+
+// resolveInSink tells the alert sink that sent alerts were cleared
+func (d *dispatcher) resolveInSink(ctx context.Context, alerts []Alert) {
+	r, ok := d.alertSink.(AlertResolveSink)
+	if !ok {
+		return
+	}
+	for _, alert := range alerts {
+		r.ResolveAlert(ctx, alert)
+	}
 }
 
 // DispatcherConfig holds configuration for the dispatcher
 type DispatcherConfig struct {
 	// StartupGracePeriod is the grace period after startup to suppress alerts
 	StartupGracePeriod time.Duration
+	// StartupGraceOverrides replaces the grace period for alerts of a type or
+	// severity (see config.SchedulerConfig.StartupGraceOverrides)
+	StartupGraceOverrides map[string]time.Duration
 	// MaxAlertsPerMinute is the global rate limit for alerts
 	MaxAlertsPerMinute int
 	// BurstLimit is the maximum burst of alerts allowed
 	BurstLimit int
 	// DefaultSuppressDuplicatesFor is the default duration to suppress duplicate alerts
 	DefaultSuppressDuplicatesFor time.Duration
+	// AlertSink optionally receives every dispatched alert, e.g. to publish it to an event bus
+	AlertSink AlertSink
+	// Identity identifies this replica on alert claims (e.g. the pod name)
+	Identity string
+	// SharedState optionally keeps suppression state, rate limit counters and
+	// delayed alerts outside the process (e.g. in Redis) instead of the store
+	// and memory
+	SharedState SharedState
+	// Ownership maps alerted CronJobs to their owning team
+	Ownership config.OwnershipConfig
+	// ClusterClients look up the CronJobs and monitors of alerts from remote
+	// clusters, keyed by cluster name (see ForCluster)
+	ClusterClients map[string]client.Client
+	// Coalescing rolls up alerts sharing a cause, e.g. the same image and
+	// reason, across CronJobs into one alert
+	Coalescing config.AlertCoalescingConfig
+	// ChannelHTTP is the default proxy and CAs of channel requests, see
+	// ValidateChannelHTTP
+	ChannelHTTP config.ChannelHTTPConfig
+	// DashboardURL is the external URL of the dashboard that channels link
+	// alerted CronJobs to (ui.external-url; empty = no links)
+	DashboardURL string
 }
 
 // NewDispatcher creates a new alert dispatcher
 func NewDispatcher(c client.Client, s store.Store, cfg DispatcherConfig) Dispatcher {
-	// Calculate rate limit: alerts per second
-	ratePerSecond := float64(cfg.MaxAlertsPerMinute) / 60.0
-	if ratePerSecond <= 0 {
-		ratePerSecond = 50.0 / 60.0 // Default: 50 per minute
-	}
-	burstLimit := cfg.BurstLimit
-	if burstLimit <= 0 {
-		burstLimit = 10 // Default burst
-	}
+	limit, burstLimit := globalLimit(cfg.MaxAlertsPerMinute, cfg.BurstLimit)
+	now := time.Now()
 
 	d := &dispatcher{
 		channels:                     make(map[string]Channel),
 		channelStats:                 make(map[string]*ChannelStats),
+		sendSettings:                 make(map[string]sendSettings),
 		sentAlerts:                   make(map[string]time.Time),
 		activeAlerts:                 make(map[string]Alert),
+		acknowledged:                 make(map[string]string),
+		resolvers:                    make(map[string][]string),
 		pendingAlerts:                make(map[string]*PendingAlert),
-		globalLimiter:                rate.NewLimiter(rate.Limit(ratePerSecond), burstLimit),
+		globalLimiter:                rate.NewLimiter(limit, burstLimit),
+		channelLimiters:              make(map[string]*rate.Limiter),
+		monitorLimiters:              make(map[string]*rate.Limiter),
 		client:                       c,
 		cleanupDone:                  make(chan struct{}),
 		startupGracePeriod:           cfg.StartupGracePeriod,
-		readyAt:                      time.Now().Add(cfg.StartupGracePeriod),
+		graceOverrides:               cfg.StartupGraceOverrides,
+		startedAt:                    now,
+		readyAt:                      now.Add(cfg.StartupGracePeriod),
 		store:                        s,
 		defaultSuppressDuplicatesFor: cfg.DefaultSuppressDuplicatesFor,
+		alertSink:                    cfg.AlertSink,
+		identity:                     cfg.Identity,
+		ownership:                    cfg.Ownership,
+		clusterClients:               cfg.ClusterClients,
+		groups:                       make(map[string]*alertGroup),
+		coalescing:                   coalescingDefaults(cfg.Coalescing),
+		bursts:                       make(map[string]*alertBurst),
+		dashboardURL:                 cfg.DashboardURL,
+	}
+	channelHTTP, err := newChannelHTTP(cfg.ChannelHTTP)
+	if err != nil {
+		packageLog.Error(err, "invalid channel HTTP configuration, using the system defaults")
+		channelHTTP, _ = newChannelHTTP(config.ChannelHTTPConfig{})
+	}
+	d.channelHTTP = channelHTTP
+	if cfg.SharedState != nil {
+		d.shared = cfg.SharedState
+		d.stateStore = cfg.SharedState
+	} else if s != nil {
+		d.stateStore = s
 	}
 	d.startCleanup()
+	d.startDeliveryRetry()
 	d.loadChannelStats()
-	d.loadRecentAlerts()
+	d.ReloadAlertState(context.Background())
 	return d
 }
 
@@ -87,7 +230,7 @@ func NewDispatcher(c client.Client, s store.Store, cfg DispatcherConfig) Dispatc
 // If alertCfg.AlertDelay is set, the alert is queued and sent after the delay
 // unless cancelled by CancelPendingAlert.
 func (d *dispatcher) Dispatch(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) error {
-	logger := log.FromContext(ctx)
+	logger := loggerFor(ctx)
 
 	if alertCfg == nil || !isEnabled(alertCfg.Enabled) {
 		return nil
@@ -101,19 +244,24 @@ func (d *dispatcher) Dispatch(ctx context.Context, alert Alert, alertCfg *v1alph
 			alert.Type,
 		)
 	}
+	if alert.ID == "" {
+		alert.ID = uuid.NewString()
+	}
 
-	if time.Now().Before(d.readyAt) {
-		remaining := time.Until(d.readyAt).Round(time.Second)
+	if readyAt := d.readyTimeFor(alert); time.Now().Before(readyAt) {
+		remaining := time.Until(readyAt).Round(time.Second)
 		logger.V(1).Info(
 			"alert suppressed during startup grace period",
 			"key", alert.Key,
 			"remainingGracePeriod", remaining,
 		)
+		d.recordSuppressed()
 		return nil
 	}
 
 	if suppressed, reason := d.IsSuppressed(alert, alertCfg); suppressed {
 		logger.V(1).Info("alert suppressed", "key", alert.Key, "reason", reason)
+		d.recordSuppressed()
 		return nil
 	}
 
@@ -126,16 +274,52 @@ func (d *dispatcher) Dispatch(ctx context.Context, alert Alert, alertCfg *v1alph
 
 // dispatchImmediate sends an alert immediately without delay
 func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) error {
-	logger := log.FromContext(ctx)
+	logger := loggerFor(ctx)
+
+	// Only the leader sends, so a replica that lost leadership (or has not
+	// won it yet) cannot send alerts the leader is also sending
+	if !d.isLeader() {
+		logger.V(1).Info("alert not sent, not the leader", "alertKey", alert.Key)
+		return nil
+	}
+
+	// Checked at send time so delayed alerts honor silences and observe mode
+	// set while pending. The alert is not marked as sent, so it fires once the
+	// silence expires or the monitor is switched to enforce.
+	if muted, reason := d.monitorMuted(ctx, alert.Cluster, alert.MonitorRef); muted {
+		logger.V(1).Info("alert suppressed", "key", alert.Key, "reason", reason)
+		return nil
+	}
+
+	d.annotateOwnership(ctx, &alert, alertCfg)
 
-	if !d.globalLimiter.Allow() {
-		logger.Info("alert rate limited", "key", alert.Key)
-		return fmt.Errorf("global rate limit exceeded")
+	routes := d.routeAlert(ctx, alert)
+	if source := d.inhibitedBy(routes, alert); source != "" {
+		logger.V(1).Info("alert suppressed", "key", alert.Key, "reason", "inhibited by "+source)
+		d.recordSuppressed()
+		return nil
+	}
+
+	if d.coalesce(alert, alertCfg, routes) {
+		logger.V(1).Info("alert held for coalescing", "key", alert.Key)
+		return nil
 	}
 
-	targetChannels := d.resolveChannels(alertCfg, alert.Severity)
+	return d.deliverAlert(ctx, alert, alertCfg, routes)
+}
+
+// deliverAlert sends a routed alert to its channels and AlertRoute groups
+func (d *dispatcher) deliverAlert(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig, routes routing) error {
+	logger := loggerFor(ctx)
 
-	if len(targetChannels) == 0 {
+	var targetChannels []Channel
+	if !routes.override {
+		targetChannels = d.resolveChannels(alertCfg, alert.Severity)
+	}
+	targetChannels = d.appendRouteChannels(targetChannels, routes.refs, alert.Severity)
+	hooks := d.monitorHooks(ctx, alert)
+
+	if len(targetChannels) == 0 && len(routes.grouped) == 0 && len(hooks) == 0 {
 		logger.V(1).Info(
 			"no channels configured for alert",
 			"alertKey", alert.Key,
@@ -145,6 +329,15 @@ func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCf
 		return nil
 	}
 
+	// Grouped notifications are only limited by their channels when sent
+	if len(targetChannels) > 0 {
+		var err error
+		targetChannels, err = d.reserveAlert(ctx, alert, alertCfg, targetChannels)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Atomic suppression check + mark as sent to prevent TOCTOU race.
 	// We mark as sent BEFORE actually sending to prevent duplicate dispatches
 	// when concurrent goroutines try to send the same alert.
@@ -155,17 +348,35 @@ func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCf
 		return nil
 	}
 	// Mark as sent immediately (before releasing lock) to prevent duplicates
-	d.sentAlerts[alert.Key] = time.Now()
+	sentAt := time.Now()
+	d.sentAlerts[alert.Key] = sentAt
 	d.activeAlerts[alert.Key] = alert
+	d.resolvers[alert.Key] = namesOf(targetChannels)
 	d.alertCount24h++
 	d.alertMu.Unlock()
 
+	// The store claim catches alerts already sent by a previous leader whose
+	// state was not yet persisted or reloaded
+	if !d.claimAlert(ctx, alert, alertCfg, sentAt) {
+		logger.V(1).Info("alert suppressed", "key", alert.Key, "reason", "already sent by another replica")
+		return nil
+	}
+
+	d.persistAlertState(ctx, alert, sentAt, "")
+
+	if d.alertSink != nil {
+		d.alertSink.PublishAlert(ctx, alert)
+	}
+
+	d.callHooks(ctx, hooks, HookPhaseBefore, alert, nil)
+
 	channelInfo := make([]string, 0, len(targetChannels))
 	for _, ch := range targetChannels {
 		channelInfo = append(channelInfo, fmt.Sprintf("%s(%s)", ch.Name(), ch.Type()))
 	}
 	logger.Info(
 		"dispatching alert to channels",
+		"alertId", alert.ID,
 		"alertKey", alert.Key,
 		"alertType", alert.Type,
 		"severity", alert.Severity,
@@ -173,24 +384,26 @@ func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCf
 		"channels", strings.Join(channelInfo, ", "),
 	)
 
-	var errs []error
+	var failures []channelFailure
 	var channelNames []string
 	for _, ch := range targetChannels {
 		logger.V(1).Info(
 			"sending alert to channel",
 			"channel", ch.Name(),
 			"provider", ch.Type(),
+			"alertId", alert.ID,
 			"alertKey", alert.Key,
 		)
 
-		if err := ch.Send(ctx, alert); err != nil {
+		if err := d.send(ctx, ch, alert); err != nil {
 			logger.Error(
 				err, "failed to send alert to channel",
 				"channel", ch.Name(),
 				"provider", ch.Type(),
+				"alertId", alert.ID,
 				"alertKey", alert.Key,
 			)
-			errs = append(errs, err)
+			failures = append(failures, channelFailure{channel: ch, err: err})
 
 			d.recordChannelFailure(ch.Name(), err)
 
@@ -206,6 +419,7 @@ func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCf
 				"alert sent successfully",
 				"channel", ch.Name(),
 				"provider", ch.Type(),
+				"alertId", alert.ID,
 				"alertKey", alert.Key,
 			)
 			channelNames = append(channelNames, ch.Name())
@@ -222,44 +436,93 @@ func (d *dispatcher) dispatchImmediate(ctx context.Context, alert Alert, alertCf
 		}
 	}
 
-	if d.store != nil && len(channelNames) > 0 {
-		alertHistory := store.AlertHistory{
-			Type:             alert.Type,
-			Severity:         alert.Severity,
-			Title:            alert.Title,
-			Message:          alert.Message,
-			CronJobNamespace: alert.CronJob.Namespace,
-			CronJobName:      alert.CronJob.Name,
-			MonitorNamespace: alert.MonitorRef.Namespace,
-			MonitorName:      alert.MonitorRef.Name,
-			OccurredAt:       alert.Timestamp,
-			ExitCode:         alert.Context.ExitCode,
-			Reason:           alert.Context.Reason,
-			SuggestedFix:     alert.Context.SuggestedFix,
-		}
-		alertHistory.SetChannelsNotified(channelNames)
-		if err := d.store.StoreAlert(ctx, alertHistory); err != nil {
-			logger.Error(err, "failed to store alert in history")
+	if len(channelNames) > 0 {
+		d.storeAlertHistory(ctx, alert, channelNames)
+	}
+
+	// Queue failed sends for retry; if nothing was delivered the first
+	// successful retry records the alert in history instead.
+	d.enqueueDeliveries(ctx, alert, failures, len(channelNames) == 0)
+
+	if len(hooks) > 0 {
+		delivery := &HookDelivery{Notified: append([]string{}, channelNames...)}
+		for _, f := range failures {
+			delivery.Failed = append(delivery.Failed, f.channel.Name())
 		}
+		d.callHooks(ctx, hooks, HookPhaseAfter, alert, delivery)
+	}
+
+	if len(routes.grouped) > 0 {
+		d.addToGroups(routes.grouped, alert, len(channelNames) > 0)
 	}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("failed to send to %d channels", len(errs))
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to send to %d channels", len(failures))
 	}
 	return nil
 }
 
+// storeAlertHistory records a delivered alert in the store
+func (d *dispatcher) storeAlertHistory(ctx context.Context, alert Alert, channelNames []string) {
+	if d.store == nil {
+		return
+	}
+
+	alertHistory := store.AlertHistory{
+		AlertID:          alert.ID,
+		Cluster:          alert.Cluster,
+		Type:             alert.Type,
+		Severity:         alert.Severity,
+		Title:            alert.Title,
+		Message:          alert.Message,
+		CronJobNamespace: alert.CronJob.Namespace,
+		CronJobName:      alert.CronJob.Name,
+		MonitorNamespace: alert.MonitorRef.Namespace,
+		MonitorName:      alert.MonitorRef.Name,
+		OccurredAt:       alert.Timestamp,
+		JobName:          alert.Context.JobName,
+		ExitCode:         alert.Context.ExitCode,
+		Reason:           alert.Context.Reason,
+		SuggestedFix:     alert.Context.SuggestedFix,
+		RunbookURL:       alert.RunbookURL,
+	}
+	alertHistory.SetChannelsNotified(channelNames)
+	if err := d.store.StoreAlert(ctx, alertHistory); err != nil {
+		loggerFor(ctx).Error(err, "failed to store alert in history")
+	}
+}
+
 // RegisterChannel adds or updates an alert channel
 func (d *dispatcher) RegisterChannel(ac *v1alpha1.AlertChannel) error {
 	ch, err := d.createChannel(ac)
 	if err != nil {
 		return err
 	}
+	if hc, ok := ch.(httpChannel); ok {
+		httpClient, err := d.httpClientFor(context.Background(), ac)
+		if err != nil {
+			return err
+		}
+		hc.setHTTPClient(httpClient)
+	}
+	if dc, ok := ch.(dashboardChannel); ok {
+		dc.setDashboardURL(d.dashboardURL)
+	}
 
 	d.channelMu.Lock()
 	d.channels[ac.Name] = ch
+	d.sendSettings[ac.Name] = sendSettingsFor(ac.Spec)
 	d.channelMu.Unlock()
 
+	// Every channel is rate limited, with defaults when not configured
+	rateLimiting := ac.Spec.RateLimiting
+	if rateLimiting == nil {
+		rateLimiting = &v1alpha1.RateLimitConfig{}
+	}
+	d.limiterMu.Lock()
+	updateLimiter(d.channelLimiters, ac.Name, rateLimiting)
+	d.limiterMu.Unlock()
+
 	return nil
 }
 
@@ -267,7 +530,12 @@ func (d *dispatcher) RegisterChannel(ac *v1alpha1.AlertChannel) error {
 func (d *dispatcher) RemoveChannel(name string) {
 	d.channelMu.Lock()
 	delete(d.channels, name)
+	delete(d.sendSettings, name)
 	d.channelMu.Unlock()
+
+	d.limiterMu.Lock()
+	delete(d.channelLimiters, name)
+	d.limiterMu.Unlock()
 }
 
 // SendToChannel sends to a specific channel (for testing)
@@ -279,8 +547,11 @@ func (d *dispatcher) SendToChannel(ctx context.Context, channelName string, aler
 	if !ok {
 		return fmt.Errorf("channel %s not found", channelName)
 	}
+	if !d.allowChannel(channelName) {
+		return fmt.Errorf("rate limit exceeded for channel %s", channelName)
+	}
 
-	return ch.Send(ctx, alert)
+	return d.send(ctx, ch, alert)
 }
 
 // IsSuppressed checks if an alert should be suppressed.
@@ -295,14 +566,14 @@ func (d *dispatcher) IsSuppressed(alert Alert, alertCfg *v1alpha1.AlertingConfig
 // isSuppressedLocked checks if an alert should be suppressed.
 // Caller MUST hold alertMu (read or write lock).
 func (d *dispatcher) isSuppressedLocked(alert Alert, alertCfg *v1alpha1.AlertingConfig) (bool, string) {
+	if by, ok := d.acknowledged[alert.Key]; ok {
+		return true, "acknowledged by " + by
+	}
 	if lastSent, ok := d.sentAlerts[alert.Key]; ok {
-		suppressDuration := 1 * time.Hour
-		if alertCfg.SuppressDuplicatesFor != nil {
-			suppressDuration = alertCfg.SuppressDuplicatesFor.Duration
-		}
-		if time.Since(lastSent) < suppressDuration {
+		if time.Since(lastSent) < d.suppressWindow(alertCfg) {
 			if existingAlert, exists := d.activeAlerts[alert.Key]; exists {
-				if errorSignatureChanged(existingAlert.Context, alert.Context) {
+				if errorSignatureChanged(existingAlert.Context, alert.Context) ||
+					severityRank[alert.Severity] > severityRank[existingAlert.Severity] {
 					return false, ""
 				}
 			}
@@ -344,27 +615,142 @@ func errorSignatureChanged(old, new AlertContext) bool {
 }
 
 // ClearAlert clears an active alert
-func (d *dispatcher) ClearAlert(_ context.Context, alertKey string) error {
+func (d *dispatcher) ClearAlert(ctx context.Context, alertKey string) error {
 	d.alertMu.Lock()
+	_, sent := d.sentAlerts[alertKey]
+	alert, ok := d.activeAlerts[alertKey]
+	if !ok {
+		alert = Alert{Key: alertKey}
+	}
+	routedTo, routed := d.resolvers[alertKey]
 	delete(d.activeAlerts, alertKey)
 	delete(d.sentAlerts, alertKey)
+	delete(d.acknowledged, alertKey)
+	delete(d.resolvers, alertKey)
 	d.alertMu.Unlock()
+
+	d.ungroup(alertKey)
+	d.uncoalesce(alertKey)
+
+	if sent {
+		d.forgetAlertStates(ctx, []string{alertKey})
+		d.resolveInSink(ctx, []Alert{alert})
+		d.resolveInChannels(ctx, alert, routedTo, routed)
+	}
 	return nil
 }
 
-// ClearAlertsForMonitor clears all alerts for a monitor
+// Acknowledge silences an alert until it is cleared. A pending delayed alert
+// with the same key is cancelled. Returns false if the alert is not active.
+func (d *dispatcher) Acknowledge(alertKey, by string) bool {
+	d.alertMu.Lock()
+	// sentAlerts also covers alerts reloaded from history after a restart
+	sentAt, active := d.sentAlerts[alertKey]
+	alert, ok := d.activeAlerts[alertKey]
+	if !ok {
+		alert = Alert{Key: alertKey}
+	}
+	if active {
+		d.acknowledged[alertKey] = by
+	}
+	d.alertMu.Unlock()
+
+	if active {
+		d.persistAlertState(context.Background(), alert, sentAt, by)
+		d.CancelPendingAlert(alertKey)
+	}
+	return active
+}
+
+// ActiveAlertID returns the tracking ID of an active alert
+func (d *dispatcher) ActiveAlertID(alertKey string) string {
+	d.alertMu.RLock()
+	defer d.alertMu.RUnlock()
+	return d.activeAlerts[alertKey].ID
+}
+
+// ClearAlertsForMonitor clears all alerts for a monitor and drops its rate limiter
 func (d *dispatcher) ClearAlertsForMonitor(namespace, name string) {
 	prefix := fmt.Sprintf("%s/%s/", namespace, name)
 
-	d.alertMu.Lock()
-	defer d.alertMu.Unlock()
+	d.limiterMu.Lock()
+	delete(d.monitorLimiters, namespace+"/"+name)
+	d.limiterMu.Unlock()
 
-	for key := range d.activeAlerts {
+	var cleared []string
+	var resolved []Alert
+	routedTo := make(map[string][]string)
+	d.alertMu.Lock()
+	for key, alert := range d.activeAlerts {
 		if strings.HasPrefix(key, prefix) {
+			if names, ok := d.resolvers[key]; ok {
+				routedTo[key] = names
+			}
 			delete(d.activeAlerts, key)
 			delete(d.sentAlerts, key)
+			delete(d.acknowledged, key)
+			delete(d.resolvers, key)
+			cleared = append(cleared, key)
+			resolved = append(resolved, alert)
 		}
 	}
+	d.alertMu.Unlock()
+
+	d.ungroup(cleared...)
+	d.uncoalesce(cleared...)
+
+	ctx := context.Background()
+	d.forgetAlertStates(ctx, cleared)
+	d.resolveInSink(ctx, resolved)
+	for _, alert := range resolved {
+		names, routed := routedTo[alert.Key]
+		d.resolveInChannels(ctx, alert, names, routed)
+	}
+}
+
+// resolveInChannels resolves a cleared alert in the channels it was routed to
+// that support it (see Resolver). When the channels are not known, e.g. for
+// alerts reloaded from persisted state, all such channels are used: a resolve
+// for an alert a channel never received is ignored.
+func (d *dispatcher) resolveInChannels(ctx context.Context, alert Alert, routedTo []string, routed bool) {
+	if !d.isLeader() {
+		return
+	}
+	logger := loggerFor(ctx)
+
+	var resolvers []Channel
+	d.channelMu.RLock()
+	for name, ch := range d.channels {
+		if _, ok := ch.(Resolver); ok && (!routed || slices.Contains(routedTo, name)) {
+			resolvers = append(resolvers, ch)
+		}
+	}
+	d.channelMu.RUnlock()
+
+	for _, ch := range resolvers {
+		if err := d.resolveOnce(ctx, ch, alert); err != nil {
+			logger.Error(err, "failed to resolve alert in channel",
+				"channel", ch.Name(), "provider", ch.Type(), "alertKey", alert.Key)
+			continue
+		}
+		logger.V(1).Info("resolved alert in channel", "channel", ch.Name(), "provider", ch.Type(), "alertKey", alert.Key)
+	}
+}
+
+// resolveOnce resolves an alert in a channel within its send timeout
+func (d *dispatcher) resolveOnce(ctx context.Context, ch Channel, alert Alert) error {
+	ctx, cancel := context.WithTimeout(ctx, d.sendSettingsOf(ch.Name()).timeout)
+	defer cancel()
+	return ch.(Resolver).Resolve(ctx, alert)
+}
+
+// namesOf returns the names of channels
+func namesOf(channels []Channel) []string {
+	names := make([]string, 0, len(channels))
+	for _, ch := range channels {
+		names = append(names, ch.Name())
+	}
+	return names
 }
 
 // queueDelayedAlert queues an alert to be sent after the configured delay.
@@ -376,7 +762,7 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 
 	if existing, ok := d.pendingAlerts[alert.Key]; ok {
 		d.pendingMu.Unlock()
-		log.Log.V(1).Info(
+		packageLog.V(1).Info(
 			"alert already pending",
 			"key", alert.Key,
 			"sendAt", existing.SendAt,
@@ -393,7 +779,7 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 	d.pendingAlerts[alert.Key] = pending
 	d.pendingMu.Unlock()
 
-	log.Log.Info(
+	packageLog.Info(
 		"alert queued with delay",
 		"key", alert.Key,
 		"delay", delay,
@@ -401,8 +787,18 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 		"cronjob", fmt.Sprintf("%s/%s", alert.CronJob.Namespace, alert.CronJob.Name),
 	)
 
+	d.queueSharedPending(context.Background(), pending)
+	d.waitPending(pending)
+	return nil
+}
+
+// waitPending sends a pending alert once its delay expires, unless it is
+// cancelled first
+func (d *dispatcher) waitPending(pending *PendingAlert) {
+	alert, alertCfg := pending.Alert, pending.AlertCfg
+
 	go func() {
-		timer := time.NewTimer(delay)
+		timer := time.NewTimer(time.Until(pending.SendAt))
 		defer timer.Stop()
 
 		select {
@@ -414,8 +810,8 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 			}
 			d.pendingMu.Unlock()
 
-			if stillPending {
-				log.Log.Info(
+			if stillPending && d.takeSharedPending(context.Background(), alert.Key) {
+				packageLog.Info(
 					"alert delay expired, dispatching",
 					"key", alert.Key,
 					"cronjob", fmt.Sprintf("%s/%s", alert.CronJob.Namespace, alert.CronJob.Name),
@@ -423,7 +819,7 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 
 				ctx := context.Background()
 				if err := d.dispatchImmediate(ctx, alert, alertCfg); err != nil {
-					log.Log.Error(err, "failed to dispatch delayed alert", "key", alert.Key)
+					packageLog.Error(err, "failed to dispatch delayed alert", "key", alert.Key)
 				}
 			}
 
@@ -432,50 +828,55 @@ func (d *dispatcher) queueDelayedAlert(alert Alert, alertCfg *v1alpha1.AlertingC
 			delete(d.pendingAlerts, alert.Key)
 			d.pendingMu.Unlock()
 
-			log.Log.Info(
+			packageLog.Info(
 				"pending alert cancelled",
 				"key", alert.Key,
 				"cronjob", fmt.Sprintf("%s/%s", alert.CronJob.Namespace, alert.CronJob.Name),
 			)
 		}
 	}()
-
-	return nil
 }
 
 // CancelPendingAlert cancels a pending (delayed) alert before it's sent.
 // Returns true if an alert was cancelled, false if no pending alert was found.
 func (d *dispatcher) CancelPendingAlert(alertKey string) bool {
 	d.pendingMu.Lock()
-	defer d.pendingMu.Unlock()
-
-	if pending, ok := d.pendingAlerts[alertKey]; ok {
+	pending, ok := d.pendingAlerts[alertKey]
+	if ok {
 		pending.Close()
 		delete(d.pendingAlerts, alertKey)
-		return true
 	}
-	return false
+	d.pendingMu.Unlock()
+
+	if ok {
+		d.takeSharedPending(context.Background(), alertKey)
+	}
+	return ok
 }
 
 // CancelPendingAlertsForCronJob cancels all pending alerts for a specific CronJob.
 // Returns the number of alerts cancelled.
 func (d *dispatcher) CancelPendingAlertsForCronJob(namespace, name string) int {
 	prefix := fmt.Sprintf("%s/%s/", namespace, name)
-	cancelled := 0
+	var keys []string
 
 	d.pendingMu.Lock()
-	defer d.pendingMu.Unlock()
-
 	for key, pending := range d.pendingAlerts {
 		if strings.HasPrefix(key, prefix) {
 			pending.Close()
 			delete(d.pendingAlerts, key)
-			cancelled++
+			keys = append(keys, key)
 		}
 	}
+	d.pendingMu.Unlock()
 
+	for _, key := range keys {
+		d.takeSharedPending(context.Background(), key)
+	}
+
+	cancelled := len(keys)
 	if cancelled > 0 {
-		log.Log.Info(
+		packageLog.Info(
 			"cancelled pending alerts for cronjob",
 			"namespace", namespace,
 			"name", name,
@@ -486,14 +887,59 @@ func (d *dispatcher) CancelPendingAlertsForCronJob(namespace, name string) int {
 	return cancelled
 }
 
-// SetGlobalRateLimits updates global rate limits
-func (d *dispatcher) SetGlobalRateLimits(limits config.RateLimitsConfig) {
-	maxPerMinute := limits.MaxAlertsPerMinute
+// globalLimit returns the rate and burst of the global limiter, defaulting
+// to 50 alerts per minute with a burst of 10
+func globalLimit(maxPerMinute, burst int) (rate.Limit, int) {
 	if maxPerMinute <= 0 {
 		maxPerMinute = 50
 	}
+	if burst <= 0 {
+		burst = 10
+	}
+	return rate.Limit(float64(maxPerMinute) / 60.0), burst
+}
 
-	d.globalLimiter = rate.NewLimiter(rate.Limit(float64(maxPerMinute)/60.0), 10)
+// SetGlobalRateLimits updates global rate limits and the default duplicate
+// suppression window. The global limiter keeps its remaining budget.
+func (d *dispatcher) SetGlobalRateLimits(limits config.RateLimitsConfig) {
+	limit, burst := globalLimit(limits.MaxAlertsPerMinute, limits.BurstLimit)
+	d.globalLimiter.SetLimit(limit)
+	d.globalLimiter.SetBurst(burst)
+
+	d.settingsMu.Lock()
+	d.defaultSuppressDuplicatesFor = limits.DefaultSuppressDuplicatesFor
+	d.settingsMu.Unlock()
+}
+
+// SetStartupGracePeriod changes the grace period, counted from when the
+// dispatcher was created
+func (d *dispatcher) SetStartupGracePeriod(period time.Duration) {
+	d.settingsMu.Lock()
+	defer d.settingsMu.Unlock()
+	d.startupGracePeriod = period
+	d.readyAt = d.startedAt.Add(period)
+}
+
+// readyTime returns when the startup grace period ends
+func (d *dispatcher) readyTime() time.Time {
+	d.settingsMu.RLock()
+	defer d.settingsMu.RUnlock()
+	return d.readyAt
+}
+
+// readyTimeFor returns when the startup grace period of an alert ends, which
+// may be overridden for its type or severity
+func (d *dispatcher) readyTimeFor(alert Alert) time.Time {
+	d.settingsMu.RLock()
+	defer d.settingsMu.RUnlock()
+	if len(d.graceOverrides) == 0 {
+		return d.readyAt
+	}
+	scheduler := config.SchedulerConfig{
+		StartupGracePeriod:    d.startupGracePeriod,
+		StartupGraceOverrides: d.graceOverrides,
+	}
+	return d.startedAt.Add(scheduler.StartupGraceFor(alert.Type, alert.Severity))
 }
 
 // GetAlertCount24h returns alerts sent in last 24h
@@ -550,7 +996,7 @@ func (d *dispatcher) loadRecentAlerts() {
 
 	alerts, _, err := d.store.ListAlertHistory(ctx, query)
 	if err != nil {
-		log.Log.Error(err, "failed to load recent alerts on startup")
+		packageLog.Error(err, "failed to load recent alerts on startup")
 		return
 	}
 
@@ -571,11 +1017,12 @@ func (d *dispatcher) loadRecentAlerts() {
 		)
 
 		d.sentAlerts[alertKey] = alert.OccurredAt
+		d.resolvers[alertKey] = alert.GetChannelsNotified()
 		loaded++
 	}
 
 	if loaded > 0 {
-		log.Log.Info(
+		packageLog.Info(
 			"loaded recent alerts for duplicate suppression",
 			"count", loaded,
 			"since", since,
@@ -583,14 +1030,14 @@ func (d *dispatcher) loadRecentAlerts() {
 	}
 }
 
-// resolveChannels resolves channel refs to actual channels
+// resolveChannels resolves the channel refs routed to at this time to actual channels
 func (d *dispatcher) resolveChannels(alertCfg *v1alpha1.AlertingConfig, severity string) []Channel {
 	var channels []Channel
 
 	d.channelMu.RLock()
 	defer d.channelMu.RUnlock()
 
-	for _, ref := range alertCfg.ChannelRefs {
+	for _, ref := range RoutedChannelRefs(alertCfg, time.Now()) {
 		if ch, ok := d.channels[ref.Name]; ok {
 			if len(ref.Severities) == 0 || contains(ref.Severities, severity) {
 				channels = append(channels, ch)
@@ -611,7 +1058,19 @@ func (d *dispatcher) createChannel(ac *v1alpha1.AlertChannel) (Channel, error) {
 	case "webhook":
 		return NewWebhookChannel(d.client, ac)
 	case "email":
-		return NewEmailChannel(d.client, ac)
+		return NewEmailChannel(d.client, d.store, ac)
+	case "telegram":
+		return NewTelegramChannel(d.client, ac)
+	case "sns":
+		return NewSNSChannel(d.client, ac)
+	case "pubsub":
+		return NewPubSubChannel(d.client, ac)
+	case "eventgrid":
+		return NewEventGridChannel(d.client, ac)
+	case "ntfy":
+		return NewNtfyChannel(d.client, ac)
+	case "pushover":
+		return NewPushoverChannel(d.client, ac)
 	default:
 		return nil, fmt.Errorf("unknown channel type: %s", ac.Spec.Type)
 	}
@@ -702,9 +1161,11 @@ func (d *dispatcher) GetChannelStats(channelName string) *ChannelStats {
 	return nil
 }
 
-// Stop gracefully shuts down the dispatcher by signaling the cleanup goroutine to exit.
+// Stop gracefully shuts down the dispatcher by signaling the cleanup and retry goroutines to exit.
 func (d *dispatcher) Stop() error {
 	close(d.cleanupDone)
+	d.stopGroups()
+	d.stopBursts()
 	return nil
 }
 
@@ -748,6 +1209,7 @@ var templateFuncs = template.FuncMap{
 		}
 		return s[:n] + "..."
 	},
+	"join":  func(items []string, sep string) string { return strings.Join(items, sep) },
 	"upper": strings.ToUpper,
 	"lower": strings.ToLower,
 	"jsonEscape": func(s string) string {
@@ -757,6 +1219,13 @@ var templateFuncs = template.FuncMap{
 		}
 		return string(b)
 	},
+	"toJson": func(v any) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "null"
+		}
+		return string(b)
+	},
 }
 
 // startCleanup starts a background goroutine that periodically cleans up old alerts
@@ -778,18 +1247,26 @@ func (d *dispatcher) startCleanup() {
 }
 
 // cleanupOldAlerts removes alerts older than 24 hours from in-memory maps
+// and the persisted alert state
 func (d *dispatcher) cleanupOldAlerts() {
-	cutoff := time.Now().Add(-24 * time.Hour)
+	cutoff := time.Now().Add(-alertStateRetention)
 
 	d.alertMu.Lock()
-	defer d.alertMu.Unlock()
-
 	for key, sentTime := range d.sentAlerts {
 		if sentTime.Before(cutoff) {
 			delete(d.sentAlerts, key)
 			delete(d.activeAlerts, key)
+			delete(d.acknowledged, key)
+			delete(d.resolvers, key)
 		}
 	}
-
 	d.alertCount24h = int32(len(d.sentAlerts))
+	d.alertMu.Unlock()
+
+	if d.stateStore != nil {
+		if _, err := d.stateStore.PruneAlertStates(context.Background(), cutoff); err != nil {
+			packageLog.Error(err, "failed to prune alert state")
+		}
+	}
+	d.pruneAlertClaims(context.Background(), time.Now())
 }
@@ -8,14 +8,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
@@ -70,8 +77,13 @@ func (m *mockChannel) Reset() {
 
 // mockStore implements the store.Store interface for testing
 type mockStore struct {
+	executions   []store.Execution
 	alerts       []store.AlertHistory
 	channelStats map[string]*store.ChannelStatsRecord
+	deliveries   []store.AlertDelivery
+	alertStates  map[string]store.AlertState
+	alertClaims  []store.AlertClaim
+	claimErr     error
 	mu           sync.Mutex
 }
 
@@ -79,6 +91,7 @@ func newMockStore() *mockStore {
 	return &mockStore{
 		alerts:       make([]store.AlertHistory, 0),
 		channelStats: make(map[string]*store.ChannelStatsRecord),
+		alertStates:  make(map[string]store.AlertState),
 	}
 }
 
@@ -86,6 +99,9 @@ func (m *mockStore) Init() error
 func (m *mockStore) Health(_ context.Context) error                             { return nil }
 func (m *mockStore) Close() error                                               { return nil }
 func (m *mockStore) RecordExecution(_ context.Context, _ store.Execution) error { return nil }
+func (m *mockStore) RecordExecutions(_ context.Context, _ []store.Execution) error {
+	return nil
+}
 func (m *mockStore) GetExecutions(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
 	return nil, nil
 }
@@ -95,7 +111,7 @@ func (m *mockStore) GetExecutionsPaginated(_ context.Context, _ types.Namespaced
 func (m *mockStore) GetExecutionsFiltered(_ context.Context, _ types.NamespacedName, _ time.Time, _ string, _, _ int) (
 	[]store.Execution, int64, error,
 ) {
-	return nil, 0, nil
+	return m.executions, int64(len(m.executions)), nil
 }
 func (m *mockStore) GetLastExecution(_ context.Context, _ types.NamespacedName) (*store.Execution, error) {
 	return nil, nil
@@ -103,6 +119,18 @@ func (m *mockStore) GetLastExecution(_ context.Context, _ types.NamespacedName)
 func (m *mockStore) GetLastSuccessfulExecution(_ context.Context, _ types.NamespacedName) (*store.Execution, error) {
 	return nil, nil
 }
+func (m *mockStore) GetFailureStreak(_ context.Context, _ types.NamespacedName, _ time.Time) (int, error) {
+	return 0, nil
+}
+func (m *mockStore) GetOutcomeChanges(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
+	return nil, nil
+}
+func (m *mockStore) GetSuccessStreak(_ context.Context, _ types.NamespacedName, _ time.Time) (store.SuccessStreak, error) {
+	return store.SuccessStreak{}, nil
+}
+func (m *mockStore) GetOutputSizes(_ context.Context, _ types.NamespacedName, _ int) ([]float64, error) {
+	return nil, nil
+}
 func (m *mockStore) GetExecutionByJobName(_ context.Context, _, _ string) (*store.Execution, error) {
 	return nil, nil
 }
@@ -115,14 +143,40 @@ func (m *mockStore) GetDurationPercentile(_ context.Context, _ types.NamespacedN
 func (m *mockStore) GetMetrics(_ context.Context, _ types.NamespacedName, _ int) (*store.Metrics, error) {
 	return nil, nil
 }
-func (m *mockStore) Prune(_ context.Context, _ time.Time) (int64, error)     { return 0, nil }
-func (m *mockStore) PruneLogs(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) GetExecutionAnalytics(_ context.Context, _ types.NamespacedName, _ int) (*store.ExecutionAnalytics, error) {
+	return nil, nil
+}
+func (m *mockStore) GetCronJobUsage(_ context.Context, _ types.NamespacedName, _ time.Time) (*store.CronJobUsage, error) {
+	return nil, nil
+}
+func (m *mockStore) ListCronJobUsage(_ context.Context, _ time.Time) ([]store.CronJobUsage, error) {
+	return nil, nil
+}
+func (m *mockStore) Prune(_ context.Context, _ time.Time, _ ...store.RetentionOverride) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) PruneLogs(_ context.Context, _ time.Time, _ ...store.RetentionOverride) (int64, error) {
+	return 0, nil
+}
 func (m *mockStore) DeleteExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
 	return 0, nil
 }
 func (m *mockStore) DeleteExecutionsByUID(_ context.Context, _ types.NamespacedName, _ string) (int64, error) {
 	return 0, nil
 }
+func (m *mockStore) RollUpExecutions(_ context.Context, _, _ time.Time) (int, error) { return 0, nil }
+func (m *mockStore) GetDailyRollups(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.DailyRollup, error) {
+	return nil, nil
+}
+func (m *mockStore) LatestRollupDay(_ context.Context) (time.Time, error)       { return time.Time{}, nil }
+func (m *mockStore) PruneRollups(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) TrashExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) RestoreExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) PurgeTrash(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
 func (m *mockStore) GetCronJobUIDs(_ context.Context, _ types.NamespacedName) ([]string, error) {
 	return nil, nil
 }
@@ -130,7 +184,16 @@ func (m *mockStore) GetExecutionCount(_ context.Context) (int64, error) { return
 func (m *mockStore) GetExecutionCountSince(_ context.Context, _ time.Time) (int64, error) {
 	return 0, nil
 }
+func (m *mockStore) GetFailedExecutionsSince(_ context.Context, _ time.Time, _ int) ([]store.Execution, error) {
+	return nil, nil
+}
 
+func (m *mockStore) ExportExecutions(_ context.Context, _ int64, _ int) ([]store.Execution, error) {
+	return nil, nil
+}
+func (m *mockStore) ExportAlertHistory(_ context.Context, _ int64, _ int) ([]store.AlertHistory, error) {
+	return nil, nil
+}
 func (m *mockStore) StoreAlert(_ context.Context, alert store.AlertHistory) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -176,19 +239,190 @@ func (m *mockStore) GetAllChannelStats(_ context.Context) (map[string]*store.Cha
 	return result, nil
 }
 
+func (m *mockStore) EnqueueDelivery(_ context.Context, delivery store.AlertDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delivery.ID = int64(len(m.deliveries) + 1)
+	m.deliveries = append(m.deliveries, delivery)
+	return nil
+}
+
+func (m *mockStore) GetDueDeliveries(_ context.Context, now time.Time, _ int) ([]store.AlertDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var due []store.AlertDelivery
+	for _, d := range m.deliveries {
+		if d.Status == store.DeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+func (m *mockStore) UpdateDelivery(_ context.Context, delivery store.AlertDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.deliveries {
+		if m.deliveries[i].ID == delivery.ID {
+			// Like the store, keep the history flag: it is only cleared by a claim
+			delivery.RecordHistory = m.deliveries[i].RecordHistory
+			m.deliveries[i] = delivery
+		}
+	}
+	return nil
+}
+
+func (m *mockStore) ClaimDeliveryHistory(_ context.Context, alertID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	claimed := false
+	for i := range m.deliveries {
+		if m.deliveries[i].AlertID == alertID && m.deliveries[i].RecordHistory {
+			m.deliveries[i].RecordHistory = false
+			claimed = true
+		}
+	}
+	return claimed, nil
+}
+
+func (m *mockStore) ListDeliveries(_ context.Context, _ store.AlertDeliveryQuery) ([]store.AlertDelivery, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.deliveries, int64(len(m.deliveries)), nil
+}
+
+func (m *mockStore) PruneDeliveries(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+
+func (m *mockStore) SaveAlertState(_ context.Context, state store.AlertState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.alertStates[state.AlertKey] = state
+	return nil
+}
+
+func (m *mockStore) DeleteAlertStates(_ context.Context, alertKeys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range alertKeys {
+		delete(m.alertStates, key)
+	}
+	return nil
+}
+
+func (m *mockStore) ListAlertStates(_ context.Context, since time.Time) ([]store.AlertState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var states []store.AlertState
+	for _, state := range m.alertStates {
+		if !state.LastSentAt.Before(since) {
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
+
+func (m *mockStore) PruneAlertStates(_ context.Context, olderThan time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var pruned int64
+	for key, state := range m.alertStates {
+		if state.LastSentAt.Before(olderThan) {
+			delete(m.alertStates, key)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+func (m *mockStore) ClaimAlert(_ context.Context, claim store.AlertClaim, window time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.claimErr != nil {
+		return false, m.claimErr
+	}
+	for i, c := range m.alertClaims {
+		if c.AlertKey == claim.AlertKey && c.Signature == claim.Signature {
+			if c.WindowStart.After(claim.WindowStart.Add(-window)) {
+				return false, nil
+			}
+			m.alertClaims[i] = claim
+			return true, nil
+		}
+	}
+	m.alertClaims = append(m.alertClaims, claim)
+	return true, nil
+}
+
+func (m *mockStore) DeleteAlertClaims(_ context.Context, alertKeys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := m.alertClaims[:0]
+	for _, c := range m.alertClaims {
+		if !contains(alertKeys, c.AlertKey) {
+			kept = append(kept, c)
+		}
+	}
+	m.alertClaims = kept
+	return nil
+}
+
+func (m *mockStore) PruneAlertClaims(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *mockStore) RecordReceipt(_ context.Context, _ store.AlertReceipt) error { return nil }
+
+func (m *mockStore) ListReceipts(_ context.Context, _ []string) ([]store.AlertReceipt, error) {
+	return nil, nil
+}
+
+func (m *mockStore) SaveView(_ context.Context, _ store.SavedView) error { return nil }
+
+func (m *mockStore) GetView(_ context.Context, _ string) (*store.SavedView, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ListViews(_ context.Context) ([]store.SavedView, error) { return nil, nil }
+
+func (m *mockStore) DeleteView(_ context.Context, _ string) (bool, error) { return false, nil }
+
+func (m *mockStore) SetLegalHold(_ context.Context, _ store.LegalHold) error { return nil }
+
+func (m *mockStore) ReleaseLegalHold(_ context.Context, _ types.NamespacedName) (bool, error) {
+	return false, nil
+}
+
+func (m *mockStore) ListLegalHolds(_ context.Context) ([]store.LegalHold, error) { return nil, nil }
+
+// makeDeliveriesDue moves every queued delivery's next attempt into the past
+func (m *mockStore) makeDeliveriesDue() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.deliveries {
+		m.deliveries[i].NextAttemptAt = time.Now().Add(-time.Second)
+	}
+}
+
 // testDispatcher creates a dispatcher for testing with no grace period
 func testDispatcher(s store.Store) *dispatcher {
 	d := &dispatcher{
 		channels:           make(map[string]Channel),
 		channelStats:       make(map[string]*ChannelStats),
+		sendSettings:       make(map[string]sendSettings),
 		sentAlerts:         make(map[string]time.Time),
 		activeAlerts:       make(map[string]Alert),
+		acknowledged:       make(map[string]string),
+		resolvers:          make(map[string][]string),
 		pendingAlerts:      make(map[string]*PendingAlert),
 		globalLimiter:      rate.NewLimiter(rate.Inf, 100),
+		channelLimiters:    make(map[string]*rate.Limiter),
+		monitorLimiters:    make(map[string]*rate.Limiter),
 		cleanupDone:        make(chan struct{}),
 		startupGracePeriod: 0,
 		readyAt:            time.Now().Add(-time.Second),
 		store:              s,
+		stateStore:         s,
+		groups:             make(map[string]*alertGroup),
 	}
 	return d
 }
@@ -267,6 +501,117 @@ func TestDispatcher_Dispatch_MultipleChannels(t *testing.T) {
 	assert.Len(t, pdCh.GetSentAlerts(), 1)
 }
 
+type recordingAlertSink struct {
+	alerts   []Alert
+	resolved []Alert
+}
+
+func (s *recordingAlertSink) PublishAlert(_ context.Context, alert Alert) {
+	s.alerts = append(s.alerts, alert)
+}
+
+func (s *recordingAlertSink) ResolveAlert(_ context.Context, alert Alert) {
+	s.resolved = append(s.resolved, alert)
+}
+
+func TestDispatcher_Dispatch_AlertSink(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+	sink := &recordingAlertSink{}
+	d.alertSink = sink
+
+	ch := newMockChannel("slack-main", "slack")
+	ch.sendErr = errors.New("slack down")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	cfg := testAlertingConfig("slack-main")
+
+	// Published even though channel delivery failed
+	_ = d.Dispatch(ctx, alert, cfg)
+	require.Len(t, sink.alerts, 1)
+	assert.Equal(t, "default/test-cron/JobFailed", sink.alerts[0].Key)
+
+	// Suppressed duplicates are not published again
+	_ = d.Dispatch(ctx, alert, cfg)
+	assert.Len(t, sink.alerts, 1)
+}
+
+func TestDispatcher_ClearAlert_ResolvesInSink(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+	first := &recordingAlertSink{}
+	second := &recordingAlertSink{}
+	d.alertSink = MultiSink(first, nil, second)
+	d.channels["slack-main"] = newMockChannel("slack-main", "slack")
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(ctx, alert, testAlertingConfig("slack-main")))
+
+	// Clearing an alert that was never sent is not a resolution
+	require.NoError(t, d.ClearAlert(ctx, "default/other-cron/JobFailed"))
+	assert.Empty(t, first.resolved)
+
+	require.NoError(t, d.ClearAlert(ctx, "default/test-cron/JobFailed"))
+	for _, sink := range []*recordingAlertSink{first, second} {
+		require.Len(t, sink.alerts, 1)
+		require.Len(t, sink.resolved, 1)
+		assert.Equal(t, "default/test-cron/JobFailed", sink.resolved[0].Key)
+		assert.Equal(t, "JobFailed", sink.resolved[0].Type)
+	}
+}
+
+// resolvingChannel is a mock channel that implements Resolver
+type resolvingChannel struct {
+	*mockChannel
+	resolved []string
+}
+
+func (r *resolvingChannel) Resolve(_ context.Context, alert Alert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resolved = append(r.resolved, alert.Key)
+	return nil
+}
+
+func TestDispatcher_ClearAlert_ResolvesInChannels(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	routed := &resolvingChannel{mockChannel: newMockChannel("pagerduty-ops", "pagerduty")}
+	other := &resolvingChannel{mockChannel: newMockChannel("pagerduty-db", "pagerduty")}
+	d.channels["pagerduty-ops"] = routed
+	d.channels["pagerduty-db"] = other
+	d.channels["slack-main"] = newMockChannel("slack-main", "slack")
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(ctx, alert, testAlertingConfig("pagerduty-ops", "slack-main")))
+
+	require.NoError(t, d.ClearAlert(ctx, "default/other-cron/JobFailed"))
+	assert.Empty(t, routed.resolved, "alerts that were never sent are not resolved")
+
+	require.NoError(t, d.ClearAlert(ctx, alert.Key))
+	assert.Equal(t, []string{alert.Key}, routed.resolved)
+	assert.Empty(t, other.resolved, "only channels the alert was routed to are resolved")
+
+	// Without the routing, e.g. after a restart, every resolving channel is used
+	d.alertMu.Lock()
+	d.sentAlerts[alert.Key] = time.Now()
+	d.alertMu.Unlock()
+	require.NoError(t, d.ClearAlert(ctx, alert.Key))
+	assert.Len(t, routed.resolved, 2)
+	assert.Equal(t, []string{alert.Key}, other.resolved)
+}
+
+func TestMultiSink(t *testing.T) {
+	assert.Nil(t, MultiSink())
+	assert.Nil(t, MultiSink(nil))
+
+	sink := &recordingAlertSink{}
+	assert.Same(t, sink, MultiSink(nil, sink))
+}
+
 func TestDispatcher_Dispatch_ChannelNotFound(t *testing.T) {
 	mockStore := newMockStore()
 	d := testDispatcher(mockStore)
@@ -378,6 +723,28 @@ func TestDispatcher_IsSuppressed_DuplicateWithinWindow(t *testing.T) {
 	assert.Contains(t, reason, "duplicate")
 }
 
+func TestDispatcher_Diagnostics(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+	d.channels["slack-main"] = newMockChannel("slack-main", "slack")
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	cfg := testAlertingConfig("slack-main")
+
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	require.NoError(t, d.Dispatch(ctx, alert, cfg)) // duplicate, suppressed
+
+	diag := d.Diagnostics()
+	assert.Equal(t, 1, diag.Channels)
+	assert.Equal(t, 1, diag.ActiveAlerts)
+	assert.Equal(t, 1, diag.SuppressionKeys)
+	assert.Equal(t, int64(1), diag.SuppressedAlerts)
+	assert.Equal(t, 0, diag.PendingAlerts)
+	assert.False(t, diag.InStartupGracePeriod)
+	assert.Positive(t, diag.RateLimits.Global.PerMinute)
+}
+
 func TestDispatcher_IsSuppressed_DifferentAlerts(t *testing.T) {
 	d := testDispatcher(nil)
 
@@ -468,6 +835,23 @@ func TestDispatcher_IsSuppressed_SameErrorSignature(t *testing.T) {
 	assert.True(t, suppressed)
 }
 
+func TestDispatcher_IsSuppressed_SeverityEscalated(t *testing.T) {
+	d := testDispatcher(nil)
+
+	oldAlert := testAlert("default", "test-cron", "JobFailed", "warning")
+	d.alertMu.Lock()
+	d.sentAlerts[oldAlert.Key] = time.Now()
+	d.activeAlerts[oldAlert.Key] = oldAlert
+	d.alertMu.Unlock()
+
+	cfg := testAlertingConfig("slack-main")
+	suppressed, _ := d.IsSuppressed(testAlert("default", "test-cron", "JobFailed", "critical"), cfg)
+	assert.False(t, suppressed, "escalated alert is sent")
+
+	suppressed, _ = d.IsSuppressed(testAlert("default", "test-cron", "JobFailed", "info"), cfg)
+	assert.True(t, suppressed, "downgraded alert is a duplicate")
+}
+
 // ==================== ClearAlert Tests ====================
 
 func TestDispatcher_ClearAlert_RemovesFromActive(t *testing.T) {
@@ -493,6 +877,154 @@ func TestDispatcher_ClearAlert_RemovesFromActive(t *testing.T) {
 	assert.False(t, existsSent)
 }
 
+func TestDispatcher_Acknowledge(t *testing.T) {
+	d := testDispatcher(nil)
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	cfg := &v1alpha1.AlertingConfig{}
+
+	assert.False(t, d.Acknowledge(alert.Key, "slack:alice"), "inactive alerts cannot be acknowledged")
+
+	// Sent long enough ago that duplicate suppression no longer applies
+	d.alertMu.Lock()
+	d.sentAlerts[alert.Key] = time.Now().Add(-2 * time.Hour)
+	d.activeAlerts[alert.Key] = alert
+	d.alertMu.Unlock()
+
+	suppressed, _ := d.IsSuppressed(alert, cfg)
+	require.False(t, suppressed)
+
+	assert.True(t, d.Acknowledge(alert.Key, "slack:alice"))
+	suppressed, reason := d.IsSuppressed(alert, cfg)
+	assert.True(t, suppressed)
+	assert.Equal(t, "acknowledged by slack:alice", reason)
+
+	// Clearing the alert (e.g., the next run succeeds) ends the acknowledgement
+	require.NoError(t, d.ClearAlert(context.Background(), alert.Key))
+	suppressed, _ = d.IsSuppressed(alert, cfg)
+	assert.False(t, suppressed)
+}
+
+func TestDispatcher_AlertStateSurvivesRestart(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	failed := testAlert("default", "cron-a", "JobFailed", "critical")
+	acked := testAlert("default", "cron-b", "JobFailed", "critical")
+	cleared := testAlert("default", "cron-c", "JobFailed", "critical")
+	for _, alert := range []Alert{failed, acked, cleared} {
+		require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	}
+	require.True(t, d.Acknowledge(acked.Key, "slack:alice"))
+	require.NoError(t, d.ClearAlert(ctx, cleared.Key))
+
+	// A new dispatcher (restart or new leader) restores the state from the store
+	restarted := testDispatcher(mockStore)
+	restarted.channels["slack-main"] = ch
+	restarted.ReloadAlertState(ctx)
+
+	suppressed, reason := restarted.IsSuppressed(failed, cfg)
+	assert.True(t, suppressed)
+	assert.Equal(t, "duplicate within suppression window", reason)
+
+	_, reason = restarted.IsSuppressed(acked, cfg)
+	assert.Equal(t, "acknowledged by slack:alice", reason)
+
+	suppressed, _ = restarted.IsSuppressed(cleared, cfg)
+	assert.False(t, suppressed)
+
+	// The restored alert keeps its context, so a changed error still bypasses suppression
+	changed := failed
+	changed.Context.ExitCode = 137
+	suppressed, _ = restarted.IsSuppressed(changed, cfg)
+	assert.False(t, suppressed)
+
+	restarted.ClearAlertsForMonitor("default", "cron-a")
+	states, err := mockStore.ListAlertStates(ctx, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, states, 1)
+}
+
+func TestDispatcher_OnlyLeaderSends(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	elected := make(chan struct{})
+	d.SetElected(elected)
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Empty(t, ch.GetSentAlerts())
+
+	// Not marked as sent, so the alert fires once leadership is won
+	close(elected)
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_StoreClaimPreventsDuplicateAcrossReplicas(t *testing.T) {
+	mockStore := newMockStore()
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+
+	first := testDispatcher(mockStore)
+	firstCh := newMockChannel("slack-main", "slack")
+	first.channels["slack-main"] = firstCh
+	require.NoError(t, first.Dispatch(ctx, alert, cfg))
+	require.Len(t, firstCh.GetSentAlerts(), 1)
+
+	// A new leader that has not reloaded the alert state loses the claim
+	second := testDispatcher(mockStore)
+	secondCh := newMockChannel("slack-main", "slack")
+	second.channels["slack-main"] = secondCh
+	require.NoError(t, second.Dispatch(ctx, alert, cfg))
+	assert.Empty(t, secondCh.GetSentAlerts())
+
+	// A different error is claimed separately
+	changed := alert
+	changed.Context.ExitCode = 137
+	require.NoError(t, second.Dispatch(ctx, changed, cfg))
+	assert.Len(t, secondCh.GetSentAlerts(), 1)
+
+	// Once resolved, the alert can fire again in the same window
+	require.NoError(t, first.ClearAlert(ctx, alert.Key))
+	require.NoError(t, first.Dispatch(ctx, alert, cfg))
+	assert.Len(t, firstCh.GetSentAlerts(), 2)
+}
+
+func TestDispatcher_ClaimAlert_SlidingWindow(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	cfg.SuppressDuplicatesFor = &metav1.Duration{Duration: time.Hour}
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+
+	// Claims just before and after a clock hour are in the same window
+	first := time.Date(2026, 1, 1, 9, 59, 0, 0, time.UTC)
+	assert.True(t, d.claimAlert(ctx, alert, cfg, first))
+	assert.False(t, d.claimAlert(ctx, alert, cfg, first.Add(2*time.Minute)))
+	assert.True(t, d.claimAlert(ctx, alert, cfg, first.Add(time.Hour)))
+}
+
+func TestDispatcher_ClaimAlert_StoreErrorSendsAnyway(t *testing.T) {
+	mockStore := newMockStore()
+	mockStore.claimErr = errors.New("database unavailable")
+	d := testDispatcher(mockStore)
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+
+	before := promtestutil.ToFloat64(metrics.AlertClaimErrorsTotal)
+	assert.True(t, d.claimAlert(context.Background(), alert, testAlertingConfig("slack-main"), time.Now()))
+	assert.Equal(t, before+1, promtestutil.ToFloat64(metrics.AlertClaimErrorsTotal))
+}
+
 func TestDispatcher_ClearAlertsForMonitor_Bulk(t *testing.T) {
 	d := testDispatcher(nil)
 
@@ -694,6 +1226,111 @@ func TestRateLimiter_BurstHandling(t *testing.T) {
 	assert.Len(t, ch.GetSentAlerts(), 5)
 }
 
+func TestRateLimiter_MonitorLimitSparesGlobalBudget(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	d.globalLimiter = rate.NewLimiter(rate.Limit(1.0/60.0), 3) // 1/min, burst 3
+
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	noisy := testAlertingConfig("slack-main")
+	noisy.RateLimiting = &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](1), BurstLimit: ptr.To[int32](1)}
+
+	// The noisy monitor is capped at one alert
+	for i := 0; i < 5; i++ {
+		alert := testAlert("team-a", "noisy", "JobFailed-"+string(rune('a'+i)), "critical")
+		err := d.Dispatch(ctx, alert, noisy)
+		if i == 0 {
+			require.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, err, "rate limit exceeded for monitor team-a/noisy-monitor")
+		}
+	}
+
+	// Other monitors still have the rest of the global budget
+	quiet := testAlertingConfig("slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("team-b", "quiet-1", "JobFailed", "critical"), quiet))
+	require.NoError(t, d.Dispatch(ctx, testAlert("team-b", "quiet-2", "JobFailed", "critical"), quiet))
+	assert.ErrorContains(t, d.Dispatch(ctx, testAlert("team-b", "quiet-3", "JobFailed", "critical"), quiet), "global rate limit")
+
+	assert.Len(t, ch.GetSentAlerts(), 3)
+}
+
+func TestRateLimiter_ChannelLimit(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	d.globalLimiter = rate.NewLimiter(rate.Limit(1.0/60.0), 2) // 1/min, burst 2
+
+	limited := newMockChannel("pager", "pagerduty")
+	open := newMockChannel("slack-main", "slack")
+	d.channels["pager"] = limited
+	d.channels["slack-main"] = open
+	d.limiterMu.Lock()
+	updateLimiter(d.channelLimiters, "pager", &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](1), BurstLimit: ptr.To[int32](1)})
+	d.limiterMu.Unlock()
+
+	ctx := context.Background()
+
+	// The limited channel only gets the first alert; the other channel gets both
+	both := testAlertingConfig("pager", "slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), both))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-b", "JobFailed", "critical"), both))
+	assert.Len(t, limited.GetSentAlerts(), 1)
+	assert.Len(t, open.GetSentAlerts(), 2)
+
+	// An alert dropped by every channel limit does not use up the global budget
+	d.globalLimiter = rate.NewLimiter(rate.Limit(1.0/60.0), 1)
+	onlyPager := testAlertingConfig("pager")
+	assert.ErrorContains(t, d.Dispatch(ctx, testAlert("default", "cron-c", "JobFailed", "critical"), onlyPager), "all channels")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-d", "JobFailed", "critical"), testAlertingConfig("slack-main")))
+}
+
+func TestRateLimiter_NilAlertingConfig(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newMockChannel("slack-main", "slack")
+
+	allowed, err := d.reserveAlert(context.Background(), testAlert("default", "cron-a", "JobFailed", "critical"), nil, []Channel{ch})
+	require.NoError(t, err)
+	assert.Equal(t, []Channel{ch}, allowed)
+}
+
+func TestRateLimiter_MonitorLimiterRemovedWithMonitor(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	d.channels["slack-main"] = newMockChannel("slack-main", "slack")
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	cfg.RateLimiting = &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](10)}
+	require.NoError(t, d.Dispatch(ctx, testAlert("team-a", "noisy", "JobFailed", "critical"), cfg))
+	remote := testAlert("team-a", "noisy", "JobFailed", "critical")
+	require.NoError(t, ForCluster(d, "prod").Dispatch(ctx, remote, cfg))
+
+	d.limiterMu.Lock()
+	assert.Len(t, d.monitorLimiters, 2)
+	d.limiterMu.Unlock()
+
+	d.ClearAlertsForMonitor("team-a", "noisy-monitor")
+	ForCluster(d, "prod").ClearAlertsForMonitor("team-a", "noisy-monitor")
+
+	d.limiterMu.Lock()
+	defer d.limiterMu.Unlock()
+	assert.Empty(t, d.monitorLimiters)
+}
+
+func TestUpdateLimiter_KeepsBudgetWhenUnchanged(t *testing.T) {
+	limiters := make(map[string]*rate.Limiter)
+	cfg := &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](10), BurstLimit: ptr.To[int32](2)}
+
+	first := updateLimiter(limiters, "ch", cfg)
+	assert.Same(t, first, updateLimiter(limiters, "ch", cfg.DeepCopy()))
+
+	changed := updateLimiter(limiters, "ch", &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](20)})
+	assert.NotSame(t, first, changed)
+
+	assert.Nil(t, updateLimiter(limiters, "ch", nil))
+	assert.Empty(t, limiters)
+}
+
 func TestSetGlobalRateLimits(t *testing.T) {
 	d := testDispatcher(nil)
 
@@ -718,6 +1355,35 @@ func TestSetGlobalRateLimits_DefaultValue(t *testing.T) {
 	assert.NotNil(t, d.globalLimiter)
 }
 
+func TestSetGlobalRateLimits_KeepsLimiter(t *testing.T) {
+	d := testDispatcher(nil)
+	limiter := d.globalLimiter
+
+	d.SetGlobalRateLimits(
+		config.RateLimitsConfig{
+			MaxAlertsPerMinute:           120,
+			BurstLimit:                   5,
+			DefaultSuppressDuplicatesFor: 10 * time.Minute,
+		},
+	)
+
+	assert.Same(t, limiter, d.globalLimiter)
+	assert.Equal(t, rate.Limit(2), d.globalLimiter.Limit())
+	assert.Equal(t, 5, d.globalLimiter.Burst())
+	assert.Equal(t, 10*time.Minute, d.suppressWindow(nil))
+}
+
+func TestSetStartupGracePeriod(t *testing.T) {
+	d := testDispatcher(nil)
+	d.startedAt = time.Now().Add(-time.Minute)
+
+	d.SetStartupGracePeriod(time.Hour)
+	assert.True(t, d.Diagnostics().InStartupGracePeriod)
+
+	d.SetStartupGracePeriod(30 * time.Second)
+	assert.False(t, d.Diagnostics().InStartupGracePeriod, "counted from startup")
+}
+
 // ==================== Startup Grace Period Tests ====================
 
 func TestStartupGrace_SuppressesDuringPeriod(t *testing.T) {
@@ -726,6 +1392,8 @@ func TestStartupGrace_SuppressesDuringPeriod(t *testing.T) {
 		channelStats:       make(map[string]*ChannelStats),
 		sentAlerts:         make(map[string]time.Time),
 		activeAlerts:       make(map[string]Alert),
+		acknowledged:       make(map[string]string),
+		resolvers:          make(map[string][]string),
 		pendingAlerts:      make(map[string]*PendingAlert),
 		globalLimiter:      rate.NewLimiter(rate.Inf, 100),
 		cleanupDone:        make(chan struct{}),
@@ -758,6 +1426,8 @@ func TestStartupGrace_AllowsAfterPeriod(t *testing.T) {
 		channelStats:       make(map[string]*ChannelStats),
 		sentAlerts:         make(map[string]time.Time),
 		activeAlerts:       make(map[string]Alert),
+		acknowledged:       make(map[string]string),
+		resolvers:          make(map[string][]string),
 		pendingAlerts:      make(map[string]*PendingAlert),
 		globalLimiter:      rate.NewLimiter(rate.Inf, 100),
 		cleanupDone:        make(chan struct{}),
@@ -778,6 +1448,29 @@ func TestStartupGrace_AllowsAfterPeriod(t *testing.T) {
 	assert.Len(t, ch.GetSentAlerts(), 1)
 }
 
+func TestStartupGrace_Overrides(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	d.startedAt = time.Now()
+	d.SetStartupGracePeriod(time.Hour)
+	// Keys come lowercased from config files
+	d.graceOverrides = map[string]time.Duration{"critical": 0, "deadmantriggered": 0}
+
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "a", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "b", "JobFailed", "warning"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "c", "DeadManTriggered", "warning"), cfg))
+
+	var sent []string
+	for _, alert := range ch.GetSentAlerts() {
+		sent = append(sent, alert.Key)
+	}
+	assert.ElementsMatch(t, []string{"default/a/JobFailed", "default/c/DeadManTriggered"}, sent)
+}
+
 // ==================== Channel Stats Tests ====================
 
 func TestDispatcher_ChannelStats_RecordsSuccess(t *testing.T) {
@@ -1139,8 +1832,61 @@ func TestTemplateFuncs_JsonEscape(t *testing.T) {
 	assert.Equal(t, `"line1\nline2"`, fn("line1\nline2"))
 }
 
+func TestTemplateFuncs_ToJson(t *testing.T) {
+	fn := templateFuncs["toJson"].(func(any) string)
+	assert.Equal(t, `{"team":"payments"}`, fn(map[string]string{"team": "payments"}))
+	assert.Equal(t, "null", fn(map[string]string(nil)))
+}
+
+func TestAlertContext_PropagateMetadata(t *testing.T) {
+	cronJob := &metav1.ObjectMeta{
+		Labels:      map[string]string{"team": "payments", "app": "billing"},
+		Annotations: map[string]string{"runbook-url": "https://runbooks.example.com/billing"},
+	}
+
+	var ctx AlertContext
+	ctx.PropagateMetadata(&v1alpha1.AlertContext{Labels: []string{"team", "service"}, Annotations: []string{"runbook-url"}}, cronJob)
+	assert.Equal(t, map[string]string{"team": "payments"}, ctx.Labels)
+	assert.Equal(t, map[string]string{"runbook-url": "https://runbooks.example.com/billing"}, ctx.Annotations)
+
+	ctx = AlertContext{}
+	ctx.PropagateMetadata(&v1alpha1.AlertContext{}, cronJob)
+	assert.Nil(t, ctx.Labels)
+	ctx.PropagateMetadata(nil, cronJob)
+	assert.Nil(t, ctx.Annotations)
+}
+
 // ==================== Store Alert Tests ====================
 
+func TestDispatcher_Dispatch_AssignsID(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	require.NoError(t, d.Dispatch(ctx, testAlert("prod", "daily-backup", "JobFailed", "critical"), testAlertingConfig("slack-main")))
+
+	sent := ch.GetSentAlerts()
+	require.Len(t, sent, 1)
+	_, err := uuid.Parse(sent[0].ID)
+	require.NoError(t, err, "the alert gets a UUID")
+	assert.Equal(t, sent[0].ID, d.ActiveAlertID("prod/daily-backup/JobFailed"))
+
+	mockStore.mu.Lock()
+	require.Len(t, mockStore.alerts, 1)
+	assert.Equal(t, sent[0].ID, mockStore.alerts[0].AlertID, "history records the ID sent to channels")
+	mockStore.mu.Unlock()
+
+	alert := testAlert("prod", "nightly-report", "JobFailed", "critical")
+	alert.ID = "caller-assigned"
+	require.NoError(t, d.Dispatch(ctx, alert, testAlertingConfig("slack-main")))
+	assert.Equal(t, "caller-assigned", ch.GetSentAlerts()[1].ID, "an ID set by the caller is kept")
+
+	assert.Empty(t, d.ActiveAlertID("prod/unknown/JobFailed"))
+}
+
 func TestDispatcher_StoresAlertHistory(t *testing.T) {
 	mockStore := newMockStore()
 	d := testDispatcher(mockStore)
@@ -1150,6 +1896,7 @@ func TestDispatcher_StoresAlertHistory(t *testing.T) {
 
 	ctx := context.Background()
 	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	alert.Context.JobName = "test-cron-29384720"
 	alert.Context.ExitCode = 137
 	alert.Context.Reason = "OOMKilled"
 	alert.Context.SuggestedFix = "Increase memory limit"
@@ -1167,6 +1914,7 @@ func TestDispatcher_StoresAlertHistory(t *testing.T) {
 	assert.Equal(t, "critical", stored.Severity)
 	assert.Equal(t, "default", stored.CronJobNamespace)
 	assert.Equal(t, "test-cron", stored.CronJobName)
+	assert.Equal(t, "test-cron-29384720", stored.JobName)
 	assert.Equal(t, int32(137), stored.ExitCode)
 	assert.Equal(t, "OOMKilled", stored.Reason)
 	assert.Equal(t, "Increase memory limit", stored.SuggestedFix)
@@ -1192,6 +1940,165 @@ func TestDispatcher_DoesNotStoreOnAllFailures(t *testing.T) {
 	assert.Len(t, mockStore.alerts, 0)
 }
 
+// ==================== Delivery Retry Queue Tests ====================
+
+func TestDispatcher_QueuesFailedDeliveries(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	ok := newMockChannel("slack-ok", "slack")
+	broken := newMockChannel("slack-broken", "slack")
+	broken.sendErr = errors.New("webhook failed")
+	d.channels["slack-ok"] = ok
+	d.channels["slack-broken"] = broken
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	err := d.Dispatch(ctx, alert, testAlertingConfig("slack-ok", "slack-broken"))
+	require.Error(t, err)
+
+	mockStore.mu.Lock()
+	defer mockStore.mu.Unlock()
+
+	require.Len(t, mockStore.deliveries, 1)
+	queued := mockStore.deliveries[0]
+	assert.Equal(t, "slack-broken", queued.ChannelName)
+	assert.Equal(t, store.DeliveryStatusPending, queued.Status)
+	assert.Equal(t, int32(1), queued.Attempts)
+	assert.Equal(t, "webhook failed", queued.LastError)
+	assert.False(t, queued.RecordHistory, "history already stored for the successful channel")
+	assert.True(t, queued.NextAttemptAt.After(time.Now()))
+}
+
+func TestDispatcher_RetryDelivery_SuccessStoresHistory(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	ch := newMockChannel("slack-main", "slack")
+	ch.sendErr = errors.New("temporarily unavailable")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	_ = d.Dispatch(ctx, alert, testAlertingConfig("slack-main"))
+
+	// Not due yet: nothing is retried
+	d.retryDueDeliveries(ctx)
+	assert.Empty(t, ch.GetSentAlerts())
+
+	ch.mu.Lock()
+	ch.sendErr = nil
+	ch.mu.Unlock()
+	mockStore.makeDeliveriesDue()
+
+	d.retryDueDeliveries(ctx)
+
+	sent := ch.GetSentAlerts()
+	require.Len(t, sent, 1)
+	assert.Equal(t, alert.Key, sent[0].Key)
+	assert.Equal(t, alert.Title, sent[0].Title)
+
+	mockStore.mu.Lock()
+	defer mockStore.mu.Unlock()
+	require.Len(t, mockStore.deliveries, 1)
+	assert.Equal(t, store.DeliveryStatusDelivered, mockStore.deliveries[0].Status)
+	assert.Equal(t, int32(2), mockStore.deliveries[0].Attempts)
+	assert.NotNil(t, mockStore.deliveries[0].DeliveredAt)
+	require.Len(t, mockStore.alerts, 1)
+	assert.Equal(t, []string{"slack-main"}, mockStore.alerts[0].GetChannelsNotified())
+}
+
+func TestDispatcher_RetryDelivery_StoresHistoryOnce(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	slack := newMockChannel("slack-main", "slack")
+	slack.sendErr = errors.New("temporarily unavailable")
+	pd := newMockChannel("pagerduty-main", "pagerduty")
+	pd.sendErr = errors.New("temporarily unavailable")
+	d.channels["slack-main"] = slack
+	d.channels["pagerduty-main"] = pd
+
+	ctx := context.Background()
+	_ = d.Dispatch(ctx, testAlert("default", "test-cron", "JobFailed", "critical"), testAlertingConfig("slack-main", "pagerduty-main"))
+
+	mockStore.mu.Lock()
+	require.Len(t, mockStore.deliveries, 2)
+	for _, delivery := range mockStore.deliveries {
+		assert.True(t, delivery.RecordHistory, "no channel accepted the alert")
+		assert.NotEmpty(t, delivery.AlertID)
+	}
+	mockStore.mu.Unlock()
+
+	for _, ch := range []*mockChannel{slack, pd} {
+		ch.mu.Lock()
+		ch.sendErr = nil
+		ch.mu.Unlock()
+	}
+	mockStore.makeDeliveriesDue()
+	d.retryDueDeliveries(ctx)
+
+	assert.Len(t, slack.GetSentAlerts(), 1)
+	assert.Len(t, pd.GetSentAlerts(), 1)
+
+	mockStore.mu.Lock()
+	defer mockStore.mu.Unlock()
+	assert.Len(t, mockStore.alerts, 1, "only the first successful retry stores history")
+}
+
+func TestDispatcher_RetryDelivery_GivesUpAfterMaxAttempts(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	ch := newMockChannel("slack-main", "slack")
+	ch.sendErr = errors.New("still down")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	_ = d.Dispatch(ctx, testAlert("default", "test-cron", "JobFailed", "critical"), testAlertingConfig("slack-main"))
+
+	for i := 1; i < DeliveryMaxAttempts; i++ {
+		mockStore.makeDeliveriesDue()
+		d.retryDueDeliveries(ctx)
+	}
+
+	mockStore.mu.Lock()
+	defer mockStore.mu.Unlock()
+	require.Len(t, mockStore.deliveries, 1)
+	assert.Equal(t, store.DeliveryStatusFailed, mockStore.deliveries[0].Status)
+	assert.Equal(t, int32(DeliveryMaxAttempts), mockStore.deliveries[0].Attempts)
+	assert.Empty(t, mockStore.alerts)
+}
+
+func TestDispatcher_RetryDelivery_ChannelRemoved(t *testing.T) {
+	mockStore := newMockStore()
+	d := testDispatcher(mockStore)
+
+	ch := newMockChannel("slack-main", "slack")
+	ch.sendErr = errors.New("down")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	_ = d.Dispatch(ctx, testAlert("default", "test-cron", "JobFailed", "critical"), testAlertingConfig("slack-main"))
+
+	d.RemoveChannel("slack-main")
+	mockStore.makeDeliveriesDue()
+	d.retryDueDeliveries(ctx)
+
+	mockStore.mu.Lock()
+	defer mockStore.mu.Unlock()
+	require.Len(t, mockStore.deliveries, 1)
+	assert.Equal(t, store.DeliveryStatusFailed, mockStore.deliveries[0].Status)
+	assert.Contains(t, mockStore.deliveries[0].LastError, "not found")
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	assert.Equal(t, DeliveryInitialBackoff, deliveryBackoff(1))
+	assert.Equal(t, 2*DeliveryInitialBackoff, deliveryBackoff(2))
+	assert.Equal(t, 4*DeliveryInitialBackoff, deliveryBackoff(3))
+	assert.Equal(t, DeliveryMaxBackoff, deliveryBackoff(20))
+}
+
 // ==================== PendingAlert.Close() Tests ====================
 
 func TestPendingAlert_Close_MultipleCalls(t *testing.T) {
@@ -1560,3 +2467,156 @@ func TestDispatcher_ConcurrentIsSuppressed(t *testing.T) {
 	wg.Wait()
 	// No panic = success for race detection
 }
+
+func TestDispatcher_SilencedMonitorSendsNothing(t *testing.T) {
+	monitor := &v1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cron-a-monitor", Namespace: "default"},
+		Spec: v1alpha1.CronJobMonitorSpec{
+			SilencedUntil: &metav1.Time{Time: time.Now().Add(time.Hour)},
+		},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(monitor).Build()
+
+	d := testDispatcher(newMockStore())
+	d.client = fakeClient
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Empty(t, ch.GetSentAlerts())
+
+	// Not marked as sent, so the alert fires once the silence expires
+	monitor.Spec.SilencedUntil = &metav1.Time{Time: time.Now().Add(-time.Second)}
+	require.NoError(t, fakeClient.Update(ctx, monitor))
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_ObserveModeSendsNothing(t *testing.T) {
+	monitor := &v1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cron-a-monitor", Namespace: "default"},
+		Spec:       v1alpha1.CronJobMonitorSpec{Mode: v1alpha1.MonitorModeObserve},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(monitor).Build()
+
+	d := testDispatcher(newMockStore())
+	d.client = fakeClient
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Empty(t, ch.GetSentAlerts())
+
+	// Switching to enforce sends the next alert
+	monitor.Spec.Mode = v1alpha1.MonitorModeEnforce
+	require.NoError(t, fakeClient.Update(ctx, monitor))
+	require.NoError(t, d.Dispatch(ctx, alert, cfg))
+	assert.Len(t, ch.GetSentAlerts(), 1)
+}
+
+func TestDispatcher_RunbookURL(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cron-a",
+			Namespace:   "default",
+			Annotations: map[string]string{RunbookURLAnnotation: "https://runbooks.example.com/cron-a"},
+		},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	d := testDispatcher(newMockStore())
+	d.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(cronJob).Build()
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+	cfg.RunbookURLs = v1alpha1.RunbookURLs{"deadManTriggered": "https://runbooks.example.com/deadman"}
+
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "DeadManTriggered", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-b", "JobFailed", "critical"), cfg))
+
+	sent := ch.GetSentAlerts()
+	require.Len(t, sent, 3)
+	assert.Equal(t, "https://runbooks.example.com/cron-a", sent[0].RunbookURL, "CronJob annotation")
+	assert.Equal(t, "https://runbooks.example.com/deadman", sent[1].RunbookURL, "monitor entry for the alert type wins")
+	assert.Empty(t, sent[2].RunbookURL, "CronJob not found")
+}
+
+func TestDispatcher_Team(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cron-a",
+			Namespace: "default",
+			Labels:    map[string]string{"team": "payments"},
+		},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	d := testDispatcher(newMockStore())
+	d.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(cronJob).Build()
+	d.ownership = config.OwnershipConfig{
+		TeamLabels:     []string{"team"},
+		NamespaceTeams: map[string]string{"default": "platform"},
+	}
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-b", "JobFailed", "critical"), cfg))
+
+	sent := ch.GetSentAlerts()
+	require.Len(t, sent, 2)
+	assert.Equal(t, "payments", sent[0].Team, "team label")
+	assert.Equal(t, "platform", sent[1].Team, "namespace mapping")
+}
+
+func TestDispatcher_ForCluster(t *testing.T) {
+	s := newMockStore()
+	d := testDispatcher(s)
+	ch := newMockChannel("slack-main", "slack")
+	d.channels["slack-main"] = ch
+	remote := ForCluster(d, "prod-eu")
+
+	ctx := context.Background()
+	cfg := testAlertingConfig("slack-main")
+
+	require.NoError(t, d.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+	require.NoError(t, remote.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), cfg))
+
+	sent := ch.GetSentAlerts()
+	require.Len(t, sent, 2, "same CronJob in another cluster is not a duplicate")
+	assert.Empty(t, sent[0].Cluster)
+	assert.Equal(t, "prod-eu", sent[1].Cluster)
+	assert.Equal(t, "prod-eu:default/cron-a/JobFailed", sent[1].Key)
+	require.Len(t, s.alerts, 2)
+	assert.Equal(t, "prod-eu", s.alerts[1].Cluster)
+
+	suppressed, _ := remote.IsSuppressed(testAlert("default", "cron-a", "JobFailed", "critical"), cfg)
+	assert.True(t, suppressed)
+
+	require.NoError(t, remote.ClearAlert(ctx, "default/cron-a/JobFailed"))
+	d.alertMu.RLock()
+	assert.Contains(t, d.sentAlerts, "default/cron-a/JobFailed", "local alert is kept")
+	assert.NotContains(t, d.sentAlerts, "prod-eu:default/cron-a/JobFailed")
+	d.alertMu.RUnlock()
+
+	assert.Same(t, d, ForCluster(d, ""), "local cluster is not wrapped")
+}
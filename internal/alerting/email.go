@@ -3,18 +3,23 @@ package alerting
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"strings"
 	"text/template"
 	"time"
 
-	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 // SMTPConfig holds SMTP connection details
@@ -25,19 +30,56 @@ type SMTPConfig struct {
 	Password string
 }
 
+const (
+	emailFormatHTML = "html"
+	// emailRecentFailuresLimit caps the recent failures table in HTML emails
+	emailRecentFailuresLimit = 5
+	// emailRecentFailuresWindow bounds how far back recent failures are looked up
+	emailRecentFailuresWindow = 30 * 24 * time.Hour
+)
+
+// templateExecutor is satisfied by both text/template and html/template
+type templateExecutor interface {
+	Execute(w io.Writer, data any) error
+}
+
 type emailChannel struct {
+	dashboardLinks
+
 	name            string
 	client          client.Client
+	store           store.Store
 	smtpSecretRef   v1alpha1.NamespacedSecretRef
 	from            string
 	to              []string
+	html            bool
+	digest          *v1alpha1.EmailDigestConfig
 	subjectTemplate *template.Template
-	bodyTemplate    *template.Template
-	rateLimiter     *rate.Limiter
+	bodyTemplate    templateExecutor
+}
+
+// emailData is the data passed to email templates. It embeds the Alert so
+// existing templates using .Type, .Context etc. keep working.
+type emailData struct {
+	Alert
+	// CronJobURL links to the CronJob in the dashboard (empty without ui.external-url)
+	CronJobURL string
+	// RecentFailures lists the CronJob's most recent failed executions
+	RecentFailures []EmailFailure
 }
 
-// NewEmailChannel creates a new email channel
-func NewEmailChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+// EmailFailure is a row in the recent failures table
+type EmailFailure struct {
+	JobName        string
+	StartTime      time.Time
+	ExitCode       int32
+	Reason         string
+	Classification string
+}
+
+// NewEmailChannel creates a new email channel. The store is optional and is
+// used to list recent failures in HTML emails.
+func NewEmailChannel(c client.Client, st store.Store, ac *v1alpha1.AlertChannel) (Channel, error) {
 	if ac.Spec.Email == nil {
 		return nil, fmt.Errorf("email config required for email channel")
 	}
@@ -45,9 +87,23 @@ func NewEmailChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error
 	ec := &emailChannel{
 		name:          ac.Name,
 		client:        c,
+		store:         st,
 		smtpSecretRef: ac.Spec.Email.SMTPSecretRef,
 		from:          ac.Spec.Email.From,
 		to:            ac.Spec.Email.To,
+		html:          ac.Spec.Email.Format == emailFormatHTML,
+		digest:        ac.Spec.Email.Digest,
+	}
+
+	if ec.digest != nil {
+		if _, _, err := DigestWindow(ec.digest, time.Now()); err != nil {
+			return nil, fmt.Errorf("invalid digest config: %w", err)
+		}
+	}
+	if r := ac.Spec.Email.SLAReport; r != nil {
+		if err := validateSLAReport(r); err != nil {
+			return nil, fmt.Errorf("invalid SLA report config: %w", err)
+		}
 	}
 
 	subjectTmplStr := defaultEmailSubjectTemplate
@@ -61,15 +117,20 @@ func NewEmailChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error
 	ec.subjectTemplate = subjectTmpl
 
 	bodyTmplStr := defaultEmailBodyTemplate
+	if ec.html {
+		bodyTmplStr = defaultEmailHTMLBodyTemplate
+	}
 	if ac.Spec.Email.BodyTemplate != "" {
 		bodyTmplStr = ac.Spec.Email.BodyTemplate
 	}
-	bodyTmpl, err := template.New("body").Funcs(templateFuncs).Parse(bodyTmplStr)
+	if ec.html {
+		ec.bodyTemplate, err = htmltemplate.New("body").Funcs(htmltemplate.FuncMap(templateFuncs)).Parse(bodyTmplStr)
+	} else {
+		ec.bodyTemplate, err = template.New("body").Funcs(templateFuncs).Parse(bodyTmplStr)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("invalid body template: %w", err)
 	}
-	ec.bodyTemplate = bodyTmpl
-	ec.rateLimiter = NewRateLimiter(ac.Spec.RateLimiting)
 
 	return ec, nil
 }
@@ -84,32 +145,192 @@ func (e *emailChannel) Type() string {
 	return "email"
 }
 
-// Send delivers an alert via email
+// Send delivers an alert via email. In digest mode alerts are not sent
+// individually; they are summarised in the next digest instead.
 func (e *emailChannel) Send(ctx context.Context, alert Alert) error {
-	if !e.rateLimiter.Allow() {
-		return fmt.Errorf("rate limit exceeded for channel %s", e.name)
+	if e.digest != nil {
+		return nil
 	}
+	return e.sendAlert(ctx, alert)
+}
 
-	smtpConfig, err := e.getSMTPConfig(ctx)
+// Test sends a test alert
+func (e *emailChannel) Test(ctx context.Context) error {
+	return e.sendAlert(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+// Preview renders the email of an alert without sending it
+func (e *emailChannel) Preview(ctx context.Context, alert Alert) (ChannelPreview, error) {
+	subject, body, err := e.renderAlert(ctx, alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	preview := ChannelPreview{ContentType: "text/plain", Payload: "Subject: " + subject + "\n\n" + body}
+	if e.html {
+		preview.ContentType = "text/html"
+	}
+	if e.digest != nil {
+		preview.Note = "the channel sends digests, so the alert is only summarised in the next digest"
+	}
+	return preview, nil
+}
+
+func (e *emailChannel) sendAlert(ctx context.Context, alert Alert) error {
+	subject, body, err := e.renderAlert(ctx, alert)
 	if err != nil {
 		return err
 	}
+	return e.sendMail(ctx, subject, body)
+}
+
+// renderAlert renders the subject and body of an alert email
+func (e *emailChannel) renderAlert(ctx context.Context, alert Alert) (string, string, error) {
+	data := emailData{
+		Alert:      alert,
+		CronJobURL: e.cronJobURL(alert.CronJob),
+	}
+	if e.html {
+		data.RecentFailures = e.recentFailures(ctx, alert.CronJob)
+	}
 
 	var subjectBuf, bodyBuf bytes.Buffer
-	if err := e.subjectTemplate.Execute(&subjectBuf, alert); err != nil {
-		return fmt.Errorf("failed to render subject: %w", err)
+	if err := e.subjectTemplate.Execute(&subjectBuf, data); err != nil {
+		return "", "", fmt.Errorf("failed to render subject: %w", err)
+	}
+	if err := e.bodyTemplate.Execute(&bodyBuf, data); err != nil {
+		return "", "", fmt.Errorf("failed to render body: %w", err)
 	}
-	if err := e.bodyTemplate.Execute(&bodyBuf, alert); err != nil {
-		return fmt.Errorf("failed to render body: %w", err)
+	return subjectBuf.String(), bodyBuf.String(), nil
+}
+
+// SendDigest delivers a digest email summarising the given period
+func (e *emailChannel) SendDigest(ctx context.Context, digest Digest) error {
+	body, err := e.renderDigest(digest)
+	if err != nil {
+		return err
+	}
+	return e.sendMail(ctx, digest.Subject(), body)
+}
+
+// renderDigest renders the body of a digest email
+func (e *emailChannel) renderDigest(digest Digest) (string, error) {
+	var tmpl templateExecutor = digestTextTemplate
+	if e.html {
+		tmpl = digestHTMLTemplate
+	}
+	var bodyBuf bytes.Buffer
+	if err := tmpl.Execute(&bodyBuf, digest); err != nil {
+		return "", fmt.Errorf("failed to render digest: %w", err)
+	}
+	return bodyBuf.String(), nil
+}
+
+// recentFailures returns the most recent failed executions of a CronJob.
+// Lookup errors only cost the table, so they are ignored.
+func (e *emailChannel) recentFailures(ctx context.Context, cronJob types.NamespacedName) []EmailFailure {
+	if e.store == nil || cronJob.Name == "" {
+		return nil
+	}
+	since := time.Now().Add(-emailRecentFailuresWindow)
+	execs, _, err := e.store.GetExecutionsFiltered(ctx, cronJob, since, "failed", emailRecentFailuresLimit, 0)
+	if err != nil {
+		return nil
+	}
+	failures := make([]EmailFailure, 0, len(execs))
+	for _, exec := range execs {
+		failures = append(failures, EmailFailure{
+			JobName:        exec.JobName,
+			StartTime:      exec.StartTime,
+			ExitCode:       exec.ExitCode,
+			Reason:         exec.Reason,
+			Classification: exec.Classification,
+		})
+	}
+	return failures
+}
+
+func (e *emailChannel) sendMail(ctx context.Context, subject, body string) error {
+	contentType := "text/plain"
+	if e.html {
+		contentType = "text/html"
+	}
+	return e.send(ctx, subject, fmt.Sprintf("Content-Type: %s; charset=utf-8\r\n\r\n%s", contentType, body))
+}
+
+// SendReport delivers a report as an HTML email, whatever the channel's format
+func (e *emailChannel) SendReport(ctx context.Context, r Report) error {
+	content, err := reportContent(r)
+	if err != nil {
+		return err
+	}
+	return e.send(ctx, r.Subject, content)
+}
+
+// reportContent returns the Content-Type header and body of a report email.
+// Reports with attachments are sent as multipart/mixed.
+func reportContent(r Report) (string, error) {
+	if len(r.Attachments) == 0 {
+		return "Content-Type: text/html; charset=utf-8\r\n\r\n" + r.HTML, nil
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(part, r.HTML); err != nil {
+		return "", err
+	}
+	for _, a := range r.Attachments {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {fmt.Sprintf("%s; name=%q", a.ContentType, a.Filename)},
+			"Content-Disposition":       {fmt.Sprintf("attachment; filename=%q", a.Filename)},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return "", err
+		}
+		// Base64 lines are limited to 76 characters
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+				return "", err
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := io.WriteString(part, encoded+"\r\n"); err != nil {
+			return "", err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Content-Type: multipart/mixed; boundary=%s\r\n\r\n%s", mw.Boundary(), buf.String()), nil
+}
+
+// send delivers an email. content starts with its Content-Type header.
+func (e *emailChannel) send(ctx context.Context, subject, content string) error {
+	smtpConfig, err := e.getSMTPConfig(ctx)
+	if err != nil {
+		return err
 	}
 
 	msg := fmt.Sprintf("From: %s\r\n", e.from)
 	msg += fmt.Sprintf("To: %s\r\n", strings.Join(e.to, ", "))
-	msg += fmt.Sprintf("Subject: %s\r\n", subjectBuf.String())
+	msg += fmt.Sprintf("Subject: %s\r\n", subject)
 	msg += "MIME-Version: 1.0\r\n"
-	msg += "Content-Type: text/plain; charset=utf-8\r\n"
-	msg += "\r\n"
-	msg += bodyBuf.String()
+	msg += content
 
 	auth := smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, smtpConfig.Host)
 	addr := fmt.Sprintf("%s:%s", smtpConfig.Host, smtpConfig.Port)
@@ -117,21 +338,6 @@ func (e *emailChannel) Send(ctx context.Context, alert Alert) error {
 	return smtp.SendMail(addr, auth, e.from, e.to, []byte(msg))
 }
 
-// Test sends a test alert
-func (e *emailChannel) Test(ctx context.Context) error {
-	return e.Send(
-		ctx, Alert{
-			Key:       "test-alert",
-			Type:      "Test",
-			Severity:  "info",
-			Title:     "CronJob Guardian Test Alert",
-			Message:   "This is a test alert from CronJob Guardian.",
-			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
-			Timestamp: time.Now(),
-		},
-	)
-}
-
 func (e *emailChannel) getSMTPConfig(ctx context.Context) (*SMTPConfig, error) {
 	secret := &corev1.Secret{}
 	err := e.client.Get(
@@ -180,7 +386,19 @@ var defaultEmailBodyTemplate = `CronJob Guardian Alert
 Type: {{ .Type }}
 Severity: {{ .Severity }}
 CronJob: {{ .CronJob.Namespace }}/{{ .CronJob.Name }}
+{{- if .Team }}
+Team: {{ .Team }}
+{{- end }}
+{{- if .Cluster }}
+Cluster: {{ .Cluster }}
+{{- end }}
+{{- if .Context.JobName }}
+Job: {{ .Context.JobName }}
+{{- end }}
 Time: {{ formatTime .Timestamp "RFC3339" }}
+{{- if .ID }}
+Alert ID: {{ .ID }}
+{{- end }}
 
 {{ .Message }}
 
@@ -189,6 +407,10 @@ Suggested Fix:
 {{ .Context.SuggestedFix }}
 {{ end }}
 
+{{ if .RunbookURL }}
+Runbook: {{ .RunbookURL }}
+{{ end }}
+
 {{ if .Context.Logs }}
 Logs:
 {{ .Context.Logs }}
@@ -197,3 +419,67 @@ Logs:
 --
 CronJob Guardian
 `
+
+var defaultEmailHTMLBodyTemplate = `<!DOCTYPE html>
+<html>
+<body style="font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; color: #1f2937; margin: 0; padding: 24px;">
+<h2 style="margin: 0 0 4px 0;">{{ .Title }}</h2>
+<p style="margin: 0 0 16px 0; color: #6b7280;">{{ upper .Severity }} &middot; {{ .Type }} &middot; {{ formatTime .Timestamp "RFC3339" }}</p>
+<p>{{ .Message }}</p>
+<table cellpadding="6" style="border-collapse: collapse; margin-bottom: 16px;">
+<tr><td style="color: #6b7280;">CronJob</td><td>{{ .CronJob.Namespace }}/{{ .CronJob.Name }}</td></tr>
+{{- if .Team }}
+<tr><td style="color: #6b7280;">Team</td><td>{{ .Team }}</td></tr>
+{{- end }}
+{{- if .Cluster }}
+<tr><td style="color: #6b7280;">Cluster</td><td>{{ .Cluster }}</td></tr>
+{{- end }}
+{{- if .Context.JobName }}
+<tr><td style="color: #6b7280;">Job</td><td>{{ .Context.JobName }}</td></tr>
+{{- end }}
+{{- if .Context.ExitCode }}
+<tr><td style="color: #6b7280;">Exit code</td><td>{{ .Context.ExitCode }}</td></tr>
+{{- end }}
+{{- if .Context.Reason }}
+<tr><td style="color: #6b7280;">Reason</td><td>{{ .Context.Reason }}</td></tr>
+{{- end }}
+{{- if .Context.Classification }}
+<tr><td style="color: #6b7280;">Classification</td><td>{{ .Context.Classification }}</td></tr>
+{{- end }}
+{{- if .Context.NodeName }}
+<tr><td style="color: #6b7280;">Node</td><td>{{ .Context.NodeName }}</td></tr>
+{{- end }}
+{{- if .Context.SuccessRate }}
+<tr><td style="color: #6b7280;">Success rate</td><td>{{ printf "%.1f" .Context.SuccessRate }}%</td></tr>
+{{- end }}
+{{- if .ID }}
+<tr><td style="color: #6b7280;">Alert ID</td><td>{{ .ID }}</td></tr>
+{{- end }}
+</table>
+{{- if .Context.SuggestedFix }}
+<h3>Suggested fix</h3>
+<p>{{ .Context.SuggestedFix }}</p>
+{{- end }}
+{{- if .RunbookURL }}
+<p><a href="{{ .RunbookURL }}">Open runbook</a></p>
+{{- end }}
+{{- if .RecentFailures }}
+<h3>Recent failures</h3>
+<table cellpadding="6" style="border-collapse: collapse; border: 1px solid #e5e7eb;">
+<tr style="background: #f3f4f6; text-align: left;"><th>Job</th><th>Started</th><th>Exit code</th><th>Reason</th></tr>
+{{- range .RecentFailures }}
+<tr style="border-top: 1px solid #e5e7eb;"><td>{{ .JobName }}</td><td>{{ formatTime .StartTime "2006-01-02 15:04 MST" }}</td><td>{{ .ExitCode }}</td><td>{{ .Reason }}{{ if .Classification }} ({{ .Classification }}){{ end }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- if .Context.Logs }}
+<h3>Logs</h3>
+<pre style="background: #f3f4f6; padding: 12px; overflow-x: auto; font-size: 12px;">{{ .Context.Logs }}</pre>
+{{- end }}
+{{- if .CronJobURL }}
+<p><a href="{{ .CronJobURL }}">View in dashboard</a></p>
+{{- end }}
+<p style="color: #9ca3af; font-size: 12px;">CronJob Guardian</p>
+</body>
+</html>
+`
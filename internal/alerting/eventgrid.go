@@ -0,0 +1,163 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+const (
+	// eventGridEventTypePrefix prefixes the alert type in Event Grid event types
+	eventGridEventTypePrefix = "CronJobGuardian."
+	// eventGridAPIVersion is the Event Grid publish API version
+	eventGridAPIVersion = "2018-01-01"
+)
+
+type eventGridChannel struct {
+	httpSender
+
+	name      string
+	client    client.Client
+	secretRef v1alpha1.NamespacedSecretKeyRef
+	endpoint  string
+}
+
+// NewEventGridChannel creates a new Azure Event Grid channel
+func NewEventGridChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.EventGrid == nil {
+		return nil, fmt.Errorf("eventGrid config required for eventgrid channel")
+	}
+	if ac.Spec.EventGrid.TopicEndpoint == "" {
+		return nil, fmt.Errorf("eventGrid topicEndpoint is required")
+	}
+
+	return &eventGridChannel{
+		name:      ac.Name,
+		client:    c,
+		secretRef: ac.Spec.EventGrid.AccessKeySecretRef,
+		endpoint:  ac.Spec.EventGrid.TopicEndpoint,
+	}, nil
+}
+
+// Name returns the channel name
+func (e *eventGridChannel) Name() string {
+	return e.name
+}
+
+// Type returns the channel type
+func (e *eventGridChannel) Type() string {
+	return "eventgrid"
+}
+
+// Send publishes an alert as an Event Grid schema event
+func (e *eventGridChannel) Send(ctx context.Context, alert Alert) error {
+	accessKey, err := getValueFromSecret(ctx, e.client, e.secretRef)
+	if err != nil {
+		return err
+	}
+
+	events := e.events(alert)
+
+	pipeline := runtime.NewPipeline("eventgrid", "v1", runtime.PipelineOptions{
+		PerRetry: []policy.Policy{runtime.NewKeyCredentialPolicy(
+			azcore.NewKeyCredential(strings.TrimSpace(accessKey)), "aeg-sas-key",
+			// The endpoint pattern allows http, e.g. for a local emulator
+			&runtime.KeyCredentialPolicyOptions{InsecureAllowCredentialWithHTTP: strings.HasPrefix(e.endpoint, "http://")},
+		)},
+	}, &policy.ClientOptions{
+		Transport: e.sendClient(),
+		// The dispatcher retries failed sends
+		Retry: policy.RetryOptions{MaxRetries: -1},
+	})
+	req, err := runtime.NewRequest(ctx, http.MethodPost, e.endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if !req.Raw().URL.Query().Has("api-version") {
+		query := req.Raw().URL.Query()
+		query.Set("api-version", eventGridAPIVersion)
+		req.Raw().URL.RawQuery = query.Encode()
+	}
+	if err := runtime.MarshalAsJSON(req, events); err != nil {
+		return fmt.Errorf("failed to marshal Event Grid payload: %w", err)
+	}
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to publish to Event Grid: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return fmt.Errorf("failed to publish to Event Grid: %w", runtime.NewResponseError(resp))
+	}
+
+	return nil
+}
+
+// Preview renders the Event Grid event of an alert without sending it
+func (e *eventGridChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	jsonPayload, err := e.render(alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
+
+// eventGridEvent is an event in the Event Grid schema
+type eventGridEvent struct {
+	ID          string       `json:"id"`
+	EventType   string       `json:"eventType"`
+	Subject     string       `json:"subject"`
+	EventTime   string       `json:"eventTime"`
+	Data        AlertPayload `json:"data"`
+	DataVersion string       `json:"dataVersion"`
+}
+
+// render builds the Event Grid request body of an alert
+func (e *eventGridChannel) render(alert Alert) ([]byte, error) {
+	jsonPayload, err := json.Marshal(e.events(alert))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Event Grid payload: %w", err)
+	}
+	return jsonPayload, nil
+}
+
+// events returns the Event Grid schema events of an alert
+func (e *eventGridChannel) events(alert Alert) []eventGridEvent {
+	return []eventGridEvent{{
+		ID:          AlertEventID(alert),
+		EventType:   eventGridEventTypePrefix + alert.Type,
+		Subject:     fmt.Sprintf("namespaces/%s/cronjobs/%s", alert.CronJob.Namespace, alert.CronJob.Name),
+		EventTime:   alert.Timestamp.UTC().Format(time.RFC3339Nano),
+		Data:        NewAlertPayload(alert),
+		DataVersion: "1.0",
+	}}
+}
+
+// Test sends a test alert
+func (e *eventGridChannel) Test(ctx context.Context) error {
+	return e.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
@@ -0,0 +1,96 @@
+package alerting
+
+import (
+	"context"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	// defaultSuppressWindow is used when neither the monitor nor the
+	// dispatcher configures a duplicate suppression window
+	defaultSuppressWindow = time.Hour
+	// alertClaimRetention is how long dedup claims are kept; it bounds the
+	// longest suppression window that is enforced across replicas
+	alertClaimRetention = 7 * 24 * time.Hour
+)
+
+// SetElected sets the leader election channel. Until it is closed the
+// dispatcher sends nothing, so replicas that are not leading cannot send
+// alerts or retry deliveries. Must be called before alerts are dispatched.
+func (d *dispatcher) SetElected(elected <-chan struct{}) {
+	d.electedMu.Lock()
+	defer d.electedMu.Unlock()
+	d.elected = elected
+}
+
+// isLeader returns true if leader election is disabled or this replica leads
+func (d *dispatcher) isLeader() bool {
+	d.electedMu.RLock()
+	elected := d.elected
+	d.electedMu.RUnlock()
+	if elected == nil {
+		return true
+	}
+	select {
+	case <-elected:
+		return true
+	default:
+		return false
+	}
+}
+
+// suppressWindow returns how long duplicates of an alert are suppressed
+func (d *dispatcher) suppressWindow(alertCfg *v1alpha1.AlertingConfig) time.Duration {
+	if alertCfg != nil && alertCfg.SuppressDuplicatesFor != nil {
+		return alertCfg.SuppressDuplicatesFor.Duration
+	}
+	d.settingsMu.RLock()
+	window := d.defaultSuppressDuplicatesFor
+	d.settingsMu.RUnlock()
+	if window > 0 {
+		return window
+	}
+	return defaultSuppressWindow
+}
+
+// claimAlert records in the store or shared state that this replica sends the
+// alert now. It returns false if another replica (or a previous leader) sent
+// it within the suppression window before now.
+func (d *dispatcher) claimAlert(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig, now time.Time) bool {
+	window := d.suppressWindow(alertCfg)
+	if d.stateStore == nil || window <= 0 {
+		return true
+	}
+
+	claim := store.AlertClaim{
+		AlertKey: alert.Key,
+		// A changed error signature bypasses suppression, so it gets its own claim
+		Signature:   exitCodeCategory(alert.Context.ExitCode) + "|" + alert.Context.Reason,
+		WindowStart: now.UTC(),
+		ClaimedBy:   d.identity,
+	}
+	claimed, err := d.stateStore.ClaimAlert(ctx, claim, window)
+	if err != nil {
+		// Fail open: with the store unreachable another replica may also send
+		// the alert, but a duplicate is better than losing it. The metric
+		// shows how often suppression across replicas was skipped.
+		metrics.RecordAlertClaimError()
+		loggerFor(ctx).Error(err, "failed to claim alert, sending anyway", "alertKey", alert.Key)
+		return true
+	}
+	return claimed
+}
+
+// pruneAlertClaims deletes dedup claims that no longer affect suppression
+func (d *dispatcher) pruneAlertClaims(ctx context.Context, now time.Time) {
+	if d.stateStore == nil {
+		return
+	}
+	if _, err := d.stateStore.PruneAlertClaims(ctx, now.Add(-alertClaimRetention)); err != nil {
+		loggerFor(ctx).Error(err, "failed to prune alert claims")
+	}
+}
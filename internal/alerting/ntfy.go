@@ -0,0 +1,235 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// Keys read from the ntfy channel's auth Secret
+const (
+	NtfyTokenKey    = "token"
+	NtfyUsernameKey = "username"
+	NtfyPasswordKey = "password"
+)
+
+// DefaultNtfyServerURL is used when the channel has no server URL
+const DefaultNtfyServerURL = "https://ntfy.sh"
+
+// ntfyPriorities maps alert severities to ntfy priorities (5 = max, 3 = default)
+var ntfyPriorities = map[string]int{"critical": 5, "warning": 4, "info": 3}
+
+// ntfyTags maps alert severities to the emoji tag shown before the title
+var ntfyTags = map[string]string{"critical": "rotating_light", "warning": "warning", "info": "information_source"}
+
+type ntfyChannel struct {
+	httpSender
+	dashboardLinks
+
+	name          string
+	client        client.Client
+	serverURL     string
+	topic         string
+	authSecretRef *v1alpha1.NamespacedSecretRef
+	template      *template.Template
+}
+
+// NewNtfyChannel creates a new ntfy channel
+func NewNtfyChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.Ntfy == nil {
+		return nil, fmt.Errorf("ntfy config required for ntfy channel")
+	}
+
+	nc := &ntfyChannel{
+		name:          ac.Name,
+		client:        c,
+		serverURL:     DefaultNtfyServerURL,
+		topic:         ac.Spec.Ntfy.Topic,
+		authSecretRef: ac.Spec.Ntfy.AuthSecretRef,
+	}
+	if ac.Spec.Ntfy.ServerURL != "" {
+		nc.serverURL = strings.TrimSuffix(ac.Spec.Ntfy.ServerURL, "/")
+	}
+
+	tmplStr := defaultNtfyTemplate
+	if ac.Spec.Ntfy.MessageTemplate != "" {
+		tmplStr = ac.Spec.Ntfy.MessageTemplate
+	}
+	tmpl, err := ParsePushTemplate(tmplStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	nc.template = tmpl
+
+	return nc, nil
+}
+
+// ParsePushTemplate parses a plain text message template of the ntfy and
+// Pushover channels
+func ParsePushTemplate(s string) (*template.Template, error) {
+	return template.New("push").Funcs(templateFuncs).Parse(s)
+}
+
+// Name returns the channel name
+func (n *ntfyChannel) Name() string {
+	return n.name
+}
+
+// Type returns the channel type
+func (n *ntfyChannel) Type() string {
+	return "ntfy"
+}
+
+// Send publishes an alert to the ntfy topic
+func (n *ntfyChannel) Send(ctx context.Context, alert Alert) error {
+	jsonPayload, err := n.render(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", n.serverURL, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := n.authorize(ctx, req); err != nil {
+		return err
+	}
+
+	resp, err := n.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send ntfy message: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		var result struct {
+			Error string `json:"error"`
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(body, &result) == nil && result.Error != "" {
+			return fmt.Errorf("ntfy returned status %d: %s", resp.StatusCode, result.Error)
+		}
+		return fmt.Errorf("ntfy returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Preview renders the ntfy message of an alert without sending it
+func (n *ntfyChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	jsonPayload, err := n.render(alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
+
+// render builds the JSON publish request of an alert
+func (n *ntfyChannel) render(alert Alert) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := n.template.Execute(&buf, alert); err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+
+	priority, ok := ntfyPriorities[alert.Severity]
+	if !ok {
+		priority = 3
+	}
+	tags := []string{}
+	if tag, ok := ntfyTags[alert.Severity]; ok {
+		tags = append(tags, tag)
+	}
+	if alert.Type != "" {
+		tags = append(tags, alert.Type)
+	}
+
+	payload := map[string]interface{}{
+		"topic":    n.topic,
+		"title":    alert.Title,
+		"message":  strings.TrimSpace(buf.String()),
+		"priority": priority,
+		"tags":     tags,
+	}
+	if link := n.cronJobURL(alert.CronJob); link != "" {
+		payload["click"] = link
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ntfy payload: %w", err)
+	}
+	return jsonPayload, nil
+}
+
+// authorize adds the access token or basic auth credentials from the auth
+// Secret, if the channel has one
+func (n *ntfyChannel) authorize(ctx context.Context, req *http.Request) error {
+	if n.authSecretRef == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	err := n.client.Get(
+		ctx, types.NamespacedName{
+			Namespace: n.authSecretRef.Namespace,
+			Name:      n.authSecretRef.Name,
+		}, secret,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get ntfy secret: %w", err)
+	}
+
+	if token, ok := secret.Data[NtfyTokenKey]; ok {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+		return nil
+	}
+	username, hasUsername := secret.Data[NtfyUsernameKey]
+	password, hasPassword := secret.Data[NtfyPasswordKey]
+	if !hasUsername || !hasPassword {
+		return fmt.Errorf("ntfy secret needs a '%s' key or '%s' and '%s' keys", NtfyTokenKey, NtfyUsernameKey, NtfyPasswordKey)
+	}
+	req.SetBasicAuth(strings.TrimSpace(string(username)), strings.TrimSpace(string(password)))
+	return nil
+}
+
+// Test sends a test alert
+func (n *ntfyChannel) Test(ctx context.Context) error {
+	return n.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+var defaultNtfyTemplate = `{{ .Message }}
+
+CronJob: {{ .CronJob.Namespace }}/{{ .CronJob.Name }}{{ if .Context.ExitCode }}
+Exit code: {{ .Context.ExitCode }}{{ end }}{{ if .Context.Reason }}
+Reason: {{ .Context.Reason }}{{ end }}{{ if .Context.SuggestedFix }}
+
+Suggested fix: {{ .Context.SuggestedFix }}{{ end }}{{ if .Context.Logs }}
+
+Recent logs:
+{{ truncate .Context.Logs 1500 }}{{ end }}
+`
@@ -8,21 +8,22 @@ import (
 	"net/http"
 	"time"
 
-	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 )
 
-const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+// pagerDutyEventsURL is the Events API v2 endpoint (overridden in tests)
+var pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
 
 type pagerDutyChannel struct {
-	name        string
-	client      client.Client
-	secretRef   v1alpha1.NamespacedSecretKeyRef
-	severity    string
-	rateLimiter *rate.Limiter
+	httpSender
+
+	name      string
+	client    client.Client
+	secretRef v1alpha1.NamespacedSecretKeyRef
+	severity  string
 }
 
 // NewPagerDutyChannel creates a new PagerDuty channel
@@ -32,11 +33,10 @@ func NewPagerDutyChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, e
 	}
 
 	pc := &pagerDutyChannel{
-		name:        ac.Name,
-		client:      c,
-		secretRef:   ac.Spec.PagerDuty.RoutingKeySecretRef,
-		severity:    ac.Spec.PagerDuty.Severity,
-		rateLimiter: NewRateLimiter(ac.Spec.RateLimiting),
+		name:      ac.Name,
+		client:    c,
+		secretRef: ac.Spec.PagerDuty.RoutingKeySecretRef,
+		severity:  ac.Spec.PagerDuty.Severity,
 	}
 
 	return pc, nil
@@ -54,15 +54,71 @@ func (p *pagerDutyChannel) Type() string {
 
 // Send delivers an alert to PagerDuty
 func (p *pagerDutyChannel) Send(ctx context.Context, alert Alert) error {
-	if !p.rateLimiter.Allow() {
-		return fmt.Errorf("rate limit exceeded for channel %s", p.name)
+	routingKey, err := getValueFromSecret(ctx, p.client, p.secretRef)
+	if err != nil {
+		return err
+	}
+
+	jsonPayload, err := p.render(alert, routingKey)
+	if err != nil {
+		return err
 	}
+	return p.post(ctx, jsonPayload)
+}
 
+// Resolve sends a resolve event with the dedup key of the alert, so the
+// incident it triggered closes when the CronJob recovers
+func (p *pagerDutyChannel) Resolve(ctx context.Context, alert Alert) error {
 	routingKey, err := getValueFromSecret(ctx, p.client, p.secretRef)
 	if err != nil {
 		return err
 	}
 
+	jsonPayload, err := json.Marshal(map[string]interface{}{
+		"routing_key":  routingKey,
+		"event_action": "resolve",
+		"dedup_key":    alert.Key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal PagerDuty payload: %w", err)
+	}
+	return p.post(ctx, jsonPayload)
+}
+
+// post sends an event to the Events API v2
+func (p *pagerDutyChannel) post(ctx context.Context, jsonPayload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", pagerDutyEventsURL, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send pagerduty event: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("pagerduty returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Preview renders the PagerDuty event of an alert without sending it
+func (p *pagerDutyChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	jsonPayload, err := p.render(alert, redacted)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
+
+// render builds the Events API v2 trigger event of an alert
+func (p *pagerDutyChannel) render(alert Alert, routingKey string) ([]byte, error) {
 	pdSeverity := p.severity
 	if pdSeverity == "" {
 		switch alert.Severity {
@@ -85,52 +141,49 @@ func (p *pagerDutyChannel) Send(ctx context.Context, alert Alert) error {
 			"severity":  pdSeverity,
 			"timestamp": alert.Timestamp.Format(time.RFC3339),
 			"custom_details": map[string]interface{}{
-				"type":          alert.Type,
-				"message":       alert.Message,
-				"suggested_fix": alert.Context.SuggestedFix,
-				"success_rate":  alert.Context.SuccessRate,
-				"exit_code":     alert.Context.ExitCode,
-				"reason":        alert.Context.Reason,
+				"alert_id":       alert.ID,
+				"type":           alert.Type,
+				"job_name":       alert.Context.JobName,
+				"team":           alert.Team,
+				"cluster":        alert.Cluster,
+				"message":        alert.Message,
+				"suggested_fix":  alert.Context.SuggestedFix,
+				"success_rate":   alert.Context.SuccessRate,
+				"exit_code":      alert.Context.ExitCode,
+				"reason":         alert.Context.Reason,
+				"classification": alert.Context.Classification,
+				"node":           alert.Context.NodeName,
+				"images":         alert.Context.Images,
+				"labels":         alert.Context.Labels,
+				"annotations":    alert.Context.Annotations,
 			},
 		},
 	}
-
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal PagerDuty payload: %w", err)
-	}
-	req, err := http.NewRequestWithContext(ctx, "POST", pagerDutyEventsURL, bytes.NewReader(jsonPayload))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	if alert.RunbookURL != "" {
+		payload["links"] = []map[string]string{{"href": alert.RunbookURL, "text": "Runbook"}}
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := AlertHTTPClient.Do(req)
+	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to send pagerduty event: %w", err)
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	if resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("pagerduty returned status %d", resp.StatusCode)
+		return nil, fmt.Errorf("failed to marshal PagerDuty payload: %w", err)
 	}
-
-	return nil
+	return jsonPayload, nil
 }
 
-// Test sends a test alert
+// Test sends a test alert and resolves it right away, so it does not leave
+// an open incident
 func (p *pagerDutyChannel) Test(ctx context.Context) error {
-	return p.Send(
-		ctx, Alert{
-			Key:       "test-alert",
-			Type:      "Test",
-			Severity:  "info",
-			Title:     "CronJob Guardian Test Alert",
-			Message:   "This is a test alert from CronJob Guardian.",
-			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
-			Timestamp: time.Now(),
-		},
-	)
+	alert := Alert{
+		Key:       "test-alert",
+		Type:      "Test",
+		Severity:  "info",
+		Title:     "CronJob Guardian Test Alert",
+		Message:   "This is a test alert from CronJob Guardian.",
+		CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+		Timestamp: time.Now(),
+	}
+	if err := p.Send(ctx, alert); err != nil {
+		return err
+	}
+	return p.Resolve(ctx, alert)
 }
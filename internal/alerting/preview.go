@@ -0,0 +1,63 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// redacted replaces secret values, such as routing keys, in previews
+const redacted = "REDACTED"
+
+// ChannelPreview is what a channel would send for an alert
+type ChannelPreview struct {
+	Channel     string `json:"channel"`
+	Type        string `json:"type"`
+	ContentType string `json:"contentType,omitempty"`
+	Payload     string `json:"payload,omitempty"`
+	Note        string `json:"note,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Previewer is implemented by channels that can render an alert without
+// sending it. Secrets that would be part of the payload are redacted.
+type Previewer interface {
+	Preview(ctx context.Context, alert Alert) (ChannelPreview, error)
+}
+
+// Preview renders an alert for every channel it would be routed to, including
+// the channels of matching AlertRoutes, without sending it or touching
+// suppression state
+func (d *dispatcher) Preview(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) []ChannelPreview {
+	if alert.Key == "" {
+		alert.Key = fmt.Sprintf("%s/%s/%s", alert.CronJob.Namespace, alert.CronJob.Name, alert.Type)
+	}
+	d.annotateOwnership(ctx, &alert, alertCfg)
+
+	routes := d.routeAlert(ctx, alert)
+	var targets []Channel
+	if !routes.override {
+		targets = d.resolveChannels(alertCfg, alert.Severity)
+	}
+	targets = d.appendRouteChannels(targets, routes.refs, alert.Severity)
+	for _, route := range routes.grouped {
+		targets = d.appendRouteChannels(targets, route.Spec.ChannelRefs, alert.Severity)
+	}
+
+	previews := []ChannelPreview{}
+	for _, ch := range targets {
+		preview := ChannelPreview{}
+		if previewer, ok := ch.(Previewer); !ok {
+			preview.Error = "channel does not support previews"
+		} else if rendered, err := previewer.Preview(ctx, alert); err != nil {
+			preview.Error = err.Error()
+		} else {
+			preview = rendered
+		}
+		preview.Channel = ch.Name()
+		preview.Type = ch.Type()
+		previews = append(previews, preview)
+	}
+	return previews
+}
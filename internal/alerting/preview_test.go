@@ -0,0 +1,61 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestDispatcher_Preview(t *testing.T) {
+	d := testDispatcher(newMockStore())
+
+	slackCfg := createTestAlertChannel("slack-main", "slack")
+	slackCfg.Spec.Slack = &v1alpha1.SlackConfig{
+		WebhookSecretRef: v1alpha1.NamespacedSecretKeyRef{Namespace: "default", Name: "slack-webhook", Key: "url"},
+		DefaultChannel:   "#alerts",
+	}
+	slack, err := NewSlackChannel(nil, slackCfg)
+	require.NoError(t, err)
+	d.channels["slack-main"] = slack
+
+	pdCfg := createTestAlertChannel("pagerduty", "pagerduty")
+	pdCfg.Spec.PagerDuty = &v1alpha1.PagerDutyConfig{
+		RoutingKeySecretRef: v1alpha1.NamespacedSecretKeyRef{Namespace: "default", Name: "pd", Key: "key"},
+	}
+	pd, err := NewPagerDutyChannel(nil, pdCfg)
+	require.NoError(t, err)
+	d.channels["pagerduty"] = pd
+
+	mock := newMockChannel("custom", "webhook")
+	d.channels["custom"] = mock
+
+	cfg := testAlertingConfig("slack-main", "pagerduty", "custom")
+	cfg.ChannelRefs[1].Severities = []string{"critical"}
+	alert := testAlert("default", "cron-a", "JobFailed", "warning")
+
+	previews := d.Preview(context.Background(), alert, cfg)
+	require.Len(t, previews, 2, "pagerduty only receives critical alerts")
+
+	assert.Equal(t, "slack-main", previews[0].Channel)
+	assert.Equal(t, "application/json", previews[0].ContentType)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal([]byte(previews[0].Payload), &payload))
+	assert.Equal(t, "#alerts", payload["channel"])
+	assert.Contains(t, payload["text"], "Test Alert")
+
+	assert.Equal(t, "custom", previews[1].Channel)
+	assert.NotEmpty(t, previews[1].Error)
+	assert.Empty(t, mock.GetSentAlerts(), "previews send nothing")
+
+	alert.Severity = "critical"
+	previews = d.Preview(context.Background(), alert, cfg)
+	require.Len(t, previews, 3)
+	assert.Equal(t, "pagerduty", previews[1].Channel)
+	assert.Contains(t, previews[1].Payload, `"routing_key":"REDACTED"`)
+	assert.Equal(t, int32(0), d.alertCount24h, "previews are not counted as sent")
+}
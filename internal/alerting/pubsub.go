@@ -0,0 +1,218 @@
+package alerting
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	pubsub "google.golang.org/api/pubsub/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// pubSubDefaultEndpoint is the Pub/Sub API endpoint used without an override
+const pubSubDefaultEndpoint = "https://pubsub.googleapis.com"
+
+// ParseServiceAccountKey parses and validates a GCP service account JSON key,
+// returning the config that exchanges it for Pub/Sub access tokens
+func ParseServiceAccountKey(data []byte) (*jwt.Config, error) {
+	conf, err := google.JWTConfigFromJSON(data, pubsub.PubsubScope)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account JSON: %w", err)
+	}
+	if conf.Email == "" {
+		return nil, fmt.Errorf("service account JSON missing client_email")
+	}
+	// The key is otherwise only parsed on the first token exchange
+	block, _ := pem.Decode(conf.PrivateKey)
+	if block == nil {
+		return nil, fmt.Errorf("service account JSON has no PEM private_key")
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		if _, pkcs1Err := x509.ParsePKCS1PrivateKey(block.Bytes); pkcs1Err != nil {
+			return nil, fmt.Errorf("invalid private_key: %w", err)
+		}
+	}
+	return conf, nil
+}
+
+type pubSubChannel struct {
+	httpSender
+
+	name      string
+	client    client.Client
+	secretRef v1alpha1.NamespacedSecretKeyRef
+	topic     string
+	endpoint  string
+
+	// Cached API client, valid for the credentials it was built with. Its
+	// token source caches and renews access tokens.
+	mu       sync.Mutex
+	service  *pubsub.Service
+	svcCreds [sha256.Size]byte
+}
+
+// NewPubSubChannel creates a new GCP Pub/Sub channel
+func NewPubSubChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.PubSub == nil {
+		return nil, fmt.Errorf("pubsub config required for pubsub channel")
+	}
+	if ac.Spec.PubSub.Topic == "" {
+		return nil, fmt.Errorf("pubsub topic is required")
+	}
+
+	endpoint := strings.TrimSuffix(ac.Spec.PubSub.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = pubSubDefaultEndpoint
+	}
+
+	return &pubSubChannel{
+		name:      ac.Name,
+		client:    c,
+		secretRef: ac.Spec.PubSub.CredentialsSecretRef,
+		topic:     ac.Spec.PubSub.Topic,
+		endpoint:  endpoint,
+	}, nil
+}
+
+// Name returns the channel name
+func (p *pubSubChannel) Name() string {
+	return p.name
+}
+
+// Type returns the channel type
+func (p *pubSubChannel) Type() string {
+	return "pubsub"
+}
+
+// Send publishes an alert to the Pub/Sub topic
+func (p *pubSubChannel) Send(ctx context.Context, alert Alert) error {
+	creds, err := getValueFromSecret(ctx, p.client, p.secretRef)
+	if err != nil {
+		return err
+	}
+	service, err := p.pubSubService(ctx, []byte(creds))
+	if err != nil {
+		return err
+	}
+
+	request, err := p.render(alert)
+	if err != nil {
+		return err
+	}
+	if _, err := service.Projects.Topics.Publish(p.topic, request).Context(ctx).Do(); err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized {
+			p.mu.Lock()
+			p.service = nil
+			p.mu.Unlock()
+		}
+		return fmt.Errorf("failed to publish to Pub/Sub: %w", err)
+	}
+
+	return nil
+}
+
+// Preview renders the Pub/Sub publish request of an alert without sending it
+func (p *pubSubChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	request, err := p.render(alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	jsonPayload, err := json.Marshal(request)
+	if err != nil {
+		return ChannelPreview{}, fmt.Errorf("failed to marshal Pub/Sub payload: %w", err)
+	}
+	return ChannelPreview{
+		ContentType: "application/json",
+		Payload:     string(jsonPayload),
+		Note:        "message data is the base64-encoded alert payload",
+	}, nil
+}
+
+// render builds the publish request of an alert
+func (p *pubSubChannel) render(alert Alert) (*pubsub.PublishRequest, error) {
+	data, err := json.Marshal(NewAlertPayload(alert))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Pub/Sub message: %w", err)
+	}
+	attributes := map[string]string{}
+	for k, v := range map[string]string{
+		"type":      alert.Type,
+		"severity":  alert.Severity,
+		"namespace": alert.CronJob.Namespace,
+		"cronjob":   alert.CronJob.Name,
+	} {
+		if v != "" {
+			attributes[k] = v
+		}
+	}
+	return &pubsub.PublishRequest{
+		Messages: []*pubsub.PubsubMessage{{
+			Data:       base64.StdEncoding.EncodeToString(data),
+			Attributes: attributes,
+		}},
+	}, nil
+}
+
+// Test sends a test alert
+func (p *pubSubChannel) Test(ctx context.Context) error {
+	return p.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+// pubSubService returns the cached API client, building a new one when the
+// credentials changed. Token exchanges and publishes are sent with the
+// channel's HTTP client.
+func (p *pubSubChannel) pubSubService(ctx context.Context, creds []byte) (*pubsub.Service, error) {
+	fingerprint := sha256.Sum256(creds)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.service != nil && p.svcCreds == fingerprint {
+		return p.service, nil
+	}
+
+	conf, err := ParseServiceAccountKey(creds)
+	if err != nil {
+		return nil, err
+	}
+	// The token source outlives this send, so it must not use its context
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, p.sendClient())
+	service, err := pubsub.NewService(ctx,
+		option.WithHTTPClient(conf.Client(tokenCtx)),
+		option.WithEndpoint(p.endpoint+"/"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Pub/Sub client: %w", err)
+	}
+
+	p.service = service
+	p.svcCreds = fingerprint
+	return service, nil
+}
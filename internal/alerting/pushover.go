@@ -0,0 +1,260 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// Keys read from the Pushover channel's Secret
+const (
+	PushoverAppTokenKey = "app-token"
+	PushoverUserKeyKey  = "user-key"
+)
+
+// pushoverAPIURL is the message API endpoint (overridden in tests)
+var pushoverAPIURL = "https://api.pushover.net/1/messages.json"
+
+// Pushover priorities
+const (
+	pushoverPriorityLow       = -1
+	pushoverPriorityNormal    = 0
+	pushoverPriorityHigh      = 1
+	pushoverPriorityEmergency = 2
+)
+
+// Emergency notifications repeat every retry interval until acknowledged or expired
+const (
+	pushoverEmergencyRetry  = 5 * time.Minute
+	pushoverEmergencyExpire = time.Hour
+)
+
+// Pushover message limits
+const (
+	pushoverMaxTitle   = 250
+	pushoverMaxMessage = 1024
+)
+
+type pushoverChannel struct {
+	httpSender
+	dashboardLinks
+
+	name              string
+	client            client.Client
+	secretRef         v1alpha1.NamespacedSecretRef
+	devices           []string
+	sound             string
+	emergencyCritical bool
+	template          *template.Template
+}
+
+// NewPushoverChannel creates a new Pushover channel
+func NewPushoverChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.Pushover == nil {
+		return nil, fmt.Errorf("pushover config required for pushover channel")
+	}
+
+	pc := &pushoverChannel{
+		name:              ac.Name,
+		client:            c,
+		secretRef:         ac.Spec.Pushover.SecretRef,
+		devices:           ac.Spec.Pushover.Devices,
+		sound:             ac.Spec.Pushover.Sound,
+		emergencyCritical: ac.Spec.Pushover.EmergencyCritical,
+	}
+
+	tmplStr := defaultPushoverTemplate
+	if ac.Spec.Pushover.MessageTemplate != "" {
+		tmplStr = ac.Spec.Pushover.MessageTemplate
+	}
+	tmpl, err := ParsePushTemplate(tmplStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	pc.template = tmpl
+
+	return pc, nil
+}
+
+// Name returns the channel name
+func (p *pushoverChannel) Name() string {
+	return p.name
+}
+
+// Type returns the channel type
+func (p *pushoverChannel) Type() string {
+	return "pushover"
+}
+
+// Send delivers an alert to Pushover
+func (p *pushoverChannel) Send(ctx context.Context, alert Alert) error {
+	appToken, userKey, err := p.getCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	form, err := p.render(alert, appToken, userKey)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", pushoverAPIURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send pushover message: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var result struct {
+		Status int      `json:"status"`
+		Errors []string `json:"errors"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK || result.Status != 1 {
+		if len(result.Errors) > 0 {
+			return fmt.Errorf("pushover returned status %d: %s", resp.StatusCode, strings.Join(result.Errors, "; "))
+		}
+		return fmt.Errorf("pushover returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Preview renders the Pushover message of an alert without sending it
+func (p *pushoverChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	form, err := p.render(alert, redacted, redacted)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/x-www-form-urlencoded", Payload: form.Encode()}, nil
+}
+
+// render builds the message request of an alert
+func (p *pushoverChannel) render(alert Alert, appToken, userKey string) (url.Values, error) {
+	var buf bytes.Buffer
+	if err := p.template.Execute(&buf, alert); err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("token", appToken)
+	form.Set("user", userKey)
+	form.Set("title", truncateRunes(alert.Title, pushoverMaxTitle))
+	form.Set("message", truncateRunes(strings.TrimSpace(buf.String()), pushoverMaxMessage))
+	if !alert.Timestamp.IsZero() {
+		form.Set("timestamp", strconv.FormatInt(alert.Timestamp.Unix(), 10))
+	}
+	if len(p.devices) > 0 {
+		form.Set("device", strings.Join(p.devices, ","))
+	}
+	if p.sound != "" {
+		form.Set("sound", p.sound)
+	}
+	if link := p.cronJobURL(alert.CronJob); link != "" {
+		form.Set("url", link)
+		form.Set("url_title", "View in dashboard")
+	}
+
+	priority := p.priority(alert.Severity)
+	form.Set("priority", strconv.Itoa(priority))
+	if priority == pushoverPriorityEmergency {
+		form.Set("retry", strconv.Itoa(int(pushoverEmergencyRetry.Seconds())))
+		form.Set("expire", strconv.Itoa(int(pushoverEmergencyExpire.Seconds())))
+	}
+	return form, nil
+}
+
+// priority maps an alert severity to a Pushover priority
+func (p *pushoverChannel) priority(severity string) int {
+	switch severity {
+	case "critical":
+		if p.emergencyCritical {
+			return pushoverPriorityEmergency
+		}
+		return pushoverPriorityHigh
+	case "info":
+		return pushoverPriorityLow
+	default:
+		return pushoverPriorityNormal
+	}
+}
+
+// truncateRunes shortens s to at most n characters
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
+
+// Test sends a test alert
+func (p *pushoverChannel) Test(ctx context.Context) error {
+	return p.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+// getCredentials reads the application token and user key from the channel's Secret
+func (p *pushoverChannel) getCredentials(ctx context.Context) (string, string, error) {
+	secret := &corev1.Secret{}
+	err := p.client.Get(
+		ctx, types.NamespacedName{
+			Namespace: p.secretRef.Namespace,
+			Name:      p.secretRef.Name,
+		}, secret,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get pushover secret: %w", err)
+	}
+
+	appToken, ok := secret.Data[PushoverAppTokenKey]
+	if !ok {
+		return "", "", fmt.Errorf("pushover secret missing '%s' key", PushoverAppTokenKey)
+	}
+	userKey, ok := secret.Data[PushoverUserKeyKey]
+	if !ok {
+		return "", "", fmt.Errorf("pushover secret missing '%s' key", PushoverUserKeyKey)
+	}
+
+	return strings.TrimSpace(string(appToken)), strings.TrimSpace(string(userKey)), nil
+}
+
+var defaultPushoverTemplate = `{{ .Message }}
+
+CronJob: {{ .CronJob.Namespace }}/{{ .CronJob.Name }}{{ if .Context.ExitCode }}
+Exit code: {{ .Context.ExitCode }}{{ end }}{{ if .Context.Reason }}
+Reason: {{ .Context.Reason }}{{ end }}{{ if .Context.SuggestedFix }}
+
+Suggested fix: {{ .Context.SuggestedFix }}{{ end }}{{ if .Context.Logs }}
+
+Recent logs:
+{{ truncate .Context.Logs 400 }}{{ end }}
+`
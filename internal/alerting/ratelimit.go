@@ -0,0 +1,161 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// Rate limit scopes reported in metrics
+const (
+	rateLimitScopeGlobal  = "global"
+	rateLimitScopeMonitor = "monitor"
+	rateLimitScopeChannel = "channel"
+)
+
+// updateLimiter returns the limiter for key, replacing it only when the
+// configured rate changed so re-reconciling keeps the remaining budget.
+// A nil cfg removes the limiter. Caller MUST hold limiterMu.
+func updateLimiter(limiters map[string]*rate.Limiter, key string, cfg *v1alpha1.RateLimitConfig) *rate.Limiter {
+	if cfg == nil {
+		delete(limiters, key)
+		return nil
+	}
+	want := NewRateLimiter(cfg)
+	if cur, ok := limiters[key]; ok && cur.Limit() == want.Limit() && cur.Burst() == want.Burst() {
+		return cur
+	}
+	limiters[key] = want
+	return want
+}
+
+// reserve takes a token from lim if one is available at now. The returned
+// reservation gives the token back when cancelled at the same time. A nil
+// limiter always allows.
+func reserve(lim *rate.Limiter, now time.Time) (*rate.Reservation, bool) {
+	if lim == nil {
+		return nil, true
+	}
+	r := lim.ReserveN(now, 1)
+	if !r.OK() {
+		return nil, false
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	return r, true
+}
+
+// reserveLimit takes a token from lim and, with shared state, counts the
+// alert against the shared counter for key. Nothing is taken if either
+// refuses.
+func (d *dispatcher) reserveLimit(ctx context.Context, counters *[]sharedCounter, key string, lim *rate.Limiter, window time.Duration, now time.Time) (*rate.Reservation, bool) {
+	r, ok := reserve(lim, now)
+	if !ok {
+		return nil, false
+	}
+	if !d.takeShared(ctx, counters, key, lim, window, now) {
+		releaseReservations([]*rate.Reservation{r}, now)
+		return nil, false
+	}
+	return r, true
+}
+
+// releaseReservations returns tokens taken at now for an alert that was not sent
+func releaseReservations(reservations []*rate.Reservation, now time.Time) {
+	for _, r := range reservations {
+		if r != nil {
+			r.CancelAt(now)
+		}
+	}
+}
+
+// reserveAlert applies the monitor, channel and global rate limits to an
+// alert, in that order, so alerts dropped by a monitor or channel limit do
+// not use up the global budget. It returns the channels the alert may be
+// sent to, or an error when it must be dropped. With shared state, each
+// limit is also counted there so it holds across replicas and restarts.
+func (d *dispatcher) reserveAlert(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig, targets []Channel) ([]Channel, error) {
+	logger := loggerFor(ctx)
+	now := time.Now()
+	var reservations []*rate.Reservation
+	var counters []sharedCounter
+	release := func() {
+		releaseReservations(reservations, now)
+		d.releaseShared(ctx, counters, now)
+	}
+
+	monitorKey := ""
+	if alert.MonitorRef.Name != "" {
+		monitorKey = ClusterKey(alert.Cluster, alert.MonitorRef.Namespace+"/"+alert.MonitorRef.Name)
+	}
+
+	var monitorLimit *v1alpha1.RateLimitConfig
+	if alertCfg != nil {
+		monitorLimit = alertCfg.RateLimiting
+	}
+
+	d.limiterMu.Lock()
+	var monitorLimiter *rate.Limiter
+	if monitorKey != "" {
+		monitorLimiter = updateLimiter(d.monitorLimiters, monitorKey, monitorLimit)
+	}
+	channelLimiters := make([]*rate.Limiter, len(targets))
+	for i, ch := range targets {
+		channelLimiters[i] = d.channelLimiters[ch.Name()]
+	}
+	d.limiterMu.Unlock()
+
+	r, ok := d.reserveLimit(ctx, &counters, rateLimitScopeMonitor+":"+monitorKey, monitorLimiter, sharedLimitWindow, now)
+	if !ok {
+		metrics.RecordAlertRateLimited(rateLimitScopeMonitor, monitorKey)
+		logger.Info("alert rate limited by monitor", "key", alert.Key, "monitor", monitorKey)
+		return nil, fmt.Errorf("rate limit exceeded for monitor %s", monitorKey)
+	}
+	reservations = append(reservations, r)
+
+	allowed := make([]Channel, 0, len(targets))
+	for i, ch := range targets {
+		r, ok := d.reserveLimit(ctx, &counters, rateLimitScopeChannel+":"+ch.Name(), channelLimiters[i], sharedLimitWindow, now)
+		if !ok {
+			metrics.RecordAlertRateLimited(rateLimitScopeChannel, ch.Name())
+			logger.Info("alert rate limited by channel", "key", alert.Key, "channel", ch.Name())
+			continue
+		}
+		reservations = append(reservations, r)
+		allowed = append(allowed, ch)
+	}
+	if len(allowed) == 0 {
+		release()
+		return nil, fmt.Errorf("rate limit exceeded for all channels")
+	}
+
+	if _, ok := d.reserveLimit(ctx, &counters, rateLimitScopeGlobal, d.globalLimiter, sharedGlobalLimitWindow, now); !ok {
+		release()
+		metrics.RecordAlertRateLimited(rateLimitScopeGlobal, "")
+		logger.Info("alert rate limited", "key", alert.Key)
+		return nil, fmt.Errorf("global rate limit exceeded")
+	}
+
+	return allowed, nil
+}
+
+// allowChannel reports whether the channel's rate limit allows a send now
+func (d *dispatcher) allowChannel(name string) bool {
+	d.limiterMu.Lock()
+	lim := d.channelLimiters[name]
+	d.limiterMu.Unlock()
+
+	var counters []sharedCounter
+	if _, ok := d.reserveLimit(context.Background(), &counters, rateLimitScopeChannel+":"+name, lim, sharedLimitWindow, time.Now()); !ok {
+		metrics.RecordAlertRateLimited(rateLimitScopeChannel, name)
+		return false
+	}
+	return true
+}
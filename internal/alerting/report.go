@@ -0,0 +1,45 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// ReportSender is implemented by channels that can deliver rendered reports
+type ReportSender interface {
+	// SendReport delivers a report
+	SendReport(ctx context.Context, r Report) error
+}
+
+// Report is a rendered report, sent as an HTML message with attachments
+type Report struct {
+	Subject     string
+	HTML        string
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to a report
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// validateSLAReport checks the timezone and time of day of scheduled SLA
+// reports; the CRD restricts the schedule
+func validateSLAReport(cfg *v1alpha1.EmailSLAReportConfig) error {
+	if cfg.Timezone != "" {
+		if _, err := time.LoadLocation(cfg.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+		}
+	}
+	if cfg.Time != "" {
+		if _, _, err := parseTimeOfDay(cfg.Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
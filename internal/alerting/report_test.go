@@ -0,0 +1,61 @@
+package alerting
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestValidateSLAReport(t *testing.T) {
+	assert.NoError(t, validateSLAReport(&v1alpha1.EmailSLAReportConfig{Schedule: "weekly"}))
+	assert.NoError(t, validateSLAReport(&v1alpha1.EmailSLAReportConfig{Schedule: "weekly", Time: "06:15", Timezone: "Europe/Berlin"}))
+	assert.Error(t, validateSLAReport(&v1alpha1.EmailSLAReportConfig{Schedule: "weekly", Timezone: "Mars/Olympus"}))
+	assert.Error(t, validateSLAReport(&v1alpha1.EmailSLAReportConfig{Schedule: "weekly", Time: "25:00"}))
+}
+
+func TestReportContent(t *testing.T) {
+	content, err := reportContent(Report{HTML: "<p>report</p>"})
+	require.NoError(t, err)
+	assert.Equal(t, "Content-Type: text/html; charset=utf-8\r\n\r\n<p>report</p>", content)
+
+	pdf := []byte(strings.Repeat("%PDF-1.4 ", 20))
+	content, err = reportContent(Report{
+		HTML:        "<p>report</p>",
+		Attachments: []Attachment{{Filename: "report.pdf", ContentType: "application/pdf", Data: pdf}},
+	})
+	require.NoError(t, err)
+
+	header, err := textproto.NewReader(bufio.NewReader(strings.NewReader(content))).ReadMIMEHeader()
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	_, body, _ := strings.Cut(content, "\r\n\r\n")
+	mr := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	part, err := mr.NextPart()
+	require.NoError(t, err)
+	html, _ := io.ReadAll(part)
+	assert.Equal(t, "<p>report</p>", string(html))
+
+	part, err = mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "report.pdf", part.FileName())
+	encoded, _ := io.ReadAll(part)
+	for _, line := range strings.Split(strings.TrimSpace(string(encoded)), "\r\n") {
+		assert.LessOrEqual(t, len(line), 76)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, pdf, decoded)
+}
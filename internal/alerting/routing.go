@@ -0,0 +1,114 @@
+package alerting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+const minutesPerDay = 24 * 60
+
+// RoutedChannelRefs returns the channels an alert sent at now goes to: those
+// of the first routing rule whose window contains now, otherwise ChannelRefs
+func RoutedChannelRefs(alertCfg *v1alpha1.AlertingConfig, now time.Time) []v1alpha1.ChannelRef {
+	routing := alertCfg.Routing
+	if routing == nil || len(routing.Rules) == 0 {
+		return alertCfg.ChannelRefs
+	}
+
+	// Invalid timezones are reported by the monitor's Ready condition
+	loc, err := time.LoadLocation(routing.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+
+	for _, rule := range routing.Rules {
+		if ruleMatches(rule, local) {
+			return rule.ChannelRefs
+		}
+	}
+	return alertCfg.ChannelRefs
+}
+
+// ruleMatches reports whether t (in the routing timezone) falls in the rule's
+// window. Rules with invalid fields never match.
+func ruleMatches(rule v1alpha1.RoutingRule, t time.Time) bool {
+	start, end, err := ruleWindow(rule)
+	if err != nil {
+		return false
+	}
+	minute := t.Hour()*60 + t.Minute()
+
+	if start < end {
+		return minute >= start && minute < end && ruleDay(rule, t.Weekday())
+	}
+	// The window runs past midnight: match the evening of a listed day or
+	// the morning after it
+	if minute >= start {
+		return ruleDay(rule, t.Weekday())
+	}
+	return minute < end && ruleDay(rule, (t.Weekday()+6)%7)
+}
+
+// ruleWindow returns the rule's start and end as minutes of the day
+func ruleWindow(rule v1alpha1.RoutingRule) (int, int, error) {
+	start, end := 0, minutesPerDay
+	if rule.Start != "" {
+		hour, minute, err := parseTimeOfDay(rule.Start)
+		if err != nil {
+			return 0, 0, err
+		}
+		start = hour*60 + minute
+	}
+	if rule.End != "" {
+		hour, minute, err := parseTimeOfDay(rule.End)
+		if err != nil {
+			return 0, 0, err
+		}
+		end = hour*60 + minute
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("start and end are both %s", rule.Start)
+	}
+	return start, end, nil
+}
+
+// ruleDay reports whether the rule's window starts on the given day
+func ruleDay(rule v1alpha1.RoutingRule, day time.Weekday) bool {
+	if len(rule.Days) == 0 {
+		return true
+	}
+	for _, d := range rule.Days {
+		if wd, err := parseWeekday(d); err == nil && wd == day {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRouting checks the routing timezone and rule windows
+func ValidateRouting(routing *v1alpha1.AlertRoutingConfig) error {
+	if routing == nil {
+		return nil
+	}
+	if _, err := time.LoadLocation(routing.Timezone); err != nil {
+		return fmt.Errorf("invalid routing timezone %q: %w", routing.Timezone, err)
+	}
+	for i, rule := range routing.Rules {
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		if _, _, err := ruleWindow(rule); err != nil {
+			return fmt.Errorf("routing rule %s: %w", name, err)
+		}
+		for _, d := range rule.Days {
+			if _, err := parseWeekday(d); err != nil {
+				return fmt.Errorf("routing rule %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
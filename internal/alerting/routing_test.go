@@ -0,0 +1,88 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestRoutedChannelRefs(t *testing.T) {
+	cfg := &v1alpha1.AlertingConfig{
+		ChannelRefs: []v1alpha1.ChannelRef{{Name: "pagerduty"}},
+		Routing: &v1alpha1.AlertRoutingConfig{
+			Timezone: "America/New_York",
+			Rules: []v1alpha1.RoutingRule{
+				{
+					Name:        "business-hours",
+					Days:        []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+					Start:       "09:00",
+					End:         "17:00",
+					ChannelRefs: []v1alpha1.ChannelRef{{Name: "slack"}},
+				},
+				{
+					Name:        "friday-night",
+					Days:        []string{"Friday"},
+					Start:       "22:00",
+					End:         "06:00",
+					ChannelRefs: []v1alpha1.ChannelRef{{Name: "oncall-weekend"}},
+				},
+			},
+		},
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want string
+	}{
+		{"weekday business hours", time.Date(2024, 1, 10, 10, 0, 0, 0, ny), "slack"},
+		{"start is inclusive", time.Date(2024, 1, 10, 9, 0, 0, 0, ny), "slack"},
+		{"end is exclusive", time.Date(2024, 1, 10, 17, 0, 0, 0, ny), "pagerduty"},
+		{"weekday night", time.Date(2024, 1, 10, 20, 0, 0, 0, ny), "pagerduty"},
+		{"weekend", time.Date(2024, 1, 13, 10, 0, 0, 0, ny), "pagerduty"},
+		// 15:00 UTC is 10:00 in New York
+		{"evaluated in routing timezone", time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC), "slack"},
+		{"overnight window on its start day", time.Date(2024, 1, 12, 23, 0, 0, 0, ny), "oncall-weekend"},
+		{"overnight window the morning after", time.Date(2024, 1, 13, 5, 0, 0, 0, ny), "oncall-weekend"},
+		{"overnight window not on other days", time.Date(2024, 1, 11, 23, 0, 0, 0, ny), "pagerduty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs := RoutedChannelRefs(cfg, tt.now)
+			if assert.Len(t, refs, 1) {
+				assert.Equal(t, tt.want, refs[0].Name)
+			}
+		})
+	}
+}
+
+func TestRoutedChannelRefs_NoRouting(t *testing.T) {
+	cfg := &v1alpha1.AlertingConfig{ChannelRefs: []v1alpha1.ChannelRef{{Name: "slack"}}}
+	assert.Equal(t, cfg.ChannelRefs, RoutedChannelRefs(cfg, time.Now()))
+}
+
+func TestValidateRouting(t *testing.T) {
+	slack := []v1alpha1.ChannelRef{{Name: "slack"}}
+
+	assert.NoError(t, ValidateRouting(nil))
+	assert.NoError(t, ValidateRouting(&v1alpha1.AlertRoutingConfig{
+		Rules: []v1alpha1.RoutingRule{{Start: "09:00", End: "17:00", ChannelRefs: slack}},
+	}))
+	assert.Error(t, ValidateRouting(&v1alpha1.AlertRoutingConfig{Timezone: "Nowhere/Land"}))
+	assert.Error(t, ValidateRouting(&v1alpha1.AlertRoutingConfig{
+		Rules: []v1alpha1.RoutingRule{{Start: "25:00", ChannelRefs: slack}},
+	}))
+	assert.Error(t, ValidateRouting(&v1alpha1.AlertRoutingConfig{
+		Rules: []v1alpha1.RoutingRule{{Start: "09:00", End: "09:00", ChannelRefs: slack}},
+	}))
+	assert.Error(t, ValidateRouting(&v1alpha1.AlertRoutingConfig{
+		Rules: []v1alpha1.RoutingRule{{Days: []string{"Someday"}, ChannelRefs: slack}},
+	}))
+}
@@ -0,0 +1,64 @@
+package alerting
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// RunbookURLAnnotation links a CronJob to its runbook. It is set on the
+// CronJob, so the owners of a workload document how to fix it.
+const RunbookURLAnnotation = "guardian.illenium.net/runbook-url"
+
+// ResolveRunbookURL returns the runbook URL for an alert type: the monitor's
+// runbookURLs entry for the type, or else the CronJob's runbook annotation.
+// cronJob may be nil when it is not known.
+func ResolveRunbookURL(alertCfg *v1alpha1.AlertingConfig, alertType string, cronJob metav1.Object) string {
+	if u := alertCfg.RunbookURLFor(alertType); u != "" {
+		return u
+	}
+	if cronJob == nil {
+		return ""
+	}
+	return cronJob.GetAnnotations()[RunbookURLAnnotation]
+}
+
+// alertCronJob looks up the CronJob an alert is about, for its runbook
+// annotation and owning team. It returns nil when the alert is not about a
+// CronJob or the lookup fails.
+func (d *dispatcher) alertCronJob(ctx context.Context, alert Alert) metav1.Object {
+	c := d.clientFor(alert.Cluster)
+	if c == nil || alert.CronJob.Name == "" {
+		return nil
+	}
+	cronJob := &batchv1.CronJob{}
+	if err := c.Get(ctx, alert.CronJob, cronJob); err != nil {
+		return nil
+	}
+	return cronJob
+}
+
+// annotateOwnership fills in the runbook URL and owning team of an alert
+// that does not carry them yet, looking up the CronJob at most once
+func (d *dispatcher) annotateOwnership(ctx context.Context, alert *Alert, alertCfg *v1alpha1.AlertingConfig) {
+	if alert.RunbookURL == "" {
+		alert.RunbookURL = alertCfg.RunbookURLFor(alert.Type)
+	}
+	if alert.RunbookURL != "" && alert.Team != "" {
+		return
+	}
+	cronJob := d.alertCronJob(ctx, *alert)
+	if alert.RunbookURL == "" {
+		alert.RunbookURL = ResolveRunbookURL(alertCfg, alert.Type, cronJob)
+	}
+	if alert.Team == "" {
+		var labels map[string]string
+		if cronJob != nil {
+			labels = cronJob.GetLabels()
+		}
+		alert.Team = d.ownership.TeamFor(alert.CronJob.Namespace, labels)
+	}
+}
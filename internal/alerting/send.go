@@ -0,0 +1,91 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+const (
+	// DefaultSendTimeout bounds a send to a channel without sendTimeout
+	DefaultSendTimeout = 30 * time.Second
+
+	// DefaultSendRetryBackoff is the wait before the first retry of a channel without retryBackoff
+	DefaultSendRetryBackoff = time.Second
+
+	// maxSendRetryBackoff caps the doubled wait between retries
+	maxSendRetryBackoff = 30 * time.Second
+)
+
+// sendSettings are a channel's send timeout and retries
+type sendSettings struct {
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+}
+
+// sendSettingsFor reads the send settings of an AlertChannel, with defaults
+func sendSettingsFor(spec v1alpha1.AlertChannelSpec) sendSettings {
+	s := sendSettings{
+		timeout: DefaultSendTimeout,
+		retries: int(max(ptr.Deref(spec.RetryCount, 0), 0)),
+		backoff: DefaultSendRetryBackoff,
+	}
+	if spec.SendTimeout != nil && spec.SendTimeout.Duration > 0 {
+		s.timeout = spec.SendTimeout.Duration
+	}
+	if spec.RetryBackoff != nil && spec.RetryBackoff.Duration > 0 {
+		s.backoff = spec.RetryBackoff.Duration
+	}
+	return s
+}
+
+// sendSettingsOf returns the send settings of a registered channel
+func (d *dispatcher) sendSettingsOf(name string) sendSettings {
+	d.channelMu.RLock()
+	s, ok := d.sendSettings[name]
+	d.channelMu.RUnlock()
+	if !ok {
+		return sendSettingsFor(v1alpha1.AlertChannelSpec{})
+	}
+	return s
+}
+
+// send sends an alert to a channel, retrying a failed send as configured on
+// the channel. Each attempt is bounded by the channel's send timeout.
+func (d *dispatcher) send(ctx context.Context, ch Channel, alert Alert) error {
+	s := d.sendSettingsOf(ch.Name())
+
+	backoff := s.backoff
+	var err error
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		if attempt > 0 {
+			loggerFor(ctx).V(1).Info("retrying send to channel",
+				"channel", ch.Name(), "alertId", alert.ID, "alertKey", alert.Key, "attempt", attempt, "backoff", backoff, "error", err.Error())
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w (retry cancelled: %w)", err, ctx.Err())
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, max(maxSendRetryBackoff, s.backoff))
+		}
+		if err = sendOnce(ctx, ch, alert, s.timeout); err == nil {
+			return nil
+		}
+	}
+	if s.retries > 0 {
+		return fmt.Errorf("after %d retries: %w", s.retries, err)
+	}
+	return err
+}
+
+// sendOnce sends an alert to a channel within the timeout
+func sendOnce(ctx context.Context, ch Channel, alert Alert, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return ch.Send(ctx, alert)
+}
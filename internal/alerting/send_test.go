@@ -0,0 +1,119 @@
+package alerting
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// flakyChannel fails its first sends, or blocks each send until its context is done
+type flakyChannel struct {
+	mockChannel
+	failures int
+	block    bool
+	attempts int
+}
+
+func (f *flakyChannel) Send(ctx context.Context, alert Alert) error {
+	f.mu.Lock()
+	f.attempts++
+	attempt := f.attempts
+	f.mu.Unlock()
+
+	if f.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if attempt <= f.failures {
+		return errors.New("connection reset")
+	}
+	return f.mockChannel.Send(ctx, alert)
+}
+
+func (f *flakyChannel) Attempts() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.attempts
+}
+
+func newFlakyChannel(name string, failures int) *flakyChannel {
+	return &flakyChannel{mockChannel: mockChannel{name: name, chanType: "webhook"}, failures: failures}
+}
+
+func TestSendSettingsFor(t *testing.T) {
+	assert.Equal(t, sendSettings{timeout: DefaultSendTimeout, backoff: DefaultSendRetryBackoff}, sendSettingsFor(v1alpha1.AlertChannelSpec{}))
+
+	assert.Equal(t, sendSettings{timeout: 5 * time.Second, retries: 3, backoff: 200 * time.Millisecond}, sendSettingsFor(v1alpha1.AlertChannelSpec{
+		SendTimeout:  &metav1.Duration{Duration: 5 * time.Second},
+		RetryCount:   ptr.To[int32](3),
+		RetryBackoff: &metav1.Duration{Duration: 200 * time.Millisecond},
+	}))
+}
+
+func TestDispatcher_Send_Retries(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newFlakyChannel("hook", 2)
+	d.channels["hook"] = ch
+	d.sendSettings["hook"] = sendSettings{timeout: time.Second, retries: 2, backoff: time.Millisecond}
+
+	require.NoError(t, d.Dispatch(context.Background(), testAlert("default", "backup", "JobFailed", "critical"), testAlertingConfig("hook")))
+	assert.Equal(t, 3, ch.Attempts())
+	assert.Len(t, ch.GetSentAlerts(), 1)
+	assert.Equal(t, int64(1), d.GetChannelStats("hook").AlertsSentTotal, "a send that succeeds on retry counts once")
+}
+
+func TestDispatcher_Send_RetriesExhausted(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newFlakyChannel("hook", 5)
+	d.channels["hook"] = ch
+	d.sendSettings["hook"] = sendSettings{timeout: time.Second, retries: 1, backoff: time.Millisecond}
+
+	err := d.SendToChannel(context.Background(), "hook", testAlert("default", "backup", "JobFailed", "critical"))
+	assert.ErrorContains(t, err, "after 1 retries: connection reset")
+	assert.Equal(t, 2, ch.Attempts())
+}
+
+func TestDispatcher_Send_Timeout(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newFlakyChannel("hook", 0)
+	ch.block = true
+	d.channels["hook"] = ch
+	d.sendSettings["hook"] = sendSettings{timeout: 20 * time.Millisecond, retries: 1, backoff: time.Millisecond}
+
+	start := time.Now()
+	err := d.SendToChannel(context.Background(), "hook", testAlert("default", "backup", "JobFailed", "critical"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 2, ch.Attempts(), "each attempt gets its own timeout")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDispatcher_Send_CancelledDuringBackoff(t *testing.T) {
+	d := testDispatcher(newMockStore())
+	ch := newFlakyChannel("hook", 5)
+	d.channels["hook"] = ch
+	d.sendSettings["hook"] = sendSettings{timeout: time.Second, retries: 3, backoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	var err error
+	go func() {
+		defer wg.Done()
+		err = d.SendToChannel(ctx, "hook", testAlert("default", "backup", "JobFailed", "critical"))
+	}()
+	require.Eventually(t, func() bool { return ch.Attempts() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, ch.Attempts())
+}
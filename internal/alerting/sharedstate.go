@@ -0,0 +1,177 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// AlertStateStore persists duplicate suppression state and dedup claims.
+// store.Store implements it.
+type AlertStateStore interface {
+	SaveAlertState(ctx context.Context, state store.AlertState) error
+	DeleteAlertStates(ctx context.Context, alertKeys []string) error
+	ListAlertStates(ctx context.Context, since time.Time) ([]store.AlertState, error)
+	PruneAlertStates(ctx context.Context, olderThan time.Time) (int64, error)
+	ClaimAlert(ctx context.Context, claim store.AlertClaim, window time.Duration) (bool, error)
+	DeleteAlertClaims(ctx context.Context, alertKeys []string) error
+	PruneAlertClaims(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+// SharedState keeps dispatcher state that replicas must agree on outside
+// the process: suppression state and claims, rate limit counters and the
+// delayed alert queue. Without it, suppression state is kept in the store and
+// the rest in memory.
+type SharedState interface {
+	AlertStateStore
+
+	// IncrCounter increments the counter for key in the fixed window
+	// containing now and returns its new value
+	IncrCounter(ctx context.Context, key string, window time.Duration, now time.Time) (int64, error)
+
+	// DecrCounter gives back an increment taken in the window containing now
+	DecrCounter(ctx context.Context, key string, window time.Duration, now time.Time) error
+
+	// AddPendingAlert queues a delayed alert; false if it is already queued
+	AddPendingAlert(ctx context.Context, alertKey string, payload []byte, sendAt time.Time) (bool, error)
+
+	// RemovePendingAlert dequeues a delayed alert; false if it was not queued
+	RemovePendingAlert(ctx context.Context, alertKey string) (bool, error)
+
+	// ListPendingAlerts returns the payloads of all queued alerts by key
+	ListPendingAlerts(ctx context.Context) (map[string][]byte, error)
+}
+
+// Fixed windows of the shared rate limit counters. Monitor and channel
+// limits are configured per hour, the global limit per minute.
+const (
+	sharedLimitWindow       = time.Hour
+	sharedGlobalLimitWindow = time.Minute
+)
+
+// sharedCounter is an increment taken from a shared rate limit counter
+type sharedCounter struct {
+	key    string
+	window time.Duration
+}
+
+// takeShared counts an alert against lim's budget in shared state, so the
+// limit holds across replicas and restarts. The limiter's rate is enforced
+// per fixed window; bursts are only shaped by the local limiter. Errors fail
+// open, preferring a duplicate over a lost alert.
+func (d *dispatcher) takeShared(ctx context.Context, taken *[]sharedCounter, key string, lim *rate.Limiter, window time.Duration, now time.Time) bool {
+	if d.shared == nil || lim == nil || lim.Limit() == rate.Inf {
+		return true
+	}
+	limit := int64(math.Round(float64(lim.Limit()) * window.Seconds()))
+	n, err := d.shared.IncrCounter(ctx, key, window, now)
+	if err != nil {
+		loggerFor(ctx).Error(err, "failed to update shared rate limit, allowing alert", "key", key)
+		return true
+	}
+	counter := sharedCounter{key: key, window: window}
+	if n > limit {
+		d.releaseShared(ctx, []sharedCounter{counter}, now)
+		return false
+	}
+	*taken = append(*taken, counter)
+	return true
+}
+
+// releaseShared gives back shared counters taken for an alert that was not sent
+func (d *dispatcher) releaseShared(ctx context.Context, taken []sharedCounter, now time.Time) {
+	for _, c := range taken {
+		if err := d.shared.DecrCounter(ctx, c.key, c.window, now); err != nil {
+			loggerFor(ctx).Error(err, "failed to release shared rate limit", "key", c.key)
+		}
+	}
+}
+
+// sharedPendingAlert is a delayed alert as queued in shared state
+type sharedPendingAlert struct {
+	Alert    Alert                    `json:"alert"`
+	AlertCfg *v1alpha1.AlertingConfig `json:"alertCfg,omitempty"`
+	SendAt   time.Time                `json:"sendAt"`
+}
+
+// queueSharedPending adds a delayed alert to the shared queue, so it is sent
+// after a restart or by the next leader
+func (d *dispatcher) queueSharedPending(ctx context.Context, pending *PendingAlert) {
+	if d.shared == nil {
+		return
+	}
+	payload, err := json.Marshal(sharedPendingAlert{Alert: pending.Alert, AlertCfg: pending.AlertCfg, SendAt: pending.SendAt})
+	if err != nil {
+		loggerFor(ctx).Error(err, "failed to encode pending alert", "key", pending.Alert.Key)
+		return
+	}
+	if _, err := d.shared.AddPendingAlert(ctx, pending.Alert.Key, payload, pending.SendAt); err != nil {
+		loggerFor(ctx).Error(err, "failed to queue pending alert in shared state", "key", pending.Alert.Key)
+	}
+}
+
+// takeSharedPending removes a delayed alert from the shared queue before it
+// is sent or cancelled. It returns false if another replica already took it.
+func (d *dispatcher) takeSharedPending(ctx context.Context, alertKey string) bool {
+	if d.shared == nil {
+		return true
+	}
+	removed, err := d.shared.RemovePendingAlert(ctx, alertKey)
+	if err != nil {
+		loggerFor(ctx).Error(err, "failed to remove pending alert from shared state", "key", alertKey)
+		return true
+	}
+	return removed
+}
+
+// restorePendingAlerts schedules delayed alerts queued in shared state that
+// this replica does not know about, e.g. ones queued before a restart or by
+// the previous leader. Alerts already due are sent right away.
+func (d *dispatcher) restorePendingAlerts(ctx context.Context) {
+	if d.shared == nil {
+		return
+	}
+	logger := loggerFor(ctx)
+
+	payloads, err := d.shared.ListPendingAlerts(ctx)
+	if err != nil {
+		logger.Error(err, "failed to load pending alerts from shared state")
+		return
+	}
+
+	restored := 0
+	for key, payload := range payloads {
+		var entry sharedPendingAlert
+		if err := json.Unmarshal(payload, &entry); err != nil {
+			logger.Error(err, "dropping invalid pending alert", "key", key)
+			d.takeSharedPending(ctx, key)
+			continue
+		}
+
+		d.pendingMu.Lock()
+		if _, ok := d.pendingAlerts[key]; ok {
+			d.pendingMu.Unlock()
+			continue
+		}
+		pending := &PendingAlert{
+			Alert:    entry.Alert,
+			AlertCfg: entry.AlertCfg,
+			SendAt:   entry.SendAt,
+			Cancel:   make(chan struct{}),
+		}
+		d.pendingAlerts[key] = pending
+		d.pendingMu.Unlock()
+
+		d.waitPending(pending)
+		restored++
+	}
+	if restored > 0 {
+		logger.Info("restored pending alerts from shared state", "count", restored)
+	}
+}
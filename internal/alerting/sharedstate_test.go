@@ -0,0 +1,182 @@
+package alerting
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// memorySharedState is an in-memory SharedState shared by test dispatchers
+type memorySharedState struct {
+	*mockStore
+	mu       sync.Mutex
+	counters map[string]int64
+	pending  map[string][]byte
+}
+
+func newMemorySharedState() *memorySharedState {
+	return &memorySharedState{
+		mockStore: newMockStore(),
+		counters:  make(map[string]int64),
+		pending:   make(map[string][]byte),
+	}
+}
+
+func (m *memorySharedState) counterKey(key string, window time.Duration, now time.Time) string {
+	return key + "@" + now.Truncate(window).String()
+}
+
+func (m *memorySharedState) IncrCounter(_ context.Context, key string, window time.Duration, now time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[m.counterKey(key, window, now)]++
+	return m.counters[m.counterKey(key, window, now)], nil
+}
+
+func (m *memorySharedState) DecrCounter(_ context.Context, key string, window time.Duration, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[m.counterKey(key, window, now)]--
+	return nil
+}
+
+func (m *memorySharedState) AddPendingAlert(_ context.Context, alertKey string, payload []byte, _ time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.pending[alertKey]; ok {
+		return false, nil
+	}
+	m.pending[alertKey] = payload
+	return true, nil
+}
+
+func (m *memorySharedState) RemovePendingAlert(_ context.Context, alertKey string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.pending[alertKey]
+	delete(m.pending, alertKey)
+	return ok, nil
+}
+
+func (m *memorySharedState) ListPendingAlerts(_ context.Context) (map[string][]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[string][]byte, len(m.pending))
+	for key, payload := range m.pending {
+		result[key] = payload
+	}
+	return result, nil
+}
+
+func (m *memorySharedState) pendingCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.pending)
+}
+
+func sharedTestDispatcher(shared SharedState) *dispatcher {
+	d := testDispatcher(newMockStore())
+	d.shared = shared
+	d.stateStore = shared
+	return d
+}
+
+func TestDispatcher_SharedState_PendingAlertSentOnce(t *testing.T) {
+	shared := newMemorySharedState()
+	ctx := context.Background()
+	alert := testAlert("default", "test-cron", "JobFailed", "critical")
+	cfg := testAlertingConfig("slack-main")
+	cfg.AlertDelay = &metav1.Duration{Duration: 200 * time.Millisecond}
+
+	first := sharedTestDispatcher(shared)
+	firstCh := newMockChannel("slack-main", "slack")
+	first.channels["slack-main"] = firstCh
+	require.NoError(t, first.Dispatch(ctx, alert, cfg))
+	assert.Equal(t, 1, shared.pendingCount())
+
+	// A new leader picks the queued alert up; only one of them sends it
+	second := sharedTestDispatcher(shared)
+	secondCh := newMockChannel("slack-main", "slack")
+	second.channels["slack-main"] = secondCh
+	second.ReloadAlertState(ctx)
+	second.pendingMu.RLock()
+	restored, ok := second.pendingAlerts[alert.Key]
+	second.pendingMu.RUnlock()
+	require.True(t, ok)
+	assert.Equal(t, alert.Key, restored.Alert.Key)
+	assert.Equal(t, cfg.AlertDelay, restored.AlertCfg.AlertDelay)
+
+	assert.Eventually(t, func() bool {
+		return len(firstCh.GetSentAlerts())+len(secondCh.GetSentAlerts()) == 1
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, len(firstCh.GetSentAlerts())+len(secondCh.GetSentAlerts()))
+	assert.Zero(t, shared.pendingCount())
+}
+
+func TestDispatcher_SharedState_CancelRemovesPendingAlert(t *testing.T) {
+	shared := newMemorySharedState()
+	d := sharedTestDispatcher(shared)
+	d.channels["slack-main"] = newMockChannel("slack-main", "slack")
+
+	cfg := testAlertingConfig("slack-main")
+	cfg.AlertDelay = &metav1.Duration{Duration: time.Hour}
+	require.NoError(t, d.Dispatch(context.Background(), testAlert("default", "test-cron", "JobFailed", "critical"), cfg))
+	require.Equal(t, 1, shared.pendingCount())
+
+	assert.Equal(t, 1, d.CancelPendingAlertsForCronJob("default", "test-cron"))
+	assert.Zero(t, shared.pendingCount())
+}
+
+func TestDispatcher_SharedState_RateLimitAcrossReplicas(t *testing.T) {
+	shared := newMemorySharedState()
+	ctx := context.Background()
+	limit := &v1alpha1.RateLimitConfig{MaxAlertsPerHour: ptr.To[int32](1), BurstLimit: ptr.To[int32](1)}
+
+	replica := func() (*dispatcher, *mockChannel) {
+		d := sharedTestDispatcher(shared)
+		ch := newMockChannel("pager", "pagerduty")
+		d.channels["pager"] = ch
+		d.limiterMu.Lock()
+		updateLimiter(d.channelLimiters, "pager", limit)
+		d.limiterMu.Unlock()
+		return d, ch
+	}
+
+	first, firstCh := replica()
+	require.NoError(t, first.Dispatch(ctx, testAlert("default", "cron-a", "JobFailed", "critical"), testAlertingConfig("pager")))
+	assert.Len(t, firstCh.GetSentAlerts(), 1)
+
+	// The second replica's own limiter has budget, but the shared counter does not
+	second, secondCh := replica()
+	assert.ErrorContains(t, second.Dispatch(ctx, testAlert("default", "cron-b", "JobFailed", "critical"), testAlertingConfig("pager")), "all channels")
+	assert.Empty(t, secondCh.GetSentAlerts())
+	assert.True(t, second.channelLimiters["pager"].Tokens() >= 1, "local token given back")
+}
+
+func TestDispatcher_SharedState_SuppressionAcrossReplicas(t *testing.T) {
+	shared := newMemorySharedState()
+	ctx := context.Background()
+	alert := testAlert("default", "cron-a", "JobFailed", "critical")
+	cfg := testAlertingConfig("slack-main")
+
+	first := sharedTestDispatcher(shared)
+	first.channels["slack-main"] = newMockChannel("slack-main", "slack")
+	require.NoError(t, first.Dispatch(ctx, alert, cfg))
+
+	// State and claims live in shared state, not in the store
+	assert.Empty(t, first.store.(*mockStore).alertStates)
+	second := sharedTestDispatcher(shared)
+	second.ReloadAlertState(ctx)
+	suppressed, reason := second.IsSuppressed(alert, cfg)
+	assert.True(t, suppressed)
+	assert.Equal(t, "duplicate within suppression window", reason)
+}
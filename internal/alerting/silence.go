@@ -0,0 +1,31 @@
+package alerting
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// monitorMuted returns true and the reason if the alert's monitor must not
+// send alerts, because it is silenced or in observe mode. Lookup errors fail
+// open, preferring an unwanted alert over a lost one.
+func (d *dispatcher) monitorMuted(ctx context.Context, cluster string, ref types.NamespacedName) (bool, string) {
+	c := d.clientFor(cluster)
+	if c == nil || ref.Name == "" {
+		return false, ""
+	}
+	monitor := &v1alpha1.CronJobMonitor{}
+	if err := c.Get(ctx, ref, monitor); err != nil {
+		return false, ""
+	}
+	switch {
+	case monitor.IsObserveOnly():
+		return true, "monitor in observe mode"
+	case monitor.IsSilenced(time.Now()):
+		return true, "monitor silenced"
+	}
+	return false, ""
+}
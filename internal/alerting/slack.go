@@ -9,20 +9,41 @@ import (
 	"text/template"
 	"time"
 
-	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 )
 
+// Slack interactive message action IDs. Button values are JSON-encoded SlackActionValue.
+const (
+	SlackActionAcknowledge   = "guardian_acknowledge"
+	SlackActionRetry         = "guardian_retry"
+	SlackActionSuspend       = "guardian_suspend"
+	SlackActionViewDashboard = "guardian_view_dashboard"
+	SlackActionOpenRunbook   = "guardian_open_runbook"
+)
+
+// slackSectionTextLimit is Slack's maximum length of a section block's text
+const slackSectionTextLimit = 3000
+
+// SlackActionValue identifies the alert and CronJob a Slack button acts on
+type SlackActionValue struct {
+	AlertKey  string `json:"k"`
+	Namespace string `json:"ns"`
+	Name      string `json:"n"`
+}
+
 type slackChannel struct {
+	httpSender
+	dashboardLinks
+
 	name        string
 	client      client.Client
 	secretRef   v1alpha1.NamespacedSecretKeyRef
 	channel     string
 	template    *template.Template
-	rateLimiter *rate.Limiter
+	interactive bool
 }
 
 // NewSlackChannel creates a new Slack channel
@@ -32,10 +53,11 @@ func NewSlackChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error
 	}
 
 	sc := &slackChannel{
-		name:      ac.Name,
-		client:    c,
-		secretRef: ac.Spec.Slack.WebhookSecretRef,
-		channel:   ac.Spec.Slack.DefaultChannel,
+		name:        ac.Name,
+		client:      c,
+		secretRef:   ac.Spec.Slack.WebhookSecretRef,
+		channel:     ac.Spec.Slack.DefaultChannel,
+		interactive: ac.Spec.Slack.Interactive,
 	}
 
 	tmplStr := defaultSlackTemplate
@@ -47,7 +69,6 @@ func NewSlackChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error
 		return nil, fmt.Errorf("invalid template: %w", err)
 	}
 	sc.template = tmpl
-	sc.rateLimiter = NewRateLimiter(ac.Spec.RateLimiting)
 
 	return sc, nil
 }
@@ -64,18 +85,50 @@ func (s *slackChannel) Type() string {
 
 // Send delivers an alert to Slack
 func (s *slackChannel) Send(ctx context.Context, alert Alert) error {
-	if !s.rateLimiter.Allow() {
-		return fmt.Errorf("rate limit exceeded for channel %s", s.name)
+	webhookURL, err := getValueFromSecret(ctx, s.client, s.secretRef)
+	if err != nil {
+		return err
 	}
 
-	webhookURL, err := getValueFromSecret(ctx, s.client, s.secretRef)
+	jsonPayload, err := s.render(alert)
 	if err != nil {
 		return err
 	}
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send slack message: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Preview renders the Slack message of an alert without sending it
+func (s *slackChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	jsonPayload, err := s.render(alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
 
+// render builds the webhook payload of an alert
+func (s *slackChannel) render(alert Alert) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := s.template.Execute(&buf, alert); err != nil {
-		return fmt.Errorf("failed to render template: %w", err)
+		return nil, fmt.Errorf("failed to render template: %w", err)
 	}
 
 	payload := map[string]interface{}{
@@ -84,30 +137,78 @@ func (s *slackChannel) Send(ctx context.Context, alert Alert) error {
 	if s.channel != "" {
 		payload["channel"] = s.channel
 	}
+	if s.interactive {
+		// text stays as the notification fallback
+		payload["blocks"] = s.buildBlocks(buf.String(), alert)
+	}
 
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal Slack payload: %w", err)
+		return nil, fmt.Errorf("failed to marshal Slack payload: %w", err)
 	}
-	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(jsonPayload))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+	return jsonPayload, nil
+}
+
+// buildBlocks renders an alert as Block Kit: the templated text in a section
+// followed by action buttons for the alert's CronJob
+func (s *slackChannel) buildBlocks(text string, alert Alert) []map[string]interface{} {
+	if len(text) > slackSectionTextLimit {
+		text = text[:slackSectionTextLimit-3] + "..."
+	}
+	blocks := []map[string]interface{}{
+		{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": text},
+		},
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := AlertHTTPClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send slack message: %w", err)
+	if alert.CronJob.Name == "" {
+		return blocks
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("slack returned status %d", resp.StatusCode)
+	value, _ := json.Marshal(SlackActionValue{
+		AlertKey:  alert.Key,
+		Namespace: alert.CronJob.Namespace,
+		Name:      alert.CronJob.Name,
+	})
+	button := func(actionID, label string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":      "button",
+			"action_id": actionID,
+			"text":      map[string]interface{}{"type": "plain_text", "text": label},
+			"value":     string(value),
+		}
 	}
 
-	return nil
+	suspend := button(SlackActionSuspend, "Suspend CronJob")
+	suspend["style"] = "danger"
+	suspend["confirm"] = map[string]interface{}{
+		"title":   map[string]interface{}{"type": "plain_text", "text": "Suspend CronJob?"},
+		"text":    map[string]interface{}{"type": "mrkdwn", "text": fmt.Sprintf("No further runs of `%s/%s` will be scheduled until it is resumed.", alert.CronJob.Namespace, alert.CronJob.Name)},
+		"confirm": map[string]interface{}{"type": "plain_text", "text": "Suspend"},
+		"deny":    map[string]interface{}{"type": "plain_text", "text": "Cancel"},
+	}
+
+	elements := []map[string]interface{}{
+		button(SlackActionAcknowledge, "Acknowledge"),
+		button(SlackActionRetry, "Retry now"),
+		suspend,
+	}
+	if link := s.cronJobURL(alert.CronJob); link != "" {
+		view := button(SlackActionViewDashboard, "View in dashboard")
+		view["url"] = link
+		elements = append(elements, view)
+	}
+	if alert.RunbookURL != "" {
+		runbook := button(SlackActionOpenRunbook, "Open runbook")
+		runbook["url"] = alert.RunbookURL
+		elements = append(elements, runbook)
+	}
+
+	return append(blocks, map[string]interface{}{
+		"type":     "actions",
+		"elements": elements,
+	})
 }
 
 // Test sends a test alert
@@ -128,13 +229,21 @@ var defaultSlackTemplate = `:{{ if eq .Severity "critical" }}red_circle{{ else i
 
 *CronJob:* ` + "`{{ .CronJob.Namespace }}/{{ .CronJob.Name }}`" + `
 *Type:* {{ .Type }}
-*Severity:* {{ .Severity }}
+*Severity:* {{ .Severity }}{{ if .Team }}
+*Team:* {{ .Team }}{{ end }}{{ if .Cluster }}
+*Cluster:* {{ .Cluster }}{{ end }}{{ if .Context.JobName }}
+*Job:* ` + "`{{ .Context.JobName }}`" + `{{ end }}
 
 {{ .Message }}
 
 {{ if .Context.ExitCode }}*Exit Code:* {{ .Context.ExitCode }}{{ end }}
 {{ if .Context.Reason }}*Reason:* {{ .Context.Reason }}{{ end }}
+{{ if .Context.Classification }}*Classification:* {{ .Context.Classification }}{{ end }}
+{{ if .Context.NodeName }}*Node:* ` + "`{{ .Context.NodeName }}`" + `{{ end }}
+{{ if .Context.Images }}*Image:* ` + "`{{ join .Context.Images \", \" }}`" + `{{ end }}
 {{ if .Context.SuggestedFix }}:bulb: *Suggested Fix:* {{ .Context.SuggestedFix }}{{ end }}
+{{ if .RunbookURL }}:book: *Runbook:* <{{ .RunbookURL }}>{{ end }}
+{{ if .ID }}*Alert ID:* ` + "`{{ .ID }}`" + `{{ end }}
 {{ if .Context.Logs }}
 *Recent Logs:*
 ` + "```" + `{{ truncate .Context.Logs 1500 }}` + "```" + `
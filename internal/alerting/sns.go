@@ -0,0 +1,202 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// Keys read from the SNS channel's credentials Secret
+const (
+	AWSAccessKeyIDKey     = "access-key-id"
+	AWSSecretAccessKeyKey = "secret-access-key"
+)
+
+type snsChannel struct {
+	httpSender
+
+	name      string
+	client    client.Client
+	secretRef v1alpha1.NamespacedSecretRef
+	topicARN  string
+	region    string
+	endpoint  string // empty = resolved by the SDK from the region
+}
+
+// snsPublish is the Publish request of an alert, shown by Preview
+type snsPublish struct {
+	TopicArn               string            `json:"TopicArn"`
+	Message                string            `json:"Message"`
+	MessageAttributes      map[string]string `json:"MessageAttributes,omitempty"`
+	MessageGroupId         string            `json:"MessageGroupId,omitempty"`
+	MessageDeduplicationId string            `json:"MessageDeduplicationId,omitempty"`
+}
+
+// NewSNSChannel creates a new AWS SNS channel
+func NewSNSChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.SNS == nil {
+		return nil, fmt.Errorf("sns config required for sns channel")
+	}
+
+	// arn:partition:sns:region:account:topic
+	parts := strings.SplitN(ac.Spec.SNS.TopicARN, ":", 6)
+	if len(parts) != 6 || parts[2] != "sns" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid SNS topic ARN %q", ac.Spec.SNS.TopicARN)
+	}
+
+	return &snsChannel{
+		name:      ac.Name,
+		client:    c,
+		secretRef: ac.Spec.SNS.CredentialsSecretRef,
+		topicARN:  ac.Spec.SNS.TopicARN,
+		region:    parts[3],
+		endpoint:  strings.TrimSuffix(ac.Spec.SNS.Endpoint, "/"),
+	}, nil
+}
+
+// Name returns the channel name
+func (s *snsChannel) Name() string {
+	return s.name
+}
+
+// Type returns the channel type
+func (s *snsChannel) Type() string {
+	return "sns"
+}
+
+// Send publishes an alert to the SNS topic
+func (s *snsChannel) Send(ctx context.Context, alert Alert) error {
+	accessKey, secretKey, err := s.getCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	publish, err := s.render(alert)
+	if err != nil {
+		return err
+	}
+
+	opts := sns.Options{
+		Region:      s.region,
+		Credentials: credentials.NewStaticCredentialsProvider(accessKey, secretKey, ""),
+		HTTPClient:  s.sendClient(),
+		// The dispatcher retries failed sends
+		Retryer: aws.NopRetryer{},
+	}
+	if s.endpoint != "" {
+		opts.BaseEndpoint = aws.String(s.endpoint)
+	}
+	if _, err := sns.New(opts).Publish(ctx, publish.input()); err != nil {
+		return fmt.Errorf("failed to publish to SNS: %w", err)
+	}
+
+	return nil
+}
+
+// Preview renders the SNS Publish request of an alert without sending it
+func (s *snsChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	publish, err := s.render(alert)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	jsonPayload, err := json.MarshalIndent(publish, "", "  ")
+	if err != nil {
+		return ChannelPreview{}, fmt.Errorf("failed to marshal SNS request: %w", err)
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
+
+// render builds the Publish request of an alert
+func (s *snsChannel) render(alert Alert) (snsPublish, error) {
+	message, err := json.Marshal(NewAlertPayload(alert))
+	if err != nil {
+		return snsPublish{}, fmt.Errorf("failed to marshal SNS message: %w", err)
+	}
+
+	publish := snsPublish{TopicArn: s.topicARN, Message: string(message), MessageAttributes: map[string]string{}}
+	// Message attributes let subscribers filter with SNS subscription filter policies
+	for k, v := range map[string]string{
+		"type":      alert.Type,
+		"severity":  alert.Severity,
+		"namespace": alert.CronJob.Namespace,
+		"cronjob":   alert.CronJob.Name,
+	} {
+		if v != "" {
+			publish.MessageAttributes[k] = v
+		}
+	}
+	if strings.HasSuffix(s.topicARN, ".fifo") {
+		publish.MessageGroupId = alert.CronJob.Namespace + "/" + alert.CronJob.Name
+		publish.MessageDeduplicationId = AlertEventID(alert)
+	}
+	return publish, nil
+}
+
+// input converts the request to its SDK form
+func (p snsPublish) input() *sns.PublishInput {
+	input := &sns.PublishInput{
+		TopicArn:          aws.String(p.TopicArn),
+		Message:           aws.String(p.Message),
+		MessageAttributes: map[string]snstypes.MessageAttributeValue{},
+	}
+	for k, v := range p.MessageAttributes {
+		input.MessageAttributes[k] = snstypes.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	}
+	if p.MessageGroupId != "" {
+		input.MessageGroupId = aws.String(p.MessageGroupId)
+		input.MessageDeduplicationId = aws.String(p.MessageDeduplicationId)
+	}
+	return input
+}
+
+// Test sends a test alert
+func (s *snsChannel) Test(ctx context.Context) error {
+	return s.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+// getCredentials reads the AWS access key pair from the channel's Secret
+func (s *snsChannel) getCredentials(ctx context.Context) (string, string, error) {
+	secret := &corev1.Secret{}
+	err := s.client.Get(
+		ctx, types.NamespacedName{
+			Namespace: s.secretRef.Namespace,
+			Name:      s.secretRef.Name,
+		}, secret,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get SNS credentials secret: %w", err)
+	}
+
+	accessKey, ok := secret.Data[AWSAccessKeyIDKey]
+	if !ok {
+		return "", "", fmt.Errorf("SNS credentials secret missing '%s' key", AWSAccessKeyIDKey)
+	}
+	secretKey, ok := secret.Data[AWSSecretAccessKeyKey]
+	if !ok {
+		return "", "", fmt.Errorf("SNS credentials secret missing '%s' key", AWSSecretAccessKeyKey)
+	}
+
+	return strings.TrimSpace(string(accessKey)), strings.TrimSpace(string(secretKey)), nil
+}
@@ -1,7 +1,9 @@
 package alerting
 
 import (
+	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -10,6 +12,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 // compiledPattern holds a pattern with pre-compiled regex
@@ -29,13 +32,14 @@ type SuggestedFixEngine struct {
 
 // MatchContext contains the data to match against patterns
 type MatchContext struct {
-	Namespace string
-	Name      string // CronJob name
-	JobName   string // Job name
-	ExitCode  int32
-	Reason    string
-	Logs      string
-	Events    []string
+	Namespace  string
+	Name       string // CronJob name
+	JobName    string // Job name
+	ExitCode   int32
+	Reason     string
+	Containers []store.ContainerResult // For patterns that match a container
+	Logs       string
+	Events     []string
 }
 
 // NewSuggestedFixEngine creates a new engine with built-in patterns
@@ -79,8 +83,19 @@ func (e *SuggestedFixEngine) getCompiledBuiltins() []compiledPattern {
 	return e.compiledBuiltins
 }
 
+// DefaultSuggestion is returned when no pattern matches
+const DefaultSuggestion = "Check job logs and events for details."
+
 // GetBestSuggestion returns the highest priority matching suggestion
 func (e *SuggestedFixEngine) GetBestSuggestion(ctx MatchContext, customPatterns []v1alpha1.SuggestedFixPattern) string {
+	_, suggestion := e.FindMatch(ctx, customPatterns)
+	return suggestion
+}
+
+// FindMatch returns the highest priority matching pattern and its rendered
+// suggestion. If nothing matches, the pattern is nil and the suggestion is
+// DefaultSuggestion.
+func (e *SuggestedFixEngine) FindMatch(ctx MatchContext, customPatterns []v1alpha1.SuggestedFixPattern) (*v1alpha1.SuggestedFixPattern, string) {
 	patterns := e.mergePatterns(customPatterns)
 
 	sort.Slice(patterns, func(i, j int) bool {
@@ -97,11 +112,43 @@ func (e *SuggestedFixEngine) GetBestSuggestion(ctx MatchContext, customPatterns
 
 	for _, pattern := range patterns {
 		if e.matchesCompiled(ctx, pattern) {
-			return e.renderSuggestion(pattern.Original.Suggestion, ctx)
+			return &pattern.Original, e.renderSuggestion(pattern.Original.Suggestion, ctx)
 		}
 	}
 
-	return "Check job logs and events for details."
+	return nil, DefaultSuggestion
+}
+
+// ValidatePattern checks that a pattern has at least one match criterion,
+// that its regexes compile and that its suggestion is a valid template
+func ValidatePattern(p v1alpha1.SuggestedFixPattern) error {
+	m := p.Match
+	if m.ExitCode == nil && m.ExitCodeRange == nil && m.Reason == "" &&
+		m.ReasonPattern == "" && m.LogPattern == "" && m.EventPattern == "" {
+		return fmt.Errorf("at least one match criterion is required")
+	}
+	if m.ExitCodeRange != nil && m.ExitCodeRange.Min > m.ExitCodeRange.Max {
+		return fmt.Errorf("exitCodeRange min %d is greater than max %d", m.ExitCodeRange.Min, m.ExitCodeRange.Max)
+	}
+	for _, re := range []struct{ field, expr string }{
+		{"reasonPattern", m.ReasonPattern},
+		{"logPattern", m.LogPattern},
+		{"eventPattern", m.EventPattern},
+	} {
+		if re.expr == "" {
+			continue
+		}
+		if _, err := regexp.Compile(re.expr); err != nil {
+			return fmt.Errorf("invalid %s: %w", re.field, err)
+		}
+	}
+	if p.Suggestion == "" {
+		return fmt.Errorf("suggestion is required")
+	}
+	if _, err := template.New("suggestion").Parse(p.Suggestion); err != nil {
+		return fmt.Errorf("invalid suggestion template: %w", err)
+	}
+	return nil
 }
 
 // mergePatterns merges custom patterns with builtins, custom overrides by name
@@ -132,8 +179,17 @@ func (e *SuggestedFixEngine) matchesCompiled(ctx MatchContext, cp compiledPatter
 	match := cp.Original.Match
 	matched := false
 
+	exitCode, reason := ctx.ExitCode, ctx.Reason
+	if match.Container != "" {
+		i := slices.IndexFunc(ctx.Containers, func(c store.ContainerResult) bool { return c.Name == match.Container })
+		if i < 0 {
+			return false
+		}
+		exitCode, reason = ctx.Containers[i].ExitCode, ctx.Containers[i].Reason
+	}
+
 	if match.ExitCode != nil {
-		if ctx.ExitCode == *match.ExitCode {
+		if exitCode == *match.ExitCode {
 			matched = true
 		} else {
 			return false
@@ -141,7 +197,7 @@ func (e *SuggestedFixEngine) matchesCompiled(ctx MatchContext, cp compiledPatter
 	}
 
 	if match.ExitCodeRange != nil {
-		if ctx.ExitCode >= match.ExitCodeRange.Min && ctx.ExitCode <= match.ExitCodeRange.Max {
+		if exitCode >= match.ExitCodeRange.Min && exitCode <= match.ExitCodeRange.Max {
 			matched = true
 		} else {
 			return false
@@ -149,7 +205,7 @@ func (e *SuggestedFixEngine) matchesCompiled(ctx MatchContext, cp compiledPatter
 	}
 
 	if match.Reason != "" {
-		if strings.EqualFold(ctx.Reason, match.Reason) {
+		if strings.EqualFold(reason, match.Reason) {
 			matched = true
 		} else {
 			return false
@@ -158,7 +214,7 @@ func (e *SuggestedFixEngine) matchesCompiled(ctx MatchContext, cp compiledPatter
 
 	// Use pre-compiled regex for ReasonPattern
 	if match.ReasonPattern != "" {
-		if cp.ReasonRe != nil && cp.ReasonRe.MatchString(ctx.Reason) {
+		if cp.ReasonRe != nil && cp.ReasonRe.MatchString(reason) {
 			matched = true
 		} else {
 			return false
@@ -228,6 +284,42 @@ func getBuiltinPatterns() []v1alpha1.SuggestedFixPattern {
 			Suggestion: "Container ran out of memory. Increase resources.limits.memory in the CronJob spec.",
 			Priority:   ptr.To(int32(100)),
 		},
+		// Pods stopped from outside are infrastructure failures: they outrank
+		// the exit codes of their killed containers and alert as warnings
+		{
+			Name:       "evicted",
+			Match:      v1alpha1.PatternMatch{Reason: "Evicted"},
+			Suggestion: "Pod was evicted by a node drain or under node resource pressure. If it keeps happening, check node pressure and set a priorityClassName or a PodDisruptionBudget.",
+			Severity:   "warning",
+			Priority:   ptr.To(int32(98)),
+		},
+		{
+			Name:       "preempted",
+			Match:      v1alpha1.PatternMatch{Reason: "Preempted"},
+			Suggestion: "Pod was preempted by the scheduler to make room for a higher-priority pod. Give the job a higher priorityClassName or add cluster capacity.",
+			Severity:   "warning",
+			Priority:   ptr.To(int32(97)),
+		},
+		{
+			Name:       "node-lost",
+			Match:      v1alpha1.PatternMatch{Reason: "NodeLost"},
+			Suggestion: "Pod was lost with its node, which became unreachable or was removed. Check the node's health; a backoffLimit above 0 lets the job retry on another node.",
+			Severity:   "warning",
+			Priority:   ptr.To(int32(96)),
+		},
+		// Jobs that never ran a pod failed before any of their code ran
+		{
+			Name:       "unschedulable",
+			Match:      v1alpha1.PatternMatch{Reason: "Unschedulable"},
+			Suggestion: "Job never started: its pods could not be scheduled. Check the scheduler's message with kubectl get events -n {{.Namespace}} --field-selector reason=FailedScheduling, then node resources, taints/tolerations, and affinity rules.",
+			Priority:   ptr.To(int32(95)),
+		},
+		{
+			Name:       "quota-exceeded",
+			Match:      v1alpha1.PatternMatch{Reason: "QuotaExceeded"},
+			Suggestion: "Job never started: its pods would exceed a ResourceQuota of the namespace. Check kubectl describe resourcequota -n {{.Namespace}}, then lower the job's resource requests or raise the quota.",
+			Priority:   ptr.To(int32(94)),
+		},
 		{
 			Name:       "oom-signal",
 			Match:      v1alpha1.PatternMatch{ExitCode: ptr.To(int32(137))},
@@ -270,12 +362,6 @@ func getBuiltinPatterns() []v1alpha1.SuggestedFixPattern {
 			Suggestion: "Job failed too many times (backoffLimit reached). Check logs from failed attempts for root cause.",
 			Priority:   ptr.To(int32(65)),
 		},
-		{
-			Name:       "evicted",
-			Match:      v1alpha1.PatternMatch{Reason: "Evicted"},
-			Suggestion: "Pod was evicted from node. Check node resource pressure and consider setting pod priority.",
-			Priority:   ptr.To(int32(60)),
-		},
 		{
 			Name:       "scheduling-failed",
 			Match:      v1alpha1.PatternMatch{EventPattern: "FailedScheduling"},
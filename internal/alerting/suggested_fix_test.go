@@ -4,9 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/utils/ptr"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 func TestSuggestedFix_OOMKilled(t *testing.T) {
@@ -139,6 +141,35 @@ func TestSuggestedFix_Evicted(t *testing.T) {
 	assert.Contains(t, suggestion, "evicted")
 }
 
+func TestSuggestedFix_PodStoppedFromOutside(t *testing.T) {
+	engine := NewSuggestedFixEngine()
+
+	tests := []struct {
+		reason  string
+		pattern string
+		want    string
+	}{
+		{reason: "Evicted", pattern: "evicted", want: "node drain"},
+		{reason: "Preempted", pattern: "preempted", want: "higher-priority pod"},
+		{reason: "NodeLost", pattern: "node-lost", want: "lost with its node"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			// The killed container's exit code does not win over the pod's fate
+			pattern, suggestion := engine.FindMatch(MatchContext{ExitCode: 143, Reason: tt.reason}, nil)
+			require.NotNil(t, pattern)
+			assert.Equal(t, tt.pattern, pattern.Name)
+			assert.Equal(t, "warning", pattern.Severity)
+			assert.Contains(t, suggestion, tt.want)
+		})
+	}
+
+	pattern, _ := engine.FindMatch(MatchContext{ExitCode: 137, Reason: "OOMKilled"}, nil)
+	require.NotNil(t, pattern)
+	assert.Empty(t, pattern.Severity)
+}
+
 func TestSuggestedFix_CustomPattern(t *testing.T) {
 	engine := NewSuggestedFixEngine()
 
@@ -165,6 +196,43 @@ func TestSuggestedFix_CustomPattern(t *testing.T) {
 	assert.Equal(t, "Check database connection settings and credentials.", suggestion)
 }
 
+func TestSuggestedFix_ContainerPattern(t *testing.T) {
+	engine := NewSuggestedFixEngine()
+
+	priority := int32(200)
+	customPatterns := []v1alpha1.SuggestedFixPattern{
+		{
+			Name: "migrations-failed",
+			Match: v1alpha1.PatternMatch{
+				Container: "migrate",
+				ExitCode:  ptr.To(int32(3)),
+			},
+			Suggestion: "Database migrations failed, check the migrate init container.",
+			Priority:   &priority,
+		},
+	}
+
+	ctx := MatchContext{
+		Namespace: "default",
+		Name:      "test-cron",
+		JobName:   "test-cron-12345",
+		ExitCode:  1,
+		Containers: []store.ContainerResult{
+			{Name: "migrate", Init: true, ExitCode: 3, Reason: "Error"},
+			{Name: "main", ExitCode: 1, Reason: "Error"},
+		},
+	}
+
+	suggestion := engine.GetBestSuggestion(ctx, customPatterns)
+	assert.Equal(t, "Database migrations failed, check the migrate init container.", suggestion)
+
+	// The execution's exit code alone does not match
+	ctx.Containers = nil
+	ctx.ExitCode = 3
+	suggestion = engine.GetBestSuggestion(ctx, customPatterns)
+	assert.NotEqual(t, "Database migrations failed, check the migrate init container.", suggestion)
+}
+
 func TestSuggestedFix_LogPattern(t *testing.T) {
 	engine := NewSuggestedFixEngine()
 
@@ -513,3 +581,91 @@ func TestSuggestedFix_MultipleEventsOneMatches(t *testing.T) {
 	suggestion := engine.GetBestSuggestion(ctx, customPatterns)
 	assert.Equal(t, "Resource quota exceeded", suggestion)
 }
+
+func TestSuggestedFix_FindMatchReturnsPattern(t *testing.T) {
+	engine := NewSuggestedFixEngine()
+
+	priority := int32(200)
+	customPatterns := []v1alpha1.SuggestedFixPattern{
+		{
+			Name:       "db-down",
+			Match:      v1alpha1.PatternMatch{LogPattern: "connection refused"},
+			Suggestion: "Database is down",
+			Priority:   &priority,
+			Severity:   "warning",
+		},
+	}
+
+	pattern, suggestion := engine.FindMatch(MatchContext{Logs: "dial tcp: connection refused"}, customPatterns)
+	assert.NotNil(t, pattern)
+	assert.Equal(t, "db-down", pattern.Name)
+	assert.Equal(t, "warning", pattern.Severity)
+	assert.Equal(t, "Database is down", suggestion)
+
+	pattern, suggestion = engine.FindMatch(MatchContext{Logs: "all good"}, customPatterns)
+	assert.Nil(t, pattern)
+	assert.Equal(t, DefaultSuggestion, suggestion)
+}
+
+func TestValidatePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern v1alpha1.SuggestedFixPattern
+		wantErr string
+	}{
+		{
+			name: "valid",
+			pattern: v1alpha1.SuggestedFixPattern{
+				Match:      v1alpha1.PatternMatch{ExitCode: ptr.To(int32(1)), LogPattern: "timeout"},
+				Suggestion: "Check {{.Name}}",
+			},
+		},
+		{
+			name:    "no match criteria",
+			pattern: v1alpha1.SuggestedFixPattern{Suggestion: "Anything"},
+			wantErr: "at least one match criterion",
+		},
+		{
+			name: "inverted exit code range",
+			pattern: v1alpha1.SuggestedFixPattern{
+				Match:      v1alpha1.PatternMatch{ExitCodeRange: &v1alpha1.ExitCodeRange{Min: 10, Max: 1}},
+				Suggestion: "Anything",
+			},
+			wantErr: "exitCodeRange",
+		},
+		{
+			name: "invalid regex",
+			pattern: v1alpha1.SuggestedFixPattern{
+				Match:      v1alpha1.PatternMatch{LogPattern: "[invalid(regex"},
+				Suggestion: "Anything",
+			},
+			wantErr: "invalid logPattern",
+		},
+		{
+			name: "empty suggestion",
+			pattern: v1alpha1.SuggestedFixPattern{
+				Match: v1alpha1.PatternMatch{Reason: "OOMKilled"},
+			},
+			wantErr: "suggestion is required",
+		},
+		{
+			name: "invalid template",
+			pattern: v1alpha1.SuggestedFixPattern{
+				Match:      v1alpha1.PatternMatch{Reason: "OOMKilled"},
+				Suggestion: "{{.Name",
+			},
+			wantErr: "invalid suggestion template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePattern(tt.pattern)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
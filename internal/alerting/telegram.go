@@ -0,0 +1,234 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// Keys read from the Telegram channel's Secret
+const (
+	TelegramBotTokenKey = "bot-token"
+	TelegramChatIDKey   = "chat-id"
+)
+
+// telegramAPIURL is the Bot API base URL (overridden in tests)
+var telegramAPIURL = "https://api.telegram.org"
+
+// telegramMarkdownV2Replacer escapes the characters MarkdownV2 reserves outside code entities
+var telegramMarkdownV2Replacer = strings.NewReplacer(
+	`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`,
+	"~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`,
+	"|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+)
+
+// telegramCodeReplacer escapes the characters MarkdownV2 reserves inside code entities
+var telegramCodeReplacer = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+
+type telegramChannel struct {
+	httpSender
+
+	name       string
+	client     client.Client
+	secretRef  v1alpha1.NamespacedSecretRef
+	silentInfo bool
+	template   *template.Template
+}
+
+// NewTelegramChannel creates a new Telegram channel
+func NewTelegramChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, error) {
+	if ac.Spec.Telegram == nil {
+		return nil, fmt.Errorf("telegram config required for telegram channel")
+	}
+
+	tc := &telegramChannel{
+		name:       ac.Name,
+		client:     c,
+		secretRef:  ac.Spec.Telegram.SecretRef,
+		silentInfo: ac.Spec.Telegram.SilentInfo,
+	}
+
+	tmplStr := defaultTelegramTemplate
+	if ac.Spec.Telegram.MessageTemplate != "" {
+		tmplStr = ac.Spec.Telegram.MessageTemplate
+	}
+	tmpl, err := ParseTelegramTemplate(tmplStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	tc.template = tmpl
+
+	return tc, nil
+}
+
+// ParseTelegramTemplate parses a Telegram message template with the
+// MarkdownV2 escape and escapeCode functions available
+func ParseTelegramTemplate(s string) (*template.Template, error) {
+	return template.New("telegram").Funcs(templateFuncs).Funcs(template.FuncMap{
+		"escape":     escapeMarkdownV2,
+		"escapeCode": escapeMarkdownV2Code,
+	}).Parse(s)
+}
+
+// escapeMarkdownV2 escapes text for use in a MarkdownV2 message
+func escapeMarkdownV2(s string) string {
+	return telegramMarkdownV2Replacer.Replace(s)
+}
+
+// escapeMarkdownV2Code escapes text for use inside a MarkdownV2 code span or block
+func escapeMarkdownV2Code(s string) string {
+	return telegramCodeReplacer.Replace(s)
+}
+
+// Name returns the channel name
+func (t *telegramChannel) Name() string {
+	return t.name
+}
+
+// Type returns the channel type
+func (t *telegramChannel) Type() string {
+	return "telegram"
+}
+
+// Send delivers an alert to Telegram
+func (t *telegramChannel) Send(ctx context.Context, alert Alert) error {
+	token, chatID, err := t.getCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	jsonPayload, err := t.render(alert, chatID)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/sendMessage", telegramAPIURL, token)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonPayload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.do(req)
+	if err != nil {
+		// The URL contains the bot token, so don't wrap the error
+		return fmt.Errorf("failed to send telegram message")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if resp.StatusCode != http.StatusOK || !result.OK {
+		if result.Description != "" {
+			return fmt.Errorf("telegram returned status %d: %s", resp.StatusCode, result.Description)
+		}
+		return fmt.Errorf("telegram returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Preview renders the Telegram message of an alert without sending it
+func (t *telegramChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	jsonPayload, err := t.render(alert, redacted)
+	if err != nil {
+		return ChannelPreview{}, err
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: string(jsonPayload)}, nil
+}
+
+// render builds the sendMessage request of an alert
+func (t *telegramChannel) render(alert Alert, chatID string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.template.Execute(&buf, alert); err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+
+	payload := map[string]interface{}{
+		"chat_id":                  chatID,
+		"text":                     buf.String(),
+		"parse_mode":               "MarkdownV2",
+		"disable_web_page_preview": true,
+		"disable_notification":     t.silentInfo && alert.Severity == "info",
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Telegram payload: %w", err)
+	}
+	return jsonPayload, nil
+}
+
+// Test sends a test alert
+func (t *telegramChannel) Test(ctx context.Context) error {
+	return t.Send(
+		ctx, Alert{
+			Key:       "test-alert",
+			Type:      "Test",
+			Severity:  "info",
+			Title:     "CronJob Guardian Test Alert",
+			Message:   "This is a test alert from CronJob Guardian.",
+			CronJob:   types.NamespacedName{Namespace: "test", Name: "test"},
+			Timestamp: time.Now(),
+		},
+	)
+}
+
+// getCredentials reads the bot token and chat ID from the channel's Secret
+func (t *telegramChannel) getCredentials(ctx context.Context) (string, string, error) {
+	secret := &corev1.Secret{}
+	err := t.client.Get(
+		ctx, types.NamespacedName{
+			Namespace: t.secretRef.Namespace,
+			Name:      t.secretRef.Name,
+		}, secret,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get telegram secret: %w", err)
+	}
+
+	token, ok := secret.Data[TelegramBotTokenKey]
+	if !ok {
+		return "", "", fmt.Errorf("telegram secret missing '%s' key", TelegramBotTokenKey)
+	}
+	chatID, ok := secret.Data[TelegramChatIDKey]
+	if !ok {
+		return "", "", fmt.Errorf("telegram secret missing '%s' key", TelegramChatIDKey)
+	}
+
+	return strings.TrimSpace(string(token)), strings.TrimSpace(string(chatID)), nil
+}
+
+var defaultTelegramTemplate = `{{ if eq .Severity "critical" }}🔴{{ else if eq .Severity "warning" }}🟠{{ else }}🔵{{ end }} *{{ escape .Title }}*
+
+*CronJob:* ` + "`{{ escapeCode .CronJob.Namespace }}/{{ escapeCode .CronJob.Name }}`" + `
+*Type:* {{ escape .Type }}
+*Severity:* {{ escape .Severity }}
+
+{{ escape .Message }}
+{{ if .Context.ExitCode }}
+*Exit Code:* {{ escape (printf "%d" .Context.ExitCode) }}{{ end }}{{ if .Context.Reason }}
+*Reason:* {{ escape .Context.Reason }}{{ end }}{{ if .Context.Classification }}
+*Classification:* {{ escape .Context.Classification }}{{ end }}{{ if .Context.NodeName }}
+*Node:* ` + "`{{ escapeCode .Context.NodeName }}`" + `{{ end }}{{ if .Context.SuggestedFix }}
+
+💡 *Suggested Fix:* {{ escape .Context.SuggestedFix }}{{ end }}{{ if .Context.Logs }}
+
+*Recent Logs:*
+` + "```\n{{ escapeCode (truncate .Context.Logs 1500) }}\n```" + `{{ end }}
+`
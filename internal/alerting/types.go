@@ -7,11 +7,13 @@ import (
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
 // Alert represents an alert to be dispatched
 type Alert struct {
+	ID         string // Tracking ID (UUID) carried into payloads, history and logs
 	Key        string // Deduplication key
 	Type       string // JobFailed, MissedSchedule, DeadManTriggered, etc.
 	Severity   string // critical, warning, info
@@ -20,19 +22,63 @@ type Alert struct {
 	CronJob    types.NamespacedName
 	MonitorRef types.NamespacedName
 	Context    AlertContext
+	RunbookURL string // Remediation docs, see ResolveRunbookURL
+	Team       string // Owning team, see config.OwnershipConfig
+	Cluster    string // Remote cluster the CronJob runs in, empty for the local cluster
 	Timestamp  time.Time
 }
 
 // AlertContext contains additional context for alerts
 type AlertContext struct {
-	Logs         string
-	Events       []string
-	PodStatus    string
-	SuggestedFix string
-	SuccessRate  float64
-	LastDuration time.Duration
-	ExitCode     int32
-	Reason       string
+	JobName          string // Job the alert was raised for, if any
+	Logs             string
+	Events           []string
+	PodStatus        string
+	SuggestedFix     string
+	SuccessRate      float64
+	LastDuration     time.Duration
+	ExitCode         int32
+	Reason           string
+	Classification   string
+	NodeName         string
+	Images           []string
+	PodNames         []string
+	UpstreamFailures []string
+	// SuccessStreakRuns and SuccessStreakSince describe the unusually long
+	// success streak a failure ended, if any
+	SuccessStreakRuns  int
+	SuccessStreakSince time.Time
+	// Labels and Annotations are the CronJob metadata the monitor propagates
+	// (includeContext.labels and includeContext.annotations)
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// PropagateMetadata copies the CronJob labels and annotations listed in the
+// monitor's includeContext into the alert context. Keys the CronJob does not
+// have are left out.
+func (c *AlertContext) PropagateMetadata(include *v1alpha1.AlertContext, cronJob metav1.Object) {
+	if include == nil || cronJob == nil {
+		return
+	}
+	c.Labels = pickKeys(cronJob.GetLabels(), include.Labels)
+	c.Annotations = pickKeys(cronJob.GetAnnotations(), include.Annotations)
+}
+
+// pickKeys returns the entries of m with the given keys, nil if there are none
+func pickKeys(m map[string]string, keys []string) map[string]string {
+	var picked map[string]string
+	for _, k := range keys {
+		v, ok := m[k]
+		if !ok {
+			continue
+		}
+		if picked == nil {
+			picked = make(map[string]string, len(keys))
+		}
+		picked[k] = v
+	}
+	return picked
 }
 
 // Channel represents an alert delivery channel
@@ -50,6 +96,13 @@ type Channel interface {
 	Test(ctx context.Context) error
 }
 
+// Resolver is implemented by channels that open something an alert can be
+// resolved in, such as a PagerDuty incident. Resolve is called with the
+// alert's key when a sent alert is cleared.
+type Resolver interface {
+	Resolve(ctx context.Context, alert Alert) error
+}
+
 // ChannelStats tracks success/failure statistics for a channel
 type ChannelStats struct {
 	AlertsSentTotal     int64
@@ -74,30 +127,55 @@ type Dispatcher interface {
 	// SendToChannel sends to a specific channel (for testing)
 	SendToChannel(ctx context.Context, channelName string, alert Alert) error
 
+	// Preview renders an alert for each channel it would be routed to,
+	// without sending it
+	Preview(ctx context.Context, alert Alert, alertCfg *v1alpha1.AlertingConfig) []ChannelPreview
+
 	// IsSuppressed checks if an alert should be suppressed
 	IsSuppressed(alert Alert, alertCfg *v1alpha1.AlertingConfig) (bool, string)
 
 	// ClearAlert clears an active alert (e.g., when resolved)
 	ClearAlert(ctx context.Context, alertKey string) error
 
-	// ClearAlertsForMonitor clears all alerts for a monitor
+	// ClearAlertsForMonitor clears all alerts for a monitor and drops its rate limiter
 	ClearAlertsForMonitor(namespace, name string)
 
+	// Acknowledge silences an active alert until it is cleared
+	Acknowledge(alertKey, by string) bool
+
+	// ActiveAlertID returns the tracking ID of an active alert, empty if the
+	// alert is not active
+	ActiveAlertID(alertKey string) string
+
+	// ReloadAlertState replaces the duplicate-suppression state with the one
+	// persisted in the store, e.g. after winning leader election
+	ReloadAlertState(ctx context.Context)
+
+	// SetElected sets the leader election channel; only the leader sends alerts
+	SetElected(elected <-chan struct{})
+
 	// CancelPendingAlert cancels a pending (delayed) alert before it's sent.
 	CancelPendingAlert(alertKey string) bool
 
 	// CancelPendingAlertsForCronJob cancels all pending alerts for a specific CronJob.
 	CancelPendingAlertsForCronJob(namespace, name string) int
 
-	// SetGlobalRateLimits updates global rate limits
+	// SetGlobalRateLimits updates global rate limits and the default
+	// duplicate suppression window
 	SetGlobalRateLimits(limits config.RateLimitsConfig)
 
+	// SetStartupGracePeriod changes how long after startup alerts are held back
+	SetStartupGracePeriod(period time.Duration)
+
 	// GetAlertCount24h returns alerts sent in last 24h
 	GetAlertCount24h() int32
 
 	// GetChannelStats returns statistics for a specific channel
 	GetChannelStats(channelName string) *ChannelStats
 
+	// Diagnostics returns a snapshot of queue, suppression and rate limiter state
+	Diagnostics() DispatcherDiagnostics
+
 	// Stop gracefully shuts down the dispatcher, stopping background goroutines
 	Stop() error
 }
@@ -8,7 +8,6 @@ import (
 	"text/template"
 	"time"
 
-	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -16,13 +15,14 @@ import (
 )
 
 type webhookChannel struct {
-	name        string
-	client      client.Client
-	secretRef   v1alpha1.NamespacedSecretKeyRef
-	method      string
-	headers     map[string]string
-	template    *template.Template
-	rateLimiter *rate.Limiter
+	httpSender
+
+	name      string
+	client    client.Client
+	secretRef v1alpha1.NamespacedSecretKeyRef
+	method    string
+	headers   map[string]string
+	template  *template.Template
 }
 
 // NewWebhookChannel creates a new webhook channel
@@ -52,7 +52,6 @@ func NewWebhookChannel(c client.Client, ac *v1alpha1.AlertChannel) (Channel, err
 		return nil, fmt.Errorf("invalid template: %w", err)
 	}
 	wc.template = tmpl
-	wc.rateLimiter = NewRateLimiter(ac.Spec.RateLimiting)
 
 	return wc, nil
 }
@@ -69,10 +68,6 @@ func (w *webhookChannel) Type() string {
 
 // Send delivers an alert via webhook
 func (w *webhookChannel) Send(ctx context.Context, alert Alert) error {
-	if !w.rateLimiter.Allow() {
-		return fmt.Errorf("rate limit exceeded for channel %s", w.name)
-	}
-
 	url, err := getValueFromSecret(ctx, w.client, w.secretRef)
 	if err != nil {
 		return err
@@ -93,7 +88,7 @@ func (w *webhookChannel) Send(ctx context.Context, alert Alert) error {
 		req.Header.Set(k, v)
 	}
 
-	resp, err := AlertHTTPClient.Do(req)
+	resp, err := w.do(req)
 	if err != nil {
 		return fmt.Errorf("failed to send webhook: %w", err)
 	}
@@ -108,6 +103,15 @@ func (w *webhookChannel) Send(ctx context.Context, alert Alert) error {
 	return nil
 }
 
+// Preview renders the webhook body of an alert without sending it
+func (w *webhookChannel) Preview(_ context.Context, alert Alert) (ChannelPreview, error) {
+	var buf bytes.Buffer
+	if err := w.template.Execute(&buf, alert); err != nil {
+		return ChannelPreview{}, fmt.Errorf("failed to render template: %w", err)
+	}
+	return ChannelPreview{ContentType: "application/json", Payload: buf.String()}, nil
+}
+
 // Test sends a test alert
 func (w *webhookChannel) Test(ctx context.Context) error {
 	return w.Send(
@@ -124,6 +128,7 @@ func (w *webhookChannel) Test(ctx context.Context) error {
 }
 
 var defaultWebhookTemplate = `{
+  "id": "{{ .ID }}",
   "key": "{{ .Key }}",
   "type": "{{ .Type }}",
   "severity": "{{ .Severity }}",
@@ -135,10 +140,19 @@ var defaultWebhookTemplate = `{
   },
   "timestamp": "{{ formatTime .Timestamp "RFC3339" }}",
   "context": {
+    "job_name": "{{ .Context.JobName }}",
     "suggested_fix": "{{ .Context.SuggestedFix }}",
     "success_rate": {{ .Context.SuccessRate }},
     "exit_code": {{ .Context.ExitCode }},
     "reason": "{{ .Context.Reason }}",
-    "logs": {{ jsonEscape .Context.Logs }}
-  }
+    "classification": "{{ .Context.Classification }}",
+    "node": "{{ .Context.NodeName }}",
+    "images": {{ jsonEscape (join .Context.Images ",") }},
+    "logs": {{ jsonEscape .Context.Logs }},
+    "labels": {{ toJson .Context.Labels }},
+    "annotations": {{ toJson .Context.Annotations }}
+  },
+  "runbook_url": {{ jsonEscape .RunbookURL }},
+  "team": {{ jsonEscape .Team }},
+  "cluster": {{ jsonEscape .Cluster }}
 }`
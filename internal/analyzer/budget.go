@@ -0,0 +1,95 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+const (
+	// DefaultScheduleBudgetPercent is the share of the schedule interval the
+	// P95 duration may take before a DurationBudget alert is raised
+	DefaultScheduleBudgetPercent = 90
+
+	// scheduleBudgetRuns is how many upcoming runs the shortest interval
+	// between scheduled runs is taken from
+	scheduleBudgetRuns = 50
+)
+
+// BudgetResult contains schedule budget check results
+type BudgetResult struct {
+	Exceeded bool
+	P95      time.Duration
+	// Interval is the shortest time between two scheduled runs
+	Interval   time.Duration
+	Percentage float64 // P95 as a percentage of Interval
+	Threshold  float64
+	Message    string
+}
+
+// CheckScheduleBudget checks whether the CronJob's P95 duration takes up
+// most of the time between its scheduled runs, a leading indicator of
+// overlapping runs and missed schedules
+func (a *analyzer) CheckScheduleBudget(ctx context.Context, cronJob *batchv1.CronJob, config *v1alpha1.SLAConfig) (*BudgetResult, error) {
+	if config == nil {
+		return &BudgetResult{}, nil
+	}
+	threshold := getOrDefaultInt32(config.ScheduleBudgetPercent, DefaultScheduleBudgetPercent)
+	if threshold == 0 {
+		return &BudgetResult{}, nil
+	}
+
+	interval, err := shortestScheduleInterval(ScheduledCronJob{
+		Schedule: cronJob.Spec.Schedule,
+		TimeZone: ptr.Deref(cronJob.Spec.TimeZone, ""),
+	}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	nn := types.NamespacedName{Namespace: cronJob.Namespace, Name: cronJob.Name}
+	windowDays := int(getOrDefaultInt32(config.WindowDays, 7))
+	p95, err := a.store.GetDurationPercentile(ctx, nn, 95, windowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BudgetResult{
+		P95:       p95,
+		Interval:  interval,
+		Threshold: float64(threshold),
+	}
+	if p95 == 0 || interval == 0 {
+		return result, nil
+	}
+
+	result.Percentage = float64(p95) / float64(interval) * 100
+	if result.Percentage >= result.Threshold {
+		result.Exceeded = true
+		result.Message = fmt.Sprintf("P95 duration %s is %.0f%% of the %s between scheduled runs; runs risk overlapping or missing their schedule",
+			p95.Round(time.Second), result.Percentage, interval)
+	}
+	return result, nil
+}
+
+// shortestScheduleInterval returns the shortest time between two of the
+// CronJob's upcoming scheduled runs, 0 if it has fewer than two
+func shortestScheduleInterval(job ScheduledCronJob, from time.Time) (time.Duration, error) {
+	runs, err := NextRuns(job, from, scheduleBudgetRuns)
+	if err != nil {
+		return 0, err
+	}
+	var shortest time.Duration
+	for i := 1; i < len(runs); i++ {
+		if gap := runs[i].Sub(runs[i-1]); shortest == 0 || gap < shortest {
+			shortest = gap
+		}
+	}
+	return shortest, nil
+}
@@ -0,0 +1,84 @@
+package analyzer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func budgetCronJob(schedule string) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hourly"},
+		Spec:       batchv1.CronJobSpec{Schedule: schedule},
+	}
+}
+
+func TestShortestScheduleInterval(t *testing.T) {
+	from := time.Date(2026, 3, 13, 8, 30, 0, 0, time.UTC) // Friday
+
+	tests := []struct {
+		schedule string
+		want     time.Duration
+	}{
+		{"0 * * * *", time.Hour},
+		{"@daily", 24 * time.Hour},
+		{"0 9 * * 1-5", 24 * time.Hour},       // Friday to Monday is not the shortest gap
+		{"0 6,7 * * *", time.Hour},            // Uneven gaps use the shortest
+		{"*/15 9-10 * * *", 15 * time.Minute}, // Within the active hours
+		{"CRON_TZ=Europe/Berlin 0 * * * *", time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			interval, err := shortestScheduleInterval(ScheduledCronJob{Schedule: tt.schedule}, from)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, interval)
+		})
+	}
+
+	_, err := shortestScheduleInterval(ScheduledCronJob{Schedule: "not a schedule"}, from)
+	assert.Error(t, err)
+}
+
+func TestCheckScheduleBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		p95      time.Duration
+		percent  *int32
+		exceeded bool
+	}{
+		{name: "within budget", p95: 30 * time.Minute},
+		{name: "exceeded", p95: 55 * time.Minute, exceeded: true},
+		{name: "custom threshold", p95: 40 * time.Minute, percent: ptr.To[int32](60), exceeded: true},
+		{name: "disabled", p95: 59 * time.Minute, percent: ptr.To[int32](0)},
+		{name: "no history", p95: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewSLAAnalyzer(&mockStore{DurationPercentileMap: map[int]time.Duration{95: tt.p95}})
+
+			result, err := a.CheckScheduleBudget(context.Background(), budgetCronJob("0 * * * *"),
+				&v1alpha1.SLAConfig{ScheduleBudgetPercent: tt.percent})
+			require.NoError(t, err)
+			assert.Equal(t, tt.exceeded, result.Exceeded)
+			if tt.exceeded {
+				assert.Equal(t, time.Hour, result.Interval)
+				assert.Contains(t, result.Message, "of the 1h0m0s between scheduled runs")
+			}
+		})
+	}
+}
+
+func TestCheckScheduleBudget_InvalidSchedule(t *testing.T) {
+	a := NewSLAAnalyzer(&mockStore{DurationPercentile: time.Hour})
+
+	_, err := a.CheckScheduleBudget(context.Background(), budgetCronJob("61 * * * *"), &v1alpha1.SLAConfig{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package analyzer
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+const (
+	// scheduledTimestampAnnotation is set by the CronJob controller on the
+	// Jobs it creates (Kubernetes 1.28+)
+	scheduledTimestampAnnotation = "batch.kubernetes.io/cronjob-scheduled-timestamp"
+
+	// CatchUpLag is how long after its scheduled time a Job may be created
+	// before it counts as a catch-up run. The CronJob controller creates Jobs
+	// within seconds; later ones make up for a schedule missed while the
+	// CronJob was suspended or the controller was down.
+	CatchUpLag = 2 * time.Minute
+)
+
+// JobScheduledTime returns the time the CronJob controller scheduled a Job
+// for, from its scheduled-timestamp annotation or, on older clusters, its
+// name, which ends in the scheduled time in minutes
+func JobScheduledTime(job *batchv1.Job, cronJobName string) (time.Time, bool) {
+	if value, ok := job.Annotations[scheduledTimestampAnnotation]; ok {
+		if scheduled, err := time.Parse(time.RFC3339, value); err == nil {
+			return scheduled, true
+		}
+	}
+
+	suffix, ok := strings.CutPrefix(job.Name, cronJobName+"-")
+	if !ok {
+		return time.Time{}, false
+	}
+	minutes, err := strconv.ParseInt(suffix, 10, 64)
+	if err != nil || minutes <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(minutes*60, 0).UTC(), true
+}
+
+// IsCatchUpRun reports whether a Job was created for a schedule that had
+// already passed, like the runs fired when a suspended CronJob is resumed
+func IsCatchUpRun(job *batchv1.Job, cronJobName string) bool {
+	scheduled, ok := JobScheduledTime(job, cronJobName)
+	if !ok || job.CreationTimestamp.IsZero() {
+		return false
+	}
+	return job.CreationTimestamp.Sub(scheduled) > CatchUpLag
+}
@@ -0,0 +1,47 @@
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func scheduledJob(name string, created time.Time, annotations map[string]string) *batchv1.Job {
+	return &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		Annotations:       annotations,
+		CreationTimestamp: metav1.NewTime(created),
+	}}
+}
+
+func TestJobScheduledTime(t *testing.T) {
+	want := time.Date(2026, 3, 13, 8, 0, 0, 0, time.UTC)
+	minutes := "backup-29556480" // 2026-03-13T08:00:00Z
+
+	scheduled, ok := JobScheduledTime(scheduledJob(minutes, want, nil), "backup")
+	assert.True(t, ok)
+	assert.Equal(t, want, scheduled)
+
+	annotated := scheduledJob("backup-manual", want, map[string]string{
+		scheduledTimestampAnnotation: "2026-03-13T08:00:00Z",
+	})
+	scheduled, ok = JobScheduledTime(annotated, "backup")
+	assert.True(t, ok)
+	assert.True(t, want.Equal(scheduled))
+
+	_, ok = JobScheduledTime(scheduledJob("backup-manual", want, nil), "backup")
+	assert.False(t, ok)
+	_, ok = JobScheduledTime(scheduledJob("other-29556480", want, nil), "backup")
+	assert.False(t, ok)
+}
+
+func TestIsCatchUpRun(t *testing.T) {
+	scheduled := time.Date(2026, 3, 13, 8, 0, 0, 0, time.UTC)
+
+	assert.False(t, IsCatchUpRun(scheduledJob("backup-29556480", scheduled.Add(5*time.Second), nil), "backup"))
+	assert.True(t, IsCatchUpRun(scheduledJob("backup-29556480", scheduled.Add(3*time.Hour), nil), "backup"))
+	assert.False(t, IsCatchUpRun(scheduledJob("backup-manual", scheduled.Add(3*time.Hour), nil), "backup"))
+}
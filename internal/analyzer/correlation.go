@@ -0,0 +1,260 @@
+package analyzer
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Correlation dimensions. A group is a burst of failures from several
+// CronJobs that share the dimension's value.
+const (
+	// CorrelationByTime groups failures from any CronJob that happen close together
+	CorrelationByTime = "time"
+	// CorrelationByNamespace groups failures in the same namespace
+	CorrelationByNamespace = "namespace"
+	// CorrelationByReason groups failures with the same failure reason
+	CorrelationByReason = "reason"
+	// CorrelationByNode groups failures on the same node
+	CorrelationByNode = "node"
+	// CorrelationByImage groups failures running the same container image
+	CorrelationByImage = "image"
+)
+
+const (
+	// DefaultCorrelationLookback is how far back failures are considered
+	DefaultCorrelationLookback = time.Hour
+	// DefaultCorrelationWindow is the largest gap between failures in one burst
+	DefaultCorrelationWindow = 10 * time.Minute
+	// DefaultCorrelationMinCronJobs is the number of distinct CronJobs a group needs
+	DefaultCorrelationMinCronJobs = 3
+
+	// maxCorrelatedFailures bounds the number of failures loaded for one report
+	maxCorrelatedFailures = 10000
+)
+
+// CorrelationOptions configures a correlation report. Zero values use the defaults.
+type CorrelationOptions struct {
+	Lookback    time.Duration
+	Window      time.Duration
+	MinCronJobs int
+}
+
+// CorrelationGroup is a set of CronJobs that failed together and share a likely cause
+type CorrelationGroup struct {
+	Dimension    string
+	Value        string
+	CronJobs     []types.NamespacedName
+	Failures     int
+	FirstFailure time.Time
+	LastFailure  time.Time
+	LikelyCause  string
+}
+
+// CorrelationReport lists correlated failure groups, largest first
+type CorrelationReport struct {
+	GeneratedAt   time.Time
+	Lookback      time.Duration
+	Window        time.Duration
+	TotalFailures int
+	Groups        []CorrelationGroup
+}
+
+// correlationDimension extracts the values failures are grouped by. A failure
+// with no value is left out of the dimension; one with several (images) is
+// counted under each.
+type correlationDimension struct {
+	name   string
+	values func(e *store.Execution) []string
+}
+
+var correlationDimensions = []correlationDimension{
+	{CorrelationByTime, func(*store.Execution) []string { return []string{""} }},
+	{CorrelationByNamespace, func(e *store.Execution) []string { return []string{e.CronJobNamespace} }},
+	{CorrelationByReason, func(e *store.Execution) []string { return nonEmpty(e.Reason) }},
+	{CorrelationByNode, func(e *store.Execution) []string { return nonEmpty(e.NodeName) }},
+	{CorrelationByImage, func(e *store.Execution) []string { return e.GetImages() }},
+}
+
+// nonEmpty returns s as a single value, or no values if it is empty
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// FailureCorrelator finds failures across CronJobs that likely share a cause
+type FailureCorrelator struct {
+	store store.Store
+	now   func() time.Time
+}
+
+// NewFailureCorrelator creates a correlator reading executions from st
+func NewFailureCorrelator(st store.Store) *FailureCorrelator {
+	return &FailureCorrelator{store: st, now: time.Now}
+}
+
+// Correlate groups recent failures by time, namespace, failure reason, node and image.
+// Failures sharing a value are split into bursts wherever the gap between
+// consecutive failures exceeds the window; bursts spanning at least
+// MinCronJobs distinct CronJobs are reported.
+func (c *FailureCorrelator) Correlate(ctx context.Context, opts CorrelationOptions) (*CorrelationReport, error) {
+	if opts.Lookback <= 0 {
+		opts.Lookback = DefaultCorrelationLookback
+	}
+	if opts.Window <= 0 {
+		opts.Window = DefaultCorrelationWindow
+	}
+	if opts.MinCronJobs <= 0 {
+		opts.MinCronJobs = DefaultCorrelationMinCronJobs
+	}
+
+	now := c.now()
+	failures, err := c.store.GetFailedExecutionsSince(ctx, now.Add(-opts.Lookback), maxCorrelatedFailures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load failures: %w", err)
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failureTime(&failures[i]).Before(failureTime(&failures[j]))
+	})
+
+	report := &CorrelationReport{
+		GeneratedAt:   now,
+		Lookback:      opts.Lookback,
+		Window:        opts.Window,
+		TotalFailures: len(failures),
+		Groups:        []CorrelationGroup{},
+	}
+
+	for _, dim := range correlationDimensions {
+		byValue := make(map[string][]*store.Execution)
+		var values []string
+		for i := range failures {
+			for _, v := range dim.values(&failures[i]) {
+				if _, seen := byValue[v]; !seen {
+					values = append(values, v)
+				}
+				byValue[v] = append(byValue[v], &failures[i])
+			}
+		}
+		for _, v := range values {
+			for _, burst := range splitBursts(byValue[v], opts.Window) {
+				group := newCorrelationGroup(dim.name, v, burst)
+				if len(group.CronJobs) >= opts.MinCronJobs {
+					report.Groups = append(report.Groups, group)
+				}
+			}
+		}
+	}
+
+	report.Groups = dropCoveredTimeGroups(report.Groups)
+	sort.SliceStable(report.Groups, func(i, j int) bool {
+		a, b := report.Groups[i], report.Groups[j]
+		if len(a.CronJobs) != len(b.CronJobs) {
+			return len(a.CronJobs) > len(b.CronJobs)
+		}
+		return a.FirstFailure.Before(b.FirstFailure)
+	})
+	return report, nil
+}
+
+// failureTime is when an execution failed
+func failureTime(e *store.Execution) time.Time {
+	if !e.CompletionTime.IsZero() {
+		return e.CompletionTime
+	}
+	return e.StartTime
+}
+
+// splitBursts splits time-ordered failures wherever consecutive failures are more than window apart
+func splitBursts(failures []*store.Execution, window time.Duration) [][]*store.Execution {
+	var bursts [][]*store.Execution
+	start := 0
+	for i := 1; i <= len(failures); i++ {
+		if i == len(failures) || failureTime(failures[i]).Sub(failureTime(failures[i-1])) > window {
+			bursts = append(bursts, failures[start:i])
+			start = i
+		}
+	}
+	return bursts
+}
+
+// newCorrelationGroup summarizes a burst of failures
+func newCorrelationGroup(dimension, value string, burst []*store.Execution) CorrelationGroup {
+	group := CorrelationGroup{
+		Dimension:    dimension,
+		Value:        value,
+		Failures:     len(burst),
+		FirstFailure: failureTime(burst[0]),
+		LastFailure:  failureTime(burst[len(burst)-1]),
+	}
+
+	seen := make(map[types.NamespacedName]bool)
+	namespaces := make(map[string]bool)
+	for _, e := range burst {
+		nn := types.NamespacedName{Namespace: e.CronJobNamespace, Name: e.CronJobName}
+		if !seen[nn] {
+			seen[nn] = true
+			group.CronJobs = append(group.CronJobs, nn)
+		}
+		namespaces[e.CronJobNamespace] = true
+	}
+	slices.SortFunc(group.CronJobs, func(a, b types.NamespacedName) int {
+		if c := cmp.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	span := group.LastFailure.Sub(group.FirstFailure).Round(time.Second)
+	n := len(group.CronJobs)
+	switch dimension {
+	case CorrelationByNamespace:
+		group.LikelyCause = fmt.Sprintf("%d CronJobs in namespace %s failed within %s; check namespace quotas and shared services, secrets or config", n, value, span)
+	case CorrelationByNode:
+		group.LikelyCause = fmt.Sprintf("%d CronJobs failed on node %s within %s; check the node's conditions, pressure and kubelet", n, value, span)
+	case CorrelationByImage:
+		group.LikelyCause = fmt.Sprintf("%d CronJobs running image %s failed within %s; check recent changes to that image", n, value, span)
+	case CorrelationByReason:
+		group.LikelyCause = fmt.Sprintf("%d CronJobs failed with reason %s within %s; check the dependency or resource behind that reason", n, value, span)
+	default:
+		group.LikelyCause = fmt.Sprintf("%d CronJobs across %d namespaces failed within %s; check cluster-wide dependencies such as DNS, the network or the API server", n, len(namespaces), span)
+	}
+	return group
+}
+
+// dropCoveredTimeGroups removes time groups whose CronJobs all appear in one
+// more specific group from the same period, which is the better explanation
+func dropCoveredTimeGroups(groups []CorrelationGroup) []CorrelationGroup {
+	result := make([]CorrelationGroup, 0, len(groups))
+	for i, g := range groups {
+		if g.Dimension != CorrelationByTime || !coveredBySpecificGroup(g, groups, i) {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// coveredBySpecificGroup reports whether another non-time group contains all of g's CronJobs within g's period
+func coveredBySpecificGroup(g CorrelationGroup, groups []CorrelationGroup, self int) bool {
+	for j, other := range groups {
+		if j == self || other.Dimension == CorrelationByTime {
+			continue
+		}
+		if other.FirstFailure.Before(g.FirstFailure) || other.LastFailure.After(g.LastFailure) {
+			continue
+		}
+		if slices.Equal(other.CronJobs, g.CronJobs) {
+			return true
+		}
+	}
+	return false
+}
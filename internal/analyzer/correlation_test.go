@@ -0,0 +1,148 @@
+package analyzer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func failedExecution(namespace, name, reason string, at time.Time) store.Execution {
+	return store.Execution{
+		CronJobNamespace: namespace,
+		CronJobName:      name,
+		StartTime:        at.Add(-time.Minute),
+		CompletionTime:   at,
+		Reason:           reason,
+	}
+}
+
+func TestCorrelate_NamespaceBurst(t *testing.T) {
+	now := time.Now()
+	base := now.Add(-30 * time.Minute)
+	mock := &mockStore{
+		FailedExecutions: []store.Execution{
+			failedExecution("payments", "a", "BackoffLimitExceeded", base),
+			failedExecution("payments", "b", "DeadlineExceeded", base.Add(time.Minute)),
+			failedExecution("payments", "c", "BackoffLimitExceeded", base.Add(2*time.Minute)),
+			failedExecution("other", "d", "BackoffLimitExceeded", now.Add(-5*time.Minute)),
+		},
+	}
+
+	report, err := NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, report.TotalFailures)
+	// The time burst covers the same CronJobs as the namespace group and is dropped;
+	// d failed 23 minutes later so it starts a new burst
+	require.Len(t, report.Groups, 1)
+	g := report.Groups[0]
+	assert.Equal(t, CorrelationByNamespace, g.Dimension)
+	assert.Equal(t, "payments", g.Value)
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "payments", Name: "a"},
+		{Namespace: "payments", Name: "b"},
+		{Namespace: "payments", Name: "c"},
+	}, g.CronJobs)
+	assert.Equal(t, 3, g.Failures)
+	assert.Contains(t, g.LikelyCause, "namespace payments")
+}
+
+func TestCorrelate_ClusterWideBurst(t *testing.T) {
+	base := time.Now().Add(-20 * time.Minute)
+	mock := &mockStore{
+		FailedExecutions: []store.Execution{
+			failedExecution("ns-a", "a", "BackoffLimitExceeded", base),
+			failedExecution("ns-b", "b", "BackoffLimitExceeded", base.Add(time.Minute)),
+			failedExecution("ns-c", "c", "DeadlineExceeded", base.Add(2*time.Minute)),
+			failedExecution("ns-d", "d", "BackoffLimitExceeded", base.Add(3*time.Minute)),
+		},
+	}
+
+	report, err := NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, report.Groups, 2)
+	assert.Equal(t, CorrelationByTime, report.Groups[0].Dimension)
+	assert.Len(t, report.Groups[0].CronJobs, 4)
+	assert.Contains(t, report.Groups[0].LikelyCause, "across 4 namespaces")
+	assert.Equal(t, CorrelationByReason, report.Groups[1].Dimension)
+	assert.Equal(t, "BackoffLimitExceeded", report.Groups[1].Value)
+	assert.Len(t, report.Groups[1].CronJobs, 3)
+}
+
+func TestCorrelate_NodeAndImage(t *testing.T) {
+	base := time.Now().Add(-20 * time.Minute)
+	onNode := func(namespace, name, node, image string, at time.Time) store.Execution {
+		e := failedExecution(namespace, name, "", at)
+		e.NodeName = node
+		e.SetImages([]string{image})
+		return e
+	}
+	mock := &mockStore{
+		FailedExecutions: []store.Execution{
+			onNode("ns-a", "a", "worker-1", "app:1", base),
+			onNode("ns-b", "b", "worker-1", "etl:2", base.Add(time.Minute)),
+			onNode("ns-c", "c", "worker-1", "report:3", base.Add(2*time.Minute)),
+			onNode("ns-d", "d", "worker-2", "etl:2", base.Add(40*time.Minute)),
+			onNode("ns-e", "e", "worker-3", "etl:2", base.Add(41*time.Minute)),
+			onNode("ns-f", "f", "worker-4", "etl:2", base.Add(42*time.Minute)),
+		},
+	}
+
+	report, err := NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{Lookback: 2 * time.Hour})
+	require.NoError(t, err)
+
+	require.Len(t, report.Groups, 2)
+	assert.Equal(t, CorrelationByNode, report.Groups[0].Dimension)
+	assert.Equal(t, "worker-1", report.Groups[0].Value)
+	assert.Contains(t, report.Groups[0].LikelyCause, "node worker-1")
+	assert.Equal(t, CorrelationByImage, report.Groups[1].Dimension)
+	assert.Equal(t, "etl:2", report.Groups[1].Value)
+	assert.Len(t, report.Groups[1].CronJobs, 3)
+}
+
+func TestCorrelate_WindowSplitsBursts(t *testing.T) {
+	base := time.Now().Add(-50 * time.Minute)
+	mock := &mockStore{
+		FailedExecutions: []store.Execution{
+			failedExecution("ns", "a", "", base),
+			failedExecution("ns", "b", "", base.Add(4*time.Minute)),
+			failedExecution("ns", "c", "", base.Add(20*time.Minute)),
+		},
+	}
+
+	report, err := NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{Window: 5 * time.Minute})
+	require.NoError(t, err)
+	assert.Empty(t, report.Groups)
+
+	report, err = NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{Window: 20 * time.Minute})
+	require.NoError(t, err)
+	require.Len(t, report.Groups, 1)
+	assert.Equal(t, CorrelationByNamespace, report.Groups[0].Dimension)
+}
+
+func TestCorrelate_MinCronJobsCountsDistinctJobs(t *testing.T) {
+	base := time.Now().Add(-10 * time.Minute)
+	mock := &mockStore{
+		FailedExecutions: []store.Execution{
+			failedExecution("ns", "a", "", base),
+			failedExecution("ns", "a", "", base.Add(time.Minute)),
+			failedExecution("ns", "b", "", base.Add(2*time.Minute)),
+		},
+	}
+
+	report, err := NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, report.Groups)
+
+	report, err = NewFailureCorrelator(mock).Correlate(context.Background(), CorrelationOptions{MinCronJobs: 2})
+	require.NoError(t, err)
+	require.Len(t, report.Groups, 1)
+	assert.Equal(t, 3, report.Groups[0].Failures)
+}
@@ -0,0 +1,88 @@
+package analyzer
+
+import (
+	"context"
+	"math"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Recommendation defaults
+const (
+	defaultDeadlineMultiplier       = 1.5
+	defaultRecommendationWindowDays = 30
+	defaultRecommendationMinRuns    = 10
+
+	// maxBackoffLimit is the Kubernetes default backoffLimit; more retries
+	// than that are never recommended
+	maxBackoffLimit = 6
+	// targetFailureRate is the chance of every attempt of a Job failing the
+	// recommended backoffLimit aims for
+	targetFailureRate = 0.01
+)
+
+// RecommendationWindowDays returns the history window recommendations are
+// computed from
+func RecommendationWindowDays(config *v1alpha1.RecommendationConfig) int {
+	if config == nil {
+		return defaultRecommendationWindowDays
+	}
+	return int(getOrDefaultInt32(config.WindowDays, defaultRecommendationWindowDays))
+}
+
+func (a *analyzer) RecommendLimits(ctx context.Context, cronJob types.NamespacedName, config *v1alpha1.RecommendationConfig) (*v1alpha1.LimitRecommendation, error) {
+	if config != nil && !isEnabled(config.Enabled) {
+		return nil, nil
+	}
+
+	metrics, err := a.store.GetMetrics(ctx, cronJob, RecommendationWindowDays(config))
+	if err != nil {
+		return nil, err
+	}
+	return ComputeLimitRecommendation(metrics, config), nil
+}
+
+// ComputeLimitRecommendation derives Job limits from execution metrics. The
+// deadline is the P99 duration times the multiplier, rounded up to a whole
+// minute. Metrics count Jobs rather than pod attempts, so the backoff limit
+// treats the Job failure rate as the chance of a single attempt failing.
+// Returns nil when there are fewer than the configured minimum runs.
+func ComputeLimitRecommendation(metrics *store.Metrics, config *v1alpha1.RecommendationConfig) *v1alpha1.LimitRecommendation {
+	multiplier := defaultDeadlineMultiplier
+	minRuns := int32(defaultRecommendationMinRuns)
+	if config != nil {
+		multiplier = getOrDefaultFloat64(config.DeadlineMultiplier, defaultDeadlineMultiplier)
+		minRuns = getOrDefaultInt32(config.MinRuns, defaultRecommendationMinRuns)
+	}
+
+	if metrics == nil || metrics.TotalRuns < minRuns || metrics.P99DurationSeconds <= 0 {
+		return nil
+	}
+
+	deadline := int64(math.Ceil(metrics.P99DurationSeconds*multiplier/60)) * 60
+
+	return &v1alpha1.LimitRecommendation{
+		ActiveDeadlineSeconds: deadline,
+		BackoffLimit:          recommendBackoffLimit(float64(metrics.FailedRuns) / float64(metrics.TotalRuns)),
+		BasedOnRuns:           metrics.TotalRuns,
+	}
+}
+
+// recommendBackoffLimit returns the retries needed for the chance of every
+// attempt failing to drop below targetFailureRate, between 1 and maxBackoffLimit
+func recommendBackoffLimit(failureRate float64) int32 {
+	if failureRate <= 0 {
+		return 1
+	}
+	if failureRate >= 1 {
+		return maxBackoffLimit
+	}
+
+	// The epsilon keeps exact ratios like 0.1 -> 2 attempts from rounding up
+	attempts := math.Ceil(math.Log(targetFailureRate)/math.Log(failureRate) - 1e-9)
+	retries := int32(attempts) - 1
+	return max(1, min(retries, maxBackoffLimit))
+}
@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestComputeLimitRecommendation(t *testing.T) {
+	metrics := &store.Metrics{TotalRuns: 20, FailedRuns: 2, P99DurationSeconds: 300}
+
+	rec := ComputeLimitRecommendation(metrics, nil)
+	require.NotNil(t, rec)
+	// 300s * 1.5 = 450s, rounded up to 8 minutes
+	assert.Equal(t, int64(480), rec.ActiveDeadlineSeconds)
+	// 10% failure rate: two attempts bring it to 1%
+	assert.Equal(t, int32(1), rec.BackoffLimit)
+	assert.Equal(t, int32(20), rec.BasedOnRuns)
+
+	multiplier := 2.0
+	rec = ComputeLimitRecommendation(metrics, &v1alpha1.RecommendationConfig{DeadlineMultiplier: &multiplier})
+	require.NotNil(t, rec)
+	assert.Equal(t, int64(600), rec.ActiveDeadlineSeconds)
+}
+
+func TestComputeLimitRecommendation_NotEnoughHistory(t *testing.T) {
+	assert.Nil(t, ComputeLimitRecommendation(nil, nil))
+	assert.Nil(t, ComputeLimitRecommendation(&store.Metrics{TotalRuns: 9, P99DurationSeconds: 60}, nil))
+	assert.Nil(t, ComputeLimitRecommendation(&store.Metrics{TotalRuns: 20}, nil))
+
+	minRuns := int32(3)
+	rec := ComputeLimitRecommendation(&store.Metrics{TotalRuns: 3, P99DurationSeconds: 60}, &v1alpha1.RecommendationConfig{MinRuns: &minRuns})
+	assert.NotNil(t, rec)
+}
+
+func TestRecommendBackoffLimit(t *testing.T) {
+	tests := []struct {
+		failureRate float64
+		want        int32
+	}{
+		{0, 1},
+		{0.05, 1},
+		{0.3, 3},
+		{0.5, 6},
+		{0.9, 6},
+		{1, 6},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, recommendBackoffLimit(tt.failureRate), "failure rate %v", tt.failureRate)
+	}
+}
+
+func TestRecommendLimits(t *testing.T) {
+	ms := &mockStore{Metrics: &store.Metrics{TotalRuns: 10, P99DurationSeconds: 30}}
+	analyzer := NewSLAAnalyzer(ms)
+	cronJob := types.NamespacedName{Namespace: "default", Name: "backup"}
+
+	rec, err := analyzer.RecommendLimits(context.Background(), cronJob, nil)
+	require.NoError(t, err)
+	require.NotNil(t, rec)
+	assert.Equal(t, int64(60), rec.ActiveDeadlineSeconds)
+
+	disabled := false
+	rec, err = analyzer.RecommendLimits(context.Background(), cronJob, &v1alpha1.RecommendationConfig{Enabled: &disabled})
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+}
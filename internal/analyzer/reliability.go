@@ -0,0 +1,66 @@
+package analyzer
+
+import (
+	"slices"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Reliability summarises how often a CronJob fails and how quickly it recovers
+type Reliability struct {
+	// Incidents is the number of failure streaks, each starting with a failed
+	// run after a success (or the first run)
+	Incidents int
+	// MTTR is the mean time from the first failure of an incident to the next
+	// success (zero = no incident recovered)
+	MTTR time.Duration
+	// MTBF is the mean time between the starts of consecutive incidents
+	// (zero = fewer than two incidents)
+	MTBF time.Duration
+}
+
+// ComputeReliability computes MTTR and MTBF from executions, newest first as
+// the store returns them. Runs are timed by when they finished. Only runs
+// whose outcome changed matter, so store.GetOutcomeChanges is enough.
+func ComputeReliability(execs []store.Execution) Reliability {
+	var (
+		result        Reliability
+		firstIncident time.Time
+		lastIncident  time.Time
+		failingSince  time.Time
+		recoveryTime  time.Duration
+		recovered     int
+	)
+	for _, exec := range slices.Backward(execs) {
+		finished := exec.CompletionTime
+		if finished.IsZero() {
+			finished = exec.StartTime
+		}
+
+		if !exec.Succeeded {
+			if failingSince.IsZero() {
+				failingSince = finished
+				result.Incidents++
+				if firstIncident.IsZero() {
+					firstIncident = finished
+				}
+				lastIncident = finished
+			}
+			continue
+		}
+		if !failingSince.IsZero() {
+			recoveryTime += max(finished.Sub(failingSince), 0)
+			recovered++
+			failingSince = time.Time{}
+		}
+	}
+
+	if recovered > 0 {
+		result.MTTR = recoveryTime / time.Duration(recovered)
+	}
+	if result.Incidents > 1 {
+		result.MTBF = lastIncident.Sub(firstIncident) / time.Duration(result.Incidents-1)
+	}
+	return result
+}
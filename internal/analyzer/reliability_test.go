@@ -0,0 +1,66 @@
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestComputeReliability(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := func(hours int, succeeded bool) store.Execution {
+		start := base.Add(time.Duration(hours) * time.Hour)
+		return store.Execution{StartTime: start, CompletionTime: start.Add(10 * time.Minute), Succeeded: succeeded}
+	}
+
+	tests := []struct {
+		name  string
+		execs []store.Execution // oldest first
+		want  Reliability
+	}{
+		{
+			name:  "no executions",
+			execs: nil,
+			want:  Reliability{},
+		},
+		{
+			name:  "only successes",
+			execs: []store.Execution{run(0, true), run(1, true)},
+			want:  Reliability{},
+		},
+		{
+			name:  "unrecovered failure",
+			execs: []store.Execution{run(0, true), run(1, false), run(2, false)},
+			want:  Reliability{Incidents: 1},
+		},
+		{
+			name: "two incidents",
+			execs: []store.Execution{
+				run(0, false), run(1, false), run(2, true), // recovered after 2h
+				run(10, false), run(14, true), // recovered after 4h
+			},
+			want: Reliability{Incidents: 2, MTTR: 3 * time.Hour, MTBF: 10 * time.Hour},
+		},
+		{
+			name: "running job without completion time",
+			execs: []store.Execution{
+				run(0, false),
+				{StartTime: base.Add(time.Hour), Succeeded: true},
+			},
+			want: Reliability{Incidents: 1, MTTR: 50 * time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The store returns executions newest first
+			execs := make([]store.Execution, len(tt.execs))
+			for i, exec := range tt.execs {
+				execs[len(execs)-1-i] = exec
+			}
+			assert.Equal(t, tt.want, ComputeReliability(execs))
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package analyzer
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// DefaultScheduleHorizon is how far ahead schedules are expanded
+	DefaultScheduleHorizon = 24 * time.Hour
+	// DefaultScheduleWindow is the width of the windows runs are counted in
+	DefaultScheduleWindow = time.Minute
+	// DefaultScheduleMinCronJobs is the number of CronJobs starting in one window that makes a conflict
+	DefaultScheduleMinCronJobs = 3
+	// DefaultScheduleMaxStagger is the largest offset suggested for a CronJob
+	DefaultScheduleMaxStagger = 15 * time.Minute
+
+	// maxRunsPerCronJob bounds the runs expanded for a single schedule
+	maxRunsPerCronJob = 20000
+)
+
+// scheduleParser parses CronJob schedules, including descriptors like @daily
+// and CRON_TZ= prefixes
+var scheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// descriptorSchedules are the standard forms of the descriptors Kubernetes accepts
+var descriptorSchedules = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ScheduledCronJob is a CronJob whose schedule is analyzed
+type ScheduledCronJob struct {
+	CronJob  types.NamespacedName
+	Schedule string
+	// TimeZone is the CronJob's spec.timeZone (empty = UTC)
+	TimeZone string
+}
+
+// ScheduleConflictOptions configures a schedule conflict report. Zero values use the defaults.
+type ScheduleConflictOptions struct {
+	Horizon     time.Duration
+	Window      time.Duration
+	MinCronJobs int
+	MaxStagger  time.Duration
+}
+
+// ScheduleConflict is a set of CronJobs that start in the same window
+type ScheduleConflict struct {
+	// CronJobs starting together
+	CronJobs []types.NamespacedName
+	// NextAt is the start of the first window they collide in
+	NextAt time.Time
+	// Occurrences is the number of windows within the horizon they collide in
+	Occurrences int
+	// Suggestions stagger all but the first CronJob
+	Suggestions []StaggerSuggestion
+}
+
+// StaggerSuggestion moves a CronJob's runs later to spread out a conflict
+type StaggerSuggestion struct {
+	CronJob  types.NamespacedName
+	Schedule string
+	Offset   time.Duration
+	// SuggestedSchedule is Schedule shifted by Offset, empty when the
+	// schedule cannot be shifted by rewriting its minute field
+	SuggestedSchedule string
+}
+
+// InvalidSchedule is a CronJob whose schedule could not be parsed
+type InvalidSchedule struct {
+	CronJob  types.NamespacedName
+	Schedule string
+	Error    string
+}
+
+// ScheduleConflictReport lists windows where many CronJobs start at once, most crowded first
+type ScheduleConflictReport struct {
+	GeneratedAt time.Time
+	Horizon     time.Duration
+	Window      time.Duration
+	CronJobs    int
+	// PeakWindow is the start of the most crowded window and PeakCronJobs its CronJob count
+	PeakWindow   time.Time
+	PeakCronJobs int
+	Conflicts    []ScheduleConflict
+	Invalid      []InvalidSchedule
+}
+
+// scheduledRuns is a CronJob's runs within the horizon, as window indexes
+type scheduledRuns struct {
+	job     ScheduledCronJob
+	windows []int
+}
+
+// AnalyzeScheduleConflicts expands every schedule over the horizon, counts
+// the CronJobs starting in each window and reports the windows where at
+// least MinCronJobs start together. Windows with the same set of CronJobs
+// (e.g. the same jobs colliding every night) are reported once. For each
+// conflict, all CronJobs but the first are given an offset of whole minutes
+// up to MaxStagger that moves their runs into the least crowded windows.
+func AnalyzeScheduleConflicts(jobs []ScheduledCronJob, now time.Time, opts ScheduleConflictOptions) *ScheduleConflictReport {
+	if opts.Horizon <= 0 {
+		opts.Horizon = DefaultScheduleHorizon
+	}
+	if opts.Window <= 0 {
+		opts.Window = DefaultScheduleWindow
+	}
+	if opts.MinCronJobs <= 0 {
+		opts.MinCronJobs = DefaultScheduleMinCronJobs
+	}
+	if opts.MaxStagger <= 0 {
+		opts.MaxStagger = DefaultScheduleMaxStagger
+	}
+
+	start := now.Truncate(opts.Window)
+	report := &ScheduleConflictReport{
+		GeneratedAt: now,
+		Horizon:     opts.Horizon,
+		Window:      opts.Window,
+		Conflicts:   []ScheduleConflict{},
+		Invalid:     []InvalidSchedule{},
+	}
+
+	// Expand every schedule into the windows it starts runs in
+	var expanded []scheduledRuns
+	load := make(map[int]int)
+	for _, job := range jobs {
+		windows, err := expandSchedule(job, start, opts.Horizon, opts.Window)
+		if err != nil {
+			report.Invalid = append(report.Invalid, InvalidSchedule{CronJob: job.CronJob, Schedule: job.Schedule, Error: err.Error()})
+			continue
+		}
+		expanded = append(expanded, scheduledRuns{job: job, windows: windows})
+		for _, w := range windows {
+			load[w]++
+		}
+	}
+	report.CronJobs = len(expanded)
+	for w, n := range load {
+		if n > report.PeakCronJobs || (n == report.PeakCronJobs && start.Add(time.Duration(w)*opts.Window).Before(report.PeakWindow)) {
+			report.PeakCronJobs = n
+			report.PeakWindow = start.Add(time.Duration(w) * opts.Window)
+		}
+	}
+
+	// Group crowded windows by the CronJobs starting in them
+	members := make(map[int][]int)
+	for i, runs := range expanded {
+		for _, w := range runs.windows {
+			if load[w] >= opts.MinCronJobs {
+				members[w] = append(members[w], i)
+			}
+		}
+	}
+	crowded := make([]int, 0, len(members))
+	for w := range members {
+		crowded = append(crowded, w)
+	}
+	slices.Sort(crowded)
+
+	type group struct {
+		conflict ScheduleConflict
+		jobs     []int
+	}
+	groups := make(map[string]*group)
+	var order []*group
+	for _, w := range crowded {
+		key := fmt.Sprint(members[w])
+		if g, ok := groups[key]; ok {
+			g.conflict.Occurrences++
+			continue
+		}
+		g := &group{
+			conflict: ScheduleConflict{NextAt: start.Add(time.Duration(w) * opts.Window), Occurrences: 1},
+			jobs:     members[w],
+		}
+		for _, i := range g.jobs {
+			g.conflict.CronJobs = append(g.conflict.CronJobs, expanded[i].job.CronJob)
+		}
+		groups[key] = g
+		order = append(order, g)
+	}
+
+	// Suggest offsets, most crowded conflicts first, updating the load as
+	// CronJobs are moved so later suggestions avoid windows filled by earlier ones
+	slices.SortStableFunc(order, func(a, b *group) int {
+		return cmp.Compare(len(b.jobs), len(a.jobs))
+	})
+	moved := make(map[int]bool)
+	for _, g := range order {
+		jobIdx := slices.Clone(g.jobs)
+		slices.SortFunc(jobIdx, func(a, b int) int {
+			return cmp.Or(
+				cmp.Compare(expanded[a].job.CronJob.Namespace, expanded[b].job.CronJob.Namespace),
+				cmp.Compare(expanded[a].job.CronJob.Name, expanded[b].job.CronJob.Name),
+			)
+		})
+
+		for _, i := range jobIdx[1:] {
+			if moved[i] {
+				continue
+			}
+			runs := expanded[i]
+			offset := bestOffset(runs.windows, load, opts.Window, opts.MaxStagger)
+			if offset == 0 {
+				continue
+			}
+			moved[i] = true
+			shift := int(offset / opts.Window)
+			for _, w := range runs.windows {
+				load[w]--
+				load[w+shift]++
+			}
+			g.conflict.Suggestions = append(g.conflict.Suggestions, StaggerSuggestion{
+				CronJob:           runs.job.CronJob,
+				Schedule:          runs.job.Schedule,
+				Offset:            offset,
+				SuggestedSchedule: shiftSchedule(runs.job.Schedule, offset),
+			})
+		}
+		report.Conflicts = append(report.Conflicts, g.conflict)
+	}
+
+	slices.SortStableFunc(report.Conflicts, func(a, b ScheduleConflict) int {
+		return cmp.Or(
+			cmp.Compare(len(b.CronJobs), len(a.CronJobs)),
+			a.NextAt.Compare(b.NextAt),
+		)
+	})
+	return report
+}
+
+// NextRuns returns the next n times the CronJob is scheduled to start after
+// from, in the CronJob's time zone
+func NextRuns(job ScheduledCronJob, from time.Time, n int) ([]time.Time, error) {
+	sched, loc, err := parseSchedule(job)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]time.Time, 0, n)
+	for t := sched.Next(from.In(loc)); len(runs) < n && !t.IsZero(); t = sched.Next(t) {
+		runs = append(runs, t)
+	}
+	return runs, nil
+}
+
+// parseSchedule parses the CronJob's schedule and loads its time zone
+func parseSchedule(job ScheduledCronJob) (cron.Schedule, *time.Location, error) {
+	sched, err := scheduleParser.Parse(job.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc := time.UTC
+	if job.TimeZone != "" {
+		if loc, err = time.LoadLocation(job.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("unknown time zone %q", job.TimeZone)
+		}
+	}
+	return sched, loc, nil
+}
+
+// expandSchedule returns the windows within the horizon the CronJob starts runs in
+func expandSchedule(job ScheduledCronJob, start time.Time, horizon, window time.Duration) ([]int, error) {
+	sched, loc, err := parseSchedule(job)
+	if err != nil {
+		return nil, err
+	}
+
+	end := start.Add(horizon)
+	var windows []int
+	last := -1
+	for t := sched.Next(start.In(loc).Add(-time.Nanosecond)); t.Before(end) && len(windows) < maxRunsPerCronJob; t = sched.Next(t) {
+		w := int(t.Sub(start) / window)
+		if w != last {
+			windows = append(windows, w)
+			last = w
+		}
+	}
+	return windows, nil
+}
+
+// bestOffset returns the offset of whole minutes up to maxStagger that
+// minimizes the most crowded window the runs would start in, preferring
+// smaller offsets. Zero means staying put is best.
+func bestOffset(windows []int, load map[int]int, window, maxStagger time.Duration) time.Duration {
+	peak := func(shift int) int {
+		highest := 0
+		for _, w := range windows {
+			n := load[w+shift]
+			if shift == 0 {
+				n-- // the CronJob itself
+			}
+			highest = max(highest, n)
+		}
+		return highest
+	}
+
+	step := max(time.Minute, window.Truncate(time.Minute))
+	best, bestPeak := time.Duration(0), peak(0)
+	for offset := step; offset <= maxStagger; offset += step {
+		if p := peak(int(offset / window)); p < bestPeak {
+			best, bestPeak = offset, p
+		}
+	}
+	return best
+}
+
+// shiftSchedule moves a schedule with a fixed minute later by offset. It
+// returns "" for schedules whose minute field is not a single value or that
+// would move into another hour.
+func shiftSchedule(schedule string, offset time.Duration) string {
+	prefix := ""
+	if strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "TZ=") {
+		i := strings.IndexByte(schedule, ' ')
+		if i < 0 {
+			return ""
+		}
+		prefix, schedule = schedule[:i+1], strings.TrimSpace(schedule[i+1:])
+	}
+	if std, ok := descriptorSchedules[schedule]; ok {
+		schedule = std
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return ""
+	}
+	minute, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return ""
+	}
+	shifted := minute + int(offset/time.Minute)
+	if shifted >= 60 {
+		return ""
+	}
+	fields[0] = strconv.Itoa(shifted)
+	return prefix + strings.Join(fields, " ")
+}
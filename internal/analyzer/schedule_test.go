@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func scheduled(name, schedule string) ScheduledCronJob {
+	return ScheduledCronJob{CronJob: types.NamespacedName{Namespace: "default", Name: name}, Schedule: schedule}
+}
+
+func TestAnalyzeScheduleConflicts_Midnight(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	jobs := []ScheduledCronJob{
+		scheduled("backup", "0 0 * * *"),
+		scheduled("cleanup", "@daily"),
+		scheduled("report", "CRON_TZ=UTC 0 0 * * *"),
+		scheduled("sync", "*/30 * * * *"),
+		scheduled("hourly", "15 * * * *"),
+	}
+
+	report := AnalyzeScheduleConflicts(jobs, now, ScheduleConflictOptions{})
+	assert.Equal(t, 5, report.CronJobs)
+	assert.Empty(t, report.Invalid)
+	assert.Equal(t, 4, report.PeakCronJobs)
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), report.PeakWindow)
+
+	require.Len(t, report.Conflicts, 1)
+	conflict := report.Conflicts[0]
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), conflict.NextAt)
+	assert.Equal(t, 1, conflict.Occurrences)
+	assert.Len(t, conflict.CronJobs, 4)
+
+	// backup keeps its schedule, the others are spread over the next minutes
+	require.Len(t, conflict.Suggestions, 3)
+	suggested := make(map[string]StaggerSuggestion)
+	for _, s := range conflict.Suggestions {
+		suggested[s.CronJob.Name] = s
+	}
+	assert.NotContains(t, suggested, "backup")
+	assert.Equal(t, "1 0 * * *", suggested["cleanup"].SuggestedSchedule)
+	assert.Equal(t, time.Minute, suggested["cleanup"].Offset)
+	assert.Equal(t, "CRON_TZ=UTC 2 0 * * *", suggested["report"].SuggestedSchedule)
+	assert.Equal(t, 3*time.Minute, suggested["sync"].Offset)
+	assert.Empty(t, suggested["sync"].SuggestedSchedule, "step schedules are not rewritten")
+}
+
+func TestAnalyzeScheduleConflicts_RecurringAndInvalid(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 30, 0, 0, time.UTC)
+	jobs := []ScheduledCronJob{
+		scheduled("a", "0 * * * *"),
+		scheduled("b", "0 * * * *"),
+		scheduled("c", "0 */2 * * *"),
+		scheduled("broken", "not a schedule"),
+		{CronJob: types.NamespacedName{Namespace: "default", Name: "tz"}, Schedule: "0 * * * *", TimeZone: "Mars/Olympus"},
+	}
+
+	report := AnalyzeScheduleConflicts(jobs, now, ScheduleConflictOptions{Horizon: 6 * time.Hour, MinCronJobs: 2})
+	assert.Equal(t, 3, report.CronJobs)
+	require.Len(t, report.Invalid, 2)
+	assert.Equal(t, "broken", report.Invalid[0].CronJob.Name)
+	assert.Contains(t, report.Invalid[1].Error, "unknown time zone")
+
+	// a, b and c collide every two hours, a and b in the hours between
+	require.Len(t, report.Conflicts, 2)
+	assert.Len(t, report.Conflicts[0].CronJobs, 3)
+	assert.Equal(t, 3, report.Conflicts[0].Occurrences)
+	assert.Equal(t, time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC), report.Conflicts[0].NextAt)
+	assert.Len(t, report.Conflicts[1].CronJobs, 2)
+	assert.Equal(t, 3, report.Conflicts[1].Occurrences)
+}
+
+func TestNextRuns(t *testing.T) {
+	from := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	runs, err := NextRuns(scheduled("daily", "@daily"), from, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC),
+	}, runs)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	job := ScheduledCronJob{CronJob: types.NamespacedName{Namespace: "default", Name: "tz"}, Schedule: "0 9 * * *", TimeZone: "Europe/Berlin"}
+	runs, err = NextRuns(job, from, 1)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.True(t, runs[0].Equal(time.Date(2026, 3, 11, 9, 0, 0, 0, berlin)))
+	assert.Equal(t, berlin, runs[0].Location())
+
+	_, err = NextRuns(scheduled("broken", "not a schedule"), from, 1)
+	assert.Error(t, err)
+}
+
+func TestShiftSchedule(t *testing.T) {
+	assert.Equal(t, "5 2 * * *", shiftSchedule("0 2 * * *", 5*time.Minute))
+	assert.Equal(t, "10 * * * *", shiftSchedule("@hourly", 10*time.Minute))
+	assert.Equal(t, "TZ=Europe/Berlin 31 4 * * 1", shiftSchedule("TZ=Europe/Berlin 30 4 * * 1", time.Minute))
+	assert.Empty(t, shiftSchedule("55 2 * * *", 10*time.Minute))
+	assert.Empty(t, shiftSchedule("0,30 * * * *", time.Minute))
+}
@@ -45,6 +45,14 @@ type SLAAnalyzer interface {
 
 	// CheckDurationRegression checks for performance regression
 	CheckDurationRegression(ctx context.Context, cronJob types.NamespacedName, config *v1alpha1.SLAConfig) (*RegressionResult, error)
+
+	// CheckScheduleBudget checks whether runs take up most of the time
+	// between scheduled runs
+	CheckScheduleBudget(ctx context.Context, cronJob *batchv1.CronJob, config *v1alpha1.SLAConfig) (*BudgetResult, error)
+
+	// RecommendLimits recommends activeDeadlineSeconds and backoffLimit from
+	// execution history; returns nil when there is not enough history
+	RecommendLimits(ctx context.Context, cronJob types.NamespacedName, config *v1alpha1.RecommendationConfig) (*v1alpha1.LimitRecommendation, error)
 }
 
 // SLAResult contains SLA check results
@@ -99,6 +107,12 @@ func (a *analyzer) GetMetrics(ctx context.Context, cronJob types.NamespacedName,
 		return nil, err
 	}
 
+	execs, err := a.store.GetOutcomeChanges(ctx, cronJob, time.Now().AddDate(0, 0, -windowDays))
+	if err != nil {
+		return nil, err
+	}
+	reliability := ComputeReliability(execs)
+
 	return &v1alpha1.CronJobMetrics{
 		SuccessRate:        metrics.SuccessRate,
 		TotalRuns:          metrics.TotalRuns,
@@ -108,6 +122,9 @@ func (a *analyzer) GetMetrics(ctx context.Context, cronJob types.NamespacedName,
 		P50DurationSeconds: metrics.P50DurationSeconds,
 		P95DurationSeconds: metrics.P95DurationSeconds,
 		P99DurationSeconds: metrics.P99DurationSeconds,
+		Incidents:          int32(reliability.Incidents),
+		MTTRSeconds:        reliability.MTTR.Seconds(),
+		MTBFSeconds:        reliability.MTBF.Seconds(),
 	}, nil
 }
 
@@ -195,6 +212,16 @@ func (a *analyzer) CheckDeadManSwitch(ctx context.Context, cronJob *batchv1.Cron
 		}
 	}
 
+	// Runs still in progress, like the catch-up runs fired when a suspended
+	// CronJob is resumed, are not missed: count from the latest scheduled one
+	inProgress := false
+	if scheduled := cronJob.Status.LastScheduleTime; len(cronJob.Status.Active) > 0 && scheduled != nil {
+		if sinceScheduled := time.Since(scheduled.Time); lastExec == nil || sinceScheduled < timeSinceLastRun {
+			timeSinceLastRun = sinceScheduled
+			inProgress = true
+		}
+	}
+
 	var expectedInterval time.Duration
 
 	if config.MaxTimeSinceLastSuccess != nil {
@@ -222,7 +249,7 @@ func (a *analyzer) CheckDeadManSwitch(ctx context.Context, cronJob *batchv1.Cron
 		threshold = *config.AutoFromSchedule.MissedScheduleThreshold
 	}
 
-	if lastExec == nil {
+	if lastExec == nil && !inProgress {
 		if cronJob.CreationTimestamp.Add(expectedInterval).Before(time.Now()) {
 			elapsed := time.Since(cronJob.CreationTimestamp.Time)
 			missedCount = int32(elapsed / expectedInterval)
@@ -237,7 +264,7 @@ func (a *analyzer) CheckDeadManSwitch(ctx context.Context, cronJob *batchv1.Cron
 
 	if missedCount >= threshold {
 		result.Triggered = true
-		if lastExec == nil {
+		if lastExec == nil && !inProgress {
 			result.Message = fmt.Sprintf("No jobs have run since creation. Missed %d scheduled run(s) (threshold: %d, expected interval: %s)",
 				missedCount, threshold, expectedInterval)
 		} else {
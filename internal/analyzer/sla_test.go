@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -30,14 +31,19 @@ type mockStore struct {
 	DurationPercentile      time.Duration
 	DurationPercentileError error
 	DurationPercentileMap   map[int]time.Duration
+	FailedExecutions        []store.Execution
+	Executions              []store.Execution
 }
 
 func (m *mockStore) Init() error                                                { return nil }
 func (m *mockStore) Close() error                                               { return nil }
 func (m *mockStore) Health(_ context.Context) error                             { return nil }
 func (m *mockStore) RecordExecution(_ context.Context, _ store.Execution) error { return nil }
+func (m *mockStore) RecordExecutions(_ context.Context, _ []store.Execution) error {
+	return nil
+}
 func (m *mockStore) GetExecutions(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
-	return nil, nil
+	return m.Executions, nil
 }
 func (m *mockStore) GetExecutionsPaginated(_ context.Context, _ types.NamespacedName, _ time.Time, _, _ int) ([]store.Execution, int64, error) {
 	return nil, 0, nil
@@ -51,6 +57,18 @@ func (m *mockStore) GetLastExecution(_ context.Context, _ types.NamespacedName)
 func (m *mockStore) GetLastSuccessfulExecution(_ context.Context, _ types.NamespacedName) (*store.Execution, error) {
 	return m.LastSuccessExec, m.GetLastSuccessfulError
 }
+func (m *mockStore) GetFailureStreak(_ context.Context, _ types.NamespacedName, _ time.Time) (int, error) {
+	return 0, nil
+}
+func (m *mockStore) GetOutcomeChanges(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
+	return m.Executions, nil
+}
+func (m *mockStore) GetSuccessStreak(_ context.Context, _ types.NamespacedName, _ time.Time) (store.SuccessStreak, error) {
+	return store.SuccessStreak{}, nil
+}
+func (m *mockStore) GetOutputSizes(_ context.Context, _ types.NamespacedName, _ int) ([]float64, error) {
+	return nil, nil
+}
 func (m *mockStore) GetExecutionByJobName(_ context.Context, _, _ string) (*store.Execution, error) {
 	return nil, nil
 }
@@ -68,14 +86,40 @@ func (m *mockStore) GetDurationPercentile(_ context.Context, _ types.NamespacedN
 func (m *mockStore) GetSuccessRate(_ context.Context, _ types.NamespacedName, _ int) (float64, error) {
 	return m.SuccessRate, m.GetSuccessRateError
 }
-func (m *mockStore) Prune(_ context.Context, _ time.Time) (int64, error)     { return 0, nil }
-func (m *mockStore) PruneLogs(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) GetExecutionAnalytics(_ context.Context, _ types.NamespacedName, _ int) (*store.ExecutionAnalytics, error) {
+	return nil, nil
+}
+func (m *mockStore) GetCronJobUsage(_ context.Context, _ types.NamespacedName, _ time.Time) (*store.CronJobUsage, error) {
+	return nil, nil
+}
+func (m *mockStore) ListCronJobUsage(_ context.Context, _ time.Time) ([]store.CronJobUsage, error) {
+	return nil, nil
+}
+func (m *mockStore) Prune(_ context.Context, _ time.Time, _ ...store.RetentionOverride) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) PruneLogs(_ context.Context, _ time.Time, _ ...store.RetentionOverride) (int64, error) {
+	return 0, nil
+}
 func (m *mockStore) DeleteExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
 	return 0, nil
 }
 func (m *mockStore) DeleteExecutionsByUID(_ context.Context, _ types.NamespacedName, _ string) (int64, error) {
 	return 0, nil
 }
+func (m *mockStore) RollUpExecutions(_ context.Context, _, _ time.Time) (int, error) { return 0, nil }
+func (m *mockStore) GetDailyRollups(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.DailyRollup, error) {
+	return nil, nil
+}
+func (m *mockStore) LatestRollupDay(_ context.Context) (time.Time, error)       { return time.Time{}, nil }
+func (m *mockStore) PruneRollups(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) TrashExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) RestoreExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	return 0, nil
+}
+func (m *mockStore) PurgeTrash(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
 func (m *mockStore) GetCronJobUIDs(_ context.Context, _ types.NamespacedName) ([]string, error) {
 	return nil, nil
 }
@@ -83,6 +127,15 @@ func (m *mockStore) GetExecutionCount(_ context.Context) (int64, error) { return
 func (m *mockStore) GetExecutionCountSince(_ context.Context, _ time.Time) (int64, error) {
 	return 0, nil
 }
+func (m *mockStore) GetFailedExecutionsSince(_ context.Context, _ time.Time, _ int) ([]store.Execution, error) {
+	return m.FailedExecutions, nil
+}
+func (m *mockStore) ExportExecutions(_ context.Context, _ int64, _ int) ([]store.Execution, error) {
+	return nil, nil
+}
+func (m *mockStore) ExportAlertHistory(_ context.Context, _ int64, _ int) ([]store.AlertHistory, error) {
+	return nil, nil
+}
 func (m *mockStore) StoreAlert(_ context.Context, _ store.AlertHistory) error { return nil }
 func (m *mockStore) ListAlertHistory(_ context.Context, _ store.AlertHistoryQuery) ([]store.AlertHistory, int64, error) {
 	return nil, 0, nil
@@ -100,6 +153,42 @@ func (m *mockStore) GetChannelStats(_ context.Context, _ string) (*store.Channel
 func (m *mockStore) GetAllChannelStats(_ context.Context) (map[string]*store.ChannelStatsRecord, error) {
 	return nil, nil
 }
+func (m *mockStore) EnqueueDelivery(_ context.Context, _ store.AlertDelivery) error { return nil }
+func (m *mockStore) GetDueDeliveries(_ context.Context, _ time.Time, _ int) ([]store.AlertDelivery, error) {
+	return nil, nil
+}
+func (m *mockStore) UpdateDelivery(_ context.Context, _ store.AlertDelivery) error { return nil }
+func (m *mockStore) ClaimDeliveryHistory(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+func (m *mockStore) ListDeliveries(_ context.Context, _ store.AlertDeliveryQuery) ([]store.AlertDelivery, int64, error) {
+	return nil, 0, nil
+}
+func (m *mockStore) PruneDeliveries(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) SaveAlertState(_ context.Context, _ store.AlertState) error    { return nil }
+func (m *mockStore) DeleteAlertStates(_ context.Context, _ []string) error         { return nil }
+func (m *mockStore) ListAlertStates(_ context.Context, _ time.Time) ([]store.AlertState, error) {
+	return nil, nil
+}
+func (m *mockStore) PruneAlertStates(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) ClaimAlert(_ context.Context, _ store.AlertClaim, _ time.Duration) (bool, error) {
+	return true, nil
+}
+func (m *mockStore) DeleteAlertClaims(_ context.Context, _ []string) error          { return nil }
+func (m *mockStore) PruneAlertClaims(_ context.Context, _ time.Time) (int64, error) { return 0, nil }
+func (m *mockStore) RecordReceipt(_ context.Context, _ store.AlertReceipt) error    { return nil }
+func (m *mockStore) ListReceipts(_ context.Context, _ []string) ([]store.AlertReceipt, error) {
+	return nil, nil
+}
+func (m *mockStore) SaveView(_ context.Context, _ store.SavedView) error           { return nil }
+func (m *mockStore) GetView(_ context.Context, _ string) (*store.SavedView, error) { return nil, nil }
+func (m *mockStore) ListViews(_ context.Context) ([]store.SavedView, error)        { return nil, nil }
+func (m *mockStore) DeleteView(_ context.Context, _ string) (bool, error)          { return false, nil }
+func (m *mockStore) SetLegalHold(_ context.Context, _ store.LegalHold) error       { return nil }
+func (m *mockStore) ReleaseLegalHold(_ context.Context, _ types.NamespacedName) (bool, error) {
+	return false, nil
+}
+func (m *mockStore) ListLegalHolds(_ context.Context) ([]store.LegalHold, error) { return nil, nil }
 
 // =============================================================================
 // GetMetrics Tests
@@ -135,6 +224,27 @@ func TestGetMetrics_AllFields(t *testing.T) {
 	assert.Equal(t, 120.0, metrics.P99DurationSeconds)
 }
 
+func TestGetMetrics_Reliability(t *testing.T) {
+	now := time.Now()
+	ms := &mockStore{
+		Metrics: &store.Metrics{TotalRuns: 3},
+		// Newest first, as the store returns them
+		Executions: []store.Execution{
+			{StartTime: now.Add(-time.Hour), Succeeded: true},
+			{StartTime: now.Add(-3 * time.Hour), Succeeded: false},
+			{StartTime: now.Add(-4 * time.Hour), Succeeded: true},
+		},
+	}
+	analyzer := NewSLAAnalyzer(ms)
+
+	metrics, err := analyzer.GetMetrics(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cron"}, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), metrics.Incidents)
+	assert.Equal(t, (2 * time.Hour).Seconds(), metrics.MTTRSeconds)
+	assert.Zero(t, metrics.MTBFSeconds)
+}
+
 func TestGetMetrics_StoreError(t *testing.T) {
 	ms := &mockStore{GetMetricsError: errors.New("database error")}
 	analyzer := NewSLAAnalyzer(ms)
@@ -373,6 +483,51 @@ func TestDeadManSwitch_Triggered(t *testing.T) {
 	assert.Contains(t, result.Message, "No jobs have run")
 }
 
+func TestDeadManSwitch_CatchUpRunsInProgress(t *testing.T) {
+	// Resumed after 2 days suspended; the catch-up run is still running
+	lastExec := &store.Execution{
+		CompletionTime: time.Now().Add(-48 * time.Hour),
+	}
+	ms := &mockStore{
+		LastExecution:   lastExec,
+		LastSuccessExec: lastExec,
+	}
+	analyzer := NewSLAAnalyzer(ms)
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-cron",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-7 * 24 * time.Hour)),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+		},
+		Status: batchv1.CronJobStatus{
+			Active:           []corev1.ObjectReference{{Name: "test-cron-29000000"}},
+			LastScheduleTime: &metav1.Time{Time: time.Now().Add(-20 * time.Minute)},
+		},
+	}
+
+	enabled := true
+	maxTime := metav1.Duration{Duration: 2 * time.Hour}
+	config := &v1alpha1.DeadManSwitchConfig{
+		Enabled:                 &enabled,
+		MaxTimeSinceLastSuccess: &maxTime,
+	}
+
+	result, err := analyzer.CheckDeadManSwitch(context.Background(), cronJob, config)
+	require.NoError(t, err)
+	assert.False(t, result.Triggered)
+
+	// A run stuck for longer than the window still counts as missed
+	cronJob.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-3 * time.Hour)}
+	result, err = analyzer.CheckDeadManSwitch(context.Background(), cronJob, config)
+	require.NoError(t, err)
+	assert.True(t, result.Triggered)
+	assert.Contains(t, result.Message, "No jobs have run for 3h0m0s")
+}
+
 func TestDeadManSwitch_Disabled(t *testing.T) {
 	ms := &mockStore{}
 	analyzer := NewSLAAnalyzer(ms)
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+// PreviewAlert handles POST /api/v1/alerts/preview
+// @Summary      Preview an alert
+// @Description  Renders the alert a CronJob's monitor would raise for the given type, as each routed channel would send it, without sending anything. Secrets that are part of a payload, such as PagerDuty routing keys, are redacted.
+// @Tags         Alerts
+// @Accept       json
+// @Produce      json
+// @Param        preview  body      AlertPreviewRequest  true  "Alert type and CronJob"
+// @Success      200  {object}  AlertPreviewResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /alerts/preview [post]
+func (h *Handlers) PreviewAlert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if h.alertDispatcher == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Alert dispatcher not available")
+		return
+	}
+
+	var req AlertPreviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if req.Type == "" || req.CronJob.Namespace == "" || req.CronJob.Name == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "type, cronJob.namespace and cronJob.name are required")
+		return
+	}
+	cronJobNN := types.NamespacedName{Namespace: req.CronJob.Namespace, Name: req.CronJob.Name}
+
+	cronJob := &batchv1.CronJob{}
+	if err := h.client.Get(ctx, cronJobNN, cronJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s not found", cronJobNN))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	monitor, err := h.previewMonitor(r, req, cronJobNN)
+	if err != nil {
+		resource := "Monitor"
+		if req.Monitor != nil {
+			resource = fmt.Sprintf("Monitor %s/%s", req.Monitor.Namespace, req.Monitor.Name)
+		}
+		writeResourceError(w, err, resource)
+		return
+	}
+	if monitor == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s is not monitored", cronJobNN))
+		return
+	}
+
+	var rule *DryRunAlertRule
+	for _, candidate := range dryRunAlertRules(monitor) {
+		if candidate.Type == req.Type {
+			rule = &candidate
+			break
+		}
+	}
+	if rule == nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST",
+			fmt.Sprintf("monitor %s/%s does not raise %s alerts", monitor.Namespace, monitor.Name, req.Type))
+		return
+	}
+
+	alert := alerting.Alert{
+		Type:     rule.Type,
+		Severity: rule.Severity,
+		Title:    fmt.Sprintf("%s: %s/%s", rule.Type, cronJobNN.Namespace, cronJobNN.Name),
+		Message:  fmt.Sprintf("Preview: %s", rule.Detail),
+		CronJob:  cronJobNN,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Timestamp: time.Now(),
+	}
+	if monitor.Spec.Alerting != nil {
+		alert.Context.PropagateMetadata(monitor.Spec.Alerting.IncludeContext, cronJob)
+	}
+
+	resp := AlertPreviewResponse{
+		Monitor:  NamespacedRef{Namespace: monitor.Namespace, Name: monitor.Name},
+		Type:     alert.Type,
+		Severity: alert.Severity,
+		Channels: alerting.ForCluster(h.alertDispatcher, h.cluster).Preview(ctx, alert, monitor.Spec.Alerting),
+	}
+	if len(resp.Channels) == 0 {
+		resp.Warnings = append(resp.Warnings, "no channel receives this alert")
+	}
+	if monitor.IsObserveOnly() {
+		resp.Warnings = append(resp.Warnings, "monitor is in observe mode, no alerts will be sent")
+	}
+	if monitor.IsSilenced(time.Now()) {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("alerts are silenced until %s", monitor.Spec.SilencedUntil.Format(time.RFC3339)))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// previewMonitor returns the monitor named in the request, or else the first
+// monitor of the CronJob. It returns nil if the CronJob is not monitored.
+func (h *Handlers) previewMonitor(r *http.Request, req AlertPreviewRequest, cronJob types.NamespacedName) (*guardianv1alpha1.CronJobMonitor, error) {
+	if req.Monitor != nil {
+		monitor := &guardianv1alpha1.CronJobMonitor{}
+		if err := h.client.Get(r.Context(), types.NamespacedName{Namespace: req.Monitor.Namespace, Name: req.Monitor.Name}, monitor); err != nil {
+			return nil, err
+		}
+		return monitor, nil
+	}
+
+	monitors, err := h.cronJobMonitors(r.Context(), cronJob)
+	if err != nil || len(monitors) == 0 {
+		return nil, err
+	}
+	return &monitors[0], nil
+}
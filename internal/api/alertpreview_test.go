@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestPreviewAlert(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "prod"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+	}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "critical", Namespace: "prod"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			Mode: guardianv1alpha1.MonitorModeObserve,
+			Alerting: &guardianv1alpha1.AlertingConfig{
+				ChannelRefs:       []guardianv1alpha1.ChannelRef{{Name: "slack-ops"}},
+				SeverityOverrides: guardianv1alpha1.SeverityOverrides{"jobFailed": "warning"},
+			},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Namespace: "prod", Name: "backup"}},
+		},
+	}
+	disp := testutil.NewMockDispatcher()
+	disp.Previews = []alerting.ChannelPreview{{Channel: "slack-ops", Type: "slack", ContentType: "application/json", Payload: `{"text":"..."}`}}
+	h := newTestHandlers(newTestAPIClient(cronJob, monitor), &testutil.MockStore{}, nil, disp)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/preview", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.PreviewAlert(w, req)
+		return w
+	}
+
+	w := post(`{"type": "JobFailed", "cronJob": {"namespace": "prod", "name": "backup"}}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp AlertPreviewResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, NamespacedRef{Namespace: "prod", Name: "critical"}, resp.Monitor)
+	assert.Equal(t, "warning", resp.Severity)
+	assert.Equal(t, disp.Previews, resp.Channels)
+	assert.Contains(t, resp.Warnings, "monitor is in observe mode, no alerts will be sent")
+
+	require.Len(t, disp.PreviewedAlerts, 1)
+	alert := disp.PreviewedAlerts[0]
+	assert.Equal(t, "JobFailed", alert.Type)
+	assert.Equal(t, "critical", alert.MonitorRef.Name)
+	assert.Empty(t, disp.DispatchedAlerts)
+
+	w = post(`{"type": "DeadManTriggered", "cronJob": {"namespace": "prod", "name": "backup"}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "does not raise DeadManTriggered alerts")
+
+	w = post(`{"type": "JobFailed", "cronJob": {"namespace": "prod", "name": "missing"}}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = post(`{"type": "JobFailed", "cronJob": {"namespace": "prod", "name": "backup"}, "monitor": {"namespace": "prod", "name": "other"}}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = post(`{"cronJob": {"namespace": "prod", "name": "backup"}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
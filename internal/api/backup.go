@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/backup"
+)
+
+// CreateBackup handles POST /api/v1/admin/backup
+// @Summary      Download a database backup
+// @Description  Streams a tar.gz with all executions, alert history and channel stats. Restore it with the `restore` command, into any storage backend.
+// @Tags         Admin
+// @Produce      application/gzip
+// @Success      200  {file}  file
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/backup [post]
+func (h *Handlers) CreateBackup(w http.ResponseWriter, r *http.Request) {
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	manifest := backup.Manifest{
+		CreatedAt:       time.Now().UTC(),
+		OperatorVersion: Version,
+	}
+	if h.config != nil {
+		manifest.StorageType = h.config.Storage.Type
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=cronjob-guardian-backup-%s.tar.gz", manifest.CreatedAt.Format("20060102-150405")))
+
+	// The archive is streamed, so errors can only end it early; restore
+	// rejects the truncated archive
+	stats, err := backup.Write(r.Context(), h.store, manifest, w)
+	if err != nil {
+		log.FromContext(r.Context()).Error(err, "backup failed")
+		return
+	}
+	log.FromContext(r.Context()).Info("backup written",
+		"executions", stats.Executions, "alertHistory", stats.AlertHistory, "channelStats", stats.ChannelStats)
+}
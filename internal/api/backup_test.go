@@ -0,0 +1,52 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/backup"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestCreateBackup(t *testing.T) {
+	src := &testutil.MockStore{
+		Executions: []store.Execution{
+			{ID: 1, CronJobNamespace: "default", CronJobName: "backup", JobName: "backup-1", Succeeded: true},
+			{ID: 2, CronJobNamespace: "default", CronJobName: "backup", JobName: "backup-2"},
+		},
+		AlertHistory: []store.AlertHistory{{ID: 1, Type: "JobFailed", Title: "Job failed"}},
+	}
+	cfg := config.DefaultConfig()
+	h := newTestHandlers(newTestAPIClient(), src, cfg, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/backup", nil)
+	w := httptest.NewRecorder()
+	h.CreateBackup(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "cronjob-guardian-backup-")
+
+	dst := &testutil.MockStore{}
+	manifest, stats, err := backup.Restore(t.Context(), dst, w.Body, false)
+	require.NoError(t, err)
+	assert.Equal(t, "sqlite", manifest.StorageType)
+	assert.Equal(t, backup.Stats{Executions: 2, AlertHistory: 1}, stats)
+	require.Len(t, dst.RecordedExecutions, 2)
+	assert.Equal(t, "backup-2", dst.RecordedExecutions[1].JobName)
+	assert.Zero(t, dst.RecordedExecutions[1].ID)
+}
+
+func TestCreateBackup_NoStore(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/backup", nil)
+	w := httptest.NewRecorder()
+	h.CreateBackup(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
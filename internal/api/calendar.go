@@ -0,0 +1,259 @@
+package api
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	// maxCalendarRunsPerCronJob bounds the scheduled runs of a single CronJob in the feed
+	maxCalendarRunsPerCronJob = 500
+	// maxCalendarFailures bounds the past failures in the feed
+	maxCalendarFailures = 1000
+	// defaultCalendarRunLength is the event length of runs with no known duration
+	defaultCalendarRunLength = 5 * time.Minute
+)
+
+// GetCalendar handles GET /api/v1/calendar.ics
+// @Summary      Get calendar feed
+// @Description  Returns an iCalendar feed with the upcoming scheduled runs and past failures of monitored CronJobs, for subscribing from team calendars
+// @Tags         Schedules
+// @Produce      text/calendar
+// @Param        monitor  query     string  false  "Only include CronJobs of this monitor (namespace/name)"
+// @Param        horizon  query     string  false  "How far ahead scheduled runs are included (default 168h, max 744h)"
+// @Param        days     query     int     false  "Days of past failures to include (default 7, max 90)"
+// @Success      200  {file}  file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /calendar.ics [get]
+func (h *Handlers) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	horizon := 7 * 24 * time.Hour
+	if v := q.Get("horizon"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > 31*24*time.Hour {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "horizon must be a duration between 0 and 744h")
+			return
+		}
+		horizon = d
+	}
+	days := 7
+	if v := q.Get("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > 90 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "days must be between 0 and 90")
+			return
+		}
+		days = n
+	}
+
+	// Collect the monitors whose CronJobs are included, with the last known
+	// run duration of each CronJob as the length of its scheduled runs
+	var monitors []guardianv1alpha1.CronJobMonitor
+	if v := q.Get("monitor"); v != "" {
+		namespace, name, ok := strings.Cut(v, "/")
+		if !ok || namespace == "" || name == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "monitor must be namespace/name")
+			return
+		}
+		monitor := guardianv1alpha1.CronJobMonitor{}
+		if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &monitor); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Monitor %s not found", v))
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		monitors = append(monitors, monitor)
+	} else {
+		list := &guardianv1alpha1.CronJobMonitorList{}
+		if err := h.client.List(ctx, list); err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		monitors = list.Items
+	}
+	runLength := make(map[types.NamespacedName]time.Duration)
+	for _, m := range monitors {
+		for _, cj := range m.Status.CronJobs {
+			nn := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
+			length := defaultCalendarRunLength
+			if cj.LastRunDuration != nil && cj.LastRunDuration.Duration > 0 {
+				length = max(time.Minute, cj.LastRunDuration.Duration.Round(time.Minute))
+			}
+			runLength[nn] = length
+		}
+	}
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := h.client.List(ctx, cronJobs); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	slices.SortFunc(cronJobs.Items, func(a, b batchv1.CronJob) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	now := time.Now().UTC()
+	cal := newICalendar(now)
+
+	// Upcoming runs of unsuspended CronJobs
+	for _, cj := range cronJobs.Items {
+		length, ok := runLength[types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}]
+		if !ok || (cj.Spec.Suspend != nil && *cj.Spec.Suspend) {
+			continue
+		}
+		runs, err := analyzer.NextRuns(scheduledCronJob(cj), now, maxCalendarRunsPerCronJob)
+		if err != nil {
+			continue
+		}
+		for _, t := range runs {
+			if t.Sub(now) > horizon {
+				break
+			}
+			cal.addEvent(icalEvent{
+				uid:         fmt.Sprintf("run-%s-%s-%d@cronjob-guardian", cj.Namespace, cj.Name, t.Unix()),
+				start:       t,
+				end:         t.Add(length),
+				summary:     fmt.Sprintf("%s/%s", cj.Namespace, cj.Name),
+				description: fmt.Sprintf("Scheduled run (%s)", cj.Spec.Schedule),
+				category:    "Scheduled",
+			})
+		}
+	}
+
+	// Past failures
+	if h.store != nil && days > 0 {
+		failures, err := h.store.GetFailedExecutionsSince(ctx, now.AddDate(0, 0, -days), maxCalendarFailures)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		for _, exec := range failures {
+			if _, ok := runLength[types.NamespacedName{Namespace: exec.CronJobNamespace, Name: exec.CronJobName}]; !ok {
+				continue
+			}
+			cal.addEvent(failureEvent(exec))
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", "inline; filename=cronjob-guardian.ics")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(cal.String()))
+}
+
+// failureEvent returns the calendar event of a failed execution
+func failureEvent(exec store.Execution) icalEvent {
+	end := exec.CompletionTime
+	if !end.After(exec.StartTime) {
+		end = exec.StartTime.Add(time.Minute)
+	}
+	description := fmt.Sprintf("Job %s failed with exit code %d", exec.JobName, exec.ExitCode)
+	if exec.Reason != "" {
+		description += ": " + exec.Reason
+	}
+	return icalEvent{
+		uid:         fmt.Sprintf("execution-%d@cronjob-guardian", exec.ID),
+		start:       exec.StartTime,
+		end:         end,
+		summary:     fmt.Sprintf("Failed: %s/%s", exec.CronJobNamespace, exec.CronJobName),
+		description: description,
+		category:    "Failed",
+	}
+}
+
+// icalEvent is a VEVENT of an iCalendar feed
+type icalEvent struct {
+	uid         string
+	start, end  time.Time
+	summary     string
+	description string
+	category    string
+}
+
+// iCalendar builds an RFC 5545 calendar
+type iCalendar struct {
+	stamp time.Time
+	b     strings.Builder
+}
+
+func newICalendar(stamp time.Time) *iCalendar {
+	c := &iCalendar{stamp: stamp}
+	c.line("BEGIN:VCALENDAR")
+	c.line("VERSION:2.0")
+	c.line("PRODID:-//cronjob-guardian//calendar//EN")
+	c.line("CALSCALE:GREGORIAN")
+	c.line("METHOD:PUBLISH")
+	c.line("X-WR-CALNAME:" + icalEscape("CronJob Guardian"))
+	return c
+}
+
+func (c *iCalendar) addEvent(e icalEvent) {
+	c.line("BEGIN:VEVENT")
+	c.line("UID:" + icalEscape(e.uid))
+	c.line("DTSTAMP:" + icalTime(c.stamp))
+	c.line("DTSTART:" + icalTime(e.start))
+	c.line("DTEND:" + icalTime(e.end))
+	c.line("SUMMARY:" + icalEscape(e.summary))
+	c.line("DESCRIPTION:" + icalEscape(e.description))
+	c.line("CATEGORIES:" + icalEscape(e.category))
+	c.line("TRANSP:TRANSPARENT")
+	c.line("END:VEVENT")
+}
+
+// String closes the calendar and returns it
+func (c *iCalendar) String() string {
+	c.line("END:VCALENDAR")
+	return c.b.String()
+}
+
+// line writes a content line, folded after 75 octets as RFC 5545 requires
+func (c *iCalendar) line(s string) {
+	for len(s) > 75 {
+		cut := 75
+		for cut > 0 && !isRuneStart(s[cut]) {
+			cut--
+		}
+		c.b.WriteString(s[:cut])
+		c.b.WriteString("\r\n ")
+		s = s[cut:]
+	}
+	c.b.WriteString(s)
+	c.b.WriteString("\r\n")
+}
+
+// isRuneStart reports whether b is the first byte of a UTF-8 sequence
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// icalTime formats t as a UTC date-time
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icalEscaper escapes the characters RFC 5545 reserves in TEXT values
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// icalEscape escapes a TEXT value
+func icalEscape(s string) string {
+	return icalEscaper.Replace(s)
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetCalendar(t *testing.T) {
+	monitor := func(name string, cronJobs ...guardianv1alpha1.CronJobStatus) *guardianv1alpha1.CronJobMonitor {
+		return &guardianv1alpha1.CronJobMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     guardianv1alpha1.CronJobMonitorStatus{CronJobs: cronJobs},
+		}
+	}
+	c := newTestAPIClient(
+		monitor("backups", guardianv1alpha1.CronJobStatus{
+			Namespace: "default", Name: "backup", LastRunDuration: &metav1.Duration{Duration: 20 * time.Minute},
+		}),
+		monitor("reports", guardianv1alpha1.CronJobStatus{Namespace: "default", Name: "report"}),
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "report", Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *", Suspend: ptr.To(true)},
+		},
+	)
+	start := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+	mockStore := &testutil.MockStore{
+		FailedExecutions: []store.Execution{
+			{
+				ID: 42, CronJobNamespace: "default", CronJobName: "backup", JobName: "backup-123",
+				StartTime: start, CompletionTime: start.Add(3 * time.Minute), ExitCode: 1, Reason: "BackoffLimitExceeded, disk full",
+			},
+			{ID: 43, CronJobNamespace: "default", CronJobName: "unmonitored", StartTime: start},
+		},
+	}
+	h := newTestHandlers(c, mockStore, nil, nil)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/calendar.ics"+query, nil)
+		w := httptest.NewRecorder()
+		h.GetCalendar(w, req)
+		return w
+	}
+
+	w := get("?monitor=default/backups&horizon=48h")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	body := strings.ReplaceAll(w.Body.String(), "\r\n ", "") // unfold long lines
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+
+	// Two daily runs of backup within 48h, lasting as long as its last run
+	assert.Equal(t, 2, strings.Count(body, "CATEGORIES:Scheduled"))
+	assert.Contains(t, body, "SUMMARY:default/backup\r\n")
+	assert.Regexp(t, `DTSTART:\d{8}T020000Z\r\nDTEND:\d{8}T022000Z`, body)
+
+	// The failure is included, the unmonitored CronJob's is not
+	assert.Equal(t, 1, strings.Count(body, "CATEGORIES:Failed"))
+	assert.Contains(t, body, "UID:execution-42@cronjob-guardian\r\n")
+	assert.Contains(t, body, "DTSTART:"+start.Format("20060102T150405Z")+"\r\nDTEND:"+start.Add(3*time.Minute).Format("20060102T150405Z"))
+	assert.Contains(t, body, `DESCRIPTION:Job backup-123 failed with exit code 1: BackoffLimitExceeded\, disk full`)
+
+	// All monitors: the suspended report CronJob adds no runs
+	w = get("?horizon=48h&days=0")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, strings.Count(w.Body.String(), "BEGIN:VEVENT"))
+
+	assert.Equal(t, http.StatusNotFound, get("?monitor=default/missing").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?monitor=backups").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?horizon=1000h").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?days=365").Code)
+}
+
+func TestICalendarLineFolding(t *testing.T) {
+	c := newICalendar(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	c.line("DESCRIPTION:" + strings.Repeat("é", 60))
+	for _, line := range strings.Split(strings.TrimSuffix(c.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
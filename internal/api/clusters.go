@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// ClusterBackend is what the API reads for one remote cluster
+type ClusterBackend struct {
+	Client    client.Client
+	Clientset *kubernetes.Clientset
+	// Store is scoped to the cluster's executions and alerts
+	Store store.Store
+}
+
+// SetClusters sets the remote clusters selectable with the cluster query parameter
+func (h *Handlers) SetClusters(clusters map[string]ClusterBackend) {
+	h.clusters = clusters
+}
+
+// localClusterName returns the configured name of the cluster guardian runs in
+func (h *Handlers) localClusterName() string {
+	if h.config == nil {
+		return ""
+	}
+	return h.config.ClusterName
+}
+
+// inCluster serves a request with the client and store of the cluster named
+// by the cluster query parameter. Without it, or with the name of the local
+// cluster, the request is served as usual.
+func (h *Handlers) inCluster(fn func(*Handlers, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("cluster")
+		if name == "" || name == h.localClusterName() {
+			fn(h, w, r)
+			return
+		}
+		backend, ok := h.clusters[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Cluster %s not found", name))
+			return
+		}
+		scoped := *h
+		scoped.client = backend.Client
+		scoped.clientset = backend.Clientset
+		scoped.store = backend.Store
+		scoped.cluster = name
+		fn(&scoped, w, r)
+	}
+}
+
+// ListClusters handles GET /api/v1/clusters
+// @Summary      List clusters
+// @Description  Returns the cluster guardian runs in and the remote clusters it monitors. Cluster-scoped endpoints select one with the cluster query parameter.
+// @Tags         Clusters
+// @Produce      json
+// @Success      200  {object}  ClusterListResponse
+// @Router       /clusters [get]
+func (h *Handlers) ListClusters(w http.ResponseWriter, _ *http.Request) {
+	items := []ClusterItem{{Name: h.localClusterName(), Local: true}}
+	names := make([]string, 0, len(h.clusters))
+	for name := range h.clusters {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		items = append(items, ClusterItem{Name: name})
+	}
+	writeJSON(w, http.StatusOK, ClusterListResponse{Items: items})
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+func newClustersTestHandlers() *Handlers {
+	monitor := func(name string) *guardianv1alpha1.CronJobMonitor {
+		return &guardianv1alpha1.CronJobMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status: guardianv1alpha1.CronJobMonitorStatus{
+				CronJobs: []guardianv1alpha1.CronJobStatus{{Name: name + "-job", Namespace: "default", Status: "healthy"}},
+			},
+		}
+	}
+	h := newTestHandlers(newTestAPIClient(monitor("local")), nil, &config.Config{ClusterName: "hub"}, nil)
+	h.SetClusters(map[string]ClusterBackend{
+		"prod-us": {Client: newTestAPIClient(monitor("us"))},
+		"prod-eu": {Client: newTestAPIClient(monitor("eu"))},
+	})
+	return h
+}
+
+func TestListClusters(t *testing.T) {
+	h := newClustersTestHandlers()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	w := httptest.NewRecorder()
+	h.ListClusters(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var result ClusterListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	assert.Equal(t, []ClusterItem{
+		{Name: "hub", Local: true},
+		{Name: "prod-eu"},
+		{Name: "prod-us"},
+	}, result.Items)
+}
+
+func TestInCluster(t *testing.T) {
+	h := newClustersTestHandlers()
+	list := h.inCluster((*Handlers).ListCronJobs)
+
+	get := func(query string) (int, CronJobListResponse) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs"+query, nil)
+		w := httptest.NewRecorder()
+		list(w, req)
+		var result CronJobListResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		}
+		return w.Code, result
+	}
+
+	for query, want := range map[string]string{"": "local-job", "?cluster=hub": "local-job", "?cluster=prod-eu": "eu-job"} {
+		code, result := get(query)
+		require.Equal(t, http.StatusOK, code, query)
+		require.Len(t, result.Items, 1, query)
+		assert.Equal(t, want, result.Items[0].Name, query)
+	}
+
+	_, result := get("?cluster=prod-eu")
+	assert.Equal(t, "prod-eu", result.Items[0].Cluster)
+
+	code, _ := get("?cluster=missing")
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,292 @@
+package api
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// storeLatencySamples is how many store health probes the diagnostics endpoint times
+const storeLatencySamples = 10
+
+// Metric families read from the controller-runtime registry
+const (
+	metricWorkqueueDepth          = "workqueue_depth"
+	metricWorkqueueQueueDuration  = "workqueue_queue_duration_seconds"
+	metricWorkqueueUnfinishedWork = "workqueue_unfinished_work_seconds"
+	metricWorkqueueLongestRunning = "workqueue_longest_running_processor_seconds"
+	metricExecutionQueueDepth     = "cronjob_guardian_execution_queue_depth"
+)
+
+// LastRunReporter is implemented by schedulers that record when they last ran
+type LastRunReporter interface {
+	LastRun() time.Time
+}
+
+// CertificateProvider is implemented by certificate watchers
+type CertificateProvider interface {
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+}
+
+// SetDiagnosticsSources sets the schedulers and certificate watchers reported by the diagnostics endpoint
+func (h *Handlers) SetDiagnosticsSources(schedulers map[string]LastRunReporter, certWatchers map[string]CertificateProvider) {
+	h.schedulers = schedulers
+	h.certWatchers = certWatchers
+}
+
+// GetDiagnostics handles GET /api/v1/admin/diagnostics
+// @Summary      Get self-diagnostics
+// @Description  Returns controller queue depths and lag, dispatcher and rate limiter state, store latency, scheduler activity and certificate status in one document
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  DiagnosticsResponse
+// @Router       /admin/diagnostics [get]
+func (h *Handlers) GetDiagnostics(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.collectDiagnostics(r.Context()))
+}
+
+// GetSupportBundle handles GET /api/v1/admin/diagnostics/bundle
+// @Summary      Download a support bundle
+// @Description  Returns a tar.gz with diagnostics, the redacted operator configuration and monitor conditions, for attaching to bug reports
+// @Tags         Admin
+// @Produce      application/gzip
+// @Success      200  {file}  file
+// @Failure      500  {object}  ErrorResponse
+// @Router       /admin/diagnostics/bundle [get]
+func (h *Handlers) GetSupportBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	now := time.Now().UTC()
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{"diagnostics.json", h.collectDiagnostics(ctx)},
+		{"config.json", h.redactedConfig()},
+		{"monitors.json", h.monitorConditions(ctx)},
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=cronjob-guardian-support-%s.tar.gz", now.Format("20060102-150405")))
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.data, "", "  ")
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		hdr := &tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(data)), ModTime: now}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return
+		}
+		if _, err := tw.Write(data); err != nil {
+			return
+		}
+	}
+	_ = tw.Close()
+	_ = gz.Close()
+}
+
+// collectDiagnostics gathers the diagnostics document
+func (h *Handlers) collectDiagnostics(ctx context.Context) DiagnosticsResponse {
+	now := time.Now()
+	resp := DiagnosticsResponse{
+		GeneratedAt: now,
+		Version:     Version,
+		Uptime:      time.Since(h.startTime).Round(time.Second).String(),
+		Leader:      true,
+	}
+	if h.leaderElectionCheck != nil {
+		resp.Leader = h.leaderElectionCheck()
+	}
+
+	resp.Controllers, resp.ExecutionQueueDepth = h.queueDiagnostics()
+
+	if h.alertDispatcher != nil {
+		diag := h.alertDispatcher.Diagnostics()
+		resp.Dispatcher = &diag
+	}
+
+	if h.store != nil {
+		latency := measureStoreLatency(ctx, h.store.Health, storeLatencySamples)
+		resp.StoreLatency = &latency
+	}
+
+	for name, s := range h.schedulers {
+		item := SchedulerDiagnostics{Name: name}
+		if last := s.LastRun(); !last.IsZero() {
+			item.LastRun = &last
+		}
+		resp.Schedulers = append(resp.Schedulers, item)
+	}
+	sort.Slice(resp.Schedulers, func(i, j int) bool { return resp.Schedulers[i].Name < resp.Schedulers[j].Name })
+
+	for name, cw := range h.certWatchers {
+		resp.Certificates = append(resp.Certificates, certificateDiagnostics(name, cw, now))
+	}
+	sort.Slice(resp.Certificates, func(i, j int) bool { return resp.Certificates[i].Name < resp.Certificates[j].Name })
+
+	return resp
+}
+
+// queueDiagnostics reads controller workqueue metrics and the execution
+// batcher queue depth from the metrics registry
+func (h *Handlers) queueDiagnostics() ([]ControllerDiagnostics, float64) {
+	gatherer := h.gatherer
+	if gatherer == nil {
+		return nil, 0
+	}
+	families, err := gatherer.Gather()
+	if err != nil {
+		return nil, 0
+	}
+
+	byQueue := make(map[string]*ControllerDiagnostics)
+	queue := func(name string) *ControllerDiagnostics {
+		if c, ok := byQueue[name]; ok {
+			return c
+		}
+		c := &ControllerDiagnostics{Name: name}
+		byQueue[name] = c
+		return c
+	}
+
+	var executionQueueDepth float64
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			var name string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" {
+					name = l.GetValue()
+				}
+			}
+
+			switch mf.GetName() {
+			case metricExecutionQueueDepth:
+				executionQueueDepth = m.GetGauge().GetValue()
+			case metricWorkqueueDepth:
+				queue(name).QueueDepth = m.GetGauge().GetValue()
+			case metricWorkqueueUnfinishedWork:
+				queue(name).UnfinishedWorkSeconds = m.GetGauge().GetValue()
+			case metricWorkqueueLongestRunning:
+				queue(name).LongestRunningSeconds = m.GetGauge().GetValue()
+			case metricWorkqueueQueueDuration:
+				if hist := m.GetHistogram(); hist.GetSampleCount() > 0 {
+					queue(name).MeanQueueWaitSeconds = hist.GetSampleSum() / float64(hist.GetSampleCount())
+				}
+			}
+		}
+	}
+
+	controllers := make([]ControllerDiagnostics, 0, len(byQueue))
+	for _, c := range byQueue {
+		controllers = append(controllers, *c)
+	}
+	sort.Slice(controllers, func(i, j int) bool { return controllers[i].Name < controllers[j].Name })
+	return controllers, executionQueueDepth
+}
+
+// measureStoreLatency times a number of store health probes
+func measureStoreLatency(ctx context.Context, probe func(context.Context) error, samples int) StoreLatencyDiagnostics {
+	result := StoreLatencyDiagnostics{Probe: "health"}
+	durations := make([]time.Duration, 0, samples)
+	for range samples {
+		start := time.Now()
+		if err := probe(ctx); err != nil {
+			result.Error = err.Error()
+			break
+		}
+		durations = append(durations, time.Since(start))
+	}
+	if len(durations) == 0 {
+		return result
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	percentile := func(p float64) float64 {
+		idx := int(p * float64(len(durations)-1))
+		return float64(durations[idx].Microseconds()) / 1000
+	}
+	result.Samples = len(durations)
+	result.P50Ms = percentile(0.50)
+	result.P90Ms = percentile(0.90)
+	result.MaxMs = percentile(1)
+	return result
+}
+
+// certificateDiagnostics reports the subject and expiry of a watched certificate
+func certificateDiagnostics(name string, cw CertificateProvider, now time.Time) CertificateDiagnostics {
+	diag := CertificateDiagnostics{Name: name}
+	cert, err := cw.GetCertificate(nil)
+	if err != nil {
+		diag.Error = err.Error()
+		return diag
+	}
+	if cert == nil || len(cert.Certificate) == 0 {
+		diag.Error = "no certificate loaded"
+		return diag
+	}
+
+	leaf := cert.Leaf
+	if leaf == nil {
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			diag.Error = fmt.Sprintf("failed to parse certificate: %s", err)
+			return diag
+		}
+	}
+
+	notAfter := leaf.NotAfter
+	diag.Subject = leaf.Subject.String()
+	diag.NotAfter = &notAfter
+	diag.Expired = now.After(notAfter)
+	return diag
+}
+
+// redactedConfig returns the configuration with secrets omitted, as served by GET /api/v1/config
+func (h *Handlers) redactedConfig() ConfigResponse {
+	if h.config == nil {
+		return ConfigResponse{}
+	}
+	return ConfigResponse{
+		LogLevel:         h.config.LogLevel,
+		Storage:          h.config.Storage,
+		HistoryRetention: h.config.HistoryRetention,
+		RateLimits:       h.config.RateLimits,
+		UI:               h.config.UI,
+		Scheduler:        h.config.Scheduler,
+	}
+}
+
+// monitorConditions lists the status conditions of every monitor, without their specs
+func (h *Handlers) monitorConditions(ctx context.Context) []MonitorDiagnostics {
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(ctx, monitors); err != nil {
+		return nil
+	}
+
+	items := make([]MonitorDiagnostics, 0, len(monitors.Items))
+	for _, m := range monitors.Items {
+		items = append(items, MonitorDiagnostics{
+			Namespace:          m.Namespace,
+			Name:               m.Name,
+			Generation:         m.Generation,
+			ObservedGeneration: m.Status.ObservedGeneration,
+			Phase:              m.Status.Phase,
+			Summary:            m.Status.Summary,
+			Conditions:         m.Status.Conditions,
+		})
+	}
+	return items
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+type fakeScheduler struct{ lastRun time.Time }
+
+func (f fakeScheduler) LastRun() time.Time { return f.lastRun }
+
+type fakeCertWatcher struct {
+	cert *tls.Certificate
+	err  error
+}
+
+func (f fakeCertWatcher) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return f.cert, f.err
+}
+
+func newTestCertificate(t *testing.T, notAfter time.Time) *tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cronjob-guardian-metrics"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestGetDiagnostics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth"}, []string{"controller", "name"})
+	depth.WithLabelValues("job", "job").Set(7)
+	registry.MustRegister(depth)
+
+	mockDispatcher := testutil.NewMockDispatcher()
+	mockDispatcher.DiagnosticsResult = alerting.DispatcherDiagnostics{PendingAlerts: 2, SuppressedAlerts: 5}
+
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, mockDispatcher)
+	h.gatherer = registry
+	lastRun := time.Now().Add(-time.Minute).Truncate(time.Second)
+	h.SetDiagnosticsSources(
+		map[string]LastRunReporter{
+			"dead-man-switch": fakeScheduler{lastRun: lastRun},
+			"sla-recalc":      fakeScheduler{},
+		},
+		map[string]CertificateProvider{
+			"metrics": fakeCertWatcher{cert: newTestCertificate(t, time.Now().Add(-time.Hour))},
+			"webhook": fakeCertWatcher{err: errors.New("no such file")},
+		},
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/diagnostics", nil)
+	w := httptest.NewRecorder()
+	h.GetDiagnostics(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp DiagnosticsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+
+	require.Len(t, resp.Controllers, 1)
+	assert.Equal(t, "job", resp.Controllers[0].Name)
+	assert.Equal(t, float64(7), resp.Controllers[0].QueueDepth)
+
+	require.NotNil(t, resp.Dispatcher)
+	assert.Equal(t, 2, resp.Dispatcher.PendingAlerts)
+	assert.Equal(t, int64(5), resp.Dispatcher.SuppressedAlerts)
+
+	require.NotNil(t, resp.StoreLatency)
+	assert.Equal(t, storeLatencySamples, resp.StoreLatency.Samples)
+	assert.Empty(t, resp.StoreLatency.Error)
+
+	require.Len(t, resp.Schedulers, 2)
+	assert.Equal(t, "dead-man-switch", resp.Schedulers[0].Name)
+	require.NotNil(t, resp.Schedulers[0].LastRun)
+	assert.True(t, lastRun.Equal(*resp.Schedulers[0].LastRun))
+	assert.Nil(t, resp.Schedulers[1].LastRun, "a scheduler that has not run has no last run")
+
+	require.Len(t, resp.Certificates, 2)
+	assert.Equal(t, "metrics", resp.Certificates[0].Name)
+	assert.Equal(t, "CN=cronjob-guardian-metrics", resp.Certificates[0].Subject)
+	assert.True(t, resp.Certificates[0].Expired)
+	assert.Equal(t, "no such file", resp.Certificates[1].Error)
+}
+
+func TestMeasureStoreLatency_Error(t *testing.T) {
+	result := measureStoreLatency(t.Context(), func(_ context.Context) error {
+		return errors.New("connection refused")
+	}, 3)
+
+	assert.Equal(t, 0, result.Samples)
+	assert.Equal(t, "connection refused", result.Error)
+}
+
+func TestGetSupportBundle(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, nil, nil)
+	h.gatherer = prometheus.NewRegistry()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/diagnostics/bundle", nil)
+	w := httptest.NewRecorder()
+	h.GetSupportBundle(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+
+	gz, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	assert.Equal(t, []string{"diagnostics.json", "config.json", "monitors.json"}, names)
+}
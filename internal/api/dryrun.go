@@ -0,0 +1,305 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/controller"
+)
+
+// DryRunMonitor handles POST /api/v1/monitors/dry-run
+// @Summary      Dry-run a monitor
+// @Description  Evaluates a CronJobMonitor without creating it: returns the CronJobs its selector matches, the alerts it would raise and the channels they would go to
+// @Tags         Monitors
+// @Accept       json
+// @Produce      json
+// @Param        monitor  body      guardianv1alpha1.CronJobMonitor  true  "CronJobMonitor to evaluate (metadata.namespace defaults to default)"
+// @Success      200  {object}  MonitorDryRunResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/dry-run [post]
+func (h *Handlers) DryRunMonitor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	if err := json.NewDecoder(r.Body).Decode(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if monitor.Namespace == "" {
+		monitor.Namespace = "default"
+	}
+	if err := validateMonitor(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	namespaces, err := controller.TargetNamespaces(ctx, h.client, monitor)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	cronJobs, err := controller.FindMatchingCronJobs(ctx, h.client, monitor)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := MonitorDryRunResponse{
+		Namespaces:     namespaces,
+		CronJobs:       make([]DryRunCronJob, 0, len(cronJobs)),
+		AlertRules:     dryRunAlertRules(monitor),
+		Channels:       []DryRunChannel{},
+		ActiveChannels: []string{},
+	}
+
+	monitoredBy := h.monitoredCronJobs(ctx, monitor)
+	for _, cj := range cronJobs {
+		resp.CronJobs = append(resp.CronJobs, DryRunCronJob{
+			Namespace:   cj.Namespace,
+			Name:        cj.Name,
+			Schedule:    cj.Spec.Schedule,
+			Suspended:   cj.Spec.Suspend != nil && *cj.Spec.Suspend,
+			MonitoredBy: monitoredBy[types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}],
+		})
+	}
+	if len(cronJobs) == 0 {
+		resp.Warnings = append(resp.Warnings, "selector matches no CronJobs")
+	}
+
+	now := time.Now()
+	alertCfg := monitor.Spec.Alerting
+	switch {
+	case alertCfg != nil && !isEnabled(alertCfg.Enabled):
+		resp.Warnings = append(resp.Warnings, "alerting is disabled")
+	case alertCfg == nil || len(alertCfg.AllChannelRefs()) == 0:
+		resp.Warnings = append(resp.Warnings, "no alert channels configured, alerts will only be recorded")
+	default:
+		resp.Channels = h.dryRunChannels(ctx, alertCfg, &resp.Warnings)
+		for _, ref := range alerting.RoutedChannelRefs(alertCfg, now) {
+			resp.ActiveChannels = append(resp.ActiveChannels, ref.Name)
+		}
+	}
+	if monitor.IsObserveOnly() {
+		resp.Warnings = append(resp.Warnings, "monitor is in observe mode, no alerts will be sent")
+	}
+	if monitor.IsSilenced(now) {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("alerts are silenced until %s", monitor.Spec.SilencedUntil.Format(time.RFC3339)))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// validateMonitor checks the parts of the spec the controller would reject.
+// It runs before a monitor is dry-run, created or updated.
+func validateMonitor(monitor *guardianv1alpha1.CronJobMonitor) error {
+	if sel := monitor.Spec.Selector; sel != nil && sel.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(sel.NamespaceSelector); err != nil {
+			return fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+	}
+	if monitor.Spec.Alerting != nil {
+		if err := alerting.ValidateRouting(monitor.Spec.Alerting.Routing); err != nil {
+			return fmt.Errorf("invalid routing: %w", err)
+		}
+	}
+	return nil
+}
+
+// monitoredCronJobs maps CronJobs to the existing monitors watching them,
+// other than the monitor being evaluated
+func (h *Handlers) monitoredCronJobs(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor) map[types.NamespacedName][]NamespacedRef {
+	result := make(map[types.NamespacedName][]NamespacedRef)
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(ctx, monitors); err != nil {
+		return result
+	}
+	for _, m := range monitors.Items {
+		if m.Namespace == monitor.Namespace && m.Name == monitor.Name {
+			continue
+		}
+		for _, cj := range m.Status.CronJobs {
+			key := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
+			result[key] = append(result[key], NamespacedRef{Namespace: m.Namespace, Name: m.Name})
+		}
+	}
+	return result
+}
+
+// dryRunChannels describes every channel the monitor references and the
+// routes that use it
+func (h *Handlers) dryRunChannels(ctx context.Context, alertCfg *guardianv1alpha1.AlertingConfig, warnings *[]string) []DryRunChannel {
+	routes := make(map[string][]string)
+	for _, ref := range alertCfg.ChannelRefs {
+		routes[ref.Name] = append(routes[ref.Name], "default")
+	}
+	if alertCfg.Routing != nil {
+		for i, rule := range alertCfg.Routing.Rules {
+			name := rule.Name
+			if name == "" {
+				name = fmt.Sprintf("rule-%d", i+1)
+			}
+			for _, ref := range rule.ChannelRefs {
+				routes[ref.Name] = append(routes[ref.Name], name)
+			}
+		}
+	}
+
+	refs := alertCfg.AllChannelRefs()
+	channels := make([]DryRunChannel, 0, len(refs))
+	for _, ref := range refs {
+		channel := DryRunChannel{
+			Name:       ref.Name,
+			Severities: ref.Severities,
+			Routes:     routes[ref.Name],
+		}
+		ac := &guardianv1alpha1.AlertChannel{}
+		if err := h.client.Get(ctx, client.ObjectKey{Name: ref.Name}, ac); err != nil {
+			*warnings = append(*warnings, fmt.Sprintf("alert channel %s not found", ref.Name))
+		} else {
+			channel.Found = true
+			channel.Type = ac.Spec.Type
+			channel.Ready = ac.Status.Ready
+			if !channel.Ready {
+				*warnings = append(*warnings, fmt.Sprintf("alert channel %s is not ready", ref.Name))
+			}
+		}
+		channels = append(channels, channel)
+	}
+	sort.SliceStable(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+	return channels
+}
+
+// dryRunAlertRules lists the alerts the monitor would raise, with the
+// severity they would be sent with
+func dryRunAlertRules(monitor *guardianv1alpha1.CronJobMonitor) []DryRunAlertRule {
+	spec := monitor.Spec
+	if spec.Alerting != nil && !isEnabled(spec.Alerting.Enabled) {
+		return []DryRunAlertRule{}
+	}
+	rule := func(alertType, severity, detail string) DryRunAlertRule {
+		return DryRunAlertRule{Type: alertType, Severity: spec.Alerting.SeverityFor(alertType, severity), Detail: detail}
+	}
+
+	rules := []DryRunAlertRule{
+		rule("JobFailed", "critical", "a Job of a selected CronJob fails"),
+		rule("ImagePullFailed", "critical", "a running Job of a selected CronJob cannot pull its image"),
+		rule("MissingConfig", "critical", "a running Job of a selected CronJob references a missing Secret or ConfigMap"),
+		rule("SchedulingFailed", "warning", "a running Job of a selected CronJob cannot be scheduled or create its pods"),
+	}
+	if spec.Alerting != nil && spec.Alerting.FailureStreak != nil {
+		streak := spec.Alerting.FailureStreak
+		if alertAfter := ptr.Deref(streak.AlertAfter, 1); alertAfter > 1 {
+			rules[0].Detail = fmt.Sprintf("Jobs of a selected CronJob fail %d times in a row", alertAfter)
+		}
+		if criticalAfter := ptr.Deref(streak.CriticalAfter, 0); criticalAfter > 0 {
+			rules[0].Detail += fmt.Sprintf(", critical after %d in a row", criticalAfter)
+		}
+	}
+
+	if dms := spec.DeadManSwitch; dms != nil && isEnabled(dms.Enabled) {
+		if auto := dms.AutoFromSchedule; auto != nil && auto.Enabled {
+			buffer := time.Hour
+			if auto.Buffer != nil {
+				buffer = auto.Buffer.Duration
+			}
+			missed := int32(1)
+			if auto.MissedScheduleThreshold != nil {
+				missed = *auto.MissedScheduleThreshold
+			}
+			rules = append(rules, rule("DeadManTriggered", "critical",
+				fmt.Sprintf("%d scheduled run(s) missed, with a %s buffer", missed, buffer)))
+		} else if dms.MaxTimeSinceLastSuccess != nil {
+			rules = append(rules, rule("DeadManTriggered", "critical",
+				fmt.Sprintf("no successful run for %s", dms.MaxTimeSinceLastSuccess.Duration)))
+		}
+	}
+
+	if sla := spec.SLA; sla != nil && isEnabled(sla.Enabled) {
+		minRate := 95.0
+		if sla.MinSuccessRate != nil {
+			minRate = *sla.MinSuccessRate
+		}
+		windowDays := int32(7)
+		if sla.WindowDays != nil {
+			windowDays = *sla.WindowDays
+		}
+		detail := fmt.Sprintf("success rate below %.1f%% over %d days", minRate, windowDays)
+		if sla.MaxDuration != nil {
+			detail += fmt.Sprintf(", or a run longer than %s", sla.MaxDuration.Duration)
+		}
+		rules = append(rules, rule("SLABreached", "warning", detail))
+
+		threshold := int32(50)
+		if sla.DurationRegressionThreshold != nil {
+			threshold = *sla.DurationRegressionThreshold
+		}
+		rules = append(rules, rule("DurationRegression", "warning",
+			fmt.Sprintf("P95 duration %d%% above its baseline", threshold)))
+
+		if budget := ptr.Deref(sla.ScheduleBudgetPercent, analyzer.DefaultScheduleBudgetPercent); budget > 0 {
+			rules = append(rules, rule("DurationBudget", "warning",
+				fmt.Sprintf("P95 duration reaches %d%% of the time between scheduled runs", budget)))
+		}
+	}
+
+	if stuck := spec.StuckJobs; stuck != nil && isEnabled(stuck.Enabled) {
+		switch {
+		case stuck.MaxRuntime != nil:
+			rules = append(rules, rule("JobStuck", "warning", fmt.Sprintf("a Job runs longer than %s", stuck.MaxRuntime.Duration)))
+		case stuck.P95Multiplier != nil:
+			rules = append(rules, rule("JobStuck", "warning", fmt.Sprintf("a Job runs longer than %gx its P95 duration", *stuck.P95Multiplier)))
+		}
+	}
+
+	if suspended := spec.SuspendedHandling; suspended != nil && suspended.AlertIfSuspendedFor != nil {
+		rules = append(rules, rule("SuspendedTooLong", "warning",
+			fmt.Sprintf("a CronJob is suspended for more than %s", suspended.AlertIfSuspendedFor.Duration)))
+	}
+
+	if len(spec.Dependencies) > 0 {
+		rules = append(rules, rule("ChainBroken", "warning", "a CronJob will run before its failed upstream recovered"))
+	}
+
+	if output := spec.Output; output != nil {
+		threshold := int32(50)
+		if output.DropThresholdPercent != nil {
+			threshold = *output.DropThresholdPercent
+		}
+		rules = append(rules, rule("OutputDropped", "warning",
+			fmt.Sprintf("a successful run's output size is %d%% below the median of recent runs", threshold)))
+	}
+
+	if spec.Alerting != nil {
+		if flap := spec.Alerting.FlapDetection; flap != nil && isEnabled(flap.Enabled) {
+			threshold := int32(4)
+			if flap.Threshold != nil {
+				threshold = *flap.Threshold
+			}
+			window := time.Hour
+			if flap.Window != nil {
+				window = flap.Window.Duration
+			}
+			rules = append(rules, rule("Flapping", "warning",
+				fmt.Sprintf("runs change between success and failure %d times within %s", threshold, window)))
+		}
+	}
+
+	return rules
+}
+
+func isEnabled(b *bool) bool {
+	return b == nil || *b
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestDryRunMonitor(t *testing.T) {
+	cronJob := func(namespace, name string, labels map[string]string) *batchv1.CronJob {
+		return &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+		}
+	}
+	existing := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "prod"},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Namespace: "prod", Name: "backup"}},
+		},
+	}
+	slack := &guardianv1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-ops"},
+		Spec:       guardianv1alpha1.AlertChannelSpec{Type: "slack"},
+		Status:     guardianv1alpha1.AlertChannelStatus{Ready: true},
+	}
+	c := newTestAPIClient(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		cronJob("prod", "backup", map[string]string{"tier": "critical"}),
+		cronJob("prod", "report", map[string]string{"tier": "batch"}),
+		cronJob("dev", "backup", map[string]string{"tier": "critical"}),
+		existing, slack,
+	)
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors/dry-run", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.DryRunMonitor(w, req)
+		return w
+	}
+
+	w := post(`{
+		"metadata": {"name": "critical", "namespace": "prod"},
+		"spec": {
+			"selector": {
+				"namespaceSelector": {"matchLabels": {"env": "prod"}},
+				"matchLabels": {"tier": "critical"}
+			},
+			"deadManSwitch": {"maxTimeSinceLastSuccess": "25h"},
+			"alerting": {
+				"channelRefs": [{"name": "slack-ops"}, {"name": "pager", "severities": ["critical"]}],
+				"severityOverrides": {"JobFailed": "warning"}
+			}
+		}
+	}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp MonitorDryRunResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, []string{"prod"}, resp.Namespaces)
+	require.Len(t, resp.CronJobs, 1)
+	assert.Equal(t, "backup", resp.CronJobs[0].Name)
+	assert.Equal(t, "0 2 * * *", resp.CronJobs[0].Schedule)
+	assert.Equal(t, []NamespacedRef{{Namespace: "prod", Name: "existing"}}, resp.CronJobs[0].MonitoredBy)
+
+	assert.Equal(t, []DryRunAlertRule{
+		{Type: "JobFailed", Severity: "warning", Detail: "a Job of a selected CronJob fails"},
+		{Type: "ImagePullFailed", Severity: "critical", Detail: "a running Job of a selected CronJob cannot pull its image"},
+		{Type: "MissingConfig", Severity: "critical", Detail: "a running Job of a selected CronJob references a missing Secret or ConfigMap"},
+		{Type: "SchedulingFailed", Severity: "warning", Detail: "a running Job of a selected CronJob cannot be scheduled or create its pods"},
+		{Type: "DeadManTriggered", Severity: "critical", Detail: "no successful run for 25h0m0s"},
+	}, resp.AlertRules)
+
+	require.Len(t, resp.Channels, 2)
+	assert.Equal(t, DryRunChannel{Name: "pager", Severities: []string{"critical"}, Routes: []string{"default"}}, resp.Channels[0])
+	assert.Equal(t, DryRunChannel{Name: "slack-ops", Type: "slack", Found: true, Ready: true, Routes: []string{"default"}}, resp.Channels[1])
+	assert.Equal(t, []string{"slack-ops", "pager"}, resp.ActiveChannels)
+	assert.Equal(t, []string{"alert channel pager not found"}, resp.Warnings)
+}
+
+func TestDryRunAlertRules_FailureStreak(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		FailureStreak: &guardianv1alpha1.FailureStreakConfig{AlertAfter: ptr.To[int32](3), CriticalAfter: ptr.To[int32](5)},
+	}
+
+	rules := dryRunAlertRules(monitor)
+	require.NotEmpty(t, rules)
+	assert.Equal(t, DryRunAlertRule{
+		Type: "JobFailed", Severity: "critical", Detail: "Jobs of a selected CronJob fail 3 times in a row, critical after 5 in a row",
+	}, rules[0])
+}
+
+func TestDryRunMonitor_NoMatches(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors/dry-run", strings.NewReader(`{"spec": {}}`))
+	w := httptest.NewRecorder()
+	h.DryRunMonitor(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp MonitorDryRunResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, []string{"default"}, resp.Namespaces)
+	assert.Empty(t, resp.CronJobs)
+	assert.Contains(t, resp.Warnings, "selector matches no CronJobs")
+	assert.Contains(t, resp.Warnings, "no alert channels configured, alerts will only be recorded")
+}
+
+func TestDryRunMonitor_Invalid(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	for _, body := range []string{
+		`not json`,
+		`{"spec": {"alerting": {"routing": {"timezone": "Mars/Olympus"}}}}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors/dry-run", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.DryRunMonitor(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+}
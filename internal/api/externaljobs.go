@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=externaljobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=externaljobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=externaljobs/finalizers,verbs=update
+
+// Ping events accepted by the inbound ping endpoint
+const (
+	pingEventStart   = "start"
+	pingEventSuccess = "success"
+	pingEventFail    = "fail"
+)
+
+// defaultPingMaxBodyKB bounds the output stored from a ping body when
+// storage.max-log-size-kb is not set
+const defaultPingMaxBodyKB = 100
+
+// kindExternalJob marks list items that come from an ExternalJob
+const kindExternalJob = "ExternalJob"
+
+// Ping handles POST /api/v1/pings/:token/:event
+// @Summary      Report an external job run
+// @Description  Records a run of an ExternalJob (a cron job running outside Kubernetes). Send "start" when the run begins and "success" or "fail" when it ends. The request body of a success or fail ping is stored as the run's output.
+// @Tags         ExternalJobs
+// @Accept       plain
+// @Produce      json
+// @Param        token     path      string  true   "Ping token from the ExternalJob's token secret"
+// @Param        event     path      string  true   "Run event (start, success, fail)"
+// @Param        exitCode  query     int     false  "Exit code of the run"
+// @Success      200  {object}  SimpleResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /pings/{token}/{event} [post]
+func (h *Handlers) Ping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := chi.URLParam(r, "token")
+	event := chi.URLParam(r, "event")
+
+	if event != pingEventStart && event != pingEventSuccess && event != pingEventFail {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Unknown ping event %q, expected start, success or fail", event))
+		return
+	}
+
+	var exitCode int32
+	if v := r.URL.Query().Get("exitCode"); v != "" {
+		code, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "exitCode must be an integer")
+			return
+		}
+		exitCode = int32(code)
+	}
+
+	job, err := h.findExternalJobByToken(ctx, token)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if job == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "No ExternalJob matches this ping token")
+		return
+	}
+
+	now := time.Now()
+	if event == pingEventStart {
+		job.Status.LastStartTime = &metav1.Time{Time: now}
+		job.Status.Running = true
+		if err := h.client.Status().Update(ctx, job); err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to update ExternalJob status: %s", err))
+			return
+		}
+		writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: "Run started"})
+		return
+	}
+
+	maxBodyKB := defaultPingMaxBodyKB
+	if h.config != nil && h.config.Storage.MaxLogSizeKB > 0 {
+		maxBodyKB = h.config.Storage.MaxLogSizeKB
+	}
+	body, _ := io.ReadAll(io.LimitReader(r.Body, int64(maxBodyKB)*1024))
+
+	succeeded := event == pingEventSuccess
+	exec := store.Execution{
+		CronJobNamespace: job.Namespace,
+		CronJobName:      job.Name,
+		CronJobUID:       string(job.UID),
+		JobName:          fmt.Sprintf("%s-%d", job.Name, now.Unix()),
+		StartTime:        now,
+		CompletionTime:   now,
+		Succeeded:        succeeded,
+		ExitCode:         exitCode,
+	}
+	if job.Status.Running && job.Status.LastStartTime != nil {
+		exec.StartTime = job.Status.LastStartTime.Time
+	}
+	exec.SetDuration(exec.CompletionTime.Sub(exec.StartTime))
+	if !succeeded {
+		exec.Reason = "PingFailed"
+	}
+	if len(body) > 0 {
+		logs := string(body)
+		exec.Logs = &logs
+	}
+
+	if h.store != nil {
+		if err := h.store.RecordExecution(ctx, exec); err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to record execution: %s", err))
+			return
+		}
+	}
+
+	job.Status.Running = false
+	if succeeded {
+		job.Status.LastSuccessTime = &metav1.Time{Time: now}
+	} else {
+		job.Status.LastFailureTime = &metav1.Time{Time: now}
+	}
+	if err := h.client.Status().Update(ctx, job); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to update ExternalJob status: %s", err))
+		return
+	}
+
+	h.alertExternalJobRun(ctx, job, exec)
+
+	writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: "Run recorded"})
+}
+
+// findExternalJobByToken returns the ExternalJob whose token secret holds token,
+// or nil if none does
+func (h *Handlers) findExternalJobByToken(ctx context.Context, token string) (*guardianv1alpha1.ExternalJob, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	jobs := &guardianv1alpha1.ExternalJobList{}
+	if err := h.client.List(ctx, jobs); err != nil {
+		return nil, fmt.Errorf("failed to list ExternalJobs: %w", err)
+	}
+
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		ref := job.Spec.TokenSecretRef
+		secret := &corev1.Secret{}
+		if err := h.client.Get(ctx, types.NamespacedName{Namespace: job.Namespace, Name: ref.Name}, secret); err != nil {
+			continue
+		}
+		expected := secret.Data[ref.Key]
+		if len(expected) > 0 && subtle.ConstantTimeCompare(expected, []byte(token)) == 1 {
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+// alertExternalJobRun sends a JobFailed alert for a failed run and clears
+// JobFailed and DeadManTriggered alerts after a successful one
+func (h *Handlers) alertExternalJobRun(ctx context.Context, job *guardianv1alpha1.ExternalJob, exec store.Execution) {
+	if h.alertDispatcher == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	ref := types.NamespacedName{Namespace: job.Namespace, Name: job.Name}
+
+	if exec.Succeeded {
+		for _, alertType := range []string{"JobFailed", "DeadManTriggered"} {
+			_ = h.alertDispatcher.ClearAlert(ctx, fmt.Sprintf("%s/%s/%s", ref.Namespace, ref.Name, alertType))
+			if h.store != nil {
+				_ = h.store.ResolveAlert(ctx, alertType, ref.Namespace, ref.Name)
+			}
+		}
+		return
+	}
+
+	severity := job.Spec.Alerting.SeverityFor("JobFailed", "critical")
+
+	alertCtx := alerting.AlertContext{ExitCode: exec.ExitCode, Reason: exec.Reason}
+	if exec.Logs != nil {
+		alertCtx.Logs = *exec.Logs
+	}
+
+	alert := alerting.Alert{
+		Type:      "JobFailed",
+		Severity:  severity,
+		Title:     fmt.Sprintf("External job %s/%s failed", ref.Namespace, ref.Name),
+		Message:   fmt.Sprintf("External job %s/%s reported a failed run (exit code %d)", ref.Namespace, ref.Name, exec.ExitCode),
+		CronJob:   ref,
+		Context:   alertCtx,
+		Timestamp: time.Now(),
+	}
+	if err := h.alertDispatcher.Dispatch(ctx, alert, job.Spec.Alerting); err != nil {
+		logger.Error(err, "failed to dispatch external job failure alert", "externalJob", ref.String())
+	}
+}
+
+// externalJobListItems returns dashboard list items for ExternalJobs matching
+// the namespace, label, status and search filters of ListCronJobs
+func (h *Handlers) externalJobListItems(ctx context.Context, filter cronJobListFilter) []CronJobListItem {
+	jobs := &guardianv1alpha1.ExternalJobList{}
+	opts := []client.ListOption{}
+	if filter.namespace != "" {
+		opts = append(opts, client.InNamespace(filter.namespace))
+	}
+	if filter.selector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: filter.selector})
+	}
+	if err := h.client.List(ctx, jobs, opts...); err != nil {
+		// The ExternalJob CRD is optional for the CronJob list
+		log.FromContext(ctx).V(1).Info("failed to list ExternalJobs", "error", err.Error())
+		return nil
+	}
+
+	items := make([]CronJobListItem, 0, len(jobs.Items))
+	for _, job := range jobs.Items {
+		if !filter.inNamespaces(job.Namespace) {
+			continue
+		}
+		status := externalJobStatus(&job)
+		if filter.status != "" && status != filter.status {
+			continue
+		}
+		if filter.search != "" && !strings.Contains(strings.ToLower(job.Name), strings.ToLower(filter.search)) {
+			continue
+		}
+
+		item := CronJobListItem{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			Kind:      kindExternalJob,
+			Status:    status,
+			Schedule:  job.Spec.Schedule,
+			Timezone:  job.Spec.Timezone,
+			Team:      h.teamFor(job.Namespace, job.Labels),
+		}
+		if job.Status.LastSuccessTime != nil {
+			t := job.Status.LastSuccessTime.Time
+			item.LastSuccess = &t
+		}
+		if h.store != nil {
+			if rate, err := h.store.GetSuccessRate(ctx, types.NamespacedName{Namespace: job.Namespace, Name: job.Name}, 7); err == nil {
+				item.SuccessRate = rate
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// externalJobStatus is critical when the most recent run failed, healthy otherwise
+func externalJobStatus(job *guardianv1alpha1.ExternalJob) string {
+	if job.Status.LastFailureTime == nil {
+		return "healthy"
+	}
+	if job.Status.LastSuccessTime == nil || job.Status.LastFailureTime.After(job.Status.LastSuccessTime.Time) {
+		return "critical"
+	}
+	return "healthy"
+}
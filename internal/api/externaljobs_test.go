@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func newTestExternalJobClient() client.Client {
+	job := &guardianv1alpha1.ExternalJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-backup", Namespace: "default"},
+		Spec: guardianv1alpha1.ExternalJobSpec{
+			Schedule: "0 2 * * *",
+			TokenSecretRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "backup-ping"},
+				Key:                  "token",
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup-ping", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(job, secret).
+		WithStatusSubresource(job).
+		Build()
+}
+
+func sendPing(h *Handlers, token, event, query, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/pings/"+token+"/"+event+query, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	chiRouterWithParams(h.Ping, map[string]string{"token": token, "event": event}).ServeHTTP(w, req)
+	return w
+}
+
+func TestPing_RecordsRun(t *testing.T) {
+	fakeClient := newTestExternalJobClient()
+	mockStore := &testutil.MockStore{}
+	mockDispatcher := testutil.NewMockDispatcher()
+	h := newTestHandlers(fakeClient, mockStore, nil, mockDispatcher)
+	key := types.NamespacedName{Namespace: "default", Name: "nightly-backup"}
+
+	w := sendPing(h, "s3cr3t", "start", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var job guardianv1alpha1.ExternalJob
+	require.NoError(t, fakeClient.Get(context.Background(), key, &job))
+	assert.True(t, job.Status.Running)
+	require.NotNil(t, job.Status.LastStartTime)
+
+	w = sendPing(h, "s3cr3t", "fail", "?exitCode=3", "disk full")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.Len(t, mockStore.RecordedExecutions, 1)
+	exec := mockStore.RecordedExecutions[0]
+	assert.Equal(t, "default", exec.CronJobNamespace)
+	assert.Equal(t, "nightly-backup", exec.CronJobName)
+	assert.False(t, exec.Succeeded)
+	assert.Equal(t, int32(3), exec.ExitCode)
+	require.NotNil(t, exec.Logs)
+	assert.Equal(t, "disk full", *exec.Logs)
+	assert.WithinDuration(t, job.Status.LastStartTime.Time, exec.StartTime, time.Second)
+
+	require.NoError(t, fakeClient.Get(context.Background(), key, &job))
+	assert.False(t, job.Status.Running)
+	assert.NotNil(t, job.Status.LastFailureTime)
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "JobFailed", mockDispatcher.DispatchedAlerts[0].Type)
+	assert.Equal(t, key, mockDispatcher.DispatchedAlerts[0].CronJob)
+
+	w = sendPing(h, "s3cr3t", "success", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, mockStore.RecordedExecutions, 2)
+	assert.True(t, mockStore.RecordedExecutions[1].Succeeded)
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/nightly-backup/JobFailed")
+}
+
+func TestPing_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		event    string
+		query    string
+		wantCode int
+	}{
+		{name: "unknown token", token: "wrong", event: "success", wantCode: http.StatusNotFound},
+		{name: "unknown event", token: "s3cr3t", event: "finish", wantCode: http.StatusBadRequest},
+		{name: "invalid exit code", token: "s3cr3t", event: "fail", query: "?exitCode=abc", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := &testutil.MockStore{}
+			h := newTestHandlers(newTestExternalJobClient(), mockStore, nil, nil)
+
+			w := sendPing(h, tt.token, tt.event, tt.query, "")
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Empty(t, mockStore.RecordedExecutions)
+		})
+	}
+}
+
+func TestListCronJobs_IncludesExternalJobs(t *testing.T) {
+	h := newTestHandlers(newTestExternalJobClient(), nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs", nil)
+	w := httptest.NewRecorder()
+	h.ListCronJobs(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp CronJobListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "nightly-backup", resp.Items[0].Name)
+	assert.Equal(t, "ExternalJob", resp.Items[0].Kind)
+	assert.Equal(t, "0 2 * * *", resp.Items[0].Schedule)
+	assert.Equal(t, int32(1), resp.Summary.Healthy)
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/grafana"
+)
+
+// GetGrafanaDashboard handles GET /api/v1/integrations/grafana/dashboard
+// @Summary      Get a Grafana dashboard
+// @Description  Returns Grafana dashboard JSON with success rate, duration and alert panels for every CronJob currently monitored, and an annotation query for guardian's alert annotations. Import it in Grafana or post it to /api/dashboards/db.
+// @Tags         Integrations
+// @Produce      json
+// @Param        namespace  query     string  false  "Only include CronJobs in this namespace"
+// @Success      200  {object}  grafana.Dashboard
+// @Failure      500  {object}  ErrorResponse
+// @Router       /integrations/grafana/dashboard [get]
+func (h *Handlers) GetGrafanaDashboard(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(r.Context(), monitors); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	seen := make(map[types.NamespacedName]bool)
+	var cronJobs []types.NamespacedName
+	for _, m := range monitors.Items {
+		for _, cj := range m.Status.CronJobs {
+			ref := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
+			if (namespace != "" && ref.Namespace != namespace) || seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			cronJobs = append(cronJobs, ref)
+		}
+	}
+
+	opts := grafana.DashboardOptions{}
+	if h.config != nil {
+		opts.UID = h.config.Grafana.DashboardUID
+		opts.Tags = h.config.Grafana.Tags
+	}
+	writeJSON(w, http.StatusOK, grafana.BuildDashboard(opts, cronJobs))
+}
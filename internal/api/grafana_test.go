@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/grafana"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetGrafanaDashboard(t *testing.T) {
+	monitor := func(name string, cronJobs ...guardianv1alpha1.CronJobStatus) *guardianv1alpha1.CronJobMonitor {
+		return &guardianv1alpha1.CronJobMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     guardianv1alpha1.CronJobMonitorStatus{CronJobs: cronJobs},
+		}
+	}
+	c := newTestAPIClient(
+		monitor("all",
+			guardianv1alpha1.CronJobStatus{Namespace: "default", Name: "backup"},
+			guardianv1alpha1.CronJobStatus{Namespace: "prod", Name: "report"},
+		),
+		// CronJobs matched by several monitors get one row
+		monitor("backups", guardianv1alpha1.CronJobStatus{Namespace: "default", Name: "backup"}),
+	)
+	cfg := &config.Config{Grafana: config.GrafanaConfig{DashboardUID: "guardian"}}
+	h := newTestHandlers(c, &testutil.MockStore{}, cfg, nil)
+
+	rows := func(url string) []string {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		h.GetGrafanaDashboard(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var d grafana.Dashboard
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&d))
+		assert.Equal(t, "guardian", d.UID)
+
+		var titles []string
+		for _, p := range d.Panels {
+			if p.Type == "row" {
+				titles = append(titles, p.Title)
+			}
+		}
+		return titles
+	}
+
+	assert.Equal(t, []string{"Overview", "default/backup", "prod/report"}, rows("/api/v1/integrations/grafana/dashboard"))
+	assert.Equal(t, []string{"Overview", "prod/report"}, rows("/api/v1/integrations/grafana/dashboard?namespace=prod"))
+}
@@ -2,26 +2,36 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/logging"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/scheduler"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
@@ -42,6 +52,12 @@ type Handlers struct {
 	leaderElectionCheck func() bool
 	analyzerEnabled     bool
 	schedulersRunning   []string
+	schedulers          map[string]LastRunReporter
+	certWatchers        map[string]CertificateProvider
+	gatherer            prometheus.Gatherer
+	clusters            map[string]ClusterBackend // Remote clusters by name
+	cluster             string                    // Remote cluster a request is served for, see inCluster
+	logLevels           *logging.Levels
 }
 
 // NewHandlers creates a new Handlers instance
@@ -54,6 +70,7 @@ func NewHandlers(c client.Client, cs *kubernetes.Clientset, s store.Store, cfg *
 		alertDispatcher:     ad,
 		startTime:           startTime,
 		leaderElectionCheck: leaderCheck,
+		gatherer:            ctrlmetrics.Registry,
 	}
 }
 
@@ -121,6 +138,9 @@ func (h *Handlers) GetHealth(w http.ResponseWriter, r *http.Request) {
 		AnalyzerEnabled:   h.analyzerEnabled,
 		SchedulersRunning: h.schedulersRunning,
 	}
+	if h.config != nil && h.config.ShardCount > 1 {
+		resp.Shard = fmt.Sprintf("%d/%d", h.config.ShardIndex, h.config.ShardCount)
+	}
 
 	writeJSON(w, http.StatusOK, resp)
 }
@@ -207,6 +227,10 @@ func (h *Handlers) ListMonitors(w http.ResponseWriter, r *http.Request) {
 			Name:      m.Name,
 			Namespace: m.Namespace,
 			Phase:     m.Status.Phase,
+			Mode:      guardianv1alpha1.MonitorModeEnforce,
+		}
+		if m.IsObserveOnly() {
+			item.Mode = guardianv1alpha1.MonitorModeObserve
 		}
 
 		if m.Status.Summary != nil {
@@ -226,6 +250,11 @@ func (h *Handlers) ListMonitors(w http.ResponseWriter, r *http.Request) {
 			item.LastReconcile = &t
 		}
 
+		if m.IsSilenced(time.Now()) {
+			t := m.Spec.SilencedUntil.Time
+			item.SilencedUntil = &t
+		}
+
 		items = append(items, item)
 	}
 
@@ -261,31 +290,197 @@ func (h *Handlers) GetMonitor(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, monitor)
 }
 
+// SilenceMonitor handles POST /api/v1/monitors/:namespace/:name/silence
+// @Summary      Silence monitor
+// @Description  Silences all alerts of a monitor for the given duration. The silence expires on its own.
+// @Tags         Monitors
+// @Produce      json
+// @Param        namespace  path      string  true  "Monitor namespace"
+// @Param        name       path      string  true  "Monitor name"
+// @Param        duration   query     string  true  "How long to silence the monitor (e.g. 2h)"
+// @Success      200  {object}  SilenceResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/{namespace}/{name}/silence [post]
+func (h *Handlers) SilenceMonitor(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	d, err := time.ParseDuration(r.URL.Query().Get("duration"))
+	if err != nil || d <= 0 {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "duration must be a positive duration (e.g. 2h)")
+		return
+	}
+
+	until := time.Now().Add(d).Truncate(time.Second)
+	if err := h.setMonitorSilence(r.Context(), namespace, name, &metav1.Time{Time: until}); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Monitor %s/%s not found", namespace, name))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	writeJSON(
+		w, http.StatusOK, SilenceResponse{
+			Success:       true,
+			Message:       fmt.Sprintf("Monitor silenced until %s", until.UTC().Format(time.RFC3339)),
+			SilencedUntil: &until,
+		},
+	)
+}
+
+// UnsilenceMonitor handles DELETE /api/v1/monitors/:namespace/:name/silence
+// @Summary      Unsilence monitor
+// @Description  Removes a monitor's silence so its alerts are sent again
+// @Tags         Monitors
+// @Produce      json
+// @Param        namespace  path      string  true  "Monitor namespace"
+// @Param        name       path      string  true  "Monitor name"
+// @Success      200  {object}  SilenceResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/{namespace}/{name}/silence [delete]
+func (h *Handlers) UnsilenceMonitor(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	if err := h.setMonitorSilence(r.Context(), namespace, name, nil); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Monitor %s/%s not found", namespace, name))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	writeJSON(
+		w, http.StatusOK, SilenceResponse{
+			Success: true,
+			Message: "Monitor unsilenced",
+		},
+	)
+}
+
+// setMonitorSilence sets or clears (until == nil) a monitor's silence
+func (h *Handlers) setMonitorSilence(ctx context.Context, namespace, name string, until *metav1.Time) error {
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, monitor); err != nil {
+		return err
+	}
+
+	monitor.Spec.SilencedUntil = until
+	if err := h.client.Update(ctx, monitor); err != nil {
+		return fmt.Errorf("failed to update monitor: %w", err)
+	}
+	return nil
+}
+
 // ListCronJobs handles GET /api/v1/cronjobs
 // @Summary      List CronJobs
 // @Description  Returns all monitored CronJobs with their status
 // @Tags         CronJobs
 // @Produce      json
 // @Param        namespace  query     string  false  "Filter by namespace"
-// @Param        status     query     string  false  "Filter by status (healthy, warning, critical)"
+// @Param        status     query     string  false  "Filter by status (healthy, warning, critical, suspended)"
+// @Param        suspended  query     bool    false  "Only include suspended (true) or unsuspended (false) CronJobs"
+// @Param        excludeIntentionallySuspended  query  bool  false  "Hide CronJobs on their monitor's intentionally suspended list"
+// @Param        team       query     string  false  "Filter by owning team (unassigned for CronJobs without one)"
+// @Param        sortBy     query     string  false  "Sort by name, namespace, status, successRate, lastSuccess or nextRun"
+// @Param        order      query     string  false  "Sort order, asc (default) or desc"
+// @Param        view       query     string  false  "Apply a saved view; other query parameters override its filters"
 // @Success      200  {object}  CronJobListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /cronjobs [get]
 func (h *Handlers) ListCronJobs(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	namespace := r.URL.Query().Get("namespace")
-	statusFilter := r.URL.Query().Get("status")
-	search := r.URL.Query().Get("search")
+	filter, err := parseCronJobListFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	filter.team = r.URL.Query().Get("team")
 
+	if name := r.URL.Query().Get("view"); name != "" {
+		if h.store == nil {
+			writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+			return
+		}
+		view, err := h.store.GetView(r.Context(), name)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		if view == nil {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("View %s not found", name))
+			return
+		}
+		if filter, err = applyView(filter, view); err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+	}
+
+	resp, err := h.listCronJobs(r.Context(), filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// cronJobListFilter selects the CronJobs returned by listCronJobs
+type cronJobListFilter struct {
+	namespace          string
+	status             string
+	search             string
+	team               string // Owning team, unassignedTeam for CronJobs without one
+	suspended          *bool
+	excludeIntentional bool
+	namespaces         []string        // Any of these namespaces, set by a saved view
+	selector           labels.Selector // CronJob labels, set by a saved view
+	sortBy             string
+	sortDesc           bool
+}
+
+// parseCronJobListFilter reads the CronJob list filters from the query string
+func parseCronJobListFilter(r *http.Request) (cronJobListFilter, error) {
+	q := r.URL.Query()
+	filter := cronJobListFilter{
+		namespace:          q.Get("namespace"),
+		status:             q.Get("status"),
+		search:             q.Get("search"),
+		excludeIntentional: q.Get("excludeIntentionallySuspended") == "true",
+		sortBy:             q.Get("sortBy"),
+		sortDesc:           q.Get("order") == "desc",
+	}
+	if filter.sortBy != "" && !slices.Contains(cronJobSortKeys, filter.sortBy) {
+		return filter, fmt.Errorf("sortBy must be one of %s", strings.Join(cronJobSortKeys, ", "))
+	}
+	if v := q.Get("suspended"); v != "" {
+		suspended, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.New("suspended must be true or false")
+		}
+		filter.suspended = &suspended
+	}
+	return filter, nil
+}
+
+// listCronJobs returns the monitored CronJobs and ExternalJobs matching the
+// filter, with summary counts over the matching ones
+func (h *Handlers) listCronJobs(ctx context.Context, filter cronJobListFilter) (CronJobListResponse, error) {
 	monitors := &guardianv1alpha1.CronJobMonitorList{}
 	opts := []client.ListOption{}
-	if namespace != "" {
-		opts = append(opts, client.InNamespace(namespace))
+	if filter.namespace != "" {
+		opts = append(opts, client.InNamespace(filter.namespace))
 	}
 
 	if err := h.client.List(ctx, monitors, opts...); err != nil {
-		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
-		return
+		return CronJobListResponse{}, err
 	}
 
 	seen := make(map[string]struct{})
@@ -300,23 +495,37 @@ func (h *Handlers) ListCronJobs(w http.ResponseWriter, r *http.Request) {
 			}
 			seen[key] = struct{}{}
 
-			if statusFilter != "" && cjStatus.Status != statusFilter {
+			if !filter.inNamespaces(cjStatus.Namespace) {
+				continue
+			}
+			if filter.status != "" && cjStatus.Status != filter.status {
+				continue
+			}
+			if filter.search != "" && !strings.Contains(strings.ToLower(cjStatus.Name), strings.ToLower(filter.search)) {
 				continue
 			}
-			if search != "" && !strings.Contains(strings.ToLower(cjStatus.Name), strings.ToLower(search)) {
+			if filter.suspended != nil && cjStatus.Suspended != *filter.suspended {
+				continue
+			}
+			if filter.excludeIntentional && cjStatus.IntentionallySuspended {
 				continue
 			}
 
 			cj := &batchv1.CronJob{}
 			err := h.client.Get(ctx, types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}, cj)
+			if filter.selector != nil && (err != nil || !filter.selector.Matches(labels.Set(cj.Labels))) {
+				continue
+			}
 
 			item := CronJobListItem{
-				Name:         cjStatus.Name,
-				Namespace:    cjStatus.Namespace,
-				Status:       cjStatus.Status,
-				Suspended:    cjStatus.Suspended,
-				ActiveAlerts: len(cjStatus.ActiveAlerts),
-				MonitorRef:   &NamespacedRef{Namespace: m.Namespace, Name: m.Name},
+				Cluster:                h.cluster,
+				Name:                   cjStatus.Name,
+				Namespace:              cjStatus.Namespace,
+				Status:                 cjStatus.Status,
+				Suspended:              cjStatus.Suspended,
+				IntentionallySuspended: cjStatus.IntentionallySuspended,
+				ActiveAlerts:           len(cjStatus.ActiveAlerts),
+				MonitorRef:             &NamespacedRef{Namespace: m.Namespace, Name: m.Name},
 			}
 
 			if err == nil {
@@ -324,6 +533,12 @@ func (h *Handlers) ListCronJobs(w http.ResponseWriter, r *http.Request) {
 				if cj.Spec.TimeZone != nil {
 					item.Timezone = *cj.Spec.TimeZone
 				}
+				item.Team = h.teamFor(cj.Namespace, cj.Labels)
+			} else {
+				item.Team = h.teamFor(cjStatus.Namespace, nil)
+			}
+			if !teamMatches(filter.team, item.Team) {
+				continue
 			}
 
 			if cjStatus.Metrics != nil {
@@ -380,12 +595,24 @@ func (h *Handlers) ListCronJobs(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	writeJSON(
-		w, http.StatusOK, CronJobListResponse{
-			Items:   items,
-			Summary: summary,
-		},
-	)
+	for _, item := range h.externalJobListItems(ctx, filter) {
+		if !teamMatches(filter.team, item.Team) {
+			continue
+		}
+		items = append(items, item)
+		switch item.Status {
+		case "healthy":
+			summary.Healthy++
+		case "critical":
+			summary.Critical++
+		}
+	}
+
+	sortCronJobItems(items, filter.sortBy, filter.sortDesc)
+	return CronJobListResponse{
+		Items:   items,
+		Summary: summary,
+	}, nil
 }
 
 // GetCronJob handles GET /api/v1/cronjobs/:namespace/:name
@@ -415,6 +642,7 @@ func (h *Handlers) GetCronJob(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := CronJobDetailResponse{
+		Cluster:   h.cluster,
 		Name:      cj.Name,
 		Namespace: cj.Namespace,
 		Schedule:  cj.Spec.Schedule,
@@ -444,6 +672,9 @@ func (h *Handlers) GetCronJob(w http.ResponseWriter, r *http.Request) {
 							P50DurationSeconds: cjStatus.Metrics.P50DurationSeconds,
 							P95DurationSeconds: cjStatus.Metrics.P95DurationSeconds,
 							P99DurationSeconds: cjStatus.Metrics.P99DurationSeconds,
+							Incidents7d:        cjStatus.Metrics.Incidents,
+							MTTRSeconds:        cjStatus.Metrics.MTTRSeconds,
+							MTBFSeconds:        cjStatus.Metrics.MTBFSeconds,
 						}
 					}
 
@@ -477,13 +708,7 @@ func (h *Handlers) GetCronJob(w http.ResponseWriter, r *http.Request) {
 							Message:  a.Message,
 							Since:    a.Since.Time,
 						}
-						if a.ExitCode != 0 || a.Reason != "" || a.SuggestedFix != "" {
-							item.Context = &AlertContextResponse{
-								ExitCode:     a.ExitCode,
-								Reason:       a.Reason,
-								SuggestedFix: a.SuggestedFix,
-							}
-						}
+						item.Context = alertContextResponse(a)
 						resp.ActiveAlerts = append(resp.ActiveAlerts, item)
 					}
 
@@ -602,14 +827,23 @@ func (h *Handlers) GetExecutions(w http.ResponseWriter, r *http.Request) {
 			status = statusSuccess
 		}
 		item := ExecutionItem{
-			ID:        e.ID,
-			JobName:   e.JobName,
-			Status:    status,
-			StartTime: e.StartTime,
-			Duration:  e.Duration().String(),
-			ExitCode:  e.ExitCode,
-			Reason:    e.Reason,
-			IsRetry:   e.IsRetry,
+			ID:              e.ID,
+			Cluster:         e.Cluster,
+			JobName:         e.JobName,
+			Status:          status,
+			StartTime:       e.StartTime,
+			Duration:        e.Duration().String(),
+			ExitCode:        e.ExitCode,
+			Reason:          e.Reason,
+			Classification:  e.Classification,
+			IsRetry:         e.IsRetry,
+			Backfilled:      e.Backfilled,
+			Synthetic:       e.Synthetic,
+			CatchUp:         e.CatchUp,
+			InfraDisruption: e.InfraDisruption,
+			OutputSize:      e.OutputSize,
+			NodeName:        e.NodeName,
+			Images:          e.GetImages(),
 		}
 		if !e.CompletionTime.IsZero() {
 			item.CompletionTime = &e.CompletionTime
@@ -630,9 +864,159 @@ func (h *Handlers) GetExecutions(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// GetCronJobAnalytics handles GET /api/v1/cronjobs/:namespace/:name/analytics
+// @Summary      Get execution analytics
+// @Description  Returns the exit code histogram, failure reason breakdown, daily duration percentiles and a day-of-week/hour success heatmap for a CronJob
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true   "CronJob namespace"
+// @Param        name       path      string  true   "CronJob name"
+// @Param        days       query     int     false  "Window in days (1-365)" default(30)
+// @Success      200  {object}  AnalyticsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/analytics [get]
+func (h *Handlers) GetCronJobAnalytics(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	days := 30
+	if d := r.URL.Query().Get("days"); d != "" {
+		parsed, err := strconv.Atoi(d)
+		if err != nil || parsed < 1 || parsed > 365 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "days must be between 1 and 365")
+			return
+		}
+		days = parsed
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	analytics, err := h.store.GetExecutionAnalytics(r.Context(), types.NamespacedName{Namespace: namespace, Name: name}, days)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := AnalyticsResponse{
+		CronJob:        NamespacedRef{Namespace: namespace, Name: name},
+		WindowDays:     analytics.WindowDays,
+		ExitCodes:      make([]ExitCodeBucket, 0, len(analytics.ExitCodes)),
+		FailureReasons: make([]FailureReasonCount, 0, len(analytics.FailureReasons)),
+		DurationTrend:  make([]DurationTrendPoint, 0, len(analytics.DurationTrend)),
+		Heatmap:        make([]HeatmapCell, 0, len(analytics.Heatmap)),
+	}
+	for _, c := range analytics.ExitCodes {
+		resp.ExitCodes = append(resp.ExitCodes, ExitCodeBucket{ExitCode: c.ExitCode, Count: c.Count})
+	}
+	for _, rc := range analytics.FailureReasons {
+		resp.FailureReasons = append(resp.FailureReasons, FailureReasonCount{Reason: rc.Reason, Count: rc.Count})
+	}
+	for _, d := range analytics.DurationTrend {
+		resp.DurationTrend = append(resp.DurationTrend, DurationTrendPoint{
+			Date:       d.Day,
+			Runs:       d.Runs,
+			P50Seconds: d.P50Seconds,
+			P95Seconds: d.P95Seconds,
+		})
+	}
+	for _, c := range analytics.Heatmap {
+		cell := HeatmapCell{DayOfWeek: c.DayOfWeek, Hour: c.Hour, Total: c.Total, Succeeded: c.Succeeded}
+		if c.Total > 0 {
+			cell.SuccessRate = float64(c.Succeeded) / float64(c.Total) * 100
+		}
+		resp.Heatmap = append(resp.Heatmap, cell)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetCorrelations handles GET /api/v1/correlations
+// @Summary      Get correlated failures
+// @Description  Groups recent failures across CronJobs that happened close together and share a namespace, failure reason or nothing but timing, pointing at a likely common cause
+// @Tags         CronJobs
+// @Produce      json
+// @Param        lookback     query     string  false  "How far back to look (Go duration)" default(1h)
+// @Param        window       query     string  false  "Largest gap between failures in one group (Go duration)" default(10m)
+// @Param        minCronJobs  query     int     false  "Minimum distinct CronJobs per group" default(3)
+// @Success      200  {object}  CorrelationReportResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /correlations [get]
+func (h *Handlers) GetCorrelations(w http.ResponseWriter, r *http.Request) {
+	var opts analyzer.CorrelationOptions
+	q := r.URL.Query()
+
+	if l := q.Get("lookback"); l != "" {
+		d, err := time.ParseDuration(l)
+		if err != nil || d <= 0 || d > 7*24*time.Hour {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "lookback must be a duration between 0 and 168h")
+			return
+		}
+		opts.Lookback = d
+	}
+	if wd := q.Get("window"); wd != "" {
+		d, err := time.ParseDuration(wd)
+		if err != nil || d <= 0 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "window must be a positive duration")
+			return
+		}
+		opts.Window = d
+	}
+	if m := q.Get("minCronJobs"); m != "" {
+		n, err := strconv.Atoi(m)
+		if err != nil || n < 2 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "minCronJobs must be at least 2")
+			return
+		}
+		opts.MinCronJobs = n
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	report, err := analyzer.NewFailureCorrelator(h.store).Correlate(r.Context(), opts)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := CorrelationReportResponse{
+		GeneratedAt:   report.GeneratedAt,
+		Lookback:      report.Lookback.String(),
+		Window:        report.Window.String(),
+		TotalFailures: report.TotalFailures,
+		Groups:        make([]CorrelationGroupItem, 0, len(report.Groups)),
+	}
+	for _, g := range report.Groups {
+		item := CorrelationGroupItem{
+			Dimension:    g.Dimension,
+			Value:        g.Value,
+			CronJobs:     make([]NamespacedRef, 0, len(g.CronJobs)),
+			Failures:     g.Failures,
+			FirstFailure: g.FirstFailure,
+			LastFailure:  g.LastFailure,
+			LikelyCause:  g.LikelyCause,
+		}
+		for _, cj := range g.CronJobs {
+			item.CronJobs = append(item.CronJobs, NamespacedRef{Namespace: cj.Namespace, Name: cj.Name})
+		}
+		resp.Groups = append(resp.Groups, item)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
 // GetLogs handles GET /api/v1/cronjobs/:namespace/:name/executions/:jobName/logs
 // @Summary      Get execution logs
-// @Description  Returns container logs from a job execution
+// @Description  Returns container logs from a job execution. Falls back to stored logs once the pods are gone.
 // @Tags         CronJobs
 // @Produce      json
 // @Param        namespace  path      string  true  "CronJob namespace"
@@ -667,6 +1051,11 @@ func (h *Handlers) GetLogs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if len(pods.Items) == 0 {
+		// Pods are gone: fall back to logs captured at completion
+		if logs, ok := h.storedLogs(ctx, namespace, jobName); ok {
+			writeJSON(w, http.StatusOK, LogsResponse{JobName: jobName, Logs: logs})
+			return
+		}
 		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No pods found for job %s", jobName))
 		return
 	}
@@ -721,13 +1110,12 @@ func (h *Handlers) GetLogs(w http.ResponseWriter, r *http.Request) {
 // @Failure      500  {object}  ErrorResponse
 // @Router       /cronjobs/{namespace}/{name}/trigger [post]
 func (h *Handlers) TriggerCronJob(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 	namespace := chi.URLParam(r, "namespace")
 	name := chi.URLParam(r, "name")
 
-	cj := &batchv1.CronJob{}
-	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cj); err != nil {
-		if client.IgnoreNotFound(err) == nil {
+	jobName, err := h.triggerCronJob(r.Context(), namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
 			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s/%s not found", namespace, name))
 			return
 		}
@@ -735,6 +1123,22 @@ func (h *Handlers) TriggerCronJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	writeJSON(
+		w, http.StatusOK, TriggerResponse{
+			Success: true,
+			JobName: jobName,
+			Message: "Job created successfully",
+		},
+	)
+}
+
+// triggerCronJob creates a Job from a CronJob's template and returns its name
+func (h *Handlers) triggerCronJob(ctx context.Context, namespace, name string) (string, error) {
+	cj := &batchv1.CronJob{}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cj); err != nil {
+		return "", err
+	}
+
 	jobName := fmt.Sprintf("%s-manual-%d", name, time.Now().Unix())
 	if len(jobName) > 63 {
 		jobName = jobName[:63]
@@ -753,17 +1157,9 @@ func (h *Handlers) TriggerCronJob(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.client.Create(ctx, job); err != nil {
-		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to create job: %v", err))
-		return
+		return "", fmt.Errorf("failed to create job: %w", err)
 	}
-
-	writeJSON(
-		w, http.StatusOK, TriggerResponse{
-			Success: true,
-			JobName: jobName,
-			Message: "Job created successfully",
-		},
-	)
+	return jobName, nil
 }
 
 // SuspendCronJob handles POST /api/v1/cronjobs/:namespace/:name/suspend
@@ -778,13 +1174,11 @@ func (h *Handlers) TriggerCronJob(w http.ResponseWriter, r *http.Request) {
 // @Failure      500  {object}  ErrorResponse
 // @Router       /cronjobs/{namespace}/{name}/suspend [post]
 func (h *Handlers) SuspendCronJob(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 	namespace := chi.URLParam(r, "namespace")
 	name := chi.URLParam(r, "name")
 
-	cj := &batchv1.CronJob{}
-	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cj); err != nil {
-		if client.IgnoreNotFound(err) == nil {
+	if err := h.setCronJobSuspend(r.Context(), namespace, name, true); err != nil {
+		if apierrors.IsNotFound(err) {
 			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s/%s not found", namespace, name))
 			return
 		}
@@ -792,12 +1186,6 @@ func (h *Handlers) SuspendCronJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cj.Spec.Suspend = ptr.To(true)
-	if err := h.client.Update(ctx, cj); err != nil {
-		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to suspend: %v", err))
-		return
-	}
-
 	writeJSON(
 		w, http.StatusOK, SimpleResponse{
 			Success: true,
@@ -818,13 +1206,11 @@ func (h *Handlers) SuspendCronJob(w http.ResponseWriter, r *http.Request) {
 // @Failure      500  {object}  ErrorResponse
 // @Router       /cronjobs/{namespace}/{name}/resume [post]
 func (h *Handlers) ResumeCronJob(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 	namespace := chi.URLParam(r, "namespace")
 	name := chi.URLParam(r, "name")
 
-	cj := &batchv1.CronJob{}
-	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cj); err != nil {
-		if client.IgnoreNotFound(err) == nil {
+	if err := h.setCronJobSuspend(r.Context(), namespace, name, false); err != nil {
+		if apierrors.IsNotFound(err) {
 			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s/%s not found", namespace, name))
 			return
 		}
@@ -832,12 +1218,6 @@ func (h *Handlers) ResumeCronJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cj.Spec.Suspend = ptr.To(false)
-	if err := h.client.Update(ctx, cj); err != nil {
-		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to resume: %v", err))
-		return
-	}
-
 	writeJSON(
 		w, http.StatusOK, SimpleResponse{
 			Success: true,
@@ -846,6 +1226,23 @@ func (h *Handlers) ResumeCronJob(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// setCronJobSuspend suspends or resumes a CronJob
+func (h *Handlers) setCronJobSuspend(ctx context.Context, namespace, name string, suspend bool) error {
+	cj := &batchv1.CronJob{}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cj); err != nil {
+		return err
+	}
+
+	cj.Spec.Suspend = ptr.To(suspend)
+	if err := h.client.Update(ctx, cj); err != nil {
+		if suspend {
+			return fmt.Errorf("failed to suspend: %w", err)
+		}
+		return fmt.Errorf("failed to resume: %w", err)
+	}
+	return nil
+}
+
 // ListAlerts handles GET /api/v1/alerts
 // @Summary      List active alerts
 // @Description  Returns all active alerts across all monitored CronJobs
@@ -891,26 +1288,24 @@ func (h *Handlers) ListAlerts(w http.ResponseWriter, r *http.Request) {
 				alertID := fmt.Sprintf("%s-%s-%s", cjStatus.Namespace, cjStatus.Name, a.Type)
 
 				item := AlertItem{
-					ID:       alertID,
-					Type:     a.Type,
-					Severity: a.Severity,
-					Title:    fmt.Sprintf("%s: %s/%s", a.Type, cjStatus.Namespace, cjStatus.Name),
-					Message:  a.Message,
-					CronJob:  &NamespacedRef{Namespace: cjStatus.Namespace, Name: cjStatus.Name},
-					Monitor:  &NamespacedRef{Namespace: m.Namespace, Name: m.Name},
-					Since:    a.Since.Time,
+					ID:         alertID,
+					Type:       a.Type,
+					Severity:   a.Severity,
+					Title:      fmt.Sprintf("%s: %s/%s", a.Type, cjStatus.Namespace, cjStatus.Name),
+					Message:    a.Message,
+					CronJob:    &NamespacedRef{Namespace: cjStatus.Namespace, Name: cjStatus.Name},
+					Monitor:    &NamespacedRef{Namespace: m.Namespace, Name: m.Name},
+					Since:      a.Since.Time,
+					RunbookURL: a.RunbookURL,
 				}
 				if a.LastNotified != nil {
 					t := a.LastNotified.Time
 					item.LastNotified = &t
 				}
-				if a.ExitCode != 0 || a.Reason != "" || a.SuggestedFix != "" {
-					item.Context = &AlertContextResponse{
-						ExitCode:     a.ExitCode,
-						Reason:       a.Reason,
-						SuggestedFix: a.SuggestedFix,
-					}
+				if h.alertDispatcher != nil {
+					item.AlertID = h.alertDispatcher.ActiveAlertID(fmt.Sprintf("%s/%s/%s", cjStatus.Namespace, cjStatus.Name, a.Type))
 				}
+				item.Context = alertContextResponse(a)
 
 				if existing, exists := alertMap[alertID]; exists {
 					if severityOrder[a.Severity] > severityOrder[existing.Severity] {
@@ -948,6 +1343,25 @@ func (h *Handlers) ListAlerts(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// alertContextResponse returns the failure context of an active alert, nil if
+// it has none
+func alertContextResponse(a guardianv1alpha1.ActiveAlert) *AlertContextResponse {
+	if a.ExitCode == 0 && a.Reason == "" && a.SuggestedFix == "" && a.SuccessStreakRuns == 0 {
+		return nil
+	}
+	resp := &AlertContextResponse{
+		ExitCode:          a.ExitCode,
+		Reason:            a.Reason,
+		SuggestedFix:      a.SuggestedFix,
+		SuccessStreakRuns: a.SuccessStreakRuns,
+	}
+	if a.SuccessStreakSince != nil {
+		t := a.SuccessStreakSince.Time
+		resp.SuccessStreakSince = &t
+	}
+	return resp
+}
+
 // GetAlertHistory handles GET /api/v1/alerts/history
 // @Summary      Get alert history
 // @Description  Returns paginated history of past alerts from the store
@@ -957,6 +1371,7 @@ func (h *Handlers) ListAlerts(w http.ResponseWriter, r *http.Request) {
 // @Param        offset    query     int     false  "Page offset"        default(0)
 // @Param        severity  query     string  false  "Filter by severity"
 // @Param        since     query     string  false  "Filter since timestamp (RFC3339)"
+// @Param        alertId   query     string  false  "Filter by alert tracking ID"
 // @Success      200  {object}  AlertHistoryResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /alerts/history [get]
@@ -996,6 +1411,7 @@ func (h *Handlers) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
 		Limit:    limit,
 		Offset:   offset,
 		Severity: r.URL.Query().Get("severity"),
+		AlertID:  r.URL.Query().Get("alertId"),
 	}
 
 	if s := r.URL.Query().Get("since"); s != "" {
@@ -1014,6 +1430,8 @@ func (h *Handlers) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
 	for _, a := range alerts {
 		item := AlertHistoryItem{
 			ID:               strconv.FormatInt(a.ID, 10),
+			AlertID:          a.AlertID,
+			Cluster:          a.Cluster,
 			Type:             a.Type,
 			Severity:         a.Severity,
 			Title:            a.Title,
@@ -1021,9 +1439,11 @@ func (h *Handlers) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
 			OccurredAt:       a.OccurredAt,
 			ResolvedAt:       a.ResolvedAt,
 			ChannelsNotified: a.GetChannelsNotified(),
+			JobName:          a.JobName,
 			ExitCode:         a.ExitCode,
 			Reason:           a.Reason,
 			SuggestedFix:     a.SuggestedFix,
+			RunbookURL:       a.RunbookURL,
 		}
 		if a.CronJobNamespace != "" || a.CronJobName != "" {
 			item.CronJob = &NamespacedRef{
@@ -1033,6 +1453,10 @@ func (h *Handlers) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
 		}
 		items = append(items, item)
 	}
+	if err := h.attachReceipts(ctx, items); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
 
 	writeJSON(
 		w, http.StatusOK, AlertHistoryResponse{
@@ -1047,6 +1471,107 @@ func (h *Handlers) GetAlertHistory(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// GetAlertDeliveries handles GET /api/v1/alerts/deliveries
+// @Summary      List alert deliveries
+// @Description  Returns failed alert deliveries queued for retry along with their retry state
+// @Tags         Alerts
+// @Produce      json
+// @Param        limit    query     int     false  "Page size"    default(50)
+// @Param        offset   query     int     false  "Page offset"  default(0)
+// @Param        status   query     string  false  "Filter by status"  Enums(pending, delivered, failed)
+// @Param        channel  query     string  false  "Filter by channel name"
+// @Success      200  {object}  AlertDeliveryListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /alerts/deliveries [get]
+func (h *Handlers) GetAlertDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 50
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	offset := 0
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if parsed, err := strconv.Atoi(o); err == nil && parsed >= 0 {
+			offset = parsed
+		}
+	}
+
+	if h.store == nil {
+		writeJSON(
+			w, http.StatusOK, AlertDeliveryListResponse{
+				Items:      []AlertDeliveryItem{},
+				Pagination: Pagination{Limit: limit, Offset: offset},
+			},
+		)
+		return
+	}
+
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "", store.DeliveryStatusPending, store.DeliveryStatusDelivered, store.DeliveryStatusFailed:
+	default:
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "status must be one of: pending, delivered, failed")
+		return
+	}
+
+	deliveries, total, err := h.store.ListDeliveries(
+		ctx, store.AlertDeliveryQuery{
+			Limit:       limit,
+			Offset:      offset,
+			Status:      status,
+			ChannelName: r.URL.Query().Get("channel"),
+		},
+	)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	items := make([]AlertDeliveryItem, 0, len(deliveries))
+	for _, d := range deliveries {
+		item := AlertDeliveryItem{
+			ID:          strconv.FormatInt(d.ID, 10),
+			AlertKey:    d.AlertKey,
+			Type:        d.AlertType,
+			Severity:    d.Severity,
+			Channel:     d.ChannelName,
+			Status:      d.Status,
+			Attempts:    d.Attempts,
+			LastError:   d.LastError,
+			CreatedAt:   d.CreatedAt,
+			DeliveredAt: d.DeliveredAt,
+		}
+		if d.Status == store.DeliveryStatusPending {
+			nextAttempt := d.NextAttemptAt
+			item.NextAttemptAt = &nextAttempt
+		}
+		if d.CronJobNamespace != "" || d.CronJobName != "" {
+			item.CronJob = &NamespacedRef{
+				Namespace: d.CronJobNamespace,
+				Name:      d.CronJobName,
+			}
+		}
+		items = append(items, item)
+	}
+
+	writeJSON(
+		w, http.StatusOK, AlertDeliveryListResponse{
+			Items: items,
+			Pagination: Pagination{
+				Total:   total,
+				Limit:   limit,
+				Offset:  offset,
+				HasMore: int64(offset+limit) < total,
+			},
+		},
+	)
+}
+
 // ListChannels handles GET /api/v1/channels
 // @Summary      List alert channels
 // @Description  Returns all configured alert channels with their status and stats
@@ -1235,26 +1760,12 @@ type ConfigResponse struct {
 // @Success      200  {object}  ConfigResponse
 // @Router       /config [get]
 func (h *Handlers) GetConfig(w http.ResponseWriter, _ *http.Request) {
-	if h.config == nil {
-		writeJSON(w, http.StatusOK, ConfigResponse{})
-		return
-	}
-
-	resp := ConfigResponse{
-		LogLevel:         h.config.LogLevel,
-		Storage:          h.config.Storage,
-		HistoryRetention: h.config.HistoryRetention,
-		RateLimits:       h.config.RateLimits,
-		UI:               h.config.UI,
-		Scheduler:        h.config.Scheduler,
-	}
-
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, h.redactedConfig())
 }
 
 // DeleteCronJobHistory handles DELETE /api/v1/cronjobs/:namespace/:name/history
 // @Summary      Delete CronJob history
-// @Description  Deletes all execution history for a specific CronJob
+// @Description  Deletes all execution history for a specific CronJob. It can be restored until the undo window (history-retention.undo-window) passes.
 // @Tags         CronJobs
 // @Produce      json
 // @Param        namespace  path      string  true  "CronJob namespace"
@@ -1273,18 +1784,80 @@ func (h *Handlers) DeleteCronJobHistory(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	var undoWindow time.Duration
+	if h.config != nil {
+		undoWindow = h.config.HistoryRetention.UndoWindow
+	}
+
 	cronJobNN := types.NamespacedName{Namespace: namespace, Name: name}
-	deleted, err := h.store.DeleteExecutionsByCronJob(ctx, cronJobNN)
+	if undoWindow <= 0 {
+		deleted, err := h.store.DeleteExecutionsByCronJob(ctx, cronJobNN)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		writeJSON(
+			w, http.StatusOK, DeleteHistoryResponse{
+				Success:        true,
+				RecordsDeleted: deleted,
+				Message:        fmt.Sprintf("Deleted %d execution records for %s/%s", deleted, namespace, name),
+			},
+		)
+		return
+	}
+
+	deleted, err := h.store.TrashExecutionsByCronJob(ctx, cronJobNN)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
 		return
 	}
-
+	restorableUntil := time.Now().Add(undoWindow)
 	writeJSON(
 		w, http.StatusOK, DeleteHistoryResponse{
-			Success:        true,
-			RecordsDeleted: deleted,
-			Message:        fmt.Sprintf("Deleted %d execution records for %s/%s", deleted, namespace, name),
+			Success:         true,
+			RecordsDeleted:  deleted,
+			RestorableUntil: &restorableUntil,
+			Message: fmt.Sprintf("Deleted %d execution records for %s/%s, restorable until %s",
+				deleted, namespace, name, restorableUntil.Format(time.RFC3339)),
+		},
+	)
+}
+
+// RestoreCronJobHistory handles POST /api/v1/cronjobs/:namespace/:name/history/restore
+// @Summary      Restore deleted CronJob history
+// @Description  Restores history deleted through DELETE /cronjobs/{namespace}/{name}/history within the undo window. Executions recorded since are kept.
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true  "CronJob namespace"
+// @Param        name       path      string  true  "CronJob name"
+// @Success      200  {object}  RestoreHistoryResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/history/restore [post]
+func (h *Handlers) RestoreCronJobHistory(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	restored, err := h.store.RestoreExecutionsByCronJob(r.Context(), types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if restored == 0 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No deleted history to restore for %s/%s", namespace, name))
+		return
+	}
+	writeJSON(
+		w, http.StatusOK, RestoreHistoryResponse{
+			Success:         true,
+			RecordsRestored: restored,
+			Message:         fmt.Sprintf("Restored %d execution records for %s/%s", restored, namespace, name),
 		},
 	)
 }
@@ -1319,6 +1892,12 @@ func (h *Handlers) GetStorageStats(w http.ResponseWriter, r *http.Request) {
 
 	healthy := h.store.Health(ctx) == nil
 
+	holds, err := h.legalHoldItems(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
 	writeJSON(
 		w, http.StatusOK, StorageStatsResponse{
 			ExecutionCount:    count,
@@ -1326,6 +1905,7 @@ func (h *Handlers) GetStorageStats(w http.ResponseWriter, r *http.Request) {
 			Healthy:           healthy,
 			RetentionDays:     h.config.HistoryRetention.DefaultDays,
 			LogStorageEnabled: h.config.Storage.LogStorageEnabled,
+			LegalHolds:        holds,
 		},
 	)
 }
@@ -1339,7 +1919,7 @@ type PruneRequest struct {
 
 // TriggerPrune handles POST /api/v1/admin/prune
 // @Summary      Trigger history pruning
-// @Description  Manually triggers pruning of old execution records
+// @Description  Manually triggers pruning of old execution records. CronJobs on legal hold are skipped.
 // @Tags         Admin
 // @Accept       json
 // @Produce      json
@@ -1385,12 +1965,18 @@ func (h *Handlers) TriggerPrune(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	holds, err := scheduler.LegalHolds(ctx, h.client, h.store)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	overrides := scheduler.ApplyLegalHolds(nil, holds)
+
 	var count int64
-	var err error
 	if req.PruneLogsOnly {
-		count, err = h.store.PruneLogs(ctx, cutoff)
+		count, err = h.store.PruneLogs(ctx, cutoff, overrides...)
 	} else {
-		count, err = h.store.Prune(ctx, cutoff)
+		count, err = h.store.Prune(ctx, cutoff, overrides...)
 	}
 
 	if err != nil {
@@ -1402,6 +1988,9 @@ func (h *Handlers) TriggerPrune(w http.ResponseWriter, r *http.Request) {
 	if req.PruneLogsOnly {
 		message = fmt.Sprintf("Pruned logs from %d execution records older than %d days", count, req.OlderThanDays)
 	}
+	if len(overrides) > 0 {
+		message += fmt.Sprintf(", skipping %d CronJobs on legal hold", len(overrides))
+	}
 
 	writeJSON(
 		w, http.StatusOK, PruneResponse{
@@ -1425,6 +2014,7 @@ func (h *Handlers) TriggerPrune(w http.ResponseWriter, r *http.Request) {
 // @Param        jobName    path      string  true  "Job name (execution ID)"
 // @Success      200  {object}  ExecutionDetailResponse
 // @Failure      404  {object}  ErrorResponse
+// @Failure      502  {object}  ErrorResponse
 // @Failure      503  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /cronjobs/{namespace}/{name}/executions/{jobName} [get]
@@ -1449,6 +2039,11 @@ func (h *Handlers) GetExecutionWithLogs(w http.ResponseWriter, r *http.Request)
 
 	for _, e := range executions {
 		if e.JobName == jobName {
+			if err := h.resolveLogs(ctx, &e); err != nil {
+				writeError(w, http.StatusBadGateway, "LOGS_UNAVAILABLE", err.Error())
+				return
+			}
+
 			status := statusFailed
 			if e.Succeeded {
 				status = statusSuccess
@@ -1465,8 +2060,19 @@ func (h *Handlers) GetExecutionWithLogs(w http.ResponseWriter, r *http.Request)
 				Duration:         e.Duration().String(),
 				ExitCode:         e.ExitCode,
 				Reason:           e.Reason,
+				Classification:   e.Classification,
 				IsRetry:          e.IsRetry,
 				RetryOf:          e.RetryOf,
+				Backfilled:       e.Backfilled,
+				Synthetic:        e.Synthetic,
+				CatchUp:          e.CatchUp,
+				InfraDisruption:  e.InfraDisruption,
+				OutputSize:       e.OutputSize,
+				SpecHash:         e.SpecHash,
+				NodeName:         e.NodeName,
+				Images:           e.GetImages(),
+				PodNames:         e.GetPodNames(),
+				Containers:       containerItems(e.GetContainers()),
 				StoredLogs:       ptr.Deref(e.Logs, ""),
 				StoredEvents:     ptr.Deref(e.Events, ""),
 			}
@@ -1482,6 +2088,58 @@ func (h *Handlers) GetExecutionWithLogs(w http.ResponseWriter, r *http.Request)
 	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Execution %s not found", jobName))
 }
 
+// containerItems converts container results for the API
+func containerItems(containers []store.ContainerResult) []ContainerItem {
+	items := make([]ContainerItem, 0, len(containers))
+	for _, c := range containers {
+		item := ContainerItem{
+			Name:     c.Name,
+			Init:     c.Init,
+			ExitCode: c.ExitCode,
+			Reason:   c.Reason,
+			Duration: c.Duration().String(),
+		}
+		if !c.StartedAt.IsZero() {
+			item.StartedAt = &c.StartedAt
+		}
+		if !c.FinishedAt.IsZero() {
+			item.FinishedAt = &c.FinishedAt
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// resolveLogs loads logs that were offloaded to object storage
+func (h *Handlers) resolveLogs(ctx context.Context, e *store.Execution) error {
+	if e.LogsRef == "" {
+		return nil
+	}
+	resolver, ok := h.store.(store.LogResolver)
+	if !ok {
+		return fmt.Errorf("logs are in object storage (%s) but log offload is not configured", e.LogsRef)
+	}
+	return resolver.ResolveLogs(ctx, e)
+}
+
+// storedLogs returns the logs stored for a job, if any
+func (h *Handlers) storedLogs(ctx context.Context, namespace, jobName string) (string, bool) {
+	if h.store == nil {
+		return "", false
+	}
+	e, err := h.store.GetExecutionByJobName(ctx, namespace, jobName)
+	if err != nil || e == nil {
+		return "", false
+	}
+	if err := h.resolveLogs(ctx, e); err != nil {
+		return "", false
+	}
+	if e.Logs == nil || *e.Logs == "" {
+		return "", false
+	}
+	return *e.Logs, true
+}
+
 // TestPattern handles POST /api/v1/patterns/test
 // @Summary      Test suggested fix pattern
 // @Description  Tests a suggested fix pattern against sample data to verify matching
@@ -1514,6 +2172,7 @@ func (h *Handlers) TestPattern(w http.ResponseWriter, r *http.Request) {
 		Suggestion: req.Pattern.Suggestion,
 		Priority:   req.Pattern.Priority,
 		Match: guardianv1alpha1.PatternMatch{
+			Container:     req.Pattern.Match.Container,
 			ExitCode:      req.Pattern.Match.ExitCode,
 			Reason:        req.Pattern.Match.Reason,
 			ReasonPattern: req.Pattern.Match.ReasonPattern,
@@ -1529,13 +2188,17 @@ func (h *Handlers) TestPattern(w http.ResponseWriter, r *http.Request) {
 	}
 
 	matchCtx := alerting.MatchContext{
-		Namespace: req.TestData.Namespace,
-		Name:      req.TestData.Name,
-		JobName:   req.TestData.JobName,
-		ExitCode:  req.TestData.ExitCode,
-		Reason:    req.TestData.Reason,
-		Logs:      req.TestData.Logs,
-		Events:    req.TestData.Events,
+		Namespace:  req.TestData.Namespace,
+		Name:       req.TestData.Name,
+		JobName:    req.TestData.JobName,
+		ExitCode:   req.TestData.ExitCode,
+		Reason:     req.TestData.Reason,
+		Containers: make([]store.ContainerResult, 0, len(req.TestData.Containers)),
+		Logs:       req.TestData.Logs,
+		Events:     req.TestData.Events,
+	}
+	for _, c := range req.TestData.Containers {
+		matchCtx.Containers = append(matchCtx.Containers, store.ContainerResult{Name: c.Name, ExitCode: c.ExitCode, Reason: c.Reason})
 	}
 
 	engine := alerting.NewSuggestedFixEngine()
@@ -415,6 +415,44 @@ func TestCronJobListHandler_All(t *testing.T) {
 	assert.Equal(t, int32(1), result.Summary.Warning)
 }
 
+func TestCronJobListHandler_SuspendedFilters(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-monitor", Namespace: "default"},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{
+				{Name: "active", Namespace: "default", Status: "healthy"},
+				{Name: "paused", Namespace: "default", Status: "warning", Suspended: true},
+				{Name: "retired", Namespace: "default", Status: "suspended", Suspended: true, IntentionallySuspended: true},
+			},
+		},
+	}
+	h := newTestHandlers(newTestAPIClient(monitor), nil, nil, nil)
+
+	names := func(url string) []string {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		h.ListCronJobs(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var result CronJobListResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		var names []string
+		for _, item := range result.Items {
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"paused", "retired"}, names("/api/v1/cronjobs?suspended=true"))
+	assert.Equal(t, []string{"active"}, names("/api/v1/cronjobs?suspended=false"))
+	assert.Equal(t, []string{"active", "paused"}, names("/api/v1/cronjobs?excludeIntentionallySuspended=true"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs?suspended=maybe", nil)
+	w := httptest.NewRecorder()
+	h.ListCronJobs(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 // ============================================================================
 // CronJob Detail Handler Tests
 // ============================================================================
@@ -503,6 +541,9 @@ func TestCronJobDetailHandler_WithMetrics(t *testing.T) {
 						P50DurationSeconds: 25.0,
 						P95DurationSeconds: 50.0,
 						P99DurationSeconds: 60.0,
+						Incidents:          2,
+						MTTRSeconds:        3600,
+						MTBFSeconds:        86400,
 					},
 				},
 			},
@@ -535,6 +576,9 @@ func TestCronJobDetailHandler_WithMetrics(t *testing.T) {
 	assert.Equal(t, 95.5, result.Metrics.SuccessRate7d)
 	assert.Equal(t, 90.0, result.Metrics.SuccessRate30d)
 	assert.Equal(t, int32(100), result.Metrics.TotalRuns7d)
+	assert.Equal(t, int32(2), result.Metrics.Incidents7d)
+	assert.Equal(t, 3600.0, result.Metrics.MTTRSeconds)
+	assert.Equal(t, 86400.0, result.Metrics.MTBFSeconds)
 }
 
 // ============================================================================
@@ -635,6 +679,50 @@ func TestAlertsHandler_FilterBySeverity(t *testing.T) {
 	assert.Equal(t, "critical", result.Items[0].Severity)
 }
 
+func TestAlertsHandler_AlertID(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-monitor",
+			Namespace: "default",
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{
+				{
+					Name:      "cron-1",
+					Namespace: "default",
+					ActiveAlerts: []guardianv1alpha1.ActiveAlert{
+						{Type: "JobFailed", Severity: "critical", Since: metav1.Now()},
+					},
+				},
+			},
+		},
+	}
+	disp := testutil.NewMockDispatcher()
+	disp.ActiveAlertIDs = map[string]string{"default/cron-1/JobFailed": "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30"}
+	mockStore := &testutil.MockStore{
+		AlertHistory: []store.AlertHistory{
+			{ID: 1, AlertID: "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", Type: "JobFailed", JobName: "cron-1-29384720"},
+		},
+		AlertHistoryTotal: 1,
+	}
+	h := newTestHandlers(newTestAPIClient(monitor), mockStore, nil, disp)
+
+	w := httptest.NewRecorder()
+	h.ListAlerts(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil))
+	var active AlertListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&active))
+	require.Len(t, active.Items, 1)
+	assert.Equal(t, "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", active.Items[0].AlertID)
+
+	w = httptest.NewRecorder()
+	h.GetAlertHistory(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts/history?alertId=5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", nil))
+	var history AlertHistoryResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&history))
+	require.Len(t, history.Items, 1)
+	assert.Equal(t, "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", history.Items[0].AlertID)
+	assert.Equal(t, "cron-1-29384720", history.Items[0].JobName)
+}
+
 func TestAlertsHandler_FilterByTime(t *testing.T) {
 	now := time.Now()
 	mockStore := &testutil.MockStore{
@@ -662,6 +750,61 @@ func TestAlertsHandler_FilterByTime(t *testing.T) {
 	assert.Len(t, result.Items, 1)
 }
 
+func TestAlertDeliveriesHandler(t *testing.T) {
+	next := time.Now().Add(time.Minute)
+	mockStore := &testutil.MockStore{
+		Deliveries: []store.AlertDelivery{
+			{
+				ID:               1,
+				AlertKey:         "default/test-cron/JobFailed",
+				AlertType:        "JobFailed",
+				Severity:         "critical",
+				CronJobNamespace: "default",
+				CronJobName:      "test-cron",
+				ChannelName:      "slack",
+				Status:           store.DeliveryStatusPending,
+				Attempts:         2,
+				NextAttemptAt:    next,
+				LastError:        "connection refused",
+			},
+		},
+		DeliveriesTotal: 1,
+	}
+
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts/deliveries?status=pending", nil)
+	w := httptest.NewRecorder()
+
+	h.GetAlertDeliveries(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result AlertDeliveryListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+
+	require.Len(t, result.Items, 1)
+	item := result.Items[0]
+	assert.Equal(t, "slack", item.Channel)
+	assert.Equal(t, "pending", item.Status)
+	assert.Equal(t, int32(2), item.Attempts)
+	assert.Equal(t, "connection refused", item.LastError)
+	require.NotNil(t, item.NextAttemptAt)
+	require.NotNil(t, item.CronJob)
+	assert.Equal(t, "test-cron", item.CronJob.Name)
+	assert.Equal(t, int64(1), result.Pagination.Total)
+}
+
+func TestAlertDeliveriesHandler_InvalidStatus(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts/deliveries?status=bogus", nil)
+	w := httptest.NewRecorder()
+
+	h.GetAlertDeliveries(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 // ============================================================================
 // Test Alert Handler Tests
 // ============================================================================
@@ -765,6 +908,102 @@ func TestGetExecutions_NoStore(t *testing.T) {
 	assert.Equal(t, int64(0), result.Pagination.Total)
 }
 
+func TestGetCronJobAnalytics(t *testing.T) {
+	mockStore := &testutil.MockStore{
+		Analytics: &store.ExecutionAnalytics{
+			WindowDays:     14,
+			ExitCodes:      []store.ExitCodeCount{{ExitCode: 0, Count: 3}, {ExitCode: 1, Count: 1}},
+			FailureReasons: []store.ReasonCount{{Reason: "BackoffLimitExceeded", Count: 1}},
+			DurationTrend:  []store.DailyDuration{{Day: "2026-01-02", Runs: 4, P50Seconds: 12, P95Seconds: 30}},
+			Heatmap:        []store.HeatmapCell{{DayOfWeek: 5, Hour: 3, Total: 4, Succeeded: 3}},
+		},
+	}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/test-cron/analytics?days=14", nil)
+	handler := chiRouterWithParams(
+		h.GetCronJobAnalytics, map[string]string{
+			"namespace": "default",
+			"name":      "test-cron",
+		},
+	)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var result AnalyticsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+
+	assert.Equal(t, "test-cron", result.CronJob.Name)
+	assert.Equal(t, 14, result.WindowDays)
+	assert.Len(t, result.ExitCodes, 2)
+	assert.Equal(t, "BackoffLimitExceeded", result.FailureReasons[0].Reason)
+	assert.Equal(t, "2026-01-02", result.DurationTrend[0].Date)
+	assert.InDelta(t, 75, result.Heatmap[0].SuccessRate, 0.001)
+}
+
+func TestGetCronJobAnalytics_InvalidDays(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	for _, days := range []string{"0", "366", "abc"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/test-cron/analytics?days="+days, nil)
+		handler := chiRouterWithParams(
+			h.GetCronJobAnalytics, map[string]string{
+				"namespace": "default",
+				"name":      "test-cron",
+			},
+		)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, "days=%s", days)
+	}
+}
+
+func TestGetCorrelations(t *testing.T) {
+	base := time.Now().Add(-15 * time.Minute)
+	var failures []store.Execution
+	for i := range 3 {
+		failures = append(failures, store.Execution{
+			CronJobNamespace: "batch",
+			CronJobName:      "job-" + string(rune('a'+i)),
+			StartTime:        base.Add(time.Duration(i) * time.Minute),
+			Reason:           "BackoffLimitExceeded",
+		})
+	}
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{FailedExecutions: failures}, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/correlations?lookback=2h&window=5m", nil)
+	w := httptest.NewRecorder()
+
+	h.GetCorrelations(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var result CorrelationReportResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	assert.Equal(t, "2h0m0s", result.Lookback)
+	assert.Equal(t, "5m0s", result.Window)
+	assert.Equal(t, 3, result.TotalFailures)
+	require.NotEmpty(t, result.Groups)
+	assert.Len(t, result.Groups[0].CronJobs, 3)
+	assert.NotEmpty(t, result.Groups[0].LikelyCause)
+}
+
+func TestGetCorrelations_InvalidParams(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	for _, query := range []string{"lookback=abc", "lookback=200h", "window=-1m", "minCronJobs=1"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/correlations?"+query, nil)
+		w := httptest.NewRecorder()
+
+		h.GetCorrelations(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
 // ============================================================================
 // Channel Handler Tests
 // ============================================================================
@@ -953,6 +1192,39 @@ func TestDeleteCronJobHistory(t *testing.T) {
 	assert.Equal(t, int64(25), result.RecordsDeleted)
 }
 
+func TestDeleteCronJobHistory_UndoWindow(t *testing.T) {
+	mockStore := &testutil.MockStore{DeletedCount: 25, RestoredCount: 25}
+	cfg := &config.Config{HistoryRetention: config.HistoryRetentionConfig{UndoWindow: 7 * 24 * time.Hour}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, cfg, nil)
+	params := map[string]string{"namespace": "default", "name": "test-cron"}
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/cronjobs/default/test-cron/history", nil)
+	w := httptest.NewRecorder()
+	chiRouterWithParams(h.DeleteCronJobHistory, params)(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var deleted DeleteHistoryResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&deleted))
+	assert.Equal(t, int64(25), deleted.RecordsDeleted)
+	require.NotNil(t, deleted.RestorableUntil)
+	assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), *deleted.RestorableUntil, time.Minute)
+	assert.Equal(t, 1, mockStore.TrashCalled)
+	assert.Zero(t, mockStore.DeleteByCronJobCalled, "history is trashed, not deleted")
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/cronjobs/default/test-cron/history/restore", nil)
+	w = httptest.NewRecorder()
+	restore := chiRouterWithParams(h.RestoreCronJobHistory, params)
+	restore(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var restored RestoreHistoryResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&restored))
+	assert.Equal(t, int64(25), restored.RecordsRestored)
+
+	mockStore.RestoredCount = 0
+	w = httptest.NewRecorder()
+	restore(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 // ============================================================================
 // Pattern Test Handler Tests
 // ============================================================================
@@ -1165,6 +1437,64 @@ func TestResumeCronJob(t *testing.T) {
 	assert.False(t, *updated.Spec.Suspend)
 }
 
+func TestSilenceMonitor(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-monitor",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := newTestAPIClient(monitor)
+	h := newTestHandlers(fakeClient, nil, nil, nil)
+	params := map[string]string{
+		"namespace": "default",
+		"name":      "test-monitor",
+	}
+	key := types.NamespacedName{Namespace: "default", Name: "test-monitor"}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors/default/test-monitor/silence?duration=2h", nil)
+	w := httptest.NewRecorder()
+	chiRouterWithParams(h.SilenceMonitor, params).ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var result SilenceResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	assert.True(t, result.Success)
+	require.NotNil(t, result.SilencedUntil)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), *result.SilencedUntil, time.Minute)
+
+	var updated guardianv1alpha1.CronJobMonitor
+	require.NoError(t, fakeClient.Get(context.Background(), key, &updated))
+	assert.True(t, updated.IsSilenced(time.Now()))
+	assert.False(t, updated.IsSilenced(time.Now().Add(3*time.Hour)), "silence should expire")
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/monitors/default/test-monitor/silence", nil)
+	w = httptest.NewRecorder()
+	chiRouterWithParams(h.UnsilenceMonitor, params).ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.NoError(t, fakeClient.Get(context.Background(), key, &updated))
+	assert.Nil(t, updated.Spec.SilencedUntil)
+}
+
+func TestSilenceMonitor_InvalidDuration(t *testing.T) {
+	fakeClient := newTestAPIClient()
+	h := newTestHandlers(fakeClient, nil, nil, nil)
+
+	for _, duration := range []string{"", "soon", "-1h"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors/default/test-monitor/silence?duration="+duration, nil)
+		w := httptest.NewRecorder()
+		chiRouterWithParams(
+			h.SilenceMonitor, map[string]string{
+				"namespace": "default",
+				"name":      "test-monitor",
+			},
+		).ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, "duration %q", duration)
+	}
+}
+
 // ============================================================================
 // Write JSON Helper Tests
 // ============================================================================
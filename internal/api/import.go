@@ -0,0 +1,291 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	// importMaxBodyBytes bounds the size of an import
+	importMaxBodyBytes = 64 << 20
+
+	// importMaxErrors is the number of invalid rows reported
+	importMaxErrors = 20
+
+	// importBatchSize is the number of executions written per transaction
+	importBatchSize = 500
+)
+
+// importColumns are the CSV columns of an import, named after the JSON
+// fields of ImportExecution
+var importColumns = []string{
+	"namespace", "cronJob", "jobName", "status", "startTime", "completionTime", "duration", "exitCode", "reason",
+}
+
+// ImportExecutions handles POST /api/v1/admin/import
+// @Summary      Import historical executions
+// @Description  Records executions from another system, so SLA and duration statistics cover the time before guardian was installed. Accepts a JSON array or CSV with a header row (Content-Type text/csv or ?format=csv). Rows whose job is already recorded are skipped; if any row is invalid, nothing is imported.
+// @Tags         Admin
+// @Accept       json
+// @Accept       text/csv
+// @Produce      json
+// @Param        request  body      []ImportExecution  true  "Executions to import"
+// @Param        format   query     string  false  "json or csv (default: from Content-Type)"
+// @Success      200  {object}  ImportResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/import [post]
+func (h *Handlers) ImportExecutions(w http.ResponseWriter, r *http.Request) {
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, importMaxBodyBytes)
+	var rows []ImportExecution
+	var err error
+	if importFormat(r) == "csv" {
+		rows, err = decodeImportCSV(body)
+	} else {
+		err = json.NewDecoder(body).Decode(&rows)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid import: %v", err))
+		return
+	}
+
+	execs := make([]store.Execution, 0, len(rows))
+	var rowErrors []string
+	for i, row := range rows {
+		exec, err := row.execution()
+		if err != nil {
+			if len(rowErrors) < importMaxErrors {
+				rowErrors = append(rowErrors, fmt.Sprintf("row %d: %v", i+1, err))
+			}
+			continue
+		}
+		execs = append(execs, exec)
+	}
+	if len(rowErrors) > 0 {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "INVALID_REQUEST",
+			Message: fmt.Sprintf("%d of %d rows are invalid, nothing was imported", len(rows)-len(execs), len(rows)),
+			Details: map[string]any{"rows": rowErrors},
+		}})
+		return
+	}
+
+	// Skip jobs that are already recorded, so an import can be repeated
+	ctx := r.Context()
+	resp := ImportResponse{}
+	seen := make(map[string]bool, len(execs))
+	batch := make([]store.Execution, 0, importBatchSize)
+	flush := func() error {
+		if err := h.store.RecordExecutions(ctx, batch); err != nil {
+			return err
+		}
+		resp.Imported += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+	for _, exec := range execs {
+		key := exec.CronJobNamespace + "/" + exec.JobName
+		if seen[key] {
+			resp.Skipped++
+			continue
+		}
+		seen[key] = true
+		recorded, err := h.store.GetExecutionByJobName(ctx, exec.CronJobNamespace, exec.JobName)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		if recorded != nil {
+			resp.Skipped++
+			continue
+		}
+		batch = append(batch, exec)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+				return
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	log.FromContext(ctx).Info("imported executions", "cluster", h.cluster, "imported", resp.Imported, "skipped", resp.Skipped)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// importFormat returns the format of an import: csv or json
+func importFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return strings.ToLower(format)
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/csv" {
+		return "csv"
+	}
+	return "json"
+}
+
+// decodeImportCSV reads executions from CSV with a header row of importColumns
+func decodeImportCSV(r io.Reader) ([]ImportExecution, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if !slices.Contains(importColumns, header[i]) {
+			return nil, fmt.Errorf("unknown column %q", header[i])
+		}
+	}
+
+	var rows []ImportExecution
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var row ImportExecution
+		for i, value := range record {
+			if err := row.set(header[i], strings.TrimSpace(value)); err != nil {
+				line, _ := reader.FieldPos(i)
+				return nil, fmt.Errorf("line %d: %s: %w", line, header[i], err)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// set sets the field of a CSV column
+func (e *ImportExecution) set(column, value string) error {
+	var err error
+	switch column {
+	case "namespace":
+		e.Namespace = value
+	case "cronJob":
+		e.CronJob = value
+	case "jobName":
+		e.JobName = value
+	case "status":
+		e.Status = value
+	case "startTime":
+		if value != "" {
+			e.StartTime, err = time.Parse(time.RFC3339, value)
+		}
+	case "completionTime":
+		if value != "" {
+			var t time.Time
+			t, err = time.Parse(time.RFC3339, value)
+			e.CompletionTime = &t
+		}
+	case "duration":
+		e.Duration = value
+	case "exitCode":
+		if value != "" {
+			var code int64
+			code, err = strconv.ParseInt(value, 10, 32)
+			e.ExitCode = int32(code)
+		}
+	case "reason":
+		e.Reason = value
+	}
+	return err
+}
+
+// execution validates the row and returns it as an execution
+func (e ImportExecution) execution() (store.Execution, error) {
+	if e.Namespace == "" || e.CronJob == "" {
+		return store.Execution{}, errors.New("namespace and cronJob are required")
+	}
+	if e.StartTime.IsZero() {
+		return store.Execution{}, errors.New("startTime is required")
+	}
+
+	var succeeded bool
+	switch e.Status {
+	case statusSuccess:
+		succeeded = true
+	case statusFailed:
+	default:
+		return store.Execution{}, fmt.Errorf("status must be %s or %s, not %q", statusSuccess, statusFailed, e.Status)
+	}
+
+	var duration time.Duration
+	switch {
+	case e.Duration != "":
+		d, err := parseImportDuration(e.Duration)
+		if err != nil {
+			return store.Execution{}, err
+		}
+		duration = d
+	case e.CompletionTime != nil:
+		duration = e.CompletionTime.Sub(e.StartTime)
+	default:
+		return store.Execution{}, errors.New("completionTime or duration is required")
+	}
+	if duration < 0 {
+		return store.Execution{}, errors.New("completionTime is before startTime")
+	}
+	completion := e.StartTime.Add(duration)
+	if e.CompletionTime != nil {
+		completion = *e.CompletionTime
+	}
+
+	jobName := e.JobName
+	if jobName == "" {
+		jobName = fmt.Sprintf("%s-%d", e.CronJob, e.StartTime.Unix()/60)
+	}
+
+	exec := store.Execution{
+		CronJobNamespace: e.Namespace,
+		CronJobName:      e.CronJob,
+		JobName:          jobName,
+		StartTime:        e.StartTime,
+		CompletionTime:   completion,
+		Succeeded:        succeeded,
+		ExitCode:         e.ExitCode,
+		Reason:           e.Reason,
+	}
+	exec.SetDuration(duration)
+	return exec, nil
+}
+
+// parseImportDuration parses a Go duration such as 1m30s, or seconds
+func parseImportDuration(value string) (time.Duration, error) {
+	if secs, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestImportExecutions_JSON(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	body := `[
+		{"namespace": "default", "cronJob": "backup", "status": "success", "startTime": "2025-06-01T02:00:00Z", "duration": "1m30s"},
+		{"namespace": "default", "cronJob": "backup", "jobName": "backup-old", "status": "failed", "startTime": "2025-06-02T02:00:00Z",
+		 "completionTime": "2025-06-02T02:05:00Z", "exitCode": 2, "reason": "BackoffLimitExceeded"},
+		{"namespace": "default", "cronJob": "backup", "status": "success", "startTime": "2025-06-01T02:00:00Z", "duration": "90"}
+	]`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/import", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ImportExecutions(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp ImportResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, ImportResponse{Imported: 2, Skipped: 1}, resp)
+
+	require.Len(t, mockStore.RecordedExecutions, 2)
+	first := mockStore.RecordedExecutions[0]
+	assert.Equal(t, "backup-29145720", first.JobName)
+	assert.True(t, first.Succeeded)
+	assert.Equal(t, 90*time.Second, first.Duration())
+	assert.Equal(t, first.StartTime.Add(90*time.Second), first.CompletionTime)
+
+	second := mockStore.RecordedExecutions[1]
+	assert.Equal(t, "backup-old", second.JobName)
+	assert.False(t, second.Succeeded)
+	assert.Equal(t, 5*time.Minute, second.Duration())
+	assert.Equal(t, int32(2), second.ExitCode)
+	assert.Equal(t, "BackoffLimitExceeded", second.Reason)
+}
+
+func TestImportExecutions_CSV(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	body := "namespace,cronJob,status,startTime,duration,exitCode\n" +
+		"default,report,success,2025-06-01T02:00:00Z,12.5,0\n" +
+		"default,report,failed,2025-06-02T02:00:00Z,3s,1\n"
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+	w := httptest.NewRecorder()
+	h.ImportExecutions(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	require.Len(t, mockStore.RecordedExecutions, 2)
+	assert.Equal(t, 12500*time.Millisecond, mockStore.RecordedExecutions[0].Duration())
+	assert.Equal(t, int32(1), mockStore.RecordedExecutions[1].ExitCode)
+	assert.False(t, mockStore.RecordedExecutions[1].Succeeded)
+}
+
+func TestImportExecutions_AlreadyRecorded(t *testing.T) {
+	mockStore := &testutil.MockStore{ExecutionByJobName: &store.Execution{JobName: "backup-1"}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	body := `[{"namespace": "default", "cronJob": "backup", "jobName": "backup-1", "status": "success", "startTime": "2025-06-01T02:00:00Z", "duration": "1m"}]`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/import", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ImportExecutions(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp ImportResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, ImportResponse{Skipped: 1}, resp)
+	assert.Empty(t, mockStore.RecordedExecutions)
+}
+
+func TestImportExecutions_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		contains    string
+	}{
+		{name: "malformed JSON", body: `{`, contains: "invalid import"},
+		{name: "unknown CSV column", contentType: "text/csv", body: "namespace,cronJob,owner\n", contains: `unknown column \"owner\"`},
+		{name: "bad CSV time", contentType: "text/csv", body: "namespace,cronJob,status,startTime\ndefault,a,success,yesterday\n", contains: "line 2: startTime"},
+		{name: "missing status", body: `[{"namespace": "default", "cronJob": "a", "startTime": "2025-06-01T02:00:00Z", "duration": "1m"}]`, contains: "row 1: status must be"},
+		{name: "missing duration", body: `[{"namespace": "default", "cronJob": "a", "status": "success", "startTime": "2025-06-01T02:00:00Z"}]`, contains: "completionTime or duration is required"},
+		{name: "completion before start", body: `[{"namespace": "default", "cronJob": "a", "status": "success", "startTime": "2025-06-01T02:00:00Z", "completionTime": "2025-06-01T01:00:00Z"}]`, contains: "completionTime is before startTime"},
+		{name: "missing cronJob", body: `[{"namespace": "default", "status": "success", "startTime": "2025-06-01T02:00:00Z", "duration": "1m"}]`, contains: "namespace and cronJob are required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := &testutil.MockStore{}
+			h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/import", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+			h.ImportExecutions(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), tt.contains)
+			assert.Empty(t, mockStore.RecordedExecutions)
+		})
+	}
+}
+
+func TestImportExecutions_NoStore(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/import", strings.NewReader("[]"))
+	w := httptest.NewRecorder()
+	h.ImportExecutions(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
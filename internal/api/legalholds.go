@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/scheduler"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// maxLegalHoldReason is the longest reason a legal hold can be given
+const maxLegalHoldReason = 1024
+
+// ListLegalHolds handles GET /api/v1/admin/legal-holds
+// @Summary      List legal holds
+// @Description  Returns the CronJobs whose history and logs are exempt from pruning, held through the API or by a monitor with dataRetention.legalHold
+// @Tags         Admin
+// @Produce      json
+// @Param        cluster  query     string  false  "Cluster (defaults to the local cluster)"
+// @Success      200  {object}  LegalHoldListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/legal-holds [get]
+func (h *Handlers) ListLegalHolds(w http.ResponseWriter, r *http.Request) {
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	items, err := h.legalHoldItems(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, LegalHoldListResponse{Items: items})
+}
+
+// SetLegalHold handles PUT /api/v1/admin/legal-holds/:namespace/:name
+// @Summary      Place a CronJob on legal hold
+// @Description  Exempts the CronJob's history and logs from pruning by the history pruner and the prune endpoint until the hold is released. The CronJob doesn't need to exist.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        namespace  path      string            true   "Namespace"
+// @Param        name       path      string            true   "CronJob name"
+// @Param        cluster    query     string            false  "Cluster (defaults to the local cluster)"
+// @Param        request    body      LegalHoldRequest  false  "Hold reason"
+// @Success      200  {object}  LegalHoldItem
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/legal-holds/{namespace}/{name} [put]
+func (h *Handlers) SetLegalHold(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	var req LegalHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Invalid request body: %v", err))
+		return
+	}
+	if len(req.Reason) > maxLegalHoldReason {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("reason must be at most %d characters", maxLegalHoldReason))
+		return
+	}
+
+	hold := store.LegalHold{CronJobNamespace: namespace, CronJobName: name, Reason: req.Reason}
+	if err := h.store.SetLegalHold(r.Context(), hold); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, LegalHoldItem{Namespace: namespace, Name: name, Reason: req.Reason})
+}
+
+// ReleaseLegalHold handles DELETE /api/v1/admin/legal-holds/:namespace/:name
+// @Summary      Release a legal hold
+// @Description  Releases a hold set through the API. Holds of monitors with dataRetention.legalHold are released by editing the monitor.
+// @Tags         Admin
+// @Produce      json
+// @Param        namespace  path      string  true   "Namespace"
+// @Param        name       path      string  true   "CronJob name"
+// @Param        cluster    query     string  false  "Cluster (defaults to the local cluster)"
+// @Success      200  {object}  SimpleResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/legal-holds/{namespace}/{name} [delete]
+func (h *Handlers) ReleaseLegalHold(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	released, err := h.store.ReleaseLegalHold(r.Context(), types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if !released {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No legal hold on %s/%s", namespace, name))
+		return
+	}
+	writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: fmt.Sprintf("Legal hold on %s/%s released", namespace, name)})
+}
+
+// legalHoldItems returns the legal holds of both sources as API items
+func (h *Handlers) legalHoldItems(ctx context.Context) ([]LegalHoldItem, error) {
+	holds, err := scheduler.LegalHolds(ctx, h.client, h.store)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]LegalHoldItem, 0, len(holds))
+	for _, hold := range holds {
+		item := LegalHoldItem{
+			Namespace: hold.CronJob.Namespace,
+			Name:      hold.CronJob.Name,
+			Reason:    hold.Reason,
+			Monitor:   hold.Monitor,
+		}
+		if !hold.Since.IsZero() {
+			item.Since = &hold.Since
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestLegalHolds(t *testing.T) {
+	monitor := &v1alpha1.CronJobMonitor{}
+	monitor.Name = "audit"
+	monitor.Namespace = "finance"
+	monitor.Spec.DataRetention = &v1alpha1.DataRetentionConfig{LegalHold: true}
+	monitor.Status.CronJobs = []v1alpha1.CronJobStatus{{Namespace: "finance", Name: "ledger"}}
+
+	mockStore := &testutil.MockStore{}
+	h := newTestHandlers(newTestAPIClient(monitor), mockStore, &config.Config{}, nil)
+	params := map[string]string{"namespace": "default", "name": "backup"}
+
+	handler := chiRouterWithParams(h.SetLegalHold, params)
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/legal-holds/default/backup", strings.NewReader(`{"reason": "litigation"}`))
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/admin/legal-holds/default/backup", strings.NewReader(`{"reason": "`+strings.Repeat("x", 1025)+`"}`))
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/legal-holds", nil)
+	w = httptest.NewRecorder()
+	h.ListLegalHolds(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list LegalHoldListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+	assert.Equal(t, []LegalHoldItem{
+		{Namespace: "default", Name: "backup", Reason: "litigation"},
+		{Namespace: "finance", Name: "ledger", Monitor: "finance/audit"},
+	}, list.Items)
+
+	// The prune endpoint skips held CronJobs
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/admin/prune", strings.NewReader(`{"olderThanDays": 7}`))
+	w = httptest.NewRecorder()
+	h.TriggerPrune(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	mockStore.Lock()
+	require.Len(t, mockStore.PruneOverrides, 2)
+	assert.Equal(t, "default/backup", mockStore.PruneOverrides[0].CronJob.String())
+	assert.True(t, mockStore.PruneOverrides[0].OlderThan.IsZero())
+	mockStore.Unlock()
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/storage-stats", nil)
+	w = httptest.NewRecorder()
+	h.GetStorageStats(w, req)
+	var stats StorageStatsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&stats))
+	assert.Len(t, stats.LegalHolds, 2)
+
+	handler = chiRouterWithParams(h.ReleaseLegalHold, params)
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/admin/legal-holds/default/backup", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code, "a released hold can't be released again")
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/logging"
+)
+
+// resetLogLevel is the level that makes a sub-logger use the default level again
+const resetLogLevel = "default"
+
+// SetLogLevels sets the log levels changed by the loglevel endpoint
+func (h *Handlers) SetLogLevels(levels *logging.Levels) {
+	h.logLevels = levels
+}
+
+// GetLogLevel handles GET /api/v1/admin/loglevel
+// @Summary      Get log levels
+// @Description  Returns the default log level and the levels of sub-loggers that differ from it
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  LogLevelResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/loglevel [get]
+func (h *Handlers) GetLogLevel(w http.ResponseWriter, _ *http.Request) {
+	if h.logLevels == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Log levels cannot be changed")
+		return
+	}
+	writeJSON(w, http.StatusOK, h.logLevelResponse())
+}
+
+// SetLogLevel handles PUT /api/v1/admin/loglevel
+// @Summary      Change log level
+// @Description  Changes the log level until the operator restarts, for all logs or for one sub-logger such as controllers, api-server or alerting. The level "default" makes a sub-logger use the default level again.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        request  body      LogLevelRequest  true  "Level to set"
+// @Success      200  {object}  LogLevelResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/loglevel [put]
+func (h *Handlers) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	if h.logLevels == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Log levels cannot be changed")
+		return
+	}
+
+	var req LogLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+
+	if req.Logger != "" && req.Level == resetLogLevel {
+		h.logLevels.ResetLoggerLevel(req.Logger)
+	} else {
+		level, err := logging.ParseLevel(req.Level)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+			return
+		}
+		if req.Logger == "" {
+			h.logLevels.SetLevel(level)
+		} else {
+			h.logLevels.SetLoggerLevel(req.Logger, level)
+		}
+	}
+
+	log.FromContext(r.Context()).Info("log level changed", "logger", req.Logger, "level", req.Level)
+	writeJSON(w, http.StatusOK, h.logLevelResponse())
+}
+
+func (h *Handlers) logLevelResponse() LogLevelResponse {
+	resp := LogLevelResponse{Level: h.logLevels.Level().String()}
+	loggers := h.logLevels.Loggers()
+	if len(loggers) > 0 {
+		resp.Loggers = make(map[string]string, len(loggers))
+		for name, level := range loggers {
+			resp.Loggers[name] = level.String()
+		}
+	}
+	return resp
+}
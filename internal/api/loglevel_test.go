@@ -0,0 +1,63 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/logging"
+)
+
+func putLogLevel(h *Handlers, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/loglevel", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.SetLogLevel(w, req)
+	return w
+}
+
+func TestSetLogLevel(t *testing.T) {
+	levels := logging.NewLevels(zerolog.InfoLevel)
+	defer zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	h := newTestHandlers(nil, nil, nil, nil)
+	h.SetLogLevels(levels)
+
+	w := putLogLevel(h, `{"level": "debug", "logger": "controllers"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	var result LogLevelResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	assert.Equal(t, LogLevelResponse{Level: "info", Loggers: map[string]string{"controllers": "debug"}}, result)
+
+	w = putLogLevel(h, `{"level": "warn"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, zerolog.WarnLevel, levels.Level())
+
+	w = putLogLevel(h, `{"level": "default", "logger": "controllers"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, levels.Loggers())
+
+	w = putLogLevel(h, `{"level": "loud"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = putLogLevel(h, `{"level": "default"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "the default level cannot be reset")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/loglevel", nil)
+	w = httptest.NewRecorder()
+	h.GetLogLevel(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var current LogLevelResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&current))
+	assert.Equal(t, LogLevelResponse{Level: "warn"}, current)
+}
+
+func TestSetLogLevel_NotConfigured(t *testing.T) {
+	h := newTestHandlers(nil, nil, nil, nil)
+
+	w := putLogLevel(h, `{"level": "debug"}`)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
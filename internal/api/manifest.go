@@ -0,0 +1,123 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// lastAppliedAnnotation is the annotation kubectl apply stores the applied object in
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// manifest is a resource as it is committed to Git: without status and
+// server-set metadata such as managedFields, uid and resourceVersion
+type manifest struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Metadata   manifestMetadata `json:"metadata"`
+	Spec       any              `json:"spec"`
+}
+
+type manifestMetadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GetMonitorManifest handles GET /api/v1/monitors/:namespace/:name/manifest
+// @Summary      Get monitor manifest
+// @Description  Returns a CronJobMonitor as clean YAML, without status and server-set metadata, so changes made through the API or UI can be committed to Git
+// @Tags         Monitors
+// @Produce      application/yaml
+// @Param        namespace  path      string  true  "Monitor namespace"
+// @Param        name       path      string  true  "Monitor name"
+// @Success      200  {string}  string  "CronJobMonitor manifest"
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/{namespace}/{name}/manifest [get]
+func (h *Handlers) GetMonitorManifest(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	if err := h.client.Get(r.Context(), types.NamespacedName{Namespace: namespace, Name: name}, monitor); err != nil {
+		writeResourceError(w, err, fmt.Sprintf("Monitor %s/%s", namespace, name))
+		return
+	}
+
+	writeManifest(w, manifest{
+		APIVersion: guardianv1alpha1.GroupVersion.String(),
+		Kind:       "CronJobMonitor",
+		Metadata: manifestMetadata{
+			Name:        monitor.Name,
+			Namespace:   monitor.Namespace,
+			Labels:      monitor.Labels,
+			Annotations: manifestAnnotations(monitor.Annotations),
+		},
+		Spec: monitor.Spec,
+	})
+}
+
+// GetChannelManifest handles GET /api/v1/channels/:name/manifest
+// @Summary      Get channel manifest
+// @Description  Returns an AlertChannel as clean YAML, without status and server-set metadata, so changes made through the API or UI can be committed to Git
+// @Tags         Channels
+// @Produce      application/yaml
+// @Param        name  path      string  true  "Channel name"
+// @Success      200  {string}  string  "AlertChannel manifest"
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /channels/{name}/manifest [get]
+func (h *Handlers) GetChannelManifest(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	channel := &guardianv1alpha1.AlertChannel{}
+	if err := h.client.Get(r.Context(), types.NamespacedName{Name: name}, channel); err != nil {
+		writeResourceError(w, err, "Channel "+name)
+		return
+	}
+
+	writeManifest(w, manifest{
+		APIVersion: guardianv1alpha1.GroupVersion.String(),
+		Kind:       "AlertChannel",
+		Metadata: manifestMetadata{
+			Name:        channel.Name,
+			Labels:      channel.Labels,
+			Annotations: manifestAnnotations(channel.Annotations),
+		},
+		Spec: channel.Spec,
+	})
+}
+
+// manifestAnnotations returns the annotations worth committing, leaving out
+// the copy of the object kubectl apply keeps
+func manifestAnnotations(annotations map[string]string) map[string]string {
+	result := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		if k != lastAppliedAnnotation {
+			result[k] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func writeManifest(w http.ResponseWriter, m manifest) {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%s.yaml", m.Metadata.Name))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetMonitorManifest(t *testing.T) {
+	now := metav1.Now()
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "critical",
+			Namespace: "prod",
+			Labels:    map[string]string{"team": "ops"},
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			Selector: &guardianv1alpha1.CronJobSelector{MatchLabels: map[string]string{"tier": "critical"}},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{Phase: "Active", LastReconcileTime: &now},
+	}
+	h := newTestHandlers(newTestAPIClient(monitor), &testutil.MockStore{}, nil, nil)
+
+	handler := chiRouterWithParams(h.GetMonitorManifest, map[string]string{"namespace": "prod", "name": "critical"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/monitors/prod/critical/manifest", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/yaml", w.Header().Get("Content-Type"))
+	assert.Equal(t, `apiVersion: guardian.illenium.net/v1alpha1
+kind: CronJobMonitor
+metadata:
+  labels:
+    team: ops
+  name: critical
+  namespace: prod
+spec:
+  selector:
+    matchLabels:
+      tier: critical
+`, w.Body.String())
+
+	handler = chiRouterWithParams(h.GetMonitorManifest, map[string]string{"namespace": "prod", "name": "missing"})
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetChannelManifest(t *testing.T) {
+	channel := &guardianv1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-ops"},
+		Spec: guardianv1alpha1.AlertChannelSpec{
+			Type: "slack",
+			Slack: &guardianv1alpha1.SlackConfig{
+				WebhookSecretRef: guardianv1alpha1.NamespacedSecretKeyRef{Name: "slack-webhook", Namespace: "guardian", Key: "url"},
+			},
+		},
+		Status: guardianv1alpha1.AlertChannelStatus{Ready: true},
+	}
+	h := newTestHandlers(newTestAPIClient(channel), &testutil.MockStore{}, nil, nil)
+
+	handler := chiRouterWithParams(h.GetChannelManifest, map[string]string{"name": "slack-ops"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/channels/slack-ops/manifest", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "kind: AlertChannel\nmetadata:\n  name: slack-ops\nspec:\n")
+	assert.NotContains(t, w.Body.String(), "status")
+}
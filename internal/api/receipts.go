@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	// receiptMaxBodyBytes bounds the size of a delivery receipt
+	receiptMaxBodyBytes = 64 << 10
+	// maxAlertIDLength is the size of the alert_id column
+	maxAlertIDLength = 36
+	// maxReceiverLength is the size of the receiver column
+	maxReceiverLength = 253
+)
+
+// RecordAlertReceipt handles POST /api/v1/alerts/{alertId}/receipts
+// @Summary      Record a delivery receipt
+// @Description  Lets a downstream system confirm it processed an alert, identified by the tracking ID sent in the alert payload. Requests must carry the receipt token as a bearer token.
+// @Tags         Alerts
+// @Accept       json
+// @Produce      json
+// @Param        alertId  path      string               true  "Alert tracking ID"
+// @Param        receipt  body      AlertReceiptRequest  true  "Receipt"
+// @Success      201  {object}  AlertReceiptItem
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /alerts/{alertId}/receipts [post]
+func (h *Handlers) RecordAlertReceipt(w http.ResponseWriter, r *http.Request) {
+	if h.config == nil || h.config.UI.ReceiptToken == "" {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Delivery receipts are not configured")
+		return
+	}
+	if !validReceiptToken(h.config.UI.ReceiptToken, r.Header.Get("Authorization")) {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing or invalid receipt token")
+		return
+	}
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	alertID := chi.URLParam(r, "alertId")
+	if alertID == "" || len(alertID) > maxAlertIDLength {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Alert ID must be 1 to %d characters", maxAlertIDLength))
+		return
+	}
+
+	var req AlertReceiptRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, receiptMaxBodyBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		return
+	}
+	req.Receiver = strings.TrimSpace(req.Receiver)
+	if req.Receiver == "" || len(req.Receiver) > maxReceiverLength {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("receiver is required and at most %d characters", maxReceiverLength))
+		return
+	}
+	switch req.Status {
+	case "":
+		req.Status = store.ReceiptStatusProcessed
+	case store.ReceiptStatusProcessed, store.ReceiptStatusFailed:
+	default:
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Unknown status %q, expected processed or failed", req.Status))
+		return
+	}
+
+	receipt := store.AlertReceipt{
+		AlertID:    alertID,
+		Receiver:   req.Receiver,
+		Status:     req.Status,
+		Message:    req.Message,
+		ReceivedAt: time.Now().UTC(),
+	}
+	if err := h.store.RecordReceipt(r.Context(), receipt); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to record receipt: %s", err))
+		return
+	}
+	writeJSON(w, http.StatusCreated, alertReceiptItem(receipt))
+}
+
+// validReceiptToken checks an Authorization header against the receipt token
+func validReceiptToken(token, header string) bool {
+	presented, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(presented), []byte(token)) == 1
+}
+
+// attachReceipts adds the delivery receipts of their tracking IDs to alert
+// history items
+func (h *Handlers) attachReceipts(ctx context.Context, items []AlertHistoryItem) error {
+	var ids []string
+	for _, item := range items {
+		if item.AlertID != "" {
+			ids = append(ids, item.AlertID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	receipts, err := h.store.ListReceipts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string][]AlertReceiptItem, len(receipts))
+	for _, r := range receipts {
+		byID[r.AlertID] = append(byID[r.AlertID], alertReceiptItem(r))
+	}
+	for i := range items {
+		items[i].Receipts = byID[items[i].AlertID]
+	}
+	return nil
+}
+
+// alertReceiptItem converts a stored receipt to its API form
+func alertReceiptItem(r store.AlertReceipt) AlertReceiptItem {
+	return AlertReceiptItem{
+		AlertID:    r.AlertID,
+		Receiver:   r.Receiver,
+		Status:     r.Status,
+		Message:    r.Message,
+		ReceivedAt: r.ReceivedAt,
+	}
+}
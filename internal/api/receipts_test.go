@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+const testAlertID = "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30"
+
+func TestRecordAlertReceipt(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	cfg := &config.Config{UI: config.UIConfig{ReceiptToken: "s3cret"}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, cfg, nil)
+
+	post := func(alertID, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/"+alertID+"/receipts", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		chiRouterWithParams(h.RecordAlertReceipt, map[string]string{"alertId": alertID})(w, req)
+		return w
+	}
+
+	w := post(testAlertID, "s3cret", `{"receiver": "pagerduty-bridge", "message": "incident Q1W2E3"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created AlertReceiptItem
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	assert.Equal(t, testAlertID, created.AlertID)
+	assert.Equal(t, store.ReceiptStatusProcessed, created.Status, "status defaults to processed")
+
+	require.Len(t, mockStore.Receipts, 1)
+	assert.Equal(t, "pagerduty-bridge", mockStore.Receipts[0].Receiver)
+	assert.Equal(t, "incident Q1W2E3", mockStore.Receipts[0].Message)
+
+	assert.Equal(t, http.StatusUnauthorized, post(testAlertID, "", `{"receiver": "x"}`).Code)
+	assert.Equal(t, http.StatusUnauthorized, post(testAlertID, "wrong", `{"receiver": "x"}`).Code)
+
+	for body, msg := range map[string]string{
+		`{}`:                                  "receiver is required",
+		`{"receiver": "x", "status": "lost"}`: "Unknown status",
+		`not json`:                            "Invalid request body",
+	} {
+		w = post(testAlertID, "s3cret", body)
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		assert.Contains(t, w.Body.String(), msg)
+	}
+	assert.Equal(t, http.StatusBadRequest, post(strings.Repeat("a", 37), "s3cret", `{"receiver": "x"}`).Code)
+	assert.Len(t, mockStore.Receipts, 1)
+}
+
+func TestRecordAlertReceipt_NotConfigured(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, &config.Config{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/"+testAlertID+"/receipts", strings.NewReader(`{"receiver": "x"}`))
+	req.Header.Set("Authorization", "Bearer ")
+	w := httptest.NewRecorder()
+	chiRouterWithParams(h.RecordAlertReceipt, map[string]string{"alertId": testAlertID})(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestAlertHistory_Receipts(t *testing.T) {
+	receivedAt := time.Now().UTC().Truncate(time.Second)
+	mockStore := &testutil.MockStore{
+		AlertHistory: []store.AlertHistory{
+			{ID: 1, AlertID: testAlertID, Type: "JobFailed"},
+			{ID: 2, Type: "JobFailed"},
+		},
+		AlertHistoryTotal: 2,
+		Receipts: []store.AlertReceipt{
+			{AlertID: testAlertID, Receiver: "pagerduty-bridge", Status: store.ReceiptStatusProcessed, ReceivedAt: receivedAt},
+			{AlertID: "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", Receiver: "other", Status: store.ReceiptStatusFailed, ReceivedAt: receivedAt},
+		},
+	}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	w := httptest.NewRecorder()
+	h.GetAlertHistory(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts/history", nil))
+	var result AlertHistoryResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	require.Len(t, result.Items, 2)
+	require.Len(t, result.Items[0].Receipts, 1)
+	assert.Equal(t, "pagerduty-bridge", result.Items[0].Receipts[0].Receiver)
+	assert.True(t, receivedAt.Equal(result.Items[0].Receipts[0].ReceivedAt))
+	assert.Empty(t, result.Items[1].Receipts)
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+)
+
+// GetCronJobRecommendation handles GET /api/v1/cronjobs/:namespace/:name/recommendation
+// @Summary      Get recommended Job limits
+// @Description  Returns the CronJob's current activeDeadlineSeconds and backoffLimit alongside values recommended from its execution history, using the recommendation settings of the monitor watching it
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true  "CronJob namespace"
+// @Param        name       path      string  true  "CronJob name"
+// @Success      200  {object}  RecommendationResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/recommendation [get]
+func (h *Handlers) GetCronJobRecommendation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	cronJobNN := types.NamespacedName{Namespace: namespace, Name: name}
+
+	cj := &batchv1.CronJob{}
+	if err := h.client.Get(ctx, cronJobNN, cj); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s/%s not found", namespace, name))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	config, applied := h.recommendationSettings(ctx, cronJobNN)
+	windowDays := analyzer.RecommendationWindowDays(config)
+	metrics, err := h.store.GetMetrics(ctx, cronJobNN, windowDays)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := RecommendationResponse{
+		CronJob:    NamespacedRef{Namespace: namespace, Name: name},
+		WindowDays: windowDays,
+		Current: JobLimits{
+			ActiveDeadlineSeconds: cj.Spec.JobTemplate.Spec.ActiveDeadlineSeconds,
+			BackoffLimit:          cj.Spec.JobTemplate.Spec.BackoffLimit,
+		},
+		Applied: applied,
+	}
+	if rec := analyzer.ComputeLimitRecommendation(metrics, config); rec != nil {
+		resp.Recommended = &JobLimits{
+			ActiveDeadlineSeconds: &rec.ActiveDeadlineSeconds,
+			BackoffLimit:          &rec.BackoffLimit,
+		}
+		resp.BasedOnRuns = rec.BasedOnRuns
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// recommendationSettings returns the recommendation config of the monitor
+// watching a CronJob and whether its recommendation has been applied
+func (h *Handlers) recommendationSettings(ctx context.Context, cronJob types.NamespacedName) (*guardianv1alpha1.RecommendationConfig, bool) {
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(ctx, monitors); err != nil {
+		return nil, false
+	}
+	for _, m := range monitors.Items {
+		for _, cjStatus := range m.Status.CronJobs {
+			if cjStatus.Name == cronJob.Name && cjStatus.Namespace == cronJob.Namespace {
+				return m.Spec.Recommendations, cjStatus.Recommendation != nil && cjStatus.Recommendation.Applied
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetCronJobRecommendation(t *testing.T) {
+	cj := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{BackoffLimit: ptr.To(int32(6))},
+			},
+		},
+	}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "backups", Namespace: "default"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			Recommendations: &guardianv1alpha1.RecommendationConfig{WindowDays: ptr.To(int32(14)), MinRuns: ptr.To(int32(5))},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Name: "backup", Namespace: "default"}},
+		},
+	}
+	mockStore := &testutil.MockStore{Metrics: &store.Metrics{TotalRuns: 5, P99DurationSeconds: 100}}
+	h := newTestHandlers(newTestAPIClient(cj, monitor), mockStore, nil, nil)
+
+	get := func(name string) *httptest.ResponseRecorder {
+		handler := chiRouterWithParams(h.GetCronJobRecommendation, map[string]string{"namespace": "default", "name": name})
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/"+name+"/recommendation", nil)
+		w := httptest.NewRecorder()
+		handler(w, req)
+		return w
+	}
+
+	w := get("backup")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp RecommendationResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, 14, resp.WindowDays)
+	assert.Nil(t, resp.Current.ActiveDeadlineSeconds)
+	assert.Equal(t, ptr.To(int32(6)), resp.Current.BackoffLimit)
+	require.NotNil(t, resp.Recommended)
+	assert.Equal(t, ptr.To(int64(180)), resp.Recommended.ActiveDeadlineSeconds)
+	assert.Equal(t, ptr.To(int32(1)), resp.Recommended.BackoffLimit)
+	assert.Equal(t, int32(5), resp.BasedOnRuns)
+	assert.False(t, resp.Applied)
+
+	assert.Equal(t, http.StatusNotFound, get("missing").Code)
+}
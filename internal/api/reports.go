@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/report"
+)
+
+// GetSLAReport handles GET /api/v1/reports/sla
+// @Summary      Download an SLA report
+// @Description  Renders the SLA compliance of a monitor's or team's CronJobs over the last complete calendar week (starting Monday) or month, in UTC: success rates against targets, SLA breach alerts, MTTR and worst offenders. Without monitor or team, the report covers all monitors.
+// @Tags         SLA
+// @Produce      html
+// @Produce      application/pdf
+// @Param        period   query     string  false  "weekly or monthly" default(weekly)
+// @Param        monitor  query     string  false  "Monitor as namespace/name"
+// @Param        team     query     string  false  "Team owning the CronJobs"
+// @Param        format   query     string  false  "html or pdf" default(html)
+// @Param        before   query     string  false  "Report the last period ending on or before this date (YYYY-MM-DD, default: today)"
+// @Param        cluster  query     string  false  "Cluster (defaults to the local cluster)"
+// @Success      200  {file}    file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /reports/sla [get]
+func (h *Handlers) GetSLAReport(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	period := cmp.Or(q.Get("period"), report.PeriodWeekly)
+	format := cmp.Or(q.Get("format"), "html")
+	if format != "html" && format != "pdf" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "format must be html or pdf")
+		return
+	}
+
+	before := time.Now().UTC()
+	if b := q.Get("before"); b != "" {
+		parsed, err := time.Parse(time.DateOnly, b)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "before must be a date (YYYY-MM-DD)")
+			return
+		}
+		// The period ending at midnight of the date is complete
+		before = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	start, end, err := report.PeriodWindow(period, before)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	scope := report.Scope{Team: q.Get("team")}
+	if m := q.Get("monitor"); m != "" {
+		namespace, name, ok := strings.Cut(m, "/")
+		if !ok || namespace == "" || name == "" {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "monitor must be namespace/name")
+			return
+		}
+		scope.Monitor = &types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	var ownership config.OwnershipConfig
+	if h.config != nil {
+		ownership = h.config.Ownership
+	}
+	rep, err := report.NewBuilder(h.client, h.store, ownership).Build(r.Context(), scope, period, start, end)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Monitor %s not found", scope.Monitor))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	// Rendered to a buffer so a failure can still be reported as an error
+	var buf bytes.Buffer
+	contentType, disposition := "text/html; charset=utf-8", "inline"
+	if format == "pdf" {
+		err = report.RenderPDF(&buf, rep)
+		contentType, disposition = "application/pdf", "attachment"
+	} else {
+		err = report.RenderHTML(&buf, rep)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("%s; filename=%s", disposition, rep.Filename(format)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
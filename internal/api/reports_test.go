@@ -0,0 +1,47 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetSLAReport(t *testing.T) {
+	monitor := &v1alpha1.CronJobMonitor{}
+	monitor.Name = "nightly"
+	monitor.Namespace = "default"
+	monitor.Spec.SLA = &v1alpha1.SLAConfig{}
+	monitor.Status.CronJobs = []v1alpha1.CronJobStatus{{Namespace: "default", Name: "backup"}}
+	h := newTestHandlers(newTestAPIClient(monitor), &testutil.MockStore{}, nil, nil)
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		h.GetSLAReport(w, req)
+		return w
+	}
+
+	w := get("/api/v1/reports/sla?monitor=default/nightly&before=2026-10-12")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "inline; filename=sla-report-weekly-2026-10-05.html", w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Body.String(), "default/backup")
+
+	w = get("/api/v1/reports/sla?period=monthly&format=pdf&before=2026-10-12")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=sla-report-monthly-2026-09-01.pdf", w.Header().Get("Content-Disposition"))
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")))
+
+	assert.Equal(t, http.StatusNotFound, get("/api/v1/reports/sla?monitor=default/missing").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/api/v1/reports/sla?period=daily").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/api/v1/reports/sla?format=docx").Code)
+	assert.Equal(t, http.StatusBadRequest, get("/api/v1/reports/sla?monitor=nightly").Code)
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/controller"
+)
+
+// CreateMonitor handles POST /api/v1/monitors
+// @Summary      Create a monitor
+// @Description  Creates a CronJobMonitor after validating it the way the controller does
+// @Tags         Monitors
+// @Accept       json
+// @Produce      json
+// @Param        monitor  body      guardianv1alpha1.CronJobMonitor  true  "CronJobMonitor to create (metadata.namespace defaults to default)"
+// @Success      201  {object}  guardianv1alpha1.CronJobMonitor
+// @Failure      400  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors [post]
+func (h *Handlers) CreateMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	if err := json.NewDecoder(r.Body).Decode(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if monitor.Name == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "metadata.name is required")
+		return
+	}
+	if monitor.Namespace == "" {
+		monitor.Namespace = "default"
+	}
+	if err := validateMonitor(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	monitor.ResourceVersion = ""
+	monitor.Status = guardianv1alpha1.CronJobMonitorStatus{}
+	if err := h.client.Create(r.Context(), monitor); err != nil {
+		writeResourceError(w, err, fmt.Sprintf("Monitor %s/%s", monitor.Namespace, monitor.Name))
+		return
+	}
+	writeJSON(w, http.StatusCreated, monitor)
+}
+
+// UpdateMonitor handles PUT /api/v1/monitors/:namespace/:name
+// @Summary      Update a monitor
+// @Description  Replaces a CronJobMonitor's spec, labels and annotations. If metadata.resourceVersion is set, the update fails with 409 when the monitor was changed since it was read.
+// @Tags         Monitors
+// @Accept       json
+// @Produce      json
+// @Param        namespace  path      string                           true  "Monitor namespace"
+// @Param        name       path      string                           true  "Monitor name"
+// @Param        monitor    body      guardianv1alpha1.CronJobMonitor  true  "Updated CronJobMonitor"
+// @Success      200  {object}  guardianv1alpha1.CronJobMonitor
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/{namespace}/{name} [put]
+func (h *Handlers) UpdateMonitor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	resource := fmt.Sprintf("Monitor %s/%s", namespace, name)
+
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	if err := json.NewDecoder(r.Body).Decode(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if (monitor.Name != "" && monitor.Name != name) || (monitor.Namespace != "" && monitor.Namespace != namespace) {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "metadata.name and metadata.namespace cannot be changed")
+		return
+	}
+	if err := validateMonitor(monitor); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	existing := &guardianv1alpha1.CronJobMonitor{}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, existing); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	updateMetadata(&existing.ObjectMeta, monitor.ObjectMeta)
+	existing.Spec = monitor.Spec
+	if err := h.client.Update(ctx, existing); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	writeJSON(w, http.StatusOK, existing)
+}
+
+// DeleteMonitor handles DELETE /api/v1/monitors/:namespace/:name
+// @Summary      Delete a monitor
+// @Description  Deletes a CronJobMonitor. Execution history of its CronJobs is kept.
+// @Tags         Monitors
+// @Produce      json
+// @Param        namespace  path      string  true  "Monitor namespace"
+// @Param        name       path      string  true  "Monitor name"
+// @Success      200  {object}  SimpleResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /monitors/{namespace}/{name} [delete]
+func (h *Handlers) DeleteMonitor(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	resource := fmt.Sprintf("Monitor %s/%s", namespace, name)
+
+	monitor := &guardianv1alpha1.CronJobMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	if err := h.client.Delete(r.Context(), monitor); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: resource + " deleted"})
+}
+
+// CreateChannel handles POST /api/v1/channels
+// @Summary      Create an alert channel
+// @Description  Creates an AlertChannel after validating it the way the controller does, including that the secrets it references exist
+// @Tags         Channels
+// @Accept       json
+// @Produce      json
+// @Param        channel  body      guardianv1alpha1.AlertChannel  true  "AlertChannel to create"
+// @Success      201  {object}  guardianv1alpha1.AlertChannel
+// @Failure      400  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /channels [post]
+func (h *Handlers) CreateChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	channel := &guardianv1alpha1.AlertChannel{}
+	if err := json.NewDecoder(r.Body).Decode(channel); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if channel.Name == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "metadata.name is required")
+		return
+	}
+	channel.Namespace = ""
+	if err := controller.ValidateAlertChannel(ctx, h.client, channel); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	channel.ResourceVersion = ""
+	channel.Status = guardianv1alpha1.AlertChannelStatus{}
+	if err := h.client.Create(ctx, channel); err != nil {
+		writeResourceError(w, err, "Channel "+channel.Name)
+		return
+	}
+	writeJSON(w, http.StatusCreated, channel)
+}
+
+// UpdateChannel handles PUT /api/v1/channels/:name
+// @Summary      Update an alert channel
+// @Description  Replaces an AlertChannel's spec, labels and annotations. If metadata.resourceVersion is set, the update fails with 409 when the channel was changed since it was read.
+// @Tags         Channels
+// @Accept       json
+// @Produce      json
+// @Param        name     path      string                         true  "Channel name"
+// @Param        channel  body      guardianv1alpha1.AlertChannel  true  "Updated AlertChannel"
+// @Success      200  {object}  guardianv1alpha1.AlertChannel
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /channels/{name} [put]
+func (h *Handlers) UpdateChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+	resource := "Channel " + name
+
+	channel := &guardianv1alpha1.AlertChannel{}
+	if err := json.NewDecoder(r.Body).Decode(channel); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if channel.Name != "" && channel.Name != name {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "metadata.name cannot be changed")
+		return
+	}
+	if err := controller.ValidateAlertChannel(ctx, h.client, channel); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	existing := &guardianv1alpha1.AlertChannel{}
+	if err := h.client.Get(ctx, types.NamespacedName{Name: name}, existing); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	updateMetadata(&existing.ObjectMeta, channel.ObjectMeta)
+	existing.Spec = channel.Spec
+	if err := h.client.Update(ctx, existing); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	writeJSON(w, http.StatusOK, existing)
+}
+
+// DeleteChannel handles DELETE /api/v1/channels/:name
+// @Summary      Delete an alert channel
+// @Description  Deletes an AlertChannel. Monitors referencing it stop sending alerts to it.
+// @Tags         Channels
+// @Produce      json
+// @Param        name  path      string  true  "Channel name"
+// @Success      200  {object}  SimpleResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /channels/{name} [delete]
+func (h *Handlers) DeleteChannel(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	resource := "Channel " + name
+
+	channel := &guardianv1alpha1.AlertChannel{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := h.client.Delete(r.Context(), channel); err != nil {
+		writeResourceError(w, err, resource)
+		return
+	}
+	writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: resource + " deleted"})
+}
+
+// updateMetadata copies the user-editable metadata of a request onto the
+// stored object. Labels and annotations are replaced when given, and a
+// resourceVersion makes the update fail if the object changed since it was read.
+func updateMetadata(existing *metav1.ObjectMeta, requested metav1.ObjectMeta) {
+	if requested.Labels != nil {
+		existing.Labels = requested.Labels
+	}
+	if requested.Annotations != nil {
+		existing.Annotations = requested.Annotations
+	}
+	if requested.ResourceVersion != "" {
+		existing.ResourceVersion = requested.ResourceVersion
+	}
+}
+
+// writeResourceError writes the API error for a failed read or write of a resource
+func writeResourceError(w http.ResponseWriter, err error, resource string) {
+	switch {
+	case apierrors.IsNotFound(err):
+		writeError(w, http.StatusNotFound, "NOT_FOUND", resource+" not found")
+	case apierrors.IsAlreadyExists(err):
+		writeError(w, http.StatusConflict, "CONFLICT", resource+" already exists")
+	case apierrors.IsConflict(err):
+		writeError(w, http.StatusConflict, "CONFLICT", resource+" was changed since it was read, reload it and try again")
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+	}
+}
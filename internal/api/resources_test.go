@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestCreateMonitor(t *testing.T) {
+	c := newTestAPIClient()
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/monitors", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.CreateMonitor(w, req)
+		return w
+	}
+	body := `{
+		"metadata": {"name": "critical"},
+		"spec": {
+			"selector": {"matchLabels": {"tier": "critical"}},
+			"alerting": {"channelRefs": [{"name": "slack-ops"}]}
+		}
+	}`
+
+	w := post(body)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "critical"}, monitor))
+	assert.Equal(t, map[string]string{"tier": "critical"}, monitor.Spec.Selector.MatchLabels)
+	assert.Equal(t, "slack-ops", monitor.Spec.Alerting.ChannelRefs[0].Name)
+
+	w = post(body)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "already exists")
+
+	w = post(`{"spec": {}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "metadata.name is required")
+
+	w = post(`{
+		"metadata": {"name": "bad"},
+		"spec": {"selector": {"namespaceSelector": {"matchExpressions": [{"key": "env", "operator": "Bogus"}]}}}
+	}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid namespaceSelector")
+}
+
+func TestUpdateMonitor(t *testing.T) {
+	existing := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "critical", Namespace: "prod", Labels: map[string]string{"team": "ops"}},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			Selector: &guardianv1alpha1.CronJobSelector{MatchLabels: map[string]string{"tier": "critical"}},
+		},
+	}
+	c := newTestAPIClient(existing)
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	put := func(name, body string) *httptest.ResponseRecorder {
+		handler := chiRouterWithParams(h.UpdateMonitor, map[string]string{"namespace": "prod", "name": name})
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/monitors/prod/"+name, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handler(w, req)
+		return w
+	}
+
+	w := put("critical", `{"spec": {"selector": {"matchLabels": {"tier": "batch"}}}}`)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	monitor := &guardianv1alpha1.CronJobMonitor{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "prod", Name: "critical"}, monitor))
+	assert.Equal(t, map[string]string{"tier": "batch"}, monitor.Spec.Selector.MatchLabels)
+	assert.Equal(t, map[string]string{"team": "ops"}, monitor.Labels, "labels are kept when not given")
+
+	w = put("critical", `{"metadata": {"resourceVersion": "1"}, "spec": {}}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	w = put("critical", `{"metadata": {"name": "renamed"}, "spec": {}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = put("missing", `{"spec": {}}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestDeleteMonitor(t *testing.T) {
+	c := newTestAPIClient(&guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "critical", Namespace: "prod"},
+	})
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	del := func() *httptest.ResponseRecorder {
+		handler := chiRouterWithParams(h.DeleteMonitor, map[string]string{"namespace": "prod", "name": "critical"})
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/monitors/prod/critical", nil)
+		w := httptest.NewRecorder()
+		handler(w, req)
+		return w
+	}
+
+	w := del()
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "prod", Name: "critical"}, &guardianv1alpha1.CronJobMonitor{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	w = del()
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestChannelWrites(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-webhook", Namespace: "guardian"},
+		Data:       map[string][]byte{"url": []byte("https://hooks.slack.com/services/x")},
+	}
+	c := newTestAPIClient(secret)
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	channelBody := func(key string) string {
+		return `{
+			"metadata": {"name": "slack-ops"},
+			"spec": {
+				"type": "slack",
+				"slack": {
+					"webhookSecretRef": {"name": "slack-webhook", "namespace": "guardian", "key": "` + key + `"},
+					"defaultChannel": "#ops"
+				}
+			}
+		}`
+	}
+
+	// The secret is checked the way the controller checks it
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/channels", strings.NewReader(channelBody("token")))
+	w := httptest.NewRecorder()
+	h.CreateChannel(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "key token not found in secret")
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/channels", strings.NewReader(channelBody("url")))
+	w = httptest.NewRecorder()
+	h.CreateChannel(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var created guardianv1alpha1.AlertChannel
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	assert.Equal(t, "#ops", created.Spec.Slack.DefaultChannel)
+
+	handler := chiRouterWithParams(h.UpdateChannel, map[string]string{"name": "slack-ops"})
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/channels/slack-ops",
+		strings.NewReader(strings.Replace(channelBody("url"), "#ops", "#alerts", 1)))
+	w = httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	channel := &guardianv1alpha1.AlertChannel{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "slack-ops"}, channel))
+	assert.Equal(t, "#alerts", channel.Spec.Slack.DefaultChannel)
+
+	handler = chiRouterWithParams(h.DeleteChannel, map[string]string{"name": "slack-ops"})
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/channels/slack-ops", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	err := c.Get(context.Background(), types.NamespacedName{Name: "slack-ops"}, &guardianv1alpha1.AlertChannel{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
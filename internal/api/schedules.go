@@ -0,0 +1,263 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+)
+
+// GetScheduleConflicts handles GET /api/v1/schedules/conflicts
+// @Summary      Get schedule conflicts
+// @Description  Expands the schedules of all monitored CronJobs and reports windows where many of them start at once, with suggested offsets to stagger them
+// @Tags         Schedules
+// @Produce      json
+// @Param        horizon      query     string  false  "How far ahead schedules are expanded (default 24h, max 168h)"
+// @Param        window       query     string  false  "Width of the windows runs are counted in (default 1m)"
+// @Param        minCronJobs  query     int     false  "CronJobs starting in one window that make a conflict (default 3)"
+// @Param        maxStagger   query     string  false  "Largest suggested offset (default 15m, max 1h)"
+// @Success      200  {object}  ScheduleConflictReportResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /schedules/conflicts [get]
+func (h *Handlers) GetScheduleConflicts(w http.ResponseWriter, r *http.Request) {
+	var opts analyzer.ScheduleConflictOptions
+	q := r.URL.Query()
+
+	if v := q.Get("horizon"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > 7*24*time.Hour {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "horizon must be a duration between 0 and 168h")
+			return
+		}
+		opts.Horizon = d
+	}
+	if v := q.Get("window"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < time.Minute {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "window must be a duration of at least 1m")
+			return
+		}
+		opts.Window = d
+	}
+	if v := q.Get("minCronJobs"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 2 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "minCronJobs must be at least 2")
+			return
+		}
+		opts.MinCronJobs = n
+	}
+	if v := q.Get("maxStagger"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < time.Minute || d > time.Hour {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "maxStagger must be a duration between 1m and 1h")
+			return
+		}
+		opts.MaxStagger = d
+	}
+
+	jobs, err := h.monitoredSchedules(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	report := analyzer.AnalyzeScheduleConflicts(jobs, time.Now(), opts)
+
+	resp := ScheduleConflictReportResponse{
+		GeneratedAt:  report.GeneratedAt,
+		Horizon:      report.Horizon.String(),
+		Window:       report.Window.String(),
+		CronJobs:     report.CronJobs,
+		PeakCronJobs: report.PeakCronJobs,
+		Conflicts:    make([]ScheduleConflictItem, 0, len(report.Conflicts)),
+	}
+	if report.PeakCronJobs > 0 {
+		resp.PeakWindow = &report.PeakWindow
+	}
+	for _, c := range report.Conflicts {
+		item := ScheduleConflictItem{
+			CronJobs:    make([]NamespacedRef, 0, len(c.CronJobs)),
+			NextAt:      c.NextAt,
+			Occurrences: c.Occurrences,
+			Suggestions: make([]StaggerSuggestionItem, 0, len(c.Suggestions)),
+		}
+		for _, cj := range c.CronJobs {
+			item.CronJobs = append(item.CronJobs, NamespacedRef{Namespace: cj.Namespace, Name: cj.Name})
+		}
+		for _, s := range c.Suggestions {
+			item.Suggestions = append(item.Suggestions, StaggerSuggestionItem{
+				CronJob:           NamespacedRef{Namespace: s.CronJob.Namespace, Name: s.CronJob.Name},
+				Schedule:          s.Schedule,
+				Offset:            s.Offset.String(),
+				SuggestedSchedule: s.SuggestedSchedule,
+			})
+		}
+		resp.Conflicts = append(resp.Conflicts, item)
+	}
+	for _, inv := range report.Invalid {
+		resp.Invalid = append(resp.Invalid, InvalidScheduleItem{
+			CronJob:  NamespacedRef{Namespace: inv.CronJob.Namespace, Name: inv.CronJob.Name},
+			Schedule: inv.Schedule,
+			Error:    inv.Error,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetUpcomingRuns handles GET /api/v1/schedules/upcoming
+// @Summary      Get upcoming runs
+// @Description  Forecasts the next scheduled runs of every monitored CronJob in its time zone. Suspended CronJobs are listed without runs.
+// @Tags         Schedules
+// @Produce      json
+// @Param        count      query     int     false  "Runs forecast per CronJob (default 5, max 100)"
+// @Param        within     query     string  false  "Only include runs within this duration from now (max 744h)"
+// @Param        namespace  query     string  false  "Filter by namespace"
+// @Success      200  {object}  UpcomingRunsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /schedules/upcoming [get]
+func (h *Handlers) GetUpcomingRuns(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	count := 5
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 100 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "count must be between 1 and 100")
+			return
+		}
+		count = n
+	}
+	var within time.Duration
+	if v := q.Get("within"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > 31*24*time.Hour {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "within must be a duration between 0 and 744h")
+			return
+		}
+		within = d
+	}
+	namespace := q.Get("namespace")
+
+	cronJobs, err := h.monitoredCronJobList(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	now := time.Now()
+	resp := UpcomingRunsResponse{
+		GeneratedAt: now,
+		CronJobs:    []CronJobUpcomingRuns{},
+		Runs:        []UpcomingRun{},
+	}
+	for _, cj := range cronJobs {
+		if namespace != "" && cj.Namespace != namespace {
+			continue
+		}
+		job := scheduledCronJob(cj)
+		item := CronJobUpcomingRuns{
+			Namespace: cj.Namespace,
+			Name:      cj.Name,
+			Schedule:  job.Schedule,
+			TimeZone:  job.TimeZone,
+			Suspended: cj.Spec.Suspend != nil && *cj.Spec.Suspend,
+			NextRuns:  []time.Time{},
+		}
+		if !item.Suspended {
+			runs, err := analyzer.NextRuns(job, now, count)
+			if err != nil {
+				resp.Invalid = append(resp.Invalid, InvalidScheduleItem{
+					CronJob:  NamespacedRef{Namespace: cj.Namespace, Name: cj.Name},
+					Schedule: job.Schedule,
+					Error:    err.Error(),
+				})
+				continue
+			}
+			for _, t := range runs {
+				if within > 0 && t.Sub(now) > within {
+					break
+				}
+				item.NextRuns = append(item.NextRuns, t)
+				resp.Runs = append(resp.Runs, UpcomingRun{
+					CronJob: NamespacedRef{Namespace: cj.Namespace, Name: cj.Name},
+					Time:    t,
+				})
+			}
+		}
+		resp.CronJobs = append(resp.CronJobs, item)
+	}
+	slices.SortStableFunc(resp.Runs, func(a, b UpcomingRun) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// monitoredSchedules returns the schedules of all unsuspended CronJobs
+// watched by a monitor, ordered by namespace and name
+func (h *Handlers) monitoredSchedules(ctx context.Context) ([]analyzer.ScheduledCronJob, error) {
+	cronJobs, err := h.monitoredCronJobList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []analyzer.ScheduledCronJob
+	for _, cj := range cronJobs {
+		if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+			continue
+		}
+		jobs = append(jobs, scheduledCronJob(cj))
+	}
+	return jobs, nil
+}
+
+// monitoredCronJobList returns all CronJobs watched by a monitor, ordered by
+// namespace and name
+func (h *Handlers) monitoredCronJobList(ctx context.Context) ([]batchv1.CronJob, error) {
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(ctx, monitors); err != nil {
+		return nil, err
+	}
+	monitored := make(map[types.NamespacedName]bool)
+	for _, m := range monitors.Items {
+		for _, cj := range m.Status.CronJobs {
+			monitored[types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}] = true
+		}
+	}
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := h.client.List(ctx, cronJobs); err != nil {
+		return nil, err
+	}
+	var items []batchv1.CronJob
+	for _, cj := range cronJobs.Items {
+		if monitored[types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}] {
+			items = append(items, cj)
+		}
+	}
+	slices.SortFunc(items, func(a, b batchv1.CronJob) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return items, nil
+}
+
+// scheduledCronJob returns the schedule of a CronJob for the analyzer
+func scheduledCronJob(cj batchv1.CronJob) analyzer.ScheduledCronJob {
+	job := analyzer.ScheduledCronJob{
+		CronJob:  types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name},
+		Schedule: cj.Spec.Schedule,
+	}
+	if cj.Spec.TimeZone != nil {
+		job.TimeZone = *cj.Spec.TimeZone
+	}
+	return job
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetScheduleConflicts(t *testing.T) {
+	cronJob := func(name, schedule string, suspend bool) *batchv1.CronJob {
+		return &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: schedule, Suspend: ptr.To(suspend)},
+		}
+	}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "default"},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{
+				{Namespace: "default", Name: "backup"},
+				{Namespace: "default", Name: "cleanup"},
+				{Namespace: "default", Name: "report"},
+				{Namespace: "default", Name: "paused"},
+			},
+		},
+	}
+	c := newTestAPIClient(
+		monitor,
+		cronJob("backup", "0 0 * * *", false),
+		cronJob("cleanup", "0 0 * * *", false),
+		cronJob("report", "0 0 * * *", false),
+		cronJob("paused", "0 0 * * *", true),
+		cronJob("unmonitored", "0 0 * * *", false),
+	)
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/conflicts"+query, nil)
+		w := httptest.NewRecorder()
+		h.GetScheduleConflicts(w, req)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp ScheduleConflictReportResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, 3, resp.CronJobs)
+	assert.Equal(t, 3, resp.PeakCronJobs)
+	require.Len(t, resp.Conflicts, 1)
+	assert.Equal(t, []NamespacedRef{
+		{Namespace: "default", Name: "backup"},
+		{Namespace: "default", Name: "cleanup"},
+		{Namespace: "default", Name: "report"},
+	}, resp.Conflicts[0].CronJobs)
+	assert.Equal(t, []StaggerSuggestionItem{
+		{CronJob: NamespacedRef{Namespace: "default", Name: "cleanup"}, Schedule: "0 0 * * *", Offset: "1m0s", SuggestedSchedule: "1 0 * * *"},
+		{CronJob: NamespacedRef{Namespace: "default", Name: "report"}, Schedule: "0 0 * * *", Offset: "2m0s", SuggestedSchedule: "2 0 * * *"},
+	}, resp.Conflicts[0].Suggestions)
+
+	// Three jobs are not a conflict when four are required
+	w = get("?minCronJobs=4")
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = ScheduleConflictReportResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Empty(t, resp.Conflicts)
+
+	assert.Equal(t, http.StatusBadRequest, get("?horizon=30d").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?window=10s").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?minCronJobs=1").Code)
+}
+
+func TestGetUpcomingRuns(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "default"},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{
+				{Namespace: "default", Name: "hourly"},
+				{Namespace: "default", Name: "frequent"},
+				{Namespace: "default", Name: "paused"},
+				{Namespace: "other", Name: "broken"},
+			},
+		},
+	}
+	c := newTestAPIClient(
+		monitor,
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "hourly", Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *", TimeZone: ptr.To("Asia/Kolkata")},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "frequent", Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: "*/5 * * * *"},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "default"},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 0 * * *", Suspend: ptr.To(true)},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: "other"},
+			Spec:       batchv1.CronJobSpec{Schedule: "not a schedule"},
+		},
+	)
+	h := newTestHandlers(c, &testutil.MockStore{}, nil, nil)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/schedules/upcoming"+query, nil)
+		w := httptest.NewRecorder()
+		h.GetUpcomingRuns(w, req)
+		return w
+	}
+
+	w := get("?count=3")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp UpcomingRunsResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Len(t, resp.CronJobs, 3)
+	assert.Equal(t, "frequent", resp.CronJobs[0].Name)
+	assert.Len(t, resp.CronJobs[0].NextRuns, 3)
+	assert.Equal(t, "hourly", resp.CronJobs[1].Name)
+	assert.Equal(t, "Asia/Kolkata", resp.CronJobs[1].TimeZone)
+	assert.Len(t, resp.CronJobs[1].NextRuns, 3)
+	assert.True(t, resp.CronJobs[2].Suspended)
+	assert.Empty(t, resp.CronJobs[2].NextRuns)
+	require.Len(t, resp.Invalid, 1)
+	assert.Equal(t, "broken", resp.Invalid[0].CronJob.Name)
+
+	require.Len(t, resp.Runs, 6)
+	for i := 1; i < len(resp.Runs); i++ {
+		assert.False(t, resp.Runs[i].Time.Before(resp.Runs[i-1].Time))
+	}
+
+	// The 5 minute schedule runs exactly once within the next 5 minutes
+	w = get("?within=5m&namespace=default")
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = UpcomingRunsResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Len(t, resp.CronJobs[0].NextRuns, 1)
+	assert.Empty(t, resp.Invalid)
+
+	assert.Equal(t, http.StatusBadRequest, get("?count=0").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?count=101").Code)
+	assert.Equal(t, http.StatusBadRequest, get("?within=1y").Code)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/logging"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
@@ -55,6 +56,10 @@ type Server struct {
 	leaderElectionCheck func() bool
 	analyzerEnabled     bool
 	schedulersRunning   []string
+	schedulers          map[string]LastRunReporter
+	certWatchers        map[string]CertificateProvider
+	clusters            map[string]ClusterBackend
+	logLevels           *logging.Levels
 	log                 logr.Logger
 }
 
@@ -69,6 +74,13 @@ type ServerOptions struct {
 	LeaderElectionCheck func() bool
 	AnalyzerEnabled     bool
 	SchedulersRunning   []string
+	// Schedulers and CertWatchers are reported by the diagnostics endpoint, keyed by name
+	Schedulers   map[string]LastRunReporter
+	CertWatchers map[string]CertificateProvider
+	// Clusters are the remote clusters selectable with the cluster query parameter, keyed by name
+	Clusters map[string]ClusterBackend
+	// LogLevels are changed by the loglevel endpoint (optional)
+	LogLevels *logging.Levels
 }
 
 // NewServer creates a new API server
@@ -88,6 +100,10 @@ func NewServer(opts ServerOptions) *Server {
 		leaderElectionCheck: opts.LeaderElectionCheck,
 		analyzerEnabled:     opts.AnalyzerEnabled,
 		schedulersRunning:   opts.SchedulersRunning,
+		schedulers:          opts.Schedulers,
+		certWatchers:        opts.CertWatchers,
+		clusters:            opts.Clusters,
+		logLevels:           opts.LogLevels,
 		log:                 ctrl.Log.WithName("api-server"),
 	}
 }
@@ -187,38 +203,96 @@ func (s *Server) setupRoutes() chi.Router {
 	h := NewHandlers(s.client, s.clientset, s.store, s.config, s.alertDispatcher, s.startTime, s.leaderElectionCheck)
 	h.SetAnalyzerEnabled(s.analyzerEnabled)
 	h.SetSchedulersRunning(s.schedulersRunning)
+	h.SetDiagnosticsSources(s.schedulers, s.certWatchers)
+	h.SetClusters(s.clusters)
+	h.SetLogLevels(s.logLevels)
 
 	// API routes
 	r.Route("/api/v1", func(r chi.Router) {
 		// Health
 		r.Get("/health", h.GetHealth)
-		r.Get("/stats", h.GetStats)
+		r.Get("/stats", h.inCluster((*Handlers).GetStats))
+
+		// Clusters
+		r.Get("/clusters", h.ListClusters)
 
 		// Monitors
-		r.Get("/monitors", h.ListMonitors)
-		r.Get("/monitors/{namespace}/{name}", h.GetMonitor)
+		r.Get("/monitors", h.inCluster((*Handlers).ListMonitors))
+		r.Post("/monitors", h.inCluster((*Handlers).CreateMonitor))
+		r.Post("/monitors/dry-run", h.DryRunMonitor)
+		r.Get("/monitors/{namespace}/{name}", h.inCluster((*Handlers).GetMonitor))
+		r.Put("/monitors/{namespace}/{name}", h.inCluster((*Handlers).UpdateMonitor))
+		r.Delete("/monitors/{namespace}/{name}", h.inCluster((*Handlers).DeleteMonitor))
+		r.Get("/monitors/{namespace}/{name}/manifest", h.inCluster((*Handlers).GetMonitorManifest))
+		r.Post("/monitors/{namespace}/{name}/silence", h.inCluster((*Handlers).SilenceMonitor))
+		r.Delete("/monitors/{namespace}/{name}/silence", h.inCluster((*Handlers).UnsilenceMonitor))
 
 		// CronJobs
-		r.Get("/cronjobs", h.ListCronJobs)
-		r.Get("/cronjobs/{namespace}/{name}", h.GetCronJob)
-		r.Get("/cronjobs/{namespace}/{name}/executions", h.GetExecutions)
-		r.Get("/cronjobs/{namespace}/{name}/executions/{jobName}", h.GetExecutionWithLogs)
-		r.Get("/cronjobs/{namespace}/{name}/executions/{jobName}/logs", h.GetLogs)
-		r.Delete("/cronjobs/{namespace}/{name}/history", h.DeleteCronJobHistory)
-		r.Post("/cronjobs/{namespace}/{name}/trigger", h.TriggerCronJob)
-		r.Post("/cronjobs/{namespace}/{name}/suspend", h.SuspendCronJob)
-		r.Post("/cronjobs/{namespace}/{name}/resume", h.ResumeCronJob)
+		r.Get("/cronjobs", h.inCluster((*Handlers).ListCronJobs))
+		r.Get("/cronjobs/{namespace}/{name}", h.inCluster((*Handlers).GetCronJob))
+		r.Get("/cronjobs/{namespace}/{name}/analytics", h.inCluster((*Handlers).GetCronJobAnalytics))
+		r.Get("/cronjobs/{namespace}/{name}/usage", h.inCluster((*Handlers).GetCronJobUsage))
+		r.Get("/cronjobs/{namespace}/{name}/trend", h.inCluster((*Handlers).GetCronJobTrend))
+		r.Get("/cronjobs/{namespace}/{name}/recommendation", h.inCluster((*Handlers).GetCronJobRecommendation))
+		r.Get("/cronjobs/{namespace}/{name}/spec-diff", h.inCluster((*Handlers).GetSpecDiff))
+		r.Get("/cronjobs/{namespace}/{name}/executions", h.inCluster((*Handlers).GetExecutions))
+		r.Get("/cronjobs/{namespace}/{name}/executions/{jobName}", h.inCluster((*Handlers).GetExecutionWithLogs))
+		r.Get("/cronjobs/{namespace}/{name}/executions/{jobName}/logs", h.inCluster((*Handlers).GetLogs))
+		r.Delete("/cronjobs/{namespace}/{name}/history", h.inCluster((*Handlers).DeleteCronJobHistory))
+		r.Post("/cronjobs/{namespace}/{name}/history/restore", h.inCluster((*Handlers).RestoreCronJobHistory))
+		r.Post("/cronjobs/{namespace}/{name}/trigger", h.inCluster((*Handlers).TriggerCronJob))
+		r.Post("/cronjobs/{namespace}/{name}/suspend", h.inCluster((*Handlers).SuspendCronJob))
+		r.Post("/cronjobs/{namespace}/{name}/resume", h.inCluster((*Handlers).ResumeCronJob))
+
+		// Teams
+		r.Get("/teams", h.inCluster((*Handlers).ListTeams))
+		r.Get("/teams/{team}/cronjobs", h.inCluster((*Handlers).ListTeamCronJobs))
+
+		// Saved views
+		r.Get("/views", h.ListViews)
+		r.Post("/views", h.CreateView)
+		r.Get("/views/{name}", h.GetView)
+		r.Put("/views/{name}", h.UpdateView)
+		r.Delete("/views/{name}", h.DeleteView)
+
+		// Usage
+		r.Get("/usage", h.inCluster((*Handlers).GetUsage))
+
+		// Reports
+		r.Get("/reports/sla", h.inCluster((*Handlers).GetSLAReport))
+
+		// Failure analysis
+		r.Get("/correlations", h.inCluster((*Handlers).GetCorrelations))
+
+		// Schedule analysis
+		r.Get("/schedules/conflicts", h.inCluster((*Handlers).GetScheduleConflicts))
+		r.Get("/schedules/upcoming", h.inCluster((*Handlers).GetUpcomingRuns))
+		r.Get("/calendar.ics", h.inCluster((*Handlers).GetCalendar))
 
 		// Alerts
-		r.Get("/alerts", h.ListAlerts)
-		r.Get("/alerts/history", h.GetAlertHistory)
+		r.Get("/alerts", h.inCluster((*Handlers).ListAlerts))
+		r.Get("/alerts/history", h.inCluster((*Handlers).GetAlertHistory))
+		r.Get("/alerts/deliveries", h.GetAlertDeliveries)
+		r.Post("/alerts/preview", h.inCluster((*Handlers).PreviewAlert))
+		r.Post("/alerts/{alertId}/receipts", h.RecordAlertReceipt)
 
 		// Patterns
 		r.Post("/patterns/test", h.TestPattern)
 
+		// Integrations
+		r.Post("/integrations/slack/interactions", h.SlackInteraction)
+		r.Get("/integrations/grafana/dashboard", h.GetGrafanaDashboard)
+
+		// External jobs
+		r.Post("/pings/{token}/{event}", h.Ping)
+
 		// Channels
 		r.Get("/channels", h.ListChannels)
+		r.Post("/channels", h.CreateChannel)
 		r.Get("/channels/{name}", h.GetChannel)
+		r.Put("/channels/{name}", h.UpdateChannel)
+		r.Delete("/channels/{name}", h.DeleteChannel)
+		r.Get("/channels/{name}/manifest", h.GetChannelManifest)
 		r.Post("/channels/{name}/test", h.TestChannel)
 
 		// Config
@@ -228,6 +302,16 @@ func (s *Server) setupRoutes() chi.Router {
 		r.Route("/admin", func(r chi.Router) {
 			r.Get("/storage-stats", h.GetStorageStats)
 			r.Post("/prune", h.TriggerPrune)
+			r.Get("/legal-holds", h.inCluster((*Handlers).ListLegalHolds))
+			r.Put("/legal-holds/{namespace}/{name}", h.inCluster((*Handlers).SetLegalHold))
+			r.Delete("/legal-holds/{namespace}/{name}", h.inCluster((*Handlers).ReleaseLegalHold))
+			r.Get("/diagnostics", h.GetDiagnostics)
+			r.Get("/diagnostics/bundle", h.GetSupportBundle)
+			r.Post("/backup", h.CreateBackup)
+			r.Post("/import", h.inCluster((*Handlers).ImportExecutions))
+			r.Post("/executions/synthetic", h.inCluster((*Handlers).InjectExecution))
+			r.Get("/loglevel", h.GetLogLevel)
+			r.Put("/loglevel", h.SetLogLevel)
 		})
 	})
 
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+const (
+	// slackMaxRequestAge rejects callbacks older than this to prevent replays
+	slackMaxRequestAge = 5 * time.Minute
+	// slackMaxBodyBytes bounds the size of an interactivity payload
+	slackMaxBodyBytes = 1 << 20
+	// slackResponseTimeout bounds the follow-up message posted to Slack's response_url
+	slackResponseTimeout = 10 * time.Second
+)
+
+// slackInteraction is the subset of a Slack block_actions payload the API uses
+type slackInteraction struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+// SlackInteraction handles POST /api/v1/integrations/slack/interactions
+// @Summary      Slack interactivity callback
+// @Description  Receives button clicks from interactive Slack alerts (Acknowledge, Retry now, Suspend CronJob). Requests must carry a valid Slack signature.
+// @Tags         Integrations
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Success      200
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /integrations/slack/interactions [post]
+func (h *Handlers) SlackInteraction(w http.ResponseWriter, r *http.Request) {
+	if h.config == nil || h.config.UI.SlackSigningSecret == "" {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Slack interactivity is not configured")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, slackMaxBodyBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "Failed to read request body")
+		return
+	}
+
+	if err := verifySlackSignature(h.config.UI.SlackSigningSecret, r.Header, body, time.Now()); err != nil {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid form body")
+		return
+	}
+	var payload slackInteraction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid interaction payload")
+		return
+	}
+
+	// Other interaction types and URL-only buttons (View in dashboard, Open runbook) need no action
+	if payload.Type != "block_actions" || len(payload.Actions) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	action := payload.Actions[0]
+	if action.ActionID == alerting.SlackActionViewDashboard || action.ActionID == alerting.SlackActionOpenRunbook {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var target alerting.SlackActionValue
+	if err := json.Unmarshal([]byte(action.Value), &target); err != nil || target.Namespace == "" || target.Name == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid action value")
+		return
+	}
+
+	text := h.runSlackAction(r.Context(), action.ActionID, target, payload.User.ID, payload.User.Username)
+
+	if payload.ResponseURL != "" {
+		go postSlackResponse(payload.ResponseURL, text)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// runSlackAction performs a Slack button action and returns the message to post back
+func (h *Handlers) runSlackAction(ctx context.Context, actionID string, target alerting.SlackActionValue, userID, userName string) string {
+	cronJob := fmt.Sprintf("`%s/%s`", target.Namespace, target.Name)
+	mention := fmt.Sprintf("<@%s>", userID)
+	if userName == "" {
+		userName = userID
+	}
+
+	switch actionID {
+	case alerting.SlackActionAcknowledge:
+		if h.alertDispatcher == nil || !h.alertDispatcher.Acknowledge(target.AlertKey, "slack:"+userName) {
+			return fmt.Sprintf(":information_source: The alert for %s is no longer active.", cronJob)
+		}
+		return fmt.Sprintf(":white_check_mark: %s acknowledged the alert for %s.", mention, cronJob)
+
+	case alerting.SlackActionRetry:
+		jobName, err := h.triggerCronJob(ctx, target.Namespace, target.Name)
+		if err != nil {
+			return slackActionError("retry", cronJob, err)
+		}
+		return fmt.Sprintf(":arrows_counterclockwise: %s started job `%s` for %s.", mention, jobName, cronJob)
+
+	case alerting.SlackActionSuspend:
+		if err := h.setCronJobSuspend(ctx, target.Namespace, target.Name, true); err != nil {
+			return slackActionError("suspend", cronJob, err)
+		}
+		return fmt.Sprintf(":double_vertical_bar: %s suspended %s.", mention, cronJob)
+
+	default:
+		return fmt.Sprintf(":warning: Unknown action %q.", actionID)
+	}
+}
+
+// slackActionError formats a failed action for Slack
+func slackActionError(verb, cronJob string, err error) string {
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf(":warning: Could not %s %s: CronJob not found.", verb, cronJob)
+	}
+	return fmt.Sprintf(":warning: Could not %s %s: %v", verb, cronJob, err)
+}
+
+// verifySlackSignature checks Slack's v0 request signature and rejects stale requests
+func verifySlackSignature(secret string, header http.Header, body []byte, now time.Time) error {
+	ts := header.Get("X-Slack-Request-Timestamp")
+	sig := header.Get("X-Slack-Signature")
+	if ts == "" || sig == "" {
+		return fmt.Errorf("missing Slack signature headers")
+	}
+
+	seconds, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid Slack request timestamp")
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > slackMaxRequestAge || age < -slackMaxRequestAge {
+		return fmt.Errorf("stale Slack request")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "v0:%s:", ts)
+	_, _ = mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return fmt.Errorf("invalid Slack signature")
+	}
+	return nil
+}
+
+// postSlackResponse posts a follow-up message to the interaction's response_url
+func postSlackResponse(responseURL, text string) {
+	ctx, cancel := context.WithTimeout(context.Background(), slackResponseTimeout)
+	defer cancel()
+
+	payload, _ := json.Marshal(map[string]any{
+		"response_type":    "in_channel",
+		"replace_original": false,
+		"text":             text,
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(payload))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := alerting.AlertHTTPClient.Do(req)
+	if err != nil {
+		ctrl.Log.WithName("api-server").Error(err, "failed to post Slack response")
+		return
+	}
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+const testSlackSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+// slackRequest builds a signed Slack interactivity request for a button click
+func slackRequest(t *testing.T, actionID, responseURL string, signedAt time.Time) *http.Request {
+	t.Helper()
+	value, err := json.Marshal(alerting.SlackActionValue{AlertKey: "default/test-cron/JobFailed", Namespace: "default", Name: "test-cron"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(map[string]any{
+		"type":         "block_actions",
+		"user":         map[string]string{"id": "U123", "username": "alice"},
+		"actions":      []map[string]string{{"action_id": actionID, "value": string(value)}},
+		"response_url": responseURL,
+	})
+	require.NoError(t, err)
+
+	body := "payload=" + url.QueryEscape(string(payload))
+	ts := strconv.FormatInt(signedAt.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(testSlackSigningSecret))
+	_, _ = fmt.Fprintf(mac, "v0:%s:%s", ts, body)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/integrations/slack/interactions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Slack-Request-Timestamp", ts)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+// slackResponseServer captures the follow-up message posted to response_url
+func slackResponseServer(t *testing.T) (*httptest.Server, <-chan string) {
+	t.Helper()
+	texts := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&msg)
+		texts <- msg.Text
+	}))
+	t.Cleanup(server.Close)
+	return server, texts
+}
+
+func waitForSlackResponse(t *testing.T, texts <-chan string) string {
+	t.Helper()
+	select {
+	case text := <-texts:
+		return text
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response posted to response_url")
+		return ""
+	}
+}
+
+func slackTestConfig() *config.Config {
+	return &config.Config{UI: config.UIConfig{SlackSigningSecret: testSlackSigningSecret}}
+}
+
+func slackTestCronJob() *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cron", Namespace: "default"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "*/5 * * * *",
+			Suspend:  ptr.To(false),
+		},
+	}
+}
+
+func TestSlackInteraction_NotConfigured(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, &config.Config{}, nil)
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionAcknowledge, "", time.Now()))
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestSlackInteraction_InvalidSignature(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, slackTestConfig(), testutil.NewMockDispatcher())
+
+	req := slackRequest(t, alerting.SlackActionAcknowledge, "", time.Now())
+	req.Header.Set("X-Slack-Signature", "v0=deadbeef")
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestSlackInteraction_StaleRequest(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, slackTestConfig(), testutil.NewMockDispatcher())
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionAcknowledge, "", time.Now().Add(-10*time.Minute)))
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestSlackInteraction_Acknowledge(t *testing.T) {
+	dispatcher := testutil.NewMockDispatcher()
+	h := newTestHandlers(newTestAPIClient(), nil, slackTestConfig(), dispatcher)
+	server, texts := slackResponseServer(t)
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionAcknowledge, server.URL, time.Now()))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, waitForSlackResponse(t, texts), "<@U123> acknowledged")
+	assert.Equal(t, []string{"default/test-cron/JobFailed"}, dispatcher.AcknowledgedAlerts)
+}
+
+func TestSlackInteraction_Retry(t *testing.T) {
+	c := newTestAPIClient(slackTestCronJob())
+	h := newTestHandlers(c, nil, slackTestConfig(), testutil.NewMockDispatcher())
+	server, texts := slackResponseServer(t)
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionRetry, server.URL, time.Now()))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, waitForSlackResponse(t, texts), "test-cron-manual-")
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobs))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "test-cron", jobs.Items[0].Labels["guardian.illenium.net/parent"])
+}
+
+func TestSlackInteraction_Suspend(t *testing.T) {
+	c := newTestAPIClient(slackTestCronJob())
+	h := newTestHandlers(c, nil, slackTestConfig(), testutil.NewMockDispatcher())
+	server, texts := slackResponseServer(t)
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionSuspend, server.URL, time.Now()))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, waitForSlackResponse(t, texts), "suspended")
+
+	cj := &batchv1.CronJob{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cron"}, cj))
+	assert.True(t, *cj.Spec.Suspend)
+}
+
+func TestSlackInteraction_CronJobNotFound(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, slackTestConfig(), testutil.NewMockDispatcher())
+	server, texts := slackResponseServer(t)
+
+	w := httptest.NewRecorder()
+	h.SlackInteraction(w, slackRequest(t, alerting.SlackActionRetry, server.URL, time.Now()))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, waitForSlackResponse(t, texts), "CronJob not found")
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	// Example from Slack's request signing documentation
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", "1531420618")
+	header.Set("X-Slack-Signature", "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503")
+
+	assert.NoError(t, verifySlackSignature(testSlackSigningSecret, header, body, time.Unix(1531420618, 0)))
+	assert.Error(t, verifySlackSignature("wrong-secret", header, body, time.Unix(1531420618, 0)))
+	assert.Error(t, verifySlackSignature(testSlackSigningSecret, http.Header{}, body, time.Unix(1531420618, 0)))
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// GetSpecDiff handles GET /api/v1/cronjobs/:namespace/:name/spec-diff
+// @Summary      Get Job spec changes between executions
+// @Description  Compares the Job spec (images, commands, arguments, environment variable names and service account) recorded with two executions, by default the last successful run and the first failed run after it, to tell whether a deploy broke the CronJob
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true   "CronJob namespace"
+// @Param        name       path      string  true   "CronJob name"
+// @Param        from       query     string  false  "Job name of the earlier execution (default: last successful run)"
+// @Param        to         query     string  false  "Job name of the later execution (default: first failed run after from)"
+// @Success      200  {object}  SpecDiffResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/spec-diff [get]
+func (h *Handlers) GetSpecDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+	cronJobNN := types.NamespacedName{Namespace: namespace, Name: name}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	// getExecution returns the execution of one of the CronJob's Jobs
+	getExecution := func(jobName string) (*store.Execution, bool) {
+		exec, err := h.store.GetExecutionByJobName(ctx, namespace, jobName)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return nil, false
+		}
+		if exec == nil || exec.CronJobName != name {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Execution %s of CronJob %s/%s not found", jobName, namespace, name))
+			return nil, false
+		}
+		return exec, true
+	}
+
+	var from, to *store.Execution
+	var ok bool
+	if jobName := r.URL.Query().Get("from"); jobName != "" {
+		if from, ok = getExecution(jobName); !ok {
+			return
+		}
+	} else {
+		var err error
+		from, err = h.store.GetLastSuccessfulExecution(ctx, cronJobNN)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		if from == nil {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No successful execution of CronJob %s/%s recorded", namespace, name))
+			return
+		}
+	}
+	if jobName := r.URL.Query().Get("to"); jobName != "" {
+		if to, ok = getExecution(jobName); !ok {
+			return
+		}
+	} else {
+		execs, err := h.store.GetExecutions(ctx, cronJobNN, from.StartTime)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		to = firstFailureAfter(execs, from)
+	}
+
+	resp := SpecDiffResponse{
+		CronJob: NamespacedRef{Namespace: namespace, Name: name},
+		From:    specDiffExecution(from),
+		Changes: []SpecChange{},
+	}
+	if to == nil {
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	resp.To = specDiffExecution(to)
+
+	for _, exec := range []*store.Execution{from, to} {
+		if exec.Spec == "" {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Execution %s has no spec snapshot, it was recorded before spec tracking", exec.JobName))
+			return
+		}
+	}
+	resp.Changed = from.SpecHash != to.SpecHash
+	if resp.Changed {
+		resp.Changes = specChanges(from.GetSpec(), to.GetSpec())
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// firstFailureAfter returns the earliest failed execution that started after
+// from, given executions newest first
+func firstFailureAfter(execs []store.Execution, from *store.Execution) *store.Execution {
+	var first *store.Execution
+	for i := range execs {
+		exec := &execs[i]
+		if !exec.Succeeded && exec.StartTime.After(from.StartTime) {
+			first = exec
+		}
+	}
+	return first
+}
+
+func specDiffExecution(exec *store.Execution) *SpecDiffExecution {
+	status := statusFailed
+	if exec.Succeeded {
+		status = statusSuccess
+	}
+	return &SpecDiffExecution{
+		JobName:   exec.JobName,
+		Status:    status,
+		StartTime: exec.StartTime,
+		SpecHash:  exec.SpecHash,
+	}
+}
+
+// specChanges lists the differences between two spec snapshots. Containers
+// are matched by name; environment variables are compared by name only.
+func specChanges(from, to *store.JobSpecSnapshot) []SpecChange {
+	if from == nil || to == nil {
+		return []SpecChange{}
+	}
+	changes := []SpecChange{}
+	change := func(field, old, updated string) {
+		if old != updated {
+			changes = append(changes, SpecChange{Field: field, From: old, To: updated})
+		}
+	}
+
+	change("serviceAccountName", from.ServiceAccountName, to.ServiceAccountName)
+
+	containerField := func(c store.ContainerSpec) string {
+		if c.Init {
+			return fmt.Sprintf("initContainers[%s]", c.Name)
+		}
+		return fmt.Sprintf("containers[%s]", c.Name)
+	}
+	findContainer := func(containers []store.ContainerSpec, c store.ContainerSpec) *store.ContainerSpec {
+		i := slices.IndexFunc(containers, func(o store.ContainerSpec) bool {
+			return o.Name == c.Name && o.Init == c.Init
+		})
+		if i < 0 {
+			return nil
+		}
+		return &containers[i]
+	}
+
+	for _, old := range from.Containers {
+		field := containerField(old)
+		updated := findContainer(to.Containers, old)
+		if updated == nil {
+			change(field, old.Image, "")
+			continue
+		}
+		change(field+".image", old.Image, updated.Image)
+		change(field+".command", strings.Join(old.Command, " "), strings.Join(updated.Command, " "))
+		change(field+".args", strings.Join(old.Args, " "), strings.Join(updated.Args, " "))
+		for _, env := range old.Env {
+			if !slices.Contains(updated.Env, env) {
+				change(field+".env", env, "")
+			}
+		}
+		for _, env := range updated.Env {
+			if !slices.Contains(old.Env, env) {
+				change(field+".env", "", env)
+			}
+		}
+	}
+	for _, added := range to.Containers {
+		if findContainer(from.Containers, added) == nil {
+			change(containerField(added), "", added.Image)
+		}
+	}
+	return changes
+}
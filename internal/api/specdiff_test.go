@@ -0,0 +1,149 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func specExecution(jobName string, start time.Time, succeeded bool, spec store.JobSpecSnapshot) store.Execution {
+	exec := store.Execution{
+		CronJobNamespace: "default",
+		CronJobName:      "backup",
+		JobName:          jobName,
+		StartTime:        start,
+		Succeeded:        succeeded,
+	}
+	exec.SetSpec(spec)
+	return exec
+}
+
+func TestGetSpecDiff(t *testing.T) {
+	now := time.Now()
+	before := store.JobSpecSnapshot{Containers: []store.ContainerSpec{
+		{Name: "backup", Image: "backup:1.0", Args: []string{"--all"}, Env: []string{"BUCKET", "REGION"}},
+	}}
+	after := store.JobSpecSnapshot{ServiceAccountName: "backup", Containers: []store.ContainerSpec{
+		{Name: "migrate", Init: true, Image: "migrate:2.0"},
+		{Name: "backup", Image: "backup:1.1", Args: []string{"--all"}, Env: []string{"BUCKET", "DRY_RUN"}},
+	}}
+
+	// Executions are newest first
+	mockStore := &testutil.MockStore{Executions: []store.Execution{
+		specExecution("backup-4", now, false, after),
+		specExecution("backup-3", now.Add(-time.Hour), false, after),
+		specExecution("backup-2", now.Add(-2*time.Hour), true, before),
+		specExecution("backup-1", now.Add(-3*time.Hour), true, before),
+	}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	handler := chiRouterWithParams(h.GetSpecDiff, map[string]string{"namespace": "default", "name": "backup"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/spec-diff", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp SpecDiffResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.NotNil(t, resp.From)
+	require.NotNil(t, resp.To)
+	assert.Equal(t, "backup-2", resp.From.JobName)
+	assert.Equal(t, "backup-3", resp.To.JobName)
+	assert.True(t, resp.Changed)
+	assert.Equal(t, []SpecChange{
+		{Field: "serviceAccountName", To: "backup"},
+		{Field: "containers[backup].image", From: "backup:1.0", To: "backup:1.1"},
+		{Field: "containers[backup].env", From: "REGION"},
+		{Field: "containers[backup].env", To: "DRY_RUN"},
+		{Field: "initContainers[migrate]", To: "migrate:2.0"},
+	}, resp.Changes)
+}
+
+func TestGetSpecDiff_Unchanged(t *testing.T) {
+	now := time.Now()
+	spec := store.JobSpecSnapshot{Containers: []store.ContainerSpec{{Name: "backup", Image: "backup:1.0"}}}
+	mockStore := &testutil.MockStore{Executions: []store.Execution{
+		specExecution("backup-2", now, false, spec),
+		specExecution("backup-1", now.Add(-time.Hour), true, spec),
+	}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	handler := chiRouterWithParams(h.GetSpecDiff, map[string]string{"namespace": "default", "name": "backup"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/spec-diff", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp SpecDiffResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.False(t, resp.Changed)
+	assert.Empty(t, resp.Changes)
+}
+
+func TestGetSpecDiff_NoFailure(t *testing.T) {
+	mockStore := &testutil.MockStore{Executions: []store.Execution{
+		specExecution("backup-1", time.Now(), true, store.JobSpecSnapshot{}),
+	}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+
+	handler := chiRouterWithParams(h.GetSpecDiff, map[string]string{"namespace": "default", "name": "backup"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/spec-diff", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp SpecDiffResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, "backup-1", resp.From.JobName)
+	assert.Nil(t, resp.To)
+	assert.False(t, resp.Changed)
+}
+
+func TestGetSpecDiff_NotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		store    *testutil.MockStore
+		query    string
+		contains string
+	}{
+		{
+			name:     "no successful run",
+			store:    &testutil.MockStore{},
+			contains: "No successful execution",
+		},
+		{
+			name:     "execution of another CronJob",
+			store:    &testutil.MockStore{ExecutionByJobName: &store.Execution{CronJobName: "other", JobName: "other-1"}},
+			query:    "?from=other-1",
+			contains: "Execution other-1 of CronJob default/backup not found",
+		},
+		{
+			name: "recorded before spec tracking",
+			store: &testutil.MockStore{Executions: []store.Execution{
+				{CronJobName: "backup", JobName: "backup-2", StartTime: time.Now()},
+				{CronJobName: "backup", JobName: "backup-1", StartTime: time.Now().Add(-time.Hour), Succeeded: true},
+			}},
+			contains: "has no spec snapshot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandlers(newTestAPIClient(), tt.store, nil, nil)
+			handler := chiRouterWithParams(h.GetSpecDiff, map[string]string{"namespace": "default", "name": "backup"})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/spec-diff"+tt.query, nil)
+			w := httptest.NewRecorder()
+			handler(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Contains(t, w.Body.String(), tt.contains)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// syntheticReason is the default reason of an injected failure
+const syntheticReason = "SyntheticFailure"
+
+// InjectExecution handles POST /api/v1/admin/executions/synthetic
+// @Summary      Inject a synthetic execution
+// @Description  Records a synthetic run of a monitored CronJob without running a pod, and alerts on it the way the controller alerts on a real run: a failure raises a JobFailed alert on each of the CronJob's monitors, a success resolves JobFailed, DeadManTriggered and SuspendedTooLong alerts. Use it to rehearse alerting and escalation end-to-end. The execution is flagged as synthetic and alert titles are prefixed with [Synthetic].
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        request  body      InjectExecutionRequest  true  "CronJob and outcome of the run"
+// @Success      201  {object}  InjectExecutionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /admin/executions/synthetic [post]
+func (h *Handlers) InjectExecution(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	var req InjectExecutionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if req.Namespace == "" || req.CronJob == "" {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "namespace and cronJob are required")
+		return
+	}
+	if req.Status != statusSuccess && req.Status != statusFailed {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST",
+			fmt.Sprintf("status must be %s or %s, not %q", statusSuccess, statusFailed, req.Status))
+		return
+	}
+	var duration time.Duration
+	if req.Duration != "" {
+		d, err := parseImportDuration(req.Duration)
+		if err != nil || d < 0 {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("invalid duration %q", req.Duration))
+			return
+		}
+		duration = d
+	}
+	cronJobNN := types.NamespacedName{Namespace: req.Namespace, Name: req.CronJob}
+
+	cronJob := &batchv1.CronJob{}
+	if err := h.client.Get(ctx, cronJobNN, cronJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s not found", cronJobNN))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	monitors, err := h.cronJobMonitors(ctx, cronJobNN)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if len(monitors) == 0 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("CronJob %s is not monitored", cronJobNN))
+		return
+	}
+
+	now := time.Now()
+	exec := store.Execution{
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		CronJobUID:       string(cronJob.UID),
+		JobName:          fmt.Sprintf("%s-synthetic-%d", cronJob.Name, now.Unix()),
+		StartTime:        now.Add(-duration),
+		CompletionTime:   now,
+		Succeeded:        req.Status == statusSuccess,
+		ExitCode:         req.ExitCode,
+		Reason:           req.Reason,
+		Synthetic:        true,
+	}
+	exec.SetDuration(duration)
+	if !exec.Succeeded {
+		if exec.ExitCode == 0 {
+			exec.ExitCode = 1
+		}
+		if exec.Reason == "" {
+			exec.Reason = syntheticReason
+		}
+	}
+	if err := h.store.RecordExecution(ctx, exec); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("Failed to record execution: %s", err))
+		return
+	}
+
+	resp := InjectExecutionResponse{JobName: exec.JobName, Status: req.Status}
+	for i := range monitors {
+		resp.Monitors = append(resp.Monitors, NamespacedRef{Namespace: monitors[i].Namespace, Name: monitors[i].Name})
+	}
+	h.alertSyntheticRun(ctx, monitors, cronJob, exec)
+
+	log.FromContext(ctx).Info("injected synthetic execution",
+		"cluster", h.cluster, "cronJob", cronJobNN.String(), "jobName", exec.JobName, "succeeded", exec.Succeeded)
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// alertSyntheticRun sends a JobFailed alert per monitor for a synthetic
+// failure, and clears the alerts a real success clears after a synthetic success
+func (h *Handlers) alertSyntheticRun(ctx context.Context, monitors []guardianv1alpha1.CronJobMonitor, cronJob *batchv1.CronJob, exec store.Execution) {
+	if h.alertDispatcher == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	dispatcher := alerting.ForCluster(h.alertDispatcher, h.cluster)
+	ref := types.NamespacedName{Namespace: cronJob.Namespace, Name: cronJob.Name}
+
+	if exec.Succeeded {
+		for _, alertType := range []string{"JobFailed", "DeadManTriggered", "SuspendedTooLong"} {
+			_ = dispatcher.ClearAlert(ctx, fmt.Sprintf("%s/%s/%s", ref.Namespace, ref.Name, alertType))
+			_ = h.store.ResolveAlert(ctx, alertType, ref.Namespace, ref.Name)
+		}
+		return
+	}
+
+	for i := range monitors {
+		monitor := &monitors[i]
+		alert := alerting.Alert{
+			Key:      fmt.Sprintf("%s/%s/JobFailed", ref.Namespace, ref.Name),
+			Type:     "JobFailed",
+			Severity: monitor.Spec.Alerting.SeverityFor("JobFailed", "critical"),
+			Title:    fmt.Sprintf("[Synthetic] CronJob %s/%s failed", ref.Namespace, ref.Name),
+			Message: fmt.Sprintf("Synthetic failure injected through the admin API (exit code %d, reason %s). No pod was run.",
+				exec.ExitCode, exec.Reason),
+			CronJob: ref,
+			Context: alerting.AlertContext{ExitCode: exec.ExitCode, Reason: exec.Reason},
+			MonitorRef: types.NamespacedName{
+				Namespace: monitor.Namespace,
+				Name:      monitor.Name,
+			},
+			Timestamp: exec.CompletionTime,
+		}
+		if monitor.Spec.Alerting != nil {
+			alert.Context.PropagateMetadata(monitor.Spec.Alerting.IncludeContext, cronJob)
+		}
+		if err := dispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+			logger.Error(err, "failed to dispatch synthetic failure alert", "cronJob", ref.String(), "monitor", alert.MonitorRef.String())
+		}
+	}
+}
+
+// cronJobMonitors returns the monitors whose status lists the CronJob
+func (h *Handlers) cronJobMonitors(ctx context.Context, cronJob types.NamespacedName) ([]guardianv1alpha1.CronJobMonitor, error) {
+	monitors := &guardianv1alpha1.CronJobMonitorList{}
+	if err := h.client.List(ctx, monitors); err != nil {
+		return nil, err
+	}
+	var result []guardianv1alpha1.CronJobMonitor
+	for i := range monitors.Items {
+		for _, cj := range monitors.Items[i].Status.CronJobs {
+			if cj.Namespace == cronJob.Namespace && cj.Name == cronJob.Name {
+				result = append(result, monitors.Items[i])
+				break
+			}
+		}
+	}
+	return result, nil
+}
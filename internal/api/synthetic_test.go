@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestInjectExecution(t *testing.T) {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "prod", UID: "uid-1"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *"},
+	}
+	unmonitored := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "cleanup", Namespace: "prod"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 3 * * *"},
+	}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "critical", Namespace: "prod"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			Alerting: &guardianv1alpha1.AlertingConfig{
+				SeverityOverrides: guardianv1alpha1.SeverityOverrides{"jobFailed": "warning"},
+			},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Namespace: "prod", Name: "backup"}},
+		},
+	}
+	mockStore := &testutil.MockStore{}
+	disp := testutil.NewMockDispatcher()
+	h := newTestHandlers(newTestAPIClient(cronJob, unmonitored, monitor), mockStore, nil, disp)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/executions/synthetic", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.InjectExecution(w, req)
+		return w
+	}
+
+	w := post(`{"namespace": "prod", "cronJob": "backup", "status": "failed", "duration": "90s"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var resp InjectExecutionResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, []NamespacedRef{{Namespace: "prod", Name: "critical"}}, resp.Monitors)
+	assert.Contains(t, resp.JobName, "backup-synthetic-")
+
+	require.Len(t, mockStore.RecordedExecutions, 1)
+	exec := mockStore.RecordedExecutions[0]
+	assert.True(t, exec.Synthetic)
+	assert.False(t, exec.Succeeded)
+	assert.Equal(t, int32(1), exec.ExitCode)
+	assert.Equal(t, "SyntheticFailure", exec.Reason)
+	assert.Equal(t, "uid-1", exec.CronJobUID)
+	assert.Equal(t, "1m30s", exec.Duration().String())
+
+	require.Len(t, disp.DispatchedAlerts, 1)
+	alert := disp.DispatchedAlerts[0]
+	assert.Equal(t, "JobFailed", alert.Type)
+	assert.Equal(t, "warning", alert.Severity)
+	assert.Equal(t, "prod/backup/JobFailed", alert.Key)
+	assert.True(t, strings.HasPrefix(alert.Title, "[Synthetic]"))
+	assert.Equal(t, "critical", alert.MonitorRef.Name)
+
+	// A synthetic success resolves the alert like a real one
+	w = post(`{"namespace": "prod", "cronJob": "backup", "status": "success"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Len(t, disp.DispatchedAlerts, 1)
+	assert.Contains(t, disp.ClearedAlerts, "prod/backup/JobFailed")
+	assert.True(t, mockStore.RecordedExecutions[1].Succeeded)
+
+	for body, code := range map[string]int{
+		`{"namespace": "prod", "cronJob": "backup", "status": "running"}`:                    http.StatusBadRequest,
+		`{"namespace": "prod", "status": "failed"}`:                                          http.StatusBadRequest,
+		`{"namespace": "prod", "cronJob": "backup", "status": "failed", "duration": "soon"}`: http.StatusBadRequest,
+		`{"namespace": "prod", "cronJob": "missing", "status": "failed"}`:                    http.StatusNotFound,
+		`{"namespace": "prod", "cronJob": "cleanup", "status": "failed"}`:                    http.StatusNotFound,
+	} {
+		w = post(body)
+		assert.Equal(t, code, w.Code, body)
+	}
+	assert.Len(t, mockStore.RecordedExecutions, 2)
+}
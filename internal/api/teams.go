@@ -0,0 +1,116 @@
+package api
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// unassignedTeam groups the CronJobs no team owns in the team views
+const unassignedTeam = "unassigned"
+
+// teamFor returns the team owning a CronJob or ExternalJob, empty if none
+func (h *Handlers) teamFor(namespace string, labels map[string]string) string {
+	if h.config == nil {
+		return ""
+	}
+	return h.config.Ownership.TeamFor(namespace, labels)
+}
+
+// teamMatches reports whether a job owned by team passes the team filter
+func teamMatches(filter, team string) bool {
+	switch filter {
+	case "":
+		return true
+	case unassignedTeam:
+		return team == ""
+	default:
+		return team == filter
+	}
+}
+
+// ListTeams handles GET /api/v1/teams
+// @Summary      List teams
+// @Description  Returns the teams owning monitored CronJobs with the health of their CronJobs. CronJobs without an owner are grouped under "unassigned".
+// @Tags         Teams
+// @Produce      json
+// @Success      200  {object}  TeamListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /teams [get]
+func (h *Handlers) ListTeams(w http.ResponseWriter, r *http.Request) {
+	list, err := h.listCronJobs(r.Context(), cronJobListFilter{})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	byTeam := make(map[string]*TeamSummary)
+	for _, item := range list.Items {
+		team := cmp.Or(item.Team, unassignedTeam)
+		t, ok := byTeam[team]
+		if !ok {
+			t = &TeamSummary{Team: team}
+			byTeam[team] = t
+		}
+		t.CronJobs++
+		t.ActiveAlerts += item.ActiveAlerts
+		t.AvgSuccessRate += item.SuccessRate
+		switch item.Status {
+		case "healthy":
+			t.Summary.Healthy++
+		case "warning":
+			t.Summary.Warning++
+		case "critical":
+			t.Summary.Critical++
+		}
+		if item.Suspended {
+			t.Summary.Suspended++
+		}
+		if len(item.ActiveJobs) > 0 {
+			t.Summary.Running++
+		}
+	}
+
+	items := make([]TeamSummary, 0, len(byTeam))
+	for _, t := range byTeam {
+		t.AvgSuccessRate /= float64(t.CronJobs)
+		items = append(items, *t)
+	}
+	slices.SortFunc(items, func(a, b TeamSummary) int {
+		return cmp.Compare(a.Team, b.Team)
+	})
+
+	writeJSON(w, http.StatusOK, TeamListResponse{Items: items})
+}
+
+// ListTeamCronJobs handles GET /api/v1/teams/:team/cronjobs
+// @Summary      List a team's CronJobs
+// @Description  Returns the monitored CronJobs owned by a team, with the same filters as the CronJob list
+// @Tags         Teams
+// @Produce      json
+// @Param        team       path      string  true   "Team name (unassigned for CronJobs without an owner)"
+// @Param        namespace  query     string  false  "Filter by namespace"
+// @Param        status     query     string  false  "Filter by status (healthy, warning, critical, suspended)"
+// @Param        suspended  query     bool    false  "Only include suspended (true) or unsuspended (false) CronJobs"
+// @Param        excludeIntentionallySuspended  query  bool  false  "Hide CronJobs on their monitor's intentionally suspended list"
+// @Success      200  {object}  CronJobListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /teams/{team}/cronjobs [get]
+func (h *Handlers) ListTeamCronJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseCronJobListFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	filter.team = chi.URLParam(r, "team")
+
+	resp, err := h.listCronJobs(r.Context(), filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
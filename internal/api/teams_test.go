@@ -0,0 +1,101 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+func newTeamsTestHandlers() *Handlers {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "default"},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{
+				{Name: "invoices", Namespace: "billing", Status: "healthy", Metrics: &guardianv1alpha1.CronJobMetrics{SuccessRate: 100}},
+				{Name: "refunds", Namespace: "billing", Status: "critical", ActiveAlerts: []guardianv1alpha1.ActiveAlert{{Type: "JobFailed"}}, Metrics: &guardianv1alpha1.CronJobMetrics{SuccessRate: 50}},
+				{Name: "etl", Namespace: "data", Status: "healthy"},
+				{Name: "cleanup", Namespace: "default", Status: "warning"},
+			},
+		},
+	}
+	cronJob := func(namespace, name string, labels map[string]string) *batchv1.CronJob {
+		return &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *"},
+		}
+	}
+	c := newTestAPIClient(
+		monitor,
+		cronJob("billing", "invoices", map[string]string{"team": "payments"}),
+		cronJob("billing", "refunds", nil),
+		cronJob("data", "etl", map[string]string{"team": "analytics"}),
+		cronJob("default", "cleanup", nil),
+	)
+	cfg := &config.Config{Ownership: config.OwnershipConfig{
+		TeamLabels:     []string{"team"},
+		NamespaceTeams: map[string]string{"billing": "payments"},
+	}}
+	return newTestHandlers(c, nil, cfg, nil)
+}
+
+func TestListTeams(t *testing.T) {
+	h := newTeamsTestHandlers()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/teams", nil)
+	w := httptest.NewRecorder()
+	h.ListTeams(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var result TeamListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	require.Len(t, result.Items, 3)
+
+	assert.Equal(t, "analytics", result.Items[0].Team)
+	assert.Equal(t, 1, result.Items[0].CronJobs)
+
+	payments := result.Items[1]
+	assert.Equal(t, "payments", payments.Team)
+	assert.Equal(t, 2, payments.CronJobs)
+	assert.Equal(t, int32(1), payments.Summary.Healthy)
+	assert.Equal(t, int32(1), payments.Summary.Critical)
+	assert.Equal(t, 1, payments.ActiveAlerts)
+	assert.InDelta(t, 75, payments.AvgSuccessRate, 0.001)
+
+	assert.Equal(t, unassignedTeam, result.Items[2].Team)
+	assert.Equal(t, int32(1), result.Items[2].Summary.Warning)
+}
+
+func TestListTeamCronJobs(t *testing.T) {
+	h := newTeamsTestHandlers()
+
+	names := func(team, query string) []string {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/teams/"+team+"/cronjobs"+query, nil)
+		w := httptest.NewRecorder()
+		chiRouterWithParams(h.ListTeamCronJobs, map[string]string{"team": team})(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var result CronJobListResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		var names []string
+		for _, item := range result.Items {
+			assert.Equal(t, team, cmp.Or(item.Team, unassignedTeam))
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"invoices", "refunds"}, names("payments", ""))
+	assert.Equal(t, []string{"refunds"}, names("payments", "?status=critical"))
+	assert.Equal(t, []string{"cleanup"}, names(unassignedTeam, ""))
+	assert.Empty(t, names("nobody", ""))
+}
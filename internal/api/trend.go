@@ -0,0 +1,84 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// defaultTrendWindowDays is the trend window when days is not given
+	defaultTrendWindowDays = 365
+
+	// maxTrendWindowDays is the longest trend window
+	maxTrendWindowDays = 3650
+)
+
+// GetCronJobTrend handles GET /api/v1/cronjobs/:namespace/:name/trend
+// @Summary      Get CronJob long-range trend
+// @Description  Returns daily roll-ups of a CronJob's runs, success rate, duration percentiles and total runtime. Roll-ups are written hourly and kept for history-retention.rollup-days, independent of raw history retention.
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true   "CronJob namespace"
+// @Param        name       path      string  true   "CronJob name"
+// @Param        days       query     int     false  "Window in days (1-3650)" default(365)
+// @Param        cluster    query     string  false  "Cluster (defaults to the local cluster)"
+// @Success      200  {object}  TrendResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/trend [get]
+func (h *Handlers) GetCronJobTrend(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	days := defaultTrendWindowDays
+	if d := r.URL.Query().Get("days"); d != "" {
+		parsed, err := strconv.Atoi(d)
+		if err != nil || parsed < 1 || parsed > maxTrendWindowDays {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("days must be between 1 and %d", maxTrendWindowDays))
+			return
+		}
+		days = parsed
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	// The window includes today
+	since := time.Now().UTC().AddDate(0, 0, -days+1)
+	rollups, err := h.store.GetDailyRollups(r.Context(), types.NamespacedName{Namespace: namespace, Name: name}, since)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := TrendResponse{
+		CronJob:    NamespacedRef{Namespace: namespace, Name: name},
+		WindowDays: days,
+		Points:     make([]TrendPoint, 0, len(rollups)),
+	}
+	for _, rollup := range rollups {
+		point := TrendPoint{
+			Date:                rollup.Day,
+			Runs:                rollup.Runs,
+			Successes:           rollup.Successes,
+			P50Seconds:          rollup.P50Secs,
+			P95Seconds:          rollup.P95Secs,
+			P99Seconds:          rollup.P99Secs,
+			TotalRuntimeSeconds: rollup.TotalRuntimeSecs,
+		}
+		if rollup.Runs > 0 {
+			point.SuccessRate = float64(rollup.Successes) / float64(rollup.Runs) * 100
+		}
+		resp.Points = append(resp.Points, point)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
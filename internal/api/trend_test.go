@@ -0,0 +1,41 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestGetCronJobTrend(t *testing.T) {
+	mockStore := &testutil.MockStore{DailyRollups: []store.DailyRollup{
+		{CronJobNamespace: "default", CronJobName: "backup", Day: "2026-10-15", Runs: 4, Successes: 3, TotalRuntimeSecs: 240, P50Secs: 55, P95Secs: 80, P99Secs: 90},
+		{CronJobNamespace: "default", CronJobName: "backup", Day: "2026-10-16", Runs: 0},
+	}}
+	h := newTestHandlers(newTestAPIClient(), mockStore, nil, nil)
+	handler := chiRouterWithParams(h.GetCronJobTrend, map[string]string{"namespace": "default", "name": "backup"})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/trend", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp TrendResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, defaultTrendWindowDays, resp.WindowDays)
+	assert.Equal(t, []TrendPoint{
+		{Date: "2026-10-15", Runs: 4, Successes: 3, SuccessRate: 75, P50Seconds: 55, P95Seconds: 80, P99Seconds: 90, TotalRuntimeSeconds: 240},
+		{Date: "2026-10-16"},
+	}, resp.Points)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/default/backup/trend?days=0", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
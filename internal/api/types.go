@@ -2,6 +2,11 @@ package api
 
 import (
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 )
 
 // NamespacedRef is a reference to a namespaced resource with proper JSON tags
@@ -15,6 +20,7 @@ type HealthResponse struct {
 	Status            string   `json:"status"`
 	Storage           string   `json:"storage"`
 	Leader            bool     `json:"leader"`
+	Shard             string   `json:"shard,omitempty"` // index/count when sharded
 	Version           string   `json:"version"`
 	Uptime            string   `json:"uptime"`
 	AnalyzerEnabled   bool     `json:"analyzerEnabled"`
@@ -63,6 +69,8 @@ type MonitorListItem struct {
 	ActiveAlerts  int32        `json:"activeAlerts"`
 	LastReconcile *time.Time   `json:"lastReconcile,omitempty"`
 	Phase         string       `json:"phase"`
+	Mode          string       `json:"mode"` // observe or enforce
+	SilencedUntil *time.Time   `json:"silencedUntil,omitempty"`
 }
 
 // CronJobListResponse is the response for GET /api/v1/cronjobs
@@ -73,23 +81,73 @@ type CronJobListResponse struct {
 
 // CronJobListItem is a single CronJob in the list
 type CronJobListItem struct {
-	Name            string          `json:"name"`
-	Namespace       string          `json:"namespace"`
-	Status          string          `json:"status"`
-	Schedule        string          `json:"schedule"`
-	Timezone        string          `json:"timezone,omitempty"`
-	Suspended       bool            `json:"suspended"`
-	SuccessRate     float64         `json:"successRate"`
-	LastSuccess     *time.Time      `json:"lastSuccess,omitempty"`
-	LastRunDuration string          `json:"lastRunDuration,omitempty"`
-	NextRun         *time.Time      `json:"nextRun,omitempty"`
-	ActiveJobs      []ActiveJobItem `json:"activeJobs,omitempty"`
-	ActiveAlerts    int             `json:"activeAlerts"`
-	MonitorRef      *NamespacedRef  `json:"monitorRef,omitempty"`
+	Cluster                string          `json:"cluster,omitempty"` // Remote cluster, empty for the local cluster
+	Name                   string          `json:"name"`
+	Namespace              string          `json:"namespace"`
+	Kind                   string          `json:"kind,omitempty"` // "ExternalJob" for jobs reporting through pings
+	Status                 string          `json:"status"`
+	Schedule               string          `json:"schedule"`
+	Timezone               string          `json:"timezone,omitempty"`
+	Suspended              bool            `json:"suspended"`
+	IntentionallySuspended bool            `json:"intentionallySuspended,omitempty"` // On the monitor's intentionally suspended list
+	SuccessRate            float64         `json:"successRate"`
+	LastSuccess            *time.Time      `json:"lastSuccess,omitempty"`
+	LastRunDuration        string          `json:"lastRunDuration,omitempty"`
+	NextRun                *time.Time      `json:"nextRun,omitempty"`
+	ActiveJobs             []ActiveJobItem `json:"activeJobs,omitempty"`
+	ActiveAlerts           int             `json:"activeAlerts"`
+	MonitorRef             *NamespacedRef  `json:"monitorRef,omitempty"`
+	Team                   string          `json:"team,omitempty"` // Owning team, see the ownership config
+}
+
+// SavedView is a named CronJob list filter. Applied with GET /api/v1/cronjobs?view=<name>.
+type SavedView struct {
+	Name          string     `json:"name"`
+	Description   string     `json:"description,omitempty"`
+	Namespaces    []string   `json:"namespaces,omitempty"`    // Any of these namespaces
+	LabelSelector string     `json:"labelSelector,omitempty"` // Kubernetes label selector on the CronJobs, e.g. schedule=nightly
+	Team          string     `json:"team,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	Search        string     `json:"search,omitempty"`
+	SortBy        string     `json:"sortBy,omitempty"` // name, namespace, status, successRate, lastSuccess or nextRun
+	SortDesc      bool       `json:"sortDesc,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SavedViewListResponse is the response for GET /api/v1/views
+type SavedViewListResponse struct {
+	Items []SavedView `json:"items"`
+}
+
+// TeamListResponse is the response for GET /api/v1/teams
+type TeamListResponse struct {
+	Items []TeamSummary `json:"items"`
+}
+
+// TeamSummary is the health of the CronJobs owned by one team
+type TeamSummary struct {
+	Team           string       `json:"team"`
+	CronJobs       int          `json:"cronjobs"`
+	Summary        SummaryStats `json:"summary"`
+	ActiveAlerts   int          `json:"activeAlerts"`
+	AvgSuccessRate float64      `json:"avgSuccessRate"`
+}
+
+// ClusterListResponse is the response for GET /api/v1/clusters
+type ClusterListResponse struct {
+	Items []ClusterItem `json:"items"`
+}
+
+// ClusterItem is a monitored cluster
+type ClusterItem struct {
+	Name  string `json:"name"`            // Empty when the local cluster has no cluster-name
+	Local bool   `json:"local,omitempty"` // The cluster guardian runs in
 }
 
 // CronJobDetailResponse is the response for GET /api/v1/cronjobs/:namespace/:name
 type CronJobDetailResponse struct {
+	Cluster       string            `json:"cluster,omitempty"` // Remote cluster, empty for the local cluster
 	Name          string            `json:"name"`
 	Namespace     string            `json:"namespace"`
 	Status        string            `json:"status"`
@@ -115,6 +173,9 @@ type CronJobMetrics struct {
 	P50DurationSeconds float64 `json:"p50DurationSeconds"`
 	P95DurationSeconds float64 `json:"p95DurationSeconds"`
 	P99DurationSeconds float64 `json:"p99DurationSeconds"`
+	Incidents7d        int32   `json:"incidents7d"`
+	MTTRSeconds        float64 `json:"mttrSeconds"`
+	MTBFSeconds        float64 `json:"mtbfSeconds"`
 }
 
 // ExecutionSummary contains execution details
@@ -135,15 +196,24 @@ type ExecutionListResponse struct {
 
 // ExecutionItem is a single execution in the list
 type ExecutionItem struct {
-	ID             int64      `json:"id"`
-	JobName        string     `json:"jobName"`
-	Status         string     `json:"status"`
-	StartTime      time.Time  `json:"startTime"`
-	CompletionTime *time.Time `json:"completionTime,omitempty"`
-	Duration       string     `json:"duration"`
-	ExitCode       int32      `json:"exitCode"`
-	Reason         string     `json:"reason,omitempty"`
-	IsRetry        bool       `json:"isRetry"`
+	ID              int64      `json:"id"`
+	Cluster         string     `json:"cluster,omitempty"`
+	JobName         string     `json:"jobName"`
+	Status          string     `json:"status"`
+	StartTime       time.Time  `json:"startTime"`
+	CompletionTime  *time.Time `json:"completionTime,omitempty"`
+	Duration        string     `json:"duration"`
+	ExitCode        int32      `json:"exitCode"`
+	Reason          string     `json:"reason,omitempty"`
+	Classification  string     `json:"classification,omitempty"`
+	IsRetry         bool       `json:"isRetry"`
+	Backfilled      bool       `json:"backfilled,omitempty"`
+	Synthetic       bool       `json:"synthetic,omitempty"`
+	CatchUp         bool       `json:"catchUp,omitempty"`
+	InfraDisruption bool       `json:"infrastructureDisruption,omitempty"`
+	OutputSize      *float64   `json:"outputSize,omitempty"`
+	NodeName        string     `json:"nodeName,omitempty"`
+	Images          []string   `json:"images,omitempty"`
 }
 
 // Pagination contains pagination info
@@ -154,6 +224,214 @@ type Pagination struct {
 	HasMore bool  `json:"hasMore"`
 }
 
+// AnalyticsResponse is the response for GET /api/v1/cronjobs/:namespace/:name/analytics
+type AnalyticsResponse struct {
+	CronJob        NamespacedRef        `json:"cronJob"`
+	WindowDays     int                  `json:"windowDays"`
+	ExitCodes      []ExitCodeBucket     `json:"exitCodes"`
+	FailureReasons []FailureReasonCount `json:"failureReasons"`
+	DurationTrend  []DurationTrendPoint `json:"durationTrend"`
+	Heatmap        []HeatmapCell        `json:"heatmap"`
+}
+
+// UsageTotals is the compute consumed by executions. Resource-seconds are the
+// requests of a Job's pods multiplied by the run duration.
+type UsageTotals struct {
+	Runs             int64   `json:"runs"`
+	RuntimeSeconds   float64 `json:"runtimeSeconds"`
+	CPUCoreSeconds   float64 `json:"cpuCoreSeconds"`
+	MemoryGiBSeconds float64 `json:"memoryGiBSeconds"`
+}
+
+// UsageResponse is the response for GET /api/v1/cronjobs/:namespace/:name/usage
+type UsageResponse struct {
+	CronJob    NamespacedRef `json:"cronJob"`
+	WindowDays int           `json:"windowDays"`
+	Usage      UsageTotals   `json:"usage"`
+}
+
+// ClusterUsageResponse is the response for GET /api/v1/usage
+type ClusterUsageResponse struct {
+	WindowDays int                `json:"windowDays"`
+	Total      UsageTotals        `json:"total"`
+	CronJobs   []CronJobUsageItem `json:"cronJobs"`
+}
+
+// CronJobUsageItem is the usage of one CronJob in the cluster roll-up
+type CronJobUsageItem struct {
+	CronJob NamespacedRef `json:"cronJob"`
+	UsageTotals
+}
+
+// RecommendationResponse is the response for GET /api/v1/cronjobs/:namespace/:name/recommendation
+type RecommendationResponse struct {
+	CronJob     NamespacedRef `json:"cronJob"`
+	WindowDays  int           `json:"windowDays"`
+	Current     JobLimits     `json:"current"`
+	Recommended *JobLimits    `json:"recommended,omitempty"` // nil when there is not enough history
+	BasedOnRuns int32         `json:"basedOnRuns"`
+	Applied     bool          `json:"applied"`
+}
+
+// SpecDiffResponse is the response for GET /api/v1/cronjobs/:namespace/:name/spec-diff
+type SpecDiffResponse struct {
+	CronJob NamespacedRef      `json:"cronJob"`
+	From    *SpecDiffExecution `json:"from,omitempty"` // The last successful run by default
+	To      *SpecDiffExecution `json:"to,omitempty"`   // The first failed run after it by default; nil if there is none
+	Changed bool               `json:"changed"`
+	Changes []SpecChange       `json:"changes"`
+}
+
+// SpecDiffExecution is an execution compared by a spec diff
+type SpecDiffExecution struct {
+	JobName   string    `json:"jobName"`
+	Status    string    `json:"status"`
+	StartTime time.Time `json:"startTime"`
+	SpecHash  string    `json:"specHash"`
+}
+
+// SpecChange is a change to the Job spec between two executions. From is
+// empty for added values and To for removed ones.
+type SpecChange struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// JobLimits are the deadline and retry limits of a CronJob's Jobs
+type JobLimits struct {
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	BackoffLimit          *int32 `json:"backoffLimit,omitempty"`
+}
+
+// ExitCodeBucket is the number of executions with an exit code
+type ExitCodeBucket struct {
+	ExitCode int32 `json:"exitCode"`
+	Count    int64 `json:"count"`
+}
+
+// FailureReasonCount is the number of failed executions with a reason
+type FailureReasonCount struct {
+	Reason string `json:"reason"`
+	Count  int64  `json:"count"`
+}
+
+// DurationTrendPoint holds duration percentiles for one UTC day
+type DurationTrendPoint struct {
+	Date       string  `json:"date"`
+	Runs       int64   `json:"runs"`
+	P50Seconds float64 `json:"p50Seconds"`
+	P95Seconds float64 `json:"p95Seconds"`
+}
+
+// TrendResponse is the response for GET /api/v1/cronjobs/:namespace/:name/trend
+type TrendResponse struct {
+	CronJob    NamespacedRef `json:"cronJob"`
+	WindowDays int           `json:"windowDays"`
+	Points     []TrendPoint  `json:"points"`
+}
+
+// TrendPoint is the daily roll-up of a CronJob's executions on one UTC day
+type TrendPoint struct {
+	Date                string  `json:"date"`
+	Runs                int64   `json:"runs"`
+	Successes           int64   `json:"successes"`
+	SuccessRate         float64 `json:"successRate"`
+	P50Seconds          float64 `json:"p50Seconds"`
+	P95Seconds          float64 `json:"p95Seconds"`
+	P99Seconds          float64 `json:"p99Seconds"`
+	TotalRuntimeSeconds float64 `json:"totalRuntimeSeconds"`
+}
+
+// HeatmapCell holds success counts for a day of week (0 = Sunday) and UTC hour
+type HeatmapCell struct {
+	DayOfWeek   int     `json:"dayOfWeek"`
+	Hour        int     `json:"hour"`
+	Total       int64   `json:"total"`
+	Succeeded   int64   `json:"succeeded"`
+	SuccessRate float64 `json:"successRate"`
+}
+
+// CorrelationReportResponse is the response for GET /api/v1/correlations
+type CorrelationReportResponse struct {
+	GeneratedAt   time.Time              `json:"generatedAt"`
+	Lookback      string                 `json:"lookback"`
+	Window        string                 `json:"window"`
+	TotalFailures int                    `json:"totalFailures"`
+	Groups        []CorrelationGroupItem `json:"groups"`
+}
+
+// CorrelationGroupItem is a set of CronJobs that failed together
+type CorrelationGroupItem struct {
+	Dimension    string          `json:"dimension"`
+	Value        string          `json:"value,omitempty"`
+	CronJobs     []NamespacedRef `json:"cronJobs"`
+	Failures     int             `json:"failures"`
+	FirstFailure time.Time       `json:"firstFailure"`
+	LastFailure  time.Time       `json:"lastFailure"`
+	LikelyCause  string          `json:"likelyCause"`
+}
+
+// ScheduleConflictReportResponse is the response for GET /api/v1/schedules/conflicts
+type ScheduleConflictReportResponse struct {
+	GeneratedAt  time.Time              `json:"generatedAt"`
+	Horizon      string                 `json:"horizon"`
+	Window       string                 `json:"window"`
+	CronJobs     int                    `json:"cronJobs"`
+	PeakWindow   *time.Time             `json:"peakWindow,omitempty"`
+	PeakCronJobs int                    `json:"peakCronJobs"`
+	Conflicts    []ScheduleConflictItem `json:"conflicts"`
+	Invalid      []InvalidScheduleItem  `json:"invalid,omitempty"`
+}
+
+// ScheduleConflictItem is a set of CronJobs that start in the same window
+type ScheduleConflictItem struct {
+	CronJobs    []NamespacedRef         `json:"cronJobs"`
+	NextAt      time.Time               `json:"nextAt"`
+	Occurrences int                     `json:"occurrences"`
+	Suggestions []StaggerSuggestionItem `json:"suggestions"`
+}
+
+// StaggerSuggestionItem moves a CronJob's runs later to spread out a conflict
+type StaggerSuggestionItem struct {
+	CronJob           NamespacedRef `json:"cronJob"`
+	Schedule          string        `json:"schedule"`
+	Offset            string        `json:"offset"`
+	SuggestedSchedule string        `json:"suggestedSchedule,omitempty"`
+}
+
+// InvalidScheduleItem is a CronJob whose schedule could not be parsed
+type InvalidScheduleItem struct {
+	CronJob  NamespacedRef `json:"cronJob"`
+	Schedule string        `json:"schedule"`
+	Error    string        `json:"error"`
+}
+
+// UpcomingRunsResponse is the response for GET /api/v1/schedules/upcoming
+type UpcomingRunsResponse struct {
+	GeneratedAt time.Time             `json:"generatedAt"`
+	CronJobs    []CronJobUpcomingRuns `json:"cronJobs"`
+	// Runs merges the upcoming runs of all CronJobs in time order
+	Runs    []UpcomingRun         `json:"runs"`
+	Invalid []InvalidScheduleItem `json:"invalid,omitempty"`
+}
+
+// CronJobUpcomingRuns is the forecast for a single CronJob
+type CronJobUpcomingRuns struct {
+	Namespace string      `json:"namespace"`
+	Name      string      `json:"name"`
+	Schedule  string      `json:"schedule"`
+	TimeZone  string      `json:"timeZone,omitempty"`
+	Suspended bool        `json:"suspended"`
+	NextRuns  []time.Time `json:"nextRuns"`
+}
+
+// UpcomingRun is a single scheduled run
+type UpcomingRun struct {
+	CronJob NamespacedRef `json:"cronJob"`
+	Time    time.Time     `json:"time"`
+}
+
 // LogsResponse is the response for GET /api/v1/cronjobs/:namespace/:name/executions/:jobName/logs
 type LogsResponse struct {
 	JobName   string `json:"jobName"`
@@ -172,6 +450,7 @@ type AlertListResponse struct {
 // AlertItem is a single alert
 type AlertItem struct {
 	ID           string                `json:"id"`
+	AlertID      string                `json:"alertId,omitempty"` // Tracking ID sent in channel payloads
 	Type         string                `json:"type"`
 	Severity     string                `json:"severity"`
 	Title        string                `json:"title"`
@@ -181,6 +460,7 @@ type AlertItem struct {
 	Since        time.Time             `json:"since"`
 	LastNotified *time.Time            `json:"lastNotified,omitempty"`
 	Context      *AlertContextResponse `json:"context,omitempty"`
+	RunbookURL   string                `json:"runbookURL,omitempty"`
 }
 
 // AlertContextResponse contains context data for an alert (suggested fixes, exit codes, etc.)
@@ -188,6 +468,9 @@ type AlertContextResponse struct {
 	ExitCode     int32  `json:"exitCode,omitempty"`
 	Reason       string `json:"reason,omitempty"`
 	SuggestedFix string `json:"suggestedFix,omitempty"`
+	// Set when the failure ended an unusually long success streak
+	SuccessStreakRuns  int32      `json:"successStreakRuns,omitempty"`
+	SuccessStreakSince *time.Time `json:"successStreakSince,omitempty"`
 }
 
 // AlertHistoryResponse is the response for GET /api/v1/alerts/history
@@ -199,6 +482,8 @@ type AlertHistoryResponse struct {
 // AlertHistoryItem is a single historical alert
 type AlertHistoryItem struct {
 	ID               string         `json:"id"`
+	AlertID          string         `json:"alertId,omitempty"` // Tracking ID sent in channel payloads
+	Cluster          string         `json:"cluster,omitempty"`
 	Type             string         `json:"type"`
 	Severity         string         `json:"severity"`
 	Title            string         `json:"title"`
@@ -208,9 +493,51 @@ type AlertHistoryItem struct {
 	ResolvedAt       *time.Time     `json:"resolvedAt,omitempty"`
 	ChannelsNotified []string       `json:"channelsNotified"`
 	// Context fields for failure alerts
+	JobName      string `json:"jobName,omitempty"`
 	ExitCode     int32  `json:"exitCode,omitempty"`
 	Reason       string `json:"reason,omitempty"`
 	SuggestedFix string `json:"suggestedFix,omitempty"`
+	RunbookURL   string `json:"runbookURL,omitempty"`
+	// Receipts are the confirmations downstream systems posted for the alert
+	Receipts []AlertReceiptItem `json:"receipts,omitempty"`
+}
+
+// AlertReceiptRequest is the body of POST /api/v1/alerts/{alertId}/receipts
+type AlertReceiptRequest struct {
+	Receiver string `json:"receiver"`         // System that processed the alert, e.g. "pagerduty"
+	Status   string `json:"status,omitempty"` // processed (default) or failed
+	Message  string `json:"message,omitempty"`
+}
+
+// AlertReceiptItem is a downstream system's confirmation of an alert
+type AlertReceiptItem struct {
+	AlertID    string    `json:"alertId"`
+	Receiver   string    `json:"receiver"`
+	Status     string    `json:"status"`
+	Message    string    `json:"message,omitempty"`
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// AlertDeliveryListResponse is the response for GET /api/v1/alerts/deliveries
+type AlertDeliveryListResponse struct {
+	Items      []AlertDeliveryItem `json:"items"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+// AlertDeliveryItem is a single queued alert delivery
+type AlertDeliveryItem struct {
+	ID            string         `json:"id"`
+	AlertKey      string         `json:"alertKey"`
+	Type          string         `json:"type"`
+	Severity      string         `json:"severity"`
+	CronJob       *NamespacedRef `json:"cronjob,omitempty"`
+	Channel       string         `json:"channel"`
+	Status        string         `json:"status"`
+	Attempts      int32          `json:"attempts"`
+	NextAttemptAt *time.Time     `json:"nextAttemptAt,omitempty"`
+	LastError     string         `json:"lastError,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	DeliveredAt   *time.Time     `json:"deliveredAt,omitempty"`
 }
 
 // ChannelListResponse is the response for GET /api/v1/channels
@@ -259,6 +586,13 @@ type TriggerResponse struct {
 	Message string `json:"message"`
 }
 
+// SilenceResponse is the response for POST and DELETE /api/v1/monitors/:namespace/:name/silence
+type SilenceResponse struct {
+	Success       bool       `json:"success"`
+	Message       string     `json:"message"`
+	SilencedUntil *time.Time `json:"silencedUntil,omitempty"`
+}
+
 // SimpleResponse is a simple success/error response
 type SimpleResponse struct {
 	Success bool   `json:"success"`
@@ -280,9 +614,17 @@ type ErrorDetail struct {
 
 // DeleteHistoryResponse is the response for DELETE /api/v1/cronjobs/:namespace/:name/history
 type DeleteHistoryResponse struct {
-	Success        bool   `json:"success"`
-	RecordsDeleted int64  `json:"recordsDeleted"`
-	Message        string `json:"message"`
+	Success         bool       `json:"success"`
+	RecordsDeleted  int64      `json:"recordsDeleted"`
+	RestorableUntil *time.Time `json:"restorableUntil,omitempty"` // Unset when deleted immediately
+	Message         string     `json:"message"`
+}
+
+// RestoreHistoryResponse is the response for POST /api/v1/cronjobs/:namespace/:name/history/restore
+type RestoreHistoryResponse struct {
+	Success         bool   `json:"success"`
+	RecordsRestored int64  `json:"recordsRestored"`
+	Message         string `json:"message"`
 }
 
 // StorageStatsResponse is the response for GET /api/v1/admin/storage-stats
@@ -292,6 +634,27 @@ type StorageStatsResponse struct {
 	Healthy           bool   `json:"healthy"`
 	RetentionDays     int    `json:"retentionDays"`
 	LogStorageEnabled bool   `json:"logStorageEnabled"`
+	// CronJobs exempt from pruning
+	LegalHolds []LegalHoldItem `json:"legalHolds"`
+}
+
+// LegalHoldItem is a CronJob whose history and logs are exempt from pruning
+type LegalHoldItem struct {
+	Namespace string     `json:"namespace"`
+	Name      string     `json:"name"`
+	Reason    string     `json:"reason,omitempty"`
+	Monitor   string     `json:"monitor,omitempty"` // Monitor holding it through dataRetention.legalHold
+	Since     *time.Time `json:"since,omitempty"`   // When the hold was set through the API
+}
+
+// LegalHoldListResponse is the response for GET /api/v1/admin/legal-holds
+type LegalHoldListResponse struct {
+	Items []LegalHoldItem `json:"items"`
+}
+
+// LegalHoldRequest is the request body for PUT /api/v1/admin/legal-holds/:namespace/:name
+type LegalHoldRequest struct {
+	Reason string `json:"reason"`
 }
 
 // PruneResponse is the response for POST /api/v1/admin/prune
@@ -306,21 +669,32 @@ type PruneResponse struct {
 
 // ExecutionDetailResponse is the response for GET /api/v1/cronjobs/:namespace/:name/executions/:jobName
 type ExecutionDetailResponse struct {
-	ID               int64      `json:"id"`
-	CronJobNamespace string     `json:"cronJobNamespace"`
-	CronJobName      string     `json:"cronJobName"`
-	CronJobUID       string     `json:"cronJobUID,omitempty"`
-	JobName          string     `json:"jobName"`
-	Status           string     `json:"status"`
-	StartTime        time.Time  `json:"startTime"`
-	CompletionTime   *time.Time `json:"completionTime,omitempty"`
-	Duration         string     `json:"duration"`
-	ExitCode         int32      `json:"exitCode"`
-	Reason           string     `json:"reason,omitempty"`
-	IsRetry          bool       `json:"isRetry"`
-	RetryOf          string     `json:"retryOf,omitempty"`
-	StoredLogs       string     `json:"storedLogs,omitempty"`
-	StoredEvents     string     `json:"storedEvents,omitempty"`
+	ID               int64           `json:"id"`
+	CronJobNamespace string          `json:"cronJobNamespace"`
+	CronJobName      string          `json:"cronJobName"`
+	CronJobUID       string          `json:"cronJobUID,omitempty"`
+	JobName          string          `json:"jobName"`
+	Status           string          `json:"status"`
+	StartTime        time.Time       `json:"startTime"`
+	CompletionTime   *time.Time      `json:"completionTime,omitempty"`
+	Duration         string          `json:"duration"`
+	ExitCode         int32           `json:"exitCode"`
+	Reason           string          `json:"reason,omitempty"`
+	Classification   string          `json:"classification,omitempty"`
+	IsRetry          bool            `json:"isRetry"`
+	RetryOf          string          `json:"retryOf,omitempty"`
+	Backfilled       bool            `json:"backfilled,omitempty"`
+	Synthetic        bool            `json:"synthetic,omitempty"`
+	CatchUp          bool            `json:"catchUp,omitempty"`
+	InfraDisruption  bool            `json:"infrastructureDisruption,omitempty"`
+	OutputSize       *float64        `json:"outputSize,omitempty"`
+	SpecHash         string          `json:"specHash,omitempty"`
+	NodeName         string          `json:"nodeName,omitempty"`
+	Images           []string        `json:"images,omitempty"`
+	PodNames         []string        `json:"podNames,omitempty"`
+	Containers       []ContainerItem `json:"containers,omitempty"`
+	StoredLogs       string          `json:"storedLogs,omitempty"`
+	StoredEvents     string          `json:"storedEvents,omitempty"`
 }
 
 // PatternTestRequest is the request for POST /api/v1/patterns/test
@@ -337,8 +711,20 @@ type PatternInput struct {
 	Priority   *int32            `json:"priority,omitempty"`
 }
 
+// ContainerItem is how one container of an execution's pod terminated
+type ContainerItem struct {
+	Name       string     `json:"name"`
+	Init       bool       `json:"init,omitempty"`
+	ExitCode   int32      `json:"exitCode"`
+	Reason     string     `json:"reason,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	Duration   string     `json:"duration,omitempty"`
+}
+
 // PatternMatchInput represents match criteria for a pattern
 type PatternMatchInput struct {
+	Container     string              `json:"container,omitempty"`
 	ExitCode      *int32              `json:"exitCode,omitempty"`
 	ExitCodeRange *ExitCodeRangeInput `json:"exitCodeRange,omitempty"`
 	Reason        string              `json:"reason,omitempty"`
@@ -355,13 +741,14 @@ type ExitCodeRangeInput struct {
 
 // PatternTestData contains sample data to test against
 type PatternTestData struct {
-	ExitCode  int32    `json:"exitCode"`
-	Reason    string   `json:"reason"`
-	Logs      string   `json:"logs"`
-	Events    []string `json:"events"`
-	Namespace string   `json:"namespace"`
-	Name      string   `json:"name"`
-	JobName   string   `json:"jobName"`
+	ExitCode   int32           `json:"exitCode"`
+	Reason     string          `json:"reason"`
+	Containers []ContainerItem `json:"containers,omitempty"`
+	Logs       string          `json:"logs"`
+	Events     []string        `json:"events"`
+	Namespace  string          `json:"namespace"`
+	Name       string          `json:"name"`
+	JobName    string          `json:"jobName"`
 }
 
 // PatternTestResponse is the response for pattern testing
@@ -370,3 +757,163 @@ type PatternTestResponse struct {
 	RenderedSuggestion string `json:"renderedSuggestion,omitempty"`
 	Error              string `json:"error,omitempty"`
 }
+
+// MonitorDryRunResponse is the response for POST /api/v1/monitors/dry-run
+type MonitorDryRunResponse struct {
+	Namespaces     []string          `json:"namespaces"`
+	CronJobs       []DryRunCronJob   `json:"cronJobs"`
+	AlertRules     []DryRunAlertRule `json:"alertRules"`
+	Channels       []DryRunChannel   `json:"channels"`
+	ActiveChannels []string          `json:"activeChannels"`
+	Warnings       []string          `json:"warnings,omitempty"`
+}
+
+// DryRunCronJob is a CronJob the monitor would select
+type DryRunCronJob struct {
+	Namespace   string          `json:"namespace"`
+	Name        string          `json:"name"`
+	Schedule    string          `json:"schedule"`
+	Suspended   bool            `json:"suspended"`
+	MonitoredBy []NamespacedRef `json:"monitoredBy,omitempty"`
+}
+
+// DryRunAlertRule is an alert the monitor would raise
+type DryRunAlertRule struct {
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+	Detail   string `json:"detail"`
+}
+
+// DryRunChannel is an alert channel the monitor references
+type DryRunChannel struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type,omitempty"`
+	Found      bool     `json:"found"`
+	Ready      bool     `json:"ready"`
+	Severities []string `json:"severities,omitempty"`
+	Routes     []string `json:"routes"`
+}
+
+// AlertPreviewRequest is the request body for POST /api/v1/alerts/preview
+type AlertPreviewRequest struct {
+	Type    string         `json:"type"`
+	CronJob NamespacedRef  `json:"cronJob"`
+	Monitor *NamespacedRef `json:"monitor,omitempty"` // Defaults to the first monitor of the CronJob
+}
+
+// AlertPreviewResponse is the response for POST /api/v1/alerts/preview
+type AlertPreviewResponse struct {
+	Monitor  NamespacedRef             `json:"monitor"`
+	Type     string                    `json:"type"`
+	Severity string                    `json:"severity"`
+	Channels []alerting.ChannelPreview `json:"channels"`
+	Warnings []string                  `json:"warnings,omitempty"`
+}
+
+// DiagnosticsResponse is the response for GET /api/v1/admin/diagnostics
+type DiagnosticsResponse struct {
+	GeneratedAt         time.Time                       `json:"generatedAt"`
+	Version             string                          `json:"version"`
+	Uptime              string                          `json:"uptime"`
+	Leader              bool                            `json:"leader"`
+	Controllers         []ControllerDiagnostics         `json:"controllers"`
+	ExecutionQueueDepth float64                         `json:"executionQueueDepth"`
+	Dispatcher          *alerting.DispatcherDiagnostics `json:"dispatcher,omitempty"`
+	StoreLatency        *StoreLatencyDiagnostics        `json:"storeLatency,omitempty"`
+	Schedulers          []SchedulerDiagnostics          `json:"schedulers"`
+	Certificates        []CertificateDiagnostics        `json:"certificates"`
+}
+
+// ControllerDiagnostics reports the work queue of a controller
+type ControllerDiagnostics struct {
+	Name                  string  `json:"name"`
+	QueueDepth            float64 `json:"queueDepth"`
+	MeanQueueWaitSeconds  float64 `json:"meanQueueWaitSeconds"`  // mean time items waited before being reconciled
+	UnfinishedWorkSeconds float64 `json:"unfinishedWorkSeconds"` // work in progress not yet observed as done
+	LongestRunningSeconds float64 `json:"longestRunningSeconds"` // longest reconcile currently running
+}
+
+// StoreLatencyDiagnostics reports store round-trip latency measured with health probes
+type StoreLatencyDiagnostics struct {
+	Probe   string  `json:"probe"`
+	Samples int     `json:"samples"`
+	P50Ms   float64 `json:"p50Ms"`
+	P90Ms   float64 `json:"p90Ms"`
+	MaxMs   float64 `json:"maxMs"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// SchedulerDiagnostics reports when a scheduler last ran
+type SchedulerDiagnostics struct {
+	Name    string     `json:"name"`
+	LastRun *time.Time `json:"lastRun,omitempty"`
+}
+
+// CertificateDiagnostics reports a certificate loaded by a certificate watcher
+type CertificateDiagnostics struct {
+	Name     string     `json:"name"`
+	Subject  string     `json:"subject,omitempty"`
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+	Expired  bool       `json:"expired"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// MonitorDiagnostics is the status of a monitor included in support bundles
+type MonitorDiagnostics struct {
+	Namespace          string                           `json:"namespace"`
+	Name               string                           `json:"name"`
+	Generation         int64                            `json:"generation"`
+	ObservedGeneration int64                            `json:"observedGeneration"`
+	Phase              string                           `json:"phase,omitempty"`
+	Summary            *guardianv1alpha1.MonitorSummary `json:"summary,omitempty"`
+	Conditions         []metav1.Condition               `json:"conditions,omitempty"`
+}
+
+// LogLevelRequest is the request for PUT /api/v1/admin/loglevel
+type LogLevelRequest struct {
+	Level  string `json:"level"`            // trace, debug, info, warn or error; "default" resets a sub-logger
+	Logger string `json:"logger,omitempty"` // Sub-logger to change, e.g. controllers, api-server or alerting; empty changes the default level
+}
+
+// LogLevelResponse is the response for GET and PUT /api/v1/admin/loglevel
+type LogLevelResponse struct {
+	Level   string            `json:"level"`             // Default level
+	Loggers map[string]string `json:"loggers,omitempty"` // Levels of sub-loggers that differ from the default, by name
+}
+
+// ImportExecution is one historical execution for POST /api/v1/admin/import.
+// In CSV, the header row names the columns after these JSON fields.
+type ImportExecution struct {
+	Namespace      string     `json:"namespace"`
+	CronJob        string     `json:"cronJob"`
+	JobName        string     `json:"jobName,omitempty"` // Default: <cronJob>-<start time in minutes>, as the CronJob controller names Jobs
+	Status         string     `json:"status"`            // success or failed
+	StartTime      time.Time  `json:"startTime"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"` // Default: startTime + duration
+	Duration       string     `json:"duration,omitempty"`       // Go duration (1m30s) or seconds; default: completionTime - startTime
+	ExitCode       int32      `json:"exitCode,omitempty"`
+	Reason         string     `json:"reason,omitempty"`
+}
+
+// ImportResponse is the response for POST /api/v1/admin/import
+type ImportResponse struct {
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"` // Rows whose job was already recorded
+}
+
+// InjectExecutionRequest is the request body for POST /api/v1/admin/executions/synthetic
+type InjectExecutionRequest struct {
+	Namespace string `json:"namespace"`
+	CronJob   string `json:"cronJob"`
+	Status    string `json:"status"`             // success or failed
+	ExitCode  int32  `json:"exitCode,omitempty"` // Default for failures: 1
+	Reason    string `json:"reason,omitempty"`   // Default for failures: SyntheticFailure
+	Duration  string `json:"duration,omitempty"` // Go duration (1m30s) or seconds; default: 0
+}
+
+// InjectExecutionResponse is the response for POST /api/v1/admin/executions/synthetic
+type InjectExecutionResponse struct {
+	JobName  string          `json:"jobName"`
+	Status   string          `json:"status"`
+	Monitors []NamespacedRef `json:"monitors"` // Monitors the run was alerted through
+}
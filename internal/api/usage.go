@@ -0,0 +1,143 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// defaultUsageWindowDays is the usage window when days is not given
+const defaultUsageWindowDays = 30
+
+// GetCronJobUsage handles GET /api/v1/cronjobs/:namespace/:name/usage
+// @Summary      Get CronJob resource usage
+// @Description  Returns the cumulative runtime of a CronJob's executions and its requested CPU and memory multiplied by run duration
+// @Tags         CronJobs
+// @Produce      json
+// @Param        namespace  path      string  true   "CronJob namespace"
+// @Param        name       path      string  true   "CronJob name"
+// @Param        days       query     int     false  "Window in days (1-365)" default(30)
+// @Success      200  {object}  UsageResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /cronjobs/{namespace}/{name}/usage [get]
+func (h *Handlers) GetCronJobUsage(w http.ResponseWriter, r *http.Request) {
+	namespace := chi.URLParam(r, "namespace")
+	name := chi.URLParam(r, "name")
+
+	days, ok := usageWindowDays(w, r)
+	if !ok {
+		return
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	usage, err := h.store.GetCronJobUsage(r.Context(), types.NamespacedName{Namespace: namespace, Name: name}, since)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, UsageResponse{
+		CronJob:    NamespacedRef{Namespace: namespace, Name: name},
+		WindowDays: days,
+		Usage:      toUsageTotals(*usage),
+	})
+}
+
+// GetUsage handles GET /api/v1/usage
+// @Summary      Get cluster resource usage
+// @Description  Returns runtime and requested CPU and memory multiplied by run duration for every CronJob, highest CPU usage first, with cluster totals
+// @Tags         CronJobs
+// @Produce      json
+// @Param        days       query     int     false  "Window in days (1-365)" default(30)
+// @Param        namespace  query     string  false  "Filter by namespace"
+// @Param        limit      query     int     false  "Maximum number of CronJobs to list (totals include all)"
+// @Success      200  {object}  ClusterUsageResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /usage [get]
+func (h *Handlers) GetUsage(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+
+	days, ok := usageWindowDays(w, r)
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	usage, err := h.store.ListCronJobUsage(r.Context(), time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	resp := ClusterUsageResponse{
+		WindowDays: days,
+		CronJobs:   make([]CronJobUsageItem, 0, len(usage)),
+	}
+	for _, u := range usage {
+		if namespace != "" && u.CronJobNamespace != namespace {
+			continue
+		}
+		totals := toUsageTotals(u)
+		resp.Total.Runs += totals.Runs
+		resp.Total.RuntimeSeconds += totals.RuntimeSeconds
+		resp.Total.CPUCoreSeconds += totals.CPUCoreSeconds
+		resp.Total.MemoryGiBSeconds += totals.MemoryGiBSeconds
+		if limit == 0 || len(resp.CronJobs) < limit {
+			resp.CronJobs = append(resp.CronJobs, CronJobUsageItem{
+				CronJob:     NamespacedRef{Namespace: u.CronJobNamespace, Name: u.CronJobName},
+				UsageTotals: totals,
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// usageWindowDays parses the days query parameter, writing a 400 response if it is invalid
+func usageWindowDays(w http.ResponseWriter, r *http.Request) (int, bool) {
+	d := r.URL.Query().Get("days")
+	if d == "" {
+		return defaultUsageWindowDays, true
+	}
+	days, err := strconv.Atoi(d)
+	if err != nil || days < 1 || days > 365 {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "days must be between 1 and 365")
+		return 0, false
+	}
+	return days, true
+}
+
+// toUsageTotals converts store usage to its API representation
+func toUsageTotals(u store.CronJobUsage) UsageTotals {
+	return UsageTotals{
+		Runs:             u.Runs,
+		RuntimeSeconds:   u.RuntimeSeconds,
+		CPUCoreSeconds:   u.CPUCoreSeconds,
+		MemoryGiBSeconds: u.MemoryGiBSeconds,
+	}
+}
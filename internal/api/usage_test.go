@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func newUsageTestStore() *testutil.MockStore {
+	return &testutil.MockStore{Usage: []store.CronJobUsage{
+		{CronJobNamespace: "data", CronJobName: "etl", Runs: 10, RuntimeSeconds: 3600, CPUCoreSeconds: 7200, MemoryGiBSeconds: 14400},
+		{CronJobNamespace: "default", CronJobName: "backup", Runs: 30, RuntimeSeconds: 900, CPUCoreSeconds: 450, MemoryGiBSeconds: 225},
+		{CronJobNamespace: "data", CronJobName: "report", Runs: 5, RuntimeSeconds: 100, CPUCoreSeconds: 0, MemoryGiBSeconds: 0},
+	}}
+}
+
+func TestGetCronJobUsage(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), newUsageTestStore(), nil, nil)
+	handler := chiRouterWithParams(h.GetCronJobUsage, map[string]string{"namespace": "data", "name": "etl"})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/data/etl/usage?days=7", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp UsageResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, NamespacedRef{Namespace: "data", Name: "etl"}, resp.CronJob)
+	assert.Equal(t, 7, resp.WindowDays)
+	assert.Equal(t, int64(10), resp.Usage.Runs)
+	assert.InDelta(t, 7200, resp.Usage.CPUCoreSeconds, 0)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs/data/etl/usage?days=0", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetUsage(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), newUsageTestStore(), nil, nil)
+
+	get := func(url string) ClusterUsageResponse {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		h.GetUsage(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp ClusterUsageResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		return resp
+	}
+
+	resp := get("/api/v1/usage")
+	assert.Equal(t, defaultUsageWindowDays, resp.WindowDays)
+	require.Len(t, resp.CronJobs, 3)
+	assert.Equal(t, "etl", resp.CronJobs[0].CronJob.Name)
+	assert.Equal(t, int64(45), resp.Total.Runs)
+	assert.InDelta(t, 7650, resp.Total.CPUCoreSeconds, 0)
+
+	// Totals cover every CronJob in the namespace, the list only the top ones
+	resp = get("/api/v1/usage?namespace=data&limit=1")
+	require.Len(t, resp.CronJobs, 1)
+	assert.Equal(t, "etl", resp.CronJobs[0].CronJob.Name)
+	assert.Equal(t, int64(15), resp.Total.Runs)
+	assert.InDelta(t, 3700, resp.Total.RuntimeSeconds, 0)
+}
+
+func TestGetUsage_NoStore(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), nil, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/usage", nil)
+	w := httptest.NewRecorder()
+	h.GetUsage(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
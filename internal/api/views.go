@@ -0,0 +1,338 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// cronJobSortKeys are the fields the CronJob list can be sorted by
+var cronJobSortKeys = []string{"name", "namespace", "status", "successRate", "lastSuccess", "nextRun"}
+
+// statusRank orders CronJob statuses from most to least severe
+var statusRank = map[string]int{"critical": 0, "warning": 1, "healthy": 2}
+
+// ListViews handles GET /api/v1/views
+// @Summary      List saved views
+// @Description  Returns the saved CronJob list filters, ordered by name
+// @Tags         Views
+// @Produce      json
+// @Success      200  {object}  SavedViewListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /views [get]
+func (h *Handlers) ListViews(w http.ResponseWriter, r *http.Request) {
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	views, err := h.store.ListViews(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	items := make([]SavedView, 0, len(views))
+	for i := range views {
+		items = append(items, savedViewItem(&views[i]))
+	}
+	writeJSON(w, http.StatusOK, SavedViewListResponse{Items: items})
+}
+
+// GetView handles GET /api/v1/views/:name
+// @Summary      Get a saved view
+// @Tags         Views
+// @Produce      json
+// @Param        name  path      string  true  "View name"
+// @Success      200  {object}  SavedView
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /views/{name} [get]
+func (h *Handlers) GetView(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	view, err := h.store.GetView(r.Context(), name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if view == nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("View %s not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, savedViewItem(view))
+}
+
+// CreateView handles POST /api/v1/views
+// @Summary      Create a saved view
+// @Description  Saves a named CronJob list filter, e.g. a team's nightly jobs
+// @Tags         Views
+// @Accept       json
+// @Produce      json
+// @Param        view  body      SavedView  true  "View to save"
+// @Success      201  {object}  SavedView
+// @Failure      400  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /views [post]
+func (h *Handlers) CreateView(w http.ResponseWriter, r *http.Request) {
+	h.saveView(w, r, "")
+}
+
+// UpdateView handles PUT /api/v1/views/:name
+// @Summary      Update a saved view
+// @Description  Replaces a saved view, or creates it if there is none with the name
+// @Tags         Views
+// @Accept       json
+// @Produce      json
+// @Param        name  path      string     true  "View name"
+// @Param        view  body      SavedView  true  "View to save"
+// @Success      200  {object}  SavedView
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /views/{name} [put]
+func (h *Handlers) UpdateView(w http.ResponseWriter, r *http.Request) {
+	h.saveView(w, r, chi.URLParam(r, "name"))
+}
+
+// saveView creates a view, or replaces the view named in the path
+func (h *Handlers) saveView(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	var req SavedView
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "invalid request body")
+		return
+	}
+	if name != "" {
+		if req.Name != "" && req.Name != name {
+			writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "name cannot be changed")
+			return
+		}
+		req.Name = name
+	}
+	if err := validateView(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	if name == "" {
+		existing, err := h.store.GetView(ctx, req.Name)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			return
+		}
+		if existing != nil {
+			writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("View %s already exists", req.Name))
+			return
+		}
+	}
+
+	view := store.SavedView{
+		Name:          req.Name,
+		Description:   req.Description,
+		LabelSelector: req.LabelSelector,
+		Team:          req.Team,
+		Status:        req.Status,
+		Search:        req.Search,
+		SortBy:        req.SortBy,
+		SortDesc:      req.SortDesc,
+	}
+	view.SetNamespaces(req.Namespaces)
+	if err := h.store.SaveView(ctx, view); err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+
+	saved, err := h.store.GetView(ctx, req.Name)
+	if err != nil || saved == nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", fmt.Sprintf("failed to read saved view %s", req.Name))
+		return
+	}
+	status := http.StatusOK
+	if name == "" {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, savedViewItem(saved))
+}
+
+// DeleteView handles DELETE /api/v1/views/:name
+// @Summary      Delete a saved view
+// @Tags         Views
+// @Produce      json
+// @Param        name  path      string  true  "View name"
+// @Success      200  {object}  SimpleResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Failure      503  {object}  ErrorResponse
+// @Router       /views/{name} [delete]
+func (h *Handlers) DeleteView(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	if h.store == nil {
+		writeError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Store not configured")
+		return
+	}
+
+	deleted, err := h.store.DeleteView(r.Context(), name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		return
+	}
+	if !deleted {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("View %s not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, SimpleResponse{Success: true, Message: fmt.Sprintf("View %s deleted", name)})
+}
+
+// validateView checks a view's name and that its filters can be applied
+func validateView(view *SavedView) error {
+	if view.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(view.Name) > 253 {
+		return fmt.Errorf("name must be at most 253 characters")
+	}
+	for _, ns := range view.Namespaces {
+		if ns == "" || strings.Contains(ns, ",") {
+			return fmt.Errorf("invalid namespace %q", ns)
+		}
+	}
+	if _, err := labels.Parse(view.LabelSelector); err != nil {
+		return fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	if view.SortBy != "" && !slices.Contains(cronJobSortKeys, view.SortBy) {
+		return fmt.Errorf("sortBy must be one of %s", strings.Join(cronJobSortKeys, ", "))
+	}
+	return nil
+}
+
+func savedViewItem(view *store.SavedView) SavedView {
+	item := SavedView{
+		Name:          view.Name,
+		Description:   view.Description,
+		Namespaces:    view.GetNamespaces(),
+		LabelSelector: view.LabelSelector,
+		Team:          view.Team,
+		Status:        view.Status,
+		Search:        view.Search,
+		SortBy:        view.SortBy,
+		SortDesc:      view.SortDesc,
+	}
+	if !view.CreatedAt.IsZero() {
+		item.CreatedAt = &view.CreatedAt
+	}
+	if !view.UpdatedAt.IsZero() {
+		item.UpdatedAt = &view.UpdatedAt
+	}
+	return item
+}
+
+// applyView fills the filters not set in the query string from a saved view
+func applyView(filter cronJobListFilter, view *store.SavedView) (cronJobListFilter, error) {
+	if filter.namespace == "" {
+		filter.namespaces = view.GetNamespaces()
+	}
+	if view.LabelSelector != "" {
+		selector, err := labels.Parse(view.LabelSelector)
+		if err != nil {
+			return filter, fmt.Errorf("view %s has an invalid labelSelector: %w", view.Name, err)
+		}
+		filter.selector = selector
+	}
+	if filter.team == "" {
+		filter.team = view.Team
+	}
+	if filter.status == "" {
+		filter.status = view.Status
+	}
+	if filter.search == "" {
+		filter.search = view.Search
+	}
+	if filter.sortBy == "" {
+		filter.sortBy = view.SortBy
+		filter.sortDesc = view.SortDesc
+	}
+	return filter, nil
+}
+
+// inNamespaces returns true if the filter has no namespace set or includes ns
+func (f cronJobListFilter) inNamespaces(ns string) bool {
+	return len(f.namespaces) == 0 || slices.Contains(f.namespaces, ns)
+}
+
+// sortCronJobItems sorts the CronJob list by one of cronJobSortKeys. Items
+// missing the sorted time are placed last, and ties are broken by namespace
+// and name.
+func sortCronJobItems(items []CronJobListItem, sortBy string, desc bool) {
+	if sortBy == "" {
+		return
+	}
+	compareTimes := func(a, b *time.Time) (int, bool) {
+		switch {
+		case a == nil && b == nil:
+			return 0, false
+		case a == nil:
+			return 1, true
+		case b == nil:
+			return -1, true
+		}
+		return a.Compare(*b), false
+	}
+	slices.SortStableFunc(items, func(a, b CronJobListItem) int {
+		var c int
+		switch sortBy {
+		case "name":
+			c = cmp.Compare(a.Name, b.Name)
+		case "namespace":
+			c = cmp.Compare(a.Namespace, b.Namespace)
+		case "status":
+			c = cmp.Compare(rankStatus(a.Status), rankStatus(b.Status))
+		case "successRate":
+			c = cmp.Compare(a.SuccessRate, b.SuccessRate)
+		case "lastSuccess", "nextRun":
+			ta, tb := a.LastSuccess, b.LastSuccess
+			if sortBy == "nextRun" {
+				ta, tb = a.NextRun, b.NextRun
+			}
+			var missing bool
+			if c, missing = compareTimes(ta, tb); missing {
+				return c
+			}
+		}
+		if desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+}
+
+func rankStatus(status string) int {
+	if rank, ok := statusRank[status]; ok {
+		return rank
+	}
+	return len(statusRank)
+}
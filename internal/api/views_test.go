@@ -0,0 +1,135 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func TestViews(t *testing.T) {
+	h := newTestHandlers(newTestAPIClient(), &testutil.MockStore{}, nil, nil)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/views", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.CreateView(w, req)
+		return w
+	}
+
+	w := post(`{"name": "nightly", "namespaces": ["billing", "data"], "labelSelector": "schedule=nightly", "sortBy": "status"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created SavedView
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	assert.Equal(t, []string{"billing", "data"}, created.Namespaces)
+	assert.NotNil(t, created.CreatedAt)
+
+	w = post(`{"name": "nightly"}`)
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	for body, msg := range map[string]string{
+		`{}`: "name is required",
+		`{"name": "bad", "labelSelector": "team in (a"}`: "invalid labelSelector",
+		`{"name": "bad", "sortBy": "color"}`:             "sortBy must be one of",
+	} {
+		w = post(body)
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		assert.Contains(t, w.Body.String(), msg)
+	}
+
+	handler := chiRouterWithParams(h.UpdateView, map[string]string{"name": "nightly"})
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/views/nightly", strings.NewReader(`{"team": "payments"}`))
+	w = httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/views", nil)
+	w = httptest.NewRecorder()
+	h.ListViews(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	var list SavedViewListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&list))
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "payments", list.Items[0].Team)
+	assert.Empty(t, list.Items[0].Namespaces, "PUT replaces the whole view")
+
+	handler = chiRouterWithParams(h.DeleteView, map[string]string{"name": "nightly"})
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/views/nightly", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	handler = chiRouterWithParams(h.GetView, map[string]string{"name": "nightly"})
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/views/nightly", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestListCronJobs_View(t *testing.T) {
+	h := newTeamsTestHandlers()
+	mockStore := &testutil.MockStore{}
+	h.store = mockStore
+
+	view := store.SavedView{Name: "payments", LabelSelector: "team=payments", SortBy: "status"}
+	view.SetNamespaces([]string{"billing", "data"})
+	require.NoError(t, mockStore.SaveView(t.Context(), view))
+	require.NoError(t, mockStore.SaveView(t.Context(), store.SavedView{Name: "billing", Namespaces: "billing", SortBy: "successRate", SortDesc: true}))
+
+	list := func(query string) CronJobListResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs?"+query, nil)
+		w := httptest.NewRecorder()
+		h.ListCronJobs(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var resp CronJobListResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		return resp
+	}
+	names := func(resp CronJobListResponse) []string {
+		var result []string
+		for _, item := range resp.Items {
+			result = append(result, item.Name)
+		}
+		return result
+	}
+
+	// Only invoices is labeled team=payments in the view's namespaces
+	assert.Equal(t, []string{"invoices"}, names(list("view=payments")))
+	assert.Equal(t, []string{"invoices", "refunds"}, names(list("view=billing")))
+	// Query parameters override the view's sort order
+	assert.Equal(t, []string{"refunds", "invoices"}, names(list("view=billing&sortBy=status")))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cronjobs?view=missing", nil)
+	w := httptest.NewRecorder()
+	h.ListCronJobs(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSortCronJobItems(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	items := []CronJobListItem{
+		{Name: "a", Status: "healthy", LastSuccess: &now},
+		{Name: "b", Status: "critical"},
+		{Name: "c", Status: "warning", LastSuccess: &earlier},
+	}
+
+	sortCronJobItems(items, "status", false)
+	assert.Equal(t, "b", items[0].Name)
+	assert.Equal(t, "c", items[1].Name)
+
+	// CronJobs that never succeeded come last in either order
+	sortCronJobItems(items, "lastSuccess", true)
+	assert.Equal(t, []string{"a", "c", "b"}, []string{items[0].Name, items[1].Name, items[2].Name})
+	sortCronJobItems(items, "lastSuccess", false)
+	assert.Equal(t, []string{"c", "a", "b"}, []string{items[0].Name, items[1].Name, items[2].Name})
+}
@@ -0,0 +1,303 @@
+// Package backup writes the guardian database to a portable archive and
+// restores it into any storage backend.
+//
+// An archive is a gzipped tarball of JSON Lines files:
+//
+//	manifest.json
+//	executions/000001.jsonl
+//	alert_history/000001.jsonl
+//	channel_stats.jsonl
+//
+// Rows are written in pages so large databases are never held in memory.
+// Offloaded logs stay in object storage; only their keys are archived.
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// FormatVersion is the archive format written by Write
+const FormatVersion = 1
+
+// pageSize is the number of rows per archive file
+const pageSize = 500
+
+const (
+	manifestFile     = "manifest.json"
+	executionsDir    = "executions"
+	alertHistoryDir  = "alert_history"
+	channelStatsFile = "channel_stats.jsonl"
+)
+
+// ErrNotEmpty is returned by Restore when the target store already has
+// executions and overwrite was not requested
+var ErrNotEmpty = errors.New("target store already has executions")
+
+// Manifest describes an archive
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// OperatorVersion is the version of the operator that wrote the archive
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	// StorageType is the backend the archive was taken from
+	StorageType string `json:"storageType,omitempty"`
+}
+
+// Stats counts the rows written or restored
+type Stats struct {
+	Executions   int `json:"executions"`
+	AlertHistory int `json:"alertHistory"`
+	ChannelStats int `json:"channelStats"`
+}
+
+// Write dumps executions, alert history and channel stats from st to w
+func Write(ctx context.Context, st store.Store, manifest Manifest, w io.Writer) (Stats, error) {
+	var stats Stats
+	manifest.FormatVersion = FormatVersion
+	if manifest.CreatedAt.IsZero() {
+		manifest.CreatedAt = time.Now().UTC()
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	a := &archiveWriter{tw: tw, modTime: manifest.CreatedAt}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return stats, err
+	}
+	if err := a.writeFile(manifestFile, data); err != nil {
+		return stats, err
+	}
+
+	stats.Executions, err = writePages(a, executionsDir, func(afterID int64) ([]store.Execution, int64, error) {
+		page, err := st.ExportExecutions(ctx, afterID, pageSize)
+		if err != nil || len(page) == 0 {
+			return nil, 0, err
+		}
+		return page, page[len(page)-1].ID, nil
+	})
+	if err != nil {
+		return stats, fmt.Errorf("export executions: %w", err)
+	}
+
+	stats.AlertHistory, err = writePages(a, alertHistoryDir, func(afterID int64) ([]store.AlertHistory, int64, error) {
+		page, err := st.ExportAlertHistory(ctx, afterID, pageSize)
+		if err != nil || len(page) == 0 {
+			return nil, 0, err
+		}
+		return page, page[len(page)-1].ID, nil
+	})
+	if err != nil {
+		return stats, fmt.Errorf("export alert history: %w", err)
+	}
+
+	channels, err := st.GetAllChannelStats(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("export channel stats: %w", err)
+	}
+	names := make([]string, 0, len(channels))
+	for name := range channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	records := make([]store.ChannelStatsRecord, 0, len(names))
+	for _, name := range names {
+		records = append(records, *channels[name])
+	}
+	data, err = encodeLines(records)
+	if err != nil {
+		return stats, err
+	}
+	if err := a.writeFile(channelStatsFile, data); err != nil {
+		return stats, err
+	}
+	stats.ChannelStats = len(records)
+
+	if err := tw.Close(); err != nil {
+		return stats, err
+	}
+	return stats, gz.Close()
+}
+
+// writePages writes the pages returned by next to numbered files in dir until
+// an empty page, returning the number of rows written
+func writePages[T any](a *archiveWriter, dir string, next func(afterID int64) ([]T, int64, error)) (int, error) {
+	var afterID int64
+	total := 0
+	for n := 1; ; n++ {
+		page, lastID, err := next(afterID)
+		if err != nil {
+			return total, err
+		}
+		if len(page) == 0 {
+			return total, nil
+		}
+		data, err := encodeLines(page)
+		if err != nil {
+			return total, err
+		}
+		if err := a.writeFile(path.Join(dir, fmt.Sprintf("%06d.jsonl", n)), data); err != nil {
+			return total, err
+		}
+		total += len(page)
+		afterID = lastID
+	}
+}
+
+func encodeLines[T any](rows []T) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+type archiveWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+}
+
+func (a *archiveWriter) writeFile(name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: a.modTime}
+	if err := a.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+// Restore loads an archive written by Write into st. Row IDs are not kept,
+// so the target assigns new ones. Unless overwrite is set, Restore refuses to
+// load into a store that already has executions, to avoid duplicating history.
+func Restore(ctx context.Context, st store.Store, r io.Reader, overwrite bool) (Manifest, Stats, error) {
+	var manifest Manifest
+	var stats Stats
+
+	if !overwrite {
+		count, err := st.GetExecutionCount(ctx)
+		if err != nil {
+			return manifest, stats, err
+		}
+		if count > 0 {
+			return manifest, stats, fmt.Errorf("%w (%d executions)", ErrNotEmpty, count)
+		}
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return manifest, stats, fmt.Errorf("read archive: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+	tr := tar.NewReader(gz)
+
+	seenManifest := false
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return manifest, stats, fmt.Errorf("read archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if hdr.Name == manifestFile {
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				return manifest, stats, fmt.Errorf("read manifest: %w", err)
+			}
+			if manifest.FormatVersion < 1 || manifest.FormatVersion > FormatVersion {
+				return manifest, stats, fmt.Errorf("unsupported archive format version %d", manifest.FormatVersion)
+			}
+			seenManifest = true
+			continue
+		}
+		if !seenManifest {
+			return manifest, stats, fmt.Errorf("archive does not start with %s", manifestFile)
+		}
+
+		switch {
+		case strings.HasPrefix(hdr.Name, executionsDir+"/"):
+			execs, err := decodeLines[store.Execution](tr)
+			if err != nil {
+				return manifest, stats, fmt.Errorf("read %s: %w", hdr.Name, err)
+			}
+			for i := range execs {
+				execs[i].ID = 0
+			}
+			if err := st.RecordExecutions(ctx, execs); err != nil {
+				return manifest, stats, fmt.Errorf("restore executions: %w", err)
+			}
+			stats.Executions += len(execs)
+
+		case strings.HasPrefix(hdr.Name, alertHistoryDir+"/"):
+			alerts, err := decodeLines[store.AlertHistory](tr)
+			if err != nil {
+				return manifest, stats, fmt.Errorf("read %s: %w", hdr.Name, err)
+			}
+			for _, alert := range alerts {
+				alert.ID = 0
+				if err := st.StoreAlert(ctx, alert); err != nil {
+					return manifest, stats, fmt.Errorf("restore alert history: %w", err)
+				}
+			}
+			stats.AlertHistory += len(alerts)
+
+		case hdr.Name == channelStatsFile:
+			records, err := decodeLines[store.ChannelStatsRecord](tr)
+			if err != nil {
+				return manifest, stats, fmt.Errorf("read %s: %w", hdr.Name, err)
+			}
+			for _, record := range records {
+				record.ID = 0
+				if err := st.SaveChannelStats(ctx, record); err != nil {
+					return manifest, stats, fmt.Errorf("restore channel stats: %w", err)
+				}
+			}
+			stats.ChannelStats += len(records)
+		}
+	}
+
+	if !seenManifest {
+		return manifest, stats, fmt.Errorf("archive has no %s", manifestFile)
+	}
+	return manifest, stats, nil
+}
+
+func decodeLines[T any](r io.Reader) ([]T, error) {
+	var rows []T
+	scanner := bufio.NewScanner(r)
+	// Rows can hold stored logs, which are capped by max-log-size-kb
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var row T
+		if err := json.Unmarshal(line, &row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, scanner.Err()
+}
@@ -0,0 +1,166 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func newStore(t *testing.T) *store.GormStore {
+	t.Helper()
+	st, err := store.NewGormStore("sqlite", filepath.Join(t.TempDir(), "guardian.db"))
+	require.NoError(t, err)
+	require.NoError(t, st.Init())
+	t.Cleanup(func() { _ = st.Close() })
+	return st
+}
+
+func TestWriteRestore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src := newStore(t)
+	start := time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)
+
+	// More than one page of executions
+	execs := make([]store.Execution, 0, pageSize+20)
+	for i := 0; i < pageSize+20; i++ {
+		logs := fmt.Sprintf("run %d\n", i)
+		execs = append(execs, store.Execution{
+			CronJobNamespace: "default",
+			CronJobName:      "backup",
+			JobName:          fmt.Sprintf("backup-%d", i),
+			StartTime:        start.Add(time.Duration(i) * time.Hour),
+			CompletionTime:   start.Add(time.Duration(i)*time.Hour + time.Minute),
+			Succeeded:        i%10 != 0,
+			ExitCode:         int32(i % 2),
+			Logs:             &logs,
+		})
+	}
+	require.NoError(t, src.RecordExecutions(ctx, execs))
+	resolved := start.Add(time.Hour)
+	require.NoError(t, src.StoreAlert(ctx, store.AlertHistory{
+		Type:             "JobFailed",
+		Severity:         "critical",
+		Title:            "Job failed",
+		CronJobNamespace: "default",
+		CronJobName:      "backup",
+		OccurredAt:       start,
+		ResolvedAt:       &resolved,
+		ChannelsNotified: "slack,pagerduty",
+	}))
+	require.NoError(t, src.SaveChannelStats(ctx, store.ChannelStatsRecord{ChannelName: "slack", AlertsSentTotal: 12, AlertsFailedTotal: 1}))
+
+	var buf bytes.Buffer
+	written, err := Write(ctx, src, Manifest{StorageType: "sqlite", OperatorVersion: "v1.2.3"}, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Executions: pageSize + 20, AlertHistory: 1, ChannelStats: 1}, written)
+
+	dst := newStore(t)
+	manifest, restored, err := Restore(ctx, dst, &buf, false)
+	require.NoError(t, err)
+	assert.Equal(t, written, restored)
+	assert.Equal(t, FormatVersion, manifest.FormatVersion)
+	assert.Equal(t, "sqlite", manifest.StorageType)
+	assert.Equal(t, "v1.2.3", manifest.OperatorVersion)
+
+	cronJob := types.NamespacedName{Namespace: "default", Name: "backup"}
+	srcMetrics, err := src.GetMetrics(ctx, cronJob, 3650)
+	require.NoError(t, err)
+	dstMetrics, err := dst.GetMetrics(ctx, cronJob, 3650)
+	require.NoError(t, err)
+	assert.Equal(t, srcMetrics.TotalRuns, dstMetrics.TotalRuns)
+	assert.Equal(t, srcMetrics.FailedRuns, dstMetrics.FailedRuns)
+
+	last, err := dst.GetExecutionByJobName(ctx, "default", fmt.Sprintf("backup-%d", pageSize+19))
+	require.NoError(t, err)
+	require.NotNil(t, last.Logs)
+	assert.Equal(t, fmt.Sprintf("run %d\n", pageSize+19), *last.Logs)
+
+	alerts, total, err := dst.ListAlertHistory(ctx, store.AlertHistoryQuery{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, []string{"slack", "pagerduty"}, alerts[0].GetChannelsNotified())
+	require.NotNil(t, alerts[0].ResolvedAt)
+
+	slack, err := dst.GetChannelStats(ctx, "slack")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), slack.AlertsSentTotal)
+}
+
+func TestRestore_RefusesNonEmptyStore(t *testing.T) {
+	ctx := context.Background()
+	src := newStore(t)
+	require.NoError(t, src.RecordExecution(ctx, store.Execution{
+		CronJobNamespace: "default",
+		CronJobName:      "backup",
+		JobName:          "backup-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+
+	var buf bytes.Buffer
+	_, err := Write(ctx, src, Manifest{}, &buf)
+	require.NoError(t, err)
+	archive := buf.Bytes()
+
+	_, _, err = Restore(ctx, src, bytes.NewReader(archive), false)
+	assert.ErrorIs(t, err, ErrNotEmpty)
+
+	_, stats, err := Restore(ctx, src, bytes.NewReader(archive), true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Executions)
+	count, err := src.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestRestore_InvalidArchives(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name:  "newer format",
+			files: map[string]string{manifestFile: `{"formatVersion": 99}`},
+			err:   "unsupported archive format version 99",
+		},
+		{
+			name:  "no manifest",
+			files: map[string]string{channelStatsFile: ""},
+			err:   "does not start with manifest.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			tw := tar.NewWriter(gz)
+			for name, content := range tt.files {
+				require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+				_, err := tw.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+			require.NoError(t, gz.Close())
+
+			_, _, err := Restore(ctx, newStore(t), &buf, false)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	_, _, err := Restore(ctx, newStore(t), bytes.NewReader([]byte("not an archive")), false)
+	assert.ErrorContains(t, err, "read archive")
+}
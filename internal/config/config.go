@@ -17,6 +17,24 @@ type Config struct {
 	// LogLevel is the logging level (debug, info, warn, error)
 	LogLevel string `mapstructure:"log-level"`
 
+	// ShardIndex is the shard this instance monitors, from 0 to ShardCount-1
+	ShardIndex int `mapstructure:"shard-index"`
+
+	// ShardCount splits monitoring by namespace across this many deployments
+	// sharing one database (1 disables sharding)
+	ShardCount int `mapstructure:"shard-count"`
+
+	// MigrateOnly applies storage schema migrations and exits without
+	// starting the operator
+	MigrateOnly bool `mapstructure:"migrate-only"`
+
+	// ClusterName names the cluster guardian runs in, next to the names of
+	// remote clusters in the API and alerts (empty = unnamed)
+	ClusterName string `mapstructure:"cluster-name"`
+
+	// RemoteClusters are additional clusters monitored through kubeconfigs
+	RemoteClusters []RemoteClusterConfig `mapstructure:"remote-clusters"`
+
 	// Scheduler configuration
 	Scheduler SchedulerConfig `mapstructure:"scheduler"`
 
@@ -29,6 +47,16 @@ type Config struct {
 	// RateLimits for alerts and remediations
 	RateLimits RateLimitsConfig `mapstructure:"rate-limits"`
 
+	// AlertCoalescing rolls up alerts with the same cause across CronJobs
+	AlertCoalescing AlertCoalescingConfig `mapstructure:"alert-coalescing"`
+
+	// ClusterDisruption detects node drains and cluster upgrades, and the
+	// failures they cause
+	ClusterDisruption ClusterDisruptionConfig `mapstructure:"cluster-disruption"`
+
+	// ChannelHTTP configures the proxy and CAs of the alert channels' requests
+	ChannelHTTP ChannelHTTPConfig `mapstructure:"channel-http"`
+
 	// UI server configuration (serves both web UI and REST API)
 	UI UIConfig `mapstructure:"ui"`
 
@@ -43,6 +71,48 @@ type Config struct {
 
 	// Webhook configuration
 	Webhook WebhookConfig `mapstructure:"webhook"`
+
+	// EventBus configuration
+	EventBus EventBusConfig `mapstructure:"event-bus"`
+
+	// Grafana integration configuration
+	Grafana GrafanaConfig `mapstructure:"grafana"`
+
+	// Redis configuration for shared alerting state
+	Redis RedisConfig `mapstructure:"redis"`
+
+	// Heartbeat lets something outside the cluster notice when guardian itself stops
+	Heartbeat HeartbeatConfig `mapstructure:"heartbeat"`
+
+	// Ownership maps CronJobs to the teams that own them
+	Ownership OwnershipConfig `mapstructure:"ownership"`
+
+	// IgnoredNamespaces are namespaces whose CronJobs are never monitored,
+	// whatever the monitors select (glob patterns such as "kube-*")
+	IgnoredNamespaces []string `mapstructure:"ignored-namespaces"`
+}
+
+// RuntimeConfig is the part of the configuration that can change while the
+// operator runs, through a GuardianConfig or a config file reload
+type RuntimeConfig struct {
+	RateLimits         RateLimitsConfig
+	RetentionDays      int
+	LogRetentionDays   int // 0 = same as RetentionDays
+	PruneInterval      time.Duration
+	StartupGracePeriod time.Duration
+	IgnoredNamespaces  []string
+}
+
+// Runtime returns the runtime settings of the configuration
+func (c *Config) Runtime() RuntimeConfig {
+	return RuntimeConfig{
+		RateLimits:         c.RateLimits,
+		RetentionDays:      c.HistoryRetention.DefaultDays,
+		LogRetentionDays:   c.Storage.LogRetentionDays,
+		PruneInterval:      c.Scheduler.PruneInterval,
+		StartupGracePeriod: c.Scheduler.StartupGracePeriod,
+		IgnoredNamespaces:  c.IgnoredNamespaces,
+	}
 }
 
 // SchedulerConfig configures background schedulers
@@ -60,13 +130,59 @@ type SchedulerConfig struct {
 	// This allows controllers to reconcile before triggering alerts, preventing
 	// alert floods on operator restart
 	StartupGracePeriod time.Duration `mapstructure:"startup-grace-period" json:"startupGracePeriod"`
+
+	// StartupGraceOverrides replaces the startup grace period for alerts of a
+	// type (e.g. DeadManTriggered) or severity (critical, warning, info).
+	// 0s sends those alerts right away. A type takes precedence over a severity.
+	StartupGraceOverrides map[string]time.Duration `mapstructure:"startup-grace-overrides" json:"startupGraceOverrides,omitempty"`
+
+	// BackfillOnStartup records the last successful run of each monitored
+	// CronJob if it completed while guardian was not running and its Job has
+	// been garbage-collected since
+	BackfillOnStartup bool `mapstructure:"backfill-on-startup" json:"backfillOnStartup"`
+
+	// UnschedulableAfter is how long a running Job's pods may stay unscheduled,
+	// or uncreated because of a ResourceQuota, before a SchedulingFailed alert
+	UnschedulableAfter time.Duration `mapstructure:"unschedulable-after" json:"unschedulableAfter"`
+}
+
+// StartupGraceFor returns the startup grace period for alerts of a type and
+// severity. Keys are matched ignoring case, since config files lowercase them.
+func (c SchedulerConfig) StartupGraceFor(alertType, severity string) time.Duration {
+	var bySeverity *time.Duration
+	for key, grace := range c.StartupGraceOverrides {
+		if strings.EqualFold(key, alertType) {
+			return grace
+		}
+		if strings.EqualFold(key, severity) {
+			bySeverity = &grace
+		}
+	}
+	if bySeverity != nil {
+		return *bySeverity
+	}
+	return c.StartupGracePeriod
+}
+
+// ShortestStartupGrace returns the shortest startup grace period of any alert
+func (c SchedulerConfig) ShortestStartupGrace() time.Duration {
+	shortest := c.StartupGracePeriod
+	for _, grace := range c.StartupGraceOverrides {
+		shortest = min(shortest, grace)
+	}
+	return shortest
 }
 
 // StorageConfig configures the storage backend
 type StorageConfig struct {
-	// Type is the storage backend type (sqlite, postgres, mysql)
+	// Type is the storage backend type (sqlite, postgres, timescale, mysql,
+	// or a backend compiled in with a build tag)
 	Type string `mapstructure:"type" json:"type"`
 
+	// DSN is the connection string of backends without a section of their
+	// own (omitted from JSON for security)
+	DSN string `mapstructure:"dsn" json:"-"`
+
 	// SQLite configuration
 	SQLite SQLiteConfig `mapstructure:"sqlite" json:"sqlite,omitempty"`
 
@@ -90,6 +206,98 @@ type StorageConfig struct {
 	// LogRetentionDays is how long to keep logs (default: same as history retention)
 	// If 0, uses history-retention.default-days
 	LogRetentionDays int `mapstructure:"log-retention-days" json:"logRetentionDays"`
+
+	// CacheTTL is how long metrics, success rates and last executions are cached
+	// in memory (0 disables the cache). Entries are invalidated when new
+	// executions are recorded.
+	CacheTTL time.Duration `mapstructure:"cache-ttl" json:"cacheTTL"`
+
+	// BatchSize is the number of buffered executions written per transaction
+	// (0 writes each execution immediately)
+	BatchSize int `mapstructure:"batch-size" json:"batchSize"`
+
+	// BatchFlushInterval is the longest an execution waits in the buffer
+	BatchFlushInterval time.Duration `mapstructure:"batch-flush-interval" json:"batchFlushInterval"`
+
+	// LogOffload moves large logs to object storage
+	LogOffload LogOffloadConfig `mapstructure:"log-offload" json:"logOffload"`
+
+	// MigrateTo is the schema version --migrate-only migrates up or down to
+	// (0 = latest, -1 = revert all migrations)
+	MigrateTo int `mapstructure:"migrate-to" json:"migrateTo,omitempty"`
+
+	// PasswordSecret reads the postgres or mysql password from a Kubernetes
+	// Secret instead of the password setting, reconnecting when it changes
+	PasswordSecret PasswordSecretConfig `mapstructure:"password-secret" json:"passwordSecret,omitempty"`
+
+	// Retry configures retries of store calls that fail with a transient
+	// database error (deadlock, serialization failure, dropped connection)
+	Retry StorageRetryConfig `mapstructure:"retry" json:"retry"`
+
+	// SlowQueryThreshold logs store calls taking at least this long
+	// (0 disables the slow query log)
+	SlowQueryThreshold time.Duration `mapstructure:"slow-query-threshold" json:"slowQueryThreshold"`
+}
+
+// StorageRetryConfig configures retries of transient database errors
+type StorageRetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt (0 = disabled)
+	MaxRetries int `mapstructure:"max-retries" json:"maxRetries"`
+
+	// InitialBackoff is the wait before the first retry, doubled for each retry
+	InitialBackoff time.Duration `mapstructure:"initial-backoff" json:"initialBackoff"`
+
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration `mapstructure:"max-backoff" json:"maxBackoff"`
+}
+
+// PasswordSecretConfig references the Secret key holding the database password
+type PasswordSecretConfig struct {
+	// Name of the Secret (empty = use the password setting)
+	Name string `mapstructure:"name" json:"name,omitempty"`
+
+	// Namespace of the Secret (empty = the operator's namespace)
+	Namespace string `mapstructure:"namespace" json:"namespace,omitempty"`
+
+	// Key in the Secret holding the password
+	Key string `mapstructure:"key" json:"key,omitempty"`
+
+	// RefreshInterval is how often the Secret is checked for a new password
+	RefreshInterval time.Duration `mapstructure:"refresh-interval" json:"refreshInterval,omitempty"`
+}
+
+// LogOffloadConfig configures offloading of large execution logs to an
+// S3-compatible bucket (AWS S3, GCS with HMAC keys, MinIO)
+type LogOffloadConfig struct {
+	// Enabled turns on log offload
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Provider is s3, gcs or minio
+	Provider string `mapstructure:"provider" json:"provider,omitempty"`
+
+	// Endpoint overrides the provider's default endpoint (required for minio)
+	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
+
+	// Region used for request signing
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	// Bucket name
+	Bucket string `mapstructure:"bucket" json:"bucket,omitempty"`
+
+	// Prefix for object keys
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+
+	// AccessKeyID for authentication
+	AccessKeyID string `mapstructure:"access-key-id" json:"-"`
+
+	// SecretAccessKey for authentication (omitted from JSON for security)
+	SecretAccessKey string `mapstructure:"secret-access-key" json:"-"`
+
+	// PathStyle addresses the bucket as endpoint/bucket instead of bucket.endpoint
+	PathStyle bool `mapstructure:"path-style" json:"pathStyle,omitempty"`
+
+	// ThresholdKB is the log size above which logs are offloaded
+	ThresholdKB int `mapstructure:"threshold-kb" json:"thresholdKB"`
 }
 
 // SQLiteConfig configures SQLite storage
@@ -135,6 +343,29 @@ type PostgreSQLConfig struct {
 
 	// ConnectionPool configures connection pooling
 	ConnectionPool ConnectionPoolConfig `mapstructure:"pool" json:"pool,omitempty"`
+
+	// Partitioning enables monthly range partitioning of the executions table.
+	// Only takes effect when the table is first created.
+	Partitioning bool `mapstructure:"partitioning" json:"partitioning"`
+
+	// ReadReplica serves read-heavy API and analytics queries from a replica
+	ReadReplica ReadReplicaConfig `mapstructure:"read-replica" json:"readReplica,omitempty"`
+}
+
+// ReadReplicaConfig configures a PostgreSQL read replica. It is reached with
+// the primary's database, credentials and SSL mode.
+type ReadReplicaConfig struct {
+	// Host is the replica host (empty = no replica)
+	Host string `mapstructure:"host" json:"host,omitempty"`
+
+	// Port is the replica port (0 = the primary's port)
+	Port int `mapstructure:"port" json:"port,omitempty"`
+
+	// MaxLag is the replication lag beyond which reads go to the primary
+	MaxLag time.Duration `mapstructure:"max-lag" json:"maxLag,omitempty"`
+
+	// CheckInterval is how often the replica's health and lag are checked
+	CheckInterval time.Duration `mapstructure:"check-interval" json:"checkInterval,omitempty"`
 }
 
 // MySQLConfig configures MySQL/MariaDB storage
@@ -165,6 +396,14 @@ type HistoryRetentionConfig struct {
 
 	// MaxDays is maximum allowed retention
 	MaxDays int `mapstructure:"max-days" json:"maxDays"`
+
+	// UndoWindow is how long history deleted through the API can be restored
+	// before the pruner removes it (0 = delete immediately, default: 168h)
+	UndoWindow time.Duration `mapstructure:"undo-window" json:"undoWindow"`
+
+	// RollupDays is how long daily roll-ups of executions are kept for
+	// long-range trends, independent of raw history (0 = disabled, default: 365)
+	RollupDays int `mapstructure:"rollup-days" json:"rollupDays"`
 }
 
 // RateLimitsConfig configures global rate limits
@@ -180,6 +419,68 @@ type RateLimitsConfig struct {
 	DefaultSuppressDuplicatesFor time.Duration `mapstructure:"default-suppress-duplicates-for" json:"defaultSuppressDuplicatesFor"`
 }
 
+// AlertCoalescingConfig rolls up alerts that share a cause, such as a bad
+// image or a shared library failing CronJobs in many namespaces, into one
+// alert listing the affected CronJobs
+type AlertCoalescingConfig struct {
+	// Enabled holds alerts with a coalescing key for the window, to collect
+	// others with the same key
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// By lists the alert fields that make up the coalescing key: image and
+	// reason. Alerts missing one of them are not coalesced.
+	By []string `mapstructure:"by" json:"by"`
+
+	// Window is how long alerts are held before they are sent
+	Window time.Duration `mapstructure:"window" json:"window"`
+
+	// MinCronJobs is the number of CronJobs sharing a key that are sent as
+	// one roll-up alert; fewer are sent on their own
+	MinCronJobs int `mapstructure:"min-cronjobs" json:"minCronJobs"`
+}
+
+// ClusterDisruptionConfig configures detection of cluster-wide disruptions
+// such as node drains and cluster upgrades. Runs that fail on a disrupted
+// node or because their pod was evicted are recorded as caused by the
+// disruption, and optionally do not alert.
+type ClusterDisruptionConfig struct {
+	// Enabled watches nodes and pod evictions for disruptions
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// MinUnavailableNodes is the number of cordoned or not ready nodes that
+	// makes a disruption (0 = nodes are not considered)
+	MinUnavailableNodes int `mapstructure:"min-unavailable-nodes" json:"minUnavailableNodes"`
+
+	// MinEvictedPods is the number of pods evicted within Window that makes
+	// a disruption (0 = evictions are not considered)
+	MinEvictedPods int `mapstructure:"min-evicted-pods" json:"minEvictedPods"`
+
+	// Window is how far back pod evictions are counted
+	Window time.Duration `mapstructure:"window" json:"window"`
+
+	// Cooldown is how long a disruption lasts after its conditions clear,
+	// for runs that started before and fail after
+	Cooldown time.Duration `mapstructure:"cooldown" json:"cooldown"`
+
+	// Interval is how often nodes and pods are checked
+	Interval time.Duration `mapstructure:"interval" json:"interval"`
+
+	// SuppressAlerts keeps failures caused by a disruption from alerting
+	SuppressAlerts bool `mapstructure:"suppress-alerts" json:"suppressAlerts"`
+}
+
+// ChannelHTTPConfig is the default proxy and CA setup of the alert channels'
+// HTTP requests. AlertChannels override it with spec.http.
+type ChannelHTTPConfig struct {
+	// ProxyURL is the forward proxy for channel requests. Empty uses the
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+	ProxyURL string `mapstructure:"proxy-url" json:"proxyURL,omitempty"`
+
+	// CAFile is a PEM file of CA certificates trusted by every channel, in
+	// addition to the system CAs
+	CAFile string `mapstructure:"ca-file" json:"caFile,omitempty"`
+}
+
 // UIConfig configures the web UI and REST API server
 type UIConfig struct {
 	// Enabled turns on the UI server (serves both web UI and REST API)
@@ -187,6 +488,20 @@ type UIConfig struct {
 
 	// Port for UI server
 	Port int `mapstructure:"port" json:"port"`
+
+	// ExternalURL is the URL users reach the dashboard at (e.g.
+	// https://guardian.example.com). Alerts and reports link to CronJob
+	// pages under it; empty = no links.
+	ExternalURL string `mapstructure:"external-url" json:"externalURL,omitempty"`
+
+	// SlackSigningSecret verifies Slack interactivity callbacks (omitted from JSON for security).
+	// Interactive Slack buttons are rejected while it is empty.
+	SlackSigningSecret string `mapstructure:"slack-signing-secret" json:"-"`
+
+	// ReceiptToken authenticates delivery receipts posted by downstream
+	// systems (omitted from JSON for security). Receipts are rejected while
+	// it is empty.
+	ReceiptToken string `mapstructure:"receipt-token" json:"-"`
 }
 
 // MetricsConfig configures the metrics server
@@ -228,8 +543,11 @@ type LeaderElectionConfig struct {
 	RetryPeriod time.Duration `mapstructure:"retry-period"`
 }
 
-// WebhookConfig configures webhook server TLS
+// WebhookConfig configures the webhook server
 type WebhookConfig struct {
+	// Enabled serves the CRD conversion webhook, needed to use the v1beta1 API
+	Enabled bool `mapstructure:"enabled"`
+
 	// CertPath is the directory containing webhook TLS certificates
 	CertPath string `mapstructure:"cert-path"`
 
@@ -243,15 +561,253 @@ type WebhookConfig struct {
 	EnableHTTP2 bool `mapstructure:"enable-http2"`
 }
 
+// EventBusConfig configures publishing of alert and execution events to a
+// message broker, independent of notification channels
+type EventBusConfig struct {
+	// Enabled turns on the event bus
+	Enabled bool `mapstructure:"enabled"`
+
+	// Type is the broker type (kafka, nats, http, splunk, elasticsearch, opensearch)
+	Type string `mapstructure:"type"`
+
+	// Format is the message format (json, cloudevents). Defaults to json for
+	// kafka and nats; http always uses cloudevents.
+	Format string `mapstructure:"format"`
+
+	// Source is the CloudEvents source attribute
+	Source string `mapstructure:"source"`
+
+	// AlertTopic is the Kafka topic, NATS subject, Splunk sourcetype or
+	// Elasticsearch index for alert events (empty disables them)
+	AlertTopic string `mapstructure:"alert-topic"`
+
+	// ExecutionTopic is the Kafka topic, NATS subject, Splunk sourcetype or
+	// Elasticsearch index for execution events (empty disables them)
+	ExecutionTopic string `mapstructure:"execution-topic"`
+
+	// QueueSize is the number of events buffered while the broker is slow or
+	// unavailable; further events are dropped
+	QueueSize int `mapstructure:"queue-size"`
+
+	// Kafka configuration
+	Kafka KafkaConfig `mapstructure:"kafka"`
+
+	// NATS configuration
+	NATS NATSConfig `mapstructure:"nats"`
+
+	// HTTP configuration
+	HTTP HTTPSinkConfig `mapstructure:"http"`
+
+	// Splunk configuration
+	Splunk SplunkSinkConfig `mapstructure:"splunk"`
+
+	// Elasticsearch configuration, also used for OpenSearch
+	Elasticsearch ElasticsearchSinkConfig `mapstructure:"elasticsearch"`
+}
+
+// KafkaConfig configures the Kafka event bus
+type KafkaConfig struct {
+	// Brokers are the bootstrap broker addresses (host:port)
+	Brokers []string `mapstructure:"brokers"`
+
+	// ClientID identifies the producer to the brokers
+	ClientID string `mapstructure:"client-id"`
+
+	// RequiredAcks is 0 (none), 1 (leader) or -1 (all in-sync replicas)
+	RequiredAcks int `mapstructure:"required-acks"`
+
+	// TLS enables TLS connections to the brokers
+	TLS bool `mapstructure:"tls"`
+
+	// SASLUsername enables SASL/PLAIN authentication
+	SASLUsername string `mapstructure:"sasl-username"`
+
+	// SASLPassword for SASL/PLAIN authentication
+	SASLPassword string `mapstructure:"sasl-password"`
+}
+
+// NATSConfig configures the NATS event bus
+type NATSConfig struct {
+	// URL is the server URL (nats://host:4222, or tls://host:4222 for TLS)
+	URL string `mapstructure:"url"`
+
+	// Username for user/password authentication
+	Username string `mapstructure:"username"`
+
+	// Password for user/password authentication
+	Password string `mapstructure:"password"`
+
+	// Token for token authentication
+	Token string `mapstructure:"token"`
+}
+
+// HTTPSinkConfig configures the HTTP CloudEvents sink
+type HTTPSinkConfig struct {
+	// URL is the sink events are POSTed to (e.g. a Knative Broker or Argo Events webhook)
+	URL string `mapstructure:"url"`
+
+	// Token is sent as a bearer token when set
+	Token string `mapstructure:"token"`
+}
+
+// SplunkSinkConfig configures the Splunk HTTP Event Collector sink
+type SplunkSinkConfig struct {
+	// URL is the HEC base URL (e.g. https://splunk.example.com:8088)
+	URL string `mapstructure:"url"`
+
+	// Token is the HEC token
+	Token string `mapstructure:"token"`
+
+	// Index overrides the token's default index when set
+	Index string `mapstructure:"index"`
+
+	// Source is the source field of every event
+	Source string `mapstructure:"source"`
+}
+
+// ElasticsearchSinkConfig configures the Elasticsearch and OpenSearch sink
+type ElasticsearchSinkConfig struct {
+	// URL is the cluster URL (e.g. https://elasticsearch.logging:9200)
+	URL string `mapstructure:"url"`
+
+	// Username for basic authentication
+	Username string `mapstructure:"username"`
+
+	// Password for basic authentication
+	Password string `mapstructure:"password"`
+
+	// APIKey is an encoded API key, used instead of basic authentication when set
+	APIKey string `mapstructure:"api-key"`
+
+	// InstallTemplates installs an index template for the alert and execution
+	// indices before writing to them
+	InstallTemplates bool `mapstructure:"install-templates"`
+}
+
+// GrafanaConfig configures pushing alert annotations to Grafana
+type GrafanaConfig struct {
+	// URL is the Grafana base URL (e.g. https://grafana.example.com).
+	// Annotations are pushed when it is set.
+	URL string `mapstructure:"url"`
+
+	// APIToken is a Grafana service account token with the annotations:write permission
+	APIToken string `mapstructure:"api-token"`
+
+	// DashboardUID attaches annotations to a dashboard (empty = organization-wide
+	// annotations). It is also the UID of the generated dashboard.
+	DashboardUID string `mapstructure:"dashboard-uid"`
+
+	// Tags are added to every annotation, in addition to cronjob-guardian and the alert details
+	Tags []string `mapstructure:"tags"`
+}
+
+// HeartbeatConfig configures the heartbeat guardian sends while it runs, a
+// dead-man's switch for the operator itself. Point it at an external service
+// that alerts when heartbeats stop (e.g. healthchecks.io, Cronitor or a
+// Pushgateway with an alert on the heartbeat timestamp).
+type HeartbeatConfig struct {
+	// URL receives a request every interval (empty = disabled)
+	URL string `mapstructure:"url"`
+
+	// Method is the HTTP method of the request (GET or POST)
+	Method string `mapstructure:"method"`
+
+	// Interval between heartbeats
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Pushgateway receives a heartbeat timestamp metric every interval
+	Pushgateway PushgatewayConfig `mapstructure:"pushgateway"`
+}
+
+// Enabled returns true if heartbeats are sent anywhere
+func (c HeartbeatConfig) Enabled() bool {
+	return c.URL != "" || c.Pushgateway.URL != ""
+}
+
+// PushgatewayConfig configures pushing metrics to a Prometheus Pushgateway
+type PushgatewayConfig struct {
+	// URL is the Pushgateway base URL (empty = disabled)
+	URL string `mapstructure:"url"`
+
+	// Job is the job label metrics are grouped by
+	Job string `mapstructure:"job"`
+}
+
+// RedisConfig configures Redis as shared state for the alert dispatcher:
+// duplicate suppression, rate limit counters and delayed alerts
+type RedisConfig struct {
+	// Enabled keeps dispatcher state in Redis instead of the store and memory
+	Enabled bool `mapstructure:"enabled"`
+
+	// Address is the Redis server (host:port)
+	Address string `mapstructure:"address"`
+
+	// Username for Redis ACL authentication (empty = default user)
+	Username string `mapstructure:"username"`
+
+	// Password for Redis authentication
+	Password string `mapstructure:"password"`
+
+	// DB is the Redis logical database
+	DB int `mapstructure:"db"`
+
+	// TLS enables TLS connections to Redis
+	TLS bool `mapstructure:"tls"`
+
+	// KeyPrefix is prepended to every key, separating installations that
+	// share a Redis database
+	KeyPrefix string `mapstructure:"key-prefix"`
+}
+
+// RemoteClusterConfig connects to a cluster monitored without running guardian
+// in it. The cluster needs the guardian CRDs and its CronJobMonitors; alert
+// channels are the ones of the local cluster.
+type RemoteClusterConfig struct {
+	// Name identifies the cluster in stored executions, the API and alerts
+	Name string `mapstructure:"name"`
+
+	// Kubeconfig is the path of the kubeconfig file, e.g. mounted from a Secret
+	Kubeconfig string `mapstructure:"kubeconfig"`
+
+	// Context selects a kubeconfig context (empty = the current context)
+	Context string `mapstructure:"context"`
+}
+
+// OwnershipConfig maps CronJobs to owning teams, for per-team views and
+// to tell alert receivers who owns a failing job
+type OwnershipConfig struct {
+	// TeamLabels are the CronJob labels holding the owning team, checked in
+	// order; the first one set wins
+	TeamLabels []string `mapstructure:"team-labels"`
+
+	// NamespaceTeams maps namespaces to teams, for CronJobs without a team label
+	NamespaceTeams map[string]string `mapstructure:"namespace-teams"`
+}
+
+// TeamFor returns the team owning a CronJob from its namespace and labels,
+// empty if it has no owner
+func (o OwnershipConfig) TeamFor(namespace string, labels map[string]string) string {
+	for _, l := range o.TeamLabels {
+		if team := labels[l]; team != "" {
+			return team
+		}
+	}
+	return o.NamespaceTeams[namespace]
+}
+
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		LogLevel: "info",
+		LogLevel:   "info",
+		ShardIndex: 0,
+		ShardCount: 1,
 		Scheduler: SchedulerConfig{
 			DeadManSwitchInterval:    1 * time.Minute,
 			SLARecalculationInterval: 5 * time.Minute,
 			PruneInterval:            1 * time.Hour,
 			StartupGracePeriod:       30 * time.Second,
+			BackfillOnStartup:        true,
+			UnschedulableAfter:       5 * time.Minute,
 		},
 		Storage: StorageConfig{
 			Type: "sqlite",
@@ -267,6 +823,10 @@ func DefaultConfig() *Config {
 					ConnMaxLifetime: 1 * time.Hour,
 					ConnMaxIdleTime: 10 * time.Minute,
 				},
+				ReadReplica: ReadReplicaConfig{
+					MaxLag:        30 * time.Second,
+					CheckInterval: 10 * time.Second,
+				},
 			},
 			MySQL: MySQLConfig{
 				Port: 3306,
@@ -281,16 +841,48 @@ func DefaultConfig() *Config {
 			EventStorageEnabled: false, // Opt-in by default
 			MaxLogSizeKB:        100,   // 100KB default max log size
 			LogRetentionDays:    0,     // 0 means use history-retention.default-days
+			CacheTTL:            30 * time.Second,
+			BatchSize:           100,
+			BatchFlushInterval:  500 * time.Millisecond,
+			LogOffload: LogOffloadConfig{
+				Provider:    "s3",
+				Prefix:      "executions",
+				ThresholdKB: 64,
+			},
+			PasswordSecret: PasswordSecretConfig{
+				Key:             "password",
+				RefreshInterval: time.Minute,
+			},
+			Retry: StorageRetryConfig{
+				MaxRetries:     3,
+				InitialBackoff: 100 * time.Millisecond,
+				MaxBackoff:     2 * time.Second,
+			},
+			SlowQueryThreshold: time.Second,
 		},
 		HistoryRetention: HistoryRetentionConfig{
 			DefaultDays: 30,
 			MaxDays:     90,
+			UndoWindow:  7 * 24 * time.Hour,
+			RollupDays:  365,
 		},
 		RateLimits: RateLimitsConfig{
 			MaxAlertsPerMinute:           50,
 			BurstLimit:                   10,
 			DefaultSuppressDuplicatesFor: 1 * time.Hour,
 		},
+		AlertCoalescing: AlertCoalescingConfig{
+			By:          []string{"image", "reason"},
+			Window:      time.Minute,
+			MinCronJobs: 3,
+		},
+		ClusterDisruption: ClusterDisruptionConfig{
+			MinUnavailableNodes: 1,
+			MinEvictedPods:      5,
+			Window:              10 * time.Minute,
+			Cooldown:            15 * time.Minute,
+			Interval:            30 * time.Second,
+		},
 		UI: UIConfig{
 			Enabled: true,
 			Port:    8080,
@@ -315,6 +907,35 @@ func DefaultConfig() *Config {
 			CertKey:     "tls.key",
 			EnableHTTP2: false,
 		},
+		EventBus: EventBusConfig{
+			AlertTopic:     "cronjob-guardian.alerts",
+			ExecutionTopic: "cronjob-guardian.executions",
+			QueueSize:      1000,
+			Source:         "/cronjob-guardian",
+			Kafka: KafkaConfig{
+				ClientID:     "cronjob-guardian",
+				RequiredAcks: -1,
+			},
+			Splunk: SplunkSinkConfig{
+				Source: "cronjob-guardian",
+			},
+			Elasticsearch: ElasticsearchSinkConfig{
+				InstallTemplates: true,
+			},
+		},
+		Redis: RedisConfig{
+			KeyPrefix: "cronjob-guardian:",
+		},
+		Heartbeat: HeartbeatConfig{
+			Method:   "GET",
+			Interval: time.Minute,
+			Pushgateway: PushgatewayConfig{
+				Job: "cronjob-guardian",
+			},
+		},
+		Ownership: OwnershipConfig{
+			TeamLabels: []string{"team"},
+		},
 	}
 }
 
@@ -323,15 +944,22 @@ func BindFlags(flags *pflag.FlagSet) {
 	// Top-level
 	flags.String("config", "", "Path to config file")
 	flags.String("log-level", "info", "Log level (debug, info, warn, error)")
+	flags.Int("shard-index", 0, "Shard monitored by this instance (0 to shard-count - 1)")
+	flags.Int("shard-count", 1, "Number of shards monitoring is split into by namespace (1 = no sharding)")
+	flags.String("cluster-name", "", "Name of the cluster guardian runs in, shown next to remote clusters")
+	flags.Bool("migrate-only", false, "Apply storage schema migrations and exit")
 
 	// Scheduler
 	flags.Duration("scheduler.dead-man-switch-interval", 1*time.Minute, "How often to check dead-man's switches")
 	flags.Duration("scheduler.sla-recalculation-interval", 5*time.Minute, "How often to recalculate SLA metrics")
 	flags.Duration("scheduler.prune-interval", 1*time.Hour, "How often to prune old execution history")
 	flags.Duration("scheduler.startup-grace-period", 30*time.Second, "Grace period after startup before sending alerts")
+	flags.Bool("scheduler.backfill-on-startup", true, "Record runs that completed while guardian was not running")
+	flags.Duration("scheduler.unschedulable-after", 5*time.Minute, "How long a running Job's pods may stay unscheduled before alerting")
 
 	// Storage
-	flags.String("storage.type", "sqlite", "Storage backend type (sqlite, postgres, mysql)")
+	flags.String("storage.type", "sqlite", "Storage backend type (sqlite, postgres, timescale, mysql)")
+	flags.String("storage.dsn", "", "Connection string of storage types compiled in with a build tag")
 	flags.String("storage.sqlite.path", "/data/guardian.db", "Path to SQLite database file")
 	flags.String("storage.postgres.host", "", "PostgreSQL host")
 	flags.Int("storage.postgres.port", 5432, "PostgreSQL port")
@@ -343,6 +971,11 @@ func BindFlags(flags *pflag.FlagSet) {
 	flags.Int("storage.postgres.pool.max-open-conns", 100, "PostgreSQL max open connections")
 	flags.Duration("storage.postgres.pool.conn-max-lifetime", 1*time.Hour, "PostgreSQL connection max lifetime")
 	flags.Duration("storage.postgres.pool.conn-max-idle-time", 10*time.Minute, "PostgreSQL connection max idle time")
+	flags.Bool("storage.postgres.partitioning", false, "Partition the PostgreSQL executions table by month (new databases only)")
+	flags.String("storage.postgres.read-replica.host", "", "PostgreSQL read replica host for API and analytics queries (empty = disabled)")
+	flags.Int("storage.postgres.read-replica.port", 0, "PostgreSQL read replica port (0 = primary port)")
+	flags.Duration("storage.postgres.read-replica.max-lag", 30*time.Second, "Replication lag beyond which reads go to the primary")
+	flags.Duration("storage.postgres.read-replica.check-interval", 10*time.Second, "How often the read replica's health and lag are checked")
 	flags.String("storage.mysql.host", "", "MySQL host")
 	flags.Int("storage.mysql.port", 3306, "MySQL port")
 	flags.String("storage.mysql.database", "", "MySQL database name")
@@ -356,10 +989,34 @@ func BindFlags(flags *pflag.FlagSet) {
 	flags.Bool("storage.event-storage-enabled", false, "Enable storing K8s events in database (default: false, opt-in)")
 	flags.Int("storage.max-log-size-kb", 100, "Maximum log size to store per execution in KB")
 	flags.Int("storage.log-retention-days", 0, "How long to keep logs (0 = use history-retention.default-days)")
+	flags.Duration("storage.cache-ttl", 30*time.Second, "How long to cache metrics and last executions in memory (0 = disabled)")
+	flags.Int("storage.batch-size", 100, "Executions written per batched transaction (0 = write each immediately)")
+	flags.Duration("storage.batch-flush-interval", 500*time.Millisecond, "Maximum time an execution waits before being written")
+	flags.Bool("storage.log-offload.enabled", false, "Offload large execution logs to object storage")
+	flags.String("storage.log-offload.provider", "s3", "Object storage provider (s3, gcs, minio)")
+	flags.String("storage.log-offload.endpoint", "", "Object storage endpoint (default depends on provider)")
+	flags.String("storage.log-offload.region", "", "Object storage region")
+	flags.String("storage.log-offload.bucket", "", "Object storage bucket")
+	flags.String("storage.log-offload.prefix", "executions", "Object key prefix for offloaded logs")
+	flags.String("storage.log-offload.access-key-id", "", "Object storage access key ID")
+	flags.String("storage.log-offload.secret-access-key", "", "Object storage secret access key")
+	flags.Bool("storage.log-offload.path-style", false, "Use path-style bucket addressing")
+	flags.Int("storage.log-offload.threshold-kb", 64, "Offload logs larger than this many KB")
+	flags.Int("storage.migrate-to", 0, "Schema version for --migrate-only (0 = latest, -1 = revert all)")
+	flags.String("storage.password-secret.name", "", "Secret holding the PostgreSQL or MySQL password (empty = use the password setting)")
+	flags.String("storage.password-secret.namespace", "", "Namespace of the password Secret (empty = operator namespace)")
+	flags.String("storage.password-secret.key", "password", "Key in the password Secret")
+	flags.Duration("storage.password-secret.refresh-interval", time.Minute, "How often to check the password Secret for rotation")
+	flags.Int("storage.retry.max-retries", 3, "Retries of store calls failing with a transient database error (0 = disabled)")
+	flags.Duration("storage.retry.initial-backoff", 100*time.Millisecond, "Wait before the first store retry, doubled for each retry")
+	flags.Duration("storage.retry.max-backoff", 2*time.Second, "Maximum wait between store retries")
+	flags.Duration("storage.slow-query-threshold", time.Second, "Log store calls taking at least this long (0 = disabled)")
 
 	// History retention
 	flags.Int("history-retention.default-days", 30, "Default retention period in days")
 	flags.Int("history-retention.max-days", 90, "Maximum retention period in days")
+	flags.Duration("history-retention.undo-window", 7*24*time.Hour, "How long deleted history can be restored before it is pruned (0 = delete immediately)")
+	flags.Int("history-retention.rollup-days", 365, "Days to keep daily execution roll-ups for trends (0 = disabled)")
 
 	// Rate limits
 	flags.Int("rate-limits.max-alerts-per-minute", 50, "Maximum alerts per minute across all channels")
@@ -369,6 +1026,9 @@ func BindFlags(flags *pflag.FlagSet) {
 	// UI server (serves both web UI and REST API)
 	flags.Bool("ui.enabled", true, "Enable the UI server (serves both web UI and REST API)")
 	flags.Int("ui.port", 8080, "UI server port")
+	flags.String("ui.external-url", "", "External URL of the dashboard that alerts and reports link to (empty = no links)")
+	flags.String("ui.slack-signing-secret", "", "Slack app signing secret for interactive message callbacks")
+	flags.String("ui.receipt-token", "", "Bearer token downstream systems use to post alert delivery receipts")
 
 	// Metrics
 	flags.String("metrics.bind-address", "0", "Metrics endpoint bind address (0 to disable)")
@@ -387,10 +1047,89 @@ func BindFlags(flags *pflag.FlagSet) {
 	flags.Duration("leader-election.retry-period", 2*time.Second, "Leader retry period")
 
 	// Webhook
+	flags.Bool("webhook.enabled", false, "Serve the CRD conversion webhook")
 	flags.String("webhook.cert-path", "", "Path to webhook TLS certificate directory")
 	flags.String("webhook.cert-name", "tls.crt", "Webhook TLS certificate file name")
 	flags.String("webhook.cert-key", "tls.key", "Webhook TLS key file name")
 	flags.Bool("webhook.enable-http2", false, "Enable HTTP/2 for webhook server")
+
+	// Event bus
+	flags.Bool("event-bus.enabled", false, "Publish alert and execution events to a message broker")
+	flags.String("event-bus.type", "", "Event bus broker type (kafka, nats, http, splunk, elasticsearch, opensearch)")
+	flags.String("event-bus.format", "", "Event message format (json, cloudevents; http always uses cloudevents)")
+	flags.String("event-bus.source", "/cronjob-guardian", "CloudEvents source attribute")
+	flags.String("event-bus.alert-topic", "cronjob-guardian.alerts", "Topic, subject, sourcetype or index for alert events (empty = disabled)")
+	flags.String("event-bus.execution-topic", "cronjob-guardian.executions", "Topic, subject, sourcetype or index for execution events (empty = disabled)")
+	flags.Int("event-bus.queue-size", 1000, "Events buffered while the broker is unavailable before dropping")
+	flags.StringSlice("event-bus.kafka.brokers", nil, "Kafka bootstrap brokers (host:port)")
+	flags.String("event-bus.kafka.client-id", "cronjob-guardian", "Kafka client ID")
+	flags.Int("event-bus.kafka.required-acks", -1, "Kafka acks: 0 (none), 1 (leader), -1 (all in-sync replicas)")
+	flags.Bool("event-bus.kafka.tls", false, "Use TLS for Kafka connections")
+	flags.String("event-bus.kafka.sasl-username", "", "Kafka SASL/PLAIN username")
+	flags.String("event-bus.kafka.sasl-password", "", "Kafka SASL/PLAIN password")
+	flags.String("event-bus.nats.url", "", "NATS server URL (nats:// or tls://)")
+	flags.String("event-bus.nats.username", "", "NATS username")
+	flags.String("event-bus.nats.password", "", "NATS password")
+	flags.String("event-bus.nats.token", "", "NATS authentication token")
+	flags.String("event-bus.http.url", "", "HTTP sink URL for CloudEvents (e.g. a Knative Broker)")
+	flags.String("event-bus.http.token", "", "Bearer token for the HTTP sink")
+	flags.String("event-bus.splunk.url", "", "Splunk HTTP Event Collector URL (e.g. https://splunk:8088)")
+	flags.String("event-bus.splunk.token", "", "Splunk HTTP Event Collector token")
+	flags.String("event-bus.splunk.index", "", "Splunk index (empty = the token's default index)")
+	flags.String("event-bus.splunk.source", "cronjob-guardian", "Splunk source field of events")
+	flags.String("event-bus.elasticsearch.url", "", "Elasticsearch or OpenSearch URL (e.g. https://elasticsearch:9200)")
+	flags.String("event-bus.elasticsearch.username", "", "Elasticsearch basic auth username")
+	flags.String("event-bus.elasticsearch.password", "", "Elasticsearch basic auth password")
+	flags.String("event-bus.elasticsearch.api-key", "", "Elasticsearch API key (used instead of basic auth)")
+	flags.Bool("event-bus.elasticsearch.install-templates", true, "Install index templates for the alert and execution indices")
+
+	// Grafana
+	flags.String("grafana.url", "", "Grafana base URL to push alert annotations to (empty = disabled)")
+	flags.String("grafana.api-token", "", "Grafana service account token")
+	flags.String("grafana.dashboard-uid", "", "Attach annotations to this dashboard (empty = organization-wide)")
+	flags.StringSlice("grafana.tags", nil, "Extra tags added to Grafana annotations")
+
+	// Redis
+	flags.Bool("redis.enabled", false, "Keep alert suppression, rate limit and delay state in Redis")
+	flags.String("redis.address", "", "Redis server address (host:port)")
+	flags.String("redis.username", "", "Redis ACL username")
+	flags.String("redis.password", "", "Redis password")
+	flags.Int("redis.db", 0, "Redis logical database")
+	flags.Bool("redis.tls", false, "Use TLS for Redis connections")
+	flags.String("redis.key-prefix", "cronjob-guardian:", "Prefix for all Redis keys")
+
+	// Heartbeat
+	flags.String("heartbeat.url", "", "URL requested every heartbeat interval while guardian runs (empty = disabled)")
+	flags.String("heartbeat.method", "GET", "HTTP method of heartbeat requests (GET or POST)")
+	flags.Duration("heartbeat.interval", time.Minute, "Interval between heartbeats")
+	flags.String("heartbeat.pushgateway.url", "", "Pushgateway URL to push the heartbeat timestamp metric to (empty = disabled)")
+	flags.String("heartbeat.pushgateway.job", "cronjob-guardian", "Job label of the heartbeat metric")
+
+	// Alert coalescing
+	flags.Bool("alert-coalescing.enabled", false, "Roll up alerts with the same cause across CronJobs into one alert")
+	flags.StringSlice("alert-coalescing.by", []string{"image", "reason"}, "Alert fields making up the coalescing key (image, reason)")
+	flags.Duration("alert-coalescing.window", time.Minute, "How long alerts are held to collect others with the same key")
+	flags.Int("alert-coalescing.min-cronjobs", 3, "CronJobs sharing a key that are sent as one roll-up alert")
+
+	// Cluster disruption
+	flags.Bool("cluster-disruption.enabled", false, "Detect node drains and cluster upgrades, and the failures they cause")
+	flags.Int("cluster-disruption.min-unavailable-nodes", 1, "Cordoned or not ready nodes that make a disruption (0 = ignore nodes)")
+	flags.Int("cluster-disruption.min-evicted-pods", 5, "Pods evicted within the window that make a disruption (0 = ignore evictions)")
+	flags.Duration("cluster-disruption.window", 10*time.Minute, "How far back pod evictions are counted")
+	flags.Duration("cluster-disruption.cooldown", 15*time.Minute, "How long a disruption lasts after its conditions clear")
+	flags.Duration("cluster-disruption.interval", 30*time.Second, "How often nodes and pod evictions are checked")
+	flags.Bool("cluster-disruption.suppress-alerts", false, "Do not alert on failures caused by a disruption")
+
+	// Channel HTTP
+	flags.String("channel-http.proxy-url", "", "Forward proxy for alert channel requests (empty = HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment)")
+	flags.String("channel-http.ca-file", "", "PEM file of CA certificates trusted by alert channels, in addition to the system CAs")
+
+	// Ownership
+	flags.StringSlice("ownership.team-labels", []string{"team"}, "CronJob labels naming the owning team, checked in order")
+	flags.StringToString("ownership.namespace-teams", nil, "Owning team per namespace for CronJobs without a team label (namespace=team,...)")
+
+	// Ignored namespaces
+	flags.StringSlice("ignored-namespaces", nil, "Namespaces whose CronJobs are never monitored (glob patterns allowed)")
 }
 
 // Load loads configuration from flags, environment, and config file
@@ -400,10 +1139,16 @@ func Load(flags *pflag.FlagSet) (*Config, error) {
 	// Set defaults from DefaultConfig
 	defaults := DefaultConfig()
 	v.SetDefault("log-level", defaults.LogLevel)
+	v.SetDefault("shard-index", defaults.ShardIndex)
+	v.SetDefault("shard-count", defaults.ShardCount)
+	v.SetDefault("cluster-name", defaults.ClusterName)
+	v.SetDefault("migrate-only", defaults.MigrateOnly)
 	v.SetDefault("scheduler.dead-man-switch-interval", defaults.Scheduler.DeadManSwitchInterval)
 	v.SetDefault("scheduler.sla-recalculation-interval", defaults.Scheduler.SLARecalculationInterval)
 	v.SetDefault("scheduler.prune-interval", defaults.Scheduler.PruneInterval)
 	v.SetDefault("scheduler.startup-grace-period", defaults.Scheduler.StartupGracePeriod)
+	v.SetDefault("scheduler.backfill-on-startup", defaults.Scheduler.BackfillOnStartup)
+	v.SetDefault("scheduler.unschedulable-after", defaults.Scheduler.UnschedulableAfter)
 	v.SetDefault("storage.type", defaults.Storage.Type)
 	v.SetDefault("storage.sqlite.path", defaults.Storage.SQLite.Path)
 	v.SetDefault("storage.postgres.port", defaults.Storage.PostgreSQL.Port)
@@ -412,6 +1157,11 @@ func Load(flags *pflag.FlagSet) (*Config, error) {
 	v.SetDefault("storage.postgres.pool.max-open-conns", defaults.Storage.PostgreSQL.ConnectionPool.MaxOpenConns)
 	v.SetDefault("storage.postgres.pool.conn-max-lifetime", defaults.Storage.PostgreSQL.ConnectionPool.ConnMaxLifetime)
 	v.SetDefault("storage.postgres.pool.conn-max-idle-time", defaults.Storage.PostgreSQL.ConnectionPool.ConnMaxIdleTime)
+	v.SetDefault("storage.postgres.partitioning", defaults.Storage.PostgreSQL.Partitioning)
+	v.SetDefault("storage.postgres.read-replica.host", defaults.Storage.PostgreSQL.ReadReplica.Host)
+	v.SetDefault("storage.postgres.read-replica.port", defaults.Storage.PostgreSQL.ReadReplica.Port)
+	v.SetDefault("storage.postgres.read-replica.max-lag", defaults.Storage.PostgreSQL.ReadReplica.MaxLag)
+	v.SetDefault("storage.postgres.read-replica.check-interval", defaults.Storage.PostgreSQL.ReadReplica.CheckInterval)
 	v.SetDefault("storage.mysql.port", defaults.Storage.MySQL.Port)
 	v.SetDefault("storage.mysql.pool.max-idle-conns", defaults.Storage.MySQL.ConnectionPool.MaxIdleConns)
 	v.SetDefault("storage.mysql.pool.max-open-conns", defaults.Storage.MySQL.ConnectionPool.MaxOpenConns)
@@ -421,11 +1171,46 @@ func Load(flags *pflag.FlagSet) (*Config, error) {
 	v.SetDefault("storage.event-storage-enabled", defaults.Storage.EventStorageEnabled)
 	v.SetDefault("storage.max-log-size-kb", defaults.Storage.MaxLogSizeKB)
 	v.SetDefault("storage.log-retention-days", defaults.Storage.LogRetentionDays)
+	v.SetDefault("storage.cache-ttl", defaults.Storage.CacheTTL)
+	v.SetDefault("storage.batch-size", defaults.Storage.BatchSize)
+	v.SetDefault("storage.batch-flush-interval", defaults.Storage.BatchFlushInterval)
+	v.SetDefault("storage.log-offload.enabled", defaults.Storage.LogOffload.Enabled)
+	v.SetDefault("storage.log-offload.provider", defaults.Storage.LogOffload.Provider)
+	v.SetDefault("storage.log-offload.endpoint", defaults.Storage.LogOffload.Endpoint)
+	v.SetDefault("storage.log-offload.region", defaults.Storage.LogOffload.Region)
+	v.SetDefault("storage.log-offload.bucket", defaults.Storage.LogOffload.Bucket)
+	v.SetDefault("storage.log-offload.prefix", defaults.Storage.LogOffload.Prefix)
+	v.SetDefault("storage.log-offload.access-key-id", defaults.Storage.LogOffload.AccessKeyID)
+	v.SetDefault("storage.log-offload.secret-access-key", defaults.Storage.LogOffload.SecretAccessKey)
+	v.SetDefault("storage.log-offload.path-style", defaults.Storage.LogOffload.PathStyle)
+	v.SetDefault("storage.log-offload.threshold-kb", defaults.Storage.LogOffload.ThresholdKB)
+	v.SetDefault("storage.migrate-to", defaults.Storage.MigrateTo)
+	v.SetDefault("storage.password-secret.key", defaults.Storage.PasswordSecret.Key)
+	v.SetDefault("storage.password-secret.refresh-interval", defaults.Storage.PasswordSecret.RefreshInterval)
+	v.SetDefault("storage.retry.max-retries", defaults.Storage.Retry.MaxRetries)
+	v.SetDefault("storage.retry.initial-backoff", defaults.Storage.Retry.InitialBackoff)
+	v.SetDefault("storage.retry.max-backoff", defaults.Storage.Retry.MaxBackoff)
+	v.SetDefault("storage.slow-query-threshold", defaults.Storage.SlowQueryThreshold)
 	v.SetDefault("history-retention.default-days", defaults.HistoryRetention.DefaultDays)
 	v.SetDefault("history-retention.max-days", defaults.HistoryRetention.MaxDays)
+	v.SetDefault("history-retention.undo-window", defaults.HistoryRetention.UndoWindow)
+	v.SetDefault("history-retention.rollup-days", defaults.HistoryRetention.RollupDays)
 	v.SetDefault("rate-limits.max-alerts-per-minute", defaults.RateLimits.MaxAlertsPerMinute)
 	v.SetDefault("rate-limits.burst-limit", defaults.RateLimits.BurstLimit)
 	v.SetDefault("rate-limits.default-suppress-duplicates-for", defaults.RateLimits.DefaultSuppressDuplicatesFor)
+	v.SetDefault("alert-coalescing.enabled", defaults.AlertCoalescing.Enabled)
+	v.SetDefault("alert-coalescing.by", defaults.AlertCoalescing.By)
+	v.SetDefault("alert-coalescing.window", defaults.AlertCoalescing.Window)
+	v.SetDefault("alert-coalescing.min-cronjobs", defaults.AlertCoalescing.MinCronJobs)
+	v.SetDefault("cluster-disruption.enabled", defaults.ClusterDisruption.Enabled)
+	v.SetDefault("cluster-disruption.min-unavailable-nodes", defaults.ClusterDisruption.MinUnavailableNodes)
+	v.SetDefault("cluster-disruption.min-evicted-pods", defaults.ClusterDisruption.MinEvictedPods)
+	v.SetDefault("cluster-disruption.window", defaults.ClusterDisruption.Window)
+	v.SetDefault("cluster-disruption.cooldown", defaults.ClusterDisruption.Cooldown)
+	v.SetDefault("cluster-disruption.interval", defaults.ClusterDisruption.Interval)
+	v.SetDefault("cluster-disruption.suppress-alerts", defaults.ClusterDisruption.SuppressAlerts)
+	v.SetDefault("channel-http.proxy-url", defaults.ChannelHTTP.ProxyURL)
+	v.SetDefault("channel-http.ca-file", defaults.ChannelHTTP.CAFile)
 	v.SetDefault("ui.enabled", defaults.UI.Enabled)
 	v.SetDefault("ui.port", defaults.UI.Port)
 	v.SetDefault("metrics.bind-address", defaults.Metrics.BindAddress)
@@ -437,9 +1222,25 @@ func Load(flags *pflag.FlagSet) (*Config, error) {
 	v.SetDefault("leader-election.lease-duration", defaults.LeaderElection.LeaseDuration)
 	v.SetDefault("leader-election.renew-deadline", defaults.LeaderElection.RenewDeadline)
 	v.SetDefault("leader-election.retry-period", defaults.LeaderElection.RetryPeriod)
+	v.SetDefault("webhook.enabled", defaults.Webhook.Enabled)
 	v.SetDefault("webhook.cert-name", defaults.Webhook.CertName)
 	v.SetDefault("webhook.cert-key", defaults.Webhook.CertKey)
 	v.SetDefault("webhook.enable-http2", defaults.Webhook.EnableHTTP2)
+	v.SetDefault("event-bus.enabled", defaults.EventBus.Enabled)
+	v.SetDefault("event-bus.alert-topic", defaults.EventBus.AlertTopic)
+	v.SetDefault("event-bus.execution-topic", defaults.EventBus.ExecutionTopic)
+	v.SetDefault("event-bus.queue-size", defaults.EventBus.QueueSize)
+	v.SetDefault("event-bus.source", defaults.EventBus.Source)
+	v.SetDefault("event-bus.kafka.client-id", defaults.EventBus.Kafka.ClientID)
+	v.SetDefault("event-bus.kafka.required-acks", defaults.EventBus.Kafka.RequiredAcks)
+	v.SetDefault("event-bus.splunk.source", defaults.EventBus.Splunk.Source)
+	v.SetDefault("event-bus.elasticsearch.install-templates", defaults.EventBus.Elasticsearch.InstallTemplates)
+	v.SetDefault("redis.enabled", defaults.Redis.Enabled)
+	v.SetDefault("redis.key-prefix", defaults.Redis.KeyPrefix)
+	v.SetDefault("heartbeat.method", defaults.Heartbeat.Method)
+	v.SetDefault("heartbeat.interval", defaults.Heartbeat.Interval)
+	v.SetDefault("heartbeat.pushgateway.job", defaults.Heartbeat.Pushgateway.Job)
+	v.SetDefault("ownership.team-labels", defaults.Ownership.TeamLabels)
 
 	// Bind flags
 	if err := v.BindPFlags(flags); err != nil {
@@ -26,6 +26,8 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, 5*time.Minute, cfg.Scheduler.SLARecalculationInterval)
 	assert.Equal(t, 1*time.Hour, cfg.Scheduler.PruneInterval)
 	assert.Equal(t, 30*time.Second, cfg.Scheduler.StartupGracePeriod)
+	assert.True(t, cfg.Scheduler.BackfillOnStartup)
+	assert.Equal(t, 5*time.Minute, cfg.Scheduler.UnschedulableAfter)
 
 	// Storage defaults
 	assert.Equal(t, "sqlite", cfg.Storage.Type)
@@ -37,10 +39,24 @@ func TestDefaultConfig(t *testing.T) {
 	assert.False(t, cfg.Storage.EventStorageEnabled)
 	assert.Equal(t, 100, cfg.Storage.MaxLogSizeKB)
 	assert.Equal(t, 0, cfg.Storage.LogRetentionDays)
+	assert.Equal(t, 30*time.Second, cfg.Storage.CacheTTL)
+	assert.Equal(t, 100, cfg.Storage.BatchSize)
+	assert.Equal(t, 500*time.Millisecond, cfg.Storage.BatchFlushInterval)
+	assert.Equal(t, 0, cfg.Storage.MigrateTo)
+	assert.Empty(t, cfg.Storage.PasswordSecret.Name)
+	assert.Equal(t, "password", cfg.Storage.PasswordSecret.Key)
+	assert.Equal(t, time.Minute, cfg.Storage.PasswordSecret.RefreshInterval)
+	assert.Equal(t, 3, cfg.Storage.Retry.MaxRetries)
+	assert.Equal(t, 100*time.Millisecond, cfg.Storage.Retry.InitialBackoff)
+	assert.Equal(t, 2*time.Second, cfg.Storage.Retry.MaxBackoff)
+	assert.Equal(t, time.Second, cfg.Storage.SlowQueryThreshold)
+	assert.False(t, cfg.MigrateOnly)
 
 	// History retention defaults
 	assert.Equal(t, 30, cfg.HistoryRetention.DefaultDays)
 	assert.Equal(t, 90, cfg.HistoryRetention.MaxDays)
+	assert.Equal(t, 7*24*time.Hour, cfg.HistoryRetention.UndoWindow)
+	assert.Equal(t, 365, cfg.HistoryRetention.RollupDays)
 
 	// Rate limits defaults
 	assert.Equal(t, 50, cfg.RateLimits.MaxAlertsPerMinute)
@@ -65,6 +81,7 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, 2*time.Second, cfg.LeaderElection.RetryPeriod)
 
 	// Webhook defaults
+	assert.False(t, cfg.Webhook.Enabled)
 	assert.Equal(t, "tls.crt", cfg.Webhook.CertName)
 	assert.Equal(t, "tls.key", cfg.Webhook.CertKey)
 	assert.False(t, cfg.Webhook.EnableHTTP2)
@@ -123,6 +140,7 @@ rate-limits:
 ui:
   enabled: true
   port: 9090
+  external-url: https://guardian.example.com
 leader-election:
   enabled: true
   lease-duration: 30s
@@ -167,6 +185,7 @@ leader-election:
 
 	assert.True(t, cfg.UI.Enabled)
 	assert.Equal(t, 9090, cfg.UI.Port)
+	assert.Equal(t, "https://guardian.example.com", cfg.UI.ExternalURL)
 
 	assert.True(t, cfg.LeaderElection.Enabled)
 	assert.Equal(t, 30*time.Second, cfg.LeaderElection.LeaseDuration)
@@ -342,6 +361,257 @@ func TestLoad_Flags_LeaderElection(t *testing.T) {
 	assert.Equal(t, 5*time.Second, cfg.LeaderElection.RetryPeriod)
 }
 
+func TestLoad_Flags_Sharding(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.ShardIndex)
+	assert.Equal(t, 1, cfg.ShardCount)
+
+	require.NoError(t, flags.Set("shard-index", "2"))
+	require.NoError(t, flags.Set("shard-count", "4"))
+	cfg, err = Load(flags)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cfg.ShardIndex)
+	assert.Equal(t, 4, cfg.ShardCount)
+}
+
+func TestLoad_Flags_MigrateOnly(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("migrate-only", "true"))
+	require.NoError(t, flags.Set("storage.migrate-to", "-1"))
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+	assert.True(t, cfg.MigrateOnly)
+	assert.Equal(t, -1, cfg.Storage.MigrateTo)
+}
+
+func TestLoad_EventBus(t *testing.T) {
+	t.Setenv("GUARDIAN_EVENT_BUS_KAFKA_SASL_PASSWORD", "s3cr3t")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("event-bus.enabled", "true"))
+	require.NoError(t, flags.Set("event-bus.type", "kafka"))
+	require.NoError(t, flags.Set("event-bus.kafka.brokers", "kafka-0:9092,kafka-1:9092"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.EventBus.Enabled)
+	assert.Equal(t, "kafka", cfg.EventBus.Type)
+	assert.Equal(t, []string{"kafka-0:9092", "kafka-1:9092"}, cfg.EventBus.Kafka.Brokers)
+	assert.Equal(t, "s3cr3t", cfg.EventBus.Kafka.SASLPassword)
+	assert.Equal(t, -1, cfg.EventBus.Kafka.RequiredAcks)
+	assert.Equal(t, "cronjob-guardian.alerts", cfg.EventBus.AlertTopic)
+	assert.Equal(t, "cronjob-guardian.executions", cfg.EventBus.ExecutionTopic)
+	assert.Equal(t, 1000, cfg.EventBus.QueueSize)
+	assert.Equal(t, "/cronjob-guardian", cfg.EventBus.Source)
+	assert.Empty(t, cfg.EventBus.Format)
+	assert.Equal(t, "cronjob-guardian", cfg.EventBus.Splunk.Source)
+	assert.True(t, cfg.EventBus.Elasticsearch.InstallTemplates)
+}
+
+func TestLoad_EventBusElasticsearch(t *testing.T) {
+	t.Setenv("GUARDIAN_EVENT_BUS_ELASTICSEARCH_API_KEY", "a2V5")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("event-bus.type", "opensearch"))
+	require.NoError(t, flags.Set("event-bus.elasticsearch.url", "https://opensearch:9200"))
+	require.NoError(t, flags.Set("event-bus.elasticsearch.install-templates", "false"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://opensearch:9200", cfg.EventBus.Elasticsearch.URL)
+	assert.Equal(t, "a2V5", cfg.EventBus.Elasticsearch.APIKey)
+	assert.False(t, cfg.EventBus.Elasticsearch.InstallTemplates)
+}
+
+func TestLoad_Redis(t *testing.T) {
+	t.Setenv("GUARDIAN_REDIS_PASSWORD", "s3cr3t")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("redis.enabled", "true"))
+	require.NoError(t, flags.Set("redis.address", "redis:6379"))
+	require.NoError(t, flags.Set("redis.db", "2"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Redis.Enabled)
+	assert.Equal(t, "redis:6379", cfg.Redis.Address)
+	assert.Equal(t, "s3cr3t", cfg.Redis.Password)
+	assert.Equal(t, 2, cfg.Redis.DB)
+	assert.False(t, cfg.Redis.TLS)
+	assert.Equal(t, "cronjob-guardian:", cfg.Redis.KeyPrefix)
+}
+
+func TestLoad_Grafana(t *testing.T) {
+	t.Setenv("GUARDIAN_GRAFANA_API_TOKEN", "glsa_token")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("grafana.url", "https://grafana.example.com"))
+	require.NoError(t, flags.Set("grafana.tags", "prod,eu-west-1"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://grafana.example.com", cfg.Grafana.URL)
+	assert.Equal(t, "glsa_token", cfg.Grafana.APIToken)
+	assert.Empty(t, cfg.Grafana.DashboardUID)
+	assert.Equal(t, []string{"prod", "eu-west-1"}, cfg.Grafana.Tags)
+}
+
+func TestLoad_Heartbeat(t *testing.T) {
+	t.Setenv("GUARDIAN_HEARTBEAT_URL", "https://hc-ping.com/abc")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("heartbeat.pushgateway.url", "http://pushgateway:9091"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Heartbeat.Enabled())
+	assert.Equal(t, "https://hc-ping.com/abc", cfg.Heartbeat.URL)
+	assert.Equal(t, "GET", cfg.Heartbeat.Method)
+	assert.Equal(t, time.Minute, cfg.Heartbeat.Interval)
+	assert.Equal(t, "http://pushgateway:9091", cfg.Heartbeat.Pushgateway.URL)
+	assert.Equal(t, "cronjob-guardian", cfg.Heartbeat.Pushgateway.Job)
+}
+
+func TestLoad_ClusterDisruption(t *testing.T) {
+	t.Setenv("GUARDIAN_CLUSTER_DISRUPTION_ENABLED", "true")
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("cluster-disruption.suppress-alerts", "true"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.ClusterDisruption.Enabled)
+	assert.True(t, cfg.ClusterDisruption.SuppressAlerts)
+	assert.Equal(t, 1, cfg.ClusterDisruption.MinUnavailableNodes)
+	assert.Equal(t, 5, cfg.ClusterDisruption.MinEvictedPods)
+	assert.Equal(t, 10*time.Minute, cfg.ClusterDisruption.Window)
+	assert.Equal(t, 15*time.Minute, cfg.ClusterDisruption.Cooldown)
+}
+
+func TestLoad_StartupGraceOverrides(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	yamlContent := `
+scheduler:
+  startup-grace-period: 2m
+  startup-grace-overrides:
+    critical: 0s
+    info: 10m
+    DeadManTriggered: 30s
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0600))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+	require.NoError(t, flags.Set("config", configPath))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	scheduler := cfg.Scheduler
+	assert.Equal(t, time.Duration(0), scheduler.StartupGraceFor("JobFailed", "critical"))
+	assert.Equal(t, 2*time.Minute, scheduler.StartupGraceFor("JobFailed", "warning"))
+	assert.Equal(t, 10*time.Minute, scheduler.StartupGraceFor("SLABreached", "info"))
+	assert.Equal(t, 30*time.Second, scheduler.StartupGraceFor("DeadManTriggered", "critical"), "type takes precedence")
+	assert.Equal(t, time.Duration(0), scheduler.ShortestStartupGrace())
+}
+
+func TestLoad_RemoteClusters(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	yamlContent := `
+cluster-name: hub
+remote-clusters:
+  - name: prod-eu
+    kubeconfig: /etc/guardian/clusters/prod-eu/kubeconfig
+  - name: prod-us
+    kubeconfig: /etc/guardian/clusters/prod-us/kubeconfig
+    context: guardian@prod-us
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0600))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+	require.NoError(t, flags.Set("config", configPath))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.Equal(t, "hub", cfg.ClusterName)
+	assert.Equal(t, []RemoteClusterConfig{
+		{Name: "prod-eu", Kubeconfig: "/etc/guardian/clusters/prod-eu/kubeconfig"},
+		{Name: "prod-us", Kubeconfig: "/etc/guardian/clusters/prod-us/kubeconfig", Context: "guardian@prod-us"},
+	}, cfg.RemoteClusters)
+}
+
+func TestLoad_Ownership(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team"}, cfg.Ownership.TeamLabels)
+	assert.Empty(t, cfg.Ownership.NamespaceTeams)
+
+	require.NoError(t, flags.Set("ownership.team-labels", "owner,team"))
+	require.NoError(t, flags.Set("ownership.namespace-teams", "billing=payments,etl=data"))
+
+	cfg, err = Load(flags)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"owner", "team"}, cfg.Ownership.TeamLabels)
+	assert.Equal(t, map[string]string{"billing": "payments", "etl": "data"}, cfg.Ownership.NamespaceTeams)
+}
+
+func TestLoad_Runtime(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+	require.NoError(t, flags.Set("ignored-namespaces", "kube-system,sandbox-*"))
+	require.NoError(t, flags.Set("history-retention.default-days", "60"))
+	require.NoError(t, flags.Set("rate-limits.burst-limit", "20"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+	runtime := cfg.Runtime()
+	assert.Equal(t, []string{"kube-system", "sandbox-*"}, runtime.IgnoredNamespaces)
+	assert.Equal(t, 60, runtime.RetentionDays)
+	assert.Equal(t, 20, runtime.RateLimits.BurstLimit)
+	assert.Equal(t, 30*time.Second, runtime.StartupGracePeriod)
+}
+
+func TestOwnershipConfig_TeamFor(t *testing.T) {
+	o := OwnershipConfig{
+		TeamLabels:     []string{"owner", "team"},
+		NamespaceTeams: map[string]string{"billing": "payments"},
+	}
+
+	assert.Equal(t, "sre", o.TeamFor("billing", map[string]string{"owner": "sre", "team": "ops"}))
+	assert.Equal(t, "ops", o.TeamFor("billing", map[string]string{"owner": "", "team": "ops"}))
+	assert.Equal(t, "payments", o.TeamFor("billing", map[string]string{"app": "invoices"}))
+	assert.Empty(t, o.TeamFor("default", nil))
+}
+
 // ============================================================================
 // Environment Variable Tests
 // ============================================================================
@@ -409,6 +679,7 @@ func TestLoad_StorageTypes(t *testing.T) {
 	}{
 		{"sqlite", "sqlite"},
 		{"postgres", "postgres"},
+		{"timescale", "timescale"},
 		{"mysql", "mysql"},
 	}
 
@@ -473,6 +744,21 @@ func TestLoad_StorageTypes_PostgreSQL(t *testing.T) {
 	assert.Equal(t, "verify-full", cfg.Storage.PostgreSQL.SSLMode)
 }
 
+func TestLoad_StorageRetry(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+
+	require.NoError(t, flags.Set("storage.retry.max-retries", "5"))
+	require.NoError(t, flags.Set("storage.retry.max-backoff", "10s"))
+
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, cfg.Storage.Retry.MaxRetries)
+	assert.Equal(t, 100*time.Millisecond, cfg.Storage.Retry.InitialBackoff)
+	assert.Equal(t, 10*time.Second, cfg.Storage.Retry.MaxBackoff)
+}
+
 func TestLoad_StorageTypes_MySQL(t *testing.T) {
 	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
 	BindFlags(flags)
@@ -570,6 +856,7 @@ func TestBindFlags_AllFlagsRegistered(t *testing.T) {
 	expectedFlags := []string{
 		"config",
 		"log-level",
+		"migrate-only",
 		"scheduler.dead-man-switch-interval",
 		"scheduler.sla-recalculation-interval",
 		"scheduler.prune-interval",
@@ -582,6 +869,8 @@ func TestBindFlags_AllFlagsRegistered(t *testing.T) {
 		"storage.postgres.username",
 		"storage.postgres.password",
 		"storage.postgres.ssl-mode",
+		"storage.postgres.read-replica.host",
+		"storage.postgres.read-replica.max-lag",
 		"storage.mysql.host",
 		"storage.mysql.port",
 		"storage.mysql.database",
@@ -591,11 +880,19 @@ func TestBindFlags_AllFlagsRegistered(t *testing.T) {
 		"storage.event-storage-enabled",
 		"storage.max-log-size-kb",
 		"storage.log-retention-days",
+		"storage.migrate-to",
+		"storage.password-secret.name",
+		"storage.password-secret.key",
+		"storage.retry.max-retries",
+		"storage.slow-query-threshold",
 		"history-retention.default-days",
 		"history-retention.max-days",
+		"history-retention.undo-window",
+		"history-retention.rollup-days",
 		"rate-limits.max-alerts-per-minute",
 		"ui.enabled",
 		"ui.port",
+		"ui.external-url",
 		"metrics.bind-address",
 		"metrics.secure",
 		"metrics.cert-path",
@@ -606,6 +903,7 @@ func TestBindFlags_AllFlagsRegistered(t *testing.T) {
 		"leader-election.lease-duration",
 		"leader-election.renew-deadline",
 		"leader-election.retry-period",
+		"webhook.enabled",
 		"webhook.cert-path",
 		"webhook.cert-name",
 		"webhook.cert-key",
@@ -676,6 +974,7 @@ leader-election:
   renew-deadline: 15s
   retry-period: 3s
 webhook:
+  enabled: true
   cert-path: /webhook-certs
   cert-name: webhook.crt
   cert-key: webhook.key
@@ -742,6 +1041,7 @@ webhook:
 	assert.Equal(t, 3*time.Second, cfg.LeaderElection.RetryPeriod)
 
 	// Webhook
+	assert.True(t, cfg.Webhook.Enabled)
 	assert.Equal(t, "/webhook-certs", cfg.Webhook.CertPath)
 	assert.Equal(t, "webhook.crt", cfg.Webhook.CertName)
 	assert.Equal(t, "webhook.key", cfg.Webhook.CertKey)
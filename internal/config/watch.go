@@ -0,0 +1,221 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// runtimeKeys are the settings a config file change applies without a
+// restart. Changes to any other setting are logged and wait for one.
+var runtimeKeys = []string{
+	"log-level",
+	"rate-limits.max-alerts-per-minute",
+	"rate-limits.burst-limit",
+	"rate-limits.default-suppress-duplicates-for",
+	"history-retention.default-days",
+	"storage.log-retention-days",
+	"scheduler.prune-interval",
+	"scheduler.startup-grace-period",
+	"ignored-namespaces",
+}
+
+// secretKeyParts mark settings whose values are never logged
+var secretKeyParts = []string{"password", "secret", "token", "api-key", "access-key"}
+
+// secretKeys are further settings whose values are never logged, such as
+// URLs that commonly contain a token
+var secretKeys = []string{"heartbeat.url"}
+
+// Change is a setting that differs between two configurations
+type Change struct {
+	Key string
+	Old string
+	New string
+}
+
+// String returns the change as "key: old -> new"
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Key, c.Old, c.New)
+}
+
+// Runtime returns true if the change is applied without a restart
+func (c Change) Runtime() bool {
+	return slices.Contains(runtimeKeys, c.Key)
+}
+
+// Diff returns the settings that differ between old and updated, sorted by
+// key. Values of secrets are masked.
+func Diff(old, updated *Config) []Change {
+	before := flatten(reflect.ValueOf(*old), "")
+	after := flatten(reflect.ValueOf(*updated), "")
+
+	var changes []Change
+	for key, value := range after {
+		if before[key] == value {
+			continue
+		}
+		c := Change{Key: key, Old: before[key], New: value}
+		if isSecretKey(key) {
+			c.Old, c.New = "***", "***"
+		}
+		changes = append(changes, c)
+	}
+	slices.SortFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return changes
+}
+
+// flatten returns the settings of a config struct by their dotted keys
+func flatten(v reflect.Value, prefix string) map[string]string {
+	out := make(map[string]string)
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name := field.Tag.Get("mapstructure")
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+		value := v.Field(i)
+		if value.Kind() == reflect.Struct {
+			for k, s := range flatten(value, key+".") {
+				out[k] = s
+			}
+			continue
+		}
+		out[key] = fmt.Sprint(value.Interface())
+	}
+	return out
+}
+
+func isSecretKey(key string) bool {
+	if slices.Contains(secretKeys, key) {
+		return true
+	}
+	name := key[strings.LastIndex(key, ".")+1:]
+	for _, part := range secretKeyParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watcher reloads the config file when it changes and calls onChange with
+// the previous and the new configuration. It watches the file's directory,
+// so the symlink swap of an updated ConfigMap volume is seen as well.
+type Watcher struct {
+	path     string
+	load     func() (*Config, error)
+	onChange func(old, updated *Config)
+	debounce time.Duration
+
+	mu      sync.Mutex
+	current *Config
+}
+
+// NewWatcher creates a watcher for the config file at path. current is the
+// configuration in use; load reads the configuration again.
+func NewWatcher(path string, current *Config, load func() (*Config, error), onChange func(old, updated *Config)) *Watcher {
+	return &Watcher{
+		path:     path,
+		load:     load,
+		onChange: onChange,
+		debounce: time.Second,
+		current:  current,
+	}
+}
+
+// Start implements manager.Runnable
+func (w *Watcher) Start(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating config file watcher: %w", err)
+	}
+	defer func() { _ = fsw.Close() }()
+
+	dir, file := filepath.Split(w.path)
+	if err := fsw.Add(filepath.Clean(dir)); err != nil {
+		return fmt.Errorf("watching config file directory: %w", err)
+	}
+	log.FromContext(ctx).Info("watching config file for changes", "file", w.path)
+
+	// Editors and ConfigMap updates produce bursts of events; reload once
+	// they settle
+	timer := time.NewTimer(0)
+	<-timer.C
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+			name := filepath.Base(event.Name)
+			if name == file || name == "..data" {
+				timer.Reset(w.debounce)
+			}
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			log.FromContext(ctx).Error(err, "config file watcher error")
+		case <-timer.C:
+			w.Reload(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection returns false: every replica applies its own config
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Reload reads the configuration once and applies it if it changed. An
+// invalid file is logged and the configuration in use kept.
+func (w *Watcher) Reload(ctx context.Context) {
+	logger := log.FromContext(ctx).WithValues("file", w.path)
+
+	updated, err := w.load()
+	if err != nil {
+		logger.Error(err, "failed to reload config file, keeping the current configuration")
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	changes := Diff(w.current, updated)
+	if len(changes) == 0 {
+		return
+	}
+
+	var applied, pending []string
+	for _, c := range changes {
+		if c.Runtime() {
+			applied = append(applied, c.String())
+		} else {
+			pending = append(pending, c.String())
+		}
+	}
+	if len(applied) > 0 {
+		logger.Info("config file changed, applying", "changes", applied)
+	}
+	if len(pending) > 0 {
+		logger.Info("config file changed, these settings apply after a restart", "changes", pending)
+	}
+
+	w.onChange(w.current, updated)
+	w.current = updated
+}
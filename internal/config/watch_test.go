@@ -0,0 +1,102 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	old := DefaultConfig()
+	updated := DefaultConfig()
+	updated.LogLevel = "debug"
+	updated.RateLimits.MaxAlertsPerMinute = 200
+	updated.Storage.PostgreSQL.Password = "hunter2"
+	updated.IgnoredNamespaces = []string{"kube-system"}
+
+	changes := Diff(old, updated)
+	require.Len(t, changes, 4)
+	assert.Equal(t, "ignored-namespaces", changes[0].Key)
+	assert.Equal(t, "log-level", changes[1].Key)
+	assert.Equal(t, "info", changes[1].Old)
+	assert.Equal(t, "debug", changes[1].New)
+	assert.True(t, changes[1].Runtime())
+	assert.Equal(t, "rate-limits.max-alerts-per-minute", changes[2].Key)
+	assert.Equal(t, "storage.postgres.password", changes[3].Key)
+	assert.Equal(t, "***", changes[3].New, "secrets are masked")
+	assert.False(t, changes[3].Runtime())
+
+	assert.Empty(t, Diff(old, DefaultConfig()))
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	current := DefaultConfig()
+	next := DefaultConfig()
+	next.RateLimits.BurstLimit = 50
+	var loadErr error
+
+	var got *Config
+	calls := 0
+	w := NewWatcher("config.yaml", current,
+		func() (*Config, error) { return next, loadErr },
+		func(old, updated *Config) {
+			calls++
+			assert.Same(t, current, old)
+			got = updated
+		})
+
+	w.Reload(context.Background())
+	assert.Equal(t, 1, calls)
+	assert.Same(t, next, got)
+
+	// Unchanged configuration is not applied again
+	w.Reload(context.Background())
+	assert.Equal(t, 1, calls)
+
+	// A file that fails to load keeps the configuration in use
+	loadErr = errors.New("invalid yaml")
+	w.Reload(context.Background())
+	assert.Equal(t, 1, calls)
+}
+
+func TestWatcher_Start(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("log-level: info\n"), 0o600))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	BindFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--config", configPath}))
+	cfg, err := Load(flags)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var level string
+	w := NewWatcher(configPath, cfg,
+		func() (*Config, error) { return Load(flags) },
+		func(_, updated *Config) {
+			mu.Lock()
+			defer mu.Unlock()
+			level = updated.LogLevel
+		})
+	w.debounce = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = w.Start(ctx) }()
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("log-level: debug\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return level == "debug"
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -30,6 +30,7 @@ type AlertChannelReconciler struct {
 // +kubebuilder:rbac:groups=guardian.illenium.net,resources=alertchannels/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=guardian.illenium.net,resources=alertchannels/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 // Reconcile handles AlertChannel reconciliation
 func (r *AlertChannelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -146,6 +147,12 @@ func (r *AlertChannelReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 }
 
+// ValidateAlertChannel checks an AlertChannel's configuration as the
+// reconciler does, including that the secrets it references hold the expected keys
+func ValidateAlertChannel(ctx context.Context, c client.Client, channel *guardianv1alpha1.AlertChannel) error {
+	return (&AlertChannelReconciler{Client: c}).validateConfig(ctx, channel)
+}
+
 func (r *AlertChannelReconciler) validateConfig(ctx context.Context, channel *guardianv1alpha1.AlertChannel) error {
 	switch channel.Spec.Type {
 	case "slack":
@@ -156,6 +163,18 @@ func (r *AlertChannelReconciler) validateConfig(ctx context.Context, channel *gu
 		return r.validateWebhook(ctx, channel.Spec.Webhook)
 	case "email":
 		return r.validateEmail(ctx, channel.Spec.Email)
+	case "telegram":
+		return r.validateTelegram(ctx, channel.Spec.Telegram)
+	case "sns":
+		return r.validateSNS(ctx, channel.Spec.SNS)
+	case "pubsub":
+		return r.validatePubSub(ctx, channel.Spec.PubSub)
+	case "eventgrid":
+		return r.validateEventGrid(ctx, channel.Spec.EventGrid)
+	case "ntfy":
+		return r.validateNtfy(ctx, channel.Spec.Ntfy)
+	case "pushover":
+		return r.validatePushover(ctx, channel.Spec.Pushover)
 	default:
 		return fmt.Errorf("unknown channel type: %s", channel.Spec.Type)
 	}
@@ -291,6 +310,175 @@ func (r *AlertChannelReconciler) validateEmail(ctx context.Context, config *guar
 	return nil
 }
 
+func (r *AlertChannelReconciler) validateTelegram(ctx context.Context, config *guardianv1alpha1.TelegramConfig) error {
+	if config == nil {
+		return fmt.Errorf("telegram config required for telegram type")
+	}
+
+	// Verify secret exists and has the bot token and chat ID
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: config.SecretRef.Namespace,
+		Name:      config.SecretRef.Name,
+	}, secret)
+	if err != nil {
+		return fmt.Errorf("failed to get telegram secret: %w", err)
+	}
+
+	for _, key := range []string{alerting.TelegramBotTokenKey, alerting.TelegramChatIDKey} {
+		if _, ok := secret.Data[key]; !ok {
+			return fmt.Errorf("telegram secret missing '%s' key", key)
+		}
+	}
+
+	// Validate template if provided
+	if config.MessageTemplate != "" {
+		if _, err := alerting.ParseTelegramTemplate(config.MessageTemplate); err != nil {
+			return fmt.Errorf("invalid message template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertChannelReconciler) validateSNS(ctx context.Context, config *guardianv1alpha1.SNSConfig) error {
+	if config == nil {
+		return fmt.Errorf("sns config required for sns type")
+	}
+
+	// Verify secret exists and has the access key pair
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: config.CredentialsSecretRef.Namespace,
+		Name:      config.CredentialsSecretRef.Name,
+	}, secret)
+	if err != nil {
+		return fmt.Errorf("failed to get SNS credentials secret: %w", err)
+	}
+
+	for _, key := range []string{alerting.AWSAccessKeyIDKey, alerting.AWSSecretAccessKeyKey} {
+		if _, ok := secret.Data[key]; !ok {
+			return fmt.Errorf("SNS credentials secret missing '%s' key", key)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertChannelReconciler) validatePubSub(ctx context.Context, config *guardianv1alpha1.PubSubConfig) error {
+	if config == nil {
+		return fmt.Errorf("pubsub config required for pubsub type")
+	}
+
+	// Verify secret exists and holds a usable service account key
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: config.CredentialsSecretRef.Namespace,
+		Name:      config.CredentialsSecretRef.Name,
+	}, secret)
+	if err != nil {
+		return fmt.Errorf("failed to get credentials secret: %w", err)
+	}
+
+	data, ok := secret.Data[config.CredentialsSecretRef.Key]
+	if !ok {
+		return fmt.Errorf("key %s not found in secret", config.CredentialsSecretRef.Key)
+	}
+	if _, err := alerting.ParseServiceAccountKey(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *AlertChannelReconciler) validateEventGrid(ctx context.Context, config *guardianv1alpha1.EventGridConfig) error {
+	if config == nil {
+		return fmt.Errorf("eventGrid config required for eventgrid type")
+	}
+
+	// Verify secret exists and has the key
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: config.AccessKeySecretRef.Namespace,
+		Name:      config.AccessKeySecretRef.Name,
+	}, secret)
+	if err != nil {
+		return fmt.Errorf("failed to get access key secret: %w", err)
+	}
+
+	if _, ok := secret.Data[config.AccessKeySecretRef.Key]; !ok {
+		return fmt.Errorf("key %s not found in secret", config.AccessKeySecretRef.Key)
+	}
+
+	return nil
+}
+
+func (r *AlertChannelReconciler) validateNtfy(ctx context.Context, config *guardianv1alpha1.NtfyConfig) error {
+	if config == nil {
+		return fmt.Errorf("ntfy config required for ntfy type")
+	}
+
+	// Verify the auth secret, if any, has a token or username and password
+	if config.AuthSecretRef != nil {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{
+			Namespace: config.AuthSecretRef.Namespace,
+			Name:      config.AuthSecretRef.Name,
+		}, secret)
+		if err != nil {
+			return fmt.Errorf("failed to get ntfy secret: %w", err)
+		}
+
+		_, hasToken := secret.Data[alerting.NtfyTokenKey]
+		_, hasUsername := secret.Data[alerting.NtfyUsernameKey]
+		_, hasPassword := secret.Data[alerting.NtfyPasswordKey]
+		if !hasToken && (!hasUsername || !hasPassword) {
+			return fmt.Errorf("ntfy secret needs a '%s' key or '%s' and '%s' keys",
+				alerting.NtfyTokenKey, alerting.NtfyUsernameKey, alerting.NtfyPasswordKey)
+		}
+	}
+
+	// Validate template if provided
+	if config.MessageTemplate != "" {
+		if _, err := alerting.ParsePushTemplate(config.MessageTemplate); err != nil {
+			return fmt.Errorf("invalid message template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *AlertChannelReconciler) validatePushover(ctx context.Context, config *guardianv1alpha1.PushoverConfig) error {
+	if config == nil {
+		return fmt.Errorf("pushover config required for pushover type")
+	}
+
+	// Verify secret exists and has the application token and user key
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: config.SecretRef.Namespace,
+		Name:      config.SecretRef.Name,
+	}, secret)
+	if err != nil {
+		return fmt.Errorf("failed to get pushover secret: %w", err)
+	}
+
+	for _, key := range []string{alerting.PushoverAppTokenKey, alerting.PushoverUserKeyKey} {
+		if _, ok := secret.Data[key]; !ok {
+			return fmt.Errorf("pushover secret missing '%s' key", key)
+		}
+	}
+
+	// Validate template if provided
+	if config.MessageTemplate != "" {
+		if _, err := alerting.ParsePushTemplate(config.MessageTemplate); err != nil {
+			return fmt.Errorf("invalid message template: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *AlertChannelReconciler) testChannel(ctx context.Context, channel *guardianv1alpha1.AlertChannel) error {
 	if r.AlertDispatcher == nil {
 		return fmt.Errorf("dispatcher not available")
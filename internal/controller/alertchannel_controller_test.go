@@ -1096,3 +1096,195 @@ func TestReconcile_NoDispatcher(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotZero(t, result.RequeueAfter)
 }
+
+func TestValidateConfig_Telegram(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "telegram-bot",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"bot-token": []byte("123:abc"),
+		},
+	}
+	channel := &guardianv1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "telegram-channel",
+			Namespace: "default",
+		},
+		Spec: guardianv1alpha1.AlertChannelSpec{
+			Type: "telegram",
+			Telegram: &guardianv1alpha1.TelegramConfig{
+				SecretRef: guardianv1alpha1.NamespacedSecretRef{
+					Name:      "telegram-bot",
+					Namespace: "default",
+				},
+				MessageTemplate: "{{ escape .Title }}",
+			},
+		},
+	}
+
+	fakeClient := newAlertChannelTestClient(secret, channel)
+	dispatcher := testutil.NewMockDispatcher()
+
+	reconciler := &AlertChannelReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		AlertDispatcher: dispatcher,
+	}
+
+	req := ctrl.Request{
+		NamespacedName: k8stypes.NamespacedName{
+			Name:      "telegram-channel",
+			Namespace: "default",
+		},
+	}
+
+	// Missing chat-id key
+	_, err := reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var updated guardianv1alpha1.AlertChannel
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+	assert.False(t, updated.Status.Ready)
+	assert.Contains(t, updated.Status.LastTestError, "missing 'chat-id' key")
+
+	// Complete secret passes validation
+	secret.Data["chat-id"] = []byte("-100987")
+	require.NoError(t, fakeClient.Update(context.Background(), secret))
+
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Contains(t, dispatcher.RegisteredChannelsMap, "telegram-channel")
+}
+
+func TestValidateConfig_CloudChannels(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cloud-creds",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"access-key-id":    []byte("AKIDEXAMPLE"),
+			"access-key":       []byte("sas-key"),
+			"credentials.json": []byte(`{"type":"service_account","client_email":"guardian@project.iam.gserviceaccount.com","private_key":"not a key"}`),
+		},
+	}
+	fakeClient := newAlertChannelTestClient(secret)
+	reconciler := &AlertChannelReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	tests := []struct {
+		name    string
+		spec    guardianv1alpha1.AlertChannelSpec
+		wantErr string
+	}{
+		{
+			name: "sns missing secret access key",
+			spec: guardianv1alpha1.AlertChannelSpec{
+				Type: "sns",
+				SNS: &guardianv1alpha1.SNSConfig{
+					TopicARN:             "arn:aws:sns:us-east-1:123456789012:alerts",
+					CredentialsSecretRef: guardianv1alpha1.NamespacedSecretRef{Name: "cloud-creds", Namespace: "default"},
+				},
+			},
+			wantErr: "missing 'secret-access-key' key",
+		},
+		{
+			name: "pubsub invalid service account key",
+			spec: guardianv1alpha1.AlertChannelSpec{
+				Type: "pubsub",
+				PubSub: &guardianv1alpha1.PubSubConfig{
+					Topic: "projects/p/topics/t",
+					CredentialsSecretRef: guardianv1alpha1.NamespacedSecretKeyRef{
+						Name: "cloud-creds", Namespace: "default", Key: "credentials.json",
+					},
+				},
+			},
+			wantErr: "private_key",
+		},
+		{
+			name: "eventgrid valid",
+			spec: guardianv1alpha1.AlertChannelSpec{
+				Type: "eventgrid",
+				EventGrid: &guardianv1alpha1.EventGridConfig{
+					TopicEndpoint: "https://topic.westeurope-1.eventgrid.azure.net/api/events",
+					AccessKeySecretRef: guardianv1alpha1.NamespacedSecretKeyRef{
+						Name: "cloud-creds", Namespace: "default", Key: "access-key",
+					},
+				},
+			},
+		},
+		{
+			name:    "sns missing config",
+			spec:    guardianv1alpha1.AlertChannelSpec{Type: "sns"},
+			wantErr: "sns config required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel := &guardianv1alpha1.AlertChannel{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloud"},
+				Spec:       tt.spec,
+			}
+			err := reconciler.validateConfig(context.Background(), channel)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateConfig_NtfyAndPushover(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "push",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"username":  []byte("guardian"),
+			"app-token": []byte("app123"),
+		},
+	}
+	fakeClient := newAlertChannelTestClient(secret)
+	ref := guardianv1alpha1.NamespacedSecretRef{Name: "push", Namespace: "default"}
+
+	ntfy := &guardianv1alpha1.AlertChannel{
+		Spec: guardianv1alpha1.AlertChannelSpec{
+			Type: "ntfy",
+			Ntfy: &guardianv1alpha1.NtfyConfig{Topic: "guardian-alerts"},
+		},
+	}
+	require.NoError(t, ValidateAlertChannel(context.Background(), fakeClient, ntfy), "public topics need no secret")
+
+	ntfy.Spec.Ntfy.AuthSecretRef = &ref
+	err := ValidateAlertChannel(context.Background(), fakeClient, ntfy)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "needs a 'token' key or 'username' and 'password' keys")
+
+	ntfy.Spec.Ntfy.AuthSecretRef = nil
+	ntfy.Spec.Ntfy.MessageTemplate = "{{ truncate .Message 10 }"
+	assert.ErrorContains(t, ValidateAlertChannel(context.Background(), fakeClient, ntfy), "invalid message template")
+
+	pushover := &guardianv1alpha1.AlertChannel{
+		Spec: guardianv1alpha1.AlertChannelSpec{
+			Type:     "pushover",
+			Pushover: &guardianv1alpha1.PushoverConfig{SecretRef: ref},
+		},
+	}
+	err = ValidateAlertChannel(context.Background(), fakeClient, pushover)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pushover secret missing 'user-key' key")
+
+	secret.Data["user-key"] = []byte("user456")
+	require.NoError(t, fakeClient.Update(context.Background(), secret))
+	assert.NoError(t, ValidateAlertChannel(context.Background(), fakeClient, pushover))
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+// AlertRouteReconciler reconciles an AlertRoute object.
+// It only validates routes; the alert dispatcher reads them when it sends an alert.
+type AlertRouteReconciler struct {
+	client.Client
+	Log    logr.Logger // Required - must be injected
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=alertroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=alertroutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=alertroutes/finalizers,verbs=update
+
+// Reconcile validates an AlertRoute and its channels and records the result in its status
+func (r *AlertRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("route", req.Name)
+	log.V(1).Info("reconciling AlertRoute")
+
+	route := &guardianv1alpha1.AlertRoute{}
+	if err := r.Get(ctx, req.NamespacedName, route); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.V(1).Info("route not found, likely deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "failed to get route")
+		return ctrl.Result{}, err
+	}
+
+	if err := alerting.ValidateAlertRoute(route.Spec); err != nil {
+		log.Info("route is invalid", "error", err.Error())
+		route.Status.Ready = false
+		r.setReadyCondition(route, metav1.ConditionFalse, "ValidationFailed", err.Error())
+	} else if missing, err := r.missingChannel(ctx, route); err != nil {
+		log.Error(err, "failed to get alert channel")
+		return ctrl.Result{}, err
+	} else if missing != "" {
+		// The route is still used; only the missing channel is skipped
+		route.Status.Ready = false
+		r.setReadyCondition(route, metav1.ConditionFalse, "ChannelNotFound",
+			fmt.Sprintf("AlertChannel %q not found", missing))
+	} else {
+		route.Status.Ready = true
+		r.setReadyCondition(route, metav1.ConditionTrue, "Valid", "Route is valid and in use")
+	}
+	route.Status.ObservedGeneration = route.Generation
+
+	if err := r.Status().Update(ctx, route); err != nil {
+		log.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// missingChannel returns the first channel the route refers to that does not exist
+func (r *AlertRouteReconciler) missingChannel(ctx context.Context, route *guardianv1alpha1.AlertRoute) (string, error) {
+	for _, ref := range route.Spec.ChannelRefs {
+		err := r.Get(ctx, types.NamespacedName{Name: ref.Name}, &guardianv1alpha1.AlertChannel{})
+		if client.IgnoreNotFound(err) != nil {
+			return "", err
+		}
+		if err != nil {
+			return ref.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *AlertRouteReconciler) setReadyCondition(route *guardianv1alpha1.AlertRoute, status metav1.ConditionStatus, reason, message string) {
+	const condType = "Ready"
+	condition := metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		ObservedGeneration: route.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+
+	for i, c := range route.Status.Conditions {
+		if c.Type == condType {
+			if c.Status == status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			route.Status.Conditions[i] = condition
+			return
+		}
+	}
+	route.Status.Conditions = append(route.Status.Conditions, condition)
+}
+
+// findRoutesForChannel returns the routes referring to an AlertChannel, so
+// their status follows the channel being created or deleted
+func (r *AlertRouteReconciler) findRoutesForChannel(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &guardianv1alpha1.AlertRouteList{}
+	if err := r.List(ctx, list); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, route := range list.Items {
+		for _, ref := range route.Spec.ChannelRefs {
+			if ref.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: route.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AlertRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("setting up AlertRoute controller")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&guardianv1alpha1.AlertRoute{}).
+		Watches(
+			&guardianv1alpha1.AlertChannel{},
+			handler.EnqueueRequestsFromMapFunc(r.findRoutesForChannel),
+		).
+		Named("alertroute").
+		Complete(r)
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func newAlertRouteTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = guardianv1alpha1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&guardianv1alpha1.AlertRoute{}).
+		Build()
+}
+
+func reconcileAlertRoute(t *testing.T, route *guardianv1alpha1.AlertRoute, objs ...client.Object) *guardianv1alpha1.AlertRoute {
+	t.Helper()
+	fakeClient := newAlertRouteTestClient(append(objs, route)...)
+	reconciler := &AlertRouteReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	nn := k8stypes.NamespacedName{Name: route.Name}
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: nn})
+	require.NoError(t, err)
+
+	updated := &guardianv1alpha1.AlertRoute{}
+	require.NoError(t, fakeClient.Get(context.Background(), nn, updated))
+	return updated
+}
+
+func TestAlertRouteReconcile_Valid(t *testing.T) {
+	channel := &guardianv1alpha1.AlertChannel{ObjectMeta: metav1.ObjectMeta{Name: "pagerduty"}}
+	updated := reconcileAlertRoute(t, &guardianv1alpha1.AlertRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Generation: 2},
+		Spec: guardianv1alpha1.AlertRouteSpec{
+			Match:       guardianv1alpha1.AlertRouteMatch{Namespaces: []string{"payments-*"}},
+			ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "pagerduty"}},
+		},
+	}, channel)
+
+	assert.True(t, updated.Status.Ready)
+	assert.Equal(t, int64(2), updated.Status.ObservedGeneration)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, conditionTypeReady, updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+}
+
+func TestAlertRouteReconcile_Invalid(t *testing.T) {
+	updated := reconcileAlertRoute(t, &guardianv1alpha1.AlertRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "broken"},
+		Spec: guardianv1alpha1.AlertRouteSpec{
+			Match: guardianv1alpha1.AlertRouteMatch{Namespaces: []string{"[payments"}},
+		},
+	})
+
+	assert.False(t, updated.Status.Ready)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "ValidationFailed", updated.Status.Conditions[0].Reason)
+}
+
+func TestAlertRouteReconcile_ChannelNotFound(t *testing.T) {
+	updated := reconcileAlertRoute(t, &guardianv1alpha1.AlertRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments"},
+		Spec: guardianv1alpha1.AlertRouteSpec{
+			ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "missing"}},
+		},
+	})
+
+	assert.False(t, updated.Status.Ready)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "ChannelNotFound", updated.Status.Conditions[0].Reason)
+	assert.Contains(t, updated.Status.Conditions[0].Message, "missing")
+}
+
+func TestAlertRouteReconcile_FindRoutesForChannel(t *testing.T) {
+	fakeClient := newAlertRouteTestClient(
+		&guardianv1alpha1.AlertRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "uses-slack"},
+			Spec:       guardianv1alpha1.AlertRouteSpec{ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "slack"}}},
+		},
+		&guardianv1alpha1.AlertRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "uses-email"},
+			Spec:       guardianv1alpha1.AlertRouteSpec{ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "email"}}},
+		},
+	)
+	reconciler := &AlertRouteReconciler{Client: fakeClient, Log: logr.Discard(), Scheme: fakeClient.Scheme()}
+
+	requests := reconciler.findRoutesForChannel(context.Background(),
+		&guardianv1alpha1.AlertChannel{ObjectMeta: metav1.ObjectMeta{Name: "slack"}})
+	require.Len(t, requests, 1)
+	assert.Equal(t, "uses-slack", requests[0].Name)
+}
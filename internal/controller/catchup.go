@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// catchUpBurst is the catch-up runs a CronJob fired when it was resumed,
+// evaluated together once the last of them finishes
+type catchUpBurst struct {
+	runs map[string]bool // Job name to whether it succeeded
+}
+
+// size returns the number of runs in the burst
+func (b catchUpBurst) size() int {
+	return len(b.runs)
+}
+
+// failed returns the number of failed runs in the burst
+func (b catchUpBurst) failed() int {
+	failed := 0
+	for _, succeeded := range b.runs {
+		if !succeeded {
+			failed++
+		}
+	}
+	return failed
+}
+
+// catchUpBursts are the bursts whose runs have not all finished yet
+type catchUpBursts struct {
+	mu     sync.Mutex
+	bursts map[types.NamespacedName]catchUpBurst
+}
+
+// add records a finished catch-up run of the CronJob
+func (c *catchUpBursts) add(cronJob types.NamespacedName, exec store.Execution) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.bursts == nil {
+		c.bursts = make(map[types.NamespacedName]catchUpBurst)
+	}
+	burst, ok := c.bursts[cronJob]
+	if !ok {
+		burst = catchUpBurst{runs: make(map[string]bool)}
+		c.bursts[cronJob] = burst
+	}
+	burst.runs[exec.JobName] = exec.Succeeded
+}
+
+// finish removes and returns the CronJob's burst
+func (c *catchUpBursts) finish(cronJob types.NamespacedName) catchUpBurst {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	burst := c.bursts[cronJob]
+	delete(c.bursts, cronJob)
+	return burst
+}
+
+// catchUpRunsActive reports whether catch-up runs of the CronJob other than
+// job are still running
+func (h *JobReconciler) catchUpRunsActive(ctx context.Context, cronJob *batchv1.CronJob, job *batchv1.Job) bool {
+	for _, ref := range cronJob.Status.Active {
+		if ref.Name == job.Name {
+			continue
+		}
+		other := &batchv1.Job{}
+		if err := h.Get(ctx, types.NamespacedName{Namespace: cronJob.Namespace, Name: ref.Name}, other); err != nil {
+			continue
+		}
+		running := other.Status.CompletionTime == nil && other.Status.Failed == 0
+		if running && analyzer.IsCatchUpRun(other, cronJob.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// annotateCatchUpBurst notes on a failure alert that it stands for a whole
+// burst of catch-up runs
+func annotateCatchUpBurst(alert *alerting.Alert, burst catchUpBurst) {
+	alert.Message += fmt.Sprintf("\n\nLast of %d catch-up runs fired when the CronJob was resumed; %d of them failed.",
+		burst.size(), burst.failed())
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// channelFailingThreshold is the number of consecutive failed deliveries
+// after which a channel makes AlertingHealthy false
+const channelFailingThreshold = 3
+
+// buildConditions computes the monitor's conditions after a successful
+// reconcile. They are computed once, outside the status update retry loop.
+func (r *CronJobMonitorReconciler) buildConditions(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, summary *guardianv1alpha1.MonitorSummary) []metav1.Condition {
+	return []metav1.Condition{
+		{
+			Type:    guardianv1alpha1.MonitorConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Reconciled",
+			Message: "Successfully reconciled",
+		},
+		degradedCondition(summary),
+		r.alertingCondition(ctx, monitor),
+		r.storeCondition(ctx),
+	}
+}
+
+// degradedCondition reports whether any monitored CronJob is unhealthy
+func degradedCondition(summary *guardianv1alpha1.MonitorSummary) metav1.Condition {
+	switch {
+	case summary.Critical > 0:
+		return metav1.Condition{
+			Type:    guardianv1alpha1.MonitorConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CronJobsCritical",
+			Message: fmt.Sprintf("%d of %d CronJobs are critical", summary.Critical, summary.TotalCronJobs),
+		}
+	case summary.Warning > 0:
+		return metav1.Condition{
+			Type:    guardianv1alpha1.MonitorConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CronJobsWarning",
+			Message: fmt.Sprintf("%d of %d CronJobs have warnings", summary.Warning, summary.TotalCronJobs),
+		}
+	default:
+		return metav1.Condition{
+			Type:    guardianv1alpha1.MonitorConditionDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  "AllHealthy",
+			Message: fmt.Sprintf("All %d CronJobs are healthy", summary.TotalCronJobs),
+		}
+	}
+}
+
+// alertingCondition reports whether the monitor's alerts can be delivered:
+// every referenced channel exists, is ready and is not failing repeatedly
+func (r *CronJobMonitorReconciler) alertingCondition(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor) metav1.Condition {
+	cond := metav1.Condition{Type: guardianv1alpha1.MonitorConditionAlertingHealthy}
+
+	alerting := monitor.Spec.Alerting
+	if alerting == nil || !isEnabled(alerting.Enabled) {
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = "AlertingDisabled"
+		cond.Message = "Alerting is disabled"
+		return cond
+	}
+	refs := alerting.AllChannelRefs()
+	if len(refs) == 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = "NoChannels"
+		cond.Message = "No alert channels are configured"
+		return cond
+	}
+
+	var problems []string
+	for _, ref := range refs {
+		channel := &guardianv1alpha1.AlertChannel{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name}, channel); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				cond.Status = metav1.ConditionUnknown
+				cond.Reason = "ChannelLookupFailed"
+				cond.Message = fmt.Sprintf("failed to get alert channel %s: %v", ref.Name, err)
+				return cond
+			}
+			setFirstReason(&cond, "ChannelNotFound")
+			problems = append(problems, fmt.Sprintf("channel %s not found", ref.Name))
+			continue
+		}
+		if !channel.Status.Ready {
+			setFirstReason(&cond, "ChannelNotReady")
+			problems = append(problems, fmt.Sprintf("channel %s is not ready", ref.Name))
+			continue
+		}
+
+		failures := channel.Status.ConsecutiveFailures
+		if r.AlertDispatcher != nil {
+			if stats := r.AlertDispatcher.GetChannelStats(ref.Name); stats != nil {
+				failures = stats.ConsecutiveFailures
+			}
+		}
+		if failures >= channelFailingThreshold {
+			setFirstReason(&cond, "ChannelFailing")
+			problems = append(problems, fmt.Sprintf("channel %s failed %d consecutive deliveries", ref.Name, failures))
+		}
+	}
+
+	if len(problems) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Message = strings.Join(problems, "; ")
+		return cond
+	}
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = "ChannelsReady"
+	cond.Message = fmt.Sprintf("All %d alert channels are ready", len(refs))
+	return cond
+}
+
+// storeCondition reports whether the execution history store is reachable
+func (r *CronJobMonitorReconciler) storeCondition(ctx context.Context) metav1.Condition {
+	cond := metav1.Condition{Type: guardianv1alpha1.MonitorConditionStoreHealthy}
+	if r.Store == nil {
+		cond.Status = metav1.ConditionUnknown
+		cond.Reason = "StoreNotConfigured"
+		cond.Message = "No execution history store is configured"
+		return cond
+	}
+	if err := r.Store.Health(ctx); err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = "StoreUnavailable"
+		cond.Message = err.Error()
+		return cond
+	}
+	cond.Status = metav1.ConditionTrue
+	cond.Reason = "Connected"
+	cond.Message = "Execution history store is reachable"
+	return cond
+}
+
+// setFirstReason sets the condition's reason unless an earlier problem set it
+func setFirstReason(cond *metav1.Condition, reason string) {
+	if cond.Reason == "" {
+		cond.Reason = reason
+	}
+}
+
+// applyConditions sets the conditions on the monitor, keeping the transition
+// time of conditions whose status did not change
+func applyConditions(monitor *guardianv1alpha1.CronJobMonitor, conditions []metav1.Condition) {
+	for _, c := range conditions {
+		c.ObservedGeneration = monitor.Generation
+		meta.SetStatusCondition(&monitor.Status.Conditions, c)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -28,17 +29,22 @@ import (
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
 	prommetrics "github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 const finalizerName = "guardian.illenium.net/finalizer"
 
+// upcomingRunsInStatus is the number of upcoming runs recorded in each CronJob's status
+const upcomingRunsInStatus = 5
+
 // CronJob status constants (lowercase to match CRD enum)
 const (
-	statusHealthy  = "healthy"
-	statusWarning  = "warning"
-	statusCritical = "critical"
-	statusUnknown  = "unknown"
+	statusHealthy   = "healthy"
+	statusWarning   = "warning"
+	statusCritical  = "critical"
+	statusSuspended = "suspended"
+	statusUnknown   = "unknown"
 )
 
 // Monitor phase constants (to match CRD enum)
@@ -58,12 +64,24 @@ type CronJobMonitorReconciler struct {
 	Config          *config.Config
 	Analyzer        analyzer.SLAAnalyzer
 	AlertDispatcher alerting.Dispatcher
+
+	// Shard limits reconciliation to monitors in this shard's namespaces (zero value = all)
+	Shard shard.Shard
+
+	// Ignored are namespaces whose CronJobs are left out of every monitor
+	// (optional; nil = none)
+	Ignored *IgnoredNamespaces
+
+	// Remote is the cluster monitors are reconciled in when it is not the
+	// manager's (optional; nil = the manager's cluster). Client must be the
+	// remote cluster's, Store and Analyzer scoped to it.
+	Remote *RemoteCluster
 }
 
 // +kubebuilder:rbac:groups=guardian.illenium.net,resources=cronjobmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=guardian.illenium.net,resources=cronjobmonitors/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=guardian.illenium.net,resources=cronjobmonitors/finalizers,verbs=update
-// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
@@ -105,7 +123,7 @@ func (r *CronJobMonitorReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// 4. Validate spec
 	if err := r.validateSpec(monitor); err != nil {
 		log.Error(err, "spec validation failed")
-		if updateErr := r.updateConditionWithRetry(ctx, req.NamespacedName, "Ready", metav1.ConditionFalse, "InvalidSpec", err.Error()); updateErr != nil {
+		if updateErr := r.updateConditionWithRetry(ctx, req.NamespacedName, guardianv1alpha1.MonitorConditionReady, metav1.ConditionFalse, "InvalidSpec", err.Error()); updateErr != nil {
 			log.Error(updateErr, "failed to update status after validation error")
 			return ctrl.Result{}, updateErr
 		}
@@ -131,12 +149,13 @@ func (r *CronJobMonitorReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// 6a. Handle CronJobs that were previously monitored but are now gone
 	r.handleRemovedCronJobs(ctx, monitor, cronJobs)
 
-	// 7. Calculate summary
+	// 7. Calculate summary and conditions
 	summary := r.calculateSummary(cronJobStatuses)
+	conditions := r.buildConditions(ctx, monitor, summary)
 
 	// 8. Update status with retry to handle optimistic locking conflicts
 	// Pass the generation we observed at the start of reconcile to detect mid-reconcile spec changes
-	if err := r.updateStatusWithRetry(ctx, req.NamespacedName, summary, cronJobStatuses, monitor.Generation); err != nil {
+	if err := r.updateStatusWithRetry(ctx, req.NamespacedName, summary, cronJobStatuses, conditions, monitor.Generation); err != nil {
 		if errors.Is(err, errGenerationChanged) {
 			// The monitor's spec changed while we were reconciling.
 			// The status we computed is stale - requeue immediately to recompute.
@@ -161,7 +180,7 @@ var errGenerationChanged = fmt.Errorf("monitor generation changed during reconci
 // updateStatusWithRetry updates the monitor status with retry logic to handle optimistic locking conflicts.
 // This re-fetches the monitor on conflict and applies the new status values.
 // The expectedGeneration parameter is used to detect if the monitor spec changed mid-reconcile.
-func (r *CronJobMonitorReconciler) updateStatusWithRetry(ctx context.Context, nn types.NamespacedName, summary *guardianv1alpha1.MonitorSummary, cronJobStatuses []guardianv1alpha1.CronJobStatus, expectedGeneration int64) error {
+func (r *CronJobMonitorReconciler) updateStatusWithRetry(ctx context.Context, nn types.NamespacedName, summary *guardianv1alpha1.MonitorSummary, cronJobStatuses []guardianv1alpha1.CronJobStatus, conditions []metav1.Condition, expectedGeneration int64) error {
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Re-fetch the latest version
 		monitor := &guardianv1alpha1.CronJobMonitor{}
@@ -186,7 +205,7 @@ func (r *CronJobMonitorReconciler) updateStatusWithRetry(ctx context.Context, nn
 		monitor.Status.LastReconcileTime = &now
 		monitor.Status.Summary = summary
 		monitor.Status.CronJobs = cronJobStatuses
-		r.setCondition(monitor, "Ready", metav1.ConditionTrue, "Reconciled", "Successfully reconciled")
+		applyConditions(monitor, conditions)
 
 		return r.Status().Update(ctx, monitor)
 	})
@@ -199,105 +218,27 @@ func (r *CronJobMonitorReconciler) updateConditionWithRetry(ctx context.Context,
 		if err := r.Get(ctx, nn, monitor); err != nil {
 			return err
 		}
-		r.setCondition(monitor, condType, status, reason, message)
+		applyConditions(monitor, []metav1.Condition{{Type: condType, Status: status, Reason: reason, Message: message}})
 		return r.Status().Update(ctx, monitor)
 	})
 }
 
-func (r *CronJobMonitorReconciler) validateSpec(_ *guardianv1alpha1.CronJobMonitor) error {
-	// Basic validation - currently no validation needed
+func (r *CronJobMonitorReconciler) validateSpec(monitor *guardianv1alpha1.CronJobMonitor) error {
 	// No selector means match all, which is valid
+	if monitor.Spec.Alerting != nil {
+		return alerting.ValidateRouting(monitor.Spec.Alerting.Routing)
+	}
 	return nil
 }
 
 func (r *CronJobMonitorReconciler) findMatchingCronJobs(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor) ([]batchv1.CronJob, error) {
-	// Determine which namespaces to search
-	namespaces, err := r.getTargetNamespaces(ctx, monitor)
-	if err != nil {
-		return nil, err
-	}
-
-	r.Log.V(1).Info("searching for CronJobs", "namespaces", namespaces)
-
-	var result []batchv1.CronJob
-	for _, ns := range namespaces {
-		cronJobList := &batchv1.CronJobList{}
-		if err := r.List(ctx, cronJobList, client.InNamespace(ns)); err != nil {
-			r.Log.Error(err, "failed to list CronJobs in namespace", "namespace", ns)
-			continue
-		}
-		r.Log.V(1).Info("found CronJobs in namespace", "namespace", ns, "count", len(cronJobList.Items))
-
-		for _, cj := range cronJobList.Items {
-			if MatchesSelector(&cj, monitor.Spec.Selector) {
-				r.Log.V(1).Info("CronJob matches selector", "namespace", ns, "cronJob", cj.Name)
-				result = append(result, cj)
-			}
-		}
-	}
-
-	return result, nil
-}
-
-// getTargetNamespaces determines which namespaces to search based on the selector
-func (r *CronJobMonitorReconciler) getTargetNamespaces(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor) ([]string, error) {
-	selector := monitor.Spec.Selector
-
-	// No selector or empty selector - use monitor's namespace
-	if selector == nil {
-		return []string{monitor.Namespace}, nil
-	}
-
-	// AllNamespaces takes precedence
-	if selector.AllNamespaces {
-		return r.getAllNamespaces(ctx)
-	}
-
-	// Explicit namespace list
-	if len(selector.Namespaces) > 0 {
-		return selector.Namespaces, nil
-	}
-
-	// Namespace label selector
-	if selector.NamespaceSelector != nil {
-		return r.getNamespacesBySelector(ctx, selector.NamespaceSelector)
-	}
-
-	// Default: monitor's own namespace
-	return []string{monitor.Namespace}, nil
-}
-
-// getAllNamespaces returns all namespace names in the cluster
-func (r *CronJobMonitorReconciler) getAllNamespaces(ctx context.Context) ([]string, error) {
-	nsList := &corev1.NamespaceList{}
-	if err := r.List(ctx, nsList); err != nil {
-		return nil, err
+	cronJobs, err := FindMatchingCronJobs(logr.NewContext(ctx, r.Log), r.Client, monitor)
+	if err != nil || r.Ignored == nil {
+		return cronJobs, err
 	}
-
-	namespaces := make([]string, 0, len(nsList.Items))
-	for _, ns := range nsList.Items {
-		namespaces = append(namespaces, ns.Name)
-	}
-	return namespaces, nil
-}
-
-// getNamespacesBySelector returns namespaces matching the label selector
-func (r *CronJobMonitorReconciler) getNamespacesBySelector(ctx context.Context, selector *metav1.LabelSelector) ([]string, error) {
-	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
-	if err != nil {
-		return nil, err
-	}
-
-	nsList := &corev1.NamespaceList{}
-	if err := r.List(ctx, nsList, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
-		return nil, err
-	}
-
-	namespaces := make([]string, 0, len(nsList.Items))
-	for _, ns := range nsList.Items {
-		namespaces = append(namespaces, ns.Name)
-	}
-	return namespaces, nil
+	return slices.DeleteFunc(cronJobs, func(cj batchv1.CronJob) bool {
+		return r.Ignored.Has(cj.Namespace)
+	}), nil
 }
 
 func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, cj *batchv1.CronJob) guardianv1alpha1.CronJobStatus {
@@ -308,6 +249,7 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 		Namespace: cj.Namespace,
 		Suspended: cj.Spec.Suspend != nil && *cj.Spec.Suspend,
 	}
+	status.IntentionallySuspended = status.Suspended && monitor.Spec.SuspendedHandling.IsIntentionallySuspended(cj.Namespace, cj.Name)
 
 	cronJobNN := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
 
@@ -326,10 +268,16 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 			status.LastSuccessfulTime = &metav1.Time{Time: lastSuccess.CompletionTime}
 			status.LastRunDuration = &metav1.Duration{Duration: lastSuccess.Duration()}
 		}
+		if streak, err := r.Store.GetFailureStreak(ctx, cronJobNN, time.Now()); err == nil {
+			status.ConsecutiveFailures = int32(streak)
+		}
 	}
 
 	// Calculate next scheduled time
 	status.NextScheduledTime = calculateNextRun(cj.Spec.Schedule, cj.Spec.TimeZone)
+	if !status.Suspended {
+		status.UpcomingRuns = calculateUpcomingRuns(cj, upcomingRunsInStatus)
+	}
 
 	// Get metrics - always fetch basic metrics, use SLA window if configured
 	windowDays := 7 // Default window
@@ -347,10 +295,25 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 		prommetrics.UpdateDuration(cj.Namespace, cj.Name, "p50", metrics.P50DurationSeconds)
 		prommetrics.UpdateDuration(cj.Namespace, cj.Name, "p95", metrics.P95DurationSeconds)
 		prommetrics.UpdateDuration(cj.Namespace, cj.Name, "p99", metrics.P99DurationSeconds)
+		prommetrics.UpdateReliability(cj.Namespace, cj.Name, monitor.Name, metrics.MTTRSeconds, metrics.MTBFSeconds)
 	} else if err != nil {
 		log.V(1).Error(err, "failed to get metrics")
 	}
 
+	// Recommend Job limits from execution history
+	recommendation, err := r.Analyzer.RecommendLimits(ctx, cronJobNN, monitor.Spec.Recommendations)
+	if err != nil {
+		log.V(1).Error(err, "failed to recommend job limits")
+	} else if recommendation != nil {
+		if monitor.Spec.Recommendations != nil && monitor.Spec.Recommendations.Apply {
+			recommendation.Applied = r.applyRecommendation(ctx, cj, recommendation)
+		}
+		status.Recommendation = recommendation
+	}
+
+	// Compare the CronJob to the state declared by its annotations
+	status.Drift = cronJobDrift(cj)
+
 	// Check for active alerts
 	// Find previous alerts for this CronJob to preserve timestamps
 	var previousAlerts []guardianv1alpha1.ActiveAlert
@@ -360,7 +323,11 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 			break
 		}
 	}
-	status.ActiveAlerts = r.checkAlerts(ctx, monitor, cj, &status, previousAlerts)
+	// CronJobs expected to stay suspended don't raise alerts
+	if !status.IntentionallySuspended {
+		status.ActiveAlerts = r.checkAlerts(ctx, monitor, cj, &status, previousAlerts)
+		r.notifyDrift(ctx, monitor, cj, status.Drift, previousAlerts)
+	}
 
 	// Update active alerts Prometheus metric by severity
 	alertsBySeverity := make(map[string]float64)
@@ -373,6 +340,9 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 
 	// Determine overall status
 	status.Status = r.determineStatus(&status)
+	if status.IntentionallySuspended {
+		status.Status = statusSuspended
+	}
 
 	log.V(1).Info("processed CronJob",
 		"status", status.Status,
@@ -384,7 +354,7 @@ func (r *CronJobMonitorReconciler) processCronJob(ctx context.Context, monitor *
 }
 
 //nolint:gocyclo // complexity is acceptable for a function that checks multiple alert conditions
-func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, cj *batchv1.CronJob, _ *guardianv1alpha1.CronJobStatus, previousAlerts []guardianv1alpha1.ActiveAlert) []guardianv1alpha1.ActiveAlert {
+func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, cj *batchv1.CronJob, status *guardianv1alpha1.CronJobStatus, previousAlerts []guardianv1alpha1.ActiveAlert) []guardianv1alpha1.ActiveAlert {
 	var alerts []guardianv1alpha1.ActiveAlert
 	cronJobNN := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
 
@@ -406,10 +376,7 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 			r.Log.V(1).Error(err, "failed to get last execution", "cronJob", cj.Name)
 		} else if lastExec != nil && !lastExec.Succeeded {
 			// Last execution failed - add a warning or critical alert
-			severity := statusWarning
-			if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-				severity = getSeverity(monitor.Spec.Alerting.SeverityOverrides.JobFailed, statusWarning)
-			}
+			severity := monitor.Spec.Alerting.SeverityFor("JobFailed", statusWarning)
 			message := "Last job execution failed"
 			if lastExec.Reason != "" {
 				message = "Last job execution failed: " + lastExec.Reason
@@ -429,7 +396,7 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 			}
 
 			r.Log.V(1).Info("last job execution failed", "cronJob", cj.Name, "severity", severity, "reason", lastExec.Reason, "exitCode", lastExec.ExitCode)
-			alerts = append(alerts, guardianv1alpha1.ActiveAlert{
+			alert := guardianv1alpha1.ActiveAlert{
 				Type:         "JobFailed",
 				Severity:     severity,
 				Message:      message,
@@ -437,7 +404,20 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 				ExitCode:     lastExec.ExitCode,
 				Reason:       lastExec.Reason,
 				SuggestedFix: lastExec.SuggestedFix, // Use stored value from execution record
-			})
+				RunbookURL:   alerting.ResolveRunbookURL(monitor.Spec.Alerting, "JobFailed", cj),
+			}
+
+			// Mark a failure that ended a long success streak, so the UI can highlight it
+			if longSuccessStreak(monitor) > 0 {
+				streak, err := r.Store.GetSuccessStreak(ctx, cronJobNN, lastExec.StartTime)
+				if err != nil {
+					r.Log.V(1).Error(err, "failed to get success streak", "cronJob", cj.Name)
+				} else if endsLongSuccessStreak(monitor, streak, lastExec.StartTime) {
+					alert.SuccessStreakRuns = int32(streak.Runs)
+					alert.SuccessStreakSince = &metav1.Time{Time: streak.Since}
+				}
+			}
+			alerts = append(alerts, alert)
 		}
 	}
 
@@ -448,10 +428,7 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 		if err != nil {
 			r.Log.V(1).Error(err, "failed to check dead-man's switch", "cronJob", cj.Name)
 		} else if result.Triggered {
-			severity := "critical"
-			if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-				severity = getSeverity(monitor.Spec.Alerting.SeverityOverrides.DeadManTriggered, "critical")
-			}
+			severity := monitor.Spec.Alerting.SeverityFor("DeadManTriggered", "critical")
 			// Preserve timestamp from existing alert
 			alertTime := metav1.Now()
 			if prev := findPreviousAlert("DeadManTriggered"); prev != nil {
@@ -460,10 +437,11 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 			}
 			r.Log.V(1).Info("dead-man's switch triggered", "cronJob", cj.Name, "severity", severity, "message", result.Message)
 			alerts = append(alerts, guardianv1alpha1.ActiveAlert{
-				Type:     "DeadManTriggered",
-				Severity: severity,
-				Message:  result.Message,
-				Since:    alertTime,
+				Type:       "DeadManTriggered",
+				Severity:   severity,
+				Message:    result.Message,
+				Since:      alertTime,
+				RunbookURL: alerting.ResolveRunbookURL(monitor.Spec.Alerting, "DeadManTriggered", cj),
 			})
 		}
 	}
@@ -477,10 +455,7 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 			r.Log.V(1).Error(err, "failed to check SLA", "cronJob", cj.Name)
 		} else if !result.Passed {
 			for _, v := range result.Violations {
-				severity := statusWarning
-				if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-					severity = getSeverity(monitor.Spec.Alerting.SeverityOverrides.SLABreached, statusWarning)
-				}
+				severity := monitor.Spec.Alerting.SeverityFor("SLABreached", statusWarning)
 				// Preserve timestamp from existing alert of same type
 				alertTime := metav1.Now()
 				if prev := findPreviousAlert(v.Type); prev != nil {
@@ -489,10 +464,11 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 				}
 				r.Log.V(1).Info("SLA violation detected", "cronJob", cj.Name, "type", v.Type, "severity", severity, "message", v.Message)
 				alerts = append(alerts, guardianv1alpha1.ActiveAlert{
-					Type:     v.Type,
-					Severity: severity,
-					Message:  v.Message,
-					Since:    alertTime,
+					Type:       v.Type,
+					Severity:   severity,
+					Message:    v.Message,
+					Since:      alertTime,
+					RunbookURL: alerting.ResolveRunbookURL(monitor.Spec.Alerting, "SLABreached", cj),
 				})
 			}
 		}
@@ -506,10 +482,7 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 		if err != nil {
 			r.Log.V(1).Error(err, "failed to check duration regression", "cronJob", cj.Name)
 		} else if result.Detected {
-			severity := "warning"
-			if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-				severity = getSeverity(monitor.Spec.Alerting.SeverityOverrides.DurationRegression, "warning")
-			}
+			severity := monitor.Spec.Alerting.SeverityFor("DurationRegression", "warning")
 			// Preserve timestamp from existing alert
 			alertTime := metav1.Now()
 			if prev := findPreviousAlert("DurationRegression"); prev != nil {
@@ -518,17 +491,76 @@ func (r *CronJobMonitorReconciler) checkAlerts(ctx context.Context, monitor *gua
 			}
 			r.Log.V(1).Info("duration regression detected", "cronJob", cj.Name, "severity", severity, "message", result.Message)
 			alerts = append(alerts, guardianv1alpha1.ActiveAlert{
-				Type:     "DurationRegression",
-				Severity: severity,
-				Message:  result.Message,
-				Since:    alertTime,
+				Type:       "DurationRegression",
+				Severity:   severity,
+				Message:    result.Message,
+				Since:      alertTime,
+				RunbookURL: alerting.ResolveRunbookURL(monitor.Spec.Alerting, "DurationRegression", cj),
 			})
 		}
+
+		// Runs taking up most of the schedule interval soon overlap or miss schedules
+		budget, err := r.Analyzer.CheckScheduleBudget(ctx, cj, monitor.Spec.SLA)
+		if err != nil {
+			r.Log.V(1).Error(err, "failed to check schedule budget", "cronJob", cj.Name)
+		} else if budget.Exceeded {
+			alertTime := metav1.Now()
+			if prev := findPreviousAlert("DurationBudget"); prev != nil {
+				alertTime = prev.Since
+			}
+			r.Log.V(1).Info("schedule budget exceeded", "cronJob", cj.Name, "p95", budget.P95, "interval", budget.Interval)
+			alerts = append(alerts, guardianv1alpha1.ActiveAlert{
+				Type:       "DurationBudget",
+				Severity:   monitor.Spec.Alerting.SeverityFor("DurationBudget", statusWarning),
+				Message:    budget.Message,
+				Since:      alertTime,
+				RunbookURL: alerting.ResolveRunbookURL(monitor.Spec.Alerting, "DurationBudget", cj),
+			})
+		}
+	}
+
+	// Check drift from the annotated expected state
+	if len(status.Drift) > 0 {
+		alertTime := metav1.Now()
+		if prev := findPreviousAlert(alertTypeSpecDrift); prev != nil {
+			alertTime = prev.Since
+		}
+		alerts = append(alerts, guardianv1alpha1.ActiveAlert{
+			Type:       alertTypeSpecDrift,
+			Severity:   monitor.Spec.Alerting.SeverityFor(alertTypeSpecDrift, statusWarning),
+			Message:    driftMessage(status.Drift),
+			Since:      alertTime,
+			RunbookURL: alerting.ResolveRunbookURL(monitor.Spec.Alerting, alertTypeSpecDrift, cj),
+		})
 	}
 
 	return alerts
 }
 
+// applyRecommendation writes recommended limits to a CronJob's job template,
+// returning whether the template now matches the recommendation
+func (r *CronJobMonitorReconciler) applyRecommendation(ctx context.Context, cj *batchv1.CronJob, rec *guardianv1alpha1.LimitRecommendation) bool {
+	spec := cj.Spec.JobTemplate.Spec
+	if spec.ActiveDeadlineSeconds != nil && *spec.ActiveDeadlineSeconds == rec.ActiveDeadlineSeconds &&
+		spec.BackoffLimit != nil && *spec.BackoffLimit == rec.BackoffLimit {
+		return true
+	}
+
+	// Patch only the two limits, so the rest of the CronJob keeps its owners
+	updated := cj.DeepCopy()
+	deadline, backoffLimit := rec.ActiveDeadlineSeconds, rec.BackoffLimit
+	updated.Spec.JobTemplate.Spec.ActiveDeadlineSeconds = &deadline
+	updated.Spec.JobTemplate.Spec.BackoffLimit = &backoffLimit
+	if err := r.Patch(ctx, updated, client.MergeFrom(cj), client.FieldOwner(guardianFieldManager)); err != nil {
+		r.Log.Error(err, "failed to apply job limit recommendation", "cronJob", cj.Name, "namespace", cj.Namespace)
+		return false
+	}
+
+	r.Log.Info("applied job limit recommendation", "cronJob", cj.Name, "namespace", cj.Namespace,
+		"activeDeadlineSeconds", rec.ActiveDeadlineSeconds, "backoffLimit", rec.BackoffLimit)
+	return true
+}
+
 // getActiveJobs returns currently running jobs for a CronJob
 func (r *CronJobMonitorReconciler) getActiveJobs(ctx context.Context, cj *batchv1.CronJob) ([]guardianv1alpha1.ActiveJob, error) {
 	// List all jobs in the namespace
@@ -740,32 +772,6 @@ func (r *CronJobMonitorReconciler) handleDeletion(ctx context.Context, monitor *
 	return ctrl.Result{}, nil
 }
 
-func (r *CronJobMonitorReconciler) setCondition(monitor *guardianv1alpha1.CronJobMonitor, condType string, status metav1.ConditionStatus, reason, message string) {
-	now := metav1.Now()
-	condition := metav1.Condition{
-		Type:               condType,
-		Status:             status,
-		LastTransitionTime: now,
-		Reason:             reason,
-		Message:            message,
-	}
-
-	// Find and update existing condition or append new one
-	found := false
-	for i, c := range monitor.Status.Conditions {
-		if c.Type == condType {
-			if c.Status != status {
-				monitor.Status.Conditions[i] = condition
-			}
-			found = true
-			break
-		}
-	}
-	if !found {
-		monitor.Status.Conditions = append(monitor.Status.Conditions, condition)
-	}
-}
-
 // findMonitorsForCronJob returns reconcile requests for monitors that match the CronJob.
 // This searches all monitors cluster-wide since monitors can watch CronJobs across namespaces.
 func (r *CronJobMonitorReconciler) findMonitorsForCronJob(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -786,6 +792,11 @@ func (r *CronJobMonitorReconciler) findMonitorsForCronJob(ctx context.Context, o
 
 	var requests []reconcile.Request
 	for _, monitor := range monitors.Items {
+		// Monitors of other shards are reconciled there
+		if !r.Shard.Owns(monitor.Namespace) {
+			continue
+		}
+
 		// Check if this monitor is watching the CronJob's namespace
 		if !r.monitorWatchesNamespace(ctx, &monitor, cj.Namespace) {
 			continue
@@ -852,11 +863,20 @@ func (r *CronJobMonitorReconciler) monitorWatchesNamespace(ctx context.Context,
 // SetupWithManager sets up the controller with the Manager.
 func (r *CronJobMonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Log.Info("setting up CronJobMonitor controller")
+	if r.Remote != nil {
+		return ctrl.NewControllerManagedBy(mgr).
+			WatchesRawSource(r.Remote.source(&guardianv1alpha1.CronJobMonitor{}, &handler.EnqueueRequestForObject{},
+				predicate.GenerationChangedPredicate{}, r.Shard.Predicate())).
+			WatchesRawSource(r.Remote.source(&batchv1.CronJob{},
+				handler.EnqueueRequestsFromMapFunc(r.findMonitorsForCronJob))).
+			Named("cronjobmonitor-" + r.Remote.Name).
+			Complete(r)
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&guardianv1alpha1.CronJobMonitor{},
 			// Only reconcile on spec changes (generation changes), not status-only updates.
 			// This prevents duplicate reconciles when we update status at the end of Reconcile().
-			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}, r.Shard.Predicate()),
 		).
 		Watches(
 			&batchv1.CronJob{},
@@ -886,13 +906,23 @@ func calculateNextRun(schedule string, timezone *string) *metav1.Time {
 	return &metav1.Time{Time: next}
 }
 
-func isEnabled(b *bool) bool {
-	return b == nil || *b
+// calculateUpcomingRuns returns the next n scheduled times of the CronJob
+func calculateUpcomingRuns(cj *batchv1.CronJob, n int) []metav1.Time {
+	job := analyzer.ScheduledCronJob{Schedule: cj.Spec.Schedule}
+	if cj.Spec.TimeZone != nil {
+		job.TimeZone = *cj.Spec.TimeZone
+	}
+	runs, err := analyzer.NextRuns(job, time.Now(), n)
+	if err != nil {
+		return nil
+	}
+	upcoming := make([]metav1.Time, 0, len(runs))
+	for _, t := range runs {
+		upcoming = append(upcoming, metav1.Time{Time: t})
+	}
+	return upcoming
 }
 
-func getSeverity(override string, defaultSeverity string) string {
-	if override != "" {
-		return override
-	}
-	return defaultSeverity
+func isEnabled(b *bool) bool {
+	return b == nil || *b
 }
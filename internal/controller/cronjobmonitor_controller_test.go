@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -11,11 +13,14 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -176,6 +181,110 @@ func TestReconcile_UpdateMonitor(t *testing.T) {
 	assert.Equal(t, int64(2), updated.Status.ObservedGeneration)
 }
 
+func TestReconcile_AppliesRecommendation(t *testing.T) {
+	scheme := newTestScheme()
+
+	monitor := newTestMonitor("test-monitor", "default")
+	controllerutil.AddFinalizer(monitor, finalizerName)
+	monitor.Spec.Recommendations = &guardianv1alpha1.RecommendationConfig{Apply: true}
+
+	cronJob := newTestCronJob("test-cronjob", "default", nil)
+
+	// CronJobs must be patched, never updated as a whole
+	var patches []string
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(monitor, cronJob).
+		WithStatusSubresource(monitor).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if _, ok := obj.(*batchv1.CronJob); ok {
+					return errors.New("unexpected CronJob update")
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if _, ok := obj.(*batchv1.CronJob); ok {
+					data, err := patch.Data(obj)
+					require.NoError(t, err)
+					patches = append(patches, string(data))
+				}
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	r := &CronJobMonitorReconciler{
+		Client: fakeClient,
+		Log:    testLogger(),
+		Scheme: scheme,
+		Store:  &testutil.MockStore{},
+		Analyzer: &testutil.MockAnalyzer{
+			Recommendation: &guardianv1alpha1.LimitRecommendation{ActiveDeadlineSeconds: 600, BackoffLimit: 2, BasedOnRuns: 20},
+		},
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-monitor", Namespace: "default"}}
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var updatedCronJob batchv1.CronJob
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "test-cronjob", Namespace: "default"}, &updatedCronJob))
+	require.NotNil(t, updatedCronJob.Spec.JobTemplate.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int64(600), *updatedCronJob.Spec.JobTemplate.Spec.ActiveDeadlineSeconds)
+	require.NotNil(t, updatedCronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	assert.Equal(t, int32(2), *updatedCronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	require.Len(t, patches, 1)
+	assert.JSONEq(t, `{"spec":{"jobTemplate":{"spec":{"activeDeadlineSeconds":600,"backoffLimit":2}}}}`, patches[0])
+
+	var updated guardianv1alpha1.CronJobMonitor
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+	require.Len(t, updated.Status.CronJobs, 1)
+	require.NotNil(t, updated.Status.CronJobs[0].Recommendation)
+	assert.True(t, updated.Status.CronJobs[0].Recommendation.Applied)
+}
+
+func TestReconcile_IntentionallySuspended(t *testing.T) {
+	scheme := newTestScheme()
+
+	monitor := newTestMonitor("test-monitor", "default")
+	controllerutil.AddFinalizer(monitor, finalizerName)
+	monitor.Spec.SuspendedHandling = &guardianv1alpha1.SuspendedHandlingConfig{
+		IntentionallySuspended: []string{"test-cronjob"},
+	}
+
+	cronJob := newTestCronJob("test-cronjob", "default", nil)
+	suspend := true
+	cronJob.Spec.Suspend = &suspend
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(monitor, cronJob).
+		WithStatusSubresource(monitor).
+		Build()
+
+	// The last run failed, which would otherwise raise a JobFailed alert
+	mockStore := &testutil.MockStore{LastExecution: &store.Execution{Succeeded: false}}
+	r := &CronJobMonitorReconciler{
+		Client:   fakeClient,
+		Log:      testLogger(),
+		Scheme:   scheme,
+		Store:    mockStore,
+		Analyzer: &testutil.MockAnalyzer{},
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-monitor", Namespace: "default"}}
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var updated guardianv1alpha1.CronJobMonitor
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+	require.Len(t, updated.Status.CronJobs, 1)
+	assert.True(t, updated.Status.CronJobs[0].IntentionallySuspended)
+	assert.Equal(t, statusSuspended, updated.Status.CronJobs[0].Status)
+	assert.Empty(t, updated.Status.CronJobs[0].ActiveAlerts)
+}
+
 func TestReconcile_DeleteMonitor(t *testing.T) {
 	scheme := newTestScheme()
 
@@ -293,13 +402,12 @@ func TestReconcile_RemovesFinalizer(t *testing.T) {
 }
 
 func TestReconcile_InvalidSpec(t *testing.T) {
-	// Note: Current implementation always returns nil from validateSpec
 	// This test verifies the flow when validation passes with invalid-looking spec
 	scheme := newTestScheme()
 
 	monitor := newTestMonitor("test-monitor", "default")
 	controllerutil.AddFinalizer(monitor, finalizerName)
-	// Spec with invalid selector - but current validateSpec returns nil
+	// Spec with a selector matching nothing - still a valid spec
 	monitor.Spec.Selector = &guardianv1alpha1.CronJobSelector{
 		MatchLabels: map[string]string{"invalid": "label"},
 	}
@@ -330,6 +438,46 @@ func TestReconcile_InvalidSpec(t *testing.T) {
 	assert.True(t, result.RequeueAfter > 0)
 }
 
+func TestReconcile_InvalidRouting(t *testing.T) {
+	scheme := newTestScheme()
+
+	monitor := newTestMonitor("test-monitor", "default")
+	controllerutil.AddFinalizer(monitor, finalizerName)
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		Routing: &guardianv1alpha1.AlertRoutingConfig{
+			Timezone: "Nowhere/Land",
+			Rules: []guardianv1alpha1.RoutingRule{
+				{Start: "09:00", End: "17:00", ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "slack"}}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(monitor).
+		WithStatusSubresource(monitor).
+		Build()
+
+	r := &CronJobMonitorReconciler{
+		Client:   fakeClient,
+		Log:      testLogger(),
+		Scheme:   scheme,
+		Analyzer: &testutil.MockAnalyzer{},
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-monitor", Namespace: "default"}}
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var updated guardianv1alpha1.CronJobMonitor
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+	ready := meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "InvalidSpec", ready.Reason)
+	assert.Contains(t, ready.Message, "Nowhere/Land")
+}
+
 func TestFindMatchingCronJobs_Labels(t *testing.T) {
 	scheme := newTestScheme()
 
@@ -806,6 +954,91 @@ func TestUpdateStatus_Conditions(t *testing.T) {
 	assert.Equal(t, "Reconciled", readyCondition.Reason)
 }
 
+func TestReconcile_HealthConditions(t *testing.T) {
+	scheme := newTestScheme()
+
+	enabled := true
+	monitor := newTestMonitor("test-monitor", "default")
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		Enabled:     &enabled,
+		ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "slack"}, {Name: "missing"}},
+	}
+	controllerutil.AddFinalizer(monitor, finalizerName)
+	channel := &guardianv1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+		Status:     guardianv1alpha1.AlertChannelStatus{Ready: true},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(monitor, channel, newTestCronJob("test-cj", "default", nil)).
+		WithStatusSubresource(monitor, channel).
+		Build()
+
+	mockStore := &testutil.MockStore{HealthError: errors.New("connection refused")}
+	r := &CronJobMonitorReconciler{
+		Client:   fakeClient,
+		Log:      testLogger(),
+		Scheme:   scheme,
+		Store:    mockStore,
+		Analyzer: &testutil.MockAnalyzer{},
+	}
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-monitor", Namespace: "default"}}
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var updated guardianv1alpha1.CronJobMonitor
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+
+	ready := meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, updated.Generation, ready.ObservedGeneration)
+
+	degraded := meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionDegraded)
+	require.NotNil(t, degraded)
+	assert.Equal(t, metav1.ConditionFalse, degraded.Status)
+
+	alerting := meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionAlertingHealthy)
+	require.NotNil(t, alerting)
+	assert.Equal(t, metav1.ConditionFalse, alerting.Status)
+	assert.Equal(t, "ChannelNotFound", alerting.Reason)
+	assert.Equal(t, "channel missing not found", alerting.Message)
+
+	storeCond := meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionStoreHealthy)
+	require.NotNil(t, storeCond)
+	assert.Equal(t, metav1.ConditionFalse, storeCond.Status)
+	assert.Equal(t, "StoreUnavailable", storeCond.Reason)
+
+	// Conditions recover on the next reconcile, keeping the Ready transition time
+	mockStore.HealthError = nil
+	monitorCopy := updated.DeepCopy()
+	monitorCopy.Spec.Alerting.ChannelRefs = []guardianv1alpha1.ChannelRef{{Name: "slack"}}
+	require.NoError(t, fakeClient.Update(context.Background(), monitorCopy))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, fakeClient.Get(context.Background(), req.NamespacedName, &updated))
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, guardianv1alpha1.MonitorConditionAlertingHealthy))
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, guardianv1alpha1.MonitorConditionStoreHealthy))
+	assert.Equal(t, ready.LastTransitionTime,
+		meta.FindStatusCondition(updated.Status.Conditions, guardianv1alpha1.MonitorConditionReady).LastTransitionTime)
+}
+
+func TestDegradedCondition(t *testing.T) {
+	cond := degradedCondition(&guardianv1alpha1.MonitorSummary{TotalCronJobs: 4, Warning: 1, Critical: 2})
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "CronJobsCritical", cond.Reason)
+	assert.Equal(t, "2 of 4 CronJobs are critical", cond.Message)
+
+	cond = degradedCondition(&guardianv1alpha1.MonitorSummary{TotalCronJobs: 4, Warning: 1})
+	assert.Equal(t, "CronJobsWarning", cond.Reason)
+
+	cond = degradedCondition(&guardianv1alpha1.MonitorSummary{TotalCronJobs: 4, Healthy: 4})
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+}
+
 func TestUpdateStatus_Summary(t *testing.T) {
 	statuses := []guardianv1alpha1.CronJobStatus{
 		{Name: "cj1", Status: statusHealthy, Suspended: false},
@@ -1025,6 +1258,19 @@ func TestCalculateNextRun_InvalidSchedule(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestCalculateUpcomingRuns(t *testing.T) {
+	tz := "Asia/Tokyo"
+	cj := &batchv1.CronJob{Spec: batchv1.CronJobSpec{Schedule: "*/10 * * * *", TimeZone: &tz}}
+	runs := calculateUpcomingRuns(cj, 3)
+	require.Len(t, runs, 3)
+	assert.True(t, runs[0].After(time.Now()))
+	assert.Equal(t, 10*time.Minute, runs[1].Sub(runs[0].Time))
+	assert.Equal(t, "Asia/Tokyo", runs[0].Location().String())
+
+	cj.Spec.Schedule = "invalid"
+	assert.Nil(t, calculateUpcomingRuns(cj, 3))
+}
+
 func TestIsEnabled(t *testing.T) {
 	assert.True(t, isEnabled(nil))
 
@@ -1035,7 +1281,123 @@ func TestIsEnabled(t *testing.T) {
 	assert.False(t, isEnabled(&disabled))
 }
 
-func TestGetSeverity(t *testing.T) {
-	assert.Equal(t, "critical", getSeverity("critical", "warning"))
-	assert.Equal(t, "warning", getSeverity("", "warning"))
+func TestSeverityFor(t *testing.T) {
+	alertingConfig := &guardianv1alpha1.AlertingConfig{
+		SeverityOverrides: guardianv1alpha1.SeverityOverrides{"jobFailed": "warning"},
+	}
+	assert.Equal(t, "warning", alertingConfig.SeverityFor("JobFailed", statusCritical))
+	assert.Equal(t, "critical", alertingConfig.SeverityFor("DeadManTriggered", statusCritical))
+}
+
+func TestCronJobDrift(t *testing.T) {
+	managedFields := []metav1.ManagedFieldsEntry{
+		{
+			Manager:  "argocd-controller",
+			Time:     ptr.To(metav1.NewTime(time.Now().Add(-time.Hour))),
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:suspend":{},"f:jobTemplate":{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"main\"}":{"f:image":{}}}}}}}}}`)},
+		},
+		{
+			Manager:  "kubectl-patch",
+			Time:     ptr.To(metav1.Now()),
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:suspend":{}}}`)},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		suspend     bool
+		managers    []metav1.ManagedFieldsEntry
+		want        []guardianv1alpha1.DriftStatus
+	}{
+		{name: "no annotations", suspend: true},
+		{
+			name:        "matches",
+			annotations: map[string]string{annotationExpectedImage: "busybox:1.36", annotationExpectedSuspend: "false"},
+		},
+		{
+			name:        "suspended manually",
+			annotations: map[string]string{annotationExpectedSuspend: "false"},
+			suspend:     true,
+			want:        []guardianv1alpha1.DriftStatus{{Field: "spec.suspend", Expected: "false", Actual: "true", Manager: "kubectl-patch"}},
+		},
+		{
+			name:        "image tag changed",
+			annotations: map[string]string{annotationExpectedImage: "busybox:1.37"},
+			want:        []guardianv1alpha1.DriftStatus{{Field: "containers[main].image", Expected: "busybox:1.37", Actual: "busybox:1.36", Manager: "argocd-controller"}},
+		},
+		{
+			name:        "written by guardian",
+			annotations: map[string]string{annotationExpectedSuspend: "false"},
+			suspend:     true,
+			managers: []metav1.ManagedFieldsEntry{{
+				Manager:  guardianFieldManager,
+				Time:     ptr.To(metav1.NewTime(time.Now().Add(time.Minute))),
+				FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:suspend":{}}}`)},
+			}},
+		},
+		{
+			name:        "image of named container",
+			annotations: map[string]string{annotationExpectedImage: "main=registry.example.com/busybox:1.36, sidecar=proxy:2"},
+			want: []guardianv1alpha1.DriftStatus{
+				{Field: "containers[main].image", Expected: "registry.example.com/busybox:1.36", Actual: "busybox:1.36", Manager: "argocd-controller"},
+				{Field: "containers[sidecar].image", Expected: "proxy:2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cj := newTestCronJob("test-cj", "default", nil)
+			cj.Annotations = tt.annotations
+			cj.ManagedFields = append(slices.Clone(managedFields), tt.managers...)
+			cj.Spec.Suspend = ptr.To(tt.suspend)
+			cj.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image = "busybox:1.36"
+			assert.Equal(t, tt.want, cronJobDrift(cj))
+		})
+	}
+}
+
+func TestImageRepository(t *testing.T) {
+	assert.Equal(t, "busybox", imageRepository("busybox:1.36"))
+	assert.Equal(t, "registry.example.com:5000/team/app", imageRepository("registry.example.com:5000/team/app"))
+	assert.Equal(t, "registry.example.com:5000/team/app", imageRepository("registry.example.com:5000/team/app:v2@sha256:abc"))
+}
+
+func TestProcessCronJob_Drift(t *testing.T) {
+	scheme := newTestScheme()
+	monitor := newTestMonitor("test-monitor", "default")
+	cronJob := newTestCronJob("test-cj", "default", nil)
+	cronJob.Annotations = map[string]string{annotationExpectedSuspend: "false"}
+	cronJob.Spec.Suspend = ptr.To(true)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(monitor, cronJob).Build()
+	mockDispatcher := testutil.NewMockDispatcher()
+	r := &CronJobMonitorReconciler{
+		Client:          fakeClient,
+		Log:             testLogger(),
+		Scheme:          scheme,
+		Analyzer:        &testutil.MockAnalyzer{},
+		AlertDispatcher: mockDispatcher,
+	}
+
+	status := r.processCronJob(context.Background(), monitor, cronJob)
+	require.Len(t, status.Drift, 1)
+	require.Len(t, status.ActiveAlerts, 1)
+	assert.Equal(t, alertTypeSpecDrift, status.ActiveAlerts[0].Type)
+	assert.Contains(t, status.ActiveAlerts[0].Message, "spec.suspend is true, expected false")
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "default/test-cj/SpecDrift", mockDispatcher.DispatchedAlerts[0].Key)
+
+	// Still drifted: the alert is not sent again
+	monitor.Status.CronJobs = []guardianv1alpha1.CronJobStatus{status}
+	r.processCronJob(context.Background(), monitor, cronJob)
+	assert.Len(t, mockDispatcher.DispatchedAlerts, 1)
+
+	// Resumed: the alert is cleared
+	cronJob.Spec.Suspend = ptr.To(false)
+	status = r.processCronJob(context.Background(), monitor, cronJob)
+	assert.Empty(t, status.Drift)
+	assert.Empty(t, status.ActiveAlerts)
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/test-cj/SpecDrift")
 }
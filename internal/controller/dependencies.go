@@ -0,0 +1,164 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const alertTypeChainBroken = "ChainBroken"
+
+// dependencyRef resolves a CronJob reference, defaulting to the monitor's namespace
+func dependencyRef(monitor *guardianv1alpha1.CronJobMonitor, ref guardianv1alpha1.CronJobReference) types.NamespacedName {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = monitor.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
+}
+
+// upstreamCronJobs returns the CronJobs the given CronJob depends on
+func upstreamCronJobs(monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName) []types.NamespacedName {
+	var upstream []types.NamespacedName
+	for _, dep := range monitor.Spec.Dependencies {
+		if dependencyRef(monitor, dep.CronJob) != cronJob {
+			continue
+		}
+		for _, ref := range dep.DependsOn {
+			upstream = append(upstream, dependencyRef(monitor, ref))
+		}
+	}
+	return upstream
+}
+
+// downstreamCronJobs returns the CronJobs that depend on the given CronJob
+func downstreamCronJobs(monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName) []types.NamespacedName {
+	var downstream []types.NamespacedName
+	for _, dep := range monitor.Spec.Dependencies {
+		for _, ref := range dep.DependsOn {
+			if dependencyRef(monitor, ref) == cronJob {
+				downstream = append(downstream, dependencyRef(monitor, dep.CronJob))
+				break
+			}
+		}
+	}
+	return downstream
+}
+
+// failedUpstreams returns the upstream CronJobs whose last execution failed
+func (h *JobReconciler) failedUpstreams(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName) []string {
+	if h.Store == nil {
+		return nil
+	}
+	var failed []string
+	for _, upstream := range upstreamCronJobs(monitor, cronJob) {
+		last, err := h.Store.GetLastExecution(ctx, upstream)
+		if err == nil && last != nil && !last.Succeeded {
+			failed = append(failed, upstream.String())
+		}
+	}
+	return failed
+}
+
+// downgradeSeverity lowers a severity by one level
+func downgradeSeverity(severity string) string {
+	switch severity {
+	case statusCritical:
+		return statusWarning
+	case statusWarning:
+		return "info"
+	default:
+		return severity
+	}
+}
+
+// annotateDownstreamFailure marks a failure alert as caused by failed
+// upstream CronJobs and lowers its severity, so on-call starts at the top
+// of the chain
+func annotateDownstreamFailure(alert *alerting.Alert, failedUpstreams []string) {
+	alert.Context.UpstreamFailures = failedUpstreams
+	alert.Severity = downgradeSeverity(alert.Severity)
+	alert.Message += fmt.Sprintf("\n\nDownstream failure: upstream CronJob %s failed before this run.",
+		strings.Join(failedUpstreams, ", "))
+}
+
+// handleChainBroken sends a ChainBroken alert for each CronJob depending on
+// the failed CronJob that will run before the failed CronJob runs again,
+// and so against stale data
+func (h *JobReconciler) handleChainBroken(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, upstream types.NamespacedName, exec store.Execution) {
+	downstream := downstreamCronJobs(monitor, upstream)
+	if h.AlertDispatcher == nil || len(downstream) == 0 {
+		return
+	}
+
+	upstreamCronJob := &batchv1.CronJob{}
+	if err := h.Get(ctx, upstream, upstreamCronJob); err != nil {
+		log.V(1).Error(err, "failed to get upstream CronJob", "cronJob", upstream)
+		return
+	}
+	upstreamNext := calculateNextRun(upstreamCronJob.Spec.Schedule, upstreamCronJob.Spec.TimeZone)
+
+	severity := monitor.Spec.Alerting.SeverityFor("ChainBroken", statusWarning)
+
+	for _, nn := range downstream {
+		cronJob := &batchv1.CronJob{}
+		if err := h.Get(ctx, nn, cronJob); err != nil {
+			log.V(1).Error(err, "failed to get downstream CronJob", "cronJob", nn)
+			continue
+		}
+		if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+			continue
+		}
+		next := calculateNextRun(cronJob.Spec.Schedule, cronJob.Spec.TimeZone)
+		if next == nil || (upstreamNext != nil && !next.Before(upstreamNext)) {
+			// The upstream runs again first and may recover
+			continue
+		}
+
+		alert := alerting.Alert{
+			Key:      fmt.Sprintf("%s/%s/%s", nn.Namespace, nn.Name, alertTypeChainBroken),
+			Type:     alertTypeChainBroken,
+			Severity: severity,
+			Title:    fmt.Sprintf("Job chain broken: %s/%s", nn.Namespace, nn.Name),
+			Message: fmt.Sprintf("Upstream CronJob %s failed. %s/%s runs next at %s, before %s runs again, and will use stale data.",
+				upstream, nn.Namespace, nn.Name, next.UTC().Format(time.RFC3339), upstream),
+			CronJob: nn,
+			Context: alerting.AlertContext{
+				ExitCode:         exec.ExitCode,
+				Reason:           exec.Reason,
+				UpstreamFailures: []string{upstream.String()},
+			},
+			MonitorRef: types.NamespacedName{
+				Namespace: monitor.Namespace,
+				Name:      monitor.Name,
+			},
+			Timestamp: time.Now(),
+		}
+		if err := h.AlertDispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+			log.Error(err, "failed to dispatch chain broken alert", "cronJob", nn)
+		}
+	}
+}
+
+// clearChainBroken clears the ChainBroken alerts of the CronJobs depending on
+// a CronJob that succeeded
+func (h *JobReconciler) clearChainBroken(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, upstream types.NamespacedName) {
+	for _, nn := range downstreamCronJobs(monitor, upstream) {
+		alertKey := fmt.Sprintf("%s/%s/%s", nn.Namespace, nn.Name, alertTypeChainBroken)
+		if err := h.AlertDispatcher.ClearAlert(ctx, alertKey); err == nil {
+			log.V(1).Info("cleared alert on upstream success", "alertKey", alertKey)
+		}
+		if h.Store != nil {
+			_ = h.Store.ResolveAlert(ctx, alertTypeChainBroken, nn.Namespace, nn.Name)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+const (
+	alertTypeSpecDrift = "SpecDrift"
+
+	// annotationExpectedImage declares the images a CronJob's containers run,
+	// as comma-separated "image:tag" or "container=image:tag" entries
+	annotationExpectedImage = "guardian.illenium.net/expected-image"
+
+	// annotationExpectedSuspend declares whether a CronJob is suspended, "true" or "false"
+	annotationExpectedSuspend = "guardian.illenium.net/expected-suspend"
+
+	// guardianFieldManager is the field manager CronJob Guardian writes
+	// CronJobs as, e.g. when applying limit recommendations
+	guardianFieldManager = "cronjob-guardian"
+)
+
+// cronJobDrift returns where a CronJob diverges from the state its
+// expected-image and expected-suspend annotations declare. These are set
+// from Git, so drift means the CronJob was changed outside GitOps. Fields
+// CronJob Guardian last wrote itself are not drift.
+func cronJobDrift(cj *batchv1.CronJob) []guardianv1alpha1.DriftStatus {
+	var drift []guardianv1alpha1.DriftStatus
+	add := func(d guardianv1alpha1.DriftStatus) {
+		if d.Manager != guardianFieldManager {
+			drift = append(drift, d)
+		}
+	}
+
+	if value, ok := cj.Annotations[annotationExpectedSuspend]; ok {
+		if expected, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+			actual := cj.Spec.Suspend != nil && *cj.Spec.Suspend
+			if actual != expected {
+				add(guardianv1alpha1.DriftStatus{
+					Field:    "spec.suspend",
+					Expected: strconv.FormatBool(expected),
+					Actual:   strconv.FormatBool(actual),
+					Manager:  fieldManager(cj, "f:spec", "f:suspend"),
+				})
+			}
+		}
+	}
+
+	podSpec := cj.Spec.JobTemplate.Spec.Template.Spec
+	initContainers := len(podSpec.InitContainers)
+	containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for entry := range strings.SplitSeq(cj.Annotations[annotationExpectedImage], ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, expected, named := strings.Cut(entry, "=")
+		if !named {
+			expected = entry
+		}
+
+		matched := false
+		for i, c := range containers {
+			if (named && c.Name != name) || (!named && imageRepository(c.Image) != imageRepository(expected)) {
+				continue
+			}
+			matched = true
+			if c.Image != expected {
+				add(guardianv1alpha1.DriftStatus{
+					Field:    fmt.Sprintf("containers[%s].image", c.Name),
+					Expected: expected,
+					Actual:   c.Image,
+					Manager:  fieldManager(cj, containerImagePath(c, i < initContainers)...),
+				})
+			}
+		}
+		if !matched {
+			field := "image"
+			if named {
+				field = fmt.Sprintf("containers[%s].image", name)
+			}
+			add(guardianv1alpha1.DriftStatus{Field: field, Expected: expected})
+		}
+	}
+	return drift
+}
+
+// imageRepository returns an image reference without its tag and digest
+func imageRepository(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// fieldManager returns the manager that most recently wrote a CronJob field,
+// given by its path in the managed fields, or "" if they do not tell
+func fieldManager(cj *batchv1.CronJob, path ...string) string {
+	var manager string
+	var latest time.Time
+	for _, entry := range cj.ManagedFields {
+		if entry.FieldsV1 == nil || !ownsField(entry.FieldsV1.Raw, path) {
+			continue
+		}
+		var t time.Time
+		if entry.Time != nil {
+			t = entry.Time.Time
+		}
+		if manager == "" || t.After(latest) {
+			manager, latest = entry.Manager, t
+		}
+	}
+	return manager
+}
+
+// ownsField returns true if a managed fields entry contains the field path
+func ownsField(raw []byte, path []string) bool {
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return false
+	}
+	for _, key := range path {
+		next, ok := fields[key].(map[string]any)
+		if !ok {
+			return false
+		}
+		fields = next
+	}
+	return true
+}
+
+// containerImagePath is the managed fields path of a container's image
+func containerImagePath(c corev1.Container, init bool) []string {
+	list := "f:containers"
+	if init {
+		list = "f:initContainers"
+	}
+	return []string{"f:spec", "f:jobTemplate", "f:spec", "f:template", "f:spec", list, fmt.Sprintf(`k:{"name":%q}`, c.Name), "f:image"}
+}
+
+// driftMessage describes a CronJob's drift for its SpecDrift alert
+func driftMessage(drift []guardianv1alpha1.DriftStatus) string {
+	parts := make([]string, 0, len(drift))
+	for _, d := range drift {
+		var part string
+		if d.Actual == "" {
+			part = fmt.Sprintf("no container runs %s", d.Expected)
+		} else {
+			part = fmt.Sprintf("%s is %s, expected %s", d.Field, d.Actual, d.Expected)
+		}
+		if d.Manager != "" {
+			part += fmt.Sprintf(" (changed by %s)", d.Manager)
+		}
+		parts = append(parts, part)
+	}
+	return "CronJob was changed outside GitOps: " + strings.Join(parts, "; ")
+}
+
+// notifyDrift sends a SpecDrift alert when a CronJob starts to drift and
+// clears it once the CronJob matches its annotations again
+func (r *CronJobMonitorReconciler) notifyDrift(ctx context.Context, monitor *guardianv1alpha1.CronJobMonitor, cj *batchv1.CronJob, drift []guardianv1alpha1.DriftStatus, previousAlerts []guardianv1alpha1.ActiveAlert) {
+	if r.AlertDispatcher == nil {
+		return
+	}
+	alerted := false
+	for _, a := range previousAlerts {
+		if a.Type == alertTypeSpecDrift {
+			alerted = true
+		}
+	}
+	cronJobNN := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
+	alertKey := fmt.Sprintf("%s/%s/%s", cj.Namespace, cj.Name, alertTypeSpecDrift)
+
+	if len(drift) == 0 {
+		if alerted {
+			_ = r.AlertDispatcher.ClearAlert(ctx, alertKey)
+			if r.Store != nil {
+				_ = r.Store.ResolveAlert(ctx, alertTypeSpecDrift, cj.Namespace, cj.Name)
+			}
+		}
+		return
+	}
+	if alerted {
+		return
+	}
+
+	alert := alerting.Alert{
+		Key:      alertKey,
+		Type:     alertTypeSpecDrift,
+		Severity: monitor.Spec.Alerting.SeverityFor(alertTypeSpecDrift, statusWarning),
+		Title:    fmt.Sprintf("CronJob drifted from Git: %s/%s", cj.Namespace, cj.Name),
+		Message:  driftMessage(drift),
+		CronJob:  cronJobNN,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Timestamp: time.Now(),
+	}
+	if monitor.Spec.Alerting != nil {
+		alert.Context.PropagateMetadata(monitor.Spec.Alerting.IncludeContext, cj)
+	}
+	if err := r.AlertDispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+		r.Log.Error(err, "failed to dispatch spec drift alert", "cronJob", cj.Name)
+	}
+}
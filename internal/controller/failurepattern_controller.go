@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+// FailurePatternReconciler reconciles a FailurePattern object.
+// It only validates patterns; the JobReconciler reads them when a job fails.
+type FailurePatternReconciler struct {
+	client.Client
+	Log    logr.Logger // Required - must be injected
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=failurepatterns,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=failurepatterns/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=failurepatterns/finalizers,verbs=update
+
+// Reconcile validates a FailurePattern and records the result in its status
+func (r *FailurePatternReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("pattern", req.NamespacedName)
+	log.V(1).Info("reconciling FailurePattern")
+
+	pattern := &guardianv1alpha1.FailurePattern{}
+	if err := r.Get(ctx, req.NamespacedName, pattern); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.V(1).Info("pattern not found, likely deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "failed to get pattern")
+		return ctrl.Result{}, err
+	}
+
+	if err := alerting.ValidatePattern(failurePatternToSuggestedFix(pattern)); err != nil {
+		log.Info("pattern is invalid", "error", err.Error())
+		pattern.Status.Ready = false
+		r.setReadyCondition(pattern, metav1.ConditionFalse, "ValidationFailed", err.Error())
+	} else {
+		pattern.Status.Ready = true
+		r.setReadyCondition(pattern, metav1.ConditionTrue, "Valid", "Pattern is valid and in use")
+	}
+	pattern.Status.ObservedGeneration = pattern.Generation
+
+	if err := r.Status().Update(ctx, pattern); err != nil {
+		log.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *FailurePatternReconciler) setReadyCondition(pattern *guardianv1alpha1.FailurePattern, status metav1.ConditionStatus, reason, message string) {
+	const condType = "Ready"
+	condition := metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		ObservedGeneration: pattern.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+
+	for i, c := range pattern.Status.Conditions {
+		if c.Type == condType {
+			if c.Status == status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			pattern.Status.Conditions[i] = condition
+			return
+		}
+	}
+	pattern.Status.Conditions = append(pattern.Status.Conditions, condition)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FailurePatternReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("setting up FailurePattern controller")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&guardianv1alpha1.FailurePattern{}).
+		Named("failurepattern").
+		Complete(r)
+}
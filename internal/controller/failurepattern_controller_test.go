@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func newFailurePatternTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = guardianv1alpha1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&guardianv1alpha1.FailurePattern{}).
+		Build()
+}
+
+func reconcileFailurePattern(t *testing.T, pattern *guardianv1alpha1.FailurePattern) *guardianv1alpha1.FailurePattern {
+	t.Helper()
+	fakeClient := newFailurePatternTestClient(pattern)
+	reconciler := &FailurePatternReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	nn := k8stypes.NamespacedName{Name: pattern.Name, Namespace: pattern.Namespace}
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: nn})
+	require.NoError(t, err)
+
+	updated := &guardianv1alpha1.FailurePattern{}
+	require.NoError(t, fakeClient.Get(context.Background(), nn, updated))
+	return updated
+}
+
+func TestFailurePatternReconcile_Valid(t *testing.T) {
+	updated := reconcileFailurePattern(t, &guardianv1alpha1.FailurePattern{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-down", Namespace: "default", Generation: 3},
+		Spec: guardianv1alpha1.FailurePatternSpec{
+			Match:      guardianv1alpha1.PatternMatch{LogPattern: "connection refused"},
+			Suggestion: "Check the database for {{.Name}}",
+		},
+	})
+
+	assert.True(t, updated.Status.Ready)
+	assert.Equal(t, int64(3), updated.Status.ObservedGeneration)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, conditionTypeReady, updated.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, updated.Status.Conditions[0].Status)
+}
+
+func TestFailurePatternReconcile_Invalid(t *testing.T) {
+	updated := reconcileFailurePattern(t, &guardianv1alpha1.FailurePattern{
+		ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: "default"},
+		Spec: guardianv1alpha1.FailurePatternSpec{
+			Match:      guardianv1alpha1.PatternMatch{LogPattern: "[invalid(regex"},
+			Suggestion: "Never shown",
+		},
+	})
+
+	assert.False(t, updated.Status.Ready)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, updated.Status.Conditions[0].Status)
+	assert.Equal(t, "ValidationFailed", updated.Status.Conditions[0].Reason)
+	assert.Contains(t, updated.Status.Conditions[0].Message, "logPattern")
+}
+
+func TestFailurePatternReconcile_NotFound(t *testing.T) {
+	fakeClient := newFailurePatternTestClient()
+	reconciler := &FailurePatternReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: k8stypes.NamespacedName{Name: "missing", Namespace: "default"},
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// failureStreakConfig returns the consecutive failures that send a monitor's
+// JobFailed alert and that raise it to critical (0 = never), and false if the
+// monitor alerts on the first failure
+func failureStreakConfig(monitor *guardianv1alpha1.CronJobMonitor) (int, int, bool) {
+	if monitor.Spec.Alerting == nil || monitor.Spec.Alerting.FailureStreak == nil {
+		return 0, 0, false
+	}
+	streak := monitor.Spec.Alerting.FailureStreak
+	return int(ptr.Deref(streak.AlertAfter, 1)), int(ptr.Deref(streak.CriticalAfter, 0)), true
+}
+
+// failureStreak returns the number of consecutive failed runs ending with this
+// one, or 0 if it is unknown or not needed by any monitor. It must be read
+// before this run is recorded, since recording may be batched.
+func (h *JobReconciler) failureStreak(ctx context.Context, monitors []*guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution) int {
+	if exec.Succeeded || h.Store == nil {
+		return 0
+	}
+	needed := false
+	for _, monitor := range monitors {
+		if _, _, ok := failureStreakConfig(monitor); ok {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return 0
+	}
+
+	streak, err := h.Store.GetFailureStreak(ctx, cronJob, exec.StartTime)
+	if err != nil {
+		h.Log.V(1).Error(err, "failed to get failure streak", "cronJob", cronJob)
+		return 0
+	}
+	return streak + 1
+}
+
+// belowFailureStreak reports whether the CronJob's failure streak is too short
+// for the monitor to send a JobFailed alert
+func belowFailureStreak(monitor *guardianv1alpha1.CronJobMonitor, streak int) bool {
+	alertAfter, _, ok := failureStreakConfig(monitor)
+	return ok && streak > 0 && streak < alertAfter
+}
+
+// applyFailureStreak raises a JobFailed alert to critical once the failure
+// streak reaches the monitor's criticalAfter, and notes the streak in its message
+func applyFailureStreak(monitor *guardianv1alpha1.CronJobMonitor, streak int, severity, message *string) {
+	_, criticalAfter, ok := failureStreakConfig(monitor)
+	if !ok || streak == 0 {
+		return
+	}
+	if criticalAfter > 0 && streak >= criticalAfter {
+		*severity = statusCritical
+	}
+	if streak > 1 {
+		*message += fmt.Sprintf(" (%d consecutive failures)", streak)
+	}
+}
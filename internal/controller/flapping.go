@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	alertTypeFlapping = "Flapping"
+
+	// defaultFlapThreshold is the number of state changes within the window that make a CronJob flapping
+	defaultFlapThreshold = 4
+
+	// defaultFlapWindow is the window state changes are counted in
+	defaultFlapWindow = time.Hour
+)
+
+// flapRun is the outcome of one run, as far as flap detection cares
+type flapRun struct {
+	start     time.Time
+	succeeded bool
+}
+
+// flapConfig returns a monitor's flap detection threshold and window, and
+// false if the monitor does not detect flapping
+func flapConfig(monitor *guardianv1alpha1.CronJobMonitor) (int, time.Duration, bool) {
+	if monitor.Spec.Alerting == nil || monitor.Spec.Alerting.FlapDetection == nil {
+		return 0, 0, false
+	}
+	flap := monitor.Spec.Alerting.FlapDetection
+	if !isEnabled(flap.Enabled) {
+		return 0, 0, false
+	}
+	window := defaultFlapWindow
+	if flap.Window != nil && flap.Window.Duration > 0 {
+		window = flap.Window.Duration
+	}
+	return int(ptr.Deref(flap.Threshold, defaultFlapThreshold)), window, true
+}
+
+// flapHistory returns the CronJob's runs within the largest flap detection
+// window of its monitors, oldest first and ending with this run. It must be
+// read before this run is recorded, since recording may be batched.
+func (h *JobReconciler) flapHistory(ctx context.Context, monitors []*guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution) []flapRun {
+	if h.Store == nil {
+		return nil
+	}
+	var window time.Duration
+	for _, monitor := range monitors {
+		if _, w, ok := flapConfig(monitor); ok {
+			window = max(window, w)
+		}
+	}
+	if window == 0 {
+		return nil
+	}
+
+	execs, err := h.Store.GetExecutions(ctx, cronJob, exec.StartTime.Add(-window))
+	if err != nil {
+		h.Log.V(1).Error(err, "failed to get executions", "cronJob", cronJob)
+		return nil
+	}
+
+	runs := make([]flapRun, 0, len(execs)+1)
+	for _, e := range execs {
+		if e.JobName == exec.JobName {
+			continue
+		}
+		runs = append(runs, flapRun{start: e.StartTime, succeeded: e.Succeeded})
+	}
+	slices.SortFunc(runs, func(a, b flapRun) int { return a.start.Compare(b.start) })
+	return append(runs, flapRun{start: exec.StartTime, succeeded: exec.Succeeded})
+}
+
+// stateChanges counts the runs started after since whose outcome differs from
+// the run before them
+func stateChanges(runs []flapRun, since time.Time) int {
+	changes := 0
+	for i := 1; i < len(runs); i++ {
+		if runs[i].start.After(since) && runs[i].succeeded != runs[i-1].succeeded {
+			changes++
+		}
+	}
+	return changes
+}
+
+// checkFlapping sends a Flapping alert while the CronJob's runs keep changing
+// between success and failure, and clears it once they are stable. It returns
+// true while the CronJob is flapping, so that the run's JobFailed alert or its
+// resolution is held.
+func (h *JobReconciler) checkFlapping(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution, runs []flapRun) bool {
+	threshold, window, ok := flapConfig(monitor)
+	if !ok || h.AlertDispatcher == nil || len(runs) == 0 {
+		return false
+	}
+
+	changes := stateChanges(runs, exec.StartTime.Add(-window))
+	alertKey := fmt.Sprintf("%s/%s/%s", cronJob.Namespace, cronJob.Name, alertTypeFlapping)
+
+	if changes < threshold {
+		if err := h.AlertDispatcher.ClearAlert(ctx, alertKey); err == nil {
+			log.V(1).Info("cleared alert on stable runs", "alertKey", alertKey)
+		}
+		if h.Store != nil {
+			_ = h.Store.ResolveAlert(ctx, alertTypeFlapping, cronJob.Namespace, cronJob.Name)
+		}
+		return false
+	}
+
+	// A delayed JobFailed alert would otherwise still be sent
+	h.AlertDispatcher.CancelPendingAlert(fmt.Sprintf("%s/%s/JobFailed", cronJob.Namespace, cronJob.Name))
+
+	alert := alerting.Alert{
+		Key:      alertKey,
+		Type:     alertTypeFlapping,
+		Severity: monitor.Spec.Alerting.SeverityFor(alertTypeFlapping, statusWarning),
+		Title:    fmt.Sprintf("CronJob %s/%s is flapping", cronJob.Namespace, cronJob.Name),
+		Message: fmt.Sprintf("Runs changed between success and failure %d times within %s. Failure alerts and their resolution are held until the runs are stable.",
+			changes, window),
+		CronJob: cronJob,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Timestamp: time.Now(),
+	}
+	log.Info("cronjob is flapping", "job", exec.JobName, "stateChanges", changes, "window", window)
+	if err := h.AlertDispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+		log.Error(err, "failed to dispatch flapping alert")
+	}
+	return true
+}
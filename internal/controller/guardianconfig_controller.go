@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/scheduler"
+)
+
+// GuardianConfigReconciler reconciles the GuardianConfig object.
+// It applies its settings on top of the ones from flags and the config
+// file, and restores those when the GuardianConfig is deleted.
+type GuardianConfigReconciler struct {
+	client.Client
+	Log    logr.Logger // Required - must be injected
+	Scheme *runtime.Scheme
+
+	// Defaults are the settings in effect without a GuardianConfig. Change
+	// them with SetDefaults once the manager runs.
+	Defaults config.RuntimeConfig
+
+	AlertDispatcher alerting.Dispatcher
+
+	// HistoryPruner gets the retention settings (optional; nil when another
+	// shard prunes)
+	HistoryPruner *scheduler.HistoryPruner
+
+	// Ignored gets the ignored namespaces
+	Ignored *IgnoredNamespaces
+
+	mu   sync.Mutex
+	spec *guardianv1alpha1.GuardianConfigSpec // last applied spec, nil without a GuardianConfig
+}
+
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=guardianconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=guardianconfigs/status,verbs=get;update;patch
+
+// Reconcile applies the GuardianConfig's settings and records the result in its status
+func (r *GuardianConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("guardianConfig", req.Name)
+	log.V(1).Info("reconciling GuardianConfig")
+
+	if req.Name != guardianv1alpha1.GuardianConfigName {
+		log.Info("ignoring GuardianConfig, only the one named " + guardianv1alpha1.GuardianConfigName + " is read")
+		return ctrl.Result{}, nil
+	}
+
+	gc := &guardianv1alpha1.GuardianConfig{}
+	if err := r.Get(ctx, req.NamespacedName, gc); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.Info("GuardianConfig deleted, restoring configured settings")
+			r.mu.Lock()
+			r.spec = nil
+			err := r.apply(r.Defaults)
+			r.mu.Unlock()
+			if err != nil {
+				log.Error(err, "failed to restore configured settings")
+			}
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "failed to get GuardianConfig")
+		return ctrl.Result{}, err
+	}
+
+	previous := gc.Status.DeepCopy()
+	r.mu.Lock()
+	settings := mergeGuardianConfig(r.Defaults, &gc.Spec)
+	err := r.apply(settings)
+	if err == nil {
+		r.spec = gc.Spec.DeepCopy()
+	}
+	r.mu.Unlock()
+	if err != nil {
+		log.Info("GuardianConfig is invalid", "error", err.Error())
+		gc.Status.Ready = false
+		r.setReadyCondition(gc, metav1.ConditionFalse, "ValidationFailed", err.Error())
+	} else {
+		log.Info("applied GuardianConfig",
+			"maxAlertsPerMinute", settings.RateLimits.MaxAlertsPerMinute,
+			"burstLimit", settings.RateLimits.BurstLimit,
+			"retentionDays", settings.RetentionDays,
+			"logRetentionDays", settings.LogRetentionDays,
+			"startupGracePeriod", settings.StartupGracePeriod,
+			"ignoredNamespaces", settings.IgnoredNamespaces)
+		gc.Status.Ready = true
+		r.setReadyCondition(gc, metav1.ConditionTrue, "Applied", "Settings are applied")
+	}
+	gc.Status.ObservedGeneration = gc.Generation
+
+	// Every shard applies the settings; only the first one to see a new
+	// generation needs to write the status
+	if equality.Semantic.DeepEqual(previous, &gc.Status) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Status().Update(ctx, gc); err != nil {
+		log.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetDefaults replaces the settings in effect without a GuardianConfig, e.g.
+// after the config file changed, and applies them. Fields set in the current
+// GuardianConfig keep overriding them.
+func (r *GuardianConfigReconciler) SetDefaults(defaults config.RuntimeConfig) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Defaults = defaults
+	settings := defaults
+	if r.spec != nil {
+		settings = mergeGuardianConfig(defaults, r.spec)
+	}
+	return r.apply(settings)
+}
+
+// apply changes the running components to settings. The ignored namespaces
+// are validated first, so an invalid GuardianConfig changes nothing.
+func (r *GuardianConfigReconciler) apply(settings config.RuntimeConfig) error {
+	if r.Ignored != nil {
+		if err := r.Ignored.Set(settings.IgnoredNamespaces); err != nil {
+			return err
+		}
+	}
+	if r.AlertDispatcher != nil {
+		r.AlertDispatcher.SetGlobalRateLimits(settings.RateLimits)
+		r.AlertDispatcher.SetStartupGracePeriod(settings.StartupGracePeriod)
+	}
+	if r.HistoryPruner != nil {
+		r.HistoryPruner.SetRetentionDays(settings.RetentionDays)
+		r.HistoryPruner.SetLogRetentionDays(settings.LogRetentionDays)
+		r.HistoryPruner.SetInterval(settings.PruneInterval)
+	}
+	return nil
+}
+
+// mergeGuardianConfig returns defaults with the fields set in spec replaced
+func mergeGuardianConfig(defaults config.RuntimeConfig, spec *guardianv1alpha1.GuardianConfigSpec) config.RuntimeConfig {
+	settings := defaults
+	if rl := spec.RateLimits; rl != nil {
+		if rl.MaxAlertsPerMinute != nil {
+			settings.RateLimits.MaxAlertsPerMinute = int(*rl.MaxAlertsPerMinute)
+		}
+		if rl.BurstLimit != nil {
+			settings.RateLimits.BurstLimit = int(*rl.BurstLimit)
+		}
+		if rl.DefaultSuppressDuplicatesFor != nil {
+			settings.RateLimits.DefaultSuppressDuplicatesFor = rl.DefaultSuppressDuplicatesFor.Duration
+		}
+	}
+	if ret := spec.Retention; ret != nil {
+		if ret.HistoryDays != nil {
+			settings.RetentionDays = int(*ret.HistoryDays)
+		}
+		if ret.LogDays != nil {
+			settings.LogRetentionDays = int(*ret.LogDays)
+		}
+	}
+	if spec.StartupGracePeriod != nil {
+		settings.StartupGracePeriod = spec.StartupGracePeriod.Duration
+	}
+	if spec.IgnoredNamespaces != nil {
+		settings.IgnoredNamespaces = slices.Clone(spec.IgnoredNamespaces)
+	}
+	return settings
+}
+
+func (r *GuardianConfigReconciler) setReadyCondition(gc *guardianv1alpha1.GuardianConfig, status metav1.ConditionStatus, reason, message string) {
+	const condType = "Ready"
+	condition := metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		ObservedGeneration: gc.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+
+	for i, c := range gc.Status.Conditions {
+		if c.Type == condType {
+			if c.Status == status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			gc.Status.Conditions[i] = condition
+			return
+		}
+	}
+	gc.Status.Conditions = append(gc.Status.Conditions, condition)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GuardianConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("setting up GuardianConfig controller")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&guardianv1alpha1.GuardianConfig{}).
+		Named("guardianconfig").
+		Complete(r)
+}
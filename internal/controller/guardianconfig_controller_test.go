@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func newGuardianConfigTestReconciler(objs ...client.Object) (*GuardianConfigReconciler, *testutil.MockDispatcher) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = guardianv1alpha1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&guardianv1alpha1.GuardianConfig{}).
+		Build()
+	dispatcher := testutil.NewMockDispatcher()
+	return &GuardianConfigReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: scheme,
+		Defaults: config.RuntimeConfig{
+			RateLimits:         config.RateLimitsConfig{MaxAlertsPerMinute: 50, BurstLimit: 10},
+			RetentionDays:      30,
+			StartupGracePeriod: 30 * time.Second,
+			IgnoredNamespaces:  []string{"kube-system"},
+		},
+		AlertDispatcher: dispatcher,
+		Ignored:         &IgnoredNamespaces{},
+	}, dispatcher
+}
+
+func reconcileGuardianConfig(t *testing.T, r *GuardianConfigReconciler, name string) {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: k8stypes.NamespacedName{Name: name}})
+	require.NoError(t, err)
+}
+
+func TestGuardianConfigReconcile_Applies(t *testing.T) {
+	maxAlerts := int32(200)
+	r, dispatcher := newGuardianConfigTestReconciler(&guardianv1alpha1.GuardianConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: guardianv1alpha1.GuardianConfigName, Generation: 2},
+		Spec: guardianv1alpha1.GuardianConfigSpec{
+			RateLimits:         &guardianv1alpha1.GuardianRateLimits{MaxAlertsPerMinute: &maxAlerts},
+			StartupGracePeriod: &metav1.Duration{Duration: time.Minute},
+			IgnoredNamespaces:  []string{"sandbox-*"},
+		},
+	})
+
+	reconcileGuardianConfig(t, r, guardianv1alpha1.GuardianConfigName)
+
+	require.NotNil(t, dispatcher.GlobalRateLimits)
+	assert.Equal(t, 200, dispatcher.GlobalRateLimits.MaxAlertsPerMinute)
+	assert.Equal(t, 10, dispatcher.GlobalRateLimits.BurstLimit, "unset fields keep the configured value")
+	assert.Equal(t, time.Minute, dispatcher.StartupGracePeriod)
+	assert.True(t, r.Ignored.Has("sandbox-alice"))
+	assert.False(t, r.Ignored.Has("kube-system"), "spec replaces the configured ignored namespaces")
+
+	updated := &guardianv1alpha1.GuardianConfig{}
+	require.NoError(t, r.Get(context.Background(), k8stypes.NamespacedName{Name: guardianv1alpha1.GuardianConfigName}, updated))
+	assert.True(t, updated.Status.Ready)
+	assert.Equal(t, int64(2), updated.Status.ObservedGeneration)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "Applied", updated.Status.Conditions[0].Reason)
+}
+
+func TestGuardianConfigReconcile_Invalid(t *testing.T) {
+	r, dispatcher := newGuardianConfigTestReconciler(&guardianv1alpha1.GuardianConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: guardianv1alpha1.GuardianConfigName},
+		Spec:       guardianv1alpha1.GuardianConfigSpec{IgnoredNamespaces: []string{"[broken"}},
+	})
+	require.NoError(t, r.Ignored.Set([]string{"kube-system"}))
+
+	reconcileGuardianConfig(t, r, guardianv1alpha1.GuardianConfigName)
+
+	assert.Nil(t, dispatcher.GlobalRateLimits, "nothing is applied")
+	assert.True(t, r.Ignored.Has("kube-system"))
+
+	updated := &guardianv1alpha1.GuardianConfig{}
+	require.NoError(t, r.Get(context.Background(), k8stypes.NamespacedName{Name: guardianv1alpha1.GuardianConfigName}, updated))
+	assert.False(t, updated.Status.Ready)
+	require.Len(t, updated.Status.Conditions, 1)
+	assert.Equal(t, "ValidationFailed", updated.Status.Conditions[0].Reason)
+}
+
+func TestGuardianConfigReconcile_DeletedRestoresDefaults(t *testing.T) {
+	r, dispatcher := newGuardianConfigTestReconciler()
+
+	reconcileGuardianConfig(t, r, guardianv1alpha1.GuardianConfigName)
+
+	require.NotNil(t, dispatcher.GlobalRateLimits)
+	assert.Equal(t, 50, dispatcher.GlobalRateLimits.MaxAlertsPerMinute)
+	assert.Equal(t, 30*time.Second, dispatcher.StartupGracePeriod)
+	assert.True(t, r.Ignored.Has("kube-system"))
+}
+
+func TestGuardianConfigReconciler_SetDefaults(t *testing.T) {
+	maxAlerts := int32(200)
+	r, dispatcher := newGuardianConfigTestReconciler(&guardianv1alpha1.GuardianConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: guardianv1alpha1.GuardianConfigName},
+		Spec: guardianv1alpha1.GuardianConfigSpec{
+			RateLimits: &guardianv1alpha1.GuardianRateLimits{MaxAlertsPerMinute: &maxAlerts},
+		},
+	})
+	reconcileGuardianConfig(t, r, guardianv1alpha1.GuardianConfigName)
+
+	defaults := r.Defaults
+	defaults.RateLimits = config.RateLimitsConfig{MaxAlertsPerMinute: 80, BurstLimit: 40}
+	require.NoError(t, r.SetDefaults(defaults))
+
+	require.NotNil(t, dispatcher.GlobalRateLimits)
+	assert.Equal(t, 200, dispatcher.GlobalRateLimits.MaxAlertsPerMinute, "the GuardianConfig keeps overriding")
+	assert.Equal(t, 40, dispatcher.GlobalRateLimits.BurstLimit)
+}
+
+func TestGuardianConfigReconcile_OtherName(t *testing.T) {
+	r, dispatcher := newGuardianConfigTestReconciler(&guardianv1alpha1.GuardianConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+	})
+
+	reconcileGuardianConfig(t, r, "other")
+
+	assert.Nil(t, dispatcher.GlobalRateLimits)
+}
+
+func TestIgnoredNamespaces(t *testing.T) {
+	var unset *IgnoredNamespaces
+	assert.False(t, unset.Has("default"))
+
+	n, err := NewIgnoredNamespaces([]string{"kube-system", "sandbox-*"})
+	require.NoError(t, err)
+	assert.True(t, n.Has("kube-system"))
+	assert.True(t, n.Has("sandbox-bob"))
+	assert.False(t, n.Has("kube-public"))
+	assert.False(t, n.Has("default"))
+
+	_, err = NewIgnoredNamespaces([]string{"[a-"})
+	assert.Error(t, err)
+}
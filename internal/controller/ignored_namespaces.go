@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"fmt"
+	"path"
+	"sync/atomic"
+)
+
+// IgnoredNamespaces holds the namespaces whose CronJobs are never monitored.
+// It is shared by the controllers and replaced at runtime by the
+// GuardianConfig controller. A nil *IgnoredNamespaces ignores nothing.
+type IgnoredNamespaces struct {
+	patterns atomic.Pointer[[]string]
+}
+
+// NewIgnoredNamespaces returns the ignored namespaces matching patterns
+func NewIgnoredNamespaces(patterns []string) (*IgnoredNamespaces, error) {
+	n := &IgnoredNamespaces{}
+	if err := n.Set(patterns); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Set replaces the ignored namespaces with those matching patterns. Invalid
+// patterns are rejected and the previous ones kept.
+func (n *IgnoredNamespaces) Set(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid ignored namespace pattern %q: %w", p, err)
+		}
+	}
+	patterns = append([]string(nil), patterns...)
+	n.patterns.Store(&patterns)
+	return nil
+}
+
+// Has returns true if CronJobs in namespace are ignored
+func (n *IgnoredNamespaces) Has(namespace string) bool {
+	if n == nil {
+		return false
+	}
+	patterns := n.patterns.Load()
+	if patterns == nil {
+		return false
+	}
+	for _, p := range *patterns {
+		if ok, _ := path.Match(p, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
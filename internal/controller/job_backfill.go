@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// finishedBeforeStart returns true if the Job finished before the job handler
+// started, i.e. while guardian was not running. Such Jobs are replayed by the
+// initial list of Jobs on startup.
+func (h *JobReconciler) finishedBeforeStart(job *batchv1.Job) bool {
+	if h.startedAt.IsZero() {
+		return false
+	}
+	finished := jobFinishedAt(job)
+	return !finished.IsZero() && finished.Before(h.startedAt)
+}
+
+// jobFinishedAt returns when a Job completed or failed (zero if it has not)
+func jobFinishedAt(job *batchv1.Job) time.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime.Time
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == "True" {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
+
+// Backfill records the last successful run of every monitored CronJob if it
+// completed while guardian was not running and its Job is gone, e.g.
+// garbage-collected through successfulJobsHistoryLimit. The run is taken from
+// the CronJob's status.lastSuccessfulTime, so only the latest successful run
+// can be recovered. Runs whose Jobs still exist are recorded by Reconcile.
+func (h *JobReconciler) Backfill(ctx context.Context) error {
+	if h.Store == nil {
+		return nil
+	}
+	log := h.Log.WithName("backfill")
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := h.List(ctx, cronJobs); err != nil {
+		return fmt.Errorf("listing CronJobs: %w", err)
+	}
+
+	backfilled := 0
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+		if !h.Shard.Owns(cronJob.Namespace) || h.Ignored.Has(cronJob.Namespace) {
+			continue
+		}
+		exec, ok := h.missedRun(ctx, cronJob)
+		if !ok {
+			continue
+		}
+		if len(h.findMonitorsForCronJob(ctx, cronJob.Namespace, cronJob.Name)) == 0 {
+			continue
+		}
+		if err := h.Store.RecordExecution(ctx, exec); err != nil {
+			log.Error(err, "failed to record missed run", "cronJob", cronJob.Namespace+"/"+cronJob.Name, "job", exec.JobName)
+			continue
+		}
+		log.Info("recorded run that completed while guardian was not running",
+			"cronJob", cronJob.Namespace+"/"+cronJob.Name, "job", exec.JobName, "completionTime", exec.CompletionTime)
+		backfilled++
+	}
+	log.Info("backfill finished", "recorded", backfilled)
+	return nil
+}
+
+// missedRun returns the execution of the CronJob's last successful run if it
+// has not been recorded and its Job no longer exists. The run is identified by
+// status.lastScheduleTime, so it is only known when no later run was scheduled.
+func (h *JobReconciler) missedRun(ctx context.Context, cronJob *batchv1.CronJob) (store.Execution, bool) {
+	lastSuccess := cronJob.Status.LastSuccessfulTime
+	lastSchedule := cronJob.Status.LastScheduleTime
+	if lastSuccess == nil || lastSchedule == nil || lastSchedule.After(lastSuccess.Time) {
+		return store.Execution{}, false
+	}
+	if !h.startedAt.IsZero() && !lastSuccess.Time.Before(h.startedAt) {
+		return store.Execution{}, false
+	}
+
+	// The CronJob controller names Jobs after their scheduled time in minutes
+	scheduled := lastSchedule.Time
+	jobName := fmt.Sprintf("%s-%d", cronJob.Name, scheduled.Unix()/60)
+
+	recorded, err := h.Store.GetExecutionByJobName(ctx, cronJob.Namespace, jobName)
+	if err != nil || recorded != nil {
+		return store.Execution{}, false
+	}
+	job := &batchv1.Job{}
+	if err := h.Get(ctx, types.NamespacedName{Namespace: cronJob.Namespace, Name: jobName}, job); !apierrors.IsNotFound(err) {
+		return store.Execution{}, false
+	}
+
+	// The start time and duration of the run are unknown; it started no
+	// earlier than it was scheduled
+	return store.Execution{
+		Cluster:          h.Remote.clusterName(),
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		CronJobUID:       string(cronJob.UID),
+		JobName:          jobName,
+		ScheduledTime:    &scheduled,
+		StartTime:        scheduled,
+		CompletionTime:   lastSuccess.Time,
+		Succeeded:        true,
+		Backfilled:       true,
+	}, true
+}
@@ -6,12 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -19,12 +22,17 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/disruption"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/ping"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
@@ -44,18 +52,52 @@ type JobReconciler struct {
 	Store           store.Store
 	Config          *config.Config
 	AlertDispatcher alerting.Dispatcher
+
+	// ExecutionBatcher buffers execution writes (optional; nil writes each execution directly)
+	ExecutionBatcher *store.ExecutionBatcher
+
+	// Shard limits recording to Jobs in this shard's namespaces (zero value = all)
+	Shard shard.Shard
+
+	// Pinger sends the monitors' healthcheck pings (optional; nil disables pings)
+	Pinger *ping.Pinger
+
+	// Ignored are namespaces whose Jobs are not recorded (optional; nil = none)
+	Ignored *IgnoredNamespaces
+
+	// Disruption attributes failures to node drains and cluster upgrades
+	// (optional; nil = failures are never attributed to them)
+	Disruption *disruption.Detector
+
+	// Remote is the cluster Jobs are watched in when it is not the manager's
+	// (optional; nil = the manager's cluster). Client and Clientset must be
+	// the remote cluster's, Store scoped to it (see store.GormStore.ForCluster).
+	Remote *RemoteCluster
+
+	// startedAt tells Jobs that finished while guardian was not running apart
+	// (set by SetupWithManager)
+	startedAt time.Time
+
+	// catchUps collects catch-up runs until their burst has finished
+	catchUps catchUpBursts
 }
 
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch
+// +kubebuilder:rbac:groups=guardian.illenium.net,resources=failurepatterns,verbs=get;list;watch
 
 // Reconcile handles Job completion/failure events
 func (h *JobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := h.Log.WithValues("job", req.NamespacedName)
 	log.V(1).Info("reconciling job")
 
+	if h.Ignored.Has(req.Namespace) {
+		log.V(1).Info("namespace is ignored, skipping")
+		return ctrl.Result{}, nil
+	}
+
 	job := &batchv1.Job{}
 	if err := h.Get(ctx, req.NamespacedName, job); err != nil {
 		if client.IgnoreNotFound(err) == nil {
@@ -92,6 +134,16 @@ func (h *JobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, nil
 	}
 
+	// Jobs that finished before startup are replayed by the initial list.
+	// Record those that finished while guardian was down, as backfilled.
+	backfilled := h.finishedBeforeStart(job)
+	if backfilled && h.Store != nil {
+		if recorded, err := h.Store.GetExecutionByJobName(ctx, job.Namespace, job.Name); err == nil && recorded != nil {
+			log.V(1).Info("job was recorded before guardian restarted, skipping")
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// Find ALL monitors whose selector matches this CronJob (real-time evaluation)
 	monitors := h.findMonitorsForCronJob(ctx, job.Namespace, cronJobName)
 	if len(monitors) == 0 {
@@ -119,10 +171,19 @@ func (h *JobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	// Record execution ONCE (keyed by CronJob, not monitor)
 	// Use first monitor for config (logs/events storage settings)
 	exec := h.buildExecution(ctx, job, cronJobName, cronJobUID, monitors[0])
+	exec.Backfilled = backfilled
+	exec.CatchUp = analyzer.IsCatchUpRun(job, cronJobName)
+
+	// Failures on drained or upgraded nodes, or of evicted pods, are the infrastructure's
+	if !exec.Succeeded && h.Disruption.Active() {
+		exec.InfraDisruption = h.Disruption.Caused(exec, h.getJobPods(ctx, job))
+	}
 
-	// Generate suggested fix for failures (stored once, used by alerts and UI)
+	// Classify and generate suggested fix for failures (stored once, used by alerts and UI)
+	var patternSeverity string
 	if !exec.Succeeded {
-		exec.SuggestedFix = h.generateSuggestedFix(exec, monitors[0])
+		exec.Classification = h.classifyFailure(ctx, job, exec, monitors[0])
+		exec.SuggestedFix, patternSeverity = h.generateSuggestedFix(ctx, exec, monitors[0], cronJob)
 	}
 
 	log.V(1).Info(
@@ -131,13 +192,24 @@ func (h *JobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		"duration", exec.Duration(),
 		"exitCode", exec.ExitCode,
 		"reason", exec.Reason,
+		"classification", exec.Classification,
 		"cronJobUID", exec.CronJobUID,
 		"hasLogs", exec.Logs != nil && *exec.Logs != "",
 		"hasEvents", exec.Events != nil && *exec.Events != "",
 		"hasSuggestedFix", exec.SuggestedFix != "",
 	)
 
-	if h.Store != nil {
+	// Read earlier output sizes and outcomes before this run is recorded
+	outputHistory := h.outputHistory(ctx, monitors, cronJobNN, exec)
+	flapHistory := h.flapHistory(ctx, monitors, cronJobNN, exec)
+	streaks := runStreaks{
+		failures:  h.failureStreak(ctx, monitors, cronJobNN, exec),
+		successes: h.successStreak(ctx, monitors, cronJobNN, exec),
+	}
+
+	if h.ExecutionBatcher != nil {
+		h.ExecutionBatcher.Enqueue(ctx, exec)
+	} else if h.Store != nil {
 		if err := h.Store.RecordExecution(ctx, exec); err != nil {
 			log.Error(err, "failed to record execution")
 		} else {
@@ -149,18 +221,55 @@ func (h *JobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		}
 	}
 
+	if h.Pinger != nil {
+		for _, monitor := range monitors {
+			h.Pinger.PingRun(ctx, monitor, exec)
+		}
+	}
+
+	// Catch-up runs fired when a CronJob is resumed are evaluated as one
+	// recovery event, by the outcome of the last of them to finish
+	var burst catchUpBurst
+	if exec.CatchUp {
+		h.catchUps.add(cronJobNN, exec)
+		if h.catchUpRunsActive(ctx, cronJob, job) {
+			log.Info("catch-up run finished, waiting for the rest of the burst")
+			return ctrl.Result{}, nil
+		}
+		burst = h.catchUps.finish(cronJobNN)
+		if burst.size() > 1 {
+			log.Info("evaluating catch-up burst", "runs", burst.size(), "failed", burst.failed())
+			// The burst counts as a single failure towards the failure streak
+			if !exec.Succeeded && streaks.failures > 0 {
+				streaks.failures = max(streaks.failures-burst.failed()+1, 1)
+			}
+		}
+	}
+	grouped := burst.size() > 1
+
 	// Handle completion for ALL matching monitors
-	if job.Status.Succeeded > 0 {
+	if exec.Succeeded {
 		log.Info("job succeeded", "cronJob", cronJobName, "job", job.Name)
 		for _, monitor := range monitors {
 			monitorLog := log.WithValues("monitor", monitor.Name)
-			h.handleSuccess(ctx, monitorLog, monitor, job, cronJobName)
+			if grouped || !h.checkFlapping(ctx, monitorLog, monitor, cronJobNN, exec, flapHistory) {
+				h.handleSuccess(ctx, monitorLog, monitor, job, cronJobName)
+			}
+			h.checkOutputSize(ctx, monitorLog, monitor, cronJobNN, exec, outputHistory)
 		}
 	} else if job.Status.Failed > 0 {
-		log.Info("job failed", "cronJob", cronJobName, "job", job.Name, "exitCode", exec.ExitCode, "reason", exec.Reason)
+		log.Info("job failed", "cronJob", cronJobName, "job", job.Name, "exitCode", exec.ExitCode, "reason", exec.Reason,
+			"infrastructureDisruption", exec.InfraDisruption)
+		if exec.InfraDisruption && h.Disruption.SuppressAlerts() {
+			log.Info("failure caused by a cluster disruption, not alerting")
+			return ctrl.Result{}, nil
+		}
 		for _, monitor := range monitors {
 			monitorLog := log.WithValues("monitor", monitor.Name)
-			h.handleFailure(ctx, monitorLog, monitor, job, cronJobName, exec)
+			if !grouped && h.checkFlapping(ctx, monitorLog, monitor, cronJobNN, exec, flapHistory) {
+				continue
+			}
+			h.handleFailure(ctx, monitorLog, monitor, job, cronJob, cronJobName, exec, patternSeverity, streaks, burst)
 		}
 	}
 
@@ -251,6 +360,7 @@ func (h *JobReconciler) monitorWatchesNamespace(monitor *guardianv1alpha1.CronJo
 
 func (h *JobReconciler) buildExecution(ctx context.Context, job *batchv1.Job, cronJobName, cronJobUID string, monitor *guardianv1alpha1.CronJobMonitor) store.Execution {
 	exec := store.Execution{
+		Cluster:          h.Remote.clusterName(),
 		CronJobNamespace: job.Namespace,
 		CronJobName:      cronJobName,
 		CronJobUID:       cronJobUID,
@@ -258,6 +368,10 @@ func (h *JobReconciler) buildExecution(ctx context.Context, job *batchv1.Job, cr
 		Succeeded:        job.Status.Succeeded > 0,
 	}
 
+	if scheduled, ok := analyzer.JobScheduledTime(job, cronJobName); ok {
+		exec.ScheduledTime = &scheduled
+	}
+
 	if job.Status.StartTime != nil {
 		exec.StartTime = job.Status.StartTime.Time
 	}
@@ -267,18 +381,57 @@ func (h *JobReconciler) buildExecution(ctx context.Context, job *batchv1.Job, cr
 		exec.SetDuration(exec.CompletionTime.Sub(exec.StartTime))
 	}
 
-	// Get exit code from pod
-	pod := h.getJobPod(ctx, job)
+	exec.CPURequestMilli, exec.MemoryRequest = jobResourceRequests(job)
+	exec.SetSpec(specSnapshot(job))
+
+	// Get exit code, node and images from the pod
+	pods := h.getJobPods(ctx, job)
+	var pod *corev1.Pod
+	if len(pods) > 0 {
+		pod = &pods[0]
+	}
+	podNames := make([]string, 0, len(pods))
+	for i := range pods {
+		podNames = append(podNames, pods[i].Name)
+	}
+	exec.SetPodNames(podNames)
 	if pod != nil {
-		for _, cs := range pod.Status.ContainerStatuses {
-			if cs.State.Terminated != nil {
-				exec.ExitCode = cs.State.Terminated.ExitCode
-				exec.Reason = cs.State.Terminated.Reason
-				break
-			}
+		exec.NodeName = pod.Spec.NodeName
+		exec.SetImages(containerImages(pod))
+		containers := containerResults(pod)
+		exec.SetContainers(containers)
+		if outcome := outcomeContainer(pod, containers); outcome != nil {
+			exec.ExitCode = outcome.ExitCode
+			exec.Reason = outcome.Reason
 		}
 	}
 
+	// With a main container, sidecar failures do not fail the run
+	if criteria := monitor.Spec.SuccessCriteria; criteria != nil && !exec.Succeeded {
+		if main := mainContainerSuccess(pods, criteria.MainContainer); main != nil {
+			exec.Succeeded = true
+			exec.ExitCode = main.ExitCode
+			exec.Reason = main.Reason
+		}
+	}
+
+	// An evicted, preempted or lost pod is recorded as such rather than by
+	// the generic reason of its killed containers
+	if !exec.Succeeded && pod != nil && exec.Reason != reasonOOMKilled {
+		if cause := h.podFailureCause(ctx, pod); cause != "" {
+			exec.Reason = cause
+		}
+	}
+
+	// A Job whose pods were never scheduled or created failed before running
+	if !exec.Succeeded && exec.Reason == "" {
+		exec.Reason = h.neverStartedCause(ctx, job, pods)
+	}
+
+	if output := monitor.Spec.Output; output != nil {
+		exec.OutputSize = outputSize(job, pods, outputAnnotation(output))
+	}
+
 	// Check if this is a retry
 	if job.Labels["guardian.illenium.net/retry"] == "true" {
 		exec.IsRetry = true
@@ -306,6 +459,15 @@ func (h *JobReconciler) buildExecution(ctx context.Context, job *batchv1.Job, cr
 }
 
 func (h *JobReconciler) getJobPod(ctx context.Context, job *batchv1.Job) *corev1.Pod {
+	pods := h.getJobPods(ctx, job)
+	if len(pods) > 0 {
+		return &pods[0]
+	}
+	return nil
+}
+
+// getJobPods lists all pods created by a job, including failed retries
+func (h *JobReconciler) getJobPods(ctx context.Context, job *batchv1.Job) []corev1.Pod {
 	pods := &corev1.PodList{}
 	if err := h.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
 		h.Log.V(1).Error(err, "failed to list pods for job", "job", job.Name)
@@ -313,13 +475,149 @@ func (h *JobReconciler) getJobPod(ctx context.Context, job *batchv1.Job) *corev1
 	}
 
 	if len(pods.Items) > 0 {
-		h.Log.V(1).Info("found pod for job", "job", job.Name, "pod", pods.Items[0].Name)
-		return &pods.Items[0]
+		h.Log.V(1).Info("found pods for job", "job", job.Name, "pod", pods.Items[0].Name, "count", len(pods.Items))
+		return pods.Items
 	}
 	h.Log.V(1).Info("no pod found for job", "job", job.Name)
 	return nil
 }
 
+// jobResourceRequests returns the CPU (millicores) and memory (bytes) requested
+// by the pods a Job runs in parallel. A pod requests the larger of the sum of
+// its containers and its largest init container, as the scheduler counts it.
+func jobResourceRequests(job *batchv1.Job) (cpuMilli, memoryBytes int64) {
+	spec := job.Spec.Template.Spec
+	for _, c := range spec.Containers {
+		cpuMilli += c.Resources.Requests.Cpu().MilliValue()
+		memoryBytes += c.Resources.Requests.Memory().Value()
+	}
+	for _, c := range spec.InitContainers {
+		cpuMilli = max(cpuMilli, c.Resources.Requests.Cpu().MilliValue())
+		memoryBytes = max(memoryBytes, c.Resources.Requests.Memory().Value())
+	}
+
+	pods := int64(ptr.Deref(job.Spec.Parallelism, 1))
+	if job.Spec.Completions != nil {
+		pods = min(pods, int64(*job.Spec.Completions))
+	}
+	pods = max(pods, 1)
+	return cpuMilli * pods, memoryBytes * pods
+}
+
+// containerImages returns the distinct images of a pod's containers, in spec order
+func containerImages(pod *corev1.Pod) []string {
+	var images []string
+	for _, c := range pod.Spec.Containers {
+		if !slices.Contains(images, c.Image) {
+			images = append(images, c.Image)
+		}
+	}
+	return images
+}
+
+// specSnapshot returns the images, commands and environment variable names
+// of a Job's pod template
+func specSnapshot(job *batchv1.Job) store.JobSpecSnapshot {
+	spec := job.Spec.Template.Spec
+	snapshot := store.JobSpecSnapshot{ServiceAccountName: spec.ServiceAccountName}
+	add := func(containers []corev1.Container, init bool) {
+		for _, c := range containers {
+			env := make([]string, 0, len(c.Env))
+			for _, e := range c.Env {
+				env = append(env, e.Name)
+			}
+			slices.Sort(env)
+			snapshot.Containers = append(snapshot.Containers, store.ContainerSpec{
+				Name:    c.Name,
+				Init:    init,
+				Image:   c.Image,
+				Command: c.Command,
+				Args:    c.Args,
+				Env:     env,
+			})
+		}
+	}
+	add(spec.InitContainers, true)
+	add(spec.Containers, false)
+	return snapshot
+}
+
+// containerResults returns how the pod's init and regular containers
+// terminated. Containers that did not terminate are left out.
+func containerResults(pod *corev1.Pod) []store.ContainerResult {
+	var results []store.ContainerResult
+	add := func(statuses []corev1.ContainerStatus, init bool) {
+		for _, cs := range statuses {
+			t := cs.State.Terminated
+			if t == nil {
+				continue
+			}
+			results = append(results, store.ContainerResult{
+				Name:       cs.Name,
+				Init:       init,
+				ExitCode:   t.ExitCode,
+				Reason:     t.Reason,
+				StartedAt:  t.StartedAt.Time,
+				FinishedAt: t.FinishedAt.Time,
+			})
+		}
+	}
+	add(pod.Status.InitContainerStatuses, true)
+	add(pod.Status.ContainerStatuses, false)
+	return results
+}
+
+// outcomeContainer returns the container the execution's exit code and
+// reason are taken from: the container that failed first, else the first
+// regular container. Native sidecars are only used when no other container
+// terminated, since they are stopped once the job's containers exit.
+func outcomeContainer(pod *corev1.Pod, containers []store.ContainerResult) *store.ContainerResult {
+	sidecars := make(map[string]bool)
+	for _, c := range pod.Spec.InitContainers {
+		if c.RestartPolicy != nil && *c.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			sidecars[c.Name] = true
+		}
+	}
+
+	var failed, sidecarFailed, first *store.ContainerResult
+	for i := range containers {
+		c := &containers[i]
+		switch {
+		case c.ExitCode != 0 && sidecars[c.Name]:
+			if sidecarFailed == nil {
+				sidecarFailed = c
+			}
+		case c.ExitCode != 0:
+			if failed == nil || c.FinishedAt.Before(failed.FinishedAt) {
+				failed = c
+			}
+		case !c.Init && first == nil:
+			first = c
+		}
+	}
+	switch {
+	case failed != nil:
+		return failed
+	case first != nil:
+		return first
+	default:
+		return sidecarFailed
+	}
+}
+
+// mainContainerSuccess returns the termination of the named container in
+// the first pod where it exited 0, or nil if it never succeeded
+func mainContainerSuccess(pods []corev1.Pod, name string) *corev1.ContainerStateTerminated {
+	for i := range pods {
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			if cs.Name == name && cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 0 {
+				return cs.State.Terminated
+			}
+		}
+	}
+	return nil
+}
+
 // handleRecreationCheck checks if a CronJob was recreated (UID changed) and handles per config
 func (h *JobReconciler) handleRecreationCheck(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, currentUID string) {
 	// Get existing UIDs for this CronJob
@@ -330,6 +628,7 @@ func (h *JobReconciler) handleRecreationCheck(ctx context.Context, log logr.Logg
 	}
 
 	// Check if there are different UIDs (indicating recreation)
+	flushed := false
 	for _, uid := range uids {
 		if uid != "" && uid != currentUID {
 			log.Info("detected CronJob recreation", "oldUID", uid, "newUID", currentUID)
@@ -341,6 +640,13 @@ func (h *JobReconciler) handleRecreationCheck(ctx context.Context, log logr.Logg
 			}
 
 			if onRecreation == retentionReset {
+				// Write buffered executions first so old-UID rows still in
+				// the batcher are deleted too
+				if h.ExecutionBatcher != nil && !flushed {
+					h.ExecutionBatcher.Flush(ctx)
+					flushed = true
+				}
+
 				// Delete executions from the old UID
 				deleted, err := h.Store.DeleteExecutionsByUID(ctx, cronJob, uid)
 				if err != nil {
@@ -443,7 +749,7 @@ func (h *JobReconciler) collectAndTruncateLogs(ctx context.Context, pod *corev1.
 	return logs
 }
 
-func (h *JobReconciler) handleSuccess(ctx context.Context, log logr.Logger, _ *guardianv1alpha1.CronJobMonitor, job *batchv1.Job, cronJobName string) {
+func (h *JobReconciler) handleSuccess(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, job *batchv1.Job, cronJobName string) {
 	if h.AlertDispatcher == nil {
 		return
 	}
@@ -461,7 +767,7 @@ func (h *JobReconciler) handleSuccess(ctx context.Context, log logr.Logger, _ *g
 	}
 
 	// Clear alert types that resolve immediately on success
-	// Note: SLABreached and DurationRegression are NOT cleared here because they're
+	// Note: SLABreached, DurationRegression and DurationBudget are NOT cleared here because they're
 	// calculated over a time window - a single success doesn't recover the SLA.
 	// The SLA recalc scheduler will clear them when metrics recover.
 	alertTypes := []string{
@@ -484,13 +790,31 @@ func (h *JobReconciler) handleSuccess(ctx context.Context, log logr.Logger, _ *g
 			_ = h.Store.ResolveAlert(ctx, alertType, cronJobNN.Namespace, cronJobNN.Name)
 		}
 	}
+
+	h.clearChainBroken(ctx, log, monitor, types.NamespacedName{Namespace: job.Namespace, Name: cronJobName})
 }
 
-func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, job *batchv1.Job, cronJobName string, exec store.Execution) {
+// handleFailure alerts on a failed job. patternSeverity is the severity of the
+// matched suggested fix pattern, if any, and overrides the monitor's JobFailed severity.
+// streaks are the runs before this job that it continues or ends, burst the
+// catch-up runs it was the last of, if any.
+func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, job *batchv1.Job, cronJob *batchv1.CronJob, cronJobName string, exec store.Execution, patternSeverity string, streaks runStreaks, burst catchUpBurst) {
+	// Flaky jobs alert only once they have failed several times in a row
+	if belowFailureStreak(monitor, streaks.failures) {
+		log.Info("failure streak below alert threshold, not alerting", "streak", streaks.failures)
+		h.handleChainBroken(ctx, log, monitor, types.NamespacedName{Namespace: job.Namespace, Name: cronJobName}, exec)
+		return
+	}
+
 	// Build alert context from the stored execution
 	alertCtx := alerting.AlertContext{
-		ExitCode: exec.ExitCode,
-		Reason:   exec.Reason,
+		JobName:        job.Name,
+		ExitCode:       exec.ExitCode,
+		Reason:         exec.Reason,
+		Classification: exec.Classification,
+		NodeName:       exec.NodeName,
+		Images:         exec.GetImages(),
+		PodNames:       exec.GetPodNames(),
 	}
 
 	// Safe access to alerting config
@@ -522,16 +846,21 @@ func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, moni
 		alertCtx.SuggestedFix = exec.SuggestedFix
 	}
 
+	// Propagate the selected CronJob labels and annotations (empty if the CronJob is gone)
+	alertCtx.PropagateMetadata(includeCtx, cronJob)
+
 	log.V(1).Info("built alert context",
 		"logLength", len(alertCtx.Logs),
 		"eventCount", len(alertCtx.Events),
 		"hasSuggestedFix", alertCtx.SuggestedFix != "")
 
 	// Determine severity (with nil safety)
-	severity := statusCritical
-	if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-		severity = getSeverity(monitor.Spec.Alerting.SeverityOverrides.JobFailed, statusCritical)
+	severity := monitor.Spec.Alerting.SeverityFor("JobFailed", statusCritical)
+	if patternSeverity != "" {
+		severity = patternSeverity
 	}
+	message := h.buildFailureMessage(job, alertCtx)
+	applyFailureStreak(monitor, streaks.failures, &severity, &message)
 
 	// Create alert
 	alert := alerting.Alert{
@@ -539,7 +868,7 @@ func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, moni
 		Type:     "JobFailed",
 		Severity: severity,
 		Title:    fmt.Sprintf("CronJob %s/%s failed", job.Namespace, cronJobName),
-		Message:  h.buildFailureMessage(job, alertCtx),
+		Message:  message,
 		CronJob: types.NamespacedName{
 			Namespace: job.Namespace,
 			Name:      cronJobName,
@@ -552,6 +881,31 @@ func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, moni
 		Timestamp: time.Now(),
 	}
 
+	// A failure after a long run of successes points at a real change, not flakiness
+	if endsLongSuccessStreak(monitor, streaks.successes, exec.StartTime) {
+		highlightSuccessStreak(&alert, streaks.successes, exec.StartTime)
+	}
+
+	// A Job that never started failed before any of its code ran
+	if neverStarted(exec.Reason) {
+		alert.Message += "\n\nThe Job never started: its pods could not be scheduled or created, so none of its code ran."
+	}
+
+	// A failure during a node drain or upgrade is most likely caused by it
+	if exec.InfraDisruption {
+		alert.Message += "\n\nThe run failed on a node that was drained or not ready, or its pod was evicted, during a cluster disruption."
+	}
+
+	// One alert stands for the whole burst of catch-up runs
+	if burst.size() > 1 {
+		annotateCatchUpBurst(&alert, burst)
+	}
+
+	// A failure after an upstream CronJob failed is most likely caused by it
+	if failed := h.failedUpstreams(ctx, monitor, alert.CronJob); len(failed) > 0 {
+		annotateDownstreamFailure(&alert, failed)
+	}
+
 	// Dispatch alert
 	if h.AlertDispatcher != nil {
 		log.Info(
@@ -569,6 +923,8 @@ func (h *JobReconciler) handleFailure(ctx context.Context, log logr.Logger, moni
 	} else {
 		log.V(1).Info("alert dispatcher not configured, skipping alert dispatch")
 	}
+
+	h.handleChainBroken(ctx, log, monitor, alert.CronJob, exec)
 }
 
 func (h *JobReconciler) collectLogs(ctx context.Context, job *batchv1.Job, alertCtx *guardianv1alpha1.AlertContext) string {
@@ -654,14 +1010,44 @@ func (h *JobReconciler) buildFailureMessage(job *batchv1.Job, ctx alerting.Alert
 	if ctx.ExitCode != 0 {
 		msg += fmt.Sprintf(" (exit code: %d)", ctx.ExitCode)
 	}
+	if ctx.Classification != "" {
+		msg += fmt.Sprintf(", classified as %s", ctx.Classification)
+	}
 	return msg
 }
 
+// defaultClassificationLogLines is how many trailing log lines are scanned when logs are not stored
+const defaultClassificationLogLines = 200
+
+// classifyFailure classifies a failed execution by matching the monitor's
+// classification rules against its logs. Stored logs are used when present;
+// otherwise the tail of the pod logs is fetched.
+func (h *JobReconciler) classifyFailure(ctx context.Context, job *batchv1.Job, exec store.Execution, monitor *guardianv1alpha1.CronJobMonitor) string {
+	cfg := monitor.Spec.FailureClassification
+	if cfg == nil || len(cfg.Rules) == 0 {
+		return ""
+	}
+
+	var logs string
+	if exec.Logs != nil && *exec.Logs != "" {
+		logs = *exec.Logs
+	} else {
+		lines := int32(defaultClassificationLogLines)
+		if cfg.LogLines != nil {
+			lines = *cfg.LogLines
+		}
+		logs = h.collectLogs(ctx, job, &guardianv1alpha1.AlertContext{LogLines: &lines})
+	}
+
+	return alerting.ClassifyLogs(cfg.Rules, logs)
+}
+
 // suggestedFixEngine is the global pattern matching engine for suggested fixes
 var suggestedFixEngine = alerting.NewSuggestedFixEngine()
 
-// generateSuggestedFix creates a suggested fix for a failed execution
-func (h *JobReconciler) generateSuggestedFix(exec store.Execution, monitor *guardianv1alpha1.CronJobMonitor) string {
+// generateSuggestedFix creates a suggested fix for a failed execution. It also
+// returns the severity override of the matched pattern, or "" if it has none.
+func (h *JobReconciler) generateSuggestedFix(ctx context.Context, exec store.Execution, monitor *guardianv1alpha1.CronJobMonitor, cronJob *batchv1.CronJob) (string, string) {
 	var events []string
 	if exec.Events != nil {
 		events = strings.Split(*exec.Events, "\n")
@@ -672,22 +1058,97 @@ func (h *JobReconciler) generateSuggestedFix(exec store.Execution, monitor *guar
 	}
 
 	matchCtx := alerting.MatchContext{
-		Namespace: exec.CronJobNamespace,
-		Name:      exec.CronJobName,
-		JobName:   exec.JobName,
-		ExitCode:  exec.ExitCode,
-		Reason:    exec.Reason,
-		Logs:      logs,
-		Events:    events,
-	}
-
-	// Get custom patterns from monitor spec
+		Namespace:  exec.CronJobNamespace,
+		Name:       exec.CronJobName,
+		JobName:    exec.JobName,
+		ExitCode:   exec.ExitCode,
+		Reason:     exec.Reason,
+		Containers: exec.GetContainers(),
+		Logs:       logs,
+		Events:     events,
+	}
+
+	// Custom patterns from the monitor spec, then shared FailurePattern resources.
+	// The monitor's own patterns win on name clashes.
 	var customPatterns []guardianv1alpha1.SuggestedFixPattern
 	if monitor.Spec.Alerting != nil {
-		customPatterns = monitor.Spec.Alerting.SuggestedFixPatterns
+		customPatterns = append(customPatterns, monitor.Spec.Alerting.SuggestedFixPatterns...)
+	}
+	for _, p := range h.findFailurePatterns(ctx, cronJob) {
+		if !slices.ContainsFunc(customPatterns, func(c guardianv1alpha1.SuggestedFixPattern) bool { return c.Name == p.Name }) {
+			customPatterns = append(customPatterns, p)
+		}
+	}
+
+	pattern, suggestion := suggestedFixEngine.FindMatch(matchCtx, customPatterns)
+	if pattern == nil {
+		return suggestion, ""
+	}
+	return suggestion, pattern.Severity
+}
+
+// findFailurePatterns returns the valid FailurePattern resources that apply to the CronJob
+func (h *JobReconciler) findFailurePatterns(ctx context.Context, cronJob *batchv1.CronJob) []guardianv1alpha1.SuggestedFixPattern {
+	if cronJob == nil || cronJob.Name == "" {
+		return nil
+	}
+
+	list := &guardianv1alpha1.FailurePatternList{}
+	if err := h.List(ctx, list); err != nil {
+		h.Log.V(1).Info("failed to list failure patterns", "error", err)
+		return nil
+	}
+
+	var patterns []guardianv1alpha1.SuggestedFixPattern
+	for i := range list.Items {
+		fp := &list.Items[i]
+		if !h.patternWatchesNamespace(ctx, fp, cronJob.Namespace) || !MatchesSelector(cronJob, fp.Spec.Selector) {
+			continue
+		}
+		pattern := failurePatternToSuggestedFix(fp)
+		if err := alerting.ValidatePattern(pattern); err != nil {
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
+
+// patternWatchesNamespace checks if a FailurePattern applies to CronJobs in the given namespace
+func (h *JobReconciler) patternWatchesNamespace(ctx context.Context, fp *guardianv1alpha1.FailurePattern, namespace string) bool {
+	selector := fp.Spec.Selector
+	if selector == nil {
+		return fp.Namespace == namespace
+	}
+	if selector.AllNamespaces {
+		return true
+	}
+	if len(selector.Namespaces) > 0 {
+		return slices.Contains(selector.Namespaces, namespace)
 	}
+	if selector.NamespaceSelector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector.NamespaceSelector)
+		if err != nil {
+			return false
+		}
+		ns := &corev1.Namespace{}
+		if err := h.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+			return false
+		}
+		return labelSelector.Matches(labels.Set(ns.Labels))
+	}
+	return fp.Namespace == namespace
+}
 
-	return suggestedFixEngine.GetBestSuggestion(matchCtx, customPatterns)
+// failurePatternToSuggestedFix converts a FailurePattern into the engine's pattern type
+func failurePatternToSuggestedFix(fp *guardianv1alpha1.FailurePattern) guardianv1alpha1.SuggestedFixPattern {
+	return guardianv1alpha1.SuggestedFixPattern{
+		Name:       fp.Name,
+		Match:      fp.Spec.Match,
+		Suggestion: fp.Spec.Suggestion,
+		Priority:   fp.Spec.Priority,
+		Severity:   fp.Spec.Severity,
+	}
 }
 
 func (h *JobReconciler) isOwnedByCronJob(obj client.Object) bool {
@@ -711,54 +1172,66 @@ func isJobComplete(job *batchv1.Job) bool {
 // SetupWithManager sets up the job handler with the Manager.
 func (h *JobReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	h.Log.Info("setting up job handler controller")
+	h.startedAt = time.Now()
+	if h.Remote != nil {
+		return ctrl.NewControllerManagedBy(mgr).
+			WatchesRawSource(h.Remote.source(&batchv1.Job{}, &handler.EnqueueRequestForObject{},
+				h.Shard.Predicate(), h.completionPredicate())).
+			Named("jobhandler-" + h.Remote.Name).
+			Complete(h)
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&batchv1.Job{}).
-		WithEventFilter(
-			predicate.Funcs{
-				CreateFunc: func(e event.CreateEvent) bool {
-					// Only process creates if job is already complete (rare but possible)
-					job, ok := e.Object.(*batchv1.Job)
-					if !ok || !h.isOwnedByCronJob(e.Object) {
-						return false
-					}
-					complete := isJobComplete(job)
-					h.Log.V(1).Info(
-						"job create event",
-						"job", e.Object.GetName(),
-						"namespace", e.Object.GetNamespace(),
-						"complete", complete,
-					)
-					return complete
-				},
-				UpdateFunc: func(e event.UpdateEvent) bool {
-					// Only process if job transitions to complete
-					if !h.isOwnedByCronJob(e.ObjectNew) {
-						return false
-					}
-					oldJob, ok1 := e.ObjectOld.(*batchv1.Job)
-					newJob, ok2 := e.ObjectNew.(*batchv1.Job)
-					if !ok1 || !ok2 {
-						return false
-					}
-					// Only reconcile when job transitions to complete
-					wasComplete := isJobComplete(oldJob)
-					nowComplete := isJobComplete(newJob)
-					shouldProcess := nowComplete && !wasComplete
-					h.Log.V(1).Info(
-						"job update event",
-						"job", e.ObjectNew.GetName(),
-						"namespace", e.ObjectNew.GetNamespace(),
-						"wasComplete", wasComplete,
-						"nowComplete", nowComplete,
-						"processing", shouldProcess,
-					)
-					return shouldProcess
-				},
-				DeleteFunc: func(_ event.DeleteEvent) bool {
-					return false // Don't process deletes
-				},
-			},
-		).
+		WithEventFilter(h.Shard.Predicate()).
+		WithEventFilter(h.completionPredicate()).
 		Named("jobhandler").
 		Complete(h)
 }
+
+// completionPredicate passes CronJob-owned Jobs that completed
+func (h *JobReconciler) completionPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			// Only process creates if job is already complete (rare but possible)
+			job, ok := e.Object.(*batchv1.Job)
+			if !ok || !h.isOwnedByCronJob(e.Object) {
+				return false
+			}
+			complete := isJobComplete(job)
+			h.Log.V(1).Info(
+				"job create event",
+				"job", e.Object.GetName(),
+				"namespace", e.Object.GetNamespace(),
+				"complete", complete,
+			)
+			return complete
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Only process if job transitions to complete
+			if !h.isOwnedByCronJob(e.ObjectNew) {
+				return false
+			}
+			oldJob, ok1 := e.ObjectOld.(*batchv1.Job)
+			newJob, ok2 := e.ObjectNew.(*batchv1.Job)
+			if !ok1 || !ok2 {
+				return false
+			}
+			// Only reconcile when job transitions to complete
+			wasComplete := isJobComplete(oldJob)
+			nowComplete := isJobComplete(newJob)
+			shouldProcess := nowComplete && !wasComplete
+			h.Log.V(1).Info(
+				"job update event",
+				"job", e.ObjectNew.GetName(),
+				"namespace", e.ObjectNew.GetNamespace(),
+				"wasComplete", wasComplete,
+				"nowComplete", nowComplete,
+				"processing", shouldProcess,
+			)
+			return shouldProcess
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false // Don't process deletes
+		},
+	}
+}
@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -10,16 +12,21 @@ import (
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/disruption"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
 )
 
@@ -248,6 +255,641 @@ func TestReconcile_FailedJob(t *testing.T) {
 	assert.Equal(t, "JobFailed", alert.Type)
 }
 
+func TestReconcile_SidecarFailureWithMainContainer(t *testing.T) {
+	cronJob := createTestCronJob("mesh-cron", "default")
+	job := createFailedJob("mesh-cron-12345", "default", "mesh-cron")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mesh-cron-12345-a",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": "mesh-cron-12345"},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "istio-proxy", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "Error"}}},
+				{Name: "main", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		criteria  *guardianv1alpha1.SuccessCriteriaConfig
+		succeeded bool
+	}{
+		{name: "without success criteria", succeeded: false},
+		{name: "main container succeeded", criteria: &guardianv1alpha1.SuccessCriteriaConfig{MainContainer: "main"}, succeeded: true},
+		{name: "main container did not run", criteria: &guardianv1alpha1.SuccessCriteriaConfig{MainContainer: "app"}, succeeded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "mesh-cron"},
+			})
+			monitor.Spec.SuccessCriteria = tt.criteria
+
+			fakeClient := newJobTestClient(cronJob.DeepCopy(), job.DeepCopy(), pod.DeepCopy(), monitor)
+			mockStore := &testutil.MockStore{}
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           mockStore,
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "mesh-cron-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, mockStore.RecordedExecutions, 1)
+			exec := mockStore.RecordedExecutions[0]
+			assert.Equal(t, tt.succeeded, exec.Succeeded)
+			if tt.succeeded {
+				assert.Zero(t, exec.ExitCode)
+				assert.Equal(t, "Completed", exec.Reason)
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+			} else {
+				assert.Equal(t, int32(137), exec.ExitCode)
+				assert.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			}
+		})
+	}
+}
+
+func TestSpecSnapshot(t *testing.T) {
+	job := &batchv1.Job{}
+	job.Spec.Template.Spec = corev1.PodSpec{
+		ServiceAccountName: "backup",
+		InitContainers:     []corev1.Container{{Name: "migrate", Image: "migrate:2.0"}},
+		Containers: []corev1.Container{{
+			Name:    "backup",
+			Image:   "backup:1.1",
+			Command: []string{"/bin/backup"},
+			Args:    []string{"--all"},
+			Env: []corev1.EnvVar{
+				{Name: "REGION", Value: "eu-west-1"},
+				{Name: "BUCKET", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "bucket"}}},
+			},
+		}},
+	}
+
+	exec := store.Execution{}
+	exec.SetSpec(specSnapshot(job))
+	assert.Len(t, exec.SpecHash, 64)
+	assert.NotContains(t, exec.Spec, "eu-west-1", "env values are not recorded")
+	assert.Equal(t, &store.JobSpecSnapshot{
+		ServiceAccountName: "backup",
+		Containers: []store.ContainerSpec{
+			{Name: "migrate", Init: true, Image: "migrate:2.0"},
+			{Name: "backup", Image: "backup:1.1", Command: []string{"/bin/backup"}, Args: []string{"--all"}, Env: []string{"BUCKET", "REGION"}},
+		},
+	}, exec.GetSpec())
+}
+
+func TestOutputSize(t *testing.T) {
+	terminated := func(message string) corev1.ContainerStatus {
+		return corev1.ContainerStatus{Name: "main", State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Message: message},
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		jobAnnotations map[string]string
+		podAnnotations map[string]string
+		status         corev1.ContainerStatus
+		annotation     string
+		want           *float64
+	}{
+		{name: "not reported", annotation: defaultOutputAnnotation},
+		{
+			name:           "job annotation",
+			jobAnnotations: map[string]string{defaultOutputAnnotation: "120000"},
+			podAnnotations: map[string]string{defaultOutputAnnotation: "5"},
+			annotation:     defaultOutputAnnotation,
+			want:           ptr.To(120000.0),
+		},
+		{
+			name:           "pod annotation with unit",
+			podAnnotations: map[string]string{defaultOutputAnnotation: "1.5Ki"},
+			annotation:     defaultOutputAnnotation,
+			want:           ptr.To(1536.0),
+		},
+		{
+			name:       "termination message",
+			status:     terminated("rows=10\nexample.com/rows=42\n"),
+			annotation: "example.com/rows",
+			want:       ptr.To(42.0),
+		},
+		{
+			name:           "invalid annotation falls back to termination message",
+			jobAnnotations: map[string]string{defaultOutputAnnotation: "lots"},
+			status:         terminated(defaultOutputAnnotation + "=7"),
+			annotation:     defaultOutputAnnotation,
+			want:           ptr.To(7.0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Annotations: tt.jobAnnotations}}
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.podAnnotations}}
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{tt.status}
+			assert.Equal(t, tt.want, outputSize(job, []corev1.Pod{pod}, tt.annotation))
+		})
+	}
+}
+
+func TestReconcile_OutputDropped(t *testing.T) {
+	cronJob := createTestCronJob("export-cron", "default")
+	previous := func(sizes ...float64) []store.Execution {
+		var execs []store.Execution
+		for _, size := range sizes {
+			execs = append(execs, store.Execution{Succeeded: true, OutputSize: ptr.To(size)})
+		}
+		return execs
+	}
+
+	tests := []struct {
+		name     string
+		size     string
+		previous []store.Execution
+		alerted  bool
+		cleared  bool
+	}{
+		{name: "output dropped", size: "300", previous: previous(1000, 1100, 900, 1000), alerted: true},
+		{name: "normal output", size: "950", previous: previous(1000, 1100, 900, 1000), cleared: true},
+		{name: "too few earlier runs", size: "10", previous: previous(1000, 1100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := createCompletedJob("export-cron-12345", "default", "export-cron")
+			job.Annotations = map[string]string{defaultOutputAnnotation: tt.size}
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "export-cron"},
+			})
+			monitor.Spec.Output = &guardianv1alpha1.OutputConfig{}
+
+			fakeClient := newJobTestClient(cronJob.DeepCopy(), job, monitor)
+			mockStore := &testutil.MockStore{Executions: tt.previous}
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           mockStore,
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "export-cron-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, mockStore.RecordedExecutions, 1)
+			require.NotNil(t, mockStore.RecordedExecutions[0].OutputSize)
+
+			if tt.alerted {
+				require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+				alert := mockDispatcher.DispatchedAlerts[0]
+				assert.Equal(t, "default/export-cron/OutputDropped", alert.Key)
+				assert.Equal(t, "warning", alert.Severity)
+				assert.Contains(t, alert.Message, "70% below the median of 1000")
+			} else {
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+			}
+			assert.Equal(t, tt.cleared, slices.Contains(mockDispatcher.ClearedAlerts, "default/export-cron/OutputDropped"))
+		})
+	}
+}
+
+func TestReconcile_Flapping(t *testing.T) {
+	cronJob := createTestCronJob("flaky-cron", "default")
+	// previous returns earlier runs 10 minutes apart, the last one 10 minutes before age
+	previous := func(age time.Duration, outcomes ...bool) []store.Execution {
+		var execs []store.Execution
+		start := time.Now().Add(-age - time.Duration(len(outcomes))*10*time.Minute)
+		for i, succeeded := range outcomes {
+			execs = append(execs, store.Execution{
+				JobName:   fmt.Sprintf("flaky-cron-%d", i),
+				StartTime: start.Add(time.Duration(i) * 10 * time.Minute),
+				Succeeded: succeeded,
+			})
+		}
+		return execs
+	}
+
+	tests := []struct {
+		name           string
+		succeeded      bool
+		previous       []store.Execution
+		flapping       bool
+		jobFailedSent  bool
+		jobFailedClear bool
+	}{
+		{name: "failure while flapping", previous: previous(0, false, true, false, true), flapping: true},
+		{name: "success while flapping holds resolution", succeeded: true, previous: previous(0, true, false, true, false), flapping: true},
+		{name: "failure after stable runs", previous: previous(0, true, true, true, true), jobFailedSent: true},
+		{name: "success after stable runs", succeeded: true, previous: previous(0, false, false, false, false), jobFailedClear: true},
+		{name: "changes outside the window", previous: previous(2*time.Hour, false, true, false, true), jobFailedSent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := createFailedJob("flaky-cron-12345", "default", "flaky-cron")
+			if tt.succeeded {
+				job = createCompletedJob("flaky-cron-12345", "default", "flaky-cron")
+			}
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "flaky-cron"},
+			})
+			monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+				FlapDetection: &guardianv1alpha1.FlapDetectionConfig{},
+			}
+
+			fakeClient := newJobTestClient(cronJob.DeepCopy(), job, monitor)
+			mockStore := &testutil.MockStore{Executions: tt.previous}
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           mockStore,
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "flaky-cron-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			var alertTypes []string
+			for _, alert := range mockDispatcher.DispatchedAlerts {
+				alertTypes = append(alertTypes, alert.Type)
+			}
+			assert.Equal(t, tt.flapping, slices.Contains(alertTypes, alertTypeFlapping))
+			assert.Equal(t, tt.jobFailedSent, slices.Contains(alertTypes, "JobFailed"))
+			assert.Equal(t, !tt.flapping, slices.Contains(mockDispatcher.ClearedAlerts, "default/flaky-cron/Flapping"))
+			assert.Equal(t, tt.jobFailedClear, slices.Contains(mockDispatcher.ClearedAlerts, "default/flaky-cron/JobFailed"))
+			assert.Equal(t, tt.flapping, slices.Contains(mockDispatcher.CancelledAlerts, "default/flaky-cron/JobFailed"))
+		})
+	}
+}
+
+func TestReconcile_FlapDetectionDisabled(t *testing.T) {
+	cronJob := createTestCronJob("flaky-cron", "default")
+	job := createFailedJob("flaky-cron-12345", "default", "flaky-cron")
+	monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+		MatchLabels: map[string]string{"app": "flaky-cron"},
+	})
+
+	var execs []store.Execution
+	for i := range 6 {
+		execs = append(execs, store.Execution{
+			StartTime: time.Now().Add(-time.Duration(i+1) * time.Minute),
+			Succeeded: i%2 == 0,
+		})
+	}
+
+	fakeClient := newJobTestClient(cronJob, job, monitor)
+	mockDispatcher := testutil.NewMockDispatcher()
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           &testutil.MockStore{Executions: execs},
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "flaky-cron-12345", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "JobFailed", mockDispatcher.DispatchedAlerts[0].Type)
+}
+
+func TestReconcile_FailureStreak(t *testing.T) {
+	cronJob := createTestCronJob("flaky-cron", "default")
+	// previous returns earlier runs an hour apart, newest first
+	previous := func(outcomes ...bool) []store.Execution {
+		var execs []store.Execution
+		for i, succeeded := range outcomes {
+			execs = append(execs, store.Execution{
+				StartTime: time.Now().Add(-time.Duration(i+1) * time.Hour),
+				Succeeded: succeeded,
+			})
+		}
+		return execs
+	}
+
+	tests := []struct {
+		name     string
+		previous []store.Execution
+		severity string // empty = no JobFailed alert
+		message  string
+	}{
+		{name: "first failure", previous: previous(true, false)},
+		{name: "below threshold", previous: previous(false, true, false)},
+		{name: "threshold reached", previous: previous(false, false, true), severity: "warning", message: "(3 consecutive failures)"},
+		{name: "escalated", previous: previous(false, false, false, false), severity: "critical", message: "(5 consecutive failures)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := createFailedJob("flaky-cron-12345", "default", "flaky-cron")
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "flaky-cron"},
+			})
+			monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+				SeverityOverrides: guardianv1alpha1.SeverityOverrides{"jobFailed": "warning"},
+				FailureStreak: &guardianv1alpha1.FailureStreakConfig{
+					AlertAfter:    ptr.To[int32](3),
+					CriticalAfter: ptr.To[int32](5),
+				},
+			}
+
+			fakeClient := newJobTestClient(cronJob.DeepCopy(), job, monitor)
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           &testutil.MockStore{Executions: tt.previous},
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "flaky-cron-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			if tt.severity == "" {
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+				return
+			}
+			require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			alert := mockDispatcher.DispatchedAlerts[0]
+			assert.Equal(t, "JobFailed", alert.Type)
+			assert.Equal(t, tt.severity, alert.Severity)
+			assert.Contains(t, alert.Message, tt.message)
+		})
+	}
+}
+
+func TestReconcile_LongSuccessStreak(t *testing.T) {
+	cronJob := createTestCronJob("steady-cron", "default")
+	// daily returns successful runs a day apart, newest first, after a failure
+	daily := func(days int) []store.Execution {
+		var execs []store.Execution
+		for i := range days {
+			execs = append(execs, store.Execution{
+				StartTime: time.Now().Add(-time.Duration(i+1) * 24 * time.Hour),
+				Succeeded: true,
+			})
+		}
+		return append(execs, store.Execution{
+			StartTime: time.Now().Add(-time.Duration(days+1) * 24 * time.Hour),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		previous    []store.Execution
+		streak      *metav1.Duration
+		highlighted bool
+	}{
+		{name: "long streak", previous: daily(60), highlighted: true},
+		{name: "short streak", previous: daily(10)},
+		{name: "custom threshold", previous: daily(10), streak: &metav1.Duration{Duration: 7 * 24 * time.Hour}, highlighted: true},
+		{name: "disabled", previous: daily(60), streak: &metav1.Duration{}},
+		{name: "after a failure", previous: append([]store.Execution{{StartTime: time.Now().Add(-time.Hour)}}, daily(60)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := createFailedJob("steady-cron-12345", "default", "steady-cron")
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "steady-cron"},
+			})
+			monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{LongSuccessStreak: tt.streak}
+
+			fakeClient := newJobTestClient(cronJob.DeepCopy(), job, monitor)
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           &testutil.MockStore{Executions: tt.previous},
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "steady-cron-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			alert := mockDispatcher.DispatchedAlerts[0]
+			if !tt.highlighted {
+				assert.Zero(t, alert.Context.SuccessStreakRuns)
+				assert.NotContains(t, alert.Message, "First failure in")
+				return
+			}
+			successes := len(tt.previous) - 1
+			assert.Equal(t, successes, alert.Context.SuccessStreakRuns)
+			assert.Contains(t, alert.Message, fmt.Sprintf("First failure in %d days, after %d successful run(s).", successes, successes))
+		})
+	}
+}
+
+func TestReconcile_CatchUpBurst(t *testing.T) {
+	resumed := time.Now().Add(-5 * time.Minute)
+	// catchUp makes job a run created on resume for a schedule hoursLate earlier
+	catchUp := func(job *batchv1.Job, hoursLate int) *batchv1.Job {
+		scheduled := resumed.Add(-time.Duration(hoursLate) * time.Hour)
+		job.Name = fmt.Sprintf("burst-cron-%d", scheduled.Unix()/60)
+		job.CreationTimestamp = metav1.NewTime(resumed)
+		return job
+	}
+
+	tests := []struct {
+		name      string
+		recovered bool
+	}{
+		{name: "recovered", recovered: true},
+		{name: "still failing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := catchUp(createFailedJob("", "default", "burst-cron"), 2)
+			last := catchUp(createFailedJob("", "default", "burst-cron"), 1)
+			if tt.recovered {
+				last = catchUp(createCompletedJob("", "default", "burst-cron"), 1)
+			}
+			running := last.DeepCopy()
+			running.Status = batchv1.JobStatus{StartTime: last.Status.StartTime}
+
+			cronJob := createTestCronJob("burst-cron", "default")
+			cronJob.Status.Active = []corev1.ObjectReference{{Name: first.Name}, {Name: last.Name}}
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "burst-cron"},
+			})
+
+			ctx := context.Background()
+			fakeClient := newJobTestClient(cronJob, first, running, monitor)
+			mockStore := &testutil.MockStore{}
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           mockStore,
+				AlertDispatcher: mockDispatcher,
+			}
+
+			// The first run fails while the second is still running
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: first.Name, Namespace: "default"},
+			})
+			require.NoError(t, err)
+			require.Len(t, mockStore.Executions, 1)
+			assert.True(t, mockStore.Executions[0].CatchUp)
+			assert.Empty(t, mockDispatcher.DispatchedAlerts)
+			assert.Empty(t, mockDispatcher.ClearedAlerts)
+
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: last.Name, Namespace: "default"}, running))
+			running.Status = last.Status
+			require.NoError(t, fakeClient.Status().Update(ctx, running))
+
+			_, err = reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: last.Name, Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			if tt.recovered {
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+				assert.Contains(t, mockDispatcher.ClearedAlerts, "default/burst-cron/JobFailed")
+				return
+			}
+			require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			assert.Contains(t, mockDispatcher.DispatchedAlerts[0].Message,
+				"Last of 2 catch-up runs fired when the CronJob was resumed; 2 of them failed.")
+		})
+	}
+}
+
+func TestReconcile_ClusterDisruption(t *testing.T) {
+	tests := []struct {
+		name     string
+		suppress bool
+	}{
+		{name: "annotated"},
+		{name: "suppressed", suppress: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := createTestCronJob("drained-cron", "default")
+			job := createFailedJob("drained-cron-12345", "default", "drained-cron")
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "drained-cron-12345-abcde",
+					Namespace: "default",
+					Labels:    map[string]string{"job-name": job.Name},
+				},
+				Spec: corev1.PodSpec{NodeName: "node-a"},
+			}
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+				Spec:       corev1.NodeSpec{Unschedulable: true},
+			}
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "drained-cron"},
+			})
+
+			fakeClient := newJobTestClient(cronJob, job, pod, node, monitor)
+			detector := disruption.NewDetector(fakeClient, config.ClusterDisruptionConfig{
+				Enabled:             true,
+				MinUnavailableNodes: 1,
+				Cooldown:            time.Minute,
+				SuppressAlerts:      tt.suppress,
+			})
+			// Start checks the cluster once before returning on the cancelled context
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			require.NoError(t, detector.Start(ctx))
+			require.True(t, detector.Active())
+
+			mockStore := &testutil.MockStore{}
+			mockDispatcher := testutil.NewMockDispatcher()
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           mockStore,
+				AlertDispatcher: mockDispatcher,
+				Disruption:      detector,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: job.Name, Namespace: "default"},
+			})
+			require.NoError(t, err)
+			require.Len(t, mockStore.Executions, 1)
+			assert.True(t, mockStore.Executions[0].InfraDisruption)
+
+			if tt.suppress {
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+				return
+			}
+			require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			assert.Contains(t, mockDispatcher.DispatchedAlerts[0].Message, "during a cluster disruption")
+		})
+	}
+}
+
+func TestReconcile_NeverStarted(t *testing.T) {
+	cronJob := createTestCronJob("quota-cron", "default")
+	job := createFailedJob("quota-cron-12345", "default", "quota-cron")
+	quotaEvent := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "quota-cron-12345.failedcreate", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Job", Name: job.Name},
+		Reason:         "FailedCreate",
+		Message:        `Error creating: pods "quota-cron-12345-x" is forbidden: exceeded quota: compute`,
+	}
+	monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+		MatchLabels: map[string]string{"app": "quota-cron"},
+	})
+
+	fakeClient := newJobTestClient(cronJob, job, quotaEvent, monitor)
+	mockStore := &testutil.MockStore{}
+	mockDispatcher := testutil.NewMockDispatcher()
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           mockStore,
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: job.Name, Namespace: "default"},
+	})
+	require.NoError(t, err)
+	require.Len(t, mockStore.Executions, 1)
+	assert.Equal(t, "QuotaExceeded", mockStore.Executions[0].Reason)
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Contains(t, mockDispatcher.DispatchedAlerts[0].Message, "The Job never started")
+}
+
 func TestReconcile_RunningJob(t *testing.T) {
 	cronJob := createTestCronJob("running-cron", "default")
 	job := createRunningJob("running-cron-12345", "default", "running-cron")
@@ -457,6 +1099,163 @@ func TestBuildExecution_FetchesEvents(t *testing.T) {
 	assert.Contains(t, *exec.Events, "Completed")
 }
 
+func TestBuildExecution_RecordsNodeImagesAndPods(t *testing.T) {
+	cronJob := createTestCronJob("node-cron", "default")
+	job := createFailedJob("node-cron-12345", "default", "node-cron")
+
+	newPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{"job-name": "node-cron-12345"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "worker-3",
+				Containers: []corev1.Container{
+					{Name: "main", Image: "registry.example.com/etl:1.4.2"},
+					{Name: "proxy", Image: "envoyproxy/envoy:v1.30"},
+					{Name: "proxy-2", Image: "envoyproxy/envoy:v1.30"},
+				},
+			},
+		}
+	}
+
+	fakeClient := newJobTestClient(cronJob, job, newPod("node-cron-12345-a"), newPod("node-cron-12345-b"))
+	reconciler := &JobReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	exec := reconciler.buildExecution(context.Background(), job, "node-cron", "test-uid", createTestMonitor("test-monitor", "default", nil))
+
+	assert.Equal(t, "worker-3", exec.NodeName)
+	assert.Equal(t, []string{"registry.example.com/etl:1.4.2", "envoyproxy/envoy:v1.30"}, exec.GetImages())
+	assert.ElementsMatch(t, []string{"node-cron-12345-a", "node-cron-12345-b"}, exec.GetPodNames())
+}
+
+func TestBuildExecution_ContainerResults(t *testing.T) {
+	cronJob := createTestCronJob("sidecar-cron", "default")
+	job := createFailedJob("sidecar-cron-12345", "default", "sidecar-cron")
+
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	terminated := func(name string, exitCode int32, reason string, from, to time.Duration) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name: name,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				ExitCode:   exitCode,
+				Reason:     reason,
+				StartedAt:  metav1.NewTime(start.Add(from)),
+				FinishedAt: metav1.NewTime(start.Add(to)),
+			}},
+		}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sidecar-cron-12345-a",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": "sidecar-cron-12345"},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "setup"},
+				{Name: "log-shipper", RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways)},
+			},
+			Containers: []corev1.Container{{Name: "proxy"}, {Name: "main"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				terminated("setup", 0, "Completed", 0, 5*time.Second),
+				terminated("log-shipper", 143, "Error", 5*time.Second, 70*time.Second),
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				terminated("proxy", 137, "Error", 6*time.Second, 65*time.Second),
+				terminated("main", 2, "Error", 6*time.Second, 60*time.Second),
+			},
+		},
+	}
+
+	fakeClient := newJobTestClient(cronJob, job, pod)
+	reconciler := &JobReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	exec := reconciler.buildExecution(context.Background(), job, "sidecar-cron", "test-uid", createTestMonitor("test-monitor", "default", nil))
+
+	// The main container failed first; the sidecars were stopped after it
+	assert.Equal(t, int32(2), exec.ExitCode)
+	assert.Equal(t, "Error", exec.Reason)
+
+	containers := exec.GetContainers()
+	require.Len(t, containers, 4)
+	assert.Equal(t, "setup", containers[0].Name)
+	assert.True(t, containers[0].Init)
+	assert.Equal(t, 5*time.Second, containers[0].Duration())
+	assert.Equal(t, "main", containers[3].Name)
+	assert.False(t, containers[3].Init)
+	assert.Equal(t, 54*time.Second, containers[3].Duration())
+}
+
+func TestOutcomeContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "sidecar", RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways)}},
+	}}
+
+	// Succeeded: the first regular container, not the stopped sidecar
+	outcome := outcomeContainer(pod, []store.ContainerResult{
+		{Name: "sidecar", Init: true, ExitCode: 143, Reason: "Error"},
+		{Name: "main", ExitCode: 0, Reason: "Completed"},
+	})
+	require.NotNil(t, outcome)
+	assert.Equal(t, "main", outcome.Name)
+
+	// A failed init container
+	outcome = outcomeContainer(pod, []store.ContainerResult{
+		{Name: "setup", Init: true, ExitCode: 1, Reason: "Error"},
+	})
+	require.NotNil(t, outcome)
+	assert.Equal(t, "setup", outcome.Name)
+
+	assert.Nil(t, outcomeContainer(pod, nil))
+}
+
+func TestJobResourceRequests(t *testing.T) {
+	requests := func(cpu, memory string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}}
+	}
+	job := createCompletedJob("usage-cron-12345", "default", "usage-cron")
+	job.Spec.Template.Spec.Containers = []corev1.Container{
+		{Name: "main", Resources: requests("500m", "256Mi")},
+		{Name: "sidecar", Resources: requests("100m", "64Mi")},
+	}
+	// The init container needs more CPU but less memory than the containers
+	job.Spec.Template.Spec.InitContainers = []corev1.Container{
+		{Name: "init", Resources: requests("1", "128Mi")},
+	}
+
+	cpu, memory := jobResourceRequests(job)
+	assert.Equal(t, int64(1000), cpu)
+	assert.Equal(t, int64(320*1024*1024), memory)
+
+	// Pods running in parallel, capped by completions
+	job.Spec.Parallelism = ptr.To[int32](4)
+	job.Spec.Completions = ptr.To[int32](3)
+	cpu, memory = jobResourceRequests(job)
+	assert.Equal(t, int64(3000), cpu)
+	assert.Equal(t, int64(3*320*1024*1024), memory)
+
+	// No requests
+	cpu, memory = jobResourceRequests(createCompletedJob("bare-12345", "default", "bare"))
+	assert.Zero(t, cpu)
+	assert.Zero(t, memory)
+}
+
 func TestBuildExecution_SuggestedFix(t *testing.T) {
 	cronJob := createTestCronJob("oom-cron", "default")
 	job := createFailedJob("oom-cron-12345", "default", "oom-cron")
@@ -503,13 +1302,377 @@ func TestBuildExecution_SuggestedFix(t *testing.T) {
 	exec := reconciler.buildExecution(context.Background(), job, "oom-cron", "test-uid", monitor)
 
 	// Then generate the suggested fix
-	suggestedFix := reconciler.generateSuggestedFix(exec, monitor)
+	suggestedFix, _ := reconciler.generateSuggestedFix(context.Background(), exec, monitor, cronJob)
 
 	// Should have a suggestion for OOMKilled
 	assert.NotEmpty(t, suggestedFix)
 	assert.Contains(t, suggestedFix, "memory")
 }
 
+func TestBuildExecution_PodFailureCause(t *testing.T) {
+	killed := corev1.ContainerStatus{
+		Name: "main",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			ExitCode: 143,
+			Reason:   "Error",
+		}},
+	}
+	disruptionTarget := func(reason string) []corev1.PodCondition {
+		return []corev1.PodCondition{{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: reason}}
+	}
+
+	tests := []struct {
+		name        string
+		status      corev1.PodStatus
+		eventReason string
+		want        string
+		severity    string
+	}{
+		{
+			name:   "application error",
+			status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{killed}},
+			want:   "Error",
+		},
+		{
+			name: "out of memory",
+			status: corev1.PodStatus{
+				Conditions: disruptionTarget("TerminationByKubelet"),
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "main",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				}},
+			},
+			want: "OOMKilled",
+		},
+		{
+			name:     "evicted by the kubelet",
+			status:   corev1.PodStatus{Reason: "Evicted", ContainerStatuses: []corev1.ContainerStatus{killed}},
+			want:     "Evicted",
+			severity: "warning",
+		},
+		{
+			name:     "drained",
+			status:   corev1.PodStatus{Conditions: disruptionTarget("EvictionByEvictionAPI"), ContainerStatuses: []corev1.ContainerStatus{killed}},
+			want:     "Evicted",
+			severity: "warning",
+		},
+		{
+			name:     "preempted",
+			status:   corev1.PodStatus{Conditions: disruptionTarget("PreemptionByScheduler"), ContainerStatuses: []corev1.ContainerStatus{killed}},
+			want:     "Preempted",
+			severity: "warning",
+		},
+		{
+			name:     "node lost",
+			status:   corev1.PodStatus{Conditions: disruptionTarget("DeletionByPodGC")},
+			want:     "NodeLost",
+			severity: "warning",
+		},
+		{
+			name:        "preempted by event",
+			status:      corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{killed}},
+			eventReason: "Preempted",
+			want:        "Preempted",
+			severity:    "warning",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := createTestCronJob("cause-cron", "default")
+			job := createFailedJob("cause-cron-12345", "default", "cause-cron")
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cause-cron-12345-abcde",
+					Namespace: "default",
+					Labels:    map[string]string{"job-name": job.Name},
+				},
+				Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}},
+				Status: tt.status,
+			}
+			objs := []client.Object{cronJob, job, pod}
+			if tt.eventReason != "" {
+				objs = append(objs, &corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "cause-event", Namespace: "default"},
+					InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod.Name},
+					Reason:         tt.eventReason,
+				})
+			}
+			monitor := createTestMonitor("test-monitor", "default", nil)
+
+			fakeClient := newJobTestClient(objs...)
+			reconciler := &JobReconciler{
+				Client: fakeClient,
+				Log:    logr.Discard(),
+				Scheme: fakeClient.Scheme(),
+			}
+
+			exec := reconciler.buildExecution(context.Background(), job, "cause-cron", "test-uid", monitor)
+			assert.Equal(t, tt.want, exec.Reason)
+
+			_, severity := reconciler.generateSuggestedFix(context.Background(), exec, monitor, cronJob)
+			assert.Equal(t, tt.severity, severity)
+		})
+	}
+}
+
+func TestBuildExecution_NeverStarted(t *testing.T) {
+	unschedulable := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "start-cron-12345-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": "start-cron-12345"},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector.",
+			}},
+		},
+	}
+	event := func(kind, name, reason, message string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name + "." + reason, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+			Reason:         reason,
+			Message:        message,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		want    string
+		pattern string
+	}{
+		{
+			name:    "pod not scheduled",
+			objs:    []client.Object{unschedulable},
+			want:    "Unschedulable",
+			pattern: "could not be scheduled",
+		},
+		{
+			name:    "deleted pod that was not scheduled",
+			objs:    []client.Object{event("Pod", "start-cron-12345-fghij", "FailedScheduling", "0/3 nodes are available")},
+			want:    "Unschedulable",
+			pattern: "could not be scheduled",
+		},
+		{
+			name: "quota exceeded",
+			objs: []client.Object{event("Job", "start-cron-12345", "FailedCreate",
+				`Error creating: pods "start-cron-12345-x" is forbidden: exceeded quota: compute, requested: cpu=2, used: cpu=4, limited: cpu=4`)},
+			want:    "QuotaExceeded",
+			pattern: "ResourceQuota",
+		},
+		{
+			name: "deleted pod that was scheduled",
+			objs: []client.Object{
+				event("Pod", "start-cron-12345-fghij", "FailedScheduling", "0/3 nodes are available"),
+				event("Pod", "start-cron-12345-fghij", "Scheduled", "Successfully assigned default/start-cron-12345-fghij to node-a"),
+			},
+		},
+		{
+			name: "no pods or events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := createTestCronJob("start-cron", "default")
+			job := createFailedJob("start-cron-12345", "default", "start-cron")
+			monitor := createTestMonitor("test-monitor", "default", nil)
+
+			fakeClient := newJobTestClient(append([]client.Object{cronJob, job}, tt.objs...)...)
+			reconciler := &JobReconciler{
+				Client: fakeClient,
+				Log:    logr.Discard(),
+				Scheme: fakeClient.Scheme(),
+			}
+
+			exec := reconciler.buildExecution(context.Background(), job, "start-cron", "test-uid", monitor)
+			assert.Equal(t, tt.want, exec.Reason)
+
+			if tt.pattern != "" {
+				fix, _ := reconciler.generateSuggestedFix(context.Background(), exec, monitor, cronJob)
+				assert.Contains(t, fix, "never started")
+				assert.Contains(t, fix, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestClassifyFailure_StoredLogs(t *testing.T) {
+	job := createFailedJob("classify-cron-12345", "default", "classify-cron")
+	monitor := createTestMonitor("test-monitor", "default", nil)
+	monitor.Spec.FailureClassification = &guardianv1alpha1.FailureClassificationConfig{
+		Rules: []guardianv1alpha1.ClassificationRule{
+			{Classification: "dependency-failure", LogPattern: "connection refused"},
+			{Classification: "db-issue", LogPattern: "deadlock"},
+		},
+	}
+
+	fakeClient := newJobTestClient(job)
+	reconciler := &JobReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	logs := "Traceback (most recent call last):\npsycopg2.errors.DeadlockDetected: deadlock detected"
+	exec := store.Execution{ExitCode: 1, Logs: &logs}
+	assert.Equal(t, "db-issue", reconciler.classifyFailure(context.Background(), job, exec, monitor))
+
+	// No rules configured: nothing is classified
+	assert.Empty(t, reconciler.classifyFailure(context.Background(), job, exec, createTestMonitor("plain", "default", nil)))
+
+	// No stored logs and no clientset to fetch them: unclassified
+	assert.Empty(t, reconciler.classifyFailure(context.Background(), job, store.Execution{ExitCode: 1}, monitor))
+}
+
+func TestBuildFailureMessage_IncludesClassification(t *testing.T) {
+	reconciler := &JobReconciler{Log: logr.Discard()}
+	job := createFailedJob("msg-cron-12345", "default", "msg-cron")
+
+	msg := reconciler.buildFailureMessage(job, alerting.AlertContext{ExitCode: 1, Reason: "Error", Classification: "dependency-failure"})
+	assert.Equal(t, "Job msg-cron-12345 failed with reason: Error (exit code: 1), classified as dependency-failure", msg)
+}
+
+func TestReconcile_FailurePatternSuggestionAndSeverity(t *testing.T) {
+	cronJob := createTestCronJob("pattern-cron", "default")
+	job := createFailedJob("pattern-cron-12345", "default", "pattern-cron")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pattern-cron-12345-pod",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": "pattern-cron-12345"},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 42, Reason: "Error"},
+				},
+			}},
+		},
+	}
+	monitor := createTestMonitor("test-monitor", "default", nil)
+
+	priority := int32(500)
+	shared := &guardianv1alpha1.FailurePattern{
+		ObjectMeta: metav1.ObjectMeta{Name: "exit-42", Namespace: "platform"},
+		Spec: guardianv1alpha1.FailurePatternSpec{
+			Selector:   &guardianv1alpha1.CronJobSelector{AllNamespaces: true},
+			Match:      guardianv1alpha1.PatternMatch{ExitCode: ptr.To(int32(42))},
+			Suggestion: "{{.Name}} exited with 42, rotate the API token",
+			Priority:   &priority,
+			Severity:   "warning",
+		},
+	}
+	// No selector: only applies to CronJobs in its own namespace
+	otherNamespace := &guardianv1alpha1.FailurePattern{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-team", Namespace: "team-b"},
+		Spec: guardianv1alpha1.FailurePatternSpec{
+			Match:      guardianv1alpha1.PatternMatch{ExitCode: ptr.To(int32(42))},
+			Suggestion: "Should not be used",
+			Priority:   ptr.To(int32(1000)),
+		},
+	}
+
+	fakeClient := newJobTestClient(cronJob, job, pod, monitor, shared, otherNamespace)
+	mockStore := &testutil.MockStore{}
+	mockDispatcher := testutil.NewMockDispatcher()
+
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           mockStore,
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "pattern-cron-12345", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, mockStore.RecordedExecutions, 1)
+	assert.Equal(t, "pattern-cron exited with 42, rotate the API token", mockStore.RecordedExecutions[0].SuggestedFix)
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "warning", mockDispatcher.DispatchedAlerts[0].Severity)
+}
+
+func TestReconcile_FailurePropagatesCronJobMetadata(t *testing.T) {
+	cronJob := createTestCronJob("labeled-cron", "default")
+	cronJob.Labels["team"] = "payments"
+	cronJob.Annotations = map[string]string{"runbook-url": "https://runbooks.example.com/labeled-cron", "other": "x"}
+	job := createFailedJob("labeled-cron-12345", "default", "labeled-cron")
+	monitor := createTestMonitor("test-monitor", "default", nil)
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		IncludeContext: &guardianv1alpha1.AlertContext{
+			Labels:      []string{"team", "service"},
+			Annotations: []string{"runbook-url"},
+		},
+	}
+
+	fakeClient := newJobTestClient(cronJob, job, monitor)
+	mockDispatcher := testutil.NewMockDispatcher()
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           &testutil.MockStore{},
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "labeled-cron-12345", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alertCtx := mockDispatcher.DispatchedAlerts[0].Context
+	assert.Equal(t, map[string]string{"team": "payments"}, alertCtx.Labels)
+	assert.Equal(t, map[string]string{"runbook-url": "https://runbooks.example.com/labeled-cron"}, alertCtx.Annotations)
+}
+
+func TestGenerateSuggestedFix_MonitorPatternWinsOverFailurePattern(t *testing.T) {
+	cronJob := createTestCronJob("clash-cron", "default")
+	shared := &guardianv1alpha1.FailurePattern{
+		ObjectMeta: metav1.ObjectMeta{Name: "exit-42", Namespace: "default"},
+		Spec: guardianv1alpha1.FailurePatternSpec{
+			Match:      guardianv1alpha1.PatternMatch{ExitCode: ptr.To(int32(42))},
+			Suggestion: "Shared suggestion",
+			Priority:   ptr.To(int32(500)),
+			Severity:   "warning",
+		},
+	}
+	monitor := createTestMonitor("test-monitor", "default", nil)
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		SuggestedFixPatterns: []guardianv1alpha1.SuggestedFixPattern{{
+			Name:       "exit-42",
+			Match:      guardianv1alpha1.PatternMatch{ExitCode: ptr.To(int32(42))},
+			Suggestion: "Monitor suggestion",
+			Priority:   ptr.To(int32(500)),
+		}},
+	}
+
+	fakeClient := newJobTestClient(cronJob, shared)
+	reconciler := &JobReconciler{
+		Client: fakeClient,
+		Log:    logr.Discard(),
+		Scheme: fakeClient.Scheme(),
+	}
+
+	exec := store.Execution{CronJobNamespace: "default", CronJobName: "clash-cron", ExitCode: 42}
+	suggestion, severity := reconciler.generateSuggestedFix(context.Background(), exec, monitor, cronJob)
+	assert.Equal(t, "Monitor suggestion", suggestion)
+	assert.Empty(t, severity)
+}
+
 func TestFindMonitorsForCronJob(t *testing.T) {
 	cronJob := createTestCronJob("multi-cron", "default")
 
@@ -623,6 +1786,38 @@ func TestHandleRecreationCheck_Reset(t *testing.T) {
 	assert.Equal(t, "old-uid-1234", mockStore.DeletedUIDs[0])
 }
 
+func TestHandleRecreationCheck_FlushesBatcherOnlyOnReset(t *testing.T) {
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "reset-monitor", Namespace: "default"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			DataRetention: &guardianv1alpha1.DataRetentionConfig{OnRecreation: "reset"},
+		},
+	}
+	cronJobNN := types.NamespacedName{Namespace: "default", Name: "recreated-cron"}
+	mockStore := &testutil.MockStore{
+		CronJobUIDsMap: map[string][]string{
+			"default/recreated-cron": {"current-uid"},
+		},
+	}
+	batcher := store.NewExecutionBatcher(mockStore, 10, time.Hour)
+	batcher.Enqueue(context.Background(), store.Execution{
+		CronJobNamespace: "default", CronJobName: "recreated-cron", CronJobUID: "current-uid",
+	})
+
+	reconciler := &JobReconciler{Log: logr.Discard(), Store: mockStore, ExecutionBatcher: batcher}
+
+	// UID unchanged: the buffer is left for the batcher's own flush
+	reconciler.handleRecreationCheck(context.Background(), logr.Discard(), monitor, cronJobNN, "current-uid")
+	assert.Equal(t, 1, batcher.Len())
+	assert.Empty(t, mockStore.DeletedUIDs)
+
+	// Recreated: buffered rows are written before the old UID is deleted
+	reconciler.handleRecreationCheck(context.Background(), logr.Discard(), monitor, cronJobNN, "new-uid")
+	assert.Equal(t, 0, batcher.Len())
+	assert.Len(t, mockStore.RecordedExecutions, 1)
+	assert.Equal(t, []string{"current-uid"}, mockStore.DeletedUIDs)
+}
+
 func TestDispatchAlerts_Success(t *testing.T) {
 	cronJob := createTestCronJob("failing-cron", "default")
 	job := createFailedJob("failing-cron-12345", "default", "failing-cron")
@@ -970,6 +2165,126 @@ func TestReconcile_SuccessClearsAlerts(t *testing.T) {
 	assert.True(t, foundJobFailed)
 }
 
+// newChainTestMonitor returns a monitor where load depends on extract
+func newChainTestMonitor() *guardianv1alpha1.CronJobMonitor {
+	monitor := createTestMonitor("test-monitor", "default", nil)
+	monitor.Spec.Dependencies = []guardianv1alpha1.CronJobDependency{
+		{
+			CronJob:   guardianv1alpha1.CronJobReference{Name: "load"},
+			DependsOn: []guardianv1alpha1.CronJobReference{{Name: "extract"}},
+		},
+	}
+	return monitor
+}
+
+func TestReconcile_DownstreamFailureIsDowngraded(t *testing.T) {
+	cronJob := createTestCronJob("load", "default")
+	job := createFailedJob("load-12345", "default", "load")
+
+	fakeClient := newJobTestClient(cronJob, job, newChainTestMonitor())
+	// The upstream's last execution failed
+	mockStore := &testutil.MockStore{
+		LastExecution: &store.Execution{CronJobNamespace: "default", CronJobName: "extract", Succeeded: false},
+	}
+	mockDispatcher := testutil.NewMockDispatcher()
+
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           mockStore,
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "load-12345", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "JobFailed", alert.Type)
+	assert.Equal(t, "warning", alert.Severity, "critical should be downgraded")
+	assert.Equal(t, []string{"default/extract"}, alert.Context.UpstreamFailures)
+	assert.Contains(t, alert.Message, "Downstream failure")
+}
+
+func TestReconcile_UpstreamFailureBreaksChain(t *testing.T) {
+	// Yearly against every 5 minutes, so the next runs never tie
+	tests := []struct {
+		name            string
+		extractSchedule string
+		loadSchedule    string
+		wantChainAlert  bool
+	}{
+		{"downstream runs before upstream reruns", "0 0 1 1 *", "*/5 * * * *", true},
+		{"upstream reruns first", "*/5 * * * *", "0 0 1 1 *", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extract := createTestCronJob("extract", "default")
+			extract.Spec.Schedule = tt.extractSchedule
+			load := createTestCronJob("load", "default")
+			load.Spec.Schedule = tt.loadSchedule
+			job := createFailedJob("extract-12345", "default", "extract")
+
+			fakeClient := newJobTestClient(extract, load, job, newChainTestMonitor())
+			mockDispatcher := testutil.NewMockDispatcher()
+
+			reconciler := &JobReconciler{
+				Client:          fakeClient,
+				Log:             logr.Discard(),
+				Scheme:          fakeClient.Scheme(),
+				Store:           &testutil.MockStore{},
+				AlertDispatcher: mockDispatcher,
+			}
+
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: "extract-12345", Namespace: "default"},
+			})
+			require.NoError(t, err)
+
+			var chainAlerts []alerting.Alert
+			for _, a := range mockDispatcher.DispatchedAlerts {
+				if a.Type == "ChainBroken" {
+					chainAlerts = append(chainAlerts, a)
+				}
+			}
+			if !tt.wantChainAlert {
+				assert.Empty(t, chainAlerts)
+				return
+			}
+			require.Len(t, chainAlerts, 1)
+			assert.Equal(t, "default/load/ChainBroken", chainAlerts[0].Key)
+			assert.Equal(t, "warning", chainAlerts[0].Severity)
+			assert.Equal(t, []string{"default/extract"}, chainAlerts[0].Context.UpstreamFailures)
+		})
+	}
+}
+
+func TestReconcile_UpstreamSuccessClearsChainBroken(t *testing.T) {
+	cronJob := createTestCronJob("extract", "default")
+	job := createCompletedJob("extract-12345", "default", "extract")
+
+	fakeClient := newJobTestClient(cronJob, job, newChainTestMonitor())
+	mockDispatcher := testutil.NewMockDispatcher()
+
+	reconciler := &JobReconciler{
+		Client:          fakeClient,
+		Log:             logr.Discard(),
+		Scheme:          fakeClient.Scheme(),
+		Store:           &testutil.MockStore{},
+		AlertDispatcher: mockDispatcher,
+	}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "extract-12345", Namespace: "default"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/load/ChainBroken")
+}
+
 func TestReconcile_WithRetryLabel(t *testing.T) {
 	cronJob := createTestCronJob("retry-cron", "default")
 	now := metav1.Now()
@@ -1176,3 +2491,149 @@ func TestBuildExecution_StoreLogs_GlobalConfig(t *testing.T) {
 	reconciler.Config = nil
 	assert.False(t, reconciler.shouldStoreLogs(monitor))
 }
+
+func TestReconcile_JobFinishedBeforeStartup(t *testing.T) {
+	cronJob := createTestCronJob("down-cron", "default")
+	job := createCompletedJob("down-cron-12345", "default", "down-cron")
+	monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+		MatchLabels: map[string]string{"app": "down-cron"},
+	})
+
+	fakeClient := newJobTestClient(cronJob, job, monitor)
+	mockStore := &testutil.MockStore{}
+
+	reconciler := &JobReconciler{
+		Client:    fakeClient,
+		Log:       logr.Discard(),
+		Scheme:    fakeClient.Scheme(),
+		Store:     mockStore,
+		startedAt: time.Now().Add(time.Minute),
+	}
+
+	req := ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "down-cron-12345", Namespace: "default"},
+	}
+
+	// Not recorded yet: recorded as backfilled
+	_, err := reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, mockStore.RecordedExecutions, 1)
+	assert.True(t, mockStore.RecordedExecutions[0].Backfilled)
+
+	// Recorded before the restart: skipped
+	mockStore.ExecutionByJobName = &mockStore.RecordedExecutions[0]
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, mockStore.RecordedExecutions, 1)
+
+	// Finished after startup: recorded as usual
+	reconciler.startedAt = time.Now().Add(-time.Hour)
+	mockStore.ExecutionByJobName = nil
+	_, err = reconciler.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, mockStore.RecordedExecutions, 2)
+	assert.False(t, mockStore.RecordedExecutions[1].Backfilled)
+}
+
+func TestBackfill_RecordsMissedRun(t *testing.T) {
+	scheduled := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	completed := scheduled.Add(2 * time.Minute)
+
+	cronJob := createTestCronJob("gc-cron", "default")
+	cronJob.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+	cronJob.Status.LastSuccessfulTime = &metav1.Time{Time: completed}
+	monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+		MatchLabels: map[string]string{"app": "gc-cron"},
+	})
+
+	fakeClient := newJobTestClient(cronJob, monitor)
+	mockStore := &testutil.MockStore{}
+
+	reconciler := &JobReconciler{
+		Client:    fakeClient,
+		Log:       logr.Discard(),
+		Scheme:    fakeClient.Scheme(),
+		Store:     mockStore,
+		startedAt: time.Now(),
+	}
+
+	require.NoError(t, reconciler.Backfill(context.Background()))
+
+	require.Len(t, mockStore.RecordedExecutions, 1)
+	exec := mockStore.RecordedExecutions[0]
+	assert.Equal(t, "gc-cron-29455380", exec.JobName)
+	assert.Equal(t, "gc-cron", exec.CronJobName)
+	assert.True(t, exec.Succeeded)
+	assert.True(t, exec.Backfilled)
+	assert.True(t, scheduled.Equal(exec.StartTime))
+	assert.True(t, completed.Equal(exec.CompletionTime))
+}
+
+func TestBackfill_SkipsKnownRuns(t *testing.T) {
+	scheduled := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	completed := scheduled.Add(2 * time.Minute)
+
+	tests := []struct {
+		name   string
+		modify func(cronJob *batchv1.CronJob, r *JobReconciler)
+		objs   []client.Object
+	}{
+		{
+			name: "later run scheduled",
+			modify: func(cronJob *batchv1.CronJob, _ *JobReconciler) {
+				cronJob.Status.LastScheduleTime = &metav1.Time{Time: completed.Add(time.Hour)}
+			},
+		},
+		{
+			name: "never succeeded",
+			modify: func(cronJob *batchv1.CronJob, _ *JobReconciler) {
+				cronJob.Status.LastSuccessfulTime = nil
+			},
+		},
+		{
+			name: "succeeded after startup",
+			modify: func(_ *batchv1.CronJob, r *JobReconciler) {
+				r.startedAt = completed.Add(-time.Minute)
+			},
+		},
+		{
+			name: "already recorded",
+			modify: func(_ *batchv1.CronJob, r *JobReconciler) {
+				r.Store.(*testutil.MockStore).ExecutionByJobName = &store.Execution{JobName: "gc-cron-29455380"}
+			},
+		},
+		{
+			name:   "job still exists",
+			modify: func(_ *batchv1.CronJob, _ *JobReconciler) {},
+			objs:   []client.Object{createCompletedJob("gc-cron-29455380", "default", "gc-cron")},
+		},
+		{
+			name: "not monitored",
+			modify: func(cronJob *batchv1.CronJob, _ *JobReconciler) {
+				cronJob.Labels = map[string]string{"app": "other"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := createTestCronJob("gc-cron", "default")
+			cronJob.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+			cronJob.Status.LastSuccessfulTime = &metav1.Time{Time: completed}
+			monitor := createTestMonitor("test-monitor", "default", &guardianv1alpha1.CronJobSelector{
+				MatchLabels: map[string]string{"app": "gc-cron"},
+			})
+			mockStore := &testutil.MockStore{}
+			reconciler := &JobReconciler{
+				Log:       logr.Discard(),
+				Store:     mockStore,
+				startedAt: time.Now(),
+			}
+			tt.modify(cronJob, reconciler)
+			reconciler.Client = newJobTestClient(append(tt.objs, cronJob, monitor)...)
+
+			require.NoError(t, reconciler.Backfill(context.Background()))
+			assert.Empty(t, mockStore.RecordedExecutions)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+const (
+	alertTypeOutputDropped = "OutputDropped"
+
+	// defaultOutputAnnotation is the annotation a run reports its output size in
+	defaultOutputAnnotation = "guardian.illenium.net/output-size"
+
+	// minOutputBaselineRuns is the number of earlier runs needed before a drop is alerted on
+	minOutputBaselineRuns = 3
+
+	// maxOutputBaselineRuns is the most earlier runs a monitor compares against
+	maxOutputBaselineRuns = 100
+)
+
+// outputAnnotation returns the annotation a monitor's runs report their output size in
+func outputAnnotation(output *guardianv1alpha1.OutputConfig) string {
+	if output.Annotation != "" {
+		return output.Annotation
+	}
+	return defaultOutputAnnotation
+}
+
+// outputSize returns the output size a run reported: the annotation on the
+// Job, else on one of its pods, else an "<annotation>=<size>" line in a
+// container's termination message. Sizes are quantities such as 1.5Gi or 120000.
+func outputSize(job *batchv1.Job, pods []corev1.Pod, annotation string) *float64 {
+	values := []string{job.Annotations[annotation]}
+	for i := range pods {
+		values = append(values, pods[i].Annotations[annotation])
+	}
+	for i := range pods {
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			if t := cs.State.Terminated; t != nil {
+				values = append(values, terminationMessageValue(t.Message, annotation))
+			}
+		}
+	}
+
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		size := q.AsApproximateFloat64()
+		return &size
+	}
+	return nil
+}
+
+// terminationMessageValue returns the value of a "key=value" line in a
+// termination message
+func terminationMessageValue(message, key string) string {
+	for line := range strings.Lines(message) {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(line), key+"="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// outputHistory returns the output sizes of the CronJob's earlier successful
+// runs, newest first, if a run reported one and a monitor tracks output
+func (h *JobReconciler) outputHistory(ctx context.Context, monitors []*guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution) []float64 {
+	if h.Store == nil || !exec.Succeeded || exec.OutputSize == nil {
+		return nil
+	}
+	tracked := slices.ContainsFunc(monitors, func(m *guardianv1alpha1.CronJobMonitor) bool {
+		return m.Spec.Output != nil
+	})
+	if !tracked {
+		return nil
+	}
+	sizes, err := h.Store.GetOutputSizes(ctx, cronJob, maxOutputBaselineRuns)
+	if err != nil {
+		h.Log.V(1).Error(err, "failed to get output sizes", "cronJob", cronJob)
+		return nil
+	}
+	return sizes
+}
+
+// checkOutputSize sends an OutputDropped alert when a successful run's output
+// is well below the median of earlier runs, and clears it otherwise
+func (h *JobReconciler) checkOutputSize(ctx context.Context, log logr.Logger, monitor *guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution, history []float64) {
+	output := monitor.Spec.Output
+	if output == nil || exec.OutputSize == nil || h.AlertDispatcher == nil {
+		return
+	}
+
+	baselineRuns := int(ptr.Deref(output.BaselineRuns, 10))
+	if len(history) > baselineRuns {
+		history = history[:baselineRuns]
+	}
+	if len(history) < minOutputBaselineRuns {
+		log.V(1).Info("not enough runs reported an output size yet", "runs", len(history))
+		return
+	}
+
+	median := outputMedian(history)
+	threshold := float64(ptr.Deref(output.DropThresholdPercent, 50))
+	size := *exec.OutputSize
+	alertKey := fmt.Sprintf("%s/%s/%s", cronJob.Namespace, cronJob.Name, alertTypeOutputDropped)
+
+	if median <= 0 || size >= median*(1-threshold/100) {
+		if err := h.AlertDispatcher.ClearAlert(ctx, alertKey); err == nil {
+			log.V(1).Info("cleared alert on normal output size", "alertKey", alertKey)
+		}
+		if h.Store != nil {
+			_ = h.Store.ResolveAlert(ctx, alertTypeOutputDropped, cronJob.Namespace, cronJob.Name)
+		}
+		return
+	}
+
+	drop := (1 - size/median) * 100
+	alert := alerting.Alert{
+		Key:      alertKey,
+		Type:     alertTypeOutputDropped,
+		Severity: monitor.Spec.Alerting.SeverityFor(alertTypeOutputDropped, statusWarning),
+		Title:    fmt.Sprintf("Output dropped: %s/%s", cronJob.Namespace, cronJob.Name),
+		Message: fmt.Sprintf("Job %s succeeded with an output size of %s, %.0f%% below the median of %s over the last %d runs.",
+			exec.JobName, formatOutputSize(size), drop, formatOutputSize(median), len(history)),
+		CronJob: cronJob,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Timestamp: time.Now(),
+	}
+	log.Info("output size dropped", "job", exec.JobName, "size", size, "median", median)
+	if err := h.AlertDispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+		log.Error(err, "failed to dispatch output dropped alert")
+	}
+}
+
+// outputMedian returns the median of output sizes
+func outputMedian(sizes []float64) float64 {
+	sorted := slices.Sorted(slices.Values(sizes))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// formatOutputSize formats an output size in plain decimal notation
+func formatOutputSize(size float64) string {
+	return strconv.FormatFloat(size, 'f', -1, 64)
+}
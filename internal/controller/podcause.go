@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Failure reasons recorded when a pod was stopped from outside rather than
+// failing on its own. They replace the generic reason of its containers.
+const (
+	reasonOOMKilled = "OOMKilled"
+	reasonEvicted   = "Evicted"
+	reasonPreempted = "Preempted"
+	reasonNodeLost  = "NodeLost"
+)
+
+// Failure reasons recorded for a Job that never ran a pod
+const (
+	reasonUnschedulable = "Unschedulable"
+	reasonQuotaExceeded = "QuotaExceeded"
+)
+
+// neverStarted reports whether a failure reason means the Job never ran a pod
+func neverStarted(reason string) bool {
+	return reason == reasonUnschedulable || reason == reasonQuotaExceeded
+}
+
+// disruptionCauses maps the reasons of a pod's DisruptionTarget condition to
+// failure reasons
+var disruptionCauses = map[string]string{
+	"PreemptionByScheduler":  reasonPreempted,
+	"DeletionByTaintManager": reasonNodeLost,
+	"DeletionByPodGC":        reasonNodeLost,
+	"EvictionByEvictionAPI":  reasonEvicted,
+	"TerminationByKubelet":   reasonEvicted,
+}
+
+// eventCauses maps the reasons of pod events to failure reasons, for clusters
+// that do not set the DisruptionTarget condition
+var eventCauses = map[string]string{
+	"Preempted":            reasonPreempted,
+	"NodeNotReady":         reasonNodeLost,
+	"TaintManagerEviction": reasonNodeLost,
+	"Evicted":              reasonEvicted,
+}
+
+// podFailureCause returns why a pod was stopped from outside: evicted,
+// preempted or lost with its node. It returns "" when the pod failed on its own.
+func (h *JobReconciler) podFailureCause(ctx context.Context, pod *corev1.Pod) string {
+	switch pod.Status.Reason {
+	case reasonEvicted, reasonPreempted, reasonNodeLost:
+		return pod.Status.Reason
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+			if cause, ok := disruptionCauses[cond.Reason]; ok {
+				return cause
+			}
+		}
+	}
+
+	events := &corev1.EventList{}
+	if err := h.List(ctx, events, client.InNamespace(pod.Namespace)); err != nil {
+		h.Log.V(1).Error(err, "failed to list events", "namespace", pod.Namespace)
+		return ""
+	}
+	for _, e := range events.Items {
+		if e.InvolvedObject.Kind != "Pod" || e.InvolvedObject.Name != pod.Name {
+			continue
+		}
+		if cause, ok := eventCauses[e.Reason]; ok {
+			return cause
+		}
+	}
+	return ""
+}
+
+// neverStartedCause returns why a failed Job never ran a pod: its pods could
+// not be scheduled, or not be created because of a ResourceQuota. It returns
+// "" when a pod of the Job was scheduled or the cause is unknown.
+func (h *JobReconciler) neverStartedCause(ctx context.Context, job *batchv1.Job, pods []corev1.Pod) string {
+	for i := range pods {
+		if !podUnschedulable(&pods[i]) {
+			return ""
+		}
+	}
+
+	// Pods deleted when the Job failed are gone, but their events are kept
+	events := &corev1.EventList{}
+	if err := h.List(ctx, events, client.InNamespace(job.Namespace)); err != nil {
+		h.Log.V(1).Error(err, "failed to list events", "namespace", job.Namespace)
+		return ""
+	}
+	var unschedulable, quotaExceeded bool
+	for _, e := range events.Items {
+		switch {
+		case e.InvolvedObject.Kind == "Pod" && strings.HasPrefix(e.InvolvedObject.Name, job.Name+"-"):
+			if e.Reason == "Scheduled" {
+				return ""
+			}
+			unschedulable = unschedulable || e.Reason == "FailedScheduling"
+		case e.InvolvedObject.Kind == "Job" && e.InvolvedObject.Name == job.Name:
+			quotaExceeded = quotaExceeded || (e.Reason == "FailedCreate" && strings.Contains(e.Message, "exceeded quota"))
+		}
+	}
+
+	switch {
+	case len(pods) > 0 || unschedulable:
+		return reasonUnschedulable
+	case quotaExceeded:
+		return reasonQuotaExceeded
+	default:
+		return ""
+	}
+}
+
+// podUnschedulable reports whether the scheduler found no node for a pod
+func podUnschedulable(pod *corev1.Pod) bool {
+	if pod.Spec.NodeName != "" {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled {
+			return cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable
+		}
+	}
+	return false
+}
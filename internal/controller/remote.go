@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// RemoteCluster is a cluster monitored through a kubeconfig instead of the
+// one the manager runs in. Its controllers run in the manager (and follow
+// its leader election) but watch the remote cluster's cache.
+type RemoteCluster struct {
+	// Name tags the cluster's executions and alerts
+	Name string
+
+	cluster.Cluster
+}
+
+// clusterName returns the name executions of rc are tagged with, empty for
+// the local cluster (nil)
+func (rc *RemoteCluster) clusterName() string {
+	if rc == nil {
+		return ""
+	}
+	return rc.Name
+}
+
+// source watches obj in the remote cluster
+func (rc *RemoteCluster) source(obj client.Object, h handler.EventHandler, preds ...predicate.Predicate) source.Source {
+	return source.Kind(rc.GetCache(), obj, h, preds...)
+}
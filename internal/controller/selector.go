@@ -1,8 +1,13 @@
 package controller
 
 import (
+	"context"
+
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 )
@@ -80,3 +85,97 @@ func MatchExpression(labelSet map[string]string, expr metav1.LabelSelectorRequir
 	}
 	return false
 }
+
+// FindMatchingCronJobs returns the CronJobs a monitor selects. Namespaces
+// whose CronJobs cannot be listed are skipped.
+func FindMatchingCronJobs(ctx context.Context, c client.Reader, monitor *guardianv1alpha1.CronJobMonitor) ([]batchv1.CronJob, error) {
+	logger := log.FromContext(ctx)
+
+	// Determine which namespaces to search
+	namespaces, err := TargetNamespaces(ctx, c, monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(1).Info("searching for CronJobs", "namespaces", namespaces)
+
+	var result []batchv1.CronJob
+	for _, ns := range namespaces {
+		cronJobList := &batchv1.CronJobList{}
+		if err := c.List(ctx, cronJobList, client.InNamespace(ns)); err != nil {
+			logger.Error(err, "failed to list CronJobs in namespace", "namespace", ns)
+			continue
+		}
+		logger.V(1).Info("found CronJobs in namespace", "namespace", ns, "count", len(cronJobList.Items))
+
+		for _, cj := range cronJobList.Items {
+			if MatchesSelector(&cj, monitor.Spec.Selector) {
+				logger.V(1).Info("CronJob matches selector", "namespace", ns, "cronJob", cj.Name)
+				result = append(result, cj)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// TargetNamespaces determines which namespaces to search based on the selector
+func TargetNamespaces(ctx context.Context, c client.Reader, monitor *guardianv1alpha1.CronJobMonitor) ([]string, error) {
+	selector := monitor.Spec.Selector
+
+	// No selector or empty selector - use monitor's namespace
+	if selector == nil {
+		return []string{monitor.Namespace}, nil
+	}
+
+	// AllNamespaces takes precedence
+	if selector.AllNamespaces {
+		return allNamespaces(ctx, c)
+	}
+
+	// Explicit namespace list
+	if len(selector.Namespaces) > 0 {
+		return selector.Namespaces, nil
+	}
+
+	// Namespace label selector
+	if selector.NamespaceSelector != nil {
+		return namespacesBySelector(ctx, c, selector.NamespaceSelector)
+	}
+
+	// Default: monitor's own namespace
+	return []string{monitor.Namespace}, nil
+}
+
+// allNamespaces returns all namespace names in the cluster
+func allNamespaces(ctx context.Context, c client.Reader) ([]string, error) {
+	nsList := &corev1.NamespaceList{}
+	if err := c.List(ctx, nsList); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		namespaces = append(namespaces, ns.Name)
+	}
+	return namespaces, nil
+}
+
+// namespacesBySelector returns namespaces matching the label selector
+func namespacesBySelector(ctx context.Context, c client.Reader, selector *metav1.LabelSelector) ([]string, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	nsList := &corev1.NamespaceList{}
+	if err := c.List(ctx, nsList, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		namespaces = append(namespaces, ns.Name)
+	}
+	return namespaces, nil
+}
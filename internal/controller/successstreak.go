@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// defaultLongSuccessStreak is how long a CronJob must have succeeded for its
+// next failure to be highlighted, unless the monitor sets longSuccessStreak
+const defaultLongSuccessStreak = 30 * 24 * time.Hour
+
+// runStreaks are the streaks of earlier runs a failed run continues or ends
+type runStreaks struct {
+	failures  int                 // Consecutive failures ending with this run, 0 if unknown
+	successes store.SuccessStreak // Consecutive successes right before this run
+}
+
+// longSuccessStreak returns how long a CronJob must have succeeded for the
+// monitor to highlight its next failure, 0 if it never does
+func longSuccessStreak(monitor *guardianv1alpha1.CronJobMonitor) time.Duration {
+	if monitor.Spec.Alerting == nil || monitor.Spec.Alerting.LongSuccessStreak == nil {
+		return defaultLongSuccessStreak
+	}
+	return monitor.Spec.Alerting.LongSuccessStreak.Duration
+}
+
+// successStreak returns the successful runs this failed run ends, or an empty
+// streak if it is unknown or not needed by any monitor. Like failureStreak it
+// must be read before this run is recorded.
+func (h *JobReconciler) successStreak(ctx context.Context, monitors []*guardianv1alpha1.CronJobMonitor, cronJob types.NamespacedName, exec store.Execution) store.SuccessStreak {
+	if exec.Succeeded || h.Store == nil {
+		return store.SuccessStreak{}
+	}
+	needed := false
+	for _, monitor := range monitors {
+		if longSuccessStreak(monitor) > 0 {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return store.SuccessStreak{}
+	}
+
+	streak, err := h.Store.GetSuccessStreak(ctx, cronJob, exec.StartTime)
+	if err != nil {
+		h.Log.V(1).Error(err, "failed to get success streak", "cronJob", cronJob)
+		return store.SuccessStreak{}
+	}
+	return streak
+}
+
+// endsLongSuccessStreak reports whether a failure at failedAt ends a success
+// streak long enough for the monitor to highlight it
+func endsLongSuccessStreak(monitor *guardianv1alpha1.CronJobMonitor, streak store.SuccessStreak, failedAt time.Time) bool {
+	threshold := longSuccessStreak(monitor)
+	return threshold > 0 && streak.Runs > 0 && failedAt.Sub(streak.Since) >= threshold
+}
+
+// highlightSuccessStreak marks a failure alert that ends an unusually long
+// success streak, since those failures are the ones that most need eyes
+func highlightSuccessStreak(alert *alerting.Alert, streak store.SuccessStreak, failedAt time.Time) {
+	alert.Context.SuccessStreakRuns = streak.Runs
+	alert.Context.SuccessStreakSince = streak.Since
+	alert.Message += fmt.Sprintf("\n\nFirst failure in %s, after %d successful run(s).",
+		formatStreakDuration(failedAt.Sub(streak.Since)), streak.Runs)
+}
+
+// formatStreakDuration formats the length of a success streak rounded to
+// days, or hours for streaks shorter than two days
+func formatStreakDuration(d time.Duration) string {
+	if days := int(d.Round(24*time.Hour) / (24 * time.Hour)); days >= 2 {
+		return fmt.Sprintf("%d days", days)
+	}
+	return fmt.Sprintf("%d hours", int(d.Round(time.Hour)/time.Hour))
+}
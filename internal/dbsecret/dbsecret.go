@@ -0,0 +1,131 @@
+// Package dbsecret reads the database password from a Kubernetes Secret and
+// watches it for rotation.
+package dbsecret
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// namespaceFile holds the namespace of the pod's service account
+var namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Ref identifies the Secret key holding the password
+type Ref struct {
+	// Namespace of the Secret (empty = the operator's namespace)
+	Namespace string
+	// Name of the Secret
+	Name string
+	// Key in the Secret
+	Key string
+}
+
+// resolve fills in the operator's namespace when none is set
+func (r Ref) resolve() (Ref, error) {
+	if r.Namespace != "" {
+		return r, nil
+	}
+	data, err := os.ReadFile(namespaceFile)
+	if err != nil {
+		return r, fmt.Errorf("password secret namespace not set and operator namespace unknown: %w", err)
+	}
+	r.Namespace = strings.TrimSpace(string(data))
+	return r, nil
+}
+
+// Read returns the password stored in the Secret
+func Read(ctx context.Context, c client.Reader, ref Ref) (string, error) {
+	ref, err := ref.resolve()
+	if err != nil {
+		return "", err
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get password secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok || len(value) == 0 {
+		return "", fmt.Errorf("key %s not found in password secret %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+	return string(value), nil
+}
+
+// Watcher polls the password Secret and calls onChange with the new password
+// when it rotates. If onChange fails (e.g. the database does not accept the
+// new password yet), the change is retried on the next poll.
+type Watcher struct {
+	reader   client.Reader
+	ref      Ref
+	interval time.Duration
+	onChange func(ctx context.Context, password string) error
+
+	mu      sync.Mutex
+	current string
+}
+
+// NewWatcher creates a watcher for ref. current is the password in use.
+func NewWatcher(reader client.Reader, ref Ref, interval time.Duration, current string, onChange func(ctx context.Context, password string) error) *Watcher {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Watcher{
+		reader:   reader,
+		ref:      ref,
+		interval: interval,
+		onChange: onChange,
+		current:  current,
+	}
+}
+
+// Start implements manager.Runnable
+func (w *Watcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Check(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection returns false: every replica holds its own connections
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Check reads the Secret once and applies a changed password
+func (w *Watcher) Check(ctx context.Context) {
+	logger := log.FromContext(ctx).WithValues("secret", w.ref.Name)
+
+	password, err := Read(ctx, w.reader, w.ref)
+	if err != nil {
+		logger.Error(err, "failed to read database password secret")
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if password == w.current {
+		return
+	}
+	if err := w.onChange(ctx, password); err != nil {
+		logger.Error(err, "failed to apply rotated database password, will retry")
+		return
+	}
+	w.current = password
+	logger.Info("reconnected to the database with the rotated password")
+}
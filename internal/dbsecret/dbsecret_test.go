@@ -0,0 +1,84 @@
+package dbsecret
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func passwordSecret(password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "guardian", Name: "postgres-credentials"},
+		Data:       map[string][]byte{"password": []byte(password)},
+	}
+}
+
+func TestRead(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithObjects(passwordSecret("hunter2")).Build()
+
+	password, err := Read(ctx, c, Ref{Namespace: "guardian", Name: "postgres-credentials", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", password)
+
+	_, err = Read(ctx, c, Ref{Namespace: "guardian", Name: "postgres-credentials", Key: "pass"})
+	assert.ErrorContains(t, err, "key pass not found")
+
+	_, err = Read(ctx, c, Ref{Namespace: "guardian", Name: "missing", Key: "password"})
+	assert.ErrorContains(t, err, "failed to get password secret guardian/missing")
+}
+
+func TestRead_OperatorNamespace(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "namespace")
+	require.NoError(t, os.WriteFile(file, []byte("guardian\n"), 0o600))
+	orig := namespaceFile
+	namespaceFile = file
+	t.Cleanup(func() { namespaceFile = orig })
+
+	c := fake.NewClientBuilder().WithObjects(passwordSecret("hunter2")).Build()
+	password, err := Read(context.Background(), c, Ref{Name: "postgres-credentials", Key: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", password)
+}
+
+func TestWatcher_Check(t *testing.T) {
+	ctx := context.Background()
+	secret := passwordSecret("old")
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	var applied []string
+	fail := false
+	w := NewWatcher(c, Ref{Namespace: "guardian", Name: "postgres-credentials", Key: "password"}, 0, "old",
+		func(_ context.Context, password string) error {
+			applied = append(applied, password)
+			if fail {
+				return errors.New("password authentication failed")
+			}
+			return nil
+		})
+
+	// Unchanged password: nothing to do
+	w.Check(ctx)
+	assert.Empty(t, applied)
+
+	secret.Data["password"] = []byte("new")
+	require.NoError(t, c.Update(ctx, secret))
+
+	// The database does not accept it yet, so it is retried
+	fail = true
+	w.Check(ctx)
+	fail = false
+	w.Check(ctx)
+	w.Check(ctx)
+	assert.Equal(t, []string{"new", "new"}, applied)
+	assert.False(t, w.NeedLeaderElection())
+}
@@ -0,0 +1,263 @@
+// Package disruption detects cluster-wide infrastructure disruptions, such as
+// node drains and cluster upgrades, so the CronJob failures they cause can be
+// told apart from failures of the CronJobs themselves.
+package disruption
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+// Status is the disruption state of the cluster
+type Status struct {
+	// Active is true from when a disruption is detected until its cooldown ends
+	Active bool
+	// Since is when the disruption was detected
+	Since time.Time
+	// UnavailableNodes are the nodes seen cordoned or not ready during the disruption
+	UnavailableNodes []string
+	// EvictedPods is the number of pods evicted within the window
+	EvictedPods int
+}
+
+// Detector checks the cluster's nodes and pod evictions for a disruption
+// every interval. A nil Detector never reports one.
+type Detector struct {
+	client client.Client
+	cfg    config.ClusterDisruptionConfig
+
+	mu        sync.RWMutex
+	since     time.Time               // start of the current disruption, zero if none
+	calmSince time.Time               // when its conditions stopped holding, zero while they hold
+	nodes     map[string]bool         // nodes unavailable during the current disruption
+	evictions map[types.UID]time.Time // evicted pods, by when they were first seen
+}
+
+// NewDetector creates a disruption detector reading nodes and pods with the
+// given client
+func NewDetector(c client.Client, cfg config.ClusterDisruptionConfig) *Detector {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	return &Detector{
+		client:    c,
+		cfg:       cfg,
+		nodes:     make(map[string]bool),
+		evictions: make(map[types.UID]time.Time),
+	}
+}
+
+// Start checks the cluster every interval until ctx is done
+func (d *Detector) Start(ctx context.Context) error {
+	log.FromContext(ctx).Info("starting cluster disruption detector", "interval", d.cfg.Interval)
+
+	d.check(ctx, time.Now())
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			d.check(ctx, now)
+		}
+	}
+}
+
+// check updates the disruption state from the cluster's nodes and pods
+func (d *Detector) check(ctx context.Context, now time.Time) {
+	logger := log.FromContext(ctx)
+
+	unavailable, err := d.unavailableNodes(ctx)
+	if err != nil {
+		logger.Error(err, "failed to list nodes")
+		return
+	}
+	evicted, err := d.evictedPods(ctx)
+	if err != nil {
+		logger.Error(err, "failed to list pods")
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Evicted pods are kept until they are gone, so ones lingering past the
+	// window are not counted again
+	present := make(map[types.UID]bool, len(evicted))
+	for _, uid := range evicted {
+		present[uid] = true
+		if _, seen := d.evictions[uid]; !seen {
+			d.evictions[uid] = now
+		}
+	}
+	for uid := range d.evictions {
+		if !present[uid] {
+			delete(d.evictions, uid)
+		}
+	}
+	recent := d.recentEvictions(now)
+
+	disrupted := (d.cfg.MinUnavailableNodes > 0 && len(unavailable) >= d.cfg.MinUnavailableNodes) ||
+		(d.cfg.MinEvictedPods > 0 && recent >= d.cfg.MinEvictedPods)
+
+	switch {
+	case disrupted:
+		if d.since.IsZero() {
+			d.since = now
+			logger.Info("cluster disruption detected, failures on disrupted nodes or of evicted pods are attributed to it",
+				"unavailableNodes", unavailable, "evictedPods", recent, "suppressAlerts", d.cfg.SuppressAlerts)
+			metrics.SetClusterDisruption(true)
+		}
+		d.calmSince = time.Time{}
+		for _, name := range unavailable {
+			d.nodes[name] = true
+		}
+	case d.since.IsZero():
+	case d.calmSince.IsZero():
+		d.calmSince = now
+	case now.Sub(d.calmSince) >= d.cfg.Cooldown:
+		logger.Info("cluster disruption ended", "duration", now.Sub(d.since).Round(time.Second))
+		d.since, d.calmSince = time.Time{}, time.Time{}
+		d.nodes = make(map[string]bool)
+		metrics.SetClusterDisruption(false)
+	}
+}
+
+// recentEvictions returns the number of pods first seen evicted within the window
+func (d *Detector) recentEvictions(now time.Time) int {
+	recent := 0
+	for _, seen := range d.evictions {
+		if now.Sub(seen) <= d.cfg.Window {
+			recent++
+		}
+	}
+	return recent
+}
+
+// unavailableNodes returns the names of the nodes that are cordoned or not ready
+func (d *Detector) unavailableNodes(ctx context.Context) ([]string, error) {
+	nodes := &corev1.NodeList{}
+	if err := d.client.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+	var unavailable []string
+	for i := range nodes.Items {
+		if !nodeAvailable(&nodes.Items[i]) {
+			unavailable = append(unavailable, nodes.Items[i].Name)
+		}
+	}
+	return unavailable, nil
+}
+
+// evictedPods returns the UIDs of the pods that are evicted or being evicted
+func (d *Detector) evictedPods(ctx context.Context) ([]types.UID, error) {
+	pods := &corev1.PodList{}
+	if err := d.client.List(ctx, pods); err != nil {
+		return nil, err
+	}
+	var evicted []types.UID
+	for i := range pods.Items {
+		if PodEvicted(&pods.Items[i]) {
+			evicted = append(evicted, pods.Items[i].UID)
+		}
+	}
+	return evicted, nil
+}
+
+// nodeAvailable reports whether a node is schedulable and ready
+func nodeAvailable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PodEvicted reports whether a pod was evicted, by the kubelet under node
+// pressure or through the eviction API, e.g. by a node drain
+func PodEvicted(pod *corev1.Pod) bool {
+	if pod.Status.Reason == "Evicted" {
+		return true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.DisruptionTarget && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// Active reports whether the cluster is going through a disruption
+func (d *Detector) Active() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return !d.since.IsZero()
+}
+
+// Status returns the disruption state of the cluster
+func (d *Detector) Status() Status {
+	if d == nil {
+		return Status{}
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	status := Status{
+		Active:      !d.since.IsZero(),
+		Since:       d.since,
+		EvictedPods: d.recentEvictions(time.Now()),
+	}
+	for name := range d.nodes {
+		status.UnavailableNodes = append(status.UnavailableNodes, name)
+	}
+	slices.Sort(status.UnavailableNodes)
+	return status
+}
+
+// Caused reports whether a failed run was caused by the current disruption:
+// one of its pods was evicted or ran on a node that became unavailable
+func (d *Detector) Caused(exec store.Execution, pods []corev1.Pod) bool {
+	if d == nil || exec.Succeeded {
+		return false
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.since.IsZero() {
+		return false
+	}
+	if d.nodes[exec.NodeName] {
+		return true
+	}
+	for i := range pods {
+		if PodEvicted(&pods[i]) || d.nodes[pods[i].Spec.NodeName] {
+			return true
+		}
+	}
+	return false
+}
+
+// SuppressAlerts reports whether failures caused by a disruption should not alert
+func (d *Detector) SuppressAlerts() bool {
+	return d != nil && d.cfg.SuppressAlerts
+}
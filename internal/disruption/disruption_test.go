@@ -0,0 +1,151 @@
+package disruption
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func testConfig() config.ClusterDisruptionConfig {
+	return config.ClusterDisruptionConfig{
+		Enabled:             true,
+		MinUnavailableNodes: 1,
+		MinEvictedPods:      3,
+		Window:              10 * time.Minute,
+		Cooldown:            15 * time.Minute,
+	}
+}
+
+func node(name string, cordoned, ready bool) *corev1.Node {
+	status := corev1.ConditionTrue
+	if !ready {
+		status = corev1.ConditionFalse
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Unschedulable: cordoned},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: status},
+		}},
+	}
+}
+
+func evictedPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID(name)},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "EvictionByEvictionAPI"},
+		}},
+	}
+}
+
+func newDetector(cfg config.ClusterDisruptionConfig, objs ...client.Object) *Detector {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(objs...).Build()
+	return NewDetector(c, cfg)
+}
+
+func TestDetector_CordonedNodes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	d := newDetector(testConfig(), node("node-a", true, true), node("node-b", false, true))
+
+	d.check(ctx, now)
+	require.True(t, d.Active())
+	status := d.Status()
+	assert.Equal(t, []string{"node-a"}, status.UnavailableNodes)
+	assert.Equal(t, now, status.Since)
+
+	failed := store.Execution{NodeName: "node-a"}
+	assert.True(t, d.Caused(failed, nil))
+	assert.False(t, d.Caused(store.Execution{NodeName: "node-b"}, nil))
+	assert.False(t, d.Caused(store.Execution{NodeName: "node-a", Succeeded: true}, nil))
+	assert.True(t, d.Caused(store.Execution{}, []corev1.Pod{*evictedPod("job-pod")}))
+}
+
+func TestDetector_NotReadyNodes(t *testing.T) {
+	cfg := testConfig()
+	cfg.MinUnavailableNodes = 2
+	d := newDetector(cfg, node("node-a", false, false), node("node-b", false, true))
+
+	d.check(context.Background(), time.Now())
+	assert.False(t, d.Active())
+	assert.False(t, d.Caused(store.Execution{NodeName: "node-a"}, nil))
+}
+
+func TestDetector_EvictedPods(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cfg := testConfig()
+	cfg.MinUnavailableNodes = 0
+	objs := []client.Object{node("node-a", true, true)}
+	for i := range 2 {
+		objs = append(objs, evictedPod(fmt.Sprintf("evicted-%d", i)))
+	}
+	d := newDetector(cfg, objs...)
+
+	d.check(ctx, now)
+	assert.False(t, d.Active(), "cordoned nodes are ignored and too few pods were evicted")
+
+	require.NoError(t, d.client.Create(ctx, evictedPod("evicted-2")))
+	d.check(ctx, now.Add(time.Minute))
+	assert.True(t, d.Active())
+	assert.Equal(t, 3, d.recentEvictions(now.Add(time.Minute)))
+
+	// Evicted pods lingering past the window are not counted again
+	assert.Equal(t, 1, d.recentEvictions(now.Add(11*time.Minute)))
+	d.check(ctx, now.Add(12*time.Minute))
+	assert.Equal(t, 0, d.recentEvictions(now.Add(12*time.Minute)))
+	assert.Len(t, d.evictions, 3)
+}
+
+func TestDetector_Cooldown(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cordoned := node("node-a", true, true)
+	d := newDetector(testConfig(), cordoned)
+
+	d.check(ctx, now)
+	require.True(t, d.Active())
+
+	// The node is uncordoned; the disruption lasts through the cooldown
+	cordoned.Spec.Unschedulable = false
+	require.NoError(t, d.client.Update(ctx, cordoned))
+	d.check(ctx, now.Add(time.Minute))
+	assert.True(t, d.Active())
+	assert.True(t, d.Caused(store.Execution{NodeName: "node-a"}, nil))
+
+	d.check(ctx, now.Add(10*time.Minute))
+	assert.True(t, d.Active())
+
+	d.check(ctx, now.Add(16*time.Minute))
+	assert.False(t, d.Active())
+	assert.Empty(t, d.Status().UnavailableNodes)
+	assert.False(t, d.Caused(store.Execution{NodeName: "node-a"}, nil))
+}
+
+func TestDetector_Nil(t *testing.T) {
+	var d *Detector
+	assert.False(t, d.Active())
+	assert.False(t, d.SuppressAlerts())
+	assert.False(t, d.Caused(store.Execution{NodeName: "node-a"}, nil))
+	assert.Equal(t, Status{}, d.Status())
+}
+
+func TestPodEvicted(t *testing.T) {
+	assert.True(t, PodEvicted(evictedPod("drained")))
+	assert.True(t, PodEvicted(&corev1.Pod{Status: corev1.PodStatus{Reason: "Evicted"}}))
+	assert.False(t, PodEvicted(&corev1.Pod{}))
+}
@@ -0,0 +1,163 @@
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Message formats
+const (
+	// FormatJSON wraps the payload in an Event envelope
+	FormatJSON = "json"
+	// FormatCloudEvents encodes the payload as a CloudEvents 1.0 structured-mode event
+	FormatCloudEvents = "cloudevents"
+)
+
+// CloudEvents attributes
+const (
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the structured-mode content type
+	CloudEventsContentType = "application/cloudevents+json"
+	// DefaultCloudEventsSource is the source attribute when none is configured
+	DefaultCloudEventsSource = "/cronjob-guardian"
+
+	CloudEventTypeAlert     = "net.illenium.guardian.alert"
+	CloudEventTypeExecution = "net.illenium.guardian.execution.completed"
+)
+
+// httpTimeout bounds a single POST to the sink
+const httpTimeout = 10 * time.Second
+
+// cloudEvent is a CloudEvents 1.0 event in the JSON event format.
+// Extension attributes are flattened next to the context attributes.
+type cloudEvent struct {
+	ID      string
+	Type    string
+	Subject string
+	Time    time.Time
+	Data    any
+	// Extensions are lowercase alphanumeric names with string values
+	Extensions map[string]string
+}
+
+// encode renders the event in the JSON event format with the given source
+func (e cloudEvent) encode(source string) map[string]any {
+	out := map[string]any{
+		"specversion":     CloudEventsSpecVersion,
+		"id":              e.ID,
+		"source":          source,
+		"type":            e.Type,
+		"time":            e.Time.UTC().Format(time.RFC3339Nano),
+		"datacontenttype": "application/json",
+		"data":            e.Data,
+	}
+	if e.Subject != "" {
+		out["subject"] = e.Subject
+	}
+	for k, v := range e.Extensions {
+		if v != "" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// cronJobSubject is the subject attribute of events about a CronJob
+func cronJobSubject(namespace, name string) string {
+	return "namespaces/" + namespace + "/cronjobs/" + name
+}
+
+// executionEventID returns a stable ID for an execution, so re-recorded
+// executions are deduplicated by the receiver
+func executionEventID(namespace, jobName string, completion time.Time) string {
+	sum := sha256.Sum256([]byte(namespace + "/" + jobName + "|" + completion.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:])
+}
+
+// HTTPConfig configures the HTTP publisher
+type HTTPConfig struct {
+	// URL is the sink events are POSTed to (e.g. a Knative Broker or Argo Events webhook)
+	URL string
+	// Token is sent as a bearer token when set
+	Token string
+}
+
+// HTTP publishes CloudEvents to a sink using the structured content mode of
+// the CloudEvents HTTP protocol binding. The topic and key are ignored.
+type HTTP struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHTTP creates an HTTP publisher
+func NewHTTP(cfg HTTPConfig) (*HTTP, error) {
+	if _, err := parseHTTPURL(cfg.URL, "http sink"); err != nil {
+		return nil, err
+	}
+	return &HTTP{
+		url:    cfg.URL,
+		token:  cfg.Token,
+		client: &http.Client{Timeout: httpTimeout},
+	}, nil
+}
+
+// Publish POSTs a structured-mode CloudEvent to the sink
+func (h *HTTP) Publish(ctx context.Context, _ string, _, value []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(value))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", CloudEventsContentType)
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	return checkStatus(resp, "sink")
+}
+
+// checkStatus returns an error with the start of the response body if an
+// HTTP response is not a 2xx
+func checkStatus(resp *http.Response, name string) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return fmt.Errorf("%s returned status %d: %s", name, resp.StatusCode, msg)
+		}
+		return fmt.Errorf("%s returned status %d", name, resp.StatusCode)
+	}
+	return nil
+}
+
+// parseHTTPURL checks that raw is an absolute http or https URL
+func parseHTTPURL(raw, name string) (*url.URL, error) {
+	if raw == "" {
+		return nil, fmt.Errorf("%s url is required", name)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid %s url %q", name, raw)
+	}
+	return u, nil
+}
+
+// Close is a no-op
+func (h *HTTP) Close() error {
+	return nil
+}
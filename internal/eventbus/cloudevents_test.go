@@ -0,0 +1,138 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestBus_CloudEvents(t *testing.T) {
+	type request struct {
+		contentType string
+		auth        string
+		event       map[string]any
+	}
+	requests := make(chan request, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		requests <- request{contentType: r.Header.Get("Content-Type"), auth: r.Header.Get("Authorization"), event: event}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	pub, err := NewHTTP(HTTPConfig{URL: server.URL, Token: "t0ken"})
+	require.NoError(t, err)
+	bus := NewBus(pub, "alerts", "executions", 10)
+	bus.UseCloudEvents("")
+
+	ctx := context.Background()
+	alert := alerting.Alert{
+		Key:       "prod/backup/JobFailed",
+		Type:      "JobFailed",
+		Severity:  "critical",
+		CronJob:   types.NamespacedName{Namespace: "prod", Name: "backup"},
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	bus.PublishAlert(ctx, alert)
+	bus.PublishExecution(ctx, store.Execution{
+		CronJobNamespace: "prod",
+		CronJobName:      "backup",
+		JobName:          "backup-123",
+		Succeeded:        true,
+		CompletionTime:   time.Date(2026, 1, 2, 3, 5, 0, 0, time.UTC),
+	})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+	require.Len(t, requests, 2)
+
+	alertReq := <-requests
+	assert.Equal(t, CloudEventsContentType, alertReq.contentType)
+	assert.Equal(t, "Bearer t0ken", alertReq.auth)
+	assert.Equal(t, "1.0", alertReq.event["specversion"])
+	assert.Equal(t, alerting.AlertEventID(alert), alertReq.event["id"])
+	assert.Equal(t, DefaultCloudEventsSource, alertReq.event["source"])
+	assert.Equal(t, CloudEventTypeAlert, alertReq.event["type"])
+	assert.Equal(t, "namespaces/prod/cronjobs/backup", alertReq.event["subject"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", alertReq.event["time"])
+	assert.Equal(t, "application/json", alertReq.event["datacontenttype"])
+	assert.Equal(t, "JobFailed", alertReq.event["alerttype"])
+	assert.Equal(t, "critical", alertReq.event["severity"])
+	assert.Equal(t, "prod/backup/JobFailed", alertReq.event["data"].(map[string]any)["key"])
+
+	execReq := <-requests
+	assert.Equal(t, CloudEventTypeExecution, execReq.event["type"])
+	assert.Equal(t, "succeeded", execReq.event["outcome"])
+	assert.NotEmpty(t, execReq.event["id"])
+	assert.Equal(t, "backup-123", execReq.event["data"].(map[string]any)["job"])
+}
+
+func TestBus_CloudEventsOverKafkaTopics(t *testing.T) {
+	pub := &fakePublisher{}
+	bus := NewBus(pub, "alerts", "executions", 10)
+	bus.UseCloudEvents("//guardian.example.com/prod-cluster")
+
+	bus.PublishExecution(context.Background(), store.Execution{CronJobNamespace: "prod", CronJobName: "backup", JobName: "a"})
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+
+	msgs := pub.published()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "executions", msgs[0].topic)
+	assert.Equal(t, "prod/backup", msgs[0].key)
+	assert.Equal(t, "//guardian.example.com/prod-cluster", msgs[0].event["source"])
+	assert.Equal(t, "failed", msgs[0].event["outcome"])
+}
+
+func TestHTTP_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "no trigger matched", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	pub, err := NewHTTP(HTTPConfig{URL: server.URL})
+	require.NoError(t, err)
+	err = pub.Publish(context.Background(), "", nil, []byte(`{}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no trigger matched")
+
+	_, err = NewHTTP(HTTPConfig{URL: "ftp://sink"})
+	assert.Error(t, err)
+}
+
+func TestResolveFormat(t *testing.T) {
+	tests := []struct {
+		busType, format string
+		want            string
+		wantErr         bool
+	}{
+		{busType: "kafka", want: FormatJSON},
+		{busType: "nats", format: FormatCloudEvents, want: FormatCloudEvents},
+		{busType: "http", want: FormatCloudEvents},
+		{busType: "http", format: FormatJSON, wantErr: true},
+		{busType: "kafka", format: "avro", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ResolveFormat(tt.busType, tt.format)
+		if tt.wantErr {
+			assert.Error(t, err, "%s/%s", tt.busType, tt.format)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
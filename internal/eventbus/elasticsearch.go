@@ -0,0 +1,226 @@
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ElasticsearchConfig configures the Elasticsearch and OpenSearch publisher
+type ElasticsearchConfig struct {
+	// URL is the cluster URL (e.g. https://elasticsearch.logging:9200)
+	URL string
+	// Username for basic authentication
+	Username string
+	// Password for basic authentication
+	Password string
+	// APIKey is an encoded API key, sent instead of basic authentication when set
+	APIKey string
+	// InstallTemplates puts an index template for each index before the first
+	// event is written to it
+	InstallTemplates bool
+}
+
+// Elasticsearch writes events to Elasticsearch or OpenSearch indices with the
+// bulk API. The topic is the index; the key is ignored.
+type Elasticsearch struct {
+	url              string
+	username         string
+	password         string
+	apiKey           string
+	installTemplates bool
+	client           *http.Client
+
+	mu        sync.Mutex
+	templates map[string]bool // Indices whose template is installed
+}
+
+// NewElasticsearch creates an Elasticsearch publisher
+func NewElasticsearch(cfg ElasticsearchConfig) (*Elasticsearch, error) {
+	if _, err := parseHTTPURL(cfg.URL, "elasticsearch"); err != nil {
+		return nil, err
+	}
+	return &Elasticsearch{
+		url:              strings.TrimSuffix(cfg.URL, "/"),
+		username:         cfg.Username,
+		password:         cfg.Password,
+		apiKey:           cfg.APIKey,
+		installTemplates: cfg.InstallTemplates,
+		client:           &http.Client{Timeout: httpTimeout},
+		templates:        make(map[string]bool),
+	}, nil
+}
+
+// indexTemplate returns the index template installed for an index. It maps
+// the event time and the payload timestamps as dates, long text fields as
+// text, and other strings as keywords, for the json and cloudevents formats.
+func indexTemplate(index string) map[string]any {
+	keyword := map[string]any{"type": "keyword", "ignore_above": 1024}
+	text := map[string]any{"type": "text"}
+	date := map[string]any{"type": "date"}
+	return map[string]any{
+		"index_patterns": []string{index, index + "-*"},
+		"priority":       200,
+		"template": map[string]any{
+			"mappings": map[string]any{
+				"dynamic_templates": []map[string]any{{
+					"strings_as_keywords": map[string]any{
+						"match_mapping_type": "string",
+						"mapping":            keyword,
+					},
+				}},
+				"properties": map[string]any{
+					"type": keyword,
+					"time": date,
+					"data": map[string]any{
+						"properties": map[string]any{
+							"title":            text,
+							"message":          text,
+							"timestamp":        date,
+							"scheduled_time":   date,
+							"start_time":       date,
+							"completion_time":  date,
+							"duration_seconds": map[string]any{"type": "double"},
+							"exit_code":        map[string]any{"type": "integer"},
+							"succeeded":        map[string]any{"type": "boolean"},
+							"suggested_fix":    text,
+							"context": map[string]any{
+								"properties": map[string]any{
+									"logs":          text,
+									"suggested_fix": text,
+									"success_rate":  map[string]any{"type": "double"},
+									"exit_code":     map[string]any{"type": "integer"},
+									"labels":        map[string]any{"type": "object", "dynamic": false},
+									"annotations":   map[string]any{"type": "object", "dynamic": false},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"_meta": map[string]any{"managed_by": "cronjob-guardian"},
+	}
+}
+
+// Publish writes one event
+func (e *Elasticsearch) Publish(ctx context.Context, topic string, key, value []byte) error {
+	return e.PublishBatch(ctx, []Message{{Topic: topic, Key: key, Value: value}})
+}
+
+// PublishBatch writes events with one bulk request. It fails if any event
+// was rejected.
+func (e *Elasticsearch) PublishBatch(ctx context.Context, msgs []Message) error {
+	var body bytes.Buffer
+	for _, msg := range msgs {
+		if err := e.ensureTemplate(ctx, msg.Topic); err != nil {
+			return err
+		}
+		// create works for both indices and data streams
+		action, _ := json.Marshal(map[string]any{"create": map[string]string{"_index": msg.Topic}})
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(msg.Value)
+		body.WriteByte('\n')
+	}
+
+	resp, err := e.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", &body)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkStatus(resp, "elasticsearch"); err != nil {
+		return err
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int `json:"status"`
+			Error  struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if !result.Errors {
+		return nil
+	}
+	var failed int
+	var first string
+	for _, item := range result.Items {
+		for _, op := range item {
+			if op.Status >= 300 {
+				failed++
+				if first == "" {
+					first = fmt.Sprintf("%s: %s", op.Error.Type, op.Error.Reason)
+				}
+			}
+		}
+	}
+	return fmt.Errorf("elasticsearch rejected %d of %d events: %s", failed, len(msgs), first)
+}
+
+// ensureTemplate installs the index template of an index once, if enabled
+func (e *Elasticsearch) ensureTemplate(ctx context.Context, index string) error {
+	if !e.installTemplates {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.templates[index] {
+		return nil
+	}
+
+	payload, err := json.Marshal(indexTemplate(index))
+	if err != nil {
+		return fmt.Errorf("failed to encode index template: %w", err)
+	}
+	resp, err := e.do(ctx, http.MethodPut, "/_index_template/"+index, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkStatus(resp, "elasticsearch"); err != nil {
+		return fmt.Errorf("failed to install index template %s: %w", index, err)
+	}
+	e.templates[index] = true
+	return nil
+}
+
+func (e *Elasticsearch) do(ctx context.Context, method, path, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, e.url+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	switch {
+	case e.apiKey != "":
+		req.Header.Set("Authorization", "ApiKey "+e.apiKey)
+	case e.username != "":
+		req.SetBasicAuth(e.username, e.password)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	return resp, nil
+}
+
+// Close is a no-op
+func (e *Elasticsearch) Close() error {
+	return nil
+}
@@ -0,0 +1,114 @@
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// fakeElasticsearch records bulk documents and installed index templates
+type fakeElasticsearch struct {
+	mu        sync.Mutex
+	bulks     int
+	actions   []map[string]any
+	docs      []map[string]any
+	templates map[string]map[string]any
+	auth      string
+	reject    bool
+}
+
+func (f *fakeElasticsearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = r.Header.Get("Authorization")
+	switch {
+	case r.Method == http.MethodPut && len(r.URL.Path) > len("/_index_template/"):
+		var template map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&template)
+		f.templates[r.URL.Path[len("/_index_template/"):]] = template
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/_bulk":
+		f.bulks++
+		scanner := bufio.NewScanner(r.Body)
+		var items []string
+		for i := 0; scanner.Scan(); i++ {
+			var line map[string]any
+			_ = json.Unmarshal(scanner.Bytes(), &line)
+			if i%2 == 0 {
+				f.actions = append(f.actions, line)
+				continue
+			}
+			f.docs = append(f.docs, line)
+			if f.reject {
+				items = append(items, `{"create":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}}`)
+			} else {
+				items = append(items, `{"create":{"status":201}}`)
+			}
+		}
+		resp := `{"errors":` + map[bool]string{true: "true", false: "false"}[f.reject] + `,"items":[`
+		for i, item := range items {
+			if i > 0 {
+				resp += ","
+			}
+			resp += item
+		}
+		_, _ = w.Write([]byte(resp + "]}"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestElasticsearch_BulkAndTemplates(t *testing.T) {
+	es := &fakeElasticsearch{templates: map[string]map[string]any{}}
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	pub, err := NewPublisher(Config{Type: "opensearch", Elasticsearch: ElasticsearchConfig{
+		URL: server.URL, Username: "guardian", Password: "s3cr3t", InstallTemplates: true,
+	}})
+	require.NoError(t, err)
+	bus := NewBus(pub, "guardian-alerts", "guardian-executions", 10)
+
+	ctx := context.Background()
+	for _, job := range []string{"backup-1", "backup-2", "backup-3"} {
+		bus.PublishExecution(ctx, store.Execution{CronJobNamespace: "prod", CronJobName: "backup", JobName: job})
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+
+	assert.Equal(t, 1, es.bulks, "queued events are written with one bulk request")
+	require.Len(t, es.docs, 3)
+	assert.Equal(t, map[string]any{"create": map[string]any{"_index": "guardian-executions"}}, es.actions[0])
+	assert.Equal(t, "backup-3", es.docs[2]["data"].(map[string]any)["job"])
+	assert.Contains(t, es.auth, "Basic ")
+
+	require.Contains(t, es.templates, "guardian-executions")
+	assert.NotContains(t, es.templates, "guardian-alerts", "templates are installed for indices written to")
+	assert.Equal(t, []any{"guardian-executions", "guardian-executions-*"}, es.templates["guardian-executions"]["index_patterns"])
+}
+
+func TestElasticsearch_RejectedEvents(t *testing.T) {
+	es := &fakeElasticsearch{templates: map[string]map[string]any{}, reject: true}
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	pub, err := NewElasticsearch(ElasticsearchConfig{URL: server.URL, APIKey: "a2V5"})
+	require.NoError(t, err)
+	err = pub.PublishBatch(context.Background(), []Message{
+		{Topic: "guardian-alerts", Value: []byte(`{"type":"alert"}`)},
+		{Topic: "guardian-alerts", Value: []byte(`{"type":"alert"}`)},
+	})
+	assert.ErrorContains(t, err, "rejected 2 of 2 events: mapper_parsing_exception: failed to parse")
+	assert.Equal(t, "ApiKey a2V5", es.auth)
+	assert.Empty(t, es.templates)
+}
@@ -0,0 +1,372 @@
+// Package eventbus publishes alerts and execution records as structured
+// events to Kafka, NATS, an HTTP CloudEvents sink, Splunk or Elasticsearch, so
+// they can be consumed by external data pipelines, event-driven automation
+// and log platforms.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Event types
+const (
+	EventTypeAlert     = "alert"
+	EventTypeExecution = "execution"
+)
+
+const (
+	// DefaultQueueSize is the number of events buffered before new events are dropped
+	DefaultQueueSize = 1000
+	// publishTimeout bounds a single publish
+	publishTimeout = 10 * time.Second
+	// finalDrainTimeout bounds publishing of queued events on shutdown
+	finalDrainTimeout = 10 * time.Second
+	// maxBatchSize is the most queued events a BatchPublisher writes at once
+	maxBatchSize = 100
+)
+
+// Publisher writes a message to a topic (Kafka) or subject (NATS)
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key, value []byte) error
+	Close() error
+}
+
+// BatchPublisher is implemented by publishers that write several messages in
+// one request (e.g. a bulk API). The bus hands it the events queued at the
+// time, up to maxBatchSize.
+type BatchPublisher interface {
+	Publisher
+	PublishBatch(ctx context.Context, msgs []Message) error
+}
+
+// Message is a queued event as handed to a BatchPublisher
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Event is the envelope of every published message
+type Event struct {
+	// Type is alert or execution
+	Type string `json:"type"`
+	// Time is when the alert fired or the execution completed
+	Time time.Time `json:"time"`
+	// Data is an alerting.AlertPayload or an ExecutionPayload
+	Data any `json:"data"`
+}
+
+// ExecutionPayload is the data of an execution event
+type ExecutionPayload struct {
+	Cluster         string     `json:"cluster,omitempty"`
+	Namespace       string     `json:"namespace"`
+	CronJob         string     `json:"cronjob"`
+	CronJobUID      string     `json:"cronjob_uid,omitempty"`
+	Job             string     `json:"job"`
+	ScheduledTime   *time.Time `json:"scheduled_time,omitempty"`
+	StartTime       time.Time  `json:"start_time"`
+	CompletionTime  time.Time  `json:"completion_time"`
+	DurationSeconds *float64   `json:"duration_seconds,omitempty"`
+	Succeeded       bool       `json:"succeeded"`
+	ExitCode        int32      `json:"exit_code"`
+	Reason          string     `json:"reason,omitempty"`
+	Classification  string     `json:"classification,omitempty"`
+	IsRetry         bool       `json:"is_retry"`
+	RetryOf         string     `json:"retry_of,omitempty"`
+	Node            string     `json:"node,omitempty"`
+	Images          []string   `json:"images,omitempty"`
+	SuggestedFix    string     `json:"suggested_fix,omitempty"`
+}
+
+// NewExecutionPayload converts an execution record to its published form.
+// Logs and events are left out to keep messages small.
+func NewExecutionPayload(exec store.Execution) ExecutionPayload {
+	return ExecutionPayload{
+		Cluster:         exec.Cluster,
+		Namespace:       exec.CronJobNamespace,
+		CronJob:         exec.CronJobName,
+		CronJobUID:      exec.CronJobUID,
+		Job:             exec.JobName,
+		ScheduledTime:   exec.ScheduledTime,
+		StartTime:       exec.StartTime,
+		CompletionTime:  exec.CompletionTime,
+		DurationSeconds: exec.DurationSecs,
+		Succeeded:       exec.Succeeded,
+		ExitCode:        exec.ExitCode,
+		Reason:          exec.Reason,
+		Classification:  exec.Classification,
+		IsRetry:         exec.IsRetry,
+		RetryOf:         exec.RetryOf,
+		Node:            exec.NodeName,
+		Images:          exec.GetImages(),
+		SuggestedFix:    exec.SuggestedFix,
+	}
+}
+
+type message struct {
+	eventType string
+	topic     string
+	key       []byte
+	value     []byte
+}
+
+// Bus queues events and publishes them in the background, so slow or
+// unavailable brokers never block alert dispatch or execution recording.
+// Events are dropped when the queue is full. It implements manager.Runnable
+// and alerting.AlertSink.
+type Bus struct {
+	publisher      Publisher
+	alertTopic     string
+	executionTopic string
+	queue          chan message
+	// source is the CloudEvents source attribute; empty publishes Event envelopes
+	source string
+}
+
+// NewBus creates a bus publishing alerts to alertTopic and executions to
+// executionTopic. An empty topic disables that event type.
+func NewBus(p Publisher, alertTopic, executionTopic string, queueSize int) *Bus {
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+	return &Bus{
+		publisher:      p,
+		alertTopic:     alertTopic,
+		executionTopic: executionTopic,
+		queue:          make(chan message, queueSize),
+	}
+}
+
+// UseCloudEvents publishes events as CloudEvents 1.0 structured-mode JSON
+// with the given source attribute (must be called before Start)
+func (b *Bus) UseCloudEvents(source string) {
+	if source == "" {
+		source = DefaultCloudEventsSource
+	}
+	b.source = source
+}
+
+// PublishAlert queues an alert event keyed by its CronJob
+func (b *Bus) PublishAlert(ctx context.Context, alert alerting.Alert) {
+	if b.alertTopic == "" {
+		return
+	}
+	key := alert.CronJob.Namespace + "/" + alert.CronJob.Name
+	b.enqueue(ctx, b.alertTopic, key, cloudEvent{
+		ID:      alerting.AlertEventID(alert),
+		Type:    EventTypeAlert,
+		Subject: cronJobSubject(alert.CronJob.Namespace, alert.CronJob.Name),
+		Time:    alert.Timestamp.UTC(),
+		Data:    alerting.NewAlertPayload(alert),
+		Extensions: map[string]string{
+			"alerttype": alert.Type,
+			"severity":  alert.Severity,
+		},
+	})
+}
+
+// PublishExecution queues an execution event keyed by its CronJob
+func (b *Bus) PublishExecution(ctx context.Context, exec store.Execution) {
+	if b.executionTopic == "" {
+		return
+	}
+	outcome := "failed"
+	if exec.Succeeded {
+		outcome = "succeeded"
+	}
+	key := exec.CronJobNamespace + "/" + exec.CronJobName
+	b.enqueue(ctx, b.executionTopic, key, cloudEvent{
+		ID:         executionEventID(exec.CronJobNamespace, exec.JobName, exec.CompletionTime),
+		Type:       EventTypeExecution,
+		Subject:    cronJobSubject(exec.CronJobNamespace, exec.CronJobName),
+		Time:       exec.CompletionTime.UTC(),
+		Data:       NewExecutionPayload(exec),
+		Extensions: map[string]string{"outcome": outcome},
+	})
+}
+
+// enqueue encodes the event and queues it. event.Type is the bus event type,
+// mapped to the CloudEvents type when publishing CloudEvents.
+func (b *Bus) enqueue(ctx context.Context, topic, key string, event cloudEvent) {
+	eventType := event.Type
+	var value []byte
+	var err error
+	if b.source != "" {
+		event.Type = CloudEventTypeAlert
+		if eventType == EventTypeExecution {
+			event.Type = CloudEventTypeExecution
+		}
+		value, err = json.Marshal(event.encode(b.source))
+	} else {
+		value, err = json.Marshal(Event{Type: eventType, Time: event.Time, Data: event.Data})
+	}
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to marshal event", "type", eventType)
+		return
+	}
+
+	select {
+	case b.queue <- message{eventType: eventType, topic: topic, key: []byte(key), value: value}:
+	default:
+		metrics.RecordEvent(eventType, metrics.EventResultDropped)
+		log.FromContext(ctx).Info("event bus queue full, dropping event", "type", eventType, "key", key)
+	}
+}
+
+// Start publishes queued events until ctx is cancelled, then publishes what
+// is left within a bounded time and closes the publisher
+func (b *Bus) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	logger.Info("starting event bus", "alertTopic", b.alertTopic, "executionTopic", b.executionTopic,
+		"cloudEvents", b.source != "")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return b.shutdown(nil)
+		case msg := <-b.queue:
+			if ctx.Err() != nil {
+				// Shutting down: publish with the drain context, not the cancelled one
+				return b.shutdown(&msg)
+			}
+			b.publish(ctx, b.batch(msg))
+		}
+	}
+}
+
+// shutdown publishes the pending message and the rest of the queue within
+// finalDrainTimeout, then closes the publisher
+func (b *Bus) shutdown(pending *message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), finalDrainTimeout)
+	defer cancel()
+	if pending != nil {
+		b.publish(ctx, b.batch(*pending))
+	}
+	b.drain(ctx)
+	return b.publisher.Close()
+}
+
+// NeedLeaderElection returns false: events are queued by whichever replica
+// records them and must be published even while not leading
+func (b *Bus) NeedLeaderElection() bool {
+	return false
+}
+
+// drain publishes queued events until the queue is empty or ctx expires
+func (b *Bus) drain(ctx context.Context) {
+	for {
+		select {
+		case msg := <-b.queue:
+			if ctx.Err() != nil {
+				metrics.RecordEvent(msg.eventType, metrics.EventResultDropped)
+				continue
+			}
+			b.publish(ctx, b.batch(msg))
+		default:
+			return
+		}
+	}
+}
+
+// batch returns msg and, if the publisher writes batches, the events queued
+// behind it up to maxBatchSize
+func (b *Bus) batch(msg message) []message {
+	msgs := []message{msg}
+	if _, ok := b.publisher.(BatchPublisher); !ok {
+		return msgs
+	}
+	for len(msgs) < maxBatchSize {
+		select {
+		case next := <-b.queue:
+			msgs = append(msgs, next)
+		default:
+			return msgs
+		}
+	}
+	return msgs
+}
+
+func (b *Bus) publish(ctx context.Context, msgs []message) {
+	pubCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	var err error
+	if bp, ok := b.publisher.(BatchPublisher); ok {
+		batch := make([]Message, 0, len(msgs))
+		for _, msg := range msgs {
+			batch = append(batch, Message{Topic: msg.topic, Key: msg.key, Value: msg.value})
+		}
+		err = bp.PublishBatch(pubCtx, batch)
+	} else {
+		for _, msg := range msgs {
+			err = errors.Join(err, b.publisher.Publish(pubCtx, msg.topic, msg.key, msg.value))
+		}
+	}
+
+	result := metrics.EventResultPublished
+	if err != nil {
+		result = metrics.EventResultFailed
+		log.FromContext(ctx).Error(err, "failed to publish event",
+			"type", msgs[0].eventType, "topic", msgs[0].topic, "count", len(msgs))
+	}
+	for _, msg := range msgs {
+		metrics.RecordEvent(msg.eventType, result)
+	}
+}
+
+// Config selects and configures the broker
+type Config struct {
+	// Type is kafka, nats, http, splunk, or elasticsearch (or opensearch)
+	Type          string
+	Kafka         KafkaConfig
+	NATS          NATSConfig
+	HTTP          HTTPConfig
+	Splunk        SplunkConfig
+	Elasticsearch ElasticsearchConfig
+}
+
+// NewPublisher creates the publisher for the configured broker type
+func NewPublisher(cfg Config) (Publisher, error) {
+	switch cfg.Type {
+	case "kafka":
+		return NewKafka(cfg.Kafka)
+	case "nats":
+		return NewNATS(cfg.NATS)
+	case "http":
+		return NewHTTP(cfg.HTTP)
+	case "splunk":
+		return NewSplunk(cfg.Splunk)
+	case "elasticsearch", "opensearch":
+		return NewElasticsearch(cfg.Elasticsearch)
+	default:
+		return nil, fmt.Errorf("unknown event bus type %q (expected kafka, nats, http, splunk or elasticsearch)", cfg.Type)
+	}
+}
+
+// ResolveFormat returns the message format for a broker type. HTTP sinks
+// always receive CloudEvents; other brokers default to JSON envelopes.
+func ResolveFormat(busType, format string) (string, error) {
+	switch {
+	case busType == "http":
+		if format != "" && format != FormatCloudEvents {
+			return "", fmt.Errorf("event bus type http only supports the %s format", FormatCloudEvents)
+		}
+		return FormatCloudEvents, nil
+	case format == "":
+		return FormatJSON, nil
+	case format == FormatJSON || format == FormatCloudEvents:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown event bus format %q (expected json or cloudevents)", format)
+	}
+}
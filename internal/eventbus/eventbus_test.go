@@ -0,0 +1,172 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+type published struct {
+	topic string
+	key   string
+	event map[string]any
+}
+
+type fakePublisher struct {
+	mu       sync.Mutex
+	messages []published
+	err      error
+	closed   bool
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, key, value []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	var event map[string]any
+	_ = json.Unmarshal(value, &event)
+	p.messages = append(p.messages, published{topic: topic, key: string(key), event: event})
+	return nil
+}
+
+func (p *fakePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *fakePublisher) published() []published {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]published(nil), p.messages...)
+}
+
+func TestBus_PublishesAlertsAndExecutions(t *testing.T) {
+	pub := &fakePublisher{}
+	bus := NewBus(pub, "guardian.alerts", "guardian.executions", 10)
+
+	ctx := context.Background()
+	bus.PublishAlert(ctx, alerting.Alert{
+		Key:       "prod/backup/JobFailed",
+		Type:      "JobFailed",
+		Severity:  "critical",
+		CronJob:   types.NamespacedName{Namespace: "prod", Name: "backup"},
+		Timestamp: time.Now(),
+	})
+	bus.PublishExecution(ctx, store.Execution{
+		CronJobNamespace: "prod",
+		CronJobName:      "backup",
+		JobName:          "backup-123",
+		Succeeded:        false,
+		ExitCode:         1,
+		Images:           "busybox:1.36",
+		CompletionTime:   time.Now(),
+	})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+
+	msgs := pub.published()
+	require.Len(t, msgs, 2)
+	assert.True(t, pub.closed)
+
+	assert.Equal(t, "guardian.alerts", msgs[0].topic)
+	assert.Equal(t, "prod/backup", msgs[0].key)
+	assert.Equal(t, EventTypeAlert, msgs[0].event["type"])
+	assert.Equal(t, "JobFailed", msgs[0].event["data"].(map[string]any)["type"])
+
+	assert.Equal(t, "guardian.executions", msgs[1].topic)
+	assert.Equal(t, EventTypeExecution, msgs[1].event["type"])
+	data := msgs[1].event["data"].(map[string]any)
+	assert.Equal(t, "backup-123", data["job"])
+	assert.Equal(t, []any{"busybox:1.36"}, data["images"])
+}
+
+func TestBus_DisabledTopicAndFullQueue(t *testing.T) {
+	pub := &fakePublisher{}
+	bus := NewBus(pub, "", "guardian.executions", 1)
+
+	ctx := context.Background()
+	bus.PublishAlert(ctx, alerting.Alert{Type: "JobFailed"})
+	bus.PublishExecution(ctx, store.Execution{JobName: "a"})
+	bus.PublishExecution(ctx, store.Execution{JobName: "b"}) // dropped: queue full
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+
+	msgs := pub.published()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "a", msgs[0].event["data"].(map[string]any)["job"])
+}
+
+func TestBus_PublishErrorDoesNotStop(t *testing.T) {
+	pub := &fakePublisher{err: errors.New("broker down")}
+	bus := NewBus(pub, "guardian.alerts", "guardian.executions", 10)
+	bus.PublishExecution(context.Background(), store.Execution{JobName: "a"})
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, bus.Start(runCtx))
+	assert.False(t, bus.NeedLeaderElection())
+}
+
+func TestStore_PublishesRecordedExecutions(t *testing.T) {
+	pub := &fakePublisher{}
+	bus := NewBus(pub, "guardian.alerts", "guardian.executions", 10)
+	mock := &testutil.MockStore{RecordExecutionError: errors.New("db down")}
+	st := NewStore(mock, bus)
+
+	// Executions that fail to store are not published
+	assert.Error(t, st.RecordExecution(context.Background(), store.Execution{JobName: "lost"}))
+	mock.RecordExecutionError = nil
+
+	ctx := context.Background()
+	require.NoError(t, st.RecordExecution(ctx, store.Execution{JobName: "a"}))
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{{JobName: "b"}, {JobName: "c"}}))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+	assert.Len(t, pub.published(), 3)
+}
+
+func TestNewPublisher(t *testing.T) {
+	_, err := NewPublisher(Config{Type: "rabbitmq"})
+	assert.Error(t, err)
+
+	p, err := NewPublisher(Config{Type: "kafka", Kafka: KafkaConfig{Brokers: []string{"kafka:9092"}, RequiredAcks: 1}})
+	require.NoError(t, err)
+	assert.IsType(t, &Kafka{}, p)
+
+	p, err = NewPublisher(Config{Type: "nats", NATS: NATSConfig{URL: "nats://nats:4222"}})
+	require.NoError(t, err)
+	assert.IsType(t, &NATS{}, p)
+
+	p, err = NewPublisher(Config{Type: "http", HTTP: HTTPConfig{URL: "http://broker-ingress.knative-eventing/default/default"}})
+	require.NoError(t, err)
+	assert.IsType(t, &HTTP{}, p)
+
+	p, err = NewPublisher(Config{Type: "splunk", Splunk: SplunkConfig{URL: "https://splunk:8088", Token: "t"}})
+	require.NoError(t, err)
+	assert.IsType(t, &Splunk{}, p)
+
+	p, err = NewPublisher(Config{Type: "elasticsearch", Elasticsearch: ElasticsearchConfig{URL: "https://es:9200"}})
+	require.NoError(t, err)
+	assert.IsType(t, &Elasticsearch{}, p)
+}
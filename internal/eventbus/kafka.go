@@ -0,0 +1,96 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+)
+
+// KafkaConfig configures the Kafka publisher
+type KafkaConfig struct {
+	// Brokers are bootstrap broker addresses (host:port)
+	Brokers []string
+	// ClientID identifies the producer to the brokers
+	ClientID string
+	// RequiredAcks is 0 (none), 1 (leader) or -1 (all in-sync replicas)
+	RequiredAcks int
+	// TLS enables TLS connections to the brokers
+	TLS bool
+	// SASLUsername and SASLPassword enable SASL/PLAIN authentication
+	SASLUsername string
+	SASLPassword string
+}
+
+// Kafka produces records with the franz-go client. Records are partitioned
+// by key with the same murmur2 hash as the Java client's default
+// partitioner, so events of a CronJob stay ordered on one partition.
+type Kafka struct {
+	client *kgo.Client
+}
+
+// NewKafka creates a Kafka publisher. Brokers are contacted on first publish.
+func NewKafka(cfg KafkaConfig) (*Kafka, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("at least one kafka broker is required")
+	}
+	for _, broker := range cfg.Brokers {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			return nil, fmt.Errorf("invalid kafka broker address %q: %w", broker, err)
+		}
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID = "cronjob-guardian"
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.ClientID(cfg.ClientID),
+		// Idempotent records cannot be abandoned once sent, so a publish could
+		// outlive its timeout; the bus drops failed events rather than
+		// retrying them, so idempotence would not prevent duplicates anyway
+		kgo.DisableIdempotentWrite(),
+		kgo.RecordDeliveryTimeout(publishTimeout),
+	}
+	switch cfg.RequiredAcks {
+	case -1:
+		opts = append(opts, kgo.RequiredAcks(kgo.AllISRAcks()))
+	case 1:
+		opts = append(opts, kgo.RequiredAcks(kgo.LeaderAck()))
+	case 0:
+		opts = append(opts, kgo.RequiredAcks(kgo.NoAck()))
+	default:
+		return nil, fmt.Errorf("kafka required acks must be 0, 1 or -1, got %d", cfg.RequiredAcks)
+	}
+	if cfg.TLS {
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	if cfg.SASLUsername != "" {
+		opts = append(opts, kgo.SASL(plain.Auth{User: cfg.SASLUsername, Pass: cfg.SASLPassword}.AsMechanism()))
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+	return &Kafka{client: client}, nil
+}
+
+// Publish writes a record to the topic and waits until it is acknowledged
+// or ctx expires. The client refreshes stale leader metadata and retries.
+func (k *Kafka) Publish(ctx context.Context, topic string, key, value []byte) error {
+	record := &kgo.Record{Topic: topic, Key: key, Value: value}
+	if err := k.client.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce to kafka topic %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Close closes all broker connections
+func (k *Kafka) Close() error {
+	k.client.Close()
+	return nil
+}
@@ -0,0 +1,105 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func newFakeKafkaCluster(t *testing.T, partitions int32, topics ...string) *kfake.Cluster {
+	t.Helper()
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, topics...))
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+	return cluster
+}
+
+// consumeAll reads n records of a topic from the start
+func consumeAll(t *testing.T, cluster *kfake.Cluster, topic string, n int) []*kgo.Record {
+	t.Helper()
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var records []*kgo.Record
+	for len(records) < n {
+		fetches := consumer.PollFetches(ctx)
+		require.NoError(t, ctx.Err())
+		records = append(records, fetches.Records()...)
+	}
+	return records
+}
+
+func TestKafka_Publish(t *testing.T) {
+	cluster := newFakeKafkaCluster(t, 3, "guardian.alerts")
+	k, err := NewKafka(KafkaConfig{Brokers: cluster.ListenAddrs(), RequiredAcks: 1})
+	require.NoError(t, err)
+	defer func() { _ = k.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, k.Publish(ctx, "guardian.alerts", []byte("prod/backup"), []byte(`{"type":"alert"}`)))
+	require.NoError(t, k.Publish(ctx, "guardian.alerts", []byte("prod/backup"), []byte(`{"type":"alert","n":2}`)))
+
+	records := consumeAll(t, cluster, "guardian.alerts", 2)
+	require.Len(t, records, 2)
+	assert.Equal(t, "prod/backup", string(records[0].Key))
+	assert.Equal(t, `{"type":"alert"}`, string(records[0].Value))
+	assert.Equal(t, records[0].Partition, records[1].Partition, "same key must use the same partition")
+}
+
+func TestKafka_PublishError(t *testing.T) {
+	cluster := newFakeKafkaCluster(t, 1, "guardian.alerts")
+	cluster.ControlKey(int16(kmsg.Produce), func(req kmsg.Request) (kmsg.Response, error, bool) {
+		cluster.KeepControl()
+		produce := req.(*kmsg.ProduceRequest)
+		resp := produce.ResponseKind().(*kmsg.ProduceResponse)
+		resp.SetVersion(produce.GetVersion())
+		for _, topic := range produce.Topics {
+			rt := kmsg.NewProduceResponseTopic()
+			rt.Topic = topic.Topic
+			rt.TopicID = topic.TopicID
+			for _, partition := range topic.Partitions {
+				rp := kmsg.NewProduceResponseTopicPartition()
+				rp.Partition = partition.Partition
+				rp.ErrorCode = kerr.TopicAuthorizationFailed.Code
+				rt.Partitions = append(rt.Partitions, rp)
+			}
+			resp.Topics = append(resp.Topics, rt)
+		}
+		return resp, nil, true
+	})
+
+	k, err := NewKafka(KafkaConfig{Brokers: cluster.ListenAddrs(), RequiredAcks: -1})
+	require.NoError(t, err)
+	defer func() { _ = k.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = k.Publish(ctx, "guardian.alerts", []byte("k"), []byte("v"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, kerr.TopicAuthorizationFailed)
+}
+
+func TestNewKafka_Invalid(t *testing.T) {
+	_, err := NewKafka(KafkaConfig{})
+	assert.Error(t, err)
+
+	_, err = NewKafka(KafkaConfig{Brokers: []string{"no-port"}})
+	assert.Error(t, err)
+
+	_, err = NewKafka(KafkaConfig{Brokers: []string{"kafka:9092"}, RequiredAcks: 2})
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type natsMessage struct {
+	subject string
+	payload string
+}
+
+// fakeNATSServer speaks enough of the NATS protocol to accept publishes
+type fakeNATSServer struct {
+	listener net.Listener
+	connects chan map[string]any
+	messages chan natsMessage
+	// denySubject is answered with a permissions violation
+	denySubject string
+}
+
+func newFakeNATSServer(t *testing.T) *fakeNATSServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeNATSServer{
+		listener: l,
+		connects: make(chan map[string]any, 10),
+		messages: make(chan natsMessage, 10),
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeNATSServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	_, _ = fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"max_payload\":1048576}\r\n")
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "CONNECT "):
+			var opts map[string]any
+			_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "CONNECT ")), &opts)
+			s.connects <- opts
+		case line == "PING":
+			_, _ = fmt.Fprint(conn, "PONG\r\n")
+		case strings.HasPrefix(line, "PUB "):
+			fields := strings.Fields(line)
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+			if fields[1] == s.denySubject {
+				_, _ = fmt.Fprintf(conn, "-ERR 'Permissions Violation for Publish to \"%s\"'\r\n", fields[1])
+				continue
+			}
+			s.messages <- natsMessage{subject: fields[1], payload: string(payload[:size])}
+		}
+	}
+}
+
+func TestNATS_Publish(t *testing.T) {
+	server := newFakeNATSServer(t)
+	n, err := NewNATS(NATSConfig{URL: "nats://guardian:secret@" + server.listener.Addr().String()})
+	require.NoError(t, err)
+	defer func() { _ = n.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, n.Publish(ctx, "guardian.alerts", nil, []byte(`{"type":"alert"}`)))
+	require.NoError(t, n.Publish(ctx, "guardian.executions", nil, []byte("{}")))
+
+	opts := <-server.connects
+	assert.Equal(t, "guardian", opts["user"])
+	assert.Equal(t, "secret", opts["pass"])
+	assert.Equal(t, natsMessage{subject: "guardian.alerts", payload: `{"type":"alert"}`}, <-server.messages)
+	assert.Equal(t, natsMessage{subject: "guardian.executions", payload: "{}"}, <-server.messages)
+	assert.Len(t, server.connects, 0, "connection should be reused")
+}
+
+func TestNATS_PublishError(t *testing.T) {
+	server := newFakeNATSServer(t)
+	server.denySubject = "guardian.alerts"
+	n, err := NewNATS(NATSConfig{URL: "nats://" + server.listener.Addr().String(), Token: "s3cr3t"})
+	require.NoError(t, err)
+	defer func() { _ = n.Close() }()
+
+	err = n.Publish(context.Background(), "guardian.alerts", nil, []byte("{}"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Permissions Violation")
+	assert.Equal(t, "s3cr3t", (<-server.connects)["auth_token"])
+
+	// The connection stays usable for other subjects
+	require.NoError(t, n.Publish(context.Background(), "guardian.executions", nil, []byte("{}")))
+	assert.Equal(t, "guardian.executions", (<-server.messages).subject)
+}
+
+func TestNewNATS_Invalid(t *testing.T) {
+	_, err := NewNATS(NATSConfig{})
+	assert.Error(t, err)
+
+	_, err = NewNATS(NATSConfig{URL: "nats://"})
+	assert.Error(t, err)
+
+	n, err := NewNATS(NATSConfig{URL: "tls://nats.example.com", TLS: true})
+	require.NoError(t, err)
+	assert.Equal(t, "tls://nats.example.com", n.url)
+
+	assert.Error(t, n.Publish(context.Background(), "bad subject", nil, nil))
+}
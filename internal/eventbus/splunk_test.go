@@ -0,0 +1,75 @@
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestSplunk_PublishBatch(t *testing.T) {
+	var path, auth string
+	var events []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var event map[string]any
+			_ = json.Unmarshal(scanner.Bytes(), &event)
+			events = append(events, event)
+		}
+		_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+	}))
+	defer server.Close()
+
+	pub, err := NewSplunk(SplunkConfig{URL: server.URL + "/", Token: "hec-token", Index: "jobs"})
+	require.NoError(t, err)
+	bus := NewBus(pub, "guardian:alert", "guardian:execution", 10)
+
+	completed := time.Date(2026, 1, 2, 3, 4, 5, 500_000_000, time.UTC)
+	ctx := context.Background()
+	bus.PublishExecution(ctx, store.Execution{CronJobNamespace: "prod", CronJobName: "backup", JobName: "backup-1", CompletionTime: completed})
+	bus.PublishExecution(ctx, store.Execution{CronJobNamespace: "prod", CronJobName: "backup", JobName: "backup-2", CompletionTime: completed})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, bus.Start(runCtx))
+
+	assert.Equal(t, "/services/collector/event", path)
+	assert.Equal(t, "Splunk hec-token", auth)
+	require.Len(t, events, 2, "queued events are sent in one request")
+	assert.Equal(t, "guardian:execution", events[0]["sourcetype"])
+	assert.Equal(t, "cronjob-guardian", events[0]["source"])
+	assert.Equal(t, "jobs", events[0]["index"])
+	assert.InDelta(t, float64(completed.UnixMilli())/1000, events[0]["time"], 0.001)
+	event := events[1]["event"].(map[string]any)
+	assert.Equal(t, EventTypeExecution, event["type"])
+	assert.Equal(t, "backup-2", event["data"].(map[string]any)["job"])
+}
+
+func TestSplunk_Errors(t *testing.T) {
+	_, err := NewSplunk(SplunkConfig{URL: "https://splunk:8088"})
+	assert.ErrorContains(t, err, "token is required")
+	_, err = NewSplunk(SplunkConfig{URL: "splunk:8088", Token: "t"})
+	assert.ErrorContains(t, err, "invalid splunk url")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"text":"Invalid token","code":4}`))
+	}))
+	defer server.Close()
+	pub, err := NewSplunk(SplunkConfig{URL: server.URL, Token: "bad"})
+	require.NoError(t, err)
+	err = pub.Publish(context.Background(), "guardian:alert", nil, []byte(`{"type":"alert"}`))
+	assert.ErrorContains(t, err, "splunk returned status 403: ")
+	assert.ErrorContains(t, err, "Invalid token")
+}
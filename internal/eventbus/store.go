@@ -0,0 +1,40 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Store publishes an execution event for every execution recorded. All other
+// methods pass straight through to the wrapped store.
+type Store struct {
+	store.Store
+
+	bus *Bus
+}
+
+// NewStore wraps s so recorded executions are also published to bus
+func NewStore(s store.Store, bus *Bus) *Store {
+	return &Store{Store: s, bus: bus}
+}
+
+// RecordExecution stores the execution, then publishes it
+func (s *Store) RecordExecution(ctx context.Context, exec store.Execution) error {
+	if err := s.Store.RecordExecution(ctx, exec); err != nil {
+		return err
+	}
+	s.bus.PublishExecution(ctx, exec)
+	return nil
+}
+
+// RecordExecutions stores the executions, then publishes them
+func (s *Store) RecordExecutions(ctx context.Context, execs []store.Execution) error {
+	if err := s.Store.RecordExecutions(ctx, execs); err != nil {
+		return err
+	}
+	for _, exec := range execs {
+		s.bus.PublishExecution(ctx, exec)
+	}
+	return nil
+}
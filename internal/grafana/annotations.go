@@ -0,0 +1,174 @@
+// Package grafana integrates guardian with Grafana: it pushes alert
+// annotations through the Grafana HTTP API and builds dashboards for the
+// monitored CronJobs.
+package grafana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+// Tag is added to every annotation guardian creates, and is what generated
+// dashboards query annotations by
+const Tag = "cronjob-guardian"
+
+// annotationTimeout bounds an annotation request including retries
+const annotationTimeout = 30 * time.Second
+
+// annotation is the body of the Grafana annotations API
+type annotation struct {
+	DashboardUID string   `json:"dashboardUID,omitempty"`
+	Time         int64    `json:"time,omitempty"`
+	TimeEnd      int64    `json:"timeEnd,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Text         string   `json:"text,omitempty"`
+}
+
+// Annotator pushes an annotation to Grafana when an alert fires and turns it
+// into a region ending at resolution when the alert clears. It implements
+// alerting.AlertSink and alerting.AlertResolveSink. Requests are sent in the
+// background so Grafana never delays alert delivery.
+type Annotator struct {
+	baseURL      string
+	token        string
+	dashboardUID string
+	tags         []string
+	retry        alerting.RetryConfig
+
+	mu          sync.Mutex
+	annotations map[string]int64 // alert key -> ID of the annotation created when it fired
+}
+
+// NewAnnotator creates an annotator for the configured Grafana instance
+func NewAnnotator(cfg config.GrafanaConfig) *Annotator {
+	return &Annotator{
+		baseURL:      strings.TrimSuffix(cfg.URL, "/"),
+		token:        cfg.APIToken,
+		dashboardUID: cfg.DashboardUID,
+		tags:         cfg.Tags,
+		retry:        alerting.DefaultRetryConfig(),
+		annotations:  make(map[string]int64),
+	}
+}
+
+// PublishAlert creates an annotation for a fired alert
+func (a *Annotator) PublishAlert(ctx context.Context, alert alerting.Alert) {
+	ts := alert.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	body := annotation{
+		DashboardUID: a.dashboardUID,
+		Time:         ts.UnixMilli(),
+		Tags:         a.alertTags(alert),
+		Text:         fmt.Sprintf("<b>%s</b><br/>%s", alert.Title, alert.Message),
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), annotationTimeout)
+		defer cancel()
+
+		var created struct {
+			ID int64 `json:"id"`
+		}
+		if err := a.do(ctx, http.MethodPost, "/api/annotations", body, &created); err != nil {
+			log.FromContext(ctx).Error(err, "failed to create Grafana annotation", "alertKey", alert.Key)
+			return
+		}
+		a.mu.Lock()
+		a.annotations[alert.Key] = created.ID
+		a.mu.Unlock()
+	}()
+}
+
+// ResolveAlert ends the alert's annotation region at the time of resolution.
+// If the annotation is not known (e.g. after a restart), a separate
+// resolution annotation is created instead.
+func (a *Annotator) ResolveAlert(ctx context.Context, alert alerting.Alert) {
+	now := time.Now()
+	a.mu.Lock()
+	id, ok := a.annotations[alert.Key]
+	delete(a.annotations, alert.Key)
+	a.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), annotationTimeout)
+		defer cancel()
+
+		var err error
+		if ok {
+			err = a.do(ctx, http.MethodPatch, fmt.Sprintf("/api/annotations/%d", id), annotation{TimeEnd: now.UnixMilli()}, nil)
+		} else {
+			err = a.do(ctx, http.MethodPost, "/api/annotations", annotation{
+				DashboardUID: a.dashboardUID,
+				Time:         now.UnixMilli(),
+				Tags:         append(a.alertTags(alert), "resolved"),
+				Text:         fmt.Sprintf("Resolved: %s", alert.Key),
+			}, nil)
+		}
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to resolve Grafana annotation", "alertKey", alert.Key)
+		}
+	}()
+}
+
+// alertTags returns the annotation tags for an alert
+func (a *Annotator) alertTags(alert alerting.Alert) []string {
+	tags := []string{Tag}
+	if alert.Type != "" {
+		tags = append(tags, alert.Type)
+	}
+	if alert.Severity != "" {
+		tags = append(tags, alert.Severity)
+	}
+	if alert.CronJob.Name != "" {
+		tags = append(tags, "namespace:"+alert.CronJob.Namespace, "cronjob:"+alert.CronJob.Name)
+	}
+	return append(tags, a.tags...)
+}
+
+// do sends a JSON request to the Grafana API and decodes the response into out
+func (a *Annotator) do(ctx context.Context, method, path string, in, out any) error {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal annotation: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+
+	resp, err := alerting.SendWithRetry(ctx, req, a.retry)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("grafana returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return nil
+}
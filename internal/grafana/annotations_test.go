@@ -0,0 +1,105 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+)
+
+type grafanaRequest struct {
+	method string
+	path   string
+	auth   string
+	body   annotation
+}
+
+func newGrafanaServer(t *testing.T) (*httptest.Server, chan grafanaRequest) {
+	requests := make(chan grafanaRequest, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := grafanaRequest{method: r.Method, path: r.URL.Path, auth: r.Header.Get("Authorization")}
+		_ = json.NewDecoder(r.Body).Decode(&req.body)
+		requests <- req
+		_, _ = w.Write([]byte(`{"id": 42, "message": "Annotation added"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func receive(t *testing.T, requests chan grafanaRequest) grafanaRequest {
+	t.Helper()
+	select {
+	case req := <-requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Grafana request")
+		return grafanaRequest{}
+	}
+}
+
+func testAlert() alerting.Alert {
+	return alerting.Alert{
+		Key:       "default/backup/JobFailed",
+		Type:      "JobFailed",
+		Severity:  "critical",
+		Title:     "Job failed",
+		Message:   "backup failed with exit code 1",
+		CronJob:   types.NamespacedName{Namespace: "default", Name: "backup"},
+		Timestamp: time.UnixMilli(1700000000000),
+	}
+}
+
+func TestAnnotator_PublishAndResolve(t *testing.T) {
+	srv, requests := newGrafanaServer(t)
+	a := NewAnnotator(config.GrafanaConfig{
+		URL:          srv.URL + "/",
+		APIToken:     "secret",
+		DashboardUID: "dash",
+		Tags:         []string{"prod"},
+	})
+	ctx := context.Background()
+
+	a.PublishAlert(ctx, testAlert())
+	created := receive(t, requests)
+	assert.Equal(t, http.MethodPost, created.method)
+	assert.Equal(t, "/api/annotations", created.path)
+	assert.Equal(t, "Bearer secret", created.auth)
+	assert.Equal(t, "dash", created.body.DashboardUID)
+	assert.Equal(t, int64(1700000000000), created.body.Time)
+	assert.Equal(t, []string{Tag, "JobFailed", "critical", "namespace:default", "cronjob:backup", "prod"}, created.body.Tags)
+	assert.Contains(t, created.body.Text, "backup failed with exit code 1")
+
+	require.Eventually(t, func() bool {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		return a.annotations["default/backup/JobFailed"] == 42
+	}, 5*time.Second, 10*time.Millisecond)
+
+	a.ResolveAlert(ctx, testAlert())
+	resolved := receive(t, requests)
+	assert.Equal(t, http.MethodPatch, resolved.method)
+	assert.Equal(t, "/api/annotations/42", resolved.path)
+	assert.NotZero(t, resolved.body.TimeEnd)
+}
+
+func TestAnnotator_ResolveUnknownAlert(t *testing.T) {
+	srv, requests := newGrafanaServer(t)
+	a := NewAnnotator(config.GrafanaConfig{URL: srv.URL})
+
+	a.ResolveAlert(context.Background(), testAlert())
+	req := receive(t, requests)
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, "/api/annotations", req.path)
+	assert.Empty(t, req.auth)
+	assert.Contains(t, req.body.Tags, "resolved")
+	assert.Equal(t, "Resolved: default/backup/JobFailed", req.body.Text)
+}
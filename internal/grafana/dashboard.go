@@ -0,0 +1,242 @@
+package grafana
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultDashboardUID is the UID of generated dashboards when none is configured
+const DefaultDashboardUID = "cronjob-guardian"
+
+// Panel layout of the generated dashboard (Grafana uses a 24 column grid)
+const (
+	panelWidth  = 8
+	panelHeight = 8
+)
+
+// Dashboard is a Grafana dashboard model, as accepted by dashboard import and
+// the /api/dashboards/db endpoint
+type Dashboard struct {
+	UID           string      `json:"uid"`
+	Title         string      `json:"title"`
+	Tags          []string    `json:"tags"`
+	Timezone      string      `json:"timezone"`
+	SchemaVersion int         `json:"schemaVersion"`
+	Refresh       string      `json:"refresh"`
+	Time          TimeRange   `json:"time"`
+	Templating    Templating  `json:"templating"`
+	Annotations   Annotations `json:"annotations"`
+	Panels        []Panel     `json:"panels"`
+}
+
+// TimeRange is the default time range of a dashboard
+type TimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Templating holds dashboard variables
+type Templating struct {
+	List []Variable `json:"list"`
+}
+
+// Variable is a dashboard template variable
+type Variable struct {
+	Name  string `json:"name"`
+	Label string `json:"label,omitempty"`
+	Type  string `json:"type"`
+	Query string `json:"query"`
+}
+
+// Annotations holds dashboard annotation queries
+type Annotations struct {
+	List []AnnotationQuery `json:"list"`
+}
+
+// AnnotationQuery shows annotations from Grafana's own store filtered by tags
+type AnnotationQuery struct {
+	Name       string     `json:"name"`
+	Datasource Datasource `json:"datasource"`
+	Enable     bool       `json:"enable"`
+	IconColor  string     `json:"iconColor"`
+	Target     struct {
+		Type     string   `json:"type"`
+		Tags     []string `json:"tags"`
+		MatchAny bool     `json:"matchAny"`
+		Limit    int      `json:"limit"`
+	} `json:"target"`
+}
+
+// Datasource references a Grafana datasource
+type Datasource struct {
+	Type string `json:"type"`
+	UID  string `json:"uid"`
+}
+
+// Panel is a dashboard panel or row
+type Panel struct {
+	ID          int          `json:"id"`
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	GridPos     GridPos      `json:"gridPos"`
+	Datasource  *Datasource  `json:"datasource,omitempty"`
+	Targets     []Target     `json:"targets,omitempty"`
+	FieldConfig *FieldConfig `json:"fieldConfig,omitempty"`
+	Collapsed   *bool        `json:"collapsed,omitempty"`
+}
+
+// GridPos positions a panel on the dashboard grid
+type GridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Target is a Prometheus query of a panel
+type Target struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat,omitempty"`
+}
+
+// FieldConfig sets the unit and range of a panel's values
+type FieldConfig struct {
+	Defaults struct {
+		Unit string   `json:"unit,omitempty"`
+		Min  *float64 `json:"min,omitempty"`
+		Max  *float64 `json:"max,omitempty"`
+	} `json:"defaults"`
+}
+
+// DashboardOptions configures the generated dashboard
+type DashboardOptions struct {
+	// UID of the dashboard (default: DefaultDashboardUID)
+	UID string
+	// Title of the dashboard (default: "CronJob Guardian")
+	Title string
+	// Tags added to the dashboard alongside Tag
+	Tags []string
+}
+
+// promDatasource is the datasource panels query, selected by the datasource variable
+var promDatasource = &Datasource{Type: "prometheus", UID: "${datasource}"}
+
+// BuildDashboard generates a dashboard with an overview row and a row of
+// success rate, duration and alert panels for each CronJob. Alert annotations
+// pushed by the Annotator are shown on every panel.
+func BuildDashboard(opts DashboardOptions, cronJobs []types.NamespacedName) Dashboard {
+	if opts.UID == "" {
+		opts.UID = DefaultDashboardUID
+	}
+	if opts.Title == "" {
+		opts.Title = "CronJob Guardian"
+	}
+
+	jobs := append([]types.NamespacedName(nil), cronJobs...)
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].String() < jobs[j].String() })
+
+	d := Dashboard{
+		UID:           opts.UID,
+		Title:         opts.Title,
+		Tags:          append([]string{Tag}, opts.Tags...),
+		Timezone:      "browser",
+		SchemaVersion: 39,
+		Refresh:       "1m",
+		Time:          TimeRange{From: "now-7d", To: "now"},
+		Templating: Templating{List: []Variable{
+			{Name: "datasource", Label: "Data source", Type: "datasource", Query: "prometheus"},
+		}},
+	}
+
+	alerts := AnnotationQuery{
+		Name:       "Guardian alerts",
+		Datasource: Datasource{Type: "grafana", UID: "-- Grafana --"},
+		Enable:     true,
+		IconColor:  "red",
+	}
+	alerts.Target.Type = "tags"
+	alerts.Target.Tags = []string{Tag}
+	alerts.Target.Limit = 100
+	d.Annotations.List = []AnnotationQuery{alerts}
+
+	b := &panelBuilder{}
+	b.row("Overview")
+	b.add("Executions", "short",
+		Target{RefID: "A", Expr: "sum by (status) (increase(cronjob_guardian_executions_total[$__interval]))", LegendFormat: "{{status}}"})
+	b.add("Active alerts", "short",
+		Target{RefID: "A", Expr: "sum by (severity) (cronjob_guardian_active_alerts)", LegendFormat: "{{severity}}"})
+	b.add("Alerts sent", "short",
+		Target{RefID: "A", Expr: "sum by (type) (increase(cronjob_guardian_alerts_total[$__interval]))", LegendFormat: "{{type}}"})
+
+	for _, job := range jobs {
+		sel := fmt.Sprintf(`namespace=%q, cronjob=%q`, job.Namespace, job.Name)
+		b.row(job.String())
+		b.addPercent("Success rate",
+			Target{RefID: "A", Expr: fmt.Sprintf("cronjob_guardian_success_rate{%s}", sel), LegendFormat: "{{monitor}}"})
+		b.add("Duration", "s",
+			Target{RefID: "A", Expr: fmt.Sprintf("cronjob_guardian_duration_seconds{%s}", sel), LegendFormat: "{{percentile}}"})
+		b.add("Alerts", "short",
+			Target{RefID: "A", Expr: fmt.Sprintf("sum by (type) (increase(cronjob_guardian_alerts_total{%s}[$__interval]))", sel), LegendFormat: "{{type}}"})
+	}
+
+	d.Panels = b.panels
+	return d
+}
+
+// panelBuilder lays out rows of panels on the dashboard grid
+type panelBuilder struct {
+	panels []Panel
+	nextID int
+	x, y   int
+}
+
+// row starts a new row
+func (b *panelBuilder) row(title string) {
+	if b.x > 0 {
+		b.x = 0
+		b.y += panelHeight
+	}
+	collapsed := false
+	b.nextID++
+	b.panels = append(b.panels, Panel{
+		ID:        b.nextID,
+		Type:      "row",
+		Title:     title,
+		GridPos:   GridPos{H: 1, W: 24, X: 0, Y: b.y},
+		Collapsed: &collapsed,
+	})
+	b.y++
+}
+
+// add appends a time series panel to the current row
+func (b *panelBuilder) add(title, unit string, targets ...Target) *Panel {
+	if b.x+panelWidth > 24 {
+		b.x = 0
+		b.y += panelHeight
+	}
+	fc := &FieldConfig{}
+	fc.Defaults.Unit = unit
+	b.nextID++
+	b.panels = append(b.panels, Panel{
+		ID:          b.nextID,
+		Type:        "timeseries",
+		Title:       title,
+		GridPos:     GridPos{H: panelHeight, W: panelWidth, X: b.x, Y: b.y},
+		Datasource:  promDatasource,
+		Targets:     targets,
+		FieldConfig: fc,
+	})
+	b.x += panelWidth
+	return &b.panels[len(b.panels)-1]
+}
+
+// addPercent appends a time series panel of a 0-100 percentage
+func (b *panelBuilder) addPercent(title string, targets ...Target) {
+	p := b.add(title, "percent", targets...)
+	lo, hi := 0.0, 100.0
+	p.FieldConfig.Defaults.Min = &lo
+	p.FieldConfig.Defaults.Max = &hi
+}
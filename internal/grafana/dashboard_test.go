@@ -0,0 +1,57 @@
+package grafana
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBuildDashboard(t *testing.T) {
+	d := BuildDashboard(DashboardOptions{Tags: []string{"prod"}}, []types.NamespacedName{
+		{Namespace: "prod", Name: "report"},
+		{Namespace: "default", Name: "backup"},
+	})
+
+	assert.Equal(t, DefaultDashboardUID, d.UID)
+	assert.Equal(t, []string{Tag, "prod"}, d.Tags)
+	require.Len(t, d.Annotations.List, 1)
+	assert.Equal(t, []string{Tag}, d.Annotations.List[0].Target.Tags)
+
+	var rows []string
+	ids := make(map[int]bool)
+	for _, p := range d.Panels {
+		assert.False(t, ids[p.ID], "duplicate panel ID %d", p.ID)
+		ids[p.ID] = true
+		assert.LessOrEqual(t, p.GridPos.X+p.GridPos.W, 24)
+		if p.Type == "row" {
+			rows = append(rows, p.Title)
+		}
+	}
+	assert.Equal(t, []string{"Overview", "default/backup", "prod/report"}, rows)
+
+	// Rows are placed below the panels of the previous row
+	assert.Equal(t, 0, d.Panels[0].GridPos.Y)
+	assert.Equal(t, 1, d.Panels[1].GridPos.Y)
+	assert.Equal(t, 1+panelHeight, d.Panels[4].GridPos.Y)
+
+	successRate := d.Panels[5]
+	assert.Equal(t, "Success rate", successRate.Title)
+	require.Len(t, successRate.Targets, 1)
+	assert.Equal(t, `cronjob_guardian_success_rate{namespace="default", cronjob="backup"}`, successRate.Targets[0].Expr)
+	require.NotNil(t, successRate.FieldConfig.Defaults.Max)
+	assert.InDelta(t, 100, *successRate.FieldConfig.Defaults.Max, 0)
+
+	_, err := json.Marshal(d)
+	require.NoError(t, err)
+}
+
+func TestBuildDashboard_NoCronJobs(t *testing.T) {
+	d := BuildDashboard(DashboardOptions{UID: "custom", Title: "Jobs"}, nil)
+
+	assert.Equal(t, "custom", d.UID)
+	assert.Equal(t, "Jobs", d.Title)
+	assert.Len(t, d.Panels, 4)
+}
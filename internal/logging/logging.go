@@ -0,0 +1,151 @@
+// Package logging lets the log level change at runtime, for all logs or for
+// named sub-loggers such as "controllers", "api-server" or "alerting".
+package logging
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zerologr"
+	"github.com/rs/zerolog"
+)
+
+// Levels holds the default log level and the levels of named sub-loggers.
+// A sub-logger is any logger created with WithName; its level applies to
+// loggers named after it too, e.g. "controllers" to "controllers/JobHandler".
+type Levels struct {
+	mu      sync.RWMutex
+	level   zerolog.Level
+	loggers map[string]zerolog.Level
+}
+
+// NewLevels creates levels logging at level until changed
+func NewLevels(level zerolog.Level) *Levels {
+	l := &Levels{level: level, loggers: make(map[string]zerolog.Level)}
+	l.updateGlobalLevel()
+	return l
+}
+
+// ParseLevel parses a level name (trace, debug, info, warn, error)
+func ParseLevel(name string) (zerolog.Level, error) {
+	level, err := zerolog.ParseLevel(strings.ToLower(name))
+	if err != nil || level == zerolog.NoLevel {
+		return zerolog.NoLevel, fmt.Errorf("invalid log level %q, use trace, debug, info, warn or error", name)
+	}
+	return level, nil
+}
+
+// Level returns the default level
+func (l *Levels) Level() zerolog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.level
+}
+
+// SetLevel changes the default level
+func (l *Levels) SetLevel(level zerolog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.level = level
+	l.updateGlobalLevel()
+}
+
+// Loggers returns the levels of sub-loggers by name
+func (l *Levels) Loggers() map[string]zerolog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return maps.Clone(l.loggers)
+}
+
+// SetLoggerLevel changes the level of the sub-logger name
+func (l *Levels) SetLoggerLevel(name string, level zerolog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.loggers[name] = level
+	l.updateGlobalLevel()
+}
+
+// ResetLoggerLevel makes the sub-logger name log at the default level again
+func (l *Levels) ResetLoggerLevel(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.loggers, name)
+	l.updateGlobalLevel()
+}
+
+// levelFor returns the level of a logger named names. The most specific
+// name with a level set wins.
+func (l *Levels) levelFor(names []string) zerolog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for i := len(names) - 1; i >= 0; i-- {
+		if level, ok := l.loggers[names[i]]; ok {
+			return level
+		}
+	}
+	return l.level
+}
+
+// updateGlobalLevel lowers zerolog's global level to the most verbose level
+// in use, so the sinks can filter per logger. Callers hold mu.
+func (l *Levels) updateGlobalLevel() {
+	global := l.level
+	for _, level := range l.loggers {
+		global = min(global, level)
+	}
+	zerolog.SetGlobalLevel(global)
+}
+
+// NewLogger returns a logr.Logger writing to zl at the levels of levels
+func NewLogger(zl *zerolog.Logger, levels *Levels) logr.Logger {
+	base := zerologr.New(zl).GetSink()
+	return logr.New(&sink{sink: base, levels: levels})
+}
+
+// sink filters the logs of a zerologr sink by the level of its name
+type sink struct {
+	sink   logr.LogSink
+	levels *Levels
+	names  []string
+}
+
+var _ logr.CallDepthLogSink = &sink{}
+
+func (s *sink) Init(info logr.RuntimeInfo) {
+	s.sink.Init(info)
+}
+
+func (s *sink) Enabled(level int) bool {
+	return zerolog.Level(1-level) >= s.levels.levelFor(s.names) && s.sink.Enabled(level)
+}
+
+func (s *sink) Info(level int, msg string, keysAndValues ...any) {
+	s.sink.Info(level, msg, keysAndValues...)
+}
+
+func (s *sink) Error(err error, msg string, keysAndValues ...any) {
+	if zerolog.ErrorLevel < s.levels.levelFor(s.names) {
+		return
+	}
+	s.sink.Error(err, msg, keysAndValues...)
+}
+
+func (s *sink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &sink{sink: s.sink.WithValues(keysAndValues...), levels: s.levels, names: s.names}
+}
+
+func (s *sink) WithName(name string) logr.LogSink {
+	names := append(s.names[:len(s.names):len(s.names)], name)
+	return &sink{sink: s.sink.WithName(name), levels: s.levels, names: names}
+}
+
+func (s *sink) WithCallDepth(depth int) logr.LogSink {
+	inner := s.sink
+	if cd, ok := inner.(logr.CallDepthLogSink); ok {
+		inner = cd.WithCallDepth(depth)
+	}
+	return &sink{sink: inner, levels: s.levels, names: s.names}
+}
@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(level zerolog.Level) (*Levels, *bytes.Buffer, func() string) {
+	var buf bytes.Buffer
+	zl := zerolog.New(&buf)
+	levels := NewLevels(level)
+	logger := NewLogger(&zl, levels)
+	controllers := logger.WithName("controllers").WithName("JobHandler")
+	alerting := logger.WithName("controllers").WithName("alerting")
+
+	emit := func() string {
+		buf.Reset()
+		logger.V(1).Info("root debug")
+		controllers.V(1).Info("controllers debug")
+		alerting.V(1).Info("alerting debug")
+		controllers.Error(nil, "controllers error")
+		return buf.String()
+	}
+	return levels, &buf, emit
+}
+
+func TestLevels_Default(t *testing.T) {
+	levels, _, emit := newTestLogger(zerolog.InfoLevel)
+
+	out := emit()
+	assert.NotContains(t, out, "debug")
+	assert.Contains(t, out, "controllers error")
+
+	levels.SetLevel(zerolog.DebugLevel)
+	out = emit()
+	assert.Contains(t, out, "root debug")
+	assert.Contains(t, out, "controllers debug")
+}
+
+func TestLevels_SubLogger(t *testing.T) {
+	levels, _, emit := newTestLogger(zerolog.InfoLevel)
+
+	levels.SetLoggerLevel("controllers", zerolog.DebugLevel)
+	out := emit()
+	assert.NotContains(t, out, "root debug")
+	assert.Contains(t, out, "controllers debug")
+	assert.Contains(t, out, "alerting debug", "names below controllers use its level")
+
+	// The most specific name wins
+	levels.SetLoggerLevel("alerting", zerolog.InfoLevel)
+	out = emit()
+	assert.Contains(t, out, "controllers debug")
+	assert.NotContains(t, out, "alerting debug")
+
+	levels.SetLoggerLevel("controllers", zerolog.Disabled)
+	assert.NotContains(t, emit(), "controllers error")
+
+	levels.ResetLoggerLevel("controllers")
+	levels.ResetLoggerLevel("alerting")
+	out = emit()
+	assert.NotContains(t, out, "debug")
+	assert.Contains(t, out, "controllers error")
+	assert.Empty(t, levels.Loggers())
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("DEBUG")
+	require.NoError(t, err)
+	assert.Equal(t, zerolog.DebugLevel, level)
+
+	_, err = ParseLevel("")
+	assert.Error(t, err)
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+}
@@ -24,6 +24,24 @@ var (
 		[]string{"namespace", "cronjob", "percentile"},
 	)
 
+	// CronJobMTTRSeconds tracks the mean time to recovery of monitored CronJobs
+	CronJobMTTRSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cronjob_guardian_mttr_seconds",
+			Help: "Mean time from a failed run of a monitored CronJob to its next successful run",
+		},
+		[]string{"namespace", "cronjob", "monitor"},
+	)
+
+	// CronJobMTBFSeconds tracks the mean time between failures of monitored CronJobs
+	CronJobMTBFSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cronjob_guardian_mtbf_seconds",
+			Help: "Mean time between the starts of consecutive failure incidents of a monitored CronJob",
+		},
+		[]string{"namespace", "cronjob", "monitor"},
+	)
+
 	// AlertsTotal tracks the total number of alerts successfully sent
 	AlertsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -42,6 +60,24 @@ var (
 		[]string{"namespace", "cronjob", "type", "severity", "channel"},
 	)
 
+	// AlertsRateLimitedTotal tracks alerts dropped by a rate limit
+	AlertsRateLimitedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_alerts_rate_limited_total",
+			Help: "Total number of alerts dropped by a global, monitor or channel rate limit",
+		},
+		[]string{"scope", "name"},
+	)
+
+	// AlertClaimErrorsTotal tracks alerts sent without a dedup claim because
+	// the claim could not be written
+	AlertClaimErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_alert_claim_errors_total",
+			Help: "Total number of alerts sent without a dedup claim because the claim could not be written",
+		},
+	)
+
 	// ExecutionsTotal tracks the total number of job executions recorded
 	ExecutionsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -59,6 +95,75 @@ var (
 		},
 		[]string{"namespace", "cronjob", "severity"},
 	)
+
+	// ExecutionQueueDepth tracks executions buffered for batched writing
+	ExecutionQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "cronjob_guardian_execution_queue_depth",
+			Help: "Number of job executions waiting to be written to the store",
+		},
+	)
+
+	// ClusterDisruption tracks whether the cluster is going through a disruption
+	ClusterDisruption = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "cronjob_guardian_cluster_disruption",
+			Help: "1 while a node drain or cluster upgrade is detected, 0 otherwise",
+		},
+	)
+
+	// EventsTotal tracks events handed to the event bus by outcome
+	EventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_events_total",
+			Help: "Total number of alert and execution events handed to the event bus, by result",
+		},
+		[]string{"type", "result"},
+	)
+
+	// HealthcheckPingsTotal tracks pings sent to external monitoring services
+	HealthcheckPingsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_healthcheck_pings_total",
+			Help: "Total number of healthcheck pings sent to external monitoring services, by result",
+		},
+		[]string{"namespace", "cronjob", "result"},
+	)
+
+	// AutomationHooksTotal tracks calls of monitors' automation hooks
+	AutomationHooksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_automation_hooks_total",
+			Help: "Total number of automation hook calls made for alerts, by result",
+		},
+		[]string{"namespace", "monitor", "hook", "result"},
+	)
+
+	// StoreRetriesTotal tracks store calls retried after a transient database error
+	StoreRetriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cronjob_guardian_store_retries_total",
+			Help: "Total number of store calls retried after a transient database error, by operation",
+		},
+		[]string{"operation"},
+	)
+
+	// StoreQuerySeconds tracks how long store calls take
+	StoreQuerySeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cronjob_guardian_store_query_seconds",
+			Help:    "Duration of store calls in seconds, by method",
+			Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"method"},
+	)
+)
+
+// Event bus results
+const (
+	EventResultPublished = "published"
+	EventResultFailed    = "failed"
+	EventResultDropped   = "dropped"
 )
 
 func init() {
@@ -66,10 +171,21 @@ func init() {
 	metrics.Registry.MustRegister(
 		CronJobSuccessRate,
 		CronJobDurationSeconds,
+		CronJobMTTRSeconds,
+		CronJobMTBFSeconds,
 		AlertsTotal,
 		AlertsFailedTotal,
+		AlertsRateLimitedTotal,
+		AlertClaimErrorsTotal,
 		ExecutionsTotal,
 		ActiveAlerts,
+		ExecutionQueueDepth,
+		ClusterDisruption,
+		EventsTotal,
+		HealthcheckPingsTotal,
+		AutomationHooksTotal,
+		StoreRetriesTotal,
+		StoreQuerySeconds,
 	)
 }
 
@@ -88,6 +204,56 @@ func RecordAlertFailed(namespace, cronjob, alertType, severity, channel string)
 	AlertsFailedTotal.WithLabelValues(namespace, cronjob, alertType, severity, channel).Inc()
 }
 
+// RecordAlertRateLimited records an alert dropped by a rate limit.
+// scope is global, monitor or channel; name identifies the monitor or channel.
+func RecordAlertRateLimited(scope, name string) {
+	AlertsRateLimitedTotal.WithLabelValues(scope, name).Inc()
+}
+
+// RecordAlertClaimError records an alert sent without a dedup claim
+func RecordAlertClaimError() {
+	AlertClaimErrorsTotal.Inc()
+}
+
+// SetExecutionQueueDepth updates the number of executions waiting to be written
+func SetExecutionQueueDepth(depth int) {
+	ExecutionQueueDepth.Set(float64(depth))
+}
+
+// SetClusterDisruption updates whether the cluster is going through a disruption
+func SetClusterDisruption(active bool) {
+	value := 0.0
+	if active {
+		value = 1
+	}
+	ClusterDisruption.Set(value)
+}
+
+// RecordEvent records the outcome of publishing an event bus event
+func RecordEvent(eventType, result string) {
+	EventsTotal.WithLabelValues(eventType, result).Inc()
+}
+
+// RecordHealthcheckPing records a healthcheck ping result (success or failed)
+func RecordHealthcheckPing(namespace, cronjob, result string) {
+	HealthcheckPingsTotal.WithLabelValues(namespace, cronjob, result).Inc()
+}
+
+// RecordAutomationHook records an automation hook call result (success or failed)
+func RecordAutomationHook(namespace, monitor, hook, result string) {
+	AutomationHooksTotal.WithLabelValues(namespace, monitor, hook, result).Inc()
+}
+
+// RecordStoreRetry records a retried store call
+func RecordStoreRetry(operation string) {
+	StoreRetriesTotal.WithLabelValues(operation).Inc()
+}
+
+// ObserveStoreQuery records the duration of a store call
+func ObserveStoreQuery(method string, seconds float64) {
+	StoreQuerySeconds.WithLabelValues(method).Observe(seconds)
+}
+
 // UpdateSuccessRate updates the success rate gauge for a CronJob
 func UpdateSuccessRate(namespace, cronjob, monitor string, rate float64) {
 	CronJobSuccessRate.WithLabelValues(namespace, cronjob, monitor).Set(rate)
@@ -98,6 +264,12 @@ func UpdateDuration(namespace, cronjob, percentile string, seconds float64) {
 	CronJobDurationSeconds.WithLabelValues(namespace, cronjob, percentile).Set(seconds)
 }
 
+// UpdateReliability updates the MTTR and MTBF gauges for a CronJob
+func UpdateReliability(namespace, cronjob, monitor string, mttrSeconds, mtbfSeconds float64) {
+	CronJobMTTRSeconds.WithLabelValues(namespace, cronjob, monitor).Set(mttrSeconds)
+	CronJobMTBFSeconds.WithLabelValues(namespace, cronjob, monitor).Set(mtbfSeconds)
+}
+
 // UpdateActiveAlerts updates the active alerts gauge for a CronJob
 func UpdateActiveAlerts(namespace, cronjob, severity string, count float64) {
 	ActiveAlerts.WithLabelValues(namespace, cronjob, severity).Set(count)
@@ -108,5 +280,7 @@ func ResetCronJobMetrics(namespace, cronjob string) {
 	// Delete all label combinations for this CronJob
 	CronJobSuccessRate.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "cronjob": cronjob})
 	CronJobDurationSeconds.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "cronjob": cronjob})
+	CronJobMTTRSeconds.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "cronjob": cronjob})
+	CronJobMTBFSeconds.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "cronjob": cronjob})
 	ActiveAlerts.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "cronjob": cronjob})
 }
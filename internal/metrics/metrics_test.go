@@ -186,6 +186,26 @@ func TestUpdateSuccessRate_MultipleMonitors(t *testing.T) {
 	})))
 }
 
+func TestUpdateReliability(t *testing.T) {
+	// Reset metrics before test
+	CronJobMTTRSeconds.Reset()
+	CronJobMTBFSeconds.Reset()
+
+	UpdateReliability("default", "test-cron", "monitor-a", 1800, 86400)
+
+	labels := prometheus.Labels{
+		"namespace": "default",
+		"cronjob":   "test-cron",
+		"monitor":   "monitor-a",
+	}
+	assert.Equal(t, 1800.0, testutil.ToFloat64(CronJobMTTRSeconds.With(labels)))
+	assert.Equal(t, 86400.0, testutil.ToFloat64(CronJobMTBFSeconds.With(labels)))
+
+	ResetCronJobMetrics("default", "test-cron")
+	assert.Equal(t, 0, testutil.CollectAndCount(CronJobMTTRSeconds))
+	assert.Equal(t, 0, testutil.CollectAndCount(CronJobMTBFSeconds))
+}
+
 func TestUpdateDuration_P50(t *testing.T) {
 	// Reset metric before test
 	CronJobDurationSeconds.Reset()
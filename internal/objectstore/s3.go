@@ -0,0 +1,183 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Supported providers. All of them are accessed through the S3 API: GCS via
+// its S3-compatible XML API with HMAC keys, MinIO natively.
+const (
+	ProviderS3    = "s3"
+	ProviderGCS   = "gcs"
+	ProviderMinIO = "minio"
+)
+
+// ErrNotFound is returned by Get when the object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// Config configures an S3-compatible bucket
+type Config struct {
+	// Provider is one of s3, gcs or minio and selects the default endpoint
+	Provider string
+	// Endpoint overrides the provider's default endpoint (required for minio)
+	Endpoint string
+	// Region used for request signing
+	Region string
+	// Bucket name
+	Bucket string
+	// AccessKeyID and SecretAccessKey are the HMAC credentials
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket as endpoint/bucket instead of bucket.endpoint
+	PathStyle bool
+	// Timeout for each request
+	Timeout time.Duration
+}
+
+// S3 stores objects in a bucket through the AWS SDK's S3 client
+type S3 struct {
+	api       *s3.Client
+	bucket    string
+	region    string
+	endpoint  string // empty = resolved by the SDK from the region
+	pathStyle bool
+}
+
+// NewS3 creates a client for the configured bucket
+func NewS3(cfg Config) (*S3, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("access key ID and secret access key are required")
+	}
+
+	region := cfg.Region
+	endpoint := cfg.Endpoint
+	pathStyle := cfg.PathStyle
+	switch cfg.Provider {
+	case "", ProviderS3:
+		if region == "" {
+			region = "us-east-1"
+		}
+	case ProviderGCS:
+		if region == "" {
+			region = "auto"
+		}
+		if endpoint == "" {
+			endpoint = "https://storage.googleapis.com"
+		}
+	case ProviderMinIO:
+		if endpoint == "" {
+			return nil, errors.New("endpoint is required for minio")
+		}
+		if region == "" {
+			region = "us-east-1"
+		}
+		// MinIO deployments rarely have wildcard DNS for buckets
+		pathStyle = true
+	default:
+		return nil, fmt.Errorf("unsupported provider: %s", cfg.Provider)
+	}
+
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid endpoint %q: scheme and host are required", endpoint)
+		}
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	opts := s3.Options{
+		Region:       region,
+		Credentials:  credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		HTTPClient:   &http.Client{Timeout: timeout},
+		UsePathStyle: pathStyle,
+		// GCS and older MinIO releases reject the checksum headers the SDK
+		// adds by default
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	}
+	if endpoint != "" {
+		opts.BaseEndpoint = aws.String(endpoint)
+	}
+
+	return &S3{
+		api:       s3.New(opts),
+		bucket:    cfg.Bucket,
+		region:    region,
+		endpoint:  endpoint,
+		pathStyle: pathStyle,
+	}, nil
+}
+
+// Bucket returns the bucket name
+func (s *S3) Bucket() string {
+	return s.bucket
+}
+
+// Put uploads an object
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	input := &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := s.api.PutObject(ctx, input); err != nil {
+		return fmt.Errorf("put %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get downloads an object
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	out, err := s.api.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(key)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("get %s: %w", key, ErrNotFound)
+		}
+		return nil, fmt.Errorf("get %s: %w", key, err)
+	}
+	defer func() { _ = out.Body.Close() }()
+	return io.ReadAll(out.Body)
+}
+
+// Delete removes an object. Deleting a missing object is not an error.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	_, err := s.api.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(key)})
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// isNotFound reports whether a request failed because the object does not exist
+func isNotFound(err error) bool {
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,119 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBucket is an in-memory S3 endpoint serving path-style requests
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	auth    []string
+}
+
+func (f *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3_RoundTrip(t *testing.T) {
+	bucket := &fakeBucket{objects: map[string][]byte{}}
+	server := httptest.NewServer(bucket)
+	defer server.Close()
+
+	s3, err := NewS3(Config{
+		Provider:        ProviderMinIO,
+		Endpoint:        server.URL,
+		Bucket:          "logs",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, s3.Put(ctx, "executions/default/job-1.log", []byte("hello"), "text/plain"))
+	assert.Contains(t, bucket.objects, "/logs/executions/default/job-1.log")
+
+	data, err := s3.Get(ctx, "executions/default/job-1.log")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	require.NoError(t, s3.Delete(ctx, "executions/default/job-1.log"))
+	_, err = s3.Get(ctx, "executions/default/job-1.log")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	require.NotEmpty(t, bucket.auth)
+	assert.Contains(t, bucket.auth[0], "AWS4-HMAC-SHA256 Credential=AKID/")
+	assert.Contains(t, bucket.auth[0], "/us-east-1/s3/aws4_request")
+}
+
+func TestS3_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>"))
+	}))
+	defer server.Close()
+
+	s3, err := NewS3(Config{Provider: ProviderMinIO, Endpoint: server.URL, Bucket: "logs", AccessKeyID: "a", SecretAccessKey: "b"})
+	require.NoError(t, err)
+
+	err = s3.Put(context.Background(), "key", []byte("x"), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "AccessDenied")
+}
+
+func TestNewS3_ProviderDefaults(t *testing.T) {
+	s3, err := NewS3(Config{Provider: ProviderS3, Region: "eu-west-1", Bucket: "b", AccessKeyID: "a", SecretAccessKey: "s"})
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", s3.region)
+	assert.Empty(t, s3.endpoint, "the SDK resolves the endpoint from the region")
+
+	gcs, err := NewS3(Config{Provider: ProviderGCS, Bucket: "b", AccessKeyID: "a", SecretAccessKey: "s", PathStyle: true})
+	require.NoError(t, err)
+	assert.Equal(t, "auto", gcs.region)
+	assert.Equal(t, "https://storage.googleapis.com", gcs.endpoint)
+
+	minio, err := NewS3(Config{Provider: ProviderMinIO, Endpoint: "http://minio:9000/", Bucket: "b", AccessKeyID: "a", SecretAccessKey: "s"})
+	require.NoError(t, err)
+	assert.True(t, minio.pathStyle)
+	assert.Equal(t, "http://minio:9000", minio.endpoint)
+
+	_, err = NewS3(Config{Provider: ProviderMinIO, Bucket: "b", AccessKeyID: "a", SecretAccessKey: "s"})
+	assert.Error(t, err)
+
+	_, err = NewS3(Config{Provider: "azure", Bucket: "b", AccessKeyID: "a", SecretAccessKey: "s"})
+	assert.Error(t, err)
+
+	_, err = NewS3(Config{Bucket: "b"})
+	assert.Error(t, err)
+}
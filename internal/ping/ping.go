@@ -0,0 +1,188 @@
+// Package ping reports CronJob runs to external ping-based monitoring
+// services such as Healthchecks.io and Cronitor, so teams with existing
+// ping-based monitoring can keep using it for CronJobs guardian watches.
+package ping
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Providers
+const (
+	ProviderHealthchecks = "healthchecks"
+	ProviderCronitor     = "cronitor"
+	ProviderCustom       = "custom"
+)
+
+// pingTimeout bounds a ping including retries
+const pingTimeout = time.Minute
+
+// URLData is available to ping URL templates
+type URLData struct {
+	Namespace string
+	Name      string
+	JobName   string
+}
+
+// Pinger sends healthcheck pings for completed runs. Pings are sent in the
+// background so a slow service never delays execution recording.
+type Pinger struct {
+	client client.Client
+	retry  alerting.RetryConfig
+}
+
+// NewPinger creates a pinger reading URL Secrets with the given client
+func NewPinger(c client.Client) *Pinger {
+	return &Pinger{client: c, retry: alerting.DefaultRetryConfig()}
+}
+
+// PingRun sends the monitor's pings that apply to a completed execution
+func (p *Pinger) PingRun(ctx context.Context, monitor *v1alpha1.CronJobMonitor, exec store.Execution) {
+	for _, cfg := range monitor.Spec.HealthcheckPings {
+		if !Applies(cfg, exec.CronJobName, exec.Succeeded) {
+			continue
+		}
+		go p.send(context.WithoutCancel(ctx), monitor.Namespace, cfg, exec)
+	}
+}
+
+// Applies reports whether a ping config reports a run of the CronJob
+func Applies(cfg v1alpha1.HealthcheckPing, cronJob string, succeeded bool) bool {
+	if len(cfg.CronJobs) > 0 && !slices.Contains(cfg.CronJobs, cronJob) {
+		return false
+	}
+	return succeeded || cfg.ReportFailures
+}
+
+func (p *Pinger) send(ctx context.Context, namespace string, cfg v1alpha1.HealthcheckPing, exec store.Execution) {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	logger := log.FromContext(ctx).WithValues("cronjob", exec.CronJobNamespace+"/"+exec.CronJobName, "provider", cfg.Provider)
+
+	result := "success"
+	if err := p.ping(ctx, namespace, cfg, exec); err != nil {
+		result = "failed"
+		logger.Error(err, "failed to send healthcheck ping")
+	} else {
+		logger.V(1).Info("sent healthcheck ping", "succeeded", exec.Succeeded)
+	}
+	metrics.RecordHealthcheckPing(exec.CronJobNamespace, exec.CronJobName, result)
+}
+
+func (p *Pinger) ping(ctx context.Context, namespace string, cfg v1alpha1.HealthcheckPing, exec store.Execution) error {
+	baseURL := cfg.URL
+	if cfg.URLSecretRef != nil {
+		var err error
+		if baseURL, err = p.secretURL(ctx, namespace, cfg.URLSecretRef); err != nil {
+			return err
+		}
+	}
+
+	data := URLData{Namespace: exec.CronJobNamespace, Name: exec.CronJobName, JobName: exec.JobName}
+	target, err := TargetURL(cfg, baseURL, data, exec.Succeeded)
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := alerting.SendWithRetry(ctx, req, p.retry)
+	if err != nil {
+		// The URL may embed a ping key, so it is left out of the error
+		return fmt.Errorf("ping request failed")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("ping returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *Pinger) secretURL(ctx context.Context, namespace string, ref *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get ping URL secret: %w", err)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("ping URL secret missing '%s' key", ref.Key)
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+// TargetURL returns the URL to ping for a run outcome, or "" if the outcome
+// is not reported (custom provider without a failure URL)
+func TargetURL(cfg v1alpha1.HealthcheckPing, baseURL string, data URLData, succeeded bool) (string, error) {
+	if !succeeded && cfg.Provider != ProviderHealthchecks && cfg.Provider != ProviderCronitor {
+		baseURL = cfg.FailureURL
+	}
+	if baseURL == "" {
+		if succeeded {
+			return "", fmt.Errorf("no ping URL configured")
+		}
+		return "", nil
+	}
+
+	rendered, err := render(baseURL, data)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(rendered)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid ping URL")
+	}
+
+	switch cfg.Provider {
+	case ProviderHealthchecks:
+		if !succeeded {
+			u.Path = strings.TrimSuffix(u.Path, "/") + "/fail"
+		}
+	case ProviderCronitor:
+		q := u.Query()
+		if succeeded {
+			q.Set("state", "complete")
+		} else {
+			q.Set("state", "fail")
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.String(), nil
+}
+
+// render executes a ping URL template
+func render(s string, data URLData) (string, error) {
+	tmpl, err := template.New("url").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid ping URL template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render ping URL: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,154 @@
+package ping
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestTargetURL(t *testing.T) {
+	data := URLData{Namespace: "data", Name: "nightly-etl", JobName: "nightly-etl-123"}
+
+	tests := []struct {
+		name      string
+		cfg       v1alpha1.HealthcheckPing
+		succeeded bool
+		want      string
+	}{
+		{
+			name:      "custom success",
+			cfg:       v1alpha1.HealthcheckPing{URL: "https://ping.example.com/{{ .Namespace }}/{{ .Name }}"},
+			succeeded: true,
+			want:      "https://ping.example.com/data/nightly-etl",
+		},
+		{
+			name:      "custom failure without failure URL",
+			cfg:       v1alpha1.HealthcheckPing{URL: "https://ping.example.com/ok"},
+			succeeded: false,
+			want:      "",
+		},
+		{
+			name:      "custom failure URL",
+			cfg:       v1alpha1.HealthcheckPing{URL: "https://ping.example.com/ok", FailureURL: "https://ping.example.com/fail?job={{ .JobName }}"},
+			succeeded: false,
+			want:      "https://ping.example.com/fail?job=nightly-etl-123",
+		},
+		{
+			name:      "healthchecks success",
+			cfg:       v1alpha1.HealthcheckPing{Provider: ProviderHealthchecks, URL: "https://hc-ping.com/key/{{ .Name }}"},
+			succeeded: true,
+			want:      "https://hc-ping.com/key/nightly-etl",
+		},
+		{
+			name:      "healthchecks failure",
+			cfg:       v1alpha1.HealthcheckPing{Provider: ProviderHealthchecks, URL: "https://hc-ping.com/key/{{ .Name }}"},
+			succeeded: false,
+			want:      "https://hc-ping.com/key/nightly-etl/fail",
+		},
+		{
+			name:      "cronitor success",
+			cfg:       v1alpha1.HealthcheckPing{Provider: ProviderCronitor, URL: "https://cronitor.link/p/key/{{ .Name }}"},
+			succeeded: true,
+			want:      "https://cronitor.link/p/key/nightly-etl?state=complete",
+		},
+		{
+			name:      "cronitor failure",
+			cfg:       v1alpha1.HealthcheckPing{Provider: ProviderCronitor, URL: "https://cronitor.link/p/key/{{ .Name }}"},
+			succeeded: false,
+			want:      "https://cronitor.link/p/key/nightly-etl?state=fail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TargetURL(tt.cfg, tt.cfg.URL, data, tt.succeeded)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTargetURL_Invalid(t *testing.T) {
+	data := URLData{Namespace: "data", Name: "nightly-etl"}
+
+	_, err := TargetURL(v1alpha1.HealthcheckPing{}, "", data, true)
+	assert.Error(t, err, "missing URL")
+
+	_, err = TargetURL(v1alpha1.HealthcheckPing{}, "https://ping.example.com/{{ .Unknown }}", data, true)
+	assert.Error(t, err, "unknown template field")
+
+	_, err = TargetURL(v1alpha1.HealthcheckPing{}, "not a url", data, true)
+	assert.Error(t, err, "not a URL")
+}
+
+func TestApplies(t *testing.T) {
+	all := v1alpha1.HealthcheckPing{}
+	assert.True(t, Applies(all, "backup", true))
+	assert.False(t, Applies(all, "backup", false), "failures are opt-in")
+
+	selected := v1alpha1.HealthcheckPing{CronJobs: []string{"backup"}, ReportFailures: true}
+	assert.True(t, Applies(selected, "backup", false))
+	assert.False(t, Applies(selected, "report", true))
+}
+
+func TestPinger_PingRun(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ping-url", Namespace: "data"},
+		Data:       map[string][]byte{"url": []byte(server.URL + "/key/{{ .Name }}\n")},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	p := NewPinger(fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build())
+
+	monitor := &v1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "etl", Namespace: "data"},
+		Spec: v1alpha1.CronJobMonitorSpec{
+			HealthcheckPings: []v1alpha1.HealthcheckPing{
+				{
+					Provider:       ProviderHealthchecks,
+					ReportFailures: true,
+					URLSecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "ping-url"},
+						Key:                  "url",
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	p.PingRun(ctx, monitor, store.Execution{CronJobNamespace: "data", CronJobName: "nightly-etl", Succeeded: true})
+	p.PingRun(ctx, monitor, store.Execution{CronJobNamespace: "data", CronJobName: "hourly-sync", Succeeded: false})
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(paths) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []string{"/key/nightly-etl", "/key/hourly-sync/fail"}, paths)
+}
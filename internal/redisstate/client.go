@@ -0,0 +1,52 @@
+package redisstate
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisDefaultPort = "6379"
+
+// ClientConfig configures the connection to Redis
+type ClientConfig struct {
+	// Address is host:port (port defaults to 6379)
+	Address string
+	// Username for Redis 6 ACL authentication (empty = default user)
+	Username string
+	// Password enables AUTH when set
+	Password string
+	// DB is the logical database selected after connecting
+	DB int
+	// TLS enables TLS connections
+	TLS bool
+}
+
+// NewClient creates a go-redis client. Connections are established on first
+// use and re-established by the client's pool after errors.
+func NewClient(cfg ClientConfig) (*redis.Client, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("redis address is required")
+	}
+	addr := cfg.Address
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+		addr = net.JoinHostPort(addr, redisDefaultPort)
+	}
+
+	opts := &redis.Options{
+		Addr:     addr,
+		Username: cfg.Username,
+		Password: cfg.Password,
+		DB:       cfg.DB,
+		// Commands are bounded by the caller's context
+		ContextTimeoutEnabled: true,
+	}
+	if cfg.TLS {
+		opts.TLSConfig = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	}
+	return redis.NewClient(opts), nil
+}
@@ -0,0 +1,266 @@
+// Package redisstate keeps the alert dispatcher's shared state in Redis:
+// duplicate suppression state and claims, rate limit counters and the
+// delayed alert queue. All replicas pointing at the same Redis see the same
+// state, and it survives operator restarts without going through the SQL
+// store.
+package redisstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// claimTTL is how long dedup claims are kept. It bounds the longest
+// suppression window enforced across replicas, like the store's claim
+// retention.
+const claimTTL = 7 * 24 * time.Hour
+
+// DefaultKeyPrefix is prepended to every key unless configured otherwise
+const DefaultKeyPrefix = "cronjob-guardian:"
+
+// State implements alerting.SharedState on top of Redis
+type State struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewState creates Redis-backed shared state. keyPrefix separates
+// installations sharing one Redis database.
+func NewState(client *redis.Client, keyPrefix string) *State {
+	return &State{client: client, prefix: keyPrefix}
+}
+
+// Close closes the Redis connection
+func (s *State) Close() error {
+	return s.client.Close()
+}
+
+// Ping checks that Redis is reachable
+func (s *State) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *State) statesKey() string {
+	return s.prefix + "alert-states"
+}
+
+func (s *State) claimKey(claim store.AlertClaim) string {
+	return s.prefix + "alert-claim:" + claim.AlertKey + "|" + claim.Signature
+}
+
+// claimIndexKey is a set of an alert's claim keys, so clearing the alert can
+// delete them without scanning the keyspace
+func (s *State) claimIndexKey(alertKey string) string {
+	return s.prefix + "alert-claims:" + alertKey
+}
+
+func (s *State) counterKey(key string, window time.Duration, now time.Time) string {
+	return s.prefix + "rate:" + key + ":" + strconv.FormatInt(now.UnixMilli()/window.Milliseconds(), 10)
+}
+
+func (s *State) pendingKey() string {
+	return s.prefix + "pending"
+}
+
+func (s *State) pendingPayloadsKey() string {
+	return s.prefix + "pending-payloads"
+}
+
+// SaveAlertState creates or replaces the suppression state of an alert
+func (s *State) SaveAlertState(ctx context.Context, state store.AlertState) error {
+	state.UpdatedAt = time.Now()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := s.client.HSet(ctx, s.statesKey(), state.AlertKey, data).Err(); err != nil {
+		return fmt.Errorf("failed to save alert state: %w", err)
+	}
+	return nil
+}
+
+// DeleteAlertStates removes the suppression state of the given alerts
+func (s *State) DeleteAlertStates(ctx context.Context, alertKeys []string) error {
+	if len(alertKeys) == 0 {
+		return nil
+	}
+	if err := s.client.HDel(ctx, s.statesKey(), alertKeys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete alert states: %w", err)
+	}
+	return nil
+}
+
+// ListAlertStates returns alert states last sent at or after since
+func (s *State) ListAlertStates(ctx context.Context, since time.Time) ([]store.AlertState, error) {
+	states, err := s.allAlertStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]store.AlertState, 0, len(states))
+	for _, state := range states {
+		if !state.LastSentAt.Before(since) {
+			result = append(result, state)
+		}
+	}
+	return result, nil
+}
+
+// PruneAlertStates deletes alert states last sent before olderThan
+func (s *State) PruneAlertStates(ctx context.Context, olderThan time.Time) (int64, error) {
+	states, err := s.allAlertStates(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var stale []string
+	for _, state := range states {
+		if state.LastSentAt.Before(olderThan) {
+			stale = append(stale, state.AlertKey)
+		}
+	}
+	if err := s.DeleteAlertStates(ctx, stale); err != nil {
+		return 0, err
+	}
+	return int64(len(stale)), nil
+}
+
+func (s *State) allAlertStates(ctx context.Context) ([]store.AlertState, error) {
+	fields, err := s.client.HGetAll(ctx, s.statesKey()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert states: %w", err)
+	}
+	states := make([]store.AlertState, 0, len(fields))
+	for alertKey, data := range fields {
+		var state store.AlertState
+		if err := json.Unmarshal([]byte(data), &state); err != nil {
+			continue
+		}
+		state.AlertKey = alertKey
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// ClaimAlert records that an alert is sent. The claim expires after the
+// suppression window, and until then it returns false.
+func (s *State) ClaimAlert(ctx context.Context, claim store.AlertClaim, window time.Duration) (bool, error) {
+	key := s.claimKey(claim)
+	claimed, err := s.client.SetNX(ctx, key, claim.ClaimedBy, min(window, claimTTL)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim alert: %w", err)
+	}
+	if !claimed {
+		return false, nil
+	}
+
+	index := s.claimIndexKey(claim.AlertKey)
+	if err := s.client.SAdd(ctx, index, key).Err(); err != nil {
+		return true, fmt.Errorf("failed to index alert claim: %w", err)
+	}
+	if err := s.client.PExpire(ctx, index, claimTTL).Err(); err != nil {
+		return true, fmt.Errorf("failed to index alert claim: %w", err)
+	}
+	return true, nil
+}
+
+// DeleteAlertClaims removes every claim of the given alerts
+func (s *State) DeleteAlertClaims(ctx context.Context, alertKeys []string) error {
+	for _, alertKey := range alertKeys {
+		index := s.claimIndexKey(alertKey)
+		keys, err := s.client.SMembers(ctx, index).Result()
+		if err != nil {
+			return fmt.Errorf("failed to delete alert claims: %w", err)
+		}
+		if err := s.client.Del(ctx, append([]string{index}, keys...)...).Err(); err != nil {
+			return fmt.Errorf("failed to delete alert claims: %w", err)
+		}
+	}
+	return nil
+}
+
+// PruneAlertClaims is a no-op: claims expire on their own
+func (s *State) PruneAlertClaims(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// IncrCounter increments the counter for key in the fixed window containing
+// now and returns its new value. Counters expire with their window.
+func (s *State) IncrCounter(ctx context.Context, key string, window time.Duration, now time.Time) (int64, error) {
+	counter := s.counterKey(key, window, now)
+	n, err := s.client.Incr(ctx, counter).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment rate limit counter: %w", err)
+	}
+	if n == 1 {
+		if err := s.client.PExpire(ctx, counter, window).Err(); err != nil {
+			return n, fmt.Errorf("failed to expire rate limit counter: %w", err)
+		}
+	}
+	return n, nil
+}
+
+// DecrCounter gives back an increment taken in the window containing now
+func (s *State) DecrCounter(ctx context.Context, key string, window time.Duration, now time.Time) error {
+	if err := s.client.Decr(ctx, s.counterKey(key, window, now)).Err(); err != nil {
+		return fmt.Errorf("failed to decrement rate limit counter: %w", err)
+	}
+	return nil
+}
+
+// AddPendingAlert queues a delayed alert. It returns false if the alert is
+// already queued.
+func (s *State) AddPendingAlert(ctx context.Context, alertKey string, payload []byte, sendAt time.Time) (bool, error) {
+	added, err := s.client.ZAddNX(ctx, s.pendingKey(), redis.Z{Score: float64(sendAt.UnixMilli()), Member: alertKey}).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to queue pending alert: %w", err)
+	}
+	if added == 0 {
+		return false, nil
+	}
+	if err := s.client.HSet(ctx, s.pendingPayloadsKey(), alertKey, payload).Err(); err != nil {
+		return true, fmt.Errorf("failed to queue pending alert: %w", err)
+	}
+	return true, nil
+}
+
+// RemovePendingAlert dequeues a delayed alert. It returns false if the alert
+// was not queued, e.g. because another replica already sent or cancelled it.
+func (s *State) RemovePendingAlert(ctx context.Context, alertKey string) (bool, error) {
+	removed, err := s.client.ZRem(ctx, s.pendingKey(), alertKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to remove pending alert: %w", err)
+	}
+	if err := s.client.HDel(ctx, s.pendingPayloadsKey(), alertKey).Err(); err != nil {
+		return removed == 1, fmt.Errorf("failed to remove pending alert: %w", err)
+	}
+	return removed == 1, nil
+}
+
+// ListPendingAlerts returns the payloads of all queued alerts by alert key
+func (s *State) ListPendingAlerts(ctx context.Context) (map[string][]byte, error) {
+	keys, err := s.client.ZRange(ctx, s.pendingKey(), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending alerts: %w", err)
+	}
+	if len(keys) == 0 {
+		return map[string][]byte{}, nil
+	}
+	payloads, err := s.client.HMGet(ctx, s.pendingPayloadsKey(), keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending alerts: %w", err)
+	}
+	result := make(map[string][]byte, len(keys))
+	for i, key := range keys {
+		// A missing payload means the queueing replica stopped halfway
+		if payload, ok := payloads[i].(string); ok && payload != "" {
+			result[key] = []byte(payload)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,181 @@
+package redisstate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func newTestState(t *testing.T, srv *miniredis.Miniredis, cfg ClientConfig) *State {
+	t.Helper()
+	cfg.Address = srv.Addr()
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return NewState(client, DefaultKeyPrefix)
+}
+
+func TestNewClient_Validation(t *testing.T) {
+	_, err := NewClient(ClientConfig{})
+	assert.Error(t, err)
+
+	c, err := NewClient(ClientConfig{Address: "redis.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "redis.example.com:6379", c.Options().Addr)
+}
+
+func TestClient_AuthAndSelect(t *testing.T) {
+	// Credentials are set before any client connects
+	srv := miniredis.RunT(t)
+	srv.RequireUserAuth("guardian", "s3cr3t")
+
+	state := newTestState(t, srv, ClientConfig{Username: "guardian", Password: "wrong"})
+	assert.ErrorContains(t, state.Ping(t.Context()), "WRONGPASS")
+
+	state = newTestState(t, srv, ClientConfig{Username: "guardian", Password: "s3cr3t", DB: 3})
+	require.NoError(t, state.Ping(t.Context()))
+	require.NoError(t, state.SaveAlertState(t.Context(), store.AlertState{AlertKey: "default/a/JobFailed"}))
+	assert.True(t, srv.DB(3).Exists(state.statesKey()))
+	assert.False(t, srv.Exists(state.statesKey()))
+}
+
+func TestClient_Reconnects(t *testing.T) {
+	srv := miniredis.RunT(t)
+	state := newTestState(t, srv, ClientConfig{})
+	require.NoError(t, state.Ping(t.Context()))
+
+	// Drop the connection under the client
+	srv.Close()
+	assert.Error(t, state.Ping(t.Context()))
+	require.NoError(t, srv.Restart())
+	assert.NoError(t, state.Ping(t.Context()))
+}
+
+func TestState_AlertStates(t *testing.T) {
+	ctx := context.Background()
+	state := newTestState(t, miniredis.RunT(t), ClientConfig{})
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, state.SaveAlertState(ctx, store.AlertState{AlertKey: "default/a/JobFailed", Payload: `{"key":"a"}`, LastSentAt: now}))
+	require.NoError(t, state.SaveAlertState(ctx, store.AlertState{AlertKey: "default/b/JobFailed", LastSentAt: now.Add(-48 * time.Hour), AcknowledgedBy: "alice"}))
+
+	states, err := state.ListAlertStates(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, "default/a/JobFailed", states[0].AlertKey)
+	assert.Equal(t, `{"key":"a"}`, states[0].Payload)
+	assert.True(t, now.Equal(states[0].LastSentAt))
+
+	pruned, err := state.PruneAlertStates(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	require.NoError(t, state.DeleteAlertStates(ctx, []string{"default/a/JobFailed"}))
+	states, err = state.ListAlertStates(ctx, time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
+
+func TestState_ClaimAlert(t *testing.T) {
+	ctx := context.Background()
+	srv := miniredis.RunT(t)
+	state := newTestState(t, srv, ClientConfig{})
+	claim := store.AlertClaim{AlertKey: "default/a/JobFailed", Signature: "error|", WindowStart: time.Now(), ClaimedBy: "pod-1"}
+
+	claimed, err := state.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claim.ClaimedBy = "pod-2"
+	claimed, err = state.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, claimed, "claimed by pod-1 within the window")
+
+	// A different error signature gets its own claim
+	other := claim
+	other.Signature = "oom|OOMKilled"
+	claimed, err = state.ClaimAlert(ctx, other, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The claim expires with the window
+	assert.Equal(t, time.Hour, srv.TTL(state.claimKey(claim)))
+	srv.FastForward(time.Hour)
+	claimed, err = state.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Windows longer than the claim retention are capped
+	long := claim
+	long.Signature = "long|"
+	claimed, err = state.ClaimAlert(ctx, long, 30*24*time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, claimTTL, srv.TTL(state.claimKey(long)))
+
+	// Clearing the alert releases its claims
+	require.NoError(t, state.DeleteAlertClaims(ctx, []string{"default/a/JobFailed"}))
+	claimed, err = state.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestState_Counters(t *testing.T) {
+	ctx := context.Background()
+	state := newTestState(t, miniredis.RunT(t), ClientConfig{})
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	for i := int64(1); i <= 3; i++ {
+		n, err := state.IncrCounter(ctx, "channel:slack", time.Hour, now)
+		require.NoError(t, err)
+		assert.Equal(t, i, n)
+	}
+	require.NoError(t, state.DecrCounter(ctx, "channel:slack", time.Hour, now))
+	n, err := state.IncrCounter(ctx, "channel:slack", time.Hour, now.Add(10*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	// The next window starts from zero
+	n, err = state.IncrCounter(ctx, "channel:slack", time.Hour, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+}
+
+func TestState_PendingAlerts(t *testing.T) {
+	ctx := context.Background()
+	state := newTestState(t, miniredis.RunT(t), ClientConfig{})
+	now := time.Now()
+
+	added, err := state.AddPendingAlert(ctx, "default/a/JobFailed", []byte(`{"a":1}`), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = state.AddPendingAlert(ctx, "default/a/JobFailed", []byte(`{"a":2}`), now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, added)
+	_, err = state.AddPendingAlert(ctx, "default/b/JobFailed", []byte(`{"b":1}`), now)
+	require.NoError(t, err)
+
+	pending, err := state.ListPendingAlerts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"default/a/JobFailed": []byte(`{"a":1}`),
+		"default/b/JobFailed": []byte(`{"b":1}`),
+	}, pending)
+
+	removed, err := state.RemovePendingAlert(ctx, "default/a/JobFailed")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = state.RemovePendingAlert(ctx, "default/a/JobFailed")
+	require.NoError(t, err)
+	assert.False(t, removed, "already taken")
+
+	pending, err = state.ListPendingAlerts(ctx)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+}
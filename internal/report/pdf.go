@@ -0,0 +1,79 @@
+package report
+
+import (
+	"io"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// A4 page layout in points
+const (
+	pdfMargin  = 50.0
+	pdfRowSize = 9.0
+	pdfFont    = "Helvetica"
+)
+
+// pdfDocument lays out lines of text and tables on A4 pages. It uses the
+// standard Helvetica fonts every PDF reader provides, so no fonts are
+// embedded and text is limited to the characters of code page 1252.
+type pdfDocument struct {
+	pdf *fpdf.Fpdf
+	tr  func(string) string // UTF-8 to the fonts' encoding
+}
+
+func newPDFDocument(title string) *pdfDocument {
+	pdf := fpdf.New("P", "pt", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.SetCreator("CronJob Guardian", true)
+	pdf.SetTitle(title, true)
+	pdf.AddPage()
+	return &pdfDocument{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+}
+
+// space leaves a gap of the given height
+func (d *pdfDocument) space(height float64) {
+	d.pdf.Ln(height)
+}
+
+// line adds a line of text at the left margin
+func (d *pdfDocument) line(size float64, bold bool, text string) {
+	d.setFont(size, bold)
+	d.pdf.CellFormat(0, size*1.4, d.tr(text), "", 1, "L", false, 0, "")
+}
+
+// row adds a table row, truncating cells to their column
+func (d *pdfDocument) row(bold bool, columns []pdfColumn, cells []string) {
+	d.setFont(pdfRowSize, bold)
+	for i, c := range columns {
+		d.pdf.CellFormat(c.width, pdfRowSize*1.6, d.fit(d.tr(cells[i]), c.width), "", 0, "L", false, 0, "")
+	}
+	d.pdf.Ln(-1)
+}
+
+func (d *pdfDocument) setFont(size float64, bold bool) {
+	style := ""
+	if bold {
+		style = "B"
+	}
+	d.pdf.SetFont(pdfFont, style, size)
+}
+
+// fit shortens encoded text to the width of a column, marking cut text
+// with "..."
+func (d *pdfDocument) fit(s string, width float64) string {
+	// Keep a gap to the next column
+	width -= 4
+	if d.pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	for len(s) > 0 && d.pdf.GetStringWidth(s+"...") > width {
+		s = s[:len(s)-1]
+	}
+	return s + "..."
+}
+
+// write writes the document as PDF
+func (d *pdfDocument) write(w io.Writer) error {
+	return d.pdf.Output(w)
+}
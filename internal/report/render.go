@@ -0,0 +1,206 @@
+package report
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// dateLayout formats period boundaries in reports
+const dateLayout = "2006-01-02"
+
+// Filename returns the file name of a report download with the given extension
+func (r SLAReport) Filename(ext string) string {
+	return fmt.Sprintf("sla-report-%s-%s.%s", r.Period, r.Start.Format(dateLayout), ext)
+}
+
+// Subject returns the subject of a report email
+func (r SLAReport) Subject() string {
+	return fmt.Sprintf("[CronJob Guardian] %s, %s: %d of %d CronJobs below SLA",
+		r.Title, r.PeriodLabel(), r.Breached(), len(r.CronJobs))
+}
+
+// PeriodLabel returns the reported period, e.g. "week of 2026-10-05" or "September 2026"
+func (r SLAReport) PeriodLabel() string {
+	if r.Period == PeriodMonthly {
+		return r.Start.Format("January 2006")
+	}
+	return "week of " + r.Start.Format(dateLayout)
+}
+
+// CronJobURL returns the dashboard page for a CronJob in the report
+func (r SLAReport) CronJobURL(cj CronJobSLA) string {
+	if r.DashboardURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/cronjob/%s/%s", strings.TrimSuffix(r.DashboardURL, "/"), url.PathEscape(cj.Namespace), url.PathEscape(cj.Name))
+}
+
+// formatMTTR formats a mean time to recovery, "-" without recoveries
+func formatMTTR(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(time.Second).String()
+}
+
+// formatTarget formats an SLA target, "-" without SLA
+func formatTarget(target float64) string {
+	if target == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", target)
+}
+
+var templateFuncs = htmltemplate.FuncMap{
+	"date":   func(t time.Time) string { return t.Format(dateLayout) },
+	"mttr":   formatMTTR,
+	"target": formatTarget,
+}
+
+// RenderHTML writes the report as an HTML document, styled inline so it
+// renders the same in email clients
+func RenderHTML(w io.Writer, r *SLAReport) error {
+	if err := htmlTemplate.Execute(w, r); err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+	return nil
+}
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("report").Funcs(templateFuncs).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{ .Title }}</title></head>
+<body style="font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; color: #1f2937; margin: 0; padding: 24px;">
+<h2 style="margin: 0 0 4px 0;">{{ .Title }}</h2>
+<p style="margin: 0 0 16px 0; color: #6b7280;">{{ .PeriodLabel }} &middot; {{ date .Start }} &ndash; {{ date .End }}</p>
+<table cellpadding="6" style="border-collapse: collapse; margin-bottom: 16px;">
+<tr><td style="color: #6b7280;">CronJobs</td><td>{{ len .CronJobs }}</td></tr>
+<tr><td style="color: #6b7280;">Runs</td><td>{{ .TotalRuns }} ({{ .TotalFailures }} failed)</td></tr>
+<tr><td style="color: #6b7280;">Success rate</td><td>{{ printf "%.2f" .SuccessRate }}%</td></tr>
+<tr><td style="color: #6b7280;">Below SLA</td><td>{{ .Breached }}</td></tr>
+<tr><td style="color: #6b7280;">SLA breach alerts</td><td>{{ .TotalBreaches }}</td></tr>
+<tr><td style="color: #6b7280;">MTTR</td><td>{{ mttr .MTTR }}</td></tr>
+</table>
+{{- with .WorstOffenders }}
+<h3>Worst offenders</h3>
+<table cellpadding="6" style="border-collapse: collapse; border: 1px solid #e5e7eb; margin-bottom: 16px;">
+<tr style="background: #f3f4f6; text-align: left;"><th>CronJob</th><th>Success rate</th><th>Failures</th><th>Incidents</th><th>MTTR</th></tr>
+{{- range . }}
+<tr style="border-top: 1px solid #e5e7eb;">
+<td>{{ .Namespace }}/{{ .Name }}</td>
+<td style="color: #dc2626;">{{ printf "%.1f" .SuccessRate }}%</td>
+<td>{{ .Failures }}</td>
+<td>{{ .Incidents }}</td>
+<td>{{ mttr .MTTR }}</td>
+</tr>
+{{- end }}
+</table>
+{{- end }}
+<h3>CronJobs</h3>
+<table cellpadding="6" style="border-collapse: collapse; border: 1px solid #e5e7eb;">
+<tr style="background: #f3f4f6; text-align: left;"><th>CronJob</th><th>Monitor</th><th>Team</th><th>Target</th><th>Success rate</th><th>Runs</th><th>Failures</th><th>Breach alerts</th><th>MTTR</th></tr>
+{{- range .CronJobs }}
+<tr style="border-top: 1px solid #e5e7eb;">
+{{- $url := $.CronJobURL . }}
+<td>{{ if $url }}<a href="{{ $url }}">{{ .Namespace }}/{{ .Name }}</a>{{ else }}{{ .Namespace }}/{{ .Name }}{{ end }}</td>
+<td>{{ .Monitor }}</td>
+<td>{{ or .Team "-" }}</td>
+<td>{{ target .Target }}</td>
+<td style="color: {{ if .Breached }}#dc2626{{ else }}#16a34a{{ end }};">{{ printf "%.1f" .SuccessRate }}%</td>
+<td>{{ .Runs }}</td>
+<td>{{ .Failures }}</td>
+<td>{{ .Breaches }}</td>
+<td>{{ mttr .MTTR }}</td>
+</tr>
+{{- else }}
+<tr><td colspan="9" style="color: #6b7280;">No monitored CronJobs</td></tr>
+{{- end }}
+</table>
+<p style="color: #9ca3af; font-size: 12px;">Generated by CronJob Guardian at {{ .GeneratedAt.UTC.Format "2006-01-02 15:04 MST" }}</p>
+</body>
+</html>
+`))
+
+// pdfColumn is a column of a PDF table, width points wide
+type pdfColumn struct {
+	title string
+	width float64
+}
+
+var (
+	pdfWorstColumns = []pdfColumn{
+		{"CronJob", 260}, {"Success rate", 70}, {"Failures", 55}, {"Incidents", 55}, {"MTTR", 55},
+	}
+	pdfCronJobColumns = []pdfColumn{
+		{"CronJob", 190}, {"Target", 50}, {"Success", 50}, {"Runs", 40}, {"Failures", 50}, {"Breaches", 50}, {"MTTR", 65},
+	}
+)
+
+// RenderPDF writes the report as a PDF document
+func RenderPDF(w io.Writer, r *SLAReport) error {
+	doc := newPDFDocument(r.Title)
+
+	doc.line(18, true, r.Title)
+	doc.line(10, false, fmt.Sprintf("%s, %s - %s", r.PeriodLabel(), r.Start.Format(dateLayout), r.End.Format(dateLayout)))
+	doc.space(10)
+
+	doc.line(10, false, fmt.Sprintf("CronJobs: %d", len(r.CronJobs)))
+	doc.line(10, false, fmt.Sprintf("Runs: %d (%d failed)", r.TotalRuns(), r.TotalFailures()))
+	doc.line(10, false, fmt.Sprintf("Success rate: %.2f%%", r.SuccessRate()))
+	doc.line(10, false, fmt.Sprintf("Below SLA: %d", r.Breached()))
+	doc.line(10, false, fmt.Sprintf("SLA breach alerts: %d", r.TotalBreaches()))
+	doc.line(10, false, fmt.Sprintf("MTTR: %s", formatMTTR(r.MTTR())))
+
+	if worst := r.WorstOffenders(); len(worst) > 0 {
+		doc.space(10)
+		doc.line(13, true, "Worst offenders")
+		doc.row(true, pdfWorstColumns, columnTitles(pdfWorstColumns))
+		for _, cj := range worst {
+			doc.row(false, pdfWorstColumns, []string{
+				cj.Namespace + "/" + cj.Name,
+				fmt.Sprintf("%.1f%%", cj.SuccessRate()),
+				fmt.Sprint(cj.Failures),
+				fmt.Sprint(cj.Incidents),
+				formatMTTR(cj.MTTR()),
+			})
+		}
+	}
+
+	doc.space(10)
+	doc.line(13, true, "CronJobs")
+	doc.row(true, pdfCronJobColumns, columnTitles(pdfCronJobColumns))
+	for _, cj := range r.CronJobs {
+		doc.row(false, pdfCronJobColumns, []string{
+			cj.Namespace + "/" + cj.Name,
+			formatTarget(cj.Target),
+			fmt.Sprintf("%.1f%%", cj.SuccessRate()),
+			fmt.Sprint(cj.Runs),
+			fmt.Sprint(cj.Failures),
+			fmt.Sprint(cj.Breaches),
+			formatMTTR(cj.MTTR()),
+		})
+	}
+	if len(r.CronJobs) == 0 {
+		doc.line(10, false, "No monitored CronJobs")
+	}
+
+	doc.space(10)
+	doc.line(8, false, "Generated by CronJob Guardian at "+r.GeneratedAt.UTC().Format("2006-01-02 15:04 MST"))
+
+	if err := doc.write(w); err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+	return nil
+}
+
+// columnTitles returns the header row of a table
+func columnTitles(columns []pdfColumn) []string {
+	titles := make([]string, len(columns))
+	for i, c := range columns {
+		titles[i] = c.title
+	}
+	return titles
+}
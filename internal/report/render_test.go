@@ -0,0 +1,90 @@
+package report
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReport() *SLAReport {
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	return &SLAReport{
+		Title:        "SLA report: team payments",
+		Period:       PeriodMonthly,
+		Start:        start,
+		End:          start.AddDate(0, 1, 0),
+		GeneratedAt:  start.AddDate(0, 1, 0),
+		DashboardURL: "https://guardian.example.com/",
+		CronJobs: []CronJobSLA{
+			{Namespace: "default", Name: "backup", Monitor: "default/nightly", Target: 95, Runs: 30, Failures: 3, Incidents: 2, Recovered: 2, RecoveryTime: 3 * time.Hour},
+			{Namespace: "default", Name: "invoices <eu>", Monitor: "default/nightly", Runs: 4},
+		},
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, RenderHTML(&buf, newTestReport()))
+	html := buf.String()
+
+	assert.Contains(t, html, "SLA report: team payments")
+	assert.Contains(t, html, "September 2026")
+	assert.Contains(t, html, "Worst offenders")
+	assert.Contains(t, html, `<a href="https://guardian.example.com/cronjob/default/backup">`)
+	assert.Contains(t, html, "1h30m0s", "MTTR")
+	assert.Contains(t, html, "invoices &lt;eu&gt;")
+}
+
+func TestRenderPDF(t *testing.T) {
+	report := newTestReport()
+	// Enough CronJobs for a second page
+	for i := range 80 {
+		report.CronJobs = append(report.CronJobs, CronJobSLA{Namespace: "batch", Name: fmt.Sprintf("job-%d (nightly)", i), Runs: 1})
+	}
+	report.CronJobs = append(report.CronJobs, CronJobSLA{Namespace: "batch", Name: "café-" + strings.Repeat("x", 80), Runs: 1})
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderPDF(&buf, report))
+	pdf := buf.Bytes()
+
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-")))
+	assert.True(t, bytes.HasSuffix(bytes.TrimSpace(pdf), []byte("%%EOF")))
+	assert.Contains(t, string(pdf), "/Count 2")
+
+	text := pdfContent(t, pdf)
+	assert.Contains(t, text, `(SLA report: team payments)Tj`)
+	assert.Contains(t, text, `(batch/job-0 \(nightly\))Tj`)
+	assert.Contains(t, text, "(batch/caf\xe9-xxx", "text is encoded for the standard fonts")
+	assert.Contains(t, text, "x...)Tj", "long names are cut to their column")
+}
+
+// pdfContent returns the decompressed content streams of a PDF
+func pdfContent(t *testing.T, pdf []byte) string {
+	t.Helper()
+	var content strings.Builder
+	streams := regexp.MustCompile(`(?s)/FlateDecode /Length (\d+)>>\nstream\n`)
+	for _, m := range streams.FindAllSubmatchIndex(pdf, -1) {
+		n, err := strconv.Atoi(string(pdf[m[2]:m[3]]))
+		require.NoError(t, err)
+		r, err := zlib.NewReader(bytes.NewReader(pdf[m[1] : m[1]+n]))
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		content.Write(data)
+	}
+	return content.String()
+}
+
+func TestSubject(t *testing.T) {
+	assert.Equal(t, "[CronJob Guardian] SLA report: team payments, September 2026: 1 of 2 CronJobs below SLA", newTestReport().Subject())
+	assert.Equal(t, "sla-report-monthly-2026-09-01.pdf", newTestReport().Filename("pdf"))
+}
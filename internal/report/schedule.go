@@ -0,0 +1,38 @@
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// DefaultScheduleTime is when scheduled reports are sent without a configured time
+const DefaultScheduleTime = "09:00"
+
+// ScheduledWindow returns the period covered by the most recent scheduled
+// report due at or before now, and when it is due
+func ScheduledWindow(cfg *v1alpha1.EmailSLAReportConfig, now time.Time) (start, end, due time.Time, err error) {
+	loc := time.UTC
+	if cfg.Timezone != "" {
+		if loc, err = time.LoadLocation(cfg.Timezone); err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+		}
+	}
+
+	timeOfDay := cfg.Time
+	if timeOfDay == "" {
+		timeOfDay = DefaultScheduleTime
+	}
+	t, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid time %q, expected HH:MM", timeOfDay)
+	}
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+
+	// Reports are due the time of day after their period ends
+	if start, end, err = PeriodWindow(cfg.Schedule, now.In(loc).Add(-offset)); err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, err
+	}
+	return start, end, end.Add(offset), nil
+}
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+func TestScheduledWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       v1alpha1.EmailSLAReportConfig
+		now       time.Time
+		wantStart time.Time
+		wantDue   time.Time
+	}{
+		{
+			name:      "weekly after the time on Monday",
+			cfg:       v1alpha1.EmailSLAReportConfig{Schedule: "weekly"},
+			now:       time.Date(2024, 1, 8, 9, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantDue:   time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "weekly before the time on Monday is the week before",
+			cfg:       v1alpha1.EmailSLAReportConfig{Schedule: "weekly"},
+			now:       time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+			wantDue:   time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly",
+			cfg:       v1alpha1.EmailSLAReportConfig{Schedule: "monthly", Time: "06:15"},
+			now:       time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantDue:   time.Date(2024, 2, 1, 6, 15, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, _, due, err := ScheduledWindow(&tt.cfg, tt.now)
+			require.NoError(t, err)
+			assert.True(t, tt.wantStart.Equal(start), "start %s", start)
+			assert.True(t, tt.wantDue.Equal(due), "due %s", due)
+		})
+	}
+
+	_, _, _, err := ScheduledWindow(&v1alpha1.EmailSLAReportConfig{Schedule: "weekly", Timezone: "Mars/Olympus"}, time.Now())
+	assert.Error(t, err)
+}
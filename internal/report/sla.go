@@ -0,0 +1,380 @@
+// Package report builds SLA reports of monitored CronJobs over a calendar
+// week or month and renders them as HTML or PDF, for download from the API
+// and for scheduled delivery through email channels.
+package report
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// Report periods
+const (
+	PeriodWeekly  = "weekly"
+	PeriodMonthly = "monthly"
+)
+
+const (
+	// defaultMinSuccessRate is the SLA target of monitors without minSuccessRate
+	defaultMinSuccessRate = 95.0
+
+	// worstOffendersLimit caps the worst offenders of a report
+	worstOffendersLimit = 5
+)
+
+// PeriodWindow returns the last complete calendar week, starting on Monday,
+// or month before t, in t's location
+func PeriodWindow(period string, t time.Time) (start, end time.Time, err error) {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch period {
+	case PeriodWeekly:
+		end = midnight.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+		return end.AddDate(0, 0, -7), end, nil
+	case PeriodMonthly:
+		end = midnight.AddDate(0, 0, 1-t.Day())
+		return end.AddDate(0, -1, 0), end, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid period %q, expected %s or %s", period, PeriodWeekly, PeriodMonthly)
+	}
+}
+
+// Scope selects the CronJobs a report covers. Without Monitor, it covers the
+// CronJobs of every monitor passing Filter.
+type Scope struct {
+	// Monitor limits the report to one monitor's CronJobs
+	Monitor *types.NamespacedName
+	// Team limits the report to the CronJobs a team owns
+	Team string
+	// Filter limits the monitors whose CronJobs are covered (nil = all)
+	Filter func(*v1alpha1.CronJobMonitor) bool
+	// Name describes the scope in the title, defaulting to the monitor or team
+	Name string
+}
+
+// SLAReport summarises the SLA compliance of CronJobs over a period
+type SLAReport struct {
+	Title       string
+	Period      string
+	Start       time.Time
+	End         time.Time
+	GeneratedAt time.Time
+	CronJobs    []CronJobSLA
+	// DashboardURL is the external dashboard the report links to (empty = no links)
+	DashboardURL string
+}
+
+// CronJobSLA is one CronJob's SLA compliance in a report
+type CronJobSLA struct {
+	Namespace string
+	Name      string
+	Monitor   string // namespace/name of the monitor tracking the CronJob
+	Team      string
+	// Target is the minimum success rate, 0 if the monitor has no SLA
+	Target   float64
+	Runs     int
+	Failures int
+	// Breaches is the number of SLA breach alerts raised in the period
+	Breaches int
+	// Incidents are the failure streaks started in the period
+	Incidents int
+	// RecoveryTime is the total time from the first failure of each
+	// incident to the next success, for recovered incidents
+	RecoveryTime time.Duration
+	Recovered    int
+}
+
+// SuccessRate returns the percentage of successful runs in the period
+func (c CronJobSLA) SuccessRate() float64 {
+	if c.Runs == 0 {
+		return 100
+	}
+	return float64(c.Runs-c.Failures) / float64(c.Runs) * 100
+}
+
+// Breached reports whether the CronJob's success rate in the period is below its target
+func (c CronJobSLA) Breached() bool {
+	return c.Target > 0 && c.Runs > 0 && c.SuccessRate() < c.Target
+}
+
+// MTTR returns the mean time to recovery, zero without recovered incidents
+func (c CronJobSLA) MTTR() time.Duration {
+	if c.Recovered == 0 {
+		return 0
+	}
+	return c.RecoveryTime / time.Duration(c.Recovered)
+}
+
+// TotalRuns returns the runs of all CronJobs in the period
+func (r SLAReport) TotalRuns() int {
+	total := 0
+	for _, cj := range r.CronJobs {
+		total += cj.Runs
+	}
+	return total
+}
+
+// TotalFailures returns the failures of all CronJobs in the period
+func (r SLAReport) TotalFailures() int {
+	total := 0
+	for _, cj := range r.CronJobs {
+		total += cj.Failures
+	}
+	return total
+}
+
+// SuccessRate returns the percentage of successful runs of all CronJobs
+func (r SLAReport) SuccessRate() float64 {
+	runs := r.TotalRuns()
+	if runs == 0 {
+		return 100
+	}
+	return float64(runs-r.TotalFailures()) / float64(runs) * 100
+}
+
+// Breached returns the number of CronJobs below their SLA target
+func (r SLAReport) Breached() int {
+	breached := 0
+	for _, cj := range r.CronJobs {
+		if cj.Breached() {
+			breached++
+		}
+	}
+	return breached
+}
+
+// TotalBreaches returns the SLA breach alerts raised in the period
+func (r SLAReport) TotalBreaches() int {
+	total := 0
+	for _, cj := range r.CronJobs {
+		total += cj.Breaches
+	}
+	return total
+}
+
+// MTTR returns the mean time to recovery over all recovered incidents
+func (r SLAReport) MTTR() time.Duration {
+	var total time.Duration
+	recovered := 0
+	for _, cj := range r.CronJobs {
+		total += cj.RecoveryTime
+		recovered += cj.Recovered
+	}
+	if recovered == 0 {
+		return 0
+	}
+	return total / time.Duration(recovered)
+}
+
+// WorstOffenders returns the CronJobs with failures, lowest success rate first
+func (r SLAReport) WorstOffenders() []CronJobSLA {
+	var worst []CronJobSLA
+	for _, cj := range r.CronJobs {
+		if cj.Failures > 0 {
+			worst = append(worst, cj)
+		}
+	}
+	slices.SortStableFunc(worst, func(a, b CronJobSLA) int {
+		return cmp.Or(cmp.Compare(a.SuccessRate(), b.SuccessRate()), cmp.Compare(b.Failures, a.Failures))
+	})
+	return worst[:min(len(worst), worstOffendersLimit)]
+}
+
+// Builder builds SLA reports from monitors and execution history
+type Builder struct {
+	client    client.Client
+	store     store.Store
+	ownership config.OwnershipConfig
+}
+
+// NewBuilder creates a report builder. Ownership resolves the teams of CronJobs.
+func NewBuilder(c client.Client, st store.Store, ownership config.OwnershipConfig) *Builder {
+	return &Builder{client: c, store: st, ownership: ownership}
+}
+
+// Build reports on the CronJobs in scope between start and end
+func (b *Builder) Build(ctx context.Context, scope Scope, period string, start, end time.Time) (*SLAReport, error) {
+	var monitors []v1alpha1.CronJobMonitor
+	if scope.Monitor != nil {
+		monitor := &v1alpha1.CronJobMonitor{}
+		if err := b.client.Get(ctx, *scope.Monitor, monitor); err != nil {
+			return nil, err
+		}
+		monitors = append(monitors, *monitor)
+	} else {
+		list := &v1alpha1.CronJobMonitorList{}
+		if err := b.client.List(ctx, list); err != nil {
+			return nil, fmt.Errorf("failed to list monitors: %w", err)
+		}
+		monitors = list.Items
+	}
+	// A CronJob selected by several monitors is reported under the first by name
+	slices.SortFunc(monitors, func(a, b v1alpha1.CronJobMonitor) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	breaches, err := b.breaches(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SLAReport{
+		Title:       "SLA report: " + scopeName(scope),
+		Period:      period,
+		Start:       start,
+		End:         end,
+		GeneratedAt: time.Now(),
+	}
+	seen := make(map[types.NamespacedName]bool)
+	for i := range monitors {
+		monitor := &monitors[i]
+		if scope.Filter != nil && !scope.Filter(monitor) {
+			continue
+		}
+		for _, cjStatus := range monitor.Status.CronJobs {
+			nn := types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}
+			if seen[nn] {
+				continue
+			}
+			seen[nn] = true
+			team, err := b.teamFor(ctx, nn)
+			if err != nil {
+				return nil, err
+			}
+			if scope.Team != "" && team != scope.Team {
+				continue
+			}
+
+			cj := CronJobSLA{
+				Namespace: nn.Namespace,
+				Name:      nn.Name,
+				Monitor:   monitor.Namespace + "/" + monitor.Name,
+				Team:      team,
+				Target:    slaTarget(monitor.Spec.SLA),
+				Breaches:  breaches[nn],
+			}
+			if err := b.addExecutions(ctx, &cj, nn, start, end); err != nil {
+				return nil, err
+			}
+			report.CronJobs = append(report.CronJobs, cj)
+		}
+	}
+
+	slices.SortFunc(report.CronJobs, func(a, b CronJobSLA) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return report, nil
+}
+
+// addExecutions counts a CronJob's runs in the period and the recovery of
+// its failure streaks. An incident started in the period may recover after it.
+func (b *Builder) addExecutions(ctx context.Context, cj *CronJobSLA, nn types.NamespacedName, start, end time.Time) error {
+	execs, err := b.store.GetExecutions(ctx, nn, start)
+	if err != nil {
+		return fmt.Errorf("failed to get executions for %s: %w", nn, err)
+	}
+	// Executions come newest first
+	slices.Reverse(execs)
+
+	var failingSince time.Time
+	for _, exec := range execs {
+		inPeriod := exec.StartTime.Before(end)
+		if inPeriod {
+			cj.Runs++
+		}
+		if !exec.Succeeded {
+			if inPeriod {
+				cj.Failures++
+				if failingSince.IsZero() {
+					cj.Incidents++
+					failingSince = finishedAt(exec)
+				}
+			}
+			continue
+		}
+		if !failingSince.IsZero() {
+			cj.RecoveryTime += max(finishedAt(exec).Sub(failingSince), 0)
+			cj.Recovered++
+			failingSince = time.Time{}
+		}
+		if !inPeriod {
+			break
+		}
+	}
+	return nil
+}
+
+// finishedAt returns when an execution completed, or started if unknown
+func finishedAt(exec store.Execution) time.Time {
+	if exec.CompletionTime.IsZero() {
+		return exec.StartTime
+	}
+	return exec.CompletionTime
+}
+
+// breaches counts the SLA breach alerts per CronJob raised in the period
+func (b *Builder) breaches(ctx context.Context, start, end time.Time) (map[types.NamespacedName]int, error) {
+	alerts, _, err := b.store.ListAlertHistory(ctx, store.AlertHistoryQuery{Since: &start, Type: "SLABreached"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SLA breaches: %w", err)
+	}
+	counts := make(map[types.NamespacedName]int)
+	for _, a := range alerts {
+		if a.OccurredAt.Before(end) {
+			counts[types.NamespacedName{Namespace: a.CronJobNamespace, Name: a.CronJobName}]++
+		}
+	}
+	return counts, nil
+}
+
+// teamFor returns the team owning a CronJob. Deleted CronJobs are owned by
+// their namespace's team, if any.
+func (b *Builder) teamFor(ctx context.Context, nn types.NamespacedName) (string, error) {
+	if len(b.ownership.TeamLabels) == 0 {
+		return b.ownership.TeamFor(nn.Namespace, nil), nil
+	}
+	cronJob := &batchv1.CronJob{}
+	if err := b.client.Get(ctx, nn, cronJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			return b.ownership.TeamFor(nn.Namespace, nil), nil
+		}
+		return "", fmt.Errorf("failed to get CronJob %s: %w", nn, err)
+	}
+	return b.ownership.TeamFor(nn.Namespace, cronJob.Labels), nil
+}
+
+// slaTarget returns a monitor's minimum success rate, 0 if SLA tracking is off
+func slaTarget(sla *v1alpha1.SLAConfig) float64 {
+	if sla == nil || (sla.Enabled != nil && !*sla.Enabled) {
+		return 0
+	}
+	if sla.MinSuccessRate != nil {
+		return *sla.MinSuccessRate
+	}
+	return defaultMinSuccessRate
+}
+
+// scopeName describes a report's scope
+func scopeName(scope Scope) string {
+	switch {
+	case scope.Name != "":
+		return scope.Name
+	case scope.Monitor != nil:
+		return "monitor " + scope.Monitor.String()
+	case scope.Team != "":
+		return "team " + scope.Team
+	default:
+		return "all monitors"
+	}
+}
@@ -0,0 +1,128 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
+)
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newTestMonitor(name string, minSuccessRate float64, cronJobs ...string) *v1alpha1.CronJobMonitor {
+	monitor := &v1alpha1.CronJobMonitor{}
+	monitor.Name = name
+	monitor.Namespace = "default"
+	monitor.Spec.SLA = &v1alpha1.SLAConfig{MinSuccessRate: &minSuccessRate}
+	for _, cj := range cronJobs {
+		monitor.Status.CronJobs = append(monitor.Status.CronJobs, v1alpha1.CronJobStatus{Namespace: "default", Name: cj})
+	}
+	return monitor
+}
+
+func TestPeriodWindow(t *testing.T) {
+	// A Friday
+	now := time.Date(2026, 10, 16, 15, 30, 0, 0, time.UTC)
+
+	start, end, err := PeriodWindow(PeriodWeekly, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), end)
+
+	// On Monday the week that just ended is reported
+	start, _, err = PeriodWindow(PeriodWeekly, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), start)
+
+	start, end, err = PeriodWindow(PeriodMonthly, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), end)
+
+	_, _, err = PeriodWindow("daily", now)
+	assert.Error(t, err)
+}
+
+func TestBuild(t *testing.T) {
+	start := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	run := func(at time.Duration, succeeded bool) store.Execution {
+		return store.Execution{
+			CronJobNamespace: "default",
+			CronJobName:      "backup",
+			StartTime:        start.Add(at),
+			CompletionTime:   start.Add(at + time.Minute),
+			Succeeded:        succeeded,
+		}
+	}
+	mockStore := &testutil.MockStore{
+		// Newest first, like the store
+		Executions: []store.Execution{
+			run(7*24*time.Hour+2*time.Hour, true), // after the period, recovers the last incident
+			run(6*24*time.Hour, false),
+			run(2*24*time.Hour+time.Hour, true),
+			run(2*24*time.Hour, false),
+			run(24*time.Hour, false),
+			run(0, true),
+		},
+		AlertHistory: []store.AlertHistory{
+			{Type: "SLABreached", CronJobNamespace: "default", CronJobName: "backup", OccurredAt: start.Add(48 * time.Hour)},
+			{Type: "SLABreached", CronJobNamespace: "default", CronJobName: "backup", OccurredAt: end.Add(time.Hour)},
+		},
+	}
+	builder := NewBuilder(newTestClient(newTestMonitor("nightly", 90, "backup")), mockStore, config.OwnershipConfig{})
+
+	report, err := builder.Build(context.Background(), Scope{Monitor: &types.NamespacedName{Namespace: "default", Name: "nightly"}}, PeriodWeekly, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, "SLA report: monitor default/nightly", report.Title)
+	require.Len(t, report.CronJobs, 1)
+
+	cj := report.CronJobs[0]
+	assert.Equal(t, "default/nightly", cj.Monitor)
+	assert.Equal(t, 5, cj.Runs)
+	assert.Equal(t, 3, cj.Failures)
+	assert.Equal(t, 2, cj.Incidents)
+	assert.Equal(t, 1, cj.Breaches)
+	assert.True(t, cj.Breached())
+	// Recovered 25h after the first incident and 26h after the second
+	assert.Equal(t, 25*time.Hour+30*time.Minute, cj.MTTR())
+	assert.Equal(t, []CronJobSLA{cj}, report.WorstOffenders())
+}
+
+func TestBuild_Team(t *testing.T) {
+	payments := &batchv1.CronJob{}
+	payments.Name = "invoices"
+	payments.Namespace = "default"
+	payments.Labels = map[string]string{"team": "payments"}
+
+	builder := NewBuilder(
+		newTestClient(newTestMonitor("a", 95, "invoices", "backup"), newTestMonitor("b", 99, "invoices"), payments),
+		&testutil.MockStore{},
+		config.OwnershipConfig{TeamLabels: []string{"team"}},
+	)
+	report, err := builder.Build(context.Background(), Scope{Team: "payments"}, PeriodMonthly, time.Now().AddDate(0, -1, 0), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "SLA report: team payments", report.Title)
+	require.Len(t, report.CronJobs, 1, "CronJobs of other teams and deleted CronJobs without a team are left out")
+	assert.Equal(t, "invoices", report.CronJobs[0].Name)
+	assert.Equal(t, "default/a", report.CronJobs[0].Monitor, "a CronJob of several monitors is reported once")
+	assert.InDelta(t, 95, report.CronJobs[0].Target, 0)
+}
@@ -14,16 +14,20 @@ import (
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
 )
 
 // DeadManScheduler periodically checks for dead-man's switch violations
 type DeadManScheduler struct {
+	runTracker
+
 	client           client.Client
 	analyzer         analyzer.SLAAnalyzer
 	dispatcher       alerting.Dispatcher
 	interval         time.Duration
 	startupDelay     time.Duration   // delay before first check to let controllers reconcile
 	elected          <-chan struct{} // leader election signal (nil = no leader election)
+	shard            shard.Shard     // only monitors in this shard's namespaces are checked
 	stopCh           chan struct{}
 	running          bool
 	mu               sync.Mutex
@@ -132,8 +136,16 @@ func (s *DeadManScheduler) SetElected(elected <-chan struct{}) {
 	s.elected = elected
 }
 
+// SetShard limits checks to monitors in the shard's namespaces (must be called before Start)
+func (s *DeadManScheduler) SetShard(sh shard.Shard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shard = sh
+}
+
 func (s *DeadManScheduler) check(ctx context.Context) {
 	logger := log.FromContext(ctx)
+	s.markRun(time.Now())
 
 	// List all CronJobMonitors
 	monitors := &v1alpha1.CronJobMonitorList{}
@@ -143,17 +155,20 @@ func (s *DeadManScheduler) check(ctx context.Context) {
 	}
 
 	for _, monitor := range monitors.Items {
-		if monitor.Spec.DeadManSwitch == nil || !isEnabled(monitor.Spec.DeadManSwitch.Enabled) {
+		if !s.shard.Owns(monitor.Namespace) {
+			continue
+		}
+		deadManEnabled := monitor.Spec.DeadManSwitch != nil && isEnabled(monitor.Spec.DeadManSwitch.Enabled)
+		suspendAlerts := monitor.Spec.SuspendedHandling != nil && monitor.Spec.SuspendedHandling.AlertIfSuspendedFor != nil
+		if !deadManEnabled && !suspendAlerts {
+			continue
+		}
+		if monitor.IsSilenced(time.Now()) {
 			continue
 		}
 
 		// Check each CronJob in the monitor
 		for _, cjStatus := range monitor.Status.CronJobs {
-			// Skip suspended CronJobs if configured
-			if cjStatus.Suspended && isEnabled(monitor.Spec.SuspendedHandling.PauseMonitoring) {
-				continue
-			}
-
 			// Skip if in maintenance window (each window has its own timezone)
 			if inMaintenanceWindow(monitor.Spec.MaintenanceWindows, time.Now(), "") {
 				continue
@@ -169,6 +184,20 @@ func (s *DeadManScheduler) check(ctx context.Context) {
 				continue
 			}
 
+			// Check suspended duration, whether or not monitoring is paused
+			if suspendAlerts {
+				s.checkSuspendedDuration(ctx, &monitor, cjStatus, cronJob)
+			}
+
+			if !deadManEnabled {
+				continue
+			}
+
+			// Skip suspended CronJobs if configured
+			if cjStatus.Suspended && (monitor.Spec.SuspendedHandling == nil || isEnabled(monitor.Spec.SuspendedHandling.PauseMonitoring)) {
+				continue
+			}
+
 			// Check dead-man's switch
 			result, err := s.analyzer.CheckDeadManSwitch(ctx, cronJob, monitor.Spec.DeadManSwitch)
 			if err != nil {
@@ -182,16 +211,10 @@ func (s *DeadManScheduler) check(ctx context.Context) {
 					continue
 				}
 
-				// Safely get severity override
-				var deadManSeverity string
-				if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-					deadManSeverity = monitor.Spec.Alerting.SeverityOverrides.DeadManTriggered
-				}
-
 				// Send alert
 				alert := alerting.Alert{
 					Type:     "DeadManTriggered",
-					Severity: getSeverity(deadManSeverity, "critical"),
+					Severity: monitor.Spec.Alerting.SeverityFor("DeadManTriggered", "critical"),
 					Title:    fmt.Sprintf("Dead-man's switch triggered: %s/%s", cjStatus.Namespace, cjStatus.Name),
 					Message:  result.Message,
 					CronJob: types.NamespacedName{
@@ -204,16 +227,18 @@ func (s *DeadManScheduler) check(ctx context.Context) {
 					},
 					Timestamp: time.Now(),
 				}
+				if monitor.Spec.Alerting != nil {
+					alert.Context.PropagateMetadata(monitor.Spec.Alerting.IncludeContext, cronJob)
+				}
 
-				if err := s.dispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+				if err := dispatchFor(ctx, s.dispatcher, &monitor, alert); err != nil {
 					logger.Error(err, "failed to dispatch dead-man's switch alert")
 				}
 			}
-
-			// Check suspended duration
-			s.checkSuspendedDuration(ctx, &monitor, cjStatus, cronJob)
 		}
 	}
+
+	s.checkExternalJobs(ctx)
 }
 
 // checkSuspendedDuration checks if a CronJob has been suspended too long and alerts
@@ -227,11 +252,12 @@ func (s *DeadManScheduler) checkSuspendedDuration(ctx context.Context, monitor *
 	}
 
 	isSuspended := cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+	intentional := monitor.Spec.SuspendedHandling.IsIntentionallySuspended(cjStatus.Namespace, cjStatus.Name)
 
 	s.suspendedSinceMu.Lock()
 	defer s.suspendedSinceMu.Unlock()
 
-	if isSuspended {
+	if isSuspended && !intentional {
 		// Track when we first saw it suspended
 		if _, exists := s.suspendedSince[cronJobKey]; !exists {
 			s.suspendedSince[cronJobKey] = time.Now()
@@ -252,7 +278,7 @@ func (s *DeadManScheduler) checkSuspendedDuration(ctx context.Context, monitor *
 			// Send alert
 			alert := alerting.Alert{
 				Type:     "SuspendedTooLong",
-				Severity: "warning",
+				Severity: monitor.Spec.Alerting.SeverityFor("SuspendedTooLong", "warning"),
 				Title:    fmt.Sprintf("CronJob suspended for too long: %s/%s", cjStatus.Namespace, cjStatus.Name),
 				Message:  fmt.Sprintf("CronJob has been suspended for %s (threshold: %s)", suspendedDuration.Round(time.Minute), threshold),
 				CronJob: types.NamespacedName{
@@ -265,18 +291,21 @@ func (s *DeadManScheduler) checkSuspendedDuration(ctx context.Context, monitor *
 				},
 				Timestamp: time.Now(),
 			}
+			if monitor.Spec.Alerting != nil {
+				alert.Context.PropagateMetadata(monitor.Spec.Alerting.IncludeContext, cronJob)
+			}
 
-			if err := s.dispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+			if err := dispatchFor(ctx, s.dispatcher, monitor, alert); err != nil {
 				logger.Error(err, "failed to dispatch suspended too long alert")
 			} else {
 				logger.Info("suspended too long alert dispatched", "cronjob", cronJobKey, "duration", suspendedDuration)
 			}
 		}
 	} else {
-		// CronJob is not suspended, clear tracking
+		// CronJob is not suspended or is expected to be, clear tracking
 		if _, exists := s.suspendedSince[cronJobKey]; exists {
 			delete(s.suspendedSince, cronJobKey)
-			logger.V(1).Info("CronJob resumed, cleared suspended tracking", "cronjob", cronJobKey)
+			logger.V(1).Info("CronJob resumed or intentionally suspended, cleared suspended tracking", "cronjob", cronJobKey)
 
 			// Clear the suspended too long alert
 			alertKey := fmt.Sprintf("%s/SuspendedTooLong", cronJobKey)
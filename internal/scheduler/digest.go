@@ -0,0 +1,287 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// DigestScheduler sends periodic digest emails for email channels in digest mode
+type DigestScheduler struct {
+	runTracker
+
+	client       client.Client
+	store        store.Store
+	newChannel   func(client.Client, store.Store, *v1alpha1.AlertChannel) (alerting.Channel, error)
+	interval     time.Duration
+	elected      <-chan struct{} // leader election signal (nil = no leader election)
+	dashboardURL string          // external dashboard URL linked to (empty = no links)
+	stopCh       chan struct{}
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewDigestScheduler creates a new digest scheduler
+func NewDigestScheduler(c client.Client, st store.Store) *DigestScheduler {
+	return &DigestScheduler{
+		client:     c,
+		store:      st,
+		newChannel: alerting.NewEmailChannel,
+		interval:   time.Minute,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *DigestScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Wait for leader election if configured
+	if elected != nil {
+		logger.Info("waiting for leader election before starting digest scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting digest scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting digest scheduler", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.sendDueDigests(ctx, time.Now())
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *DigestScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetInterval changes how often channels are checked for due digests
+func (s *DigestScheduler) SetInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interval = d
+}
+
+// SetDashboardURL sets the external dashboard URL digests link to (must be
+// called before Start)
+func (s *DigestScheduler) SetDashboardURL(dashboardURL string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dashboardURL = strings.TrimSuffix(dashboardURL, "/")
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *DigestScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+func (s *DigestScheduler) sendDueDigests(ctx context.Context, now time.Time) {
+	logger := log.FromContext(ctx)
+	s.markRun(now)
+
+	channels := &v1alpha1.AlertChannelList{}
+	if err := s.client.List(ctx, channels); err != nil {
+		logger.Error(err, "failed to list alert channels")
+		return
+	}
+
+	for i := range channels.Items {
+		ac := &channels.Items[i]
+		if ac.Spec.Type != "email" || ac.Spec.Email == nil || ac.Spec.Email.Digest == nil {
+			continue
+		}
+
+		start, end, err := alerting.DigestWindow(ac.Spec.Email.Digest, now)
+		if err != nil {
+			logger.Error(err, "invalid digest config", "channel", ac.Name)
+			continue
+		}
+		if !digestDue(ac, end) {
+			continue
+		}
+
+		if err := s.sendDigest(ctx, ac, start, end); err != nil {
+			logger.Error(err, "failed to send digest", "channel", ac.Name)
+			continue
+		}
+		logger.Info("sent digest", "channel", ac.Name, "start", start, "end", end)
+	}
+}
+
+// digestDue reports whether the digest ending at end has not been sent yet.
+// Channels created after end wait for the next period.
+func digestDue(ac *v1alpha1.AlertChannel, end time.Time) bool {
+	if ac.Status.LastDigestTime != nil {
+		return ac.Status.LastDigestTime.Time.Before(end)
+	}
+	return ac.CreationTimestamp.Time.Before(end)
+}
+
+func (s *DigestScheduler) sendDigest(ctx context.Context, ac *v1alpha1.AlertChannel, start, end time.Time) error {
+	digest, err := s.buildDigest(ctx, ac.Name, start, end)
+	if err != nil {
+		return err
+	}
+	digest.Schedule = ac.Spec.Email.Digest.Schedule
+	digest.DashboardURL = s.dashboardURL
+
+	ch, err := s.newChannel(s.client, s.store, ac)
+	if err != nil {
+		return err
+	}
+	sender, ok := ch.(alerting.DigestSender)
+	if !ok {
+		return fmt.Errorf("channel type %s does not support digests", ch.Type())
+	}
+	if err := sender.SendDigest(ctx, digest); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(ac.DeepCopy())
+	now := metav1.Now()
+	ac.Status.LastDigestTime = &now
+	return s.client.Status().Patch(ctx, ac, patch)
+}
+
+// buildDigest summarises the CronJobs of all monitors that route alerts to the channel
+func (s *DigestScheduler) buildDigest(ctx context.Context, channelName string, start, end time.Time) (alerting.Digest, error) {
+	digest := alerting.Digest{Start: start, End: end}
+
+	monitors := &v1alpha1.CronJobMonitorList{}
+	if err := s.client.List(ctx, monitors); err != nil {
+		return digest, fmt.Errorf("failed to list monitors: %w", err)
+	}
+
+	alertCounts := s.alertCounts(ctx, start, end)
+	seen := make(map[types.NamespacedName]bool)
+
+	for _, monitor := range monitors.Items {
+		if monitor.IsObserveOnly() || !routesToChannel(monitor.Spec.Alerting, channelName) {
+			continue
+		}
+		for _, cjStatus := range monitor.Status.CronJobs {
+			nn := types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}
+			if seen[nn] {
+				continue
+			}
+			seen[nn] = true
+
+			summary := alerting.DigestCronJob{
+				Namespace: cjStatus.Namespace,
+				Name:      cjStatus.Name,
+				Status:    cjStatus.Status,
+				Suspended: cjStatus.Suspended,
+				Alerts:    alertCounts[nn],
+			}
+			if cjStatus.LastSuccessfulTime != nil {
+				summary.LastSuccess = cjStatus.LastSuccessfulTime.Time
+			}
+			if s.store != nil {
+				execs, err := s.store.GetExecutions(ctx, nn, start)
+				if err != nil {
+					return digest, fmt.Errorf("failed to get executions for %s: %w", nn, err)
+				}
+				for _, exec := range execs {
+					if !exec.StartTime.Before(end) {
+						continue
+					}
+					summary.Runs++
+					if !exec.Succeeded {
+						summary.Failures++
+					}
+				}
+			}
+			digest.CronJobs = append(digest.CronJobs, summary)
+		}
+	}
+
+	// Failing CronJobs first, then by name
+	sort.Slice(digest.CronJobs, func(i, j int) bool {
+		a, b := digest.CronJobs[i], digest.CronJobs[j]
+		if a.Failures != b.Failures {
+			return a.Failures > b.Failures
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return digest, nil
+}
+
+// alertCounts returns the number of alerts per CronJob raised in the period
+func (s *DigestScheduler) alertCounts(ctx context.Context, start, end time.Time) map[types.NamespacedName]int {
+	counts := make(map[types.NamespacedName]int)
+	if s.store == nil {
+		return counts
+	}
+	alerts, _, err := s.store.ListAlertHistory(ctx, store.AlertHistoryQuery{Since: &start})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to list alert history for digest")
+		return counts
+	}
+	for _, a := range alerts {
+		if a.OccurredAt.Before(end) {
+			counts[types.NamespacedName{Namespace: a.CronJobNamespace, Name: a.CronJobName}]++
+		}
+	}
+	return counts
+}
+
+// routesToChannel reports whether an alerting config references the channel
+func routesToChannel(alertCfg *v1alpha1.AlertingConfig, channelName string) bool {
+	if alertCfg == nil || !isEnabled(alertCfg.Enabled) {
+		return false
+	}
+	for _, ref := range alertCfg.AllChannelRefs() {
+		if ref.Name == channelName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+)
+
+// checkExternalJobs applies the dead-man's switch to ExternalJobs, which
+// report their runs through the inbound ping endpoint instead of Jobs
+func (s *DeadManScheduler) checkExternalJobs(ctx context.Context) {
+	logger := log.FromContext(ctx)
+
+	jobs := &v1alpha1.ExternalJobList{}
+	if err := s.client.List(ctx, jobs); err != nil {
+		logger.V(1).Info("failed to list external jobs", "error", err.Error())
+		return
+	}
+
+	for _, job := range jobs.Items {
+		if !s.shard.Owns(job.Namespace) {
+			continue
+		}
+		if job.Spec.DeadManSwitch == nil || !isEnabled(job.Spec.DeadManSwitch.Enabled) {
+			continue
+		}
+
+		result, err := s.analyzer.CheckDeadManSwitch(ctx, externalJobAsCronJob(&job), job.Spec.DeadManSwitch)
+		if err != nil {
+			logger.Error(err, "failed to check dead-man's switch", "externalJob", job.Name)
+			continue
+		}
+		if !result.Triggered {
+			continue
+		}
+
+		alert := alerting.Alert{
+			Type:      "DeadManTriggered",
+			Severity:  job.Spec.Alerting.SeverityFor("DeadManTriggered", "critical"),
+			Title:     fmt.Sprintf("Dead-man's switch triggered: %s/%s", job.Namespace, job.Name),
+			Message:   result.Message,
+			CronJob:   types.NamespacedName{Namespace: job.Namespace, Name: job.Name},
+			Timestamp: time.Now(),
+		}
+
+		if err := s.dispatcher.Dispatch(ctx, alert, job.Spec.Alerting); err != nil {
+			logger.Error(err, "failed to dispatch dead-man's switch alert", "externalJob", job.Name)
+		}
+	}
+}
+
+// externalJobAsCronJob builds the CronJob view of an ExternalJob the
+// analyzer needs: identity, creation time and schedule
+func externalJobAsCronJob(job *v1alpha1.ExternalJob) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         job.Namespace,
+			Name:              job.Name,
+			UID:               job.UID,
+			CreationTimestamp: job.CreationTimestamp,
+		},
+		Spec: batchv1.CronJobSpec{Schedule: job.Spec.Schedule},
+	}
+	if job.Spec.Timezone != "" {
+		tz := job.Spec.Timezone
+		cronJob.Spec.TimeZone = &tz
+	}
+	return cronJob
+}
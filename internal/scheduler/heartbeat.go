@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// heartbeatTimeout bounds one heartbeat, including the store health check
+const heartbeatTimeout = 10 * time.Second
+
+// HeartbeatScheduler sends a heartbeat to an external URL and/or a
+// Pushgateway every interval, a dead-man's switch for guardian itself: when
+// the operator dies or loses its store, the heartbeats stop and the external
+// service alerts.
+type HeartbeatScheduler struct {
+	runTracker
+
+	store    store.Store // checked before every heartbeat (nil = not checked)
+	url      string
+	method   string
+	client   *http.Client
+	pusher   *push.Pusher
+	gauge    prometheus.Gauge
+	interval time.Duration
+	elected  <-chan struct{} // leader election signal (nil = no leader election)
+	stopCh   chan struct{}
+	running  bool
+	mu       sync.Mutex
+}
+
+// NewHeartbeatScheduler creates a heartbeat scheduler for the configured
+// URL and Pushgateway. grouping labels the pushed metric, e.g. by cluster.
+func NewHeartbeatScheduler(cfg config.HeartbeatConfig, st store.Store, grouping map[string]string) *HeartbeatScheduler {
+	s := &HeartbeatScheduler{
+		store:    st,
+		url:      cfg.URL,
+		method:   strings.ToUpper(cfg.Method),
+		client:   &http.Client{Timeout: heartbeatTimeout},
+		interval: cfg.Interval,
+		stopCh:   make(chan struct{}),
+	}
+	if s.method == "" {
+		s.method = http.MethodGet
+	}
+	if s.interval <= 0 {
+		s.interval = time.Minute
+	}
+	if cfg.Pushgateway.URL != "" {
+		s.gauge = prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "cronjob_guardian_heartbeat_timestamp_seconds",
+			Help: "Unix time of the last heartbeat sent by cronjob-guardian",
+		})
+		s.pusher = push.New(cfg.Pushgateway.URL, cfg.Pushgateway.Job).Collector(s.gauge)
+		for name, value := range grouping {
+			s.pusher = s.pusher.Grouping(name, value)
+		}
+	}
+	return s
+}
+
+// Start begins the heartbeat loop. The first heartbeat is sent right away.
+func (s *HeartbeatScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Only the leader sends heartbeats: they mean guardian is at work
+	if elected != nil {
+		logger.Info("waiting for leader election before starting heartbeat scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting heartbeat scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting heartbeat scheduler", "interval", s.interval)
+
+	s.beat(ctx)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.beat(ctx)
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *HeartbeatScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *HeartbeatScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+// beat sends one heartbeat. No heartbeat is sent while the store is
+// unhealthy, since guardian cannot record executions then.
+func (s *HeartbeatScheduler) beat(ctx context.Context) {
+	s.markRun(time.Now())
+	logger := log.FromContext(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, heartbeatTimeout)
+	defer cancel()
+
+	if s.store != nil {
+		if err := s.store.Health(ctx); err != nil {
+			logger.Error(err, "store is unhealthy, skipping heartbeat")
+			return
+		}
+	}
+
+	if s.url != "" {
+		if err := s.ping(ctx); err != nil {
+			logger.Error(err, "failed to send heartbeat", "url", s.url)
+		} else {
+			logger.V(1).Info("sent heartbeat", "url", s.url)
+		}
+	}
+
+	if s.pusher != nil {
+		s.gauge.SetToCurrentTime()
+		if err := s.pusher.PushContext(ctx); err != nil {
+			logger.Error(err, "failed to push heartbeat to Pushgateway")
+		} else {
+			logger.V(1).Info("pushed heartbeat to Pushgateway")
+		}
+	}
+}
+
+// ping requests the heartbeat URL
+func (s *HeartbeatScheduler) ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, s.method, s.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("heartbeat URL returned %s", resp.Status)
+	}
+	return nil
+}
@@ -1,18 +1,53 @@
 package scheduler
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 )
 
+// runTracker records when a scheduler last ran. It is embedded by every
+// scheduler so the diagnostics endpoint can report scheduler activity.
+type runTracker struct {
+	runMu   sync.RWMutex
+	lastRun time.Time
+}
+
+// markRun records the start of a scheduler run
+func (t *runTracker) markRun(now time.Time) {
+	t.runMu.Lock()
+	defer t.runMu.Unlock()
+	t.lastRun = now
+}
+
+// LastRun returns when the scheduler last started a run (zero if it has not run yet)
+func (t *runTracker) LastRun() time.Time {
+	t.runMu.RLock()
+	defer t.runMu.RUnlock()
+	return t.lastRun
+}
+
 // isEnabled returns true if the pointer is nil (default true) or points to true
 func isEnabled(b *bool) bool {
 	return b == nil || *b
 }
 
+// dispatchFor sends an alert of a monitor. Alerts of a monitor in observe
+// mode are only logged, so its checks still run but never notify anyone.
+func dispatchFor(ctx context.Context, d alerting.Dispatcher, monitor *v1alpha1.CronJobMonitor, alert alerting.Alert) error {
+	if monitor.IsObserveOnly() {
+		log.FromContext(ctx).V(1).Info("alert not sent, monitor in observe mode", "key", alert.Key, "monitor", monitor.Namespace+"/"+monitor.Name)
+		return nil
+	}
+	return d.Dispatch(ctx, alert, monitor.Spec.Alerting)
+}
+
 // getOrDefault returns the value if the pointer is non-nil, otherwise returns the default
 func getOrDefault[T any](ptr *T, def T) T {
 	if ptr != nil {
@@ -21,14 +56,6 @@ func getOrDefault[T any](ptr *T, def T) T {
 	return def
 }
 
-// getSeverity returns the override severity if non-empty, otherwise returns the default
-func getSeverity(override string, defaultSeverity string) string {
-	if override != "" {
-		return override
-	}
-	return defaultSeverity
-}
-
 // inMaintenanceWindow checks if the given time falls within any maintenance window
 func inMaintenanceWindow(windows []v1alpha1.MaintenanceWindow, t time.Time, timezone string) bool {
 	if len(windows) == 0 {
@@ -0,0 +1,450 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// imagePullReasons are the waiting reasons of a container whose image cannot be pulled
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// The kubelet's CreateContainerConfigError messages for missing Secrets and
+// ConfigMaps, e.g. `secret "db-creds" not found` and
+// `couldn't find key password in Secret payments/db-creds`
+var (
+	missingObjectRe = regexp.MustCompile(`(?i)\b(secret|configmap) "([^"]+)" not found`)
+	missingKeyRe    = regexp.MustCompile(`couldn't find key (\S+) in (Secret|ConfigMap) (?:[^/\s]+/)?(\S+)`)
+)
+
+// Reasons of a running Job whose pods cannot be scheduled or created
+const (
+	reasonUnschedulable = "Unschedulable"
+	reasonQuotaExceeded = "QuotaExceeded"
+)
+
+// jobEventsIndex indexes Events by the name of the Job they are about, so a
+// Job's events are read from the cache without listing its whole namespace
+const jobEventsIndex = "involvedObject.job"
+
+// IndexJobEvents adds the Job events index the PodStartScheduler lists events
+// with to a cache. It must be called before the cache starts.
+func IndexJobEvents(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &corev1.Event{}, jobEventsIndex, jobEventName)
+}
+
+// jobEventName returns the Job an Event is about, if it is about a Job
+func jobEventName(obj client.Object) []string {
+	e, ok := obj.(*corev1.Event)
+	if !ok || e.InvolvedObject.Kind != "Job" {
+		return nil
+	}
+	return []string{e.InvolvedObject.Name}
+}
+
+// startFailure is a container of a running Job that cannot start. For a Job
+// whose pods cannot be scheduled or created, only the Job, the pod if there
+// is one, the reason and the message of the scheduler or the Job controller
+// are set.
+type startFailure struct {
+	job       string
+	pod       string
+	container string
+	image     string
+	reason    string
+	message   string
+}
+
+// missingConfig returns the Secret or ConfigMap, and the key if only a key is
+// missing, named by a container's config error message. ok is false when the
+// error is not about a missing object.
+func missingConfig(message string) (kind, name, key string, ok bool) {
+	if m := missingObjectRe.FindStringSubmatch(message); m != nil {
+		kind = "Secret"
+		if strings.EqualFold(m[1], "configmap") {
+			kind = "ConfigMap"
+		}
+		return kind, m[2], "", true
+	}
+	if m := missingKeyRe.FindStringSubmatch(message); m != nil {
+		return m[2], m[3], m[1], true
+	}
+	return "", "", "", false
+}
+
+// PodStartScheduler periodically checks the pods of running Jobs of monitored
+// CronJobs and alerts as soon as one cannot start because its image cannot be
+// pulled or a Secret or ConfigMap it references is missing, instead of when
+// the Job finally fails after exhausting its backoffLimit. It also alerts on
+// Jobs whose pods stay unscheduled, or cannot be created because of a
+// ResourceQuota, for longer than the unschedulable threshold.
+type PodStartScheduler struct {
+	runTracker
+
+	client     client.Client
+	store      store.Store
+	dispatcher alerting.Dispatcher
+	interval   time.Duration
+	pending    time.Duration   // how long pods may stay unscheduled before alerting
+	elected    <-chan struct{} // leader election signal (nil = no leader election)
+	shard      shard.Shard     // only monitors in this shard's namespaces are checked
+	stopCh     chan struct{}
+	running    bool
+	mu         sync.Mutex
+	failing    map[string]bool // keys of the alerts sent by this scheduler
+}
+
+// NewPodStartScheduler creates a new pod start scheduler
+func NewPodStartScheduler(c client.Client, st store.Store, d alerting.Dispatcher) *PodStartScheduler {
+	return &PodStartScheduler{
+		client:     c,
+		store:      st,
+		dispatcher: d,
+		interval:   30 * time.Second,
+		pending:    5 * time.Minute,
+		stopCh:     make(chan struct{}),
+		failing:    make(map[string]bool),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *PodStartScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Wait for leader election if configured
+	if elected != nil {
+		logger.Info("waiting for leader election before starting pod start scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting pod start scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting pod start scheduler", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *PodStartScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetInterval changes the check interval
+func (s *PodStartScheduler) SetInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interval = d
+}
+
+// SetUnschedulableAfter changes how long a running Job's pods may stay
+// unscheduled before alerting
+func (s *PodStartScheduler) SetUnschedulableAfter(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = d
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *PodStartScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+// SetShard limits checks to monitors in the shard's namespaces (must be called before Start)
+func (s *PodStartScheduler) SetShard(sh shard.Shard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shard = sh
+}
+
+func (s *PodStartScheduler) check(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	now := time.Now()
+	s.markRun(now)
+
+	monitors := &v1alpha1.CronJobMonitorList{}
+	if err := s.client.List(ctx, monitors); err != nil {
+		logger.Error(err, "failed to list monitors")
+		return
+	}
+
+	// Running Jobs by namespace, listed once per check
+	jobsByNamespace := make(map[string][]batchv1.Job)
+
+	for _, monitor := range monitors.Items {
+		if !s.shard.Owns(monitor.Namespace) {
+			continue
+		}
+		if monitor.IsSilenced(now) || inMaintenanceWindow(monitor.Spec.MaintenanceWindows, now, "") {
+			continue
+		}
+
+		for _, cjStatus := range monitor.Status.CronJobs {
+			cronJob := types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}
+
+			jobs, ok := jobsByNamespace[cronJob.Namespace]
+			if !ok {
+				jobs = runningJobs(ctx, s.client, cronJob.Namespace)
+				jobsByNamespace[cronJob.Namespace] = jobs
+			}
+
+			var pull, config, scheduling *startFailure
+			for i := range jobs {
+				job := &jobs[i]
+				if !ownedByCronJob(job, cronJob.Name) {
+					continue
+				}
+				pods := s.jobPods(ctx, job)
+				if pull == nil {
+					pull = findStartFailure(job, pods, func(w *corev1.ContainerStateWaiting) bool {
+						return imagePullReasons[w.Reason]
+					})
+				}
+				if config == nil {
+					config = findStartFailure(job, pods, func(w *corev1.ContainerStateWaiting) bool {
+						_, _, _, missing := missingConfig(w.Message)
+						return w.Reason == "CreateContainerConfigError" && missing
+					})
+				}
+				if scheduling == nil {
+					scheduling = s.findSchedulingFailure(ctx, job, pods, now)
+				}
+			}
+			s.update(ctx, &monitor, cjStatus, "ImagePullFailed", pull)
+			s.update(ctx, &monitor, cjStatus, "MissingConfig", config)
+			s.update(ctx, &monitor, cjStatus, "SchedulingFailed", scheduling)
+		}
+	}
+}
+
+// update sends the alert of the given type for a CronJob's start failure, or
+// clears it when there is none
+func (s *PodStartScheduler) update(ctx context.Context, monitor *v1alpha1.CronJobMonitor, cjStatus v1alpha1.CronJobStatus, alertType string, failure *startFailure) {
+	cronJob := types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}
+	key := fmt.Sprintf("%s/%s/%s", cronJob.Namespace, cronJob.Name, alertType)
+
+	if failure == nil {
+		if s.failing[key] || hasActiveAlert(cjStatus.ActiveAlerts, alertType) {
+			delete(s.failing, key)
+			_ = s.dispatcher.ClearAlert(ctx, key)
+			if s.store != nil {
+				_ = s.store.ResolveAlert(ctx, alertType, cronJob.Namespace, cronJob.Name)
+			}
+		}
+		return
+	}
+
+	alert := alerting.Alert{
+		Key:      key,
+		Type:     alertType,
+		Severity: monitor.Spec.Alerting.SeverityFor(alertType, "critical"),
+		CronJob:  cronJob,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Context: alerting.AlertContext{
+			JobName: failure.job,
+			Reason:  failure.reason,
+		},
+		Timestamp: time.Now(),
+	}
+	if failure.image != "" {
+		alert.Context.Images = []string{failure.image}
+	}
+	if failure.pod != "" {
+		alert.Context.PodNames = []string{failure.pod}
+	}
+	switch alertType {
+	case "MissingConfig":
+		missingConfigAlert(&alert, failure)
+	case "SchedulingFailed":
+		alert.Severity = monitor.Spec.Alerting.SeverityFor(alertType, "warning")
+		schedulingAlert(&alert, failure)
+	default:
+		imagePullAlert(&alert, failure)
+	}
+
+	if err := dispatchFor(ctx, s.dispatcher, monitor, alert); err != nil {
+		log.FromContext(ctx).Error(err, "failed to dispatch pod start alert", "cronjob", cronJob.String(), "type", alertType)
+		return
+	}
+	s.failing[key] = true
+}
+
+// jobPods lists the pods of a Job by the job-name label the Job controller
+// sets, so only the Job's pods are read from the cache
+func (s *PodStartScheduler) jobPods(ctx context.Context, job *batchv1.Job) []corev1.Pod {
+	pods := &corev1.PodList{}
+	if err := s.client.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list pods", "job", job.Name, "namespace", job.Namespace)
+		return nil
+	}
+	return pods.Items
+}
+
+// jobEvents lists the events about a Job through the Job events index
+func (s *PodStartScheduler) jobEvents(ctx context.Context, job *batchv1.Job) []corev1.Event {
+	events := &corev1.EventList{}
+	if err := s.client.List(ctx, events, client.InNamespace(job.Namespace), client.MatchingFields{jobEventsIndex: job.Name}); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list events", "job", job.Name, "namespace", job.Namespace)
+		return nil
+	}
+	return events.Items
+}
+
+// findSchedulingFailure returns a failure if a running Job's pod has not been
+// scheduled, or the Job has not been able to create a pod, for longer than
+// the unschedulable threshold, or nil otherwise. Events are only listed for
+// Jobs without pods.
+func (s *PodStartScheduler) findSchedulingFailure(ctx context.Context, job *batchv1.Job, pods []corev1.Pod, now time.Time) *startFailure {
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse &&
+				cond.Reason == corev1.PodReasonUnschedulable && now.Sub(cond.LastTransitionTime.Time) > s.pending {
+				return &startFailure{job: job.Name, pod: pod.Name, reason: reasonUnschedulable, message: cond.Message}
+			}
+		}
+	}
+	if len(pods) > 0 || now.Sub(job.Status.StartTime.Time) <= s.pending {
+		return nil
+	}
+
+	// Without pods, the Job controller's last FailedCreate event says why
+	var failedCreate *corev1.Event
+	for _, e := range s.jobEvents(ctx, job) {
+		if e.Reason != "FailedCreate" {
+			continue
+		}
+		if failedCreate == nil || eventTime(&e).After(eventTime(failedCreate)) {
+			failedCreate = &e
+		}
+	}
+	if failedCreate != nil && strings.Contains(failedCreate.Message, "exceeded quota") {
+		return &startFailure{job: job.Name, reason: reasonQuotaExceeded, message: failedCreate.Message}
+	}
+	return nil
+}
+
+// eventTime returns when an event was last seen
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// findStartFailure returns a container of a running Job's pods that is
+// waiting to start for a reason that matches, or nil if there is none
+func findStartFailure(job *batchv1.Job, pods []corev1.Pod, matches func(*corev1.ContainerStateWaiting) bool) *startFailure {
+	for i := range pods {
+		pod := &pods[i]
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if w := cs.State.Waiting; w != nil && matches(w) {
+				return &startFailure{
+					job:       job.Name,
+					pod:       pod.Name,
+					container: cs.Name,
+					image:     cs.Image,
+					reason:    w.Reason,
+					message:   w.Message,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// imagePullAlert fills in an ImagePullFailed alert with the failed image reference
+func imagePullAlert(alert *alerting.Alert, failure *startFailure) {
+	alert.Title = fmt.Sprintf("Image pull failing: %s/%s", alert.CronJob.Namespace, alert.CronJob.Name)
+	alert.Message = fmt.Sprintf("Container %s of Job %s cannot pull image %s (%s)", failure.container, failure.job, failure.image, failure.reason)
+	if failure.message != "" {
+		alert.Message += ": " + failure.message
+	}
+	alert.Message += ". The Job keeps retrying and fails once its backoffLimit or activeDeadlineSeconds is reached."
+}
+
+// missingConfigAlert fills in a MissingConfig alert with the missing Secret or ConfigMap
+func missingConfigAlert(alert *alerting.Alert, failure *startFailure) {
+	kind, name, key, _ := missingConfig(failure.message)
+	alert.Title = fmt.Sprintf("Missing %s: %s/%s", kind, alert.CronJob.Namespace, alert.CronJob.Name)
+	if key != "" {
+		alert.Message = fmt.Sprintf("Container %s of Job %s cannot start: %s %s/%s has no key %q", failure.container, failure.job, kind, alert.CronJob.Namespace, name, key)
+	} else {
+		alert.Message = fmt.Sprintf("Container %s of Job %s cannot start: %s %s/%s does not exist", failure.container, failure.job, kind, alert.CronJob.Namespace, name)
+	}
+	alert.Message += ". Create it, or fix the reference in the CronJob's pod template; the Job stays pending until then."
+}
+
+// schedulingAlert fills in a SchedulingFailed alert with the message of the
+// scheduler or the Job controller, which is also put in the alert context
+func schedulingAlert(alert *alerting.Alert, failure *startFailure) {
+	alert.Title = fmt.Sprintf("Job not scheduled: %s/%s", alert.CronJob.Namespace, alert.CronJob.Name)
+	if failure.reason == reasonQuotaExceeded {
+		alert.Message = fmt.Sprintf("Job %s has not started: its pods cannot be created because a ResourceQuota is exceeded", failure.job)
+	} else {
+		alert.Message = fmt.Sprintf("Job %s has not started: pod %s cannot be scheduled", failure.job, failure.pod)
+	}
+	alert.Message += fmt.Sprintf(": %s. None of its code has run yet.", strings.TrimSuffix(failure.message, "."))
+	alert.Context.Events = []string{failure.reason + ": " + failure.message}
+}
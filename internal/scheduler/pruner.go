@@ -2,20 +2,29 @@ package scheduler
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 // HistoryPruner periodically removes old execution records
 type HistoryPruner struct {
+	runTracker
+
 	store            store.Store
+	client           client.Client // lists monitors for retention overrides (nil = none)
 	retentionDays    int
-	logRetentionDays int // 0 means same as retentionDays
+	logRetentionDays int           // 0 means same as retentionDays
+	undoWindow       time.Duration // how long deleted history stays in the trash
 	interval         time.Duration
+	intervalChanged  chan struct{}   // signals the running loop to pick up a new interval
 	elected          <-chan struct{} // leader election signal (nil = no leader election)
 	stopCh           chan struct{}
 	running          bool
@@ -28,7 +37,9 @@ func NewHistoryPruner(st store.Store, retentionDays int) *HistoryPruner {
 		store:            st,
 		retentionDays:    retentionDays,
 		logRetentionDays: 0, // default to same as retentionDays
+		undoWindow:       7 * 24 * time.Hour,
 		interval:         6 * time.Hour,
+		intervalChanged:  make(chan struct{}, 1),
 		stopCh:           make(chan struct{}),
 	}
 }
@@ -41,6 +52,23 @@ func (p *HistoryPruner) SetLogRetentionDays(days int) {
 	p.logRetentionDays = days
 }
 
+// SetUndoWindow sets how long history deleted through the API can be
+// restored before the pruner purges it from the trash
+func (p *HistoryPruner) SetUndoWindow(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.undoWindow = max(d, 0)
+}
+
+// SetClient makes the pruner honor the dataRetention.retentionDays,
+// logRetentionDays and legalHold of monitors, read through c, for the
+// CronJobs they select
+func (p *HistoryPruner) SetClient(c client.Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.client = c
+}
+
 // Start begins the pruner loop
 func (p *HistoryPruner) Start(ctx context.Context) error {
 	p.mu.Lock()
@@ -72,7 +100,10 @@ func (p *HistoryPruner) Start(ctx context.Context) error {
 	// Run immediately on start
 	p.prune(ctx)
 
-	ticker := time.NewTicker(p.interval)
+	p.mu.Lock()
+	interval := p.interval
+	p.mu.Unlock()
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -81,6 +112,12 @@ func (p *HistoryPruner) Start(ctx context.Context) error {
 			return ctx.Err()
 		case <-p.stopCh:
 			return nil
+		case <-p.intervalChanged:
+			p.mu.Lock()
+			interval = p.interval
+			p.mu.Unlock()
+			ticker.Reset(interval)
+			logger.Info("history pruner interval changed", "interval", interval)
 		case <-ticker.C:
 			p.prune(ctx)
 		}
@@ -104,11 +141,18 @@ func (p *HistoryPruner) SetRetentionDays(days int) {
 	p.retentionDays = days
 }
 
-// SetInterval changes the prune interval
+// SetInterval changes the prune interval, also while the pruner runs
 func (p *HistoryPruner) SetInterval(d time.Duration) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	if d <= 0 || d == p.interval {
+		return
+	}
 	p.interval = d
+	select {
+	case p.intervalChanged <- struct{}{}:
+	default:
+	}
 }
 
 // SetElected sets the leader election channel (must be called before Start)
@@ -120,29 +164,205 @@ func (p *HistoryPruner) SetElected(elected <-chan struct{}) {
 
 func (p *HistoryPruner) prune(ctx context.Context) {
 	logger := log.FromContext(ctx)
+	now := time.Now()
+	p.markRun(now)
 
 	p.mu.Lock()
 	retentionDays := p.retentionDays
 	logRetentionDays := p.logRetentionDays
+	undoWindow := p.undoWindow
 	p.mu.Unlock()
 
-	// 1. Global time-based execution prune
-	cutoff := time.Now().AddDate(0, 0, -retentionDays)
-	count, err := p.store.Prune(ctx, cutoff)
+	// Logs older than the executions they belong to are pruned with them
+	if logRetentionDays <= 0 || logRetentionDays > retentionDays {
+		logRetentionDays = retentionDays
+	}
+
+	// Pruning at the global cutoff without the overrides could delete
+	// history that a monitor keeps longer or holds, so skip this run instead
+	p.mu.Lock()
+	c := p.client
+	p.mu.Unlock()
+	historyOverrides, logOverrides, err := p.retentionOverrides(ctx, c, now, retentionDays, logRetentionDays)
+	if err != nil {
+		logger.Error(err, "failed to resolve monitor retention overrides, skipping prune")
+		return
+	}
+	holds, err := LegalHolds(ctx, c, p.store)
+	if err != nil {
+		logger.Error(err, "failed to resolve legal holds, skipping prune")
+		return
+	}
+	historyOverrides = ApplyLegalHolds(historyOverrides, holds)
+	logOverrides = ApplyLegalHolds(logOverrides, holds)
+
+	// 1. Time-based execution prune, at the global cutoff or the CronJob's own
+	cutoff := now.AddDate(0, 0, -retentionDays)
+	count, err := p.store.Prune(ctx, cutoff, historyOverrides...)
 	if err != nil {
 		logger.Error(err, "failed to prune execution history")
 	} else if count > 0 {
-		logger.Info("pruned execution history", "recordsDeleted", count, "cutoff", cutoff)
+		logger.Info("pruned execution history", "recordsDeleted", count, "cutoff", cutoff, "overrides", len(historyOverrides))
+	}
+
+	// 2. Prune finished alert deliveries from the retry queue
+	deliveryCount, err := p.store.PruneDeliveries(ctx, cutoff)
+	if err != nil {
+		logger.Error(err, "failed to prune alert deliveries")
+	} else if deliveryCount > 0 {
+		logger.Info("pruned alert deliveries", "recordsDeleted", deliveryCount, "cutoff", cutoff)
 	}
 
-	// 2. Prune logs separately if log retention differs from execution retention
-	if logRetentionDays > 0 && logRetentionDays < retentionDays {
-		logCutoff := time.Now().AddDate(0, 0, -logRetentionDays)
-		logCount, err := p.store.PruneLogs(ctx, logCutoff)
+	// 3. Purge history deleted through the API once it can't be restored
+	trashCutoff := now.Add(-undoWindow)
+	trashCount, err := p.store.PurgeTrash(ctx, trashCutoff)
+	if err != nil {
+		logger.Error(err, "failed to purge deleted history")
+	} else if trashCount > 0 {
+		logger.Info("purged deleted history", "recordsDeleted", trashCount, "cutoff", trashCutoff)
+	}
+
+	// 4. Prune logs separately if log retention differs from execution
+	// retention, globally or for some CronJobs
+	if logRetentionDays < retentionDays || len(logOverrides) > 0 {
+		logCutoff := now.AddDate(0, 0, -logRetentionDays)
+		logCount, err := p.store.PruneLogs(ctx, logCutoff, logOverrides...)
 		if err != nil {
 			logger.Error(err, "failed to prune logs")
 		} else if logCount > 0 {
-			logger.Info("pruned stored logs", "recordsUpdated", logCount, "cutoff", logCutoff)
+			logger.Info("pruned stored logs", "recordsUpdated", logCount, "cutoff", logCutoff, "overrides", len(logOverrides))
+		}
+	}
+}
+
+// retentionOverrides returns the cutoffs of CronJobs whose monitors
+// override the history or log retention. Log retention defaults to the
+// monitor's retentionDays, then to the global one. A CronJob selected by
+// several monitors keeps the longest retention.
+func (p *HistoryPruner) retentionOverrides(ctx context.Context, c client.Client, now time.Time, retentionDays, logRetentionDays int) (history, logs []store.RetentionOverride, err error) {
+	if c == nil {
+		return nil, nil, nil
+	}
+
+	monitors := &v1alpha1.CronJobMonitorList{}
+	if err := c.List(ctx, monitors); err != nil {
+		return nil, nil, err
+	}
+
+	historyDays := make(map[types.NamespacedName]int)
+	logDays := make(map[types.NamespacedName]int)
+	for _, monitor := range monitors.Items {
+		dr := monitor.Spec.DataRetention
+		if dr == nil || (dr.RetentionDays == nil && dr.LogRetentionDays == nil) {
+			continue
+		}
+		h, l := retentionDays, logRetentionDays
+		if dr.RetentionDays != nil {
+			h = int(*dr.RetentionDays)
+			l = h
+		}
+		if dr.LogRetentionDays != nil {
+			l = int(*dr.LogRetentionDays)
+		}
+		for _, cj := range monitor.Status.CronJobs {
+			nn := types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name}
+			historyDays[nn] = max(historyDays[nn], h)
+			logDays[nn] = max(logDays[nn], l)
+		}
+	}
+
+	for nn, days := range historyDays {
+		if days != retentionDays {
+			history = append(history, store.RetentionOverride{CronJob: nn, OlderThan: now.AddDate(0, 0, -days)})
 		}
 	}
+	for nn, days := range logDays {
+		if days != logRetentionDays {
+			logs = append(logs, store.RetentionOverride{CronJob: nn, OlderThan: now.AddDate(0, 0, -days)})
+		}
+	}
+	sortOverrides(history)
+	sortOverrides(logs)
+	return history, logs, nil
+}
+
+// LegalHold is a CronJob whose history and logs are exempt from pruning
+type LegalHold struct {
+	CronJob types.NamespacedName
+	// Reason given when the hold was set through the API
+	Reason string
+	// Monitor holding the CronJob through dataRetention.legalHold, empty for
+	// holds set through the API
+	Monitor string
+	// Since is when the hold was set through the API (zero for monitor holds)
+	Since time.Time
+}
+
+// LegalHolds returns the CronJobs exempt from pruning: those held through the
+// API and, if c is set, those selected by monitors with
+// dataRetention.legalHold. A CronJob held several ways is listed once per hold.
+func LegalHolds(ctx context.Context, c client.Client, st store.Store) ([]LegalHold, error) {
+	stored, err := st.ListLegalHolds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holds := make([]LegalHold, 0, len(stored))
+	for _, h := range stored {
+		holds = append(holds, LegalHold{
+			CronJob: types.NamespacedName{Namespace: h.CronJobNamespace, Name: h.CronJobName},
+			Reason:  h.Reason,
+			Since:   h.CreatedAt,
+		})
+	}
+	if c == nil {
+		return holds, nil
+	}
+
+	monitors := &v1alpha1.CronJobMonitorList{}
+	if err := c.List(ctx, monitors); err != nil {
+		return nil, err
+	}
+	for _, monitor := range monitors.Items {
+		dr := monitor.Spec.DataRetention
+		if dr == nil || !dr.LegalHold {
+			continue
+		}
+		for _, cj := range monitor.Status.CronJobs {
+			holds = append(holds, LegalHold{
+				CronJob: types.NamespacedName{Namespace: cj.Namespace, Name: cj.Name},
+				Monitor: monitor.Namespace + "/" + monitor.Name,
+			})
+		}
+	}
+	return holds, nil
+}
+
+// ApplyLegalHolds replaces the overrides of held CronJobs with ones keeping
+// everything, and adds them for held CronJobs without an override
+func ApplyLegalHolds(overrides []store.RetentionOverride, holds []LegalHold) []store.RetentionOverride {
+	if len(holds) == 0 {
+		return overrides
+	}
+	held := make(map[types.NamespacedName]bool, len(holds))
+	for _, h := range holds {
+		held[h.CronJob] = true
+	}
+	result := make([]store.RetentionOverride, 0, len(overrides)+len(held))
+	for _, o := range overrides {
+		if !held[o.CronJob] {
+			result = append(result, o)
+		}
+	}
+	for nn := range held {
+		result = append(result, store.RetentionOverride{CronJob: nn})
+	}
+	sortOverrides(result)
+	return result
+}
+
+// sortOverrides orders overrides by CronJob, so prunes are deterministic
+func sortOverrides(overrides []store.RetentionOverride) {
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].CronJob.String() < overrides[j].CronJob.String()
+	})
 }
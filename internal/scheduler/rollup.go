@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// RollupScheduler periodically writes daily per-CronJob roll-ups of
+// executions, so long-range trends outlive raw history
+type RollupScheduler struct {
+	runTracker
+
+	store         store.Store
+	rollupDays    int // how long roll-ups are kept
+	retentionDays int // raw history retention, bounds the first backfill
+	interval      time.Duration
+	caughtUp      bool            // days missed while not running have been rolled up
+	elected       <-chan struct{} // leader election signal (nil = no leader election)
+	stopCh        chan struct{}
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewRollupScheduler creates a new roll-up scheduler keeping roll-ups for
+// rollupDays, of raw history kept for retentionDays
+func NewRollupScheduler(st store.Store, rollupDays, retentionDays int) *RollupScheduler {
+	return &RollupScheduler{
+		store:         st,
+		rollupDays:    rollupDays,
+		retentionDays: retentionDays,
+		interval:      time.Hour,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *RollupScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Wait for leader election if configured
+	if elected != nil {
+		logger.Info("waiting for leader election before starting roll-up scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting roll-up scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting roll-up scheduler", "rollupDays", s.rollupDays, "interval", s.interval)
+
+	// Run immediately on start
+	s.rollUp(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.rollUp(ctx)
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *RollupScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetInterval changes the roll-up interval (must be called before Start)
+func (s *RollupScheduler) SetInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interval = d
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *RollupScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+func (s *RollupScheduler) rollUp(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	now := time.Now().UTC()
+	s.markRun(now)
+
+	s.mu.Lock()
+	rollupDays := s.rollupDays
+	retentionDays := s.retentionDays
+	caughtUp := s.caughtUp
+	s.mu.Unlock()
+
+	// Yesterday is rolled up again, since runs started before midnight may
+	// have finished since
+	from := now.AddDate(0, 0, -1)
+	if !caughtUp {
+		latest, err := s.store.LatestRollupDay(ctx)
+		if err != nil {
+			logger.Error(err, "failed to get latest roll-up day")
+			return
+		}
+		switch {
+		case latest.IsZero():
+			// The oldest day still complete in raw history; earlier days
+			// may have been partly pruned
+			from = now.AddDate(0, 0, -min(retentionDays, rollupDays)+1)
+		case latest.Before(from):
+			from = latest
+		}
+	}
+
+	n, err := s.store.RollUpExecutions(ctx, from, now)
+	if err != nil {
+		logger.Error(err, "failed to roll up executions")
+		return
+	}
+	if !caughtUp {
+		s.mu.Lock()
+		s.caughtUp = true
+		s.mu.Unlock()
+	}
+	logger.V(1).Info("rolled up executions", "from", from.Format(time.DateOnly), "rollups", n)
+
+	pruned, err := s.store.PruneRollups(ctx, now.AddDate(0, 0, -rollupDays))
+	if err != nil {
+		logger.Error(err, "failed to prune roll-ups")
+		return
+	}
+	if pruned > 0 {
+		logger.Info("pruned old roll-ups", "count", pruned)
+	}
+}
@@ -2,6 +2,11 @@ package scheduler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,14 +14,20 @@ import (
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/testutil"
 )
 
@@ -35,13 +46,12 @@ func newTestSchedulerClient(objs ...client.Object) client.Client {
 	return fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithObjects(objs...).
-		WithStatusSubresource(&guardianv1alpha1.CronJobMonitor{}).
+		WithStatusSubresource(&guardianv1alpha1.CronJobMonitor{}, &guardianv1alpha1.AlertChannel{}).
+		WithIndex(&corev1.Event{}, jobEventsIndex, jobEventName).
 		Build()
 }
 
 // Helper to create a test CronJob
-//
-//nolint:unparam // namespace is always "default" in tests but parameter kept for API clarity
 func newTestSchedulerCronJob(name, namespace string, suspended bool) *batchv1.CronJob {
 	return &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
@@ -61,8 +71,6 @@ func newTestSchedulerCronJob(name, namespace string, suspended bool) *batchv1.Cr
 }
 
 // Helper to create a test monitor with dead-man's switch enabled
-//
-//nolint:unparam // namespace is always "default" in tests but parameter kept for API clarity
 func newTestMonitorWithDeadMan(name, namespace string, cjName string) *guardianv1alpha1.CronJobMonitor {
 	enabled := true
 	return &guardianv1alpha1.CronJobMonitor{
@@ -275,6 +283,45 @@ func TestDeadManScheduler_ChecksAllMonitors(t *testing.T) {
 	assert.GreaterOrEqual(t, callCount, 2, "should check all monitors")
 }
 
+func TestDeadManScheduler_ChecksOnlyOwnShard(t *testing.T) {
+	ownShard := shard.Shard{Index: shard.Of("default", 2), Count: 2}
+	otherNamespace := "team-0"
+	for i := 1; ownShard.Owns(otherNamespace); i++ {
+		otherNamespace = fmt.Sprintf("team-%d", i)
+	}
+
+	cronJob1 := newTestSchedulerCronJob("cron-1", "default", false)
+	cronJob2 := newTestSchedulerCronJob("cron-2", otherNamespace, false)
+	monitor1 := newTestMonitorWithDeadMan("monitor-1", "default", "cron-1")
+	monitor2 := newTestMonitorWithDeadMan("monitor-2", otherNamespace, "cron-2")
+
+	fakeClient := newTestSchedulerClient(cronJob1, cronJob2, monitor1, monitor2)
+	mockAnalyzer := &testutil.MockAnalyzer{}
+
+	scheduler := NewDeadManScheduler(fakeClient, mockAnalyzer, testutil.NewMockDispatcher())
+	scheduler.SetShard(ownShard)
+	scheduler.check(context.Background())
+
+	assert.Equal(t, 1, mockAnalyzer.CheckDeadManSwitchCalled, "should only check monitors of its shard")
+}
+
+func TestDeadManScheduler_SkipsSilencedMonitors(t *testing.T) {
+	cronJob1 := newTestSchedulerCronJob("cron-1", "default", false)
+	cronJob2 := newTestSchedulerCronJob("cron-2", "default", false)
+	silenced := newTestMonitorWithDeadMan("silenced", "default", "cron-1")
+	silenced.Spec.SilencedUntil = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	expired := newTestMonitorWithDeadMan("expired", "default", "cron-2")
+	expired.Spec.SilencedUntil = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+
+	fakeClient := newTestSchedulerClient(cronJob1, cronJob2, silenced, expired)
+	mockAnalyzer := &testutil.MockAnalyzer{}
+
+	scheduler := NewDeadManScheduler(fakeClient, mockAnalyzer, testutil.NewMockDispatcher())
+	scheduler.check(context.Background())
+
+	assert.Equal(t, 1, mockAnalyzer.CheckDeadManSwitchCalled, "should only check monitors whose silence expired")
+}
+
 func TestDeadManScheduler_DispatchesAlerts(t *testing.T) {
 	cronJob := newTestSchedulerCronJob("test-cron", "default", false)
 	monitor := newTestMonitorWithDeadMan("test-monitor", "default", "test-cron")
@@ -311,6 +358,64 @@ func TestDeadManScheduler_DispatchesAlerts(t *testing.T) {
 	assert.Contains(t, alerts[0].Title, "test-cron")
 }
 
+func TestDeadManScheduler_ObserveModeSendsNoAlerts(t *testing.T) {
+	cronJob := newTestSchedulerCronJob("test-cron", "default", false)
+	monitor := newTestMonitorWithDeadMan("test-monitor", "default", "test-cron")
+	monitor.Spec.Mode = guardianv1alpha1.MonitorModeObserve
+
+	fakeClient := newTestSchedulerClient(cronJob, monitor)
+	mockAnalyzer := &testutil.MockAnalyzer{
+		DeadManResult: &analyzer.DeadManResult{Triggered: true, Message: "CronJob has not run in expected window"},
+	}
+	mockDispatcher := testutil.NewMockDispatcher()
+
+	scheduler := NewDeadManScheduler(fakeClient, mockAnalyzer, mockDispatcher)
+	scheduler.check(context.Background())
+
+	assert.Equal(t, 1, mockAnalyzer.CheckDeadManSwitchCalled, "observe mode still runs the check")
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+}
+
+func TestDeadManScheduler_RecordsLastRun(t *testing.T) {
+	scheduler := NewDeadManScheduler(newTestSchedulerClient(), &testutil.MockAnalyzer{}, testutil.NewMockDispatcher())
+	assert.True(t, scheduler.LastRun().IsZero())
+
+	before := time.Now()
+	scheduler.check(context.Background())
+	assert.False(t, scheduler.LastRun().Before(before))
+}
+
+func TestDeadManScheduler_ChecksExternalJobs(t *testing.T) {
+	enabled := true
+	external := &guardianv1alpha1.ExternalJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-backup", Namespace: "default"},
+		Spec: guardianv1alpha1.ExternalJobSpec{
+			Schedule:       "0 2 * * *",
+			TokenSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "ping"}, Key: "token"},
+			DeadManSwitch:  &guardianv1alpha1.DeadManSwitchConfig{MaxTimeSinceLastSuccess: &metav1.Duration{Duration: 25 * time.Hour}},
+			Alerting:       &guardianv1alpha1.AlertingConfig{Enabled: &enabled},
+		},
+	}
+	unmonitored := external.DeepCopy()
+	unmonitored.Name = "no-deadman"
+	unmonitored.Spec.DeadManSwitch = nil
+
+	fakeClient := newTestSchedulerClient(external, unmonitored)
+	mockAnalyzer := &testutil.MockAnalyzer{
+		DeadManResult: &analyzer.DeadManResult{Triggered: true, Message: "no success in 25h"},
+	}
+	mockDispatcher := testutil.NewMockDispatcher()
+
+	scheduler := NewDeadManScheduler(fakeClient, mockAnalyzer, mockDispatcher)
+	scheduler.check(context.Background())
+
+	assert.Equal(t, 1, mockAnalyzer.CheckDeadManSwitchCalled)
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "DeadManTriggered", alert.Type)
+	assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "nightly-backup"}, alert.CronJob)
+}
+
 func TestDeadManScheduler_TracksSuspended(t *testing.T) {
 	suspended := true
 	cronJob := newTestSchedulerCronJob("suspended-cron", "default", suspended)
@@ -431,6 +536,62 @@ func TestDeadManScheduler_SuspendedAlertTiming(t *testing.T) {
 	assert.True(t, hasSuspendedAlert, "should dispatch SuspendedTooLong alert")
 }
 
+func TestDeadManScheduler_SuspendedTooLongWithoutDeadManSwitch(t *testing.T) {
+	cronJob := newTestSchedulerCronJob("suspended-cron", "default", true)
+
+	threshold := metav1.Duration{Duration: time.Minute}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-monitor", Namespace: "default"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			SuspendedHandling: &guardianv1alpha1.SuspendedHandlingConfig{AlertIfSuspendedFor: &threshold},
+			Alerting: &guardianv1alpha1.AlertingConfig{
+				SeverityOverrides: guardianv1alpha1.SeverityOverrides{"suspendedTooLong": "critical"},
+			},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Name: "suspended-cron", Namespace: "default", Suspended: true}},
+		},
+	}
+
+	mockDispatcher := testutil.NewMockDispatcher()
+	scheduler := NewDeadManScheduler(newTestSchedulerClient(cronJob, monitor), &testutil.MockAnalyzer{}, mockDispatcher)
+	scheduler.suspendedSince["default/suspended-cron"] = time.Now().Add(-2 * time.Minute)
+
+	scheduler.check(context.Background())
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "SuspendedTooLong", mockDispatcher.DispatchedAlerts[0].Type)
+	assert.Equal(t, "critical", mockDispatcher.DispatchedAlerts[0].Severity)
+}
+
+func TestDeadManScheduler_IntentionallySuspended(t *testing.T) {
+	cronJob := newTestSchedulerCronJob("suspended-cron", "default", true)
+
+	threshold := metav1.Duration{Duration: time.Minute}
+	monitor := &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-monitor", Namespace: "default"},
+		Spec: guardianv1alpha1.CronJobMonitorSpec{
+			SuspendedHandling: &guardianv1alpha1.SuspendedHandlingConfig{
+				AlertIfSuspendedFor:    &threshold,
+				IntentionallySuspended: []string{"default/suspended-cron"},
+			},
+		},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Name: "suspended-cron", Namespace: "default", Suspended: true}},
+		},
+	}
+
+	mockDispatcher := testutil.NewMockDispatcher()
+	scheduler := NewDeadManScheduler(newTestSchedulerClient(cronJob, monitor), &testutil.MockAnalyzer{}, mockDispatcher)
+	scheduler.suspendedSince["default/suspended-cron"] = time.Now().Add(-2 * time.Minute)
+
+	scheduler.check(context.Background())
+
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/suspended-cron/SuspendedTooLong")
+	assert.NotContains(t, scheduler.suspendedSince, "default/suspended-cron")
+}
+
 // ============================================================================
 // Section 1.4.2: SLARecalcScheduler Tests
 // ============================================================================
@@ -621,6 +782,36 @@ func TestSLARecalcScheduler_ClearsOnRecovery(t *testing.T) {
 	assert.GreaterOrEqual(t, resolveCalled, 1, "should resolve alert in store")
 }
 
+func TestSLARecalcScheduler_ScheduleBudget(t *testing.T) {
+	cronJob := newTestSchedulerCronJob("test-cron", "default", false)
+	monitor := newTestMonitorWithSLA("test-monitor", "default", "test-cron")
+
+	fakeClient := newTestSchedulerClient(cronJob, monitor)
+	mockAnalyzer := &testutil.MockAnalyzer{
+		BudgetResult: &analyzer.BudgetResult{Exceeded: true, Message: "P95 duration 55m0s is 92% of the 1h0m0s between scheduled runs"},
+	}
+	mockDispatcher := testutil.NewMockDispatcher()
+	scheduler := NewSLARecalcScheduler(fakeClient, &testutil.MockStore{}, mockAnalyzer, mockDispatcher)
+
+	scheduler.recalculate(context.Background())
+
+	var budgetAlerts []alerting.Alert
+	for _, alert := range mockDispatcher.DispatchedAlerts {
+		if alert.Type == "DurationBudget" {
+			budgetAlerts = append(budgetAlerts, alert)
+		}
+	}
+	require.Len(t, budgetAlerts, 1)
+	assert.Equal(t, "default/test-cron/DurationBudget", budgetAlerts[0].Key)
+	assert.Equal(t, "warning", budgetAlerts[0].Severity)
+	assert.Contains(t, budgetAlerts[0].Message, "92%")
+
+	// Once runs fit the schedule again the alert is cleared
+	mockAnalyzer.BudgetResult = &analyzer.BudgetResult{}
+	scheduler.recalculate(context.Background())
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/test-cron/DurationBudget")
+}
+
 // ============================================================================
 // Section 1.4.3: HistoryPruner Tests
 // ============================================================================
@@ -700,6 +891,32 @@ func TestHistoryPruner_PrunesAtInterval(t *testing.T) {
 	assert.GreaterOrEqual(t, callCount, 2, "should prune at intervals")
 }
 
+func TestHistoryPruner_SetIntervalWhileRunning(t *testing.T) {
+	mockStore := &testutil.MockStore{PrunedCount: 5}
+
+	pruner := NewHistoryPruner(mockStore, 7)
+	pruner.SetInterval(time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = pruner.Start(ctx)
+	}()
+
+	// Only the immediate run happens with the hourly interval
+	time.Sleep(30 * time.Millisecond)
+	pruner.SetInterval(20 * time.Millisecond)
+	time.Sleep(80 * time.Millisecond)
+	pruner.Stop()
+
+	mockStore.Lock()
+	callCount := mockStore.PruneCalled
+	mockStore.Unlock()
+
+	assert.GreaterOrEqual(t, callCount, 3, "should prune at the new interval")
+}
+
 func TestHistoryPruner_UsesRetentionDays(t *testing.T) {
 	mockStore := &testutil.MockStore{PrunedCount: 10}
 	retentionDays := 14
@@ -768,6 +985,126 @@ func TestHistoryPruner_SeparateLogRetention(t *testing.T) {
 	assert.WithinDuration(t, expectedLogCutoff, logPrunedCutoff, 1*time.Second)
 }
 
+func TestHistoryPruner_MonitorRetentionOverrides(t *testing.T) {
+	days := func(d int32) *int32 { return &d }
+	billing := newTestMonitorWithDeadMan("billing", "finance", "invoices")
+	billing.Spec.DataRetention = &guardianv1alpha1.DataRetentionConfig{RetentionDays: days(365)}
+	dev := newTestMonitorWithDeadMan("dev", "dev", "nightly")
+	dev.Spec.DataRetention = &guardianv1alpha1.DataRetentionConfig{RetentionDays: days(14), LogRetentionDays: days(3)}
+	logsOnly := newTestMonitorWithDeadMan("logs", "default", "report")
+	logsOnly.Spec.DataRetention = &guardianv1alpha1.DataRetentionConfig{LogRetentionDays: days(30)}
+	// A second monitor with shorter retention doesn't shorten billing's
+	billingDev := newTestMonitorWithDeadMan("billing-dev", "finance", "invoices")
+	billingDev.Spec.DataRetention = &guardianv1alpha1.DataRetentionConfig{RetentionDays: days(7)}
+	defaults := newTestMonitorWithDeadMan("defaults", "default", "backup")
+
+	mockStore := &testutil.MockStore{}
+	pruner := NewHistoryPruner(mockStore, 30)
+	pruner.SetLogRetentionDays(7)
+	pruner.SetClient(newTestSchedulerClient(billing, dev, logsOnly, billingDev, defaults))
+	pruner.prune(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	cutoff := func(d int) time.Time { return time.Now().AddDate(0, 0, -d) }
+
+	assert.WithinDuration(t, cutoff(30), mockStore.PruneCutoff, time.Second)
+	require.Len(t, mockStore.PruneOverrides, 2)
+	assert.Equal(t, types.NamespacedName{Namespace: "dev", Name: "nightly"}, mockStore.PruneOverrides[0].CronJob)
+	assert.WithinDuration(t, cutoff(14), mockStore.PruneOverrides[0].OlderThan, time.Second)
+	assert.Equal(t, types.NamespacedName{Namespace: "finance", Name: "invoices"}, mockStore.PruneOverrides[1].CronJob)
+	assert.WithinDuration(t, cutoff(365), mockStore.PruneOverrides[1].OlderThan, time.Second)
+
+	// Logs default to the monitor's history retention
+	assert.WithinDuration(t, cutoff(7), mockStore.LogPruneCutoff, time.Second)
+	require.Len(t, mockStore.LogPruneOverrides, 3)
+	assert.Equal(t, "default/report", mockStore.LogPruneOverrides[0].CronJob.String())
+	assert.WithinDuration(t, cutoff(30), mockStore.LogPruneOverrides[0].OlderThan, time.Second)
+	assert.Equal(t, "dev/nightly", mockStore.LogPruneOverrides[1].CronJob.String())
+	assert.WithinDuration(t, cutoff(3), mockStore.LogPruneOverrides[1].OlderThan, time.Second)
+	assert.Equal(t, "finance/invoices", mockStore.LogPruneOverrides[2].CronJob.String())
+	assert.WithinDuration(t, cutoff(365), mockStore.LogPruneOverrides[2].OlderThan, time.Second)
+}
+
+func TestHistoryPruner_LegalHolds(t *testing.T) {
+	days := func(d int32) *int32 { return &d }
+	audit := newTestMonitorWithDeadMan("audit", "finance", "ledger")
+	audit.Spec.DataRetention = &guardianv1alpha1.DataRetentionConfig{RetentionDays: days(7), LegalHold: true}
+
+	mockStore := &testutil.MockStore{
+		LegalHolds: []store.LegalHold{{CronJobNamespace: "default", CronJobName: "backup", Reason: "litigation"}},
+	}
+	pruner := NewHistoryPruner(mockStore, 30)
+	pruner.SetClient(newTestSchedulerClient(audit))
+	pruner.prune(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	// The hold wins over the monitor's shorter retention, and held CronJobs
+	// keep everything
+	want := []store.RetentionOverride{
+		{CronJob: types.NamespacedName{Namespace: "default", Name: "backup"}},
+		{CronJob: types.NamespacedName{Namespace: "finance", Name: "ledger"}},
+	}
+	assert.Equal(t, want, mockStore.PruneOverrides)
+	assert.Equal(t, want, mockStore.LogPruneOverrides)
+}
+
+func TestHistoryPruner_PurgesTrashAfterUndoWindow(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	pruner := NewHistoryPruner(mockStore, 30)
+	pruner.SetUndoWindow(48 * time.Hour)
+	pruner.prune(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	assert.WithinDuration(t, time.Now().Add(-48*time.Hour), mockStore.PurgeTrashCutoff, time.Second)
+}
+
+func TestHistoryPruner_SkipsPruneWhenMonitorsUnavailable(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	pruner := NewHistoryPruner(mockStore, 30)
+	// No CronJobMonitor kind in the scheme, so listing monitors fails
+	pruner.SetClient(fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build())
+	pruner.prune(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	assert.Zero(t, mockStore.PruneCalled, "history a monitor keeps longer must not be pruned at the global cutoff")
+}
+
+// ============================================================================
+// RollupScheduler Tests
+// ============================================================================
+
+func TestRollupScheduler_BackfillsRetainedHistory(t *testing.T) {
+	mockStore := &testutil.MockStore{}
+	rollups := NewRollupScheduler(mockStore, 365, 30)
+	rollups.rollUp(context.Background())
+	rollups.rollUp(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	require.Len(t, mockStore.RollUpCalls, 2)
+	// Without roll-ups, the first run starts at the oldest complete day of
+	// raw history, later runs at yesterday
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -29), mockStore.RollUpCalls[0][0], time.Second)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -1), mockStore.RollUpCalls[1][0], time.Second)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -365), mockStore.RollupsPruned, time.Second)
+}
+
+func TestRollupScheduler_ResumesFromLatestRollup(t *testing.T) {
+	latest := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -5)
+	mockStore := &testutil.MockStore{LatestRollup: latest}
+	rollups := NewRollupScheduler(mockStore, 365, 30)
+	rollups.rollUp(context.Background())
+
+	mockStore.Lock()
+	defer mockStore.Unlock()
+	require.Len(t, mockStore.RollUpCalls, 1)
+	assert.Equal(t, latest, mockStore.RollUpCalls[0][0])
+}
+
 // ============================================================================
 // Helper Function Tests
 // ============================================================================
@@ -801,15 +1138,19 @@ func TestGetOrDefault(t *testing.T) {
 	})
 }
 
-func TestGetSeverity(t *testing.T) {
-	t.Run("empty override returns default", func(t *testing.T) {
-		result := getSeverity("", "warning")
-		assert.Equal(t, "warning", result)
+func TestSeverityFor(t *testing.T) {
+	t.Run("no alerting config returns default", func(t *testing.T) {
+		var alertingConfig *guardianv1alpha1.AlertingConfig
+		assert.Equal(t, "warning", alertingConfig.SeverityFor("DeadManTriggered", "warning"))
 	})
 
-	t.Run("non-empty override returns override", func(t *testing.T) {
-		result := getSeverity("critical", "warning")
-		assert.Equal(t, "critical", result)
+	t.Run("override matches alert type ignoring case", func(t *testing.T) {
+		alertingConfig := &guardianv1alpha1.AlertingConfig{
+			SeverityOverrides: guardianv1alpha1.SeverityOverrides{"deadManTriggered": "critical", "SLABreached": "warning"},
+		}
+		assert.Equal(t, "critical", alertingConfig.SeverityFor("DeadManTriggered", "warning"))
+		assert.Equal(t, "warning", alertingConfig.SeverityFor("SLABreached", "critical"))
+		assert.Equal(t, "critical", alertingConfig.SeverityFor("JobFailed", "critical"))
 	})
 }
 
@@ -1146,3 +1487,700 @@ func TestHistoryPruner_ContextCancelledDuringElection(t *testing.T) {
 	mockStore.Unlock()
 	assert.Equal(t, 0, pruneCalled)
 }
+
+// ============================================================================
+// DigestScheduler Tests
+// ============================================================================
+
+// fakeDigestChannel records digests instead of sending email
+type fakeDigestChannel struct {
+	digests []alerting.Digest
+}
+
+func (f *fakeDigestChannel) Name() string                                   { return "fake" }
+func (f *fakeDigestChannel) Type() string                                   { return "email" }
+func (f *fakeDigestChannel) Send(_ context.Context, _ alerting.Alert) error { return nil }
+func (f *fakeDigestChannel) Test(_ context.Context) error                   { return nil }
+func (f *fakeDigestChannel) SendDigest(_ context.Context, d alerting.Digest) error {
+	f.digests = append(f.digests, d)
+	return nil
+}
+
+func newTestDigestChannel(name string, created time.Time) *guardianv1alpha1.AlertChannel {
+	return &guardianv1alpha1.AlertChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Spec: guardianv1alpha1.AlertChannelSpec{
+			Type: "email",
+			Email: &guardianv1alpha1.EmailConfig{
+				SMTPSecretRef: guardianv1alpha1.NamespacedSecretRef{Name: "smtp", Namespace: "default"},
+				From:          "guardian@example.com",
+				To:            []string{"ops@example.com"},
+				Digest:        &guardianv1alpha1.EmailDigestConfig{Schedule: "daily"},
+			},
+		},
+	}
+}
+
+func newTestDigestScheduler(c client.Client, st *testutil.MockStore) (*DigestScheduler, *fakeDigestChannel) {
+	ch := &fakeDigestChannel{}
+	s := NewDigestScheduler(c, st)
+	s.newChannel = func(client.Client, store.Store, *guardianv1alpha1.AlertChannel) (alerting.Channel, error) {
+		return ch, nil
+	}
+	return s, ch
+}
+
+func TestDigestScheduler_SendsDueDigest(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	ac := newTestDigestChannel("ops-digest", now.Add(-72*time.Hour))
+
+	monitor := newTestMonitorWithSLA("backups", "prod", "backup")
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "ops-digest"}},
+	}
+	monitor.Status.CronJobs[0].Status = "critical"
+	other := newTestMonitorWithSLA("reports", "prod", "report") // routes elsewhere
+
+	mockStore := &testutil.MockStore{
+		Executions: []store.Execution{
+			{StartTime: now.Add(-4 * time.Hour), Succeeded: true},
+			{StartTime: now.Add(-5 * time.Hour), Succeeded: false},
+			{StartTime: now.Add(-time.Hour), Succeeded: false}, // after the digest window
+		},
+		AlertHistory: []store.AlertHistory{
+			{CronJobNamespace: "prod", CronJobName: "backup", OccurredAt: now.Add(-5 * time.Hour)},
+		},
+	}
+	c := newTestSchedulerClient(ac, monitor, other)
+	s, ch := newTestDigestScheduler(c, mockStore)
+	s.SetDashboardURL("https://guardian.example.com/")
+
+	s.sendDueDigests(context.Background(), now)
+
+	require.Len(t, ch.digests, 1)
+	digest := ch.digests[0]
+	assert.Equal(t, "daily", digest.Schedule)
+	assert.Equal(t, "https://guardian.example.com", digest.DashboardURL)
+	require.Len(t, digest.CronJobs, 1)
+	assert.Equal(t, "backup", digest.CronJobs[0].Name)
+	assert.Equal(t, "critical", digest.CronJobs[0].Status)
+	assert.Equal(t, 2, digest.CronJobs[0].Runs)
+	assert.Equal(t, 1, digest.CronJobs[0].Failures)
+	assert.Equal(t, 1, digest.CronJobs[0].Alerts)
+
+	updated := &guardianv1alpha1.AlertChannel{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "ops-digest"}, updated))
+	assert.NotNil(t, updated.Status.LastDigestTime)
+
+	// Already sent for this period
+	s.sendDueDigests(context.Background(), now.Add(time.Minute))
+	assert.Len(t, ch.digests, 1)
+}
+
+func TestDigestScheduler_SkipsNewChannels(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	// Created after today's 09:00 digest
+	ac := newTestDigestChannel("ops-digest", now.Add(-time.Hour))
+	s, ch := newTestDigestScheduler(newTestSchedulerClient(ac), &testutil.MockStore{})
+
+	s.sendDueDigests(context.Background(), now)
+
+	assert.Empty(t, ch.digests)
+}
+
+func TestDigestScheduler_IgnoresNonDigestChannels(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	ac := newTestDigestChannel("ops-email", now.Add(-72*time.Hour))
+	ac.Spec.Email.Digest = nil
+	s, ch := newTestDigestScheduler(newTestSchedulerClient(ac), &testutil.MockStore{})
+
+	s.sendDueDigests(context.Background(), now)
+
+	assert.Empty(t, ch.digests)
+}
+
+// ============================================================================
+// SLAReportScheduler Tests
+// ============================================================================
+
+// fakeReportChannel records reports instead of sending email
+type fakeReportChannel struct {
+	fakeDigestChannel
+	reports []alerting.Report
+}
+
+func (f *fakeReportChannel) SendReport(_ context.Context, r alerting.Report) error {
+	f.reports = append(f.reports, r)
+	return nil
+}
+
+func newTestSLAReportScheduler(c client.Client, st *testutil.MockStore) (*SLAReportScheduler, *fakeReportChannel) {
+	ch := &fakeReportChannel{}
+	s := NewSLAReportScheduler(c, st, config.OwnershipConfig{})
+	s.newChannel = func(client.Client, store.Store, *guardianv1alpha1.AlertChannel) (alerting.Channel, error) {
+		return ch, nil
+	}
+	return s, ch
+}
+
+func TestSLAReportScheduler_SendsDueReport(t *testing.T) {
+	// Monday after the 09:00 report time
+	now := time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC)
+	ac := newTestDigestChannel("ops-reports", now.Add(-30*24*time.Hour))
+	ac.Spec.Email.Digest = nil
+	ac.Spec.Email.SLAReport = &guardianv1alpha1.EmailSLAReportConfig{Schedule: "weekly", AttachPDF: true}
+
+	monitor := newTestMonitorWithSLA("backups", "prod", "backup")
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		ChannelRefs: []guardianv1alpha1.ChannelRef{{Name: "ops-reports"}},
+	}
+	other := newTestMonitorWithSLA("reports", "prod", "report") // routes elsewhere
+
+	mockStore := &testutil.MockStore{
+		Executions: []store.Execution{
+			{StartTime: now.Add(-3 * 24 * time.Hour), Succeeded: true},
+		},
+	}
+	c := newTestSchedulerClient(ac, monitor, other)
+	s, ch := newTestSLAReportScheduler(c, mockStore)
+	s.SetDashboardURL("https://guardian.example.com")
+
+	s.sendDueReports(context.Background(), now)
+
+	require.Len(t, ch.reports, 1)
+	rep := ch.reports[0]
+	assert.Contains(t, rep.Subject, "channel ops-reports")
+	assert.Contains(t, rep.HTML, "prod/backup")
+	assert.Contains(t, rep.HTML, "https://guardian.example.com/cronjob/prod/backup")
+	assert.NotContains(t, rep.HTML, "prod/report")
+	require.Len(t, rep.Attachments, 1)
+	assert.Equal(t, "sla-report-weekly-2024-01-01.pdf", rep.Attachments[0].Filename)
+
+	updated := &guardianv1alpha1.AlertChannel{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "ops-reports"}, updated))
+	assert.NotNil(t, updated.Status.LastSLAReportTime)
+
+	// Already sent for this period
+	s.sendDueReports(context.Background(), now.Add(time.Minute))
+	assert.Len(t, ch.reports, 1)
+}
+
+func TestSLAReportScheduler_NotDueYet(t *testing.T) {
+	// Monday before the 09:00 report time; last week's report went out
+	now := time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC)
+	ac := newTestDigestChannel("ops-reports", now.Add(-30*24*time.Hour))
+	ac.Spec.Email.SLAReport = &guardianv1alpha1.EmailSLAReportConfig{Schedule: "weekly"}
+	ac.Status.LastSLAReportTime = &metav1.Time{Time: time.Date(2024, 1, 1, 9, 1, 0, 0, time.UTC)}
+	s, ch := newTestSLAReportScheduler(newTestSchedulerClient(ac), &testutil.MockStore{})
+
+	s.sendDueReports(context.Background(), now)
+
+	assert.Empty(t, ch.reports)
+}
+
+// ============================================================================
+// StuckJobScheduler Tests
+// ============================================================================
+
+func newTestStuckJobMonitor(cfg *guardianv1alpha1.StuckJobConfig) *guardianv1alpha1.CronJobMonitor {
+	return &guardianv1alpha1.CronJobMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-monitor", Namespace: "default"},
+		Spec:       guardianv1alpha1.CronJobMonitorSpec{StuckJobs: cfg},
+		Status: guardianv1alpha1.CronJobMonitorStatus{
+			CronJobs: []guardianv1alpha1.CronJobStatus{{Name: "test-cron", Namespace: "default"}},
+		},
+	}
+}
+
+func newTestRunningJob(name string, startedAgo time.Duration) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "CronJob", Name: "test-cron", UID: "cron-uid"},
+			},
+		},
+		Status: batchv1.JobStatus{
+			StartTime: &metav1.Time{Time: time.Now().Add(-startedAgo)},
+			Active:    1,
+		},
+	}
+}
+
+func TestStuckJobScheduler_MaxRuntime(t *testing.T) {
+	monitor := newTestStuckJobMonitor(&guardianv1alpha1.StuckJobConfig{
+		MaxRuntime: &metav1.Duration{Duration: time.Hour},
+	})
+	cronJob := newTestSchedulerCronJob("test-cron", "default", false)
+	c := newTestSchedulerClient(monitor, cronJob, newTestRunningJob("test-cron-1", 30*time.Minute))
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewStuckJobScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	// Within the limit
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+
+	require.NoError(t, c.Create(context.Background(), newTestRunningJob("test-cron-0", 2*time.Hour)))
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "JobStuck", alert.Type)
+	assert.Equal(t, "warning", alert.Severity)
+	assert.Contains(t, alert.Message, "test-cron-0")
+	assert.NotContains(t, alert.Message, "deleted")
+
+	// Not opted in to killing
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cron-0"}, job))
+}
+
+func TestStuckJobScheduler_P95Multiplier(t *testing.T) {
+	multiplier := 3.0
+	monitor := newTestStuckJobMonitor(&guardianv1alpha1.StuckJobConfig{
+		P95Multiplier: &multiplier,
+		MaxRuntime:    &metav1.Duration{Duration: 24 * time.Hour},
+	})
+	job := newTestRunningJob("test-cron-1", 40*time.Minute)
+	c := newTestSchedulerClient(monitor, job)
+	mockStore := &testutil.MockStore{Metrics: &store.Metrics{TotalRuns: 4, P95DurationSeconds: 600}}
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewStuckJobScheduler(c, mockStore, mockDispatcher)
+
+	// Too little history for the relative limit, and below the absolute one
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+
+	// 40m is more than 3 x 10m
+	mockStore.Metrics.TotalRuns = 5
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Contains(t, mockDispatcher.DispatchedAlerts[0].Message, "limit: 30m0s")
+}
+
+func TestStuckJobScheduler_KillsOptedInJobs(t *testing.T) {
+	monitor := newTestStuckJobMonitor(&guardianv1alpha1.StuckJobConfig{
+		MaxRuntime: &metav1.Duration{Duration: time.Hour},
+	})
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		SeverityOverrides: guardianv1alpha1.SeverityOverrides{"jobStuck": "critical"},
+	}
+	cronJob := newTestSchedulerCronJob("test-cron", "default", false)
+	cronJob.Annotations = map[string]string{KillStuckJobsAnnotation: "true"}
+	c := newTestSchedulerClient(monitor, cronJob, newTestRunningJob("test-cron-1", 2*time.Hour))
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewStuckJobScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "critical", mockDispatcher.DispatchedAlerts[0].Severity)
+	assert.Contains(t, mockDispatcher.DispatchedAlerts[0].Message, "deleted")
+
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cron-1"}, &batchv1.Job{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestStuckJobScheduler_ClearsWhenFinished(t *testing.T) {
+	monitor := newTestStuckJobMonitor(&guardianv1alpha1.StuckJobConfig{
+		MaxRuntime: &metav1.Duration{Duration: time.Hour},
+	})
+	job := newTestRunningJob("test-cron-1", 2*time.Hour)
+	c := newTestSchedulerClient(monitor, job)
+	mockStore := &testutil.MockStore{}
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewStuckJobScheduler(c, mockStore, mockDispatcher)
+
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+
+	job.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	require.NoError(t, c.Status().Update(context.Background(), job))
+
+	s.check(context.Background())
+	assert.Equal(t, []string{"default/test-cron/JobStuck"}, mockDispatcher.ClearedAlerts)
+	assert.Equal(t, 1, mockStore.ResolveAlertCalls)
+
+	// Cleared once
+	s.check(context.Background())
+	assert.Len(t, mockDispatcher.ClearedAlerts, 1)
+}
+
+// ============================================================================
+// PodStartScheduler Tests
+// ============================================================================
+
+func newTestJobPod(jobName, waitingReason string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "main",
+				Image: "registry.example.com/backup:2.1.0",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  waitingReason,
+					Message: "manifest unknown",
+				}},
+			}},
+		},
+	}
+}
+
+func TestPodStartScheduler_ImagePullWhileRunning(t *testing.T) {
+	monitor := newTestStuckJobMonitor(nil)
+	pod := newTestJobPod("test-cron-1", "ContainerCreating")
+	c := newTestSchedulerClient(monitor, newTestRunningJob("test-cron-1", time.Minute), pod)
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+
+	pod.Status.ContainerStatuses[0].State.Waiting.Reason = "ImagePullBackOff"
+	require.NoError(t, c.Status().Update(context.Background(), pod))
+	s.check(context.Background())
+
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "ImagePullFailed", alert.Type)
+	assert.Equal(t, "default/test-cron/ImagePullFailed", alert.Key)
+	assert.Equal(t, "critical", alert.Severity)
+	assert.Contains(t, alert.Message, "cannot pull image registry.example.com/backup:2.1.0 (ImagePullBackOff): manifest unknown")
+	assert.Equal(t, "test-cron-1", alert.Context.JobName)
+	assert.Equal(t, []string{"registry.example.com/backup:2.1.0"}, alert.Context.Images)
+}
+
+func TestPodStartScheduler_SkipsSilencedMonitors(t *testing.T) {
+	monitor := newTestStuckJobMonitor(nil)
+	monitor.Spec.Alerting = &guardianv1alpha1.AlertingConfig{
+		SeverityOverrides: guardianv1alpha1.SeverityOverrides{"imagePullFailed": "warning"},
+	}
+	monitor.Spec.SilencedUntil = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	c := newTestSchedulerClient(monitor, newTestRunningJob("test-cron-1", time.Minute), newTestJobPod("test-cron-1", "ErrImagePull"))
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+
+	monitor.Spec.SilencedUntil = nil
+	require.NoError(t, c.Update(context.Background(), monitor))
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, "warning", mockDispatcher.DispatchedAlerts[0].Severity)
+}
+
+// podListRecorder records the label selectors pods are listed with
+type podListRecorder struct {
+	client.Client
+	selectors []string
+}
+
+func (r *podListRecorder) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, ok := list.(*corev1.PodList); ok {
+		listOpts := &client.ListOptions{}
+		listOpts.ApplyOptions(opts)
+		selector := ""
+		if listOpts.LabelSelector != nil {
+			selector = listOpts.LabelSelector.String()
+		}
+		r.selectors = append(r.selectors, selector)
+	}
+	return r.Client.List(ctx, list, opts...)
+}
+
+func TestPodStartScheduler_ListsPodsPerJob(t *testing.T) {
+	other := newTestRunningJob("other-1", time.Minute)
+	other.OwnerReferences[0].Name = "other"
+	c := &podListRecorder{Client: newTestSchedulerClient(
+		newTestStuckJobMonitor(nil),
+		newTestRunningJob("test-cron-1", time.Minute), newTestJobPod("test-cron-1", "ErrImagePull"),
+		other, newTestJobPod("other-1", "ErrImagePull"),
+	)}
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	assert.Equal(t, []string{"job-name=test-cron-1"}, c.selectors, "only the pods of the monitored CronJob's Jobs are listed")
+}
+
+func TestPodStartScheduler_ClearsWhenPulled(t *testing.T) {
+	monitor := newTestStuckJobMonitor(nil)
+	pod := newTestJobPod("test-cron-1", "ErrImagePull")
+	c := newTestSchedulerClient(monitor, newTestRunningJob("test-cron-1", time.Minute), pod)
+	mockStore := &testutil.MockStore{}
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, mockStore, mockDispatcher)
+
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	require.NoError(t, c.Status().Update(context.Background(), pod))
+
+	s.check(context.Background())
+	assert.Equal(t, []string{"default/test-cron/ImagePullFailed"}, mockDispatcher.ClearedAlerts)
+	assert.Equal(t, 1, mockStore.ResolveAlertCalls)
+
+	// Cleared once
+	s.check(context.Background())
+	assert.Len(t, mockDispatcher.ClearedAlerts, 1)
+}
+
+func TestPodStartScheduler_MissingConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		title   string
+		want    string
+	}{
+		{
+			name:    "missing secret",
+			message: `secret "db-creds" not found`,
+			title:   "Missing Secret: default/test-cron",
+			want:    "cannot start: Secret default/db-creds does not exist",
+		},
+		{
+			name:    "missing configmap",
+			message: `configmap "app-config" not found`,
+			title:   "Missing ConfigMap: default/test-cron",
+			want:    "cannot start: ConfigMap default/app-config does not exist",
+		},
+		{
+			name:    "missing key",
+			message: "couldn't find key password in Secret default/db-creds",
+			title:   "Missing Secret: default/test-cron",
+			want:    `cannot start: Secret default/db-creds has no key "password"`,
+		},
+		{
+			name:    "other config error",
+			message: "container has runAsNonRoot and image will run as root",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := newTestJobPod("test-cron-1", "CreateContainerConfigError")
+			pod.Status.ContainerStatuses[0].State.Waiting.Message = tt.message
+			c := newTestSchedulerClient(newTestStuckJobMonitor(nil), newTestRunningJob("test-cron-1", time.Minute), pod)
+			mockDispatcher := testutil.NewMockDispatcher()
+			s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+			s.check(context.Background())
+			if tt.want == "" {
+				assert.Empty(t, mockDispatcher.DispatchedAlerts)
+				return
+			}
+			require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+			alert := mockDispatcher.DispatchedAlerts[0]
+			assert.Equal(t, "MissingConfig", alert.Type)
+			assert.Equal(t, "default/test-cron/MissingConfig", alert.Key)
+			assert.Equal(t, "critical", alert.Severity)
+			assert.Equal(t, tt.title, alert.Title)
+			assert.Contains(t, alert.Message, "Container main of Job test-cron-1 "+tt.want)
+		})
+	}
+}
+
+func TestPodStartScheduler_MissingConfigOnlyForOwnJobs(t *testing.T) {
+	other := newTestRunningJob("other-1", time.Minute)
+	other.OwnerReferences[0].Name = "other"
+	otherPod := newTestJobPod("other-1", "CreateContainerConfigError")
+	otherPod.Status.ContainerStatuses[0].State.Waiting.Message = `secret "other-creds" not found`
+
+	// The Job's own pod waits on an init container
+	pod := newTestJobPod("test-cron-1", "PodInitializing")
+	pod.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+		Name: "migrate",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+			Reason:  "CreateContainerConfigError",
+			Message: `configmap "migrations" not found`,
+		}},
+	}}
+
+	c := &podListRecorder{Client: newTestSchedulerClient(
+		newTestStuckJobMonitor(nil), newTestRunningJob("test-cron-1", time.Minute), pod, other, otherPod,
+	)}
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "MissingConfig", alert.Type)
+	assert.Contains(t, alert.Message, "Container migrate of Job test-cron-1 cannot start: ConfigMap default/migrations does not exist")
+	assert.Equal(t, []string{"job-name=test-cron-1"}, c.selectors, "pods are listed once per Job for all checks")
+}
+
+func newTestUnschedulablePod(jobName string, pendingFor time.Duration) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName + "-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": jobName},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionFalse,
+				Reason:             corev1.PodReasonUnschedulable,
+				Message:            "0/3 nodes are available: 3 Insufficient memory.",
+				LastTransitionTime: metav1.Time{Time: time.Now().Add(-pendingFor)},
+			}},
+		},
+	}
+}
+
+func TestPodStartScheduler_Unschedulable(t *testing.T) {
+	monitor := newTestStuckJobMonitor(nil)
+	pod := newTestUnschedulablePod("test-cron-1", 2*time.Minute)
+	c := newTestSchedulerClient(monitor, newTestRunningJob("test-cron-1", 2*time.Minute), pod)
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	// Pending within the threshold
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts)
+
+	s.SetUnschedulableAfter(time.Minute)
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "SchedulingFailed", alert.Type)
+	assert.Equal(t, "default/test-cron/SchedulingFailed", alert.Key)
+	assert.Equal(t, "warning", alert.Severity)
+	assert.Contains(t, alert.Message, "has not started")
+	assert.Contains(t, alert.Message, "None of its code has run yet")
+	assert.Equal(t, []string{"Unschedulable: 0/3 nodes are available: 3 Insufficient memory."}, alert.Context.Events)
+	assert.Equal(t, []string{"test-cron-1-abcde"}, alert.Context.PodNames)
+
+	// Scheduled at last
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.Conditions[0].Status = corev1.ConditionTrue
+	require.NoError(t, c.Status().Update(context.Background(), pod))
+	s.check(context.Background())
+	assert.Contains(t, mockDispatcher.ClearedAlerts, "default/test-cron/SchedulingFailed")
+}
+
+func TestPodStartScheduler_QuotaExceeded(t *testing.T) {
+	monitor := newTestStuckJobMonitor(nil)
+	quotaEvent := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "test-cron-1.failedcreate", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Job", Name: "test-cron-1"},
+		Reason:         "FailedCreate",
+		Message:        `Error creating: pods "test-cron-1-x" is forbidden: exceeded quota: compute, requested: memory=4Gi, used: memory=8Gi, limited: memory=8Gi`,
+	}
+	c := newTestSchedulerClient(monitor, newTestRunningJob("test-cron-1", 10*time.Minute), quotaEvent)
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	require.Len(t, mockDispatcher.DispatchedAlerts, 1)
+	alert := mockDispatcher.DispatchedAlerts[0]
+	assert.Equal(t, "SchedulingFailed", alert.Type)
+	assert.Equal(t, "QuotaExceeded", alert.Context.Reason)
+	assert.Contains(t, alert.Message, "exceeded quota: compute")
+	assert.Empty(t, alert.Context.PodNames)
+}
+
+func TestPodStartScheduler_QuotaExceededOfOtherJob(t *testing.T) {
+	quotaEvent := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "other-1.failedcreate", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Job", Name: "other-1"},
+		Reason:         "FailedCreate",
+		Message:        `Error creating: pods "other-1-x" is forbidden: exceeded quota: compute`,
+	}
+	c := newTestSchedulerClient(newTestStuckJobMonitor(nil), newTestRunningJob("test-cron-1", 10*time.Minute), quotaEvent)
+	mockDispatcher := testutil.NewMockDispatcher()
+	s := NewPodStartScheduler(c, &testutil.MockStore{}, mockDispatcher)
+
+	s.check(context.Background())
+	assert.Empty(t, mockDispatcher.DispatchedAlerts, "events of other Jobs are ignored")
+}
+
+// ============================================================================
+// HeartbeatScheduler Tests
+// ============================================================================
+
+// heartbeatRecorder records the requests of a heartbeat receiver
+type heartbeatRecorder struct {
+	mu       sync.Mutex
+	requests []string // "METHOD path"
+}
+
+func (h *heartbeatRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.requests = append(h.requests, r.Method+" "+r.URL.Path)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *heartbeatRecorder) Requests() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string(nil), h.requests...)
+}
+
+func TestHeartbeatScheduler_Beat(t *testing.T) {
+	recorder := &heartbeatRecorder{}
+	srv := httptest.NewServer(recorder)
+	defer srv.Close()
+
+	s := NewHeartbeatScheduler(config.HeartbeatConfig{
+		URL:         srv.URL + "/ping/abc",
+		Method:      "post",
+		Pushgateway: config.PushgatewayConfig{URL: srv.URL, Job: "cronjob-guardian"},
+	}, &testutil.MockStore{}, map[string]string{"cluster": "prod"})
+
+	s.beat(context.Background())
+
+	assert.Equal(t, []string{
+		"POST /ping/abc",
+		"PUT /metrics/job/cronjob-guardian/cluster/prod",
+	}, recorder.Requests())
+	assert.False(t, s.LastRun().IsZero())
+}
+
+func TestHeartbeatScheduler_SkipsWhenStoreUnhealthy(t *testing.T) {
+	recorder := &heartbeatRecorder{}
+	srv := httptest.NewServer(recorder)
+	defer srv.Close()
+
+	s := NewHeartbeatScheduler(config.HeartbeatConfig{URL: srv.URL},
+		&testutil.MockStore{HealthError: errors.New("connection refused")}, nil)
+
+	s.beat(context.Background())
+
+	assert.Empty(t, recorder.Requests(), "no heartbeat while the store is down")
+}
+
+func TestHeartbeatScheduler_Start(t *testing.T) {
+	recorder := &heartbeatRecorder{}
+	srv := httptest.NewServer(recorder)
+	defer srv.Close()
+
+	s := NewHeartbeatScheduler(config.HeartbeatConfig{URL: srv.URL, Interval: 20 * time.Millisecond}, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = s.Start(ctx)
+	}()
+
+	// The first heartbeat is sent right away, then every interval
+	assert.Eventually(t, func() bool {
+		return len(recorder.Requests()) >= 3
+	}, time.Second, 10*time.Millisecond)
+	s.Stop()
+
+	for _, r := range recorder.Requests() {
+		assert.Equal(t, "GET /", r)
+	}
+}
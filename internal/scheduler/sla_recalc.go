@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -13,17 +14,21 @@ import (
 	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/analyzer"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
 	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
 )
 
 // SLARecalcScheduler periodically recalculates SLA metrics
 type SLARecalcScheduler struct {
+	runTracker
+
 	client     client.Client
 	store      store.Store
 	analyzer   analyzer.SLAAnalyzer
 	dispatcher alerting.Dispatcher
 	interval   time.Duration
 	elected    <-chan struct{} // leader election signal (nil = no leader election)
+	shard      shard.Shard     // only monitors in this shard's namespaces are recalculated
 	stopCh     chan struct{}
 	running    bool
 	mu         sync.Mutex
@@ -108,8 +113,16 @@ func (s *SLARecalcScheduler) SetElected(elected <-chan struct{}) {
 	s.elected = elected
 }
 
+// SetShard limits recalculation to monitors in the shard's namespaces (must be called before Start)
+func (s *SLARecalcScheduler) SetShard(sh shard.Shard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shard = sh
+}
+
 func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 	logger := log.FromContext(ctx)
+	s.markRun(time.Now())
 
 	monitors := &v1alpha1.CronJobMonitorList{}
 	if err := s.client.List(ctx, monitors); err != nil {
@@ -118,9 +131,15 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 	}
 
 	for _, monitor := range monitors.Items {
+		if !s.shard.Owns(monitor.Namespace) {
+			continue
+		}
 		if monitor.Spec.SLA == nil || !isEnabled(monitor.Spec.SLA.Enabled) {
 			continue
 		}
+		if monitor.IsSilenced(time.Now()) {
+			continue
+		}
 
 		windowDays := int(getOrDefault(monitor.Spec.SLA.WindowDays, 7))
 
@@ -149,16 +168,10 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 				for _, v := range slaResult.Violations {
 					alertKey := fmt.Sprintf("%s/%s/SLA/%s", cjStatus.Namespace, cjStatus.Name, v.Type)
 
-					// Safely get severity override
-					var slaBreachedSeverity string
-					if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-						slaBreachedSeverity = monitor.Spec.Alerting.SeverityOverrides.SLABreached
-					}
-
 					alert := alerting.Alert{
 						Key:      alertKey,
 						Type:     "SLABreached",
-						Severity: getSeverity(slaBreachedSeverity, "warning"),
+						Severity: monitor.Spec.Alerting.SeverityFor("SLABreached", "warning"),
 						Title:    fmt.Sprintf("SLA breach: %s/%s", cjStatus.Namespace, cjStatus.Name),
 						Message:  v.Message,
 						CronJob:  cronJobNN,
@@ -172,7 +185,7 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 						Timestamp: time.Now(),
 					}
 
-					if err := s.dispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+					if err := dispatchFor(ctx, s.dispatcher, &monitor, alert); err != nil {
 						logger.Error(err, "failed to dispatch SLA alert")
 					}
 				}
@@ -191,16 +204,10 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 			// Check duration regression
 			regResult, err := s.analyzer.CheckDurationRegression(ctx, cronJobNN, monitor.Spec.SLA)
 			if err == nil && regResult.Detected {
-				// Safely get severity override
-				var regressionSeverity string
-				if monitor.Spec.Alerting != nil && monitor.Spec.Alerting.SeverityOverrides != nil {
-					regressionSeverity = monitor.Spec.Alerting.SeverityOverrides.DurationRegression
-				}
-
 				alert := alerting.Alert{
 					Key:      fmt.Sprintf("%s/%s/DurationRegression", cjStatus.Namespace, cjStatus.Name),
 					Type:     "DurationRegression",
-					Severity: getSeverity(regressionSeverity, "warning"),
+					Severity: monitor.Spec.Alerting.SeverityFor("DurationRegression", "warning"),
 					Title:    fmt.Sprintf("Duration regression: %s/%s", cjStatus.Namespace, cjStatus.Name),
 					Message:  regResult.Message,
 					CronJob:  cronJobNN,
@@ -211,7 +218,7 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 					Timestamp: time.Now(),
 				}
 
-				if err := s.dispatcher.Dispatch(ctx, alert, monitor.Spec.Alerting); err != nil {
+				if err := dispatchFor(ctx, s.dispatcher, &monitor, alert); err != nil {
 					logger.Error(err, "failed to dispatch regression alert")
 				}
 			} else if err == nil {
@@ -223,6 +230,51 @@ func (s *SLARecalcScheduler) recalculate(ctx context.Context) {
 					_ = s.store.ResolveAlert(ctx, "DurationRegression", cjStatus.Namespace, cjStatus.Name)
 				}
 			}
+
+			s.checkScheduleBudget(ctx, &monitor, cronJobNN)
 		}
 	}
 }
+
+// checkScheduleBudget alerts when a CronJob's runs take up most of the time
+// between its scheduled runs, and clears the alert once they no longer do
+func (s *SLARecalcScheduler) checkScheduleBudget(ctx context.Context, monitor *v1alpha1.CronJobMonitor, cronJobNN types.NamespacedName) {
+	logger := log.FromContext(ctx)
+	alertKey := fmt.Sprintf("%s/%s/DurationBudget", cronJobNN.Namespace, cronJobNN.Name)
+
+	cronJob := &batchv1.CronJob{}
+	if err := s.client.Get(ctx, cronJobNN, cronJob); err != nil {
+		logger.V(1).Error(err, "failed to get CronJob for schedule budget", "cronjob", cronJobNN.Name)
+		return
+	}
+	result, err := s.analyzer.CheckScheduleBudget(ctx, cronJob, monitor.Spec.SLA)
+	if err != nil {
+		logger.V(1).Error(err, "failed to check schedule budget", "cronjob", cronJobNN.Name)
+		return
+	}
+
+	if !result.Exceeded {
+		_ = s.dispatcher.ClearAlert(ctx, alertKey)
+		if s.store != nil {
+			_ = s.store.ResolveAlert(ctx, "DurationBudget", cronJobNN.Namespace, cronJobNN.Name)
+		}
+		return
+	}
+
+	alert := alerting.Alert{
+		Key:      alertKey,
+		Type:     "DurationBudget",
+		Severity: monitor.Spec.Alerting.SeverityFor("DurationBudget", "warning"),
+		Title:    fmt.Sprintf("Duration budget exceeded: %s/%s", cronJobNN.Namespace, cronJobNN.Name),
+		Message:  result.Message,
+		CronJob:  cronJobNN,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Timestamp: time.Now(),
+	}
+	if err := dispatchFor(ctx, s.dispatcher, monitor, alert); err != nil {
+		logger.Error(err, "failed to dispatch schedule budget alert")
+	}
+}
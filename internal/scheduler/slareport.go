@@ -0,0 +1,217 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/config"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/report"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// SLAReportScheduler sends periodic SLA reports for email channels with slaReport set
+type SLAReportScheduler struct {
+	runTracker
+
+	client       client.Client
+	store        store.Store
+	builder      *report.Builder
+	newChannel   func(client.Client, store.Store, *v1alpha1.AlertChannel) (alerting.Channel, error)
+	interval     time.Duration
+	elected      <-chan struct{} // leader election signal (nil = no leader election)
+	dashboardURL string          // external dashboard URL linked to (empty = no links)
+	stopCh       chan struct{}
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewSLAReportScheduler creates a new SLA report scheduler. Ownership
+// resolves the CronJobs of teams.
+func NewSLAReportScheduler(c client.Client, st store.Store, ownership config.OwnershipConfig) *SLAReportScheduler {
+	return &SLAReportScheduler{
+		client:     c,
+		store:      st,
+		builder:    report.NewBuilder(c, st, ownership),
+		newChannel: alerting.NewEmailChannel,
+		interval:   time.Minute,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *SLAReportScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Wait for leader election if configured
+	if elected != nil {
+		logger.Info("waiting for leader election before starting SLA report scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting SLA report scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting SLA report scheduler", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.sendDueReports(ctx, time.Now())
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *SLAReportScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetInterval changes how often channels are checked for due reports
+func (s *SLAReportScheduler) SetInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interval = d
+}
+
+// SetDashboardURL sets the external dashboard URL reports link to (must be
+// called before Start)
+func (s *SLAReportScheduler) SetDashboardURL(dashboardURL string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dashboardURL = strings.TrimSuffix(dashboardURL, "/")
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *SLAReportScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+func (s *SLAReportScheduler) sendDueReports(ctx context.Context, now time.Time) {
+	logger := log.FromContext(ctx)
+	s.markRun(now)
+
+	channels := &v1alpha1.AlertChannelList{}
+	if err := s.client.List(ctx, channels); err != nil {
+		logger.Error(err, "failed to list alert channels")
+		return
+	}
+
+	for i := range channels.Items {
+		ac := &channels.Items[i]
+		if ac.Spec.Type != "email" || ac.Spec.Email == nil || ac.Spec.Email.SLAReport == nil {
+			continue
+		}
+
+		start, end, due, err := report.ScheduledWindow(ac.Spec.Email.SLAReport, now)
+		if err != nil {
+			logger.Error(err, "invalid SLA report config", "channel", ac.Name)
+			continue
+		}
+		if !slaReportDue(ac, end, due) {
+			continue
+		}
+
+		if err := s.sendReport(ctx, ac, start, end); err != nil {
+			logger.Error(err, "failed to send SLA report", "channel", ac.Name)
+			continue
+		}
+		logger.Info("sent SLA report", "channel", ac.Name, "start", start, "end", end)
+	}
+}
+
+// slaReportDue reports whether the report due at due, for the period ending
+// at end, has not been sent yet. Channels created after the period ended
+// wait for the next one.
+func slaReportDue(ac *v1alpha1.AlertChannel, end, due time.Time) bool {
+	if ac.Status.LastSLAReportTime != nil {
+		return ac.Status.LastSLAReportTime.Time.Before(due)
+	}
+	return ac.CreationTimestamp.Time.Before(end)
+}
+
+func (s *SLAReportScheduler) sendReport(ctx context.Context, ac *v1alpha1.AlertChannel, start, end time.Time) error {
+	cfg := ac.Spec.Email.SLAReport
+	scope := report.Scope{Team: cfg.Team}
+	if cfg.Team == "" {
+		scope.Name = "channel " + ac.Name
+		scope.Filter = func(monitor *v1alpha1.CronJobMonitor) bool {
+			return !monitor.IsObserveOnly() && routesToChannel(monitor.Spec.Alerting, ac.Name)
+		}
+	}
+	rep, err := s.builder.Build(ctx, scope, cfg.Schedule, start, end)
+	if err != nil {
+		return err
+	}
+	rep.DashboardURL = s.dashboardURL
+
+	var html bytes.Buffer
+	if err := report.RenderHTML(&html, rep); err != nil {
+		return err
+	}
+	msg := alerting.Report{Subject: rep.Subject(), HTML: html.String()}
+	if cfg.AttachPDF {
+		var pdf bytes.Buffer
+		if err := report.RenderPDF(&pdf, rep); err != nil {
+			return err
+		}
+		msg.Attachments = append(msg.Attachments, alerting.Attachment{
+			Filename:    rep.Filename("pdf"),
+			ContentType: "application/pdf",
+			Data:        pdf.Bytes(),
+		})
+	}
+
+	ch, err := s.newChannel(s.client, s.store, ac)
+	if err != nil {
+		return err
+	}
+	sender, ok := ch.(alerting.ReportSender)
+	if !ok {
+		return fmt.Errorf("channel type %s does not support reports", ch.Type())
+	}
+	if err := sender.SendReport(ctx, msg); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(ac.DeepCopy())
+	now := metav1.Now()
+	ac.Status.LastSLAReportTime = &now
+	return s.client.Status().Patch(ctx, ac, patch)
+}
@@ -0,0 +1,329 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/alerting"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/shard"
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=delete
+
+// KillStuckJobsAnnotation opts a CronJob in to having its stuck Jobs deleted.
+// It is set on the CronJob, so the owners of a workload decide whether it may
+// be killed, not the owners of the monitor.
+const KillStuckJobsAnnotation = "guardian.illenium.net/kill-stuck-jobs"
+
+// Stuck job detection defaults
+const (
+	defaultStuckBaselineWindowDays = 14
+	defaultStuckMinRuns            = 5
+)
+
+// StuckJobScheduler periodically checks running Jobs of monitored CronJobs and
+// alerts on those that run far longer than they should
+type StuckJobScheduler struct {
+	runTracker
+
+	client     client.Client
+	store      store.Store
+	dispatcher alerting.Dispatcher
+	interval   time.Duration
+	elected    <-chan struct{} // leader election signal (nil = no leader election)
+	shard      shard.Shard     // only monitors in this shard's namespaces are checked
+	stopCh     chan struct{}
+	running    bool
+	mu         sync.Mutex
+	stuck      map[types.NamespacedName]bool // CronJobs with a JobStuck alert sent by this scheduler
+}
+
+// NewStuckJobScheduler creates a new stuck job scheduler
+func NewStuckJobScheduler(c client.Client, st store.Store, d alerting.Dispatcher) *StuckJobScheduler {
+	return &StuckJobScheduler{
+		client:     c,
+		store:      st,
+		dispatcher: d,
+		interval:   1 * time.Minute,
+		stopCh:     make(chan struct{}),
+		stuck:      make(map[types.NamespacedName]bool),
+	}
+}
+
+// Start begins the scheduler loop
+func (s *StuckJobScheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return nil
+	}
+	s.running = true
+	elected := s.elected
+	s.mu.Unlock()
+
+	logger := log.FromContext(ctx)
+
+	// Wait for leader election if configured
+	if elected != nil {
+		logger.Info("waiting for leader election before starting stuck job scheduler")
+		select {
+		case <-elected:
+			logger.Info("leader election won, starting stuck job scheduler")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		}
+	}
+
+	logger.Info("starting stuck job scheduler", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// Stop halts the scheduler
+func (s *StuckJobScheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		close(s.stopCh)
+		s.running = false
+	}
+}
+
+// SetInterval changes the check interval
+func (s *StuckJobScheduler) SetInterval(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interval = d
+}
+
+// SetElected sets the leader election channel (must be called before Start)
+func (s *StuckJobScheduler) SetElected(elected <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elected = elected
+}
+
+// SetShard limits checks to monitors in the shard's namespaces (must be called before Start)
+func (s *StuckJobScheduler) SetShard(sh shard.Shard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shard = sh
+}
+
+func (s *StuckJobScheduler) check(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	now := time.Now()
+	s.markRun(now)
+
+	monitors := &v1alpha1.CronJobMonitorList{}
+	if err := s.client.List(ctx, monitors); err != nil {
+		logger.Error(err, "failed to list monitors")
+		return
+	}
+
+	// Running Jobs by namespace, listed once per check
+	jobsByNamespace := make(map[string][]batchv1.Job)
+
+	for _, monitor := range monitors.Items {
+		if !s.shard.Owns(monitor.Namespace) {
+			continue
+		}
+		cfg := monitor.Spec.StuckJobs
+		if cfg == nil || !isEnabled(cfg.Enabled) || (cfg.MaxRuntime == nil && cfg.P95Multiplier == nil) {
+			continue
+		}
+		if monitor.IsSilenced(now) || inMaintenanceWindow(monitor.Spec.MaintenanceWindows, now, "") {
+			continue
+		}
+
+		for _, cjStatus := range monitor.Status.CronJobs {
+			cronJob := types.NamespacedName{Namespace: cjStatus.Namespace, Name: cjStatus.Name}
+
+			jobs, ok := jobsByNamespace[cronJob.Namespace]
+			if !ok {
+				jobs = runningJobs(ctx, s.client, cronJob.Namespace)
+				jobsByNamespace[cronJob.Namespace] = jobs
+			}
+
+			stuck, runtime, threshold := s.findStuckJob(ctx, cfg, cronJob, jobs, now)
+			if stuck == nil {
+				if s.stuck[cronJob] || hasActiveAlert(cjStatus.ActiveAlerts, "JobStuck") {
+					delete(s.stuck, cronJob)
+					_ = s.dispatcher.ClearAlert(ctx, fmt.Sprintf("%s/%s/JobStuck", cronJob.Namespace, cronJob.Name))
+					if s.store != nil {
+						_ = s.store.ResolveAlert(ctx, "JobStuck", cronJob.Namespace, cronJob.Name)
+					}
+				}
+				continue
+			}
+
+			killed := s.killIfOptedIn(ctx, cronJob, stuck)
+			s.alertStuck(ctx, &monitor, cronJob, stuck, runtime, threshold, killed)
+		}
+	}
+}
+
+// runningJobs lists the Jobs in a namespace that have started and not finished
+func runningJobs(ctx context.Context, c client.Client, namespace string) []batchv1.Job {
+	jobs := &batchv1.JobList{}
+	if err := c.List(ctx, jobs, client.InNamespace(namespace)); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list jobs", "namespace", namespace)
+		return nil
+	}
+
+	running := make([]batchv1.Job, 0, len(jobs.Items))
+	for _, job := range jobs.Items {
+		if job.Status.StartTime != nil && job.Status.CompletionTime == nil && !jobFinished(&job) {
+			running = append(running, job)
+		}
+	}
+	return running
+}
+
+// jobFinished reports whether a Job has a terminal condition
+func jobFinished(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// findStuckJob returns the longest-running Job of a CronJob that exceeds its
+// runtime threshold, with its runtime and the threshold
+func (s *StuckJobScheduler) findStuckJob(ctx context.Context, cfg *v1alpha1.StuckJobConfig, cronJob types.NamespacedName, jobs []batchv1.Job, now time.Time) (*batchv1.Job, time.Duration, time.Duration) {
+	var longest *batchv1.Job
+	var runtime time.Duration
+	for i := range jobs {
+		if !ownedByCronJob(&jobs[i], cronJob.Name) {
+			continue
+		}
+		if r := now.Sub(jobs[i].Status.StartTime.Time); longest == nil || r > runtime {
+			longest = &jobs[i]
+			runtime = r
+		}
+	}
+	if longest == nil {
+		return nil, 0, 0
+	}
+
+	threshold := s.stuckThreshold(ctx, cfg, cronJob)
+	if threshold <= 0 || runtime <= threshold {
+		return nil, 0, 0
+	}
+	return longest, runtime, threshold
+}
+
+// stuckThreshold returns the lower of the absolute and the P95-relative
+// runtime limit, or 0 if neither applies
+func (s *StuckJobScheduler) stuckThreshold(ctx context.Context, cfg *v1alpha1.StuckJobConfig, cronJob types.NamespacedName) time.Duration {
+	var threshold time.Duration
+	if cfg.MaxRuntime != nil {
+		threshold = cfg.MaxRuntime.Duration
+	}
+
+	if cfg.P95Multiplier == nil || s.store == nil {
+		return threshold
+	}
+	windowDays := int(getOrDefault(cfg.BaselineWindowDays, defaultStuckBaselineWindowDays))
+	metrics, err := s.store.GetMetrics(ctx, cronJob, windowDays)
+	if err != nil || metrics == nil {
+		return threshold
+	}
+	if metrics.TotalRuns < getOrDefault(cfg.MinRuns, defaultStuckMinRuns) || metrics.P95DurationSeconds <= 0 {
+		return threshold
+	}
+
+	relative := time.Duration(math.Round(metrics.P95DurationSeconds * *cfg.P95Multiplier * float64(time.Second)))
+	if threshold == 0 || relative < threshold {
+		threshold = relative
+	}
+	return threshold
+}
+
+// ownedByCronJob reports whether a Job was created by the named CronJob
+func ownedByCronJob(job *batchv1.Job, cronJobName string) bool {
+	for _, ref := range job.OwnerReferences {
+		if ref.Kind == "CronJob" && ref.Name == cronJobName {
+			return true
+		}
+	}
+	return false
+}
+
+// killIfOptedIn deletes a stuck Job if its CronJob has the kill-stuck-jobs annotation
+func (s *StuckJobScheduler) killIfOptedIn(ctx context.Context, cronJob types.NamespacedName, job *batchv1.Job) bool {
+	logger := log.FromContext(ctx)
+
+	cj := &batchv1.CronJob{}
+	if err := s.client.Get(ctx, cronJob, cj); err != nil {
+		return false
+	}
+	if cj.Annotations[KillStuckJobsAnnotation] != "true" {
+		return false
+	}
+
+	if err := s.client.Delete(ctx, job, client.PropagationPolicy("Background")); err != nil {
+		logger.Error(err, "failed to delete stuck job", "job", job.Name, "namespace", job.Namespace)
+		return false
+	}
+	logger.Info("deleted stuck job", "job", job.Name, "namespace", job.Namespace, "cronjob", cronJob.Name)
+	return true
+}
+
+// alertStuck sends a JobStuck alert for a CronJob's stuck Job
+func (s *StuckJobScheduler) alertStuck(ctx context.Context, monitor *v1alpha1.CronJobMonitor, cronJob types.NamespacedName, job *batchv1.Job, runtime, threshold time.Duration, killed bool) {
+	message := fmt.Sprintf("Job %s has been running for %s (limit: %s)", job.Name, runtime.Round(time.Second), threshold.Round(time.Second))
+	if killed {
+		message += "; the Job was deleted"
+	}
+
+	alert := alerting.Alert{
+		Type:     "JobStuck",
+		Severity: monitor.Spec.Alerting.SeverityFor("JobStuck", "warning"),
+		Title:    fmt.Sprintf("Job stuck: %s/%s", cronJob.Namespace, cronJob.Name),
+		Message:  message,
+		CronJob:  cronJob,
+		MonitorRef: types.NamespacedName{
+			Namespace: monitor.Namespace,
+			Name:      monitor.Name,
+		},
+		Context: alerting.AlertContext{
+			JobName:      job.Name,
+			LastDuration: runtime,
+		},
+		Timestamp: time.Now(),
+	}
+
+	if err := dispatchFor(ctx, s.dispatcher, monitor, alert); err != nil {
+		log.FromContext(ctx).Error(err, "failed to dispatch stuck job alert", "cronjob", cronJob.String())
+		return
+	}
+	s.stuck[cronJob] = true
+}
@@ -0,0 +1,91 @@
+// Package shard assigns namespaces to shards, so the CronJobs of a large
+// cluster can be monitored by several independent guardian deployments that
+// share one database.
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Shard is the slice of namespaces handled by this instance. The zero value
+// (and any Shard with Count <= 1) handles every namespace.
+type Shard struct {
+	// Index is this instance's shard, from 0 to Count-1
+	Index int
+	// Count is the total number of shards
+	Count int
+}
+
+// New returns the shard with the given index, validating it against count
+func New(index, count int) (Shard, error) {
+	if count < 1 {
+		return Shard{}, fmt.Errorf("shard count must be at least 1, got %d", count)
+	}
+	if index < 0 || index >= count {
+		return Shard{}, fmt.Errorf("shard index must be between 0 and %d, got %d", count-1, index)
+	}
+	return Shard{Index: index, Count: count}, nil
+}
+
+// Enabled returns true if monitoring is split across more than one shard
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+// Primary returns true for the shard that runs cluster-wide tasks such as
+// history pruning and digests, which must run exactly once
+func (s Shard) Primary() bool {
+	return !s.Enabled() || s.Index == 0
+}
+
+// Owns returns true if the namespace belongs to this shard
+func (s Shard) Owns(namespace string) bool {
+	return !s.Enabled() || Of(namespace, s.Count) == s.Index
+}
+
+// Predicate filters watch events to objects in namespaces of this shard
+func (s Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return s.Owns(obj.GetNamespace())
+	})
+}
+
+// LeaderElectionID returns the leader election ID for this shard, so each
+// shard elects its own leader
+func (s Shard) LeaderElectionID(base string) string {
+	if !s.Enabled() {
+		return base
+	}
+	return fmt.Sprintf("shard-%d-of-%d.%s", s.Index, s.Count, base)
+}
+
+// String returns the shard as index/count
+func (s Shard) String() string {
+	if !s.Enabled() {
+		return "0/1"
+	}
+	return fmt.Sprintf("%d/%d", s.Index, s.Count)
+}
+
+// Of returns the shard of a namespace using jump consistent hashing, so
+// changing the shard count moves as few namespaces as possible
+func Of(namespace string, count int) int {
+	if count <= 1 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(namespace))
+	key := h.Sum64()
+
+	var b, j int64 = -1, 0
+	for j < int64(count) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,90 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestNew(t *testing.T) {
+	s, err := New(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, Shard{Index: 1, Count: 3}, s)
+	assert.Equal(t, "1/3", s.String())
+
+	_, err = New(3, 3)
+	assert.Error(t, err)
+	_, err = New(-1, 3)
+	assert.Error(t, err)
+	_, err = New(0, 0)
+	assert.Error(t, err)
+}
+
+func TestShard_Unsharded(t *testing.T) {
+	for _, s := range []Shard{{}, {Index: 0, Count: 1}} {
+		assert.False(t, s.Enabled())
+		assert.True(t, s.Primary())
+		assert.True(t, s.Owns("any"))
+		assert.Equal(t, "59ab3636.illenium.net", s.LeaderElectionID("59ab3636.illenium.net"))
+	}
+}
+
+func TestShard_EachNamespaceOwnedOnce(t *testing.T) {
+	const count = 4
+	owned := make([]int, count)
+	for i := 0; i < 1000; i++ {
+		ns := fmt.Sprintf("team-%d", i)
+		owners := 0
+		for idx := 0; idx < count; idx++ {
+			if (Shard{Index: idx, Count: count}).Owns(ns) {
+				owners++
+				owned[idx]++
+			}
+		}
+		require.Equal(t, 1, owners, "namespace %s", ns)
+	}
+	// Namespaces are spread roughly evenly
+	for idx, n := range owned {
+		assert.InDelta(t, 250, n, 60, "shard %d", idx)
+	}
+}
+
+func TestOf_MinimalMovementWhenGrowing(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		ns := fmt.Sprintf("team-%d", i)
+		before, after := Of(ns, 4), Of(ns, 5)
+		// A namespace either stays or moves to the new shard
+		if before != after {
+			assert.Equal(t, 4, after, "namespace %s", ns)
+		}
+	}
+}
+
+func TestShard_LeaderElectionID(t *testing.T) {
+	s := Shard{Index: 1, Count: 3}
+	assert.Equal(t, "shard-1-of-3.59ab3636.illenium.net", s.LeaderElectionID("59ab3636.illenium.net"))
+	assert.False(t, s.Primary())
+}
+
+func TestShard_Predicate(t *testing.T) {
+	s := Shard{Index: Of("payments", 3), Count: 3}
+	p := s.Predicate()
+
+	owned := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "payments", Name: "a"}}
+	assert.True(t, p.Create(event.CreateEvent{Object: owned}))
+
+	for i := 0; i < 100; i++ {
+		ns := fmt.Sprintf("team-%d", i)
+		if Of(ns, 3) != s.Index {
+			other := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "a"}}
+			assert.False(t, p.Create(event.CreateEvent{Object: other}))
+			return
+		}
+	}
+	t.Fatal("no namespace of another shard found")
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Per-CronJob analytics aggregated in the database. Day and hour buckets are
+// in UTC. Daily duration percentiles use percentile_cont on PostgreSQL and the
+// same window-function interpolation as GetMetrics elsewhere, so all backends
+// (including SQLite 3.25+) return identical numbers.
+
+// dayExpr returns the dialect's expression for the UTC day (YYYY-MM-DD) of start_time
+func (s *GormStore) dayExpr() string {
+	switch {
+	case s.isPostgres():
+		return "to_char(start_time AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+	case s.dialect == "mysql":
+		return "DATE_FORMAT(start_time, '%Y-%m-%d')"
+	default:
+		return "date(start_time)"
+	}
+}
+
+// dayOfWeekExpr returns the dialect's expression for the day of week of start_time (0 = Sunday)
+func (s *GormStore) dayOfWeekExpr() string {
+	switch {
+	case s.isPostgres():
+		return "CAST(EXTRACT(DOW FROM start_time AT TIME ZONE 'UTC') AS INTEGER)"
+	case s.dialect == "mysql":
+		return "(DAYOFWEEK(start_time) - 1)"
+	default:
+		return "CAST(strftime('%w', start_time) AS INTEGER)"
+	}
+}
+
+// hourExpr returns the dialect's expression for the hour of day of start_time
+func (s *GormStore) hourExpr() string {
+	switch {
+	case s.isPostgres():
+		return "CAST(EXTRACT(HOUR FROM start_time AT TIME ZONE 'UTC') AS INTEGER)"
+	case s.dialect == "mysql":
+		return "HOUR(start_time)"
+	default:
+		return "CAST(strftime('%H', start_time) AS INTEGER)"
+	}
+}
+
+// GetExecutionAnalytics aggregates a CronJob's executions over the window
+func (s *GormStore) GetExecutionAnalytics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (*ExecutionAnalytics, error) {
+	s = s.forRead()
+	since := time.Now().AddDate(0, 0, -windowDays)
+	a := &ExecutionAnalytics{WindowDays: windowDays}
+
+	scope := s.scoped(ctx).Model(&Execution{}).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?", cronJob.Namespace, cronJob.Name, since)
+
+	if err := scope.Session(&gorm.Session{}).
+		Select("exit_code, COUNT(*) AS count").
+		Group("exit_code").
+		Order("count DESC, exit_code").
+		Scan(&a.ExitCodes).Error; err != nil {
+		return nil, fmt.Errorf("exit code histogram: %w", err)
+	}
+
+	if err := scope.Session(&gorm.Session{}).
+		Where("succeeded = ?", false).
+		Select("reason, COUNT(*) AS count").
+		Group("reason").
+		Order("count DESC, reason").
+		Scan(&a.FailureReasons).Error; err != nil {
+		return nil, fmt.Errorf("failure reasons: %w", err)
+	}
+
+	trend, err := s.getDailyDurations(ctx, cronJob, since)
+	if err != nil {
+		return nil, fmt.Errorf("duration trend: %w", err)
+	}
+	a.DurationTrend = trend
+
+	dow, hour := s.dayOfWeekExpr(), s.hourExpr()
+	if err := scope.Session(&gorm.Session{}).
+		Select(fmt.Sprintf(`%s AS day_of_week, %s AS hour,
+			COUNT(*) AS total,
+			SUM(CASE WHEN succeeded THEN 1 ELSE 0 END) AS succeeded`, dow, hour)).
+		Group(dow + ", " + hour).
+		Order("day_of_week, hour").
+		Scan(&a.Heatmap).Error; err != nil {
+		return nil, fmt.Errorf("heatmap: %w", err)
+	}
+
+	return a, nil
+}
+
+// getDailyDurations computes p50 and p95 run durations per UTC day
+func (s *GormStore) getDailyDurations(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]DailyDuration, error) {
+	day := s.dayExpr()
+	var trend []DailyDuration
+
+	if s.isPostgres() {
+		err := s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
+				cronJob.Namespace, cronJob.Name, since).
+			Select(day + ` AS day,
+				COUNT(*) AS runs,
+				PERCENTILE_CONT(0.50) WITHIN GROUP (ORDER BY duration_secs) AS p50_seconds,
+				PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY duration_secs) AS p95_seconds`).
+			Group("day").
+			Order("day").
+			Scan(&trend).Error
+		return trend, err
+	}
+
+	query := fmt.Sprintf(`SELECT day, MAX(cnt) AS runs, %[1]s AS p50_seconds, %[2]s AS p95_seconds
+		FROM (
+			SELECT %[3]s AS day,
+				duration_secs AS d,
+				LEAD(duration_secs) OVER (PARTITION BY %[3]s ORDER BY duration_secs) AS next_d,
+				ROW_NUMBER() OVER (PARTITION BY %[3]s ORDER BY duration_secs) AS rn,
+				COUNT(*) OVER (PARTITION BY %[3]s) AS cnt
+			FROM executions
+			WHERE cluster = ? AND cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL
+		) ranked
+		GROUP BY day
+		ORDER BY day`,
+		windowedPercentileExpr(50), windowedPercentileExpr(95), day,
+	)
+	err := s.conn().WithContext(ctx).
+		Raw(query, s.cluster, cronJob.Namespace, cronJob.Name, since).
+		Scan(&trend).Error
+	return trend, err
+}
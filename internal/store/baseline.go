@@ -0,0 +1,128 @@
+package store
+
+import "time"
+
+// The models below are the tables as they were at the 0001_baseline
+// migration, i.e. as the last release before versioned migrations created
+// them with AutoMigrate. baselineLegacySchema uses them to bring databases of
+// older releases up to the baseline; later changes belong in migrations, so
+// these must not change (see TestBaselineModels_MatchBaselineMigration).
+var baselineModels = []any{&baselineExecution{}, &baselineAlertHistory{}, &baselineChannelStats{}, &baselineAlertDelivery{}, &baselineAlertState{}, &baselineAlertClaim{}}
+
+type baselineExecution struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement"`
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;not null;index:idx_cronjob_time,priority:1;index:idx_cronjob_uid,priority:1;index:idx_cronjob_duration,priority:1"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253;not null;index:idx_cronjob_time,priority:2;index:idx_cronjob_uid,priority:2;index:idx_cronjob_duration,priority:2"`
+	CronJobUID       string     `gorm:"column:cronjob_uid;size:36;index:idx_cronjob_uid,priority:3"`
+	JobName          string     `gorm:"column:job_name;size:253;not null;index"`
+	ScheduledTime    *time.Time `gorm:"column:scheduled_time"`
+	StartTime        time.Time  `gorm:"column:start_time;not null;index:idx_cronjob_time,priority:3,sort:desc;index:idx_start_time;index:idx_cronjob_duration,priority:3"`
+	CompletionTime   time.Time  `gorm:"column:completion_time"`
+	DurationSecs     *float64   `gorm:"column:duration_secs;index:idx_cronjob_duration,priority:4"`
+	Succeeded        bool       `gorm:"column:succeeded;not null"`
+	ExitCode         int32      `gorm:"column:exit_code"`
+	Reason           string     `gorm:"column:reason;size:255"`
+	Classification   string     `gorm:"column:classification;size:64"`
+	IsRetry          bool       `gorm:"column:is_retry;default:false"`
+	RetryOf          string     `gorm:"column:retry_of;size:253"`
+	NodeName         string     `gorm:"column:node_name;size:253"`
+	Images           string     `gorm:"column:images;size:2048"`
+	PodNames         string     `gorm:"column:pod_names;size:2048"`
+	CPURequestMilli  int64      `gorm:"column:cpu_request_milli"`
+	MemoryRequest    int64      `gorm:"column:memory_request_bytes"`
+	Logs             *string    `gorm:"column:logs;type:text"`
+	LogsRef          string     `gorm:"column:logs_ref;size:1024"`
+	Events           *string    `gorm:"column:events;type:text"`
+	SuggestedFix     string     `gorm:"column:suggested_fix;type:text"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (*baselineExecution) TableName() string {
+	return "executions"
+}
+
+type baselineAlertHistory struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement"`
+	Type             string     `gorm:"column:alert_type;size:100;not null;index:idx_alert_resolve,priority:1"`
+	Severity         string     `gorm:"column:severity;size:20;not null;index:idx_alert_severity"`
+	Title            string     `gorm:"column:title;size:500;not null"`
+	Message          string     `gorm:"column:message;type:text"`
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;index:idx_alert_cronjob,priority:1;index:idx_alert_cronjob_time,priority:1;index:idx_alert_resolve,priority:2"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253;index:idx_alert_cronjob,priority:2;index:idx_alert_cronjob_time,priority:2;index:idx_alert_resolve,priority:3"`
+	MonitorNamespace string     `gorm:"column:monitor_ns;size:253"`
+	MonitorName      string     `gorm:"column:monitor_name;size:253"`
+	ChannelsNotified string     `gorm:"column:channels_notified;type:text"`
+	OccurredAt       time.Time  `gorm:"column:occurred_at;not null;index:idx_alert_occurred,sort:desc;index:idx_alert_cronjob_time,priority:3,sort:desc"`
+	ResolvedAt       *time.Time `gorm:"column:resolved_at;index:idx_alert_unresolved;index:idx_alert_resolve,priority:4"`
+	ExitCode         int32      `gorm:"column:exit_code"`
+	Reason           string     `gorm:"column:reason;size:255"`
+	SuggestedFix     string     `gorm:"column:suggested_fix;type:text"`
+}
+
+func (*baselineAlertHistory) TableName() string {
+	return "alert_history"
+}
+
+type baselineChannelStats struct {
+	ID                  int64      `gorm:"primaryKey;autoIncrement"`
+	ChannelName         string     `gorm:"column:channel_name;size:253;not null;uniqueIndex"`
+	AlertsSentTotal     int64      `gorm:"column:alerts_sent_total;default:0"`
+	AlertsFailedTotal   int64      `gorm:"column:alerts_failed_total;default:0"`
+	LastAlertTime       *time.Time `gorm:"column:last_alert_time"`
+	LastFailedTime      *time.Time `gorm:"column:last_failed_time"`
+	LastFailedError     string     `gorm:"column:last_failed_error;type:text"`
+	ConsecutiveFailures int32      `gorm:"column:consecutive_failures;default:0"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (*baselineChannelStats) TableName() string {
+	return "channel_stats"
+}
+
+type baselineAlertDelivery struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement"`
+	AlertKey         string     `gorm:"column:alert_key;size:512;not null;index:idx_delivery_alert"`
+	AlertType        string     `gorm:"column:alert_type;size:100;not null"`
+	Severity         string     `gorm:"column:severity;size:20;not null"`
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253"`
+	ChannelName      string     `gorm:"column:channel_name;size:253;not null;index:idx_delivery_channel"`
+	Payload          string     `gorm:"column:payload;type:text"`
+	RecordHistory    bool       `gorm:"column:record_history;default:false"`
+	Status           string     `gorm:"column:status;size:20;not null;index:idx_delivery_due,priority:1"`
+	Attempts         int32      `gorm:"column:attempts;default:0"`
+	NextAttemptAt    time.Time  `gorm:"column:next_attempt_at;not null;index:idx_delivery_due,priority:2"`
+	LastError        string     `gorm:"column:last_error;type:text"`
+	DeliveredAt      *time.Time `gorm:"column:delivered_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime;index:idx_delivery_created,sort:desc"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (*baselineAlertDelivery) TableName() string {
+	return "alert_deliveries"
+}
+
+type baselineAlertState struct {
+	AlertKey       string    `gorm:"column:alert_key;size:512;primaryKey"`
+	Payload        string    `gorm:"column:payload;type:text"`
+	LastSentAt     time.Time `gorm:"column:last_sent_at;not null;index:idx_alert_state_sent"`
+	AcknowledgedBy string    `gorm:"column:acknowledged_by;size:253"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (*baselineAlertState) TableName() string {
+	return "alert_states"
+}
+
+type baselineAlertClaim struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement"`
+	AlertKey    string    `gorm:"column:alert_key;size:512;not null;uniqueIndex:idx_alert_claim,priority:1"`
+	Signature   string    `gorm:"column:signature;size:255;not null;uniqueIndex:idx_alert_claim,priority:2"`
+	WindowStart time.Time `gorm:"column:window_start;not null;uniqueIndex:idx_alert_claim,priority:3;index:idx_alert_claim_window"`
+	ClaimedBy   string    `gorm:"column:claimed_by;size:253"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (*baselineAlertClaim) TableName() string {
+	return "alert_claims"
+}
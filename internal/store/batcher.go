@@ -0,0 +1,162 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+const (
+	// DefaultBatchSize is the number of buffered executions that triggers an immediate flush
+	DefaultBatchSize = 100
+	// DefaultBatchFlushInterval is how often buffered executions are written
+	DefaultBatchFlushInterval = 500 * time.Millisecond
+
+	// maxQueueBatches bounds the buffer to this many batches; beyond that
+	// Enqueue writes synchronously so memory stays bounded if the store is slow
+	maxQueueBatches = 10
+	// finalFlushTimeout bounds the flush performed on shutdown
+	finalFlushTimeout = 10 * time.Second
+)
+
+// ExecutionBatcher buffers execution records and writes them to the store in
+// batched transactions, flushing when the buffer reaches the batch size or the
+// flush interval elapses. It implements manager.Runnable.
+type ExecutionBatcher struct {
+	store         Store
+	batchSize     int
+	flushInterval time.Duration
+
+	mu      sync.Mutex
+	pending []Execution
+	full    chan struct{}
+
+	// flushMu serializes flushes so batches are written in order
+	flushMu sync.Mutex
+}
+
+// NewExecutionBatcher creates a batcher writing to st. Non-positive values use the defaults.
+func NewExecutionBatcher(st Store, batchSize int, flushInterval time.Duration) *ExecutionBatcher {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = DefaultBatchFlushInterval
+	}
+	return &ExecutionBatcher{
+		store:         st,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		full:          make(chan struct{}, 1),
+	}
+}
+
+// Enqueue buffers an execution for the next batch
+func (b *ExecutionBatcher) Enqueue(ctx context.Context, exec Execution) {
+	b.mu.Lock()
+	b.pending = append(b.pending, exec)
+	depth := len(b.pending)
+	b.mu.Unlock()
+	metrics.SetExecutionQueueDepth(depth)
+
+	if depth >= b.batchSize*maxQueueBatches {
+		// Writer is falling behind: apply backpressure
+		b.Flush(ctx)
+		return
+	}
+	if depth >= b.batchSize {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Len returns the number of buffered executions
+func (b *ExecutionBatcher) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// Start runs the flush loop until ctx is cancelled, then flushes what is left
+func (b *ExecutionBatcher) Start(ctx context.Context) error {
+	log.FromContext(ctx).Info("starting execution batcher", "batchSize", b.batchSize, "flushInterval", b.flushInterval)
+
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), finalFlushTimeout)
+			b.Flush(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+			b.Flush(ctx)
+		case <-b.full:
+			b.Flush(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection returns false: executions are buffered by whichever
+// replica records them and must be written even while not leading
+func (b *ExecutionBatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Flush writes all buffered executions
+func (b *ExecutionBatcher) Flush(ctx context.Context) {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	for start := 0; start < len(pending); start += b.batchSize {
+		end := min(start+b.batchSize, len(pending))
+		b.write(ctx, pending[start:end])
+	}
+	metrics.SetExecutionQueueDepth(b.Len())
+}
+
+// write stores one batch. If the batched insert fails the rows are retried
+// individually so one bad record does not drop the rest.
+func (b *ExecutionBatcher) write(ctx context.Context, batch []Execution) {
+	logger := log.FromContext(ctx)
+
+	err := b.store.RecordExecutions(ctx, batch)
+	if err == nil {
+		for _, exec := range batch {
+			recordExecutionMetric(exec)
+		}
+		return
+	}
+	logger.Error(err, "batched execution write failed, retrying individually", "count", len(batch))
+
+	for _, exec := range batch {
+		if err := b.store.RecordExecution(ctx, exec); err != nil {
+			logger.Error(err, "failed to record execution",
+				"cronJob", exec.CronJobNamespace+"/"+exec.CronJobName, "job", exec.JobName)
+			continue
+		}
+		recordExecutionMetric(exec)
+	}
+}
+
+// recordExecutionMetric counts a stored execution
+func recordExecutionMetric(exec Execution) {
+	status := "failed"
+	if exec.Succeeded {
+		status = "success"
+	}
+	metrics.RecordExecution(exec.CronJobNamespace, exec.CronJobName, status)
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBatcherTestStore(t *testing.T) *GormStore {
+	t.Helper()
+	s, err := NewGormStore("sqlite", "file::memory:")
+	require.NoError(t, err)
+	require.NoError(t, s.Init())
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+// flushSignalStore signals each batched write, so tests wait for flushes
+// instead of polling the store against the clock
+type flushSignalStore struct {
+	Store
+	flushed chan int
+}
+
+func (s *flushSignalStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	err := s.Store.RecordExecutions(ctx, execs)
+	s.flushed <- len(execs)
+	return err
+}
+
+// waitForFlush returns the size of the next batch written to s
+func waitForFlush(t *testing.T, s *flushSignalStore) int {
+	t.Helper()
+	select {
+	case n := <-s.flushed:
+		return n
+	case <-time.After(30 * time.Second):
+		t.Fatal("batch was not flushed")
+		return 0
+	}
+}
+
+func batcherTestExecution(i int) Execution {
+	return Execution{
+		CronJobNamespace: "default",
+		CronJobName:      "batched",
+		JobName:          "batched-" + string(rune('A'+i)),
+		StartTime:        time.Now().Add(time.Duration(-i) * time.Second),
+		Succeeded:        true,
+	}
+}
+
+func TestExecutionBatcher_FlushWritesPending(t *testing.T) {
+	ctx := context.Background()
+	s := newBatcherTestStore(t)
+	b := NewExecutionBatcher(s, 10, time.Hour)
+
+	for i := 0; i < 25; i++ {
+		b.Enqueue(ctx, batcherTestExecution(i))
+	}
+	assert.Equal(t, 25, b.Len())
+
+	count, err := s.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	b.Flush(ctx)
+	assert.Equal(t, 0, b.Len())
+
+	count, err = s.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), count)
+}
+
+func TestExecutionBatcher_FlushesOnInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &flushSignalStore{Store: newBatcherTestStore(t), flushed: make(chan int, 1)}
+	b := NewExecutionBatcher(s, 100, 20*time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		_ = b.Start(ctx)
+		close(done)
+	}()
+
+	b.Enqueue(ctx, batcherTestExecution(0))
+
+	assert.Equal(t, 1, waitForFlush(t, s))
+	count, err := s.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	cancel()
+	<-done
+}
+
+func TestExecutionBatcher_FlushesOnFullBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &flushSignalStore{Store: newBatcherTestStore(t), flushed: make(chan int, 1)}
+	b := NewExecutionBatcher(s, 5, time.Hour)
+
+	done := make(chan struct{})
+	go func() {
+		_ = b.Start(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < 5; i++ {
+		b.Enqueue(ctx, batcherTestExecution(i))
+	}
+
+	// The hour-long interval cannot fire, so the write is the full batch's
+	assert.Equal(t, 5, waitForFlush(t, s))
+	count, err := s.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), count)
+
+	cancel()
+	<-done
+}
+
+func TestExecutionBatcher_FlushesOnShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := newBatcherTestStore(t)
+	b := NewExecutionBatcher(s, 100, time.Hour)
+
+	done := make(chan struct{})
+	go func() {
+		_ = b.Start(ctx)
+		close(done)
+	}()
+
+	b.Enqueue(ctx, batcherTestExecution(0))
+	b.Enqueue(ctx, batcherTestExecution(1))
+	cancel()
+	<-done
+
+	count, err := s.GetExecutionCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestExecutionBatcher_Defaults(t *testing.T) {
+	b := NewExecutionBatcher(nil, 0, 0)
+	assert.Equal(t, DefaultBatchSize, b.batchSize)
+	assert.Equal(t, DefaultBatchFlushInterval, b.flushInterval)
+	assert.False(t, b.NeedLeaderElection())
+}
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// cacheSize bounds the number of entries held per cached query
+const cacheSize = 10000
+
+// windowKey identifies a windowed query for a CronJob
+type windowKey struct {
+	cronJob    types.NamespacedName
+	windowDays int
+}
+
+// CachedStore is a read-through cache in front of a Store. GetMetrics,
+// GetSuccessRate and GetLastExecution results are cached per CronJob for a
+// fixed TTL and invalidated when that CronJob's executions change. All other
+// methods pass straight through to the wrapped store.
+type CachedStore struct {
+	Store
+
+	metrics       *expirable.LRU[windowKey, Metrics]
+	successRates  *expirable.LRU[windowKey, float64]
+	lastExecution *expirable.LRU[types.NamespacedName, *Execution]
+
+	// generation is bumped on every invalidation so that a read which raced
+	// with a write does not repopulate the cache with stale data
+	generation atomic.Uint64
+}
+
+// NewCachedStore wraps s with a read-through cache whose entries expire after ttl
+func NewCachedStore(s Store, ttl time.Duration) *CachedStore {
+	return &CachedStore{
+		Store:         s,
+		metrics:       expirable.NewLRU[windowKey, Metrics](cacheSize, nil, ttl),
+		successRates:  expirable.NewLRU[windowKey, float64](cacheSize, nil, ttl),
+		lastExecution: expirable.NewLRU[types.NamespacedName, *Execution](cacheSize, nil, ttl),
+	}
+}
+
+// RecordExecution stores an execution and invalidates the CronJob's cached results
+func (c *CachedStore) RecordExecution(ctx context.Context, exec Execution) error {
+	err := c.Store.RecordExecution(ctx, exec)
+	c.invalidate(types.NamespacedName{Namespace: exec.CronJobNamespace, Name: exec.CronJobName})
+	return err
+}
+
+// RecordExecutions stores executions and invalidates the cached results of every CronJob involved
+func (c *CachedStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	err := c.Store.RecordExecutions(ctx, execs)
+	seen := make(map[types.NamespacedName]bool)
+	for _, exec := range execs {
+		cronJob := types.NamespacedName{Namespace: exec.CronJobNamespace, Name: exec.CronJobName}
+		if !seen[cronJob] {
+			seen[cronJob] = true
+			c.invalidate(cronJob)
+		}
+	}
+	return err
+}
+
+// GetLastExecution returns the most recent execution, from cache when fresh
+func (c *CachedStore) GetLastExecution(ctx context.Context, cronJob types.NamespacedName) (*Execution, error) {
+	if exec, ok := c.lastExecution.Get(cronJob); ok {
+		return copyExecution(exec), nil
+	}
+
+	gen := c.generation.Load()
+	exec, err := c.Store.GetLastExecution(ctx, cronJob)
+	if err != nil {
+		return nil, err
+	}
+	if c.generation.Load() == gen {
+		c.lastExecution.Add(cronJob, copyExecution(exec))
+	}
+	return exec, nil
+}
+
+// GetMetrics calculates SLA metrics for a CronJob, from cache when fresh
+func (c *CachedStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (*Metrics, error) {
+	key := windowKey{cronJob: cronJob, windowDays: windowDays}
+	if m, ok := c.metrics.Get(key); ok {
+		return &m, nil
+	}
+
+	gen := c.generation.Load()
+	m, err := c.Store.GetMetrics(ctx, cronJob, windowDays)
+	if err != nil {
+		return nil, err
+	}
+	if m != nil && c.generation.Load() == gen {
+		c.metrics.Add(key, *m)
+	}
+	return m, nil
+}
+
+// GetSuccessRate calculates success rate, from cache when fresh
+func (c *CachedStore) GetSuccessRate(ctx context.Context, cronJob types.NamespacedName, windowDays int) (float64, error) {
+	key := windowKey{cronJob: cronJob, windowDays: windowDays}
+	if rate, ok := c.successRates.Get(key); ok {
+		return rate, nil
+	}
+
+	gen := c.generation.Load()
+	rate, err := c.Store.GetSuccessRate(ctx, cronJob, windowDays)
+	if err != nil {
+		return 0, err
+	}
+	if c.generation.Load() == gen {
+		c.successRates.Add(key, rate)
+	}
+	return rate, nil
+}
+
+// Prune removes old execution records and clears the cache
+func (c *CachedStore) Prune(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (int64, error) {
+	n, err := c.Store.Prune(ctx, olderThan, overrides...)
+	if n > 0 {
+		c.Purge()
+	}
+	return n, err
+}
+
+// DeleteExecutionsByCronJob deletes all executions for a CronJob and invalidates its cached results
+func (c *CachedStore) DeleteExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
+	n, err := c.Store.DeleteExecutionsByCronJob(ctx, cronJob)
+	c.invalidate(cronJob)
+	return n, err
+}
+
+// DeleteExecutionsByUID deletes executions for a CronJob UID and invalidates its cached results
+func (c *CachedStore) DeleteExecutionsByUID(ctx context.Context, cronJob types.NamespacedName, uid string) (int64, error) {
+	n, err := c.Store.DeleteExecutionsByUID(ctx, cronJob, uid)
+	c.invalidate(cronJob)
+	return n, err
+}
+
+// TrashExecutionsByCronJob trashes all executions for a CronJob and invalidates its cached results
+func (c *CachedStore) TrashExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
+	n, err := c.Store.TrashExecutionsByCronJob(ctx, cronJob)
+	c.invalidate(cronJob)
+	return n, err
+}
+
+// RestoreExecutionsByCronJob restores the trashed executions of a CronJob and invalidates its cached results
+func (c *CachedStore) RestoreExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
+	n, err := c.Store.RestoreExecutionsByCronJob(ctx, cronJob)
+	c.invalidate(cronJob)
+	return n, err
+}
+
+// Purge drops every cached entry
+func (c *CachedStore) Purge() {
+	c.generation.Add(1)
+	c.metrics.Purge()
+	c.successRates.Purge()
+	c.lastExecution.Purge()
+}
+
+// invalidate drops all cached results for a CronJob
+func (c *CachedStore) invalidate(cronJob types.NamespacedName) {
+	c.generation.Add(1)
+	c.lastExecution.Remove(cronJob)
+	for _, key := range c.metrics.Keys() {
+		if key.cronJob == cronJob {
+			c.metrics.Remove(key)
+		}
+	}
+	for _, key := range c.successRates.Keys() {
+		if key.cronJob == cronJob {
+			c.successRates.Remove(key)
+		}
+	}
+}
+
+// copyExecution returns a shallow copy so callers cannot modify cached values
+func copyExecution(exec *Execution) *Execution {
+	if exec == nil {
+		return nil
+	}
+	cp := *exec
+	return &cp
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newCachedTestStore(t *testing.T, ttl time.Duration) (*CachedStore, *GormStore) {
+	t.Helper()
+	s, err := NewGormStore("sqlite", "file::memory:")
+	require.NoError(t, err)
+	require.NoError(t, s.Init())
+	t.Cleanup(func() { _ = s.Close() })
+	return NewCachedStore(s, ttl), s
+}
+
+func TestCachedStore_ServesFromCacheUntilInvalidated(t *testing.T) {
+	ctx := context.Background()
+	cached, backing := newCachedTestStore(t, time.Minute)
+	cronJob := types.NamespacedName{Namespace: "default", Name: "cached-cron"}
+
+	exec := Execution{
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		JobName:          "cached-cron-1",
+		StartTime:        time.Now().Add(-time.Minute),
+		Succeeded:        true,
+	}
+	require.NoError(t, cached.RecordExecution(ctx, exec))
+
+	rate, err := cached.GetSuccessRate(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, rate)
+	metrics, err := cached.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), metrics.TotalRuns)
+
+	// Written behind the cache's back: cached values are still served
+	exec.JobName = "cached-cron-2"
+	exec.Succeeded = false
+	require.NoError(t, backing.RecordExecution(ctx, exec))
+
+	rate, err = cached.GetSuccessRate(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, rate)
+	metrics, err = cached.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), metrics.TotalRuns)
+
+	// Recording through the cache invalidates it
+	exec.JobName = "cached-cron-3"
+	exec.StartTime = time.Now()
+	require.NoError(t, cached.RecordExecution(ctx, exec))
+
+	rate, err = cached.GetSuccessRate(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.InDelta(t, 33.33, rate, 0.01)
+	metrics, err = cached.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), metrics.TotalRuns)
+
+	last, err := cached.GetLastExecution(ctx, cronJob)
+	require.NoError(t, err)
+	require.NotNil(t, last)
+	assert.False(t, last.Succeeded)
+}
+
+func TestCachedStore_InvalidationIsPerCronJob(t *testing.T) {
+	ctx := context.Background()
+	cached, backing := newCachedTestStore(t, time.Minute)
+	first := types.NamespacedName{Namespace: "default", Name: "first"}
+	second := types.NamespacedName{Namespace: "default", Name: "second"}
+
+	last, err := cached.GetLastExecution(ctx, first)
+	require.NoError(t, err)
+	assert.Nil(t, last)
+
+	require.NoError(t, backing.RecordExecution(ctx, Execution{
+		CronJobNamespace: first.Namespace,
+		CronJobName:      first.Name,
+		JobName:          "first-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+	require.NoError(t, cached.RecordExecution(ctx, Execution{
+		CronJobNamespace: second.Namespace,
+		CronJobName:      second.Name,
+		JobName:          "second-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+
+	// The cached "no executions" result for first is untouched by second's write
+	last, err = cached.GetLastExecution(ctx, first)
+	require.NoError(t, err)
+	assert.Nil(t, last)
+
+	cached.Purge()
+	last, err = cached.GetLastExecution(ctx, first)
+	require.NoError(t, err)
+	require.NotNil(t, last)
+	assert.Equal(t, "first-1", last.JobName)
+}
+
+func TestCachedStore_EntriesExpire(t *testing.T) {
+	ctx := context.Background()
+	cached, backing := newCachedTestStore(t, 50*time.Millisecond)
+	cronJob := types.NamespacedName{Namespace: "default", Name: "expiring"}
+
+	rate, err := cached.GetSuccessRate(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, rate)
+
+	require.NoError(t, backing.RecordExecution(ctx, Execution{
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		JobName:          "expiring-1",
+		StartTime:        time.Now(),
+		Succeeded:        false,
+	}))
+
+	assert.Eventually(t, func() bool {
+		rate, err := cached.GetSuccessRate(ctx, cronJob, 7)
+		return err == nil && rate == 0
+	}, time.Second, 20*time.Millisecond)
+}
+
+func TestCachedStore_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	cached, _ := newCachedTestStore(t, time.Minute)
+	cronJob := types.NamespacedName{Namespace: "default", Name: "copied"}
+
+	require.NoError(t, cached.RecordExecution(ctx, Execution{
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		JobName:          "copied-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+
+	metrics, err := cached.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	metrics.TotalRuns = 42
+
+	metrics, err = cached.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), metrics.TotalRuns)
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// defaultCopyBatchSize is the number of rows read and written per batch
+const defaultCopyBatchSize = 1000
+
+// ErrDestinationNotEmpty is returned by CopyStore when the destination
+// already has executions
+var ErrDestinationNotEmpty = errors.New("destination store already has executions")
+
+// CopyOptions configures CopyStore
+type CopyOptions struct {
+	// BatchSize is the number of rows copied per transaction (default 1000)
+	BatchSize int
+
+	// Progress is called after every batch with the table, the rows copied
+	// so far and the table's row count when the copy started
+	Progress func(table string, copied, total int64)
+}
+
+// CopyStore copies every table from src to dst, e.g. to move from SQLite to
+// PostgreSQL. Both stores must be initialized. Rows with generated IDs get
+// new ones in dst. The destination must not have executions yet.
+func CopyStore(ctx context.Context, src, dst *GormStore, opts CopyOptions) (map[string]int64, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultCopyBatchSize
+	}
+
+	count, err := dst.GetExecutionCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w (%d executions)", ErrDestinationNotEmpty, count)
+	}
+
+	copied := make(map[string]int64)
+	steps := []func() error{
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "executions", "id", func(e *Execution) int64 {
+				id := e.ID
+				e.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "alert_history", "id", func(a *AlertHistory) int64 {
+				id := a.ID
+				a.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "channel_stats", "id", func(c *ChannelStatsRecord) int64 {
+				id := c.ID
+				c.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "alert_deliveries", "id", func(d *AlertDelivery) int64 {
+				id := d.ID
+				d.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "alert_states", "alert_key", func(s *AlertState) string {
+				return s.AlertKey
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "alert_claims", "id", func(c *AlertClaim) int64 {
+				id := c.ID
+				c.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "saved_views", "id", func(v *SavedView) int64 {
+				id := v.ID
+				v.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "alert_receipts", "id", func(r *AlertReceipt) int64 {
+				id := r.ID
+				r.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "legal_holds", "id", func(h *LegalHold) int64 {
+				id := h.ID
+				h.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "execution_trash", "id", func(e *TrashedExecution) int64 {
+				id := e.ID
+				e.ID = 0
+				return id
+			})
+		},
+		func() error {
+			return copyTable(ctx, src, dst, opts, copied, "daily_rollups", "id", func(r *DailyRollup) int64 {
+				id := r.ID
+				r.ID = 0
+				return id
+			})
+		},
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return copied, err
+		}
+	}
+	return copied, nil
+}
+
+// copyTable copies a table in batches ordered by its primary key. key returns
+// a row's primary key and clears it if the destination should generate it.
+func copyTable[T any, K int64 | string](ctx context.Context, src, dst *GormStore, opts CopyOptions, copied map[string]int64, table, keyColumn string, key func(*T) K) error {
+	var total int64
+	if err := src.conn().WithContext(ctx).Model(new(T)).Count(&total).Error; err != nil {
+		return fmt.Errorf("count %s: %w", table, err)
+	}
+
+	copied[table] = 0
+	var last K
+	for {
+		var rows []T
+		err := src.conn().WithContext(ctx).
+			Where(keyColumn+" > ?", last).
+			Order(keyColumn + " ASC").
+			Limit(opts.BatchSize).
+			Find(&rows).Error
+		if err != nil {
+			return fmt.Errorf("read %s: %w", table, err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		for i := range rows {
+			last = key(&rows[i])
+		}
+		err = dst.conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return tx.CreateInBatches(&rows, 100).Error
+		})
+		if err != nil {
+			return fmt.Errorf("write %s: %w", table, err)
+		}
+
+		copied[table] += int64(len(rows))
+		if opts.Progress != nil {
+			opts.Progress(table, copied[table], total)
+		}
+	}
+}
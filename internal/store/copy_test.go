@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCopyStore(t *testing.T) {
+	ctx := context.Background()
+	src := newFileStore(t, "src.db")
+	require.NoError(t, src.Init())
+	dst := newFileStore(t, "dst.db")
+	require.NoError(t, dst.Init())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	var execs []Execution
+	for i := 0; i < 25; i++ {
+		execs = append(execs, Execution{
+			CronJobNamespace: "default",
+			CronJobName:      "report",
+			JobName:          fmt.Sprintf("report-%d", i),
+			StartTime:        now.Add(-time.Duration(i) * time.Hour),
+			Succeeded:        i%5 != 0,
+		})
+	}
+	require.NoError(t, src.RecordExecutions(ctx, execs))
+	require.NoError(t, src.StoreAlert(ctx, AlertHistory{Type: "JobFailed", Severity: "warning", Title: "Job failed", OccurredAt: now}))
+	require.NoError(t, src.SaveChannelStats(ctx, ChannelStatsRecord{ChannelName: "slack", AlertsSentTotal: 3}))
+	require.NoError(t, src.EnqueueDelivery(ctx, AlertDelivery{AlertKey: "default/report/JobFailed", AlertType: "JobFailed", Severity: "warning", ChannelName: "slack", Status: DeliveryStatusPending, NextAttemptAt: now}))
+	require.NoError(t, src.SaveAlertState(ctx, AlertState{AlertKey: "default/report/JobFailed", LastSentAt: now}))
+	require.NoError(t, src.SaveAlertState(ctx, AlertState{AlertKey: "default/other/JobFailed", LastSentAt: now}))
+	claimed, err := src.ClaimAlert(ctx, AlertClaim{AlertKey: "default/report/JobFailed", WindowStart: now}, time.Hour)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.NoError(t, src.SaveView(ctx, SavedView{Name: "nightly", Team: "payments"}))
+	require.NoError(t, src.RecordReceipt(ctx, AlertReceipt{AlertID: "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", Receiver: "pagerduty", Status: ReceiptStatusProcessed, ReceivedAt: now}))
+	require.NoError(t, src.SetLegalHold(ctx, LegalHold{CronJobNamespace: "default", CronJobName: "report", Reason: "audit"}))
+	require.NoError(t, src.RecordExecution(ctx, Execution{CronJobNamespace: "default", CronJobName: "deleted", JobName: "deleted-1", StartTime: now}))
+	_, err = src.TrashExecutionsByCronJob(ctx, types.NamespacedName{Namespace: "default", Name: "deleted"})
+	require.NoError(t, err)
+	rollups, err := src.RollUpExecutions(ctx, now.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+
+	progress := make(map[string][]int64)
+	copied, err := CopyStore(ctx, src, dst, CopyOptions{
+		BatchSize: 10,
+		Progress: func(table string, n, total int64) {
+			progress[table] = append(progress[table], n)
+			assert.LessOrEqual(t, n, total)
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int64{
+		"executions":       25,
+		"alert_history":    1,
+		"channel_stats":    1,
+		"alert_deliveries": 1,
+		"alert_states":     2,
+		"alert_claims":     1,
+		"saved_views":      1,
+		"alert_receipts":   1,
+		"legal_holds":      1,
+		"execution_trash":  1,
+		"daily_rollups":    int64(rollups),
+	}, copied)
+	assert.Equal(t, []int64{10, 20, 25}, progress["executions"])
+
+	cronJob := types.NamespacedName{Namespace: "default", Name: "report"}
+	srcMetrics, err := src.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	dstMetrics, err := dst.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Equal(t, srcMetrics.TotalRuns, dstMetrics.TotalRuns)
+	assert.Equal(t, srcMetrics.SuccessfulRuns, dstMetrics.SuccessfulRuns)
+
+	states, err := dst.ListAlertStates(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, states, 2)
+
+	// The copied claim still blocks a duplicate send within the window
+	claimed, err = dst.ClaimAlert(ctx, AlertClaim{AlertKey: "default/report/JobFailed", WindowStart: now}, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// Copying again would duplicate history
+	_, err = CopyStore(ctx, src, dst, CopyOptions{})
+	assert.ErrorIs(t, err, ErrDestinationNotEmpty)
+}
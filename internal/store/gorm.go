@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/glebarez/sqlite" // Pure Go SQLite driver (no CGO required)
@@ -18,8 +19,13 @@ import (
 
 // GormStore implements Store using GORM
 type GormStore struct {
-	db      *gorm.DB
-	dialect string
+	db           *atomic.Pointer[gorm.DB] // shared with the stores returned by ForCluster
+	cluster      string                   // see ForCluster
+	dialect      string
+	pool         ConnectionPoolConfig
+	partitioning bool         // monthly executions partitioning requested (postgres only)
+	partitioned  bool         // executions table is range-partitioned
+	replica      *readReplica // see SetReadReplica
 }
 
 // ConnectionPoolConfig holds connection pool settings
@@ -30,6 +36,26 @@ type ConnectionPoolConfig struct {
 	ConnMaxIdleTime time.Duration
 }
 
+// gormDialects are the backends implemented by GormStore
+var gormDialects = []string{"sqlite", "postgres", "timescale", "mysql"}
+
+func init() {
+	for _, dialect := range gormDialects {
+		Register(dialect, func(opts BackendOptions) (Store, error) {
+			s, err := NewGormStoreWithPool(dialect, opts.DSN, opts.Pool)
+			if err != nil {
+				return nil, err
+			}
+			s.SetPartitioning(opts.Partitioning)
+			if err := s.SetReadReplica(opts.Replica); err != nil {
+				_ = s.Close()
+				return nil, err
+			}
+			return s, nil
+		})
+	}
+}
+
 // NewGormStore creates a new GORM-based store
 func NewGormStore(dialect string, dsn string) (*GormStore, error) {
 	return NewGormStoreWithPool(dialect, dsn, ConnectionPoolConfig{})
@@ -37,11 +63,23 @@ func NewGormStore(dialect string, dsn string) (*GormStore, error) {
 
 // NewGormStoreWithPool creates a new GORM-based store with connection pool settings
 func NewGormStoreWithPool(dialect string, dsn string, pool ConnectionPoolConfig) (*GormStore, error) {
+	db, err := openDB(dialect, dsn, pool, true)
+	if err != nil {
+		return nil, err
+	}
+	s := &GormStore{db: new(atomic.Pointer[gorm.DB]), dialect: dialect, pool: pool}
+	s.db.Store(db)
+	return s, nil
+}
+
+// openDB opens a connection pool for dialect, checking the database is
+// reachable if ping is set
+func openDB(dialect string, dsn string, pool ConnectionPoolConfig, ping bool) (*gorm.DB, error) {
 	var dialector gorm.Dialector
 	switch dialect {
 	case "sqlite":
 		dialector = sqlite.Open(dsn)
-	case "postgres":
+	case "postgres", "timescale":
 		dialector = postgres.Open(dsn)
 	case "mysql":
 		dialector = mysql.Open(dsn)
@@ -50,7 +88,8 @@ func NewGormStoreWithPool(dialect string, dsn string, pool ConnectionPoolConfig)
 	}
 
 	db, err := gorm.Open(dialector, &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Silent),
+		Logger:               logger.Default.LogMode(logger.Silent),
+		DisableAutomaticPing: !ping,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
@@ -77,17 +116,104 @@ func NewGormStoreWithPool(dialect string, dsn string, pool ConnectionPoolConfig)
 		}
 	}
 
-	return &GormStore{db: db, dialect: dialect}, nil
+	return db, nil
+}
+
+// conn returns the current connection pool
+func (s *GormStore) conn() *gorm.DB {
+	return s.db.Load()
+}
+
+// scoped returns a query restricted to the rows of the store's cluster
+func (s *GormStore) scoped(ctx context.Context) *gorm.DB {
+	return s.conn().WithContext(ctx).Where("cluster = ?", s.cluster)
+}
+
+// ForCluster returns a store sharing s's connection pool whose executions and
+// alerts are tagged with and scoped to the given remote cluster. Queries by
+// CronJob only see that cluster's rows, so CronJobs with the same namespace
+// and name in different clusters don't mix. Retention, exports, counts and
+// the alert delivery state stay global. Call it after Init.
+func (s *GormStore) ForCluster(name string) Store {
+	scoped := *s
+	scoped.cluster = name
+	return &scoped
+}
+
+// Reconnect replaces the connection pool with one opened with dsn, e.g.
+// after the database password was rotated. The new pool is checked first,
+// so an unusable dsn leaves the current pool in place. The old pool is
+// closed once its in-flight queries finish.
+func (s *GormStore) Reconnect(ctx context.Context, dsn string) error {
+	db, err := openDB(s.dialect, dsn, s.pool, true)
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return fmt.Errorf("failed to connect with new credentials: %w", err)
+	}
+
+	old := s.db.Swap(db)
+	if oldDB, err := old.DB(); err == nil {
+		go func() { _ = oldDB.Close() }()
+	}
+	return nil
 }
 
-// Init initializes the store (creates tables via auto-migration)
+// Init initializes the store, applying any pending schema migrations, and
+// logs indexes missing from the database
 func (s *GormStore) Init() error {
-	return s.db.AutoMigrate(&Execution{}, &AlertHistory{}, &ChannelStatsRecord{})
+	ctx := context.Background()
+
+	// Checked before TimescaleDB and partitioning create the executions table
+	legacy, err := s.isLegacySchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.dialect == "timescale" {
+		if err := s.initTimescale(ctx); err != nil {
+			return err
+		}
+	}
+	if s.partitioning {
+		if err := s.initPartitioning(ctx); err != nil {
+			return err
+		}
+	}
+	if legacy {
+		if err := s.baselineLegacySchema(ctx); err != nil {
+			return err
+		}
+	}
+	if err := s.Migrate(ctx, 0); err != nil {
+		return err
+	}
+	if s.dialect == "timescale" {
+		if err := s.initTimescaleAggregates(ctx); err != nil {
+			return err
+		}
+	}
+	s.auditIndexes(ctx)
+	if s.replica != nil {
+		checkCtx, cancel := context.WithTimeout(ctx, replicaCheckTimeout)
+		defer cancel()
+		s.CheckReplica(checkCtx)
+	}
+	return nil
 }
 
 // Close closes the store and releases resources
 func (s *GormStore) Close() error {
-	sqlDB, err := s.db.DB()
+	if err := s.closeReplica(); err != nil {
+		return err
+	}
+	sqlDB, err := s.conn().DB()
 	if err != nil {
 		return err
 	}
@@ -96,13 +222,32 @@ func (s *GormStore) Close() error {
 
 // RecordExecution stores a new execution record
 func (s *GormStore) RecordExecution(ctx context.Context, exec Execution) error {
-	return s.db.WithContext(ctx).Create(&exec).Error
+	if exec.Cluster == "" {
+		exec.Cluster = s.cluster
+	}
+	return s.conn().WithContext(ctx).Create(&exec).Error
+}
+
+// RecordExecutions stores multiple execution records in a single transaction
+func (s *GormStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	if len(execs) == 0 {
+		return nil
+	}
+	for i := range execs {
+		if execs[i].Cluster == "" {
+			execs[i].Cluster = s.cluster
+		}
+	}
+	return s.conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&execs, 100).Error
+	})
 }
 
 // GetExecutions returns executions for a CronJob since a given time
 func (s *GormStore) GetExecutions(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]Execution, error) {
+	s = s.forRead()
 	var execs []Execution
-	err := s.db.WithContext(ctx).
+	err := s.scoped(ctx).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
 			cronJob.Namespace, cronJob.Name, since).
 		Order("start_time DESC").
@@ -112,10 +257,11 @@ func (s *GormStore) GetExecutions(ctx context.Context, cronJob types.NamespacedN
 
 // GetExecutionsPaginated returns executions with database-level pagination
 func (s *GormStore) GetExecutionsPaginated(ctx context.Context, cronJob types.NamespacedName, since time.Time, limit, offset int) ([]Execution, int64, error) {
+	s = s.forRead()
 	var execs []Execution
 	var total int64
 
-	query := s.db.WithContext(ctx).Model(&Execution{}).
+	query := s.scoped(ctx).Model(&Execution{}).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
 			cronJob.Namespace, cronJob.Name, since)
 
@@ -135,10 +281,11 @@ func (s *GormStore) GetExecutionsPaginated(ctx context.Context, cronJob types.Na
 
 // GetExecutionsFiltered returns executions with database-level filtering and pagination
 func (s *GormStore) GetExecutionsFiltered(ctx context.Context, cronJob types.NamespacedName, since time.Time, status string, limit, offset int) ([]Execution, int64, error) {
+	s = s.forRead()
 	var execs []Execution
 	var total int64
 
-	query := s.db.WithContext(ctx).Model(&Execution{}).
+	query := s.scoped(ctx).Model(&Execution{}).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
 			cronJob.Namespace, cronJob.Name, since)
 
@@ -167,7 +314,7 @@ func (s *GormStore) GetExecutionsFiltered(ctx context.Context, cronJob types.Nam
 // GetLastExecution returns the most recent execution
 func (s *GormStore) GetLastExecution(ctx context.Context, cronJob types.NamespacedName) (*Execution, error) {
 	var exec Execution
-	err := s.db.WithContext(ctx).
+	err := s.scoped(ctx).
 		Where("cronjob_ns = ? AND cronjob_name = ?", cronJob.Namespace, cronJob.Name).
 		Order("start_time DESC").
 		First(&exec).Error
@@ -183,7 +330,7 @@ func (s *GormStore) GetLastExecution(ctx context.Context, cronJob types.Namespac
 // GetLastSuccessfulExecution returns the most recent successful execution
 func (s *GormStore) GetLastSuccessfulExecution(ctx context.Context, cronJob types.NamespacedName) (*Execution, error) {
 	var exec Execution
-	err := s.db.WithContext(ctx).
+	err := s.scoped(ctx).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ?",
 			cronJob.Namespace, cronJob.Name, true).
 		Order("start_time DESC").
@@ -197,10 +344,115 @@ func (s *GormStore) GetLastSuccessfulExecution(ctx context.Context, cronJob type
 	return &exec, nil
 }
 
+// GetFailureStreak returns the number of consecutive failed executions
+// started before a given time, back to the last success
+func (s *GormStore) GetFailureStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (int, error) {
+	var lastSuccess Execution
+	err := s.scoped(ctx).
+		Select("start_time").
+		Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ? AND start_time < ?",
+			cronJob.Namespace, cronJob.Name, true, before).
+		Order("start_time DESC").
+		First(&lastSuccess).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+
+	query := s.scoped(ctx).Model(&Execution{}).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ? AND start_time < ?",
+			cronJob.Namespace, cronJob.Name, false, before)
+	if err == nil {
+		query = query.Where("start_time > ?", lastSuccess.StartTime)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// GetOutcomeChanges returns the executions of a CronJob since a given time
+// whose outcome differs from the run before, i.e. where failure streaks start
+// and end, newest first. The window function keeps the read small however
+// many runs the window holds.
+func (s *GormStore) GetOutcomeChanges(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]Execution, error) {
+	s = s.forRead()
+	runs := s.scoped(ctx).Model(&Execution{}).
+		Select("id, start_time, completion_time, succeeded, LAG(succeeded) OVER (ORDER BY start_time, id) AS prev_succeeded").
+		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?", cronJob.Namespace, cronJob.Name, since)
+
+	var execs []Execution
+	err := s.conn().WithContext(ctx).
+		Table("(?) AS runs", runs).
+		Select("id, start_time, completion_time, succeeded").
+		Where("prev_succeeded IS NULL OR prev_succeeded <> succeeded").
+		Order("start_time DESC, id DESC").
+		Find(&execs).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range execs {
+		execs[i].CronJobNamespace, execs[i].CronJobName = cronJob.Namespace, cronJob.Name
+	}
+	return execs, nil
+}
+
+// GetSuccessStreak returns the consecutive successful executions started
+// before a given time, back to the last failure
+func (s *GormStore) GetSuccessStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (SuccessStreak, error) {
+	var lastFailure Execution
+	err := s.scoped(ctx).
+		Select("start_time").
+		Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ? AND start_time < ?",
+			cronJob.Namespace, cronJob.Name, false, before).
+		Order("start_time DESC").
+		First(&lastFailure).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return SuccessStreak{}, err
+	}
+
+	hasFailure := err == nil
+	successes := func() *gorm.DB {
+		query := s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ? AND start_time < ?",
+				cronJob.Namespace, cronJob.Name, true, before)
+		if hasFailure {
+			query = query.Where("start_time > ?", lastFailure.StartTime)
+		}
+		return query
+	}
+	var count int64
+	if err := successes().Count(&count).Error; err != nil {
+		return SuccessStreak{}, err
+	}
+	if count == 0 {
+		return SuccessStreak{}, nil
+	}
+
+	var first Execution
+	if err := successes().Select("start_time").Order("start_time ASC").First(&first).Error; err != nil {
+		return SuccessStreak{}, err
+	}
+	return SuccessStreak{Runs: int(count), Since: first.StartTime}, nil
+}
+
+// GetOutputSizes returns the output sizes of the most recent successful
+// executions that reported one, newest first
+func (s *GormStore) GetOutputSizes(ctx context.Context, cronJob types.NamespacedName, limit int) ([]float64, error) {
+	var sizes []float64
+	err := s.scoped(ctx).Model(&Execution{}).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND succeeded = ? AND output_size IS NOT NULL",
+			cronJob.Namespace, cronJob.Name, true).
+		Order("start_time DESC").
+		Limit(limit).
+		Pluck("output_size", &sizes).Error
+	return sizes, err
+}
+
 // GetExecutionByJobName returns an execution by its job name
 func (s *GormStore) GetExecutionByJobName(ctx context.Context, namespace, jobName string) (*Execution, error) {
 	var exec Execution
-	err := s.db.WithContext(ctx).
+	err := s.scoped(ctx).
 		Where("cronjob_ns = ? AND job_name = ?", namespace, jobName).
 		First(&exec).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -214,6 +466,7 @@ func (s *GormStore) GetExecutionByJobName(ctx context.Context, namespace, jobNam
 
 // GetMetrics calculates SLA metrics for a CronJob
 func (s *GormStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (*Metrics, error) {
+	s = s.forRead()
 	since := time.Now().AddDate(0, 0, -windowDays)
 
 	// Count query
@@ -224,14 +477,21 @@ func (s *GormStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName
 	}
 	var result countResult
 
-	err := s.db.WithContext(ctx).Model(&Execution{}).
-		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
-			cronJob.Namespace, cronJob.Name, since).
-		Select("COUNT(*) as total, "+
-			"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as succeeded, "+
-			"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as failed",
-			true, false).
-		Scan(&result).Error
+	var err error
+	if s.dialect == "timescale" {
+		// Counts come from the hourly continuous aggregate
+		result.Total, result.Succeeded, err = s.getRunCountsFromAggregate(ctx, cronJob, since)
+		result.Failed = result.Total - result.Succeeded
+	} else {
+		err = s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
+				cronJob.Namespace, cronJob.Name, since).
+			Select("COUNT(*) as total, "+
+				"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as succeeded, "+
+				"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as failed",
+				true, false).
+			Scan(&result).Error
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -248,36 +508,20 @@ func (s *GormStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName
 	}
 
 	// Get durations for percentile calculation
-	// Use native percentile functions for PostgreSQL, in-memory for SQLite
-	if s.dialect == "postgres" {
-		// Use native PostgreSQL percentile_cont for O(1) memory usage
-		type percentileResult struct {
-			Avg float64
-			P50 float64
-			P95 float64
-			P99 float64
-		}
-		var pr percentileResult
-		err = s.db.WithContext(ctx).Model(&Execution{}).
-			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
-				cronJob.Namespace, cronJob.Name, since).
-			Select(`
-				AVG(duration_secs) as avg,
-				PERCENTILE_CONT(0.50) WITHIN GROUP (ORDER BY duration_secs) as p50,
-				PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY duration_secs) as p95,
-				PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY duration_secs) as p99
-			`).
-			Scan(&pr).Error
-		if err == nil {
-			metrics.AvgDurationSeconds = pr.Avg
-			metrics.P50DurationSeconds = pr.P50
-			metrics.P95DurationSeconds = pr.P95
-			metrics.P99DurationSeconds = pr.P99
+	// Computed in the database for PostgreSQL and MySQL, in-memory for SQLite
+	if s.supportsSQLPercentiles() {
+		stats, err := s.getDurationStatsSQL(ctx, cronJob, since)
+		if err != nil {
+			return nil, err
 		}
+		metrics.AvgDurationSeconds = stats.Avg
+		metrics.P50DurationSeconds = stats.P50
+		metrics.P95DurationSeconds = stats.P95
+		metrics.P99DurationSeconds = stats.P99
 	} else {
 		// SQLite: Use in-memory percentile calculation
 		var durations []float64
-		err = s.db.WithContext(ctx).Model(&Execution{}).
+		err = s.scoped(ctx).Model(&Execution{}).
 			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
 				cronJob.Namespace, cronJob.Name, since).
 			Order("duration_secs").
@@ -301,14 +545,20 @@ func (s *GormStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName
 	return metrics, nil
 }
 
-// GetDurationPercentile calculates a duration percentile using database-level
-// LIMIT/OFFSET for O(1) memory usage instead of fetching all durations
+// GetDurationPercentile calculates a duration percentile. PostgreSQL and MySQL
+// compute it in the database; SQLite uses LIMIT/OFFSET for O(1) memory usage
+// instead of fetching all durations.
 func (s *GormStore) GetDurationPercentile(ctx context.Context, cronJob types.NamespacedName, p int, windowDays int) (time.Duration, error) {
+	s = s.forRead()
 	since := time.Now().AddDate(0, 0, -windowDays)
 
+	if s.supportsSQLPercentiles() {
+		return s.getDurationPercentileSQL(ctx, cronJob, p, since)
+	}
+
 	// First get count
 	var count int64
-	if err := s.db.WithContext(ctx).Model(&Execution{}).
+	if err := s.scoped(ctx).Model(&Execution{}).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
 			cronJob.Namespace, cronJob.Name, since).
 		Count(&count).Error; err != nil {
@@ -324,7 +574,7 @@ func (s *GormStore) GetDurationPercentile(ctx context.Context, cronJob types.Nam
 
 	// Get single value at percentile position using LIMIT/OFFSET
 	var duration float64
-	err := s.db.WithContext(ctx).Model(&Execution{}).
+	err := s.scoped(ctx).Model(&Execution{}).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
 			cronJob.Namespace, cronJob.Name, since).
 		Order("duration_secs").
@@ -340,6 +590,7 @@ func (s *GormStore) GetDurationPercentile(ctx context.Context, cronJob types.Nam
 
 // GetSuccessRate calculates success rate
 func (s *GormStore) GetSuccessRate(ctx context.Context, cronJob types.NamespacedName, windowDays int) (float64, error) {
+	s = s.forRead()
 	since := time.Now().AddDate(0, 0, -windowDays)
 
 	type countResult struct {
@@ -348,12 +599,17 @@ func (s *GormStore) GetSuccessRate(ctx context.Context, cronJob types.Namespaced
 	}
 	var result countResult
 
-	err := s.db.WithContext(ctx).Model(&Execution{}).
-		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
-			cronJob.Namespace, cronJob.Name, since).
-		Select("COUNT(*) as total, "+
-			"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as succeeded", true).
-		Scan(&result).Error
+	var err error
+	if s.dialect == "timescale" {
+		result.Total, result.Succeeded, err = s.getRunCountsFromAggregate(ctx, cronJob, since)
+	} else {
+		err = s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ?",
+				cronJob.Namespace, cronJob.Name, since).
+			Select("COUNT(*) as total, "+
+				"SUM(CASE WHEN succeeded = ? THEN 1 ELSE 0 END) as succeeded", true).
+			Scan(&result).Error
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -365,25 +621,100 @@ func (s *GormStore) GetSuccessRate(ctx context.Context, cronJob types.Namespaced
 	return float64(result.Succeeded) / float64(result.Total) * 100, nil
 }
 
-// Prune removes old execution records
-func (s *GormStore) Prune(ctx context.Context, olderThan time.Time) (int64, error) {
-	result := s.db.WithContext(ctx).
+// Prune removes old execution records. Overridden CronJobs of the store's
+// cluster are pruned at their own cutoff.
+func (s *GormStore) Prune(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (int64, error) {
+	// Partitions and chunks hold every CronJob, so only whole ones past the
+	// longest retention can be dropped
+	dropBefore := olderThan
+	for _, o := range overrides {
+		if o.OlderThan.Before(dropBefore) {
+			dropBefore = o.OlderThan
+		}
+	}
+
+	var dropped int64
+	if s.dialect == "timescale" {
+		var err error
+		if dropped, err = s.dropExpiredChunks(ctx, dropBefore); err != nil {
+			return 0, err
+		}
+	}
+	if s.partitioned {
+		// Keep upcoming partitions in place, then drop whole months before the cutoff
+		if err := s.ensurePartitions(ctx, time.Now()); err != nil {
+			return 0, err
+		}
+		var err error
+		if dropped, err = s.dropExpiredPartitions(ctx, dropBefore); err != nil {
+			return dropped, err
+		}
+	}
+
+	// Remaining rows (the partially expired month or the default partition)
+	result := s.exceptOverrides(s.conn().WithContext(ctx), overrides).
 		Where("start_time < ?", olderThan).
 		Delete(&Execution{})
-	return result.RowsAffected, result.Error
+	if result.Error != nil {
+		return dropped, result.Error
+	}
+	deleted := dropped + result.RowsAffected
+
+	for _, o := range overrides {
+		result := s.scoped(ctx).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time < ?", o.CronJob.Namespace, o.CronJob.Name, o.OlderThan).
+			Delete(&Execution{})
+		if result.Error != nil {
+			return deleted, result.Error
+		}
+		deleted += result.RowsAffected
+	}
+	return deleted, nil
 }
 
-// PruneLogs removes logs from executions older than the given time
-func (s *GormStore) PruneLogs(ctx context.Context, olderThan time.Time) (int64, error) {
-	result := s.db.WithContext(ctx).Model(&Execution{}).
-		Where("start_time < ? AND (logs IS NOT NULL OR events IS NOT NULL)", olderThan).
-		Updates(map[string]interface{}{"logs": nil, "events": nil})
-	return result.RowsAffected, result.Error
+// PruneLogs removes logs from executions older than the given time.
+// Overridden CronJobs of the store's cluster are pruned at their own cutoff.
+func (s *GormStore) PruneLogs(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (int64, error) {
+	noLogs := map[string]interface{}{"logs": nil, "logs_ref": "", "events": nil}
+	result := s.exceptOverrides(s.conn().WithContext(ctx).Model(&Execution{}), overrides).
+		Where("start_time < ? AND (logs IS NOT NULL OR events IS NOT NULL OR logs_ref <> '')", olderThan).
+		Updates(noLogs)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	updated := result.RowsAffected
+
+	for _, o := range overrides {
+		result := s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time < ? AND (logs IS NOT NULL OR events IS NOT NULL OR logs_ref <> '')",
+				o.CronJob.Namespace, o.CronJob.Name, o.OlderThan).
+			Updates(noLogs)
+		if result.Error != nil {
+			return updated, result.Error
+		}
+		updated += result.RowsAffected
+	}
+	return updated, nil
+}
+
+// exceptOverrides leaves out the executions of the store's cluster of the
+// CronJobs with a retention override
+func (s *GormStore) exceptOverrides(db *gorm.DB, overrides []RetentionOverride) *gorm.DB {
+	if len(overrides) == 0 {
+		return db
+	}
+	conds := make([]string, 0, len(overrides))
+	args := []any{s.cluster}
+	for _, o := range overrides {
+		conds = append(conds, "(cronjob_ns = ? AND cronjob_name = ?)")
+		args = append(args, o.CronJob.Namespace, o.CronJob.Name)
+	}
+	return db.Where("NOT (cluster = ? AND ("+strings.Join(conds, " OR ")+"))", args...)
 }
 
 // DeleteExecutionsByCronJob deletes all executions for a specific CronJob
 func (s *GormStore) DeleteExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
-	result := s.db.WithContext(ctx).
+	result := s.scoped(ctx).
 		Where("cronjob_ns = ? AND cronjob_name = ?", cronJob.Namespace, cronJob.Name).
 		Delete(&Execution{})
 	return result.RowsAffected, result.Error
@@ -391,17 +722,85 @@ func (s *GormStore) DeleteExecutionsByCronJob(ctx context.Context, cronJob types
 
 // DeleteExecutionsByUID deletes executions for a specific CronJob UID
 func (s *GormStore) DeleteExecutionsByUID(ctx context.Context, cronJob types.NamespacedName, uid string) (int64, error) {
-	result := s.db.WithContext(ctx).
+	result := s.scoped(ctx).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND cronjob_uid = ?",
 			cronJob.Namespace, cronJob.Name, uid).
 		Delete(&Execution{})
 	return result.RowsAffected, result.Error
 }
 
+// TrashExecutionsByCronJob moves all executions for a specific CronJob to
+// the execution_trash table
+func (s *GormStore) TrashExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
+	return s.moveExecutions(ctx, cronJob, "executions", "execution_trash", time.Now())
+}
+
+// RestoreExecutionsByCronJob moves the trashed executions of a CronJob back
+// to the executions table. They get new IDs.
+func (s *GormStore) RestoreExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error) {
+	return s.moveExecutions(ctx, cronJob, "execution_trash", "executions", time.Time{})
+}
+
+// PurgeTrash permanently deletes executions trashed before olderThan
+func (s *GormStore) PurgeTrash(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := s.conn().WithContext(ctx).
+		Where("deleted_at < ?", olderThan).
+		Delete(&TrashedExecution{})
+	return result.RowsAffected, result.Error
+}
+
+// moveExecutions moves a CronJob's rows between executions and the trash in
+// one transaction, setting deleted_at when moving to the trash. Rows written
+// while it runs have higher IDs and stay where they are.
+func (s *GormStore) moveExecutions(ctx context.Context, cronJob types.NamespacedName, from, to string, deletedAt time.Time) (int64, error) {
+	stmt := &gorm.Statement{DB: s.conn()}
+	if err := stmt.Parse(&Execution{}); err != nil {
+		return 0, err
+	}
+	columns := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		if name != "id" {
+			columns = append(columns, name)
+		}
+	}
+	insertColumns, selectColumns := strings.Join(columns, ", "), strings.Join(columns, ", ")
+	args := []any{s.cluster, cronJob.Namespace, cronJob.Name}
+	if !deletedAt.IsZero() {
+		insertColumns += ", deleted_at"
+		selectColumns += ", ?"
+		args = append([]any{deletedAt}, args...)
+	}
+	const where = "cluster = ? AND cronjob_ns = ? AND cronjob_name = ? AND id <= ?"
+
+	var moved int64
+	err := s.conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var maxID *int64
+		if err := tx.Table(from).
+			Where("cluster = ? AND cronjob_ns = ? AND cronjob_name = ?", s.cluster, cronJob.Namespace, cronJob.Name).
+			Select("MAX(id)").Scan(&maxID).Error; err != nil {
+			return err
+		}
+		if maxID == nil {
+			return nil
+		}
+		err := tx.Exec(
+			fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE %s", to, insertColumns, selectColumns, from, where),
+			append(args, *maxID)...,
+		).Error
+		if err != nil {
+			return err
+		}
+		result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", from, where), s.cluster, cronJob.Namespace, cronJob.Name, *maxID)
+		moved = result.RowsAffected
+		return result.Error
+	})
+	return moved, err
+}
+
 // GetCronJobUIDs returns distinct UIDs for a CronJob
 func (s *GormStore) GetCronJobUIDs(ctx context.Context, cronJob types.NamespacedName) ([]string, error) {
 	var uids []string
-	err := s.db.WithContext(ctx).Model(&Execution{}).
+	err := s.scoped(ctx).Model(&Execution{}).
 		Where("cronjob_ns = ? AND cronjob_name = ? AND cronjob_uid IS NOT NULL AND cronjob_uid != ''",
 			cronJob.Namespace, cronJob.Name).
 		Distinct("cronjob_uid").
@@ -412,31 +811,61 @@ func (s *GormStore) GetCronJobUIDs(ctx context.Context, cronJob types.Namespaced
 
 // GetExecutionCount returns the total number of executions
 func (s *GormStore) GetExecutionCount(ctx context.Context) (int64, error) {
+	s = s.forRead()
 	var count int64
-	err := s.db.WithContext(ctx).Model(&Execution{}).Count(&count).Error
+	err := s.conn().WithContext(ctx).Model(&Execution{}).Count(&count).Error
 	return count, err
 }
 
 // GetExecutionCountSince returns the count of executions since a given time
 func (s *GormStore) GetExecutionCountSince(ctx context.Context, since time.Time) (int64, error) {
+	s = s.forRead()
 	var count int64
-	err := s.db.WithContext(ctx).Model(&Execution{}).
+	err := s.conn().WithContext(ctx).Model(&Execution{}).
 		Where("start_time >= ?", since).
 		Count(&count).Error
 	return count, err
 }
 
+// GetFailedExecutionsSince returns failed executions across all CronJobs since a given time
+func (s *GormStore) GetFailedExecutionsSince(ctx context.Context, since time.Time, limit int) ([]Execution, error) {
+	s = s.forRead()
+	var execs []Execution
+	err := s.scoped(ctx).
+		Omit("logs", "events", "suggested_fix").
+		Where("succeeded = ? AND start_time >= ?", false, since).
+		Order("start_time ASC").
+		Limit(limit).
+		Find(&execs).Error
+	return execs, err
+}
+
+// ExportExecutions returns executions after an ID in ID order
+func (s *GormStore) ExportExecutions(ctx context.Context, afterID int64, limit int) ([]Execution, error) {
+	var execs []Execution
+	err := s.conn().WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&execs).Error
+	return execs, err
+}
+
 // StoreAlert stores an alert in history
 func (s *GormStore) StoreAlert(ctx context.Context, alert AlertHistory) error {
-	return s.db.WithContext(ctx).Create(&alert).Error
+	if alert.Cluster == "" {
+		alert.Cluster = s.cluster
+	}
+	return s.conn().WithContext(ctx).Create(&alert).Error
 }
 
 // ListAlertHistory returns alert history with pagination
 func (s *GormStore) ListAlertHistory(ctx context.Context, query AlertHistoryQuery) ([]AlertHistory, int64, error) {
+	s = s.forRead()
 	var alerts []AlertHistory
 	var total int64
 
-	db := s.db.WithContext(ctx).Model(&AlertHistory{})
+	db := s.scoped(ctx).Model(&AlertHistory{})
 
 	if query.Since != nil {
 		db = db.Where("occurred_at >= ?", *query.Since)
@@ -447,6 +876,9 @@ func (s *GormStore) ListAlertHistory(ctx context.Context, query AlertHistoryQuer
 	if query.Type != "" {
 		db = db.Where("alert_type = ?", query.Type)
 	}
+	if query.AlertID != "" {
+		db = db.Where("alert_id = ?", query.AlertID)
+	}
 
 	// Get count first (before pagination)
 	if err := db.Count(&total).Error; err != nil {
@@ -465,10 +897,21 @@ func (s *GormStore) ListAlertHistory(ctx context.Context, query AlertHistoryQuer
 	return alerts, total, err
 }
 
+// ExportAlertHistory returns alerts after an ID in ID order
+func (s *GormStore) ExportAlertHistory(ctx context.Context, afterID int64, limit int) ([]AlertHistory, error) {
+	var alerts []AlertHistory
+	err := s.conn().WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&alerts).Error
+	return alerts, err
+}
+
 // ResolveAlert marks an alert as resolved
 func (s *GormStore) ResolveAlert(ctx context.Context, alertType, cronJobNs, cronJobName string) error {
 	now := time.Now()
-	return s.db.WithContext(ctx).Model(&AlertHistory{}).
+	return s.scoped(ctx).Model(&AlertHistory{}).
 		Where("alert_type = ? AND cronjob_ns = ? AND cronjob_name = ? AND resolved_at IS NULL",
 			alertType, cronJobNs, cronJobName).
 		Update("resolved_at", &now).Error
@@ -477,6 +920,7 @@ func (s *GormStore) ResolveAlert(ctx context.Context, alertType, cronJobNs, cron
 // GetChannelAlertStats returns alert statistics for all channels.
 // Uses batched queries to limit memory usage when processing large datasets.
 func (s *GormStore) GetChannelAlertStats(ctx context.Context) (map[string]ChannelAlertStats, error) {
+	s = s.forRead()
 	// Use batched processing to avoid loading all rows into memory at once.
 	// The channels_notified field is comma-separated, requiring app-level processing.
 	const batchSize = 1000
@@ -485,7 +929,7 @@ func (s *GormStore) GetChannelAlertStats(ctx context.Context) (map[string]Channe
 
 	for {
 		var rows []string
-		err := s.db.WithContext(ctx).Model(&AlertHistory{}).
+		err := s.conn().WithContext(ctx).Model(&AlertHistory{}).
 			Select("channels_notified").
 			Where("channels_notified IS NOT NULL AND channels_notified != ''").
 			Order("id"). // Consistent ordering for pagination
@@ -521,7 +965,7 @@ func (s *GormStore) GetChannelAlertStats(ctx context.Context) (map[string]Channe
 
 // Health checks if the store is healthy
 func (s *GormStore) Health(ctx context.Context) error {
-	sqlDB, err := s.db.DB()
+	sqlDB, err := s.conn().DB()
 	if err != nil {
 		return err
 	}
@@ -530,7 +974,7 @@ func (s *GormStore) Health(ctx context.Context) error {
 
 // SaveChannelStats persists channel statistics using upsert
 func (s *GormStore) SaveChannelStats(ctx context.Context, stats ChannelStatsRecord) error {
-	return s.db.WithContext(ctx).
+	return s.conn().WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "channel_name"}},
 			UpdateAll: true,
@@ -540,7 +984,7 @@ func (s *GormStore) SaveChannelStats(ctx context.Context, stats ChannelStatsReco
 // GetChannelStats retrieves channel statistics by name
 func (s *GormStore) GetChannelStats(ctx context.Context, channelName string) (*ChannelStatsRecord, error) {
 	var stats ChannelStatsRecord
-	err := s.db.WithContext(ctx).
+	err := s.conn().WithContext(ctx).
 		Where("channel_name = ?", channelName).
 		First(&stats).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -555,7 +999,7 @@ func (s *GormStore) GetChannelStats(ctx context.Context, channelName string) (*C
 // GetAllChannelStats retrieves all channel statistics
 func (s *GormStore) GetAllChannelStats(ctx context.Context) (map[string]*ChannelStatsRecord, error) {
 	var records []ChannelStatsRecord
-	if err := s.db.WithContext(ctx).Find(&records).Error; err != nil {
+	if err := s.conn().WithContext(ctx).Find(&records).Error; err != nil {
 		return nil, err
 	}
 
@@ -566,6 +1010,271 @@ func (s *GormStore) GetAllChannelStats(ctx context.Context) (map[string]*Channel
 	return result, nil
 }
 
+// EnqueueDelivery persists a failed alert delivery for later retry
+func (s *GormStore) EnqueueDelivery(ctx context.Context, delivery AlertDelivery) error {
+	if delivery.Status == "" {
+		delivery.Status = DeliveryStatusPending
+	}
+	return s.conn().WithContext(ctx).Create(&delivery).Error
+}
+
+// GetDueDeliveries returns pending deliveries whose next attempt is at or before now
+func (s *GormStore) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]AlertDelivery, error) {
+	var deliveries []AlertDelivery
+	db := s.conn().WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", DeliveryStatusPending, now).
+		Order("next_attempt_at ASC")
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	err := db.Find(&deliveries).Error
+	return deliveries, err
+}
+
+// UpdateDelivery saves the retry state of a queued delivery
+func (s *GormStore) UpdateDelivery(ctx context.Context, delivery AlertDelivery) error {
+	return s.conn().WithContext(ctx).Model(&AlertDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"last_error":      delivery.LastError,
+			"delivered_at":    delivery.DeliveredAt,
+		}).Error
+}
+
+// ClaimDeliveryHistory clears the history flag on all queued deliveries of an alert
+func (s *GormStore) ClaimDeliveryHistory(ctx context.Context, alertID string) (bool, error) {
+	// A single conditional update, so concurrent claims cannot both succeed
+	result := s.conn().WithContext(ctx).Model(&AlertDelivery{}).
+		Where("alert_id = ? AND record_history = ?", alertID, true).
+		Update("record_history", false)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListDeliveries returns queued deliveries with pagination
+func (s *GormStore) ListDeliveries(ctx context.Context, query AlertDeliveryQuery) ([]AlertDelivery, int64, error) {
+	s = s.forRead()
+	var deliveries []AlertDelivery
+	var total int64
+
+	db := s.conn().WithContext(ctx).Model(&AlertDelivery{})
+
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.ChannelName != "" {
+		db = db.Where("channel_name = ?", query.ChannelName)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	if query.Offset > 0 {
+		db = db.Offset(query.Offset)
+	}
+
+	err := db.Order("created_at DESC").Order("id DESC").Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// PruneDeliveries deletes delivered and failed deliveries older than the given time.
+// Pending deliveries are kept regardless of age so the retry worker can finish them.
+func (s *GormStore) PruneDeliveries(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := s.conn().WithContext(ctx).
+		Where("status <> ? AND created_at < ?", DeliveryStatusPending, olderThan).
+		Delete(&AlertDelivery{})
+	return result.RowsAffected, result.Error
+}
+
+// SaveAlertState persists the suppression state of a sent alert (upsert)
+func (s *GormStore) SaveAlertState(ctx context.Context, state AlertState) error {
+	return s.conn().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "alert_key"}},
+			UpdateAll: true,
+		}).Create(&state).Error
+}
+
+// DeleteAlertStates removes the suppression state of cleared alerts
+func (s *GormStore) DeleteAlertStates(ctx context.Context, alertKeys []string) error {
+	if len(alertKeys) == 0 {
+		return nil
+	}
+	return s.conn().WithContext(ctx).
+		Where("alert_key IN ?", alertKeys).
+		Delete(&AlertState{}).Error
+}
+
+// ListAlertStates returns the states of alerts last sent at or after since
+func (s *GormStore) ListAlertStates(ctx context.Context, since time.Time) ([]AlertState, error) {
+	var states []AlertState
+	err := s.conn().WithContext(ctx).
+		Where("last_sent_at >= ?", since).
+		Order("last_sent_at ASC").
+		Find(&states).Error
+	return states, err
+}
+
+// PruneAlertStates deletes states of alerts last sent before the given time
+func (s *GormStore) PruneAlertStates(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := s.conn().WithContext(ctx).
+		Where("last_sent_at < ?", olderThan).
+		Delete(&AlertState{})
+	return result.RowsAffected, result.Error
+}
+
+// ClaimAlert atomically records that claim.AlertKey is being sent in the window
+// starting at claim.WindowStart. It returns false if it was already claimed.
+// MySQL ignores the conflict columns and matches any unique key, which there
+// is a digest of alert_key and signature (see the mysql baseline migration).
+func (s *GormStore) ClaimAlert(ctx context.Context, claim AlertClaim, window time.Duration) (bool, error) {
+	db := s.conn().WithContext(ctx)
+
+	// Each alert and signature has one claim. Take it over if its window has
+	// passed; the condition is checked by the update itself, so only one of
+	// several concurrent claims succeeds.
+	result := db.Model(&AlertClaim{}).
+		Where("alert_key = ? AND signature = ? AND window_start <= ?", claim.AlertKey, claim.Signature, claim.WindowStart.Add(-window)).
+		Updates(map[string]interface{}{
+			"window_start": claim.WindowStart,
+			"claimed_by":   claim.ClaimedBy,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// Not taken over: either it is still within its window, or the alert
+	// has no claim yet
+	result = db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "alert_key"}, {Name: "signature"}},
+		DoNothing: true,
+	}).Create(&claim)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteAlertClaims deletes all claims of the given alert keys
+func (s *GormStore) DeleteAlertClaims(ctx context.Context, alertKeys []string) error {
+	if len(alertKeys) == 0 {
+		return nil
+	}
+	return s.conn().WithContext(ctx).
+		Where("alert_key IN ?", alertKeys).
+		Delete(&AlertClaim{}).Error
+}
+
+// PruneAlertClaims deletes claims last taken before the given time
+func (s *GormStore) PruneAlertClaims(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := s.conn().WithContext(ctx).
+		Where("window_start < ?", olderThan).
+		Delete(&AlertClaim{})
+	return result.RowsAffected, result.Error
+}
+
+// RecordReceipt stores a downstream system's confirmation of an alert
+func (s *GormStore) RecordReceipt(ctx context.Context, receipt AlertReceipt) error {
+	receipt.ID = 0
+	return s.conn().WithContext(ctx).Create(&receipt).Error
+}
+
+// ListReceipts returns the receipts of the given alert tracking IDs, oldest first
+func (s *GormStore) ListReceipts(ctx context.Context, alertIDs []string) ([]AlertReceipt, error) {
+	if len(alertIDs) == 0 {
+		return nil, nil
+	}
+	var receipts []AlertReceipt
+	err := s.conn().WithContext(ctx).
+		Where("alert_id IN ?", alertIDs).
+		Order("received_at ASC").
+		Find(&receipts).Error
+	return receipts, err
+}
+
+// SaveView creates a saved view, or replaces the one with the same name
+func (s *GormStore) SaveView(ctx context.Context, view SavedView) error {
+	view.ID = 0
+	return s.conn().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"description", "namespaces", "label_selector", "team", "status", "search", "sort_by", "sort_desc", "updated_at",
+			}),
+		}).Create(&view).Error
+}
+
+// GetView returns a saved view by name, or nil if there is none
+func (s *GormStore) GetView(ctx context.Context, name string) (*SavedView, error) {
+	var view SavedView
+	err := s.conn().WithContext(ctx).
+		Where("name = ?", name).
+		First(&view).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+// ListViews returns all saved views ordered by name
+func (s *GormStore) ListViews(ctx context.Context) ([]SavedView, error) {
+	var views []SavedView
+	err := s.conn().WithContext(ctx).
+		Order("name ASC").
+		Find(&views).Error
+	return views, err
+}
+
+// DeleteView deletes a saved view, returning false if there was none
+func (s *GormStore) DeleteView(ctx context.Context, name string) (bool, error) {
+	result := s.conn().WithContext(ctx).
+		Where("name = ?", name).
+		Delete(&SavedView{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// SetLegalHold exempts a CronJob from pruning, or updates the reason of its
+// existing hold
+func (s *GormStore) SetLegalHold(ctx context.Context, hold LegalHold) error {
+	hold.ID = 0
+	hold.Cluster = s.cluster
+	return s.conn().WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "cluster"}, {Name: "cronjob_ns"}, {Name: "cronjob_name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+		}).Create(&hold).Error
+}
+
+// ReleaseLegalHold removes a CronJob's legal hold, returning false if there
+// was none
+func (s *GormStore) ReleaseLegalHold(ctx context.Context, cronJob types.NamespacedName) (bool, error) {
+	result := s.scoped(ctx).
+		Where("cronjob_ns = ? AND cronjob_name = ?", cronJob.Namespace, cronJob.Name).
+		Delete(&LegalHold{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListLegalHolds returns all legal holds ordered by namespace and name
+func (s *GormStore) ListLegalHolds(ctx context.Context) ([]LegalHold, error) {
+	var holds []LegalHold
+	err := s.scoped(ctx).
+		Order("cronjob_ns ASC, cronjob_name ASC").
+		Find(&holds).Error
+	return holds, err
+}
+
 // percentile calculates the p-th percentile from pre-sorted data.
 // IMPORTANT: The input data must already be sorted in ascending order.
 // The database query should use ORDER BY to ensure this.
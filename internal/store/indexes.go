@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// indexedModels are the models whose indexes the index audit checks
+var indexedModels = []any{&Execution{}, &AlertHistory{}, &ChannelStatsRecord{}, &AlertDelivery{}, &AlertState{}, &AlertClaim{}, &SavedView{}, &AlertReceipt{}, &LegalHold{}, &TrashedExecution{}, &DailyRollup{}}
+
+// MissingIndexes returns the indexes declared on the models, as
+// "table.index", that the database doesn't have. The migrations create all
+// of them, so a missing index was dropped by hand or failed to build, and
+// the queries relying on it scan the table.
+func (s *GormStore) MissingIndexes(ctx context.Context) ([]string, error) {
+	db := s.conn().WithContext(ctx)
+	var missing []string
+	for _, model := range indexedModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("parse model %T: %w", model, err)
+		}
+		for _, idx := range stmt.Schema.ParseIndexes() {
+			if !db.Migrator().HasIndex(model, idx.Name) {
+				missing = append(missing, stmt.Schema.Table+"."+idx.Name)
+			}
+		}
+	}
+	return missing, nil
+}
+
+// auditIndexes logs the indexes missing from the database. It never fails
+// Init, since the store works without them, only slower.
+func (s *GormStore) auditIndexes(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	missing, err := s.MissingIndexes(ctx)
+	if err != nil {
+		logger.Error(err, "unable to check store indexes")
+		return
+	}
+	if len(missing) > 0 {
+		logger.Info("store indexes missing, queries using them will scan their tables; recreate them or run the migrations again",
+			"indexes", missing)
+	}
+}
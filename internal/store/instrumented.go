@@ -0,0 +1,593 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// InstrumentedStore records the duration of every store call in
+// cronjob_guardian_store_query_seconds and logs calls slower than a
+// threshold, to find the queries that hurt on large datasets
+type InstrumentedStore struct {
+	Store
+
+	slowThreshold time.Duration
+}
+
+// NewInstrumentedStore wraps s with query metrics, logging calls that take
+// at least slowThreshold (0 = no slow query log)
+func NewInstrumentedStore(s Store, slowThreshold time.Duration) *InstrumentedStore {
+	return &InstrumentedStore{Store: s, slowThreshold: slowThreshold}
+}
+
+// measure calls fn, recording how long it took
+func (s *InstrumentedStore) measure(ctx context.Context, method string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	elapsed := time.Since(start)
+	metrics.ObserveStoreQuery(method, elapsed.Seconds())
+
+	if s.slowThreshold > 0 && elapsed >= s.slowThreshold {
+		logger := log.FromContext(ctx).WithValues("method", method, "duration", elapsed.String(), "threshold", s.slowThreshold.String())
+		if err != nil {
+			logger = logger.WithValues("error", err.Error())
+		}
+		logger.Info("slow store query")
+	}
+	return err
+}
+
+// RecordExecution implements Store
+func (s *InstrumentedStore) RecordExecution(ctx context.Context, exec Execution) error {
+	return s.measure(ctx, "RecordExecution", func() error {
+		return s.Store.RecordExecution(ctx, exec)
+	})
+}
+
+// RecordExecutions implements Store
+func (s *InstrumentedStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	return s.measure(ctx, "RecordExecutions", func() error {
+		return s.Store.RecordExecutions(ctx, execs)
+	})
+}
+
+// GetExecutions implements Store
+func (s *InstrumentedStore) GetExecutions(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []Execution, err error) {
+	err = s.measure(ctx, "GetExecutions", func() (err error) {
+		result, err = s.Store.GetExecutions(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionsPaginated implements Store
+func (s *InstrumentedStore) GetExecutionsPaginated(ctx context.Context, cronJob types.NamespacedName, since time.Time, limit, offset int) (result []Execution, total int64, err error) {
+	err = s.measure(ctx, "GetExecutionsPaginated", func() (err error) {
+		result, total, err = s.Store.GetExecutionsPaginated(ctx, cronJob, since, limit, offset)
+		return err
+	})
+	return result, total, err
+}
+
+// GetExecutionsFiltered implements Store
+func (s *InstrumentedStore) GetExecutionsFiltered(ctx context.Context, cronJob types.NamespacedName, since time.Time, status string, limit, offset int) (result []Execution, total int64, err error) {
+	err = s.measure(ctx, "GetExecutionsFiltered", func() (err error) {
+		result, total, err = s.Store.GetExecutionsFiltered(ctx, cronJob, since, status, limit, offset)
+		return err
+	})
+	return result, total, err
+}
+
+// GetLastExecution implements Store
+func (s *InstrumentedStore) GetLastExecution(ctx context.Context, cronJob types.NamespacedName) (result *Execution, err error) {
+	err = s.measure(ctx, "GetLastExecution", func() (err error) {
+		result, err = s.Store.GetLastExecution(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetLastSuccessfulExecution implements Store
+func (s *InstrumentedStore) GetLastSuccessfulExecution(ctx context.Context, cronJob types.NamespacedName) (result *Execution, err error) {
+	err = s.measure(ctx, "GetLastSuccessfulExecution", func() (err error) {
+		result, err = s.Store.GetLastSuccessfulExecution(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetFailureStreak implements Store
+func (s *InstrumentedStore) GetFailureStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (result int, err error) {
+	err = s.measure(ctx, "GetFailureStreak", func() (err error) {
+		result, err = s.Store.GetFailureStreak(ctx, cronJob, before)
+		return err
+	})
+	return result, err
+}
+
+// GetOutcomeChanges implements Store
+func (s *InstrumentedStore) GetOutcomeChanges(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []Execution, err error) {
+	err = s.measure(ctx, "GetOutcomeChanges", func() (err error) {
+		result, err = s.Store.GetOutcomeChanges(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// GetSuccessStreak implements Store
+func (s *InstrumentedStore) GetSuccessStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (result SuccessStreak, err error) {
+	err = s.measure(ctx, "GetSuccessStreak", func() (err error) {
+		result, err = s.Store.GetSuccessStreak(ctx, cronJob, before)
+		return err
+	})
+	return result, err
+}
+
+// GetOutputSizes implements Store
+func (s *InstrumentedStore) GetOutputSizes(ctx context.Context, cronJob types.NamespacedName, limit int) (result []float64, err error) {
+	err = s.measure(ctx, "GetOutputSizes", func() (err error) {
+		result, err = s.Store.GetOutputSizes(ctx, cronJob, limit)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionByJobName implements Store
+func (s *InstrumentedStore) GetExecutionByJobName(ctx context.Context, namespace, jobName string) (result *Execution, err error) {
+	err = s.measure(ctx, "GetExecutionByJobName", func() (err error) {
+		result, err = s.Store.GetExecutionByJobName(ctx, namespace, jobName)
+		return err
+	})
+	return result, err
+}
+
+// GetMetrics implements Store
+func (s *InstrumentedStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result *Metrics, err error) {
+	err = s.measure(ctx, "GetMetrics", func() (err error) {
+		result, err = s.Store.GetMetrics(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetDurationPercentile implements Store
+func (s *InstrumentedStore) GetDurationPercentile(ctx context.Context, cronJob types.NamespacedName, percentile int, windowDays int) (result time.Duration, err error) {
+	err = s.measure(ctx, "GetDurationPercentile", func() (err error) {
+		result, err = s.Store.GetDurationPercentile(ctx, cronJob, percentile, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetSuccessRate implements Store
+func (s *InstrumentedStore) GetSuccessRate(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result float64, err error) {
+	err = s.measure(ctx, "GetSuccessRate", func() (err error) {
+		result, err = s.Store.GetSuccessRate(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionAnalytics implements Store
+func (s *InstrumentedStore) GetExecutionAnalytics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result *ExecutionAnalytics, err error) {
+	err = s.measure(ctx, "GetExecutionAnalytics", func() (err error) {
+		result, err = s.Store.GetExecutionAnalytics(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// RollUpExecutions implements Store
+func (s *InstrumentedStore) RollUpExecutions(ctx context.Context, from, to time.Time) (result int, err error) {
+	err = s.measure(ctx, "RollUpExecutions", func() (err error) {
+		result, err = s.Store.RollUpExecutions(ctx, from, to)
+		return err
+	})
+	return result, err
+}
+
+// GetDailyRollups implements Store
+func (s *InstrumentedStore) GetDailyRollups(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []DailyRollup, err error) {
+	err = s.measure(ctx, "GetDailyRollups", func() (err error) {
+		result, err = s.Store.GetDailyRollups(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// LatestRollupDay implements Store
+func (s *InstrumentedStore) LatestRollupDay(ctx context.Context) (result time.Time, err error) {
+	err = s.measure(ctx, "LatestRollupDay", func() (err error) {
+		result, err = s.Store.LatestRollupDay(ctx)
+		return err
+	})
+	return result, err
+}
+
+// PruneRollups implements Store
+func (s *InstrumentedStore) PruneRollups(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = s.measure(ctx, "PruneRollups", func() (err error) {
+		result, err = s.Store.PruneRollups(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// GetCronJobUsage implements Store
+func (s *InstrumentedStore) GetCronJobUsage(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result *CronJobUsage, err error) {
+	err = s.measure(ctx, "GetCronJobUsage", func() (err error) {
+		result, err = s.Store.GetCronJobUsage(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// ListCronJobUsage implements Store
+func (s *InstrumentedStore) ListCronJobUsage(ctx context.Context, since time.Time) (result []CronJobUsage, err error) {
+	err = s.measure(ctx, "ListCronJobUsage", func() (err error) {
+		result, err = s.Store.ListCronJobUsage(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// Prune implements Store
+func (s *InstrumentedStore) Prune(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (result int64, err error) {
+	err = s.measure(ctx, "Prune", func() (err error) {
+		result, err = s.Store.Prune(ctx, olderThan, overrides...)
+		return err
+	})
+	return result, err
+}
+
+// PruneLogs implements Store
+func (s *InstrumentedStore) PruneLogs(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (result int64, err error) {
+	err = s.measure(ctx, "PruneLogs", func() (err error) {
+		result, err = s.Store.PruneLogs(ctx, olderThan, overrides...)
+		return err
+	})
+	return result, err
+}
+
+// DeleteExecutionsByCronJob implements Store
+func (s *InstrumentedStore) DeleteExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = s.measure(ctx, "DeleteExecutionsByCronJob", func() (err error) {
+		result, err = s.Store.DeleteExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// DeleteExecutionsByUID implements Store
+func (s *InstrumentedStore) DeleteExecutionsByUID(ctx context.Context, cronJob types.NamespacedName, uid string) (result int64, err error) {
+	err = s.measure(ctx, "DeleteExecutionsByUID", func() (err error) {
+		result, err = s.Store.DeleteExecutionsByUID(ctx, cronJob, uid)
+		return err
+	})
+	return result, err
+}
+
+// TrashExecutionsByCronJob implements Store
+func (s *InstrumentedStore) TrashExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = s.measure(ctx, "TrashExecutionsByCronJob", func() (err error) {
+		result, err = s.Store.TrashExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// RestoreExecutionsByCronJob implements Store
+func (s *InstrumentedStore) RestoreExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = s.measure(ctx, "RestoreExecutionsByCronJob", func() (err error) {
+		result, err = s.Store.RestoreExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// PurgeTrash implements Store
+func (s *InstrumentedStore) PurgeTrash(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = s.measure(ctx, "PurgeTrash", func() (err error) {
+		result, err = s.Store.PurgeTrash(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// GetCronJobUIDs implements Store
+func (s *InstrumentedStore) GetCronJobUIDs(ctx context.Context, cronJob types.NamespacedName) (result []string, err error) {
+	err = s.measure(ctx, "GetCronJobUIDs", func() (err error) {
+		result, err = s.Store.GetCronJobUIDs(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionCount implements Store
+func (s *InstrumentedStore) GetExecutionCount(ctx context.Context) (result int64, err error) {
+	err = s.measure(ctx, "GetExecutionCount", func() (err error) {
+		result, err = s.Store.GetExecutionCount(ctx)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionCountSince implements Store
+func (s *InstrumentedStore) GetExecutionCountSince(ctx context.Context, since time.Time) (result int64, err error) {
+	err = s.measure(ctx, "GetExecutionCountSince", func() (err error) {
+		result, err = s.Store.GetExecutionCountSince(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// GetFailedExecutionsSince implements Store
+func (s *InstrumentedStore) GetFailedExecutionsSince(ctx context.Context, since time.Time, limit int) (result []Execution, err error) {
+	err = s.measure(ctx, "GetFailedExecutionsSince", func() (err error) {
+		result, err = s.Store.GetFailedExecutionsSince(ctx, since, limit)
+		return err
+	})
+	return result, err
+}
+
+// ExportExecutions implements Store
+func (s *InstrumentedStore) ExportExecutions(ctx context.Context, afterID int64, limit int) (result []Execution, err error) {
+	err = s.measure(ctx, "ExportExecutions", func() (err error) {
+		result, err = s.Store.ExportExecutions(ctx, afterID, limit)
+		return err
+	})
+	return result, err
+}
+
+// StoreAlert implements Store
+func (s *InstrumentedStore) StoreAlert(ctx context.Context, alert AlertHistory) error {
+	return s.measure(ctx, "StoreAlert", func() error {
+		return s.Store.StoreAlert(ctx, alert)
+	})
+}
+
+// ListAlertHistory implements Store
+func (s *InstrumentedStore) ListAlertHistory(ctx context.Context, query AlertHistoryQuery) (result []AlertHistory, total int64, err error) {
+	err = s.measure(ctx, "ListAlertHistory", func() (err error) {
+		result, total, err = s.Store.ListAlertHistory(ctx, query)
+		return err
+	})
+	return result, total, err
+}
+
+// ExportAlertHistory implements Store
+func (s *InstrumentedStore) ExportAlertHistory(ctx context.Context, afterID int64, limit int) (result []AlertHistory, err error) {
+	err = s.measure(ctx, "ExportAlertHistory", func() (err error) {
+		result, err = s.Store.ExportAlertHistory(ctx, afterID, limit)
+		return err
+	})
+	return result, err
+}
+
+// ResolveAlert implements Store
+func (s *InstrumentedStore) ResolveAlert(ctx context.Context, alertType, cronJobNs, cronJobName string) error {
+	return s.measure(ctx, "ResolveAlert", func() error {
+		return s.Store.ResolveAlert(ctx, alertType, cronJobNs, cronJobName)
+	})
+}
+
+// GetChannelAlertStats implements Store
+func (s *InstrumentedStore) GetChannelAlertStats(ctx context.Context) (result map[string]ChannelAlertStats, err error) {
+	err = s.measure(ctx, "GetChannelAlertStats", func() (err error) {
+		result, err = s.Store.GetChannelAlertStats(ctx)
+		return err
+	})
+	return result, err
+}
+
+// SaveChannelStats implements Store
+func (s *InstrumentedStore) SaveChannelStats(ctx context.Context, stats ChannelStatsRecord) error {
+	return s.measure(ctx, "SaveChannelStats", func() error {
+		return s.Store.SaveChannelStats(ctx, stats)
+	})
+}
+
+// GetChannelStats implements Store
+func (s *InstrumentedStore) GetChannelStats(ctx context.Context, channelName string) (result *ChannelStatsRecord, err error) {
+	err = s.measure(ctx, "GetChannelStats", func() (err error) {
+		result, err = s.Store.GetChannelStats(ctx, channelName)
+		return err
+	})
+	return result, err
+}
+
+// GetAllChannelStats implements Store
+func (s *InstrumentedStore) GetAllChannelStats(ctx context.Context) (result map[string]*ChannelStatsRecord, err error) {
+	err = s.measure(ctx, "GetAllChannelStats", func() (err error) {
+		result, err = s.Store.GetAllChannelStats(ctx)
+		return err
+	})
+	return result, err
+}
+
+// EnqueueDelivery implements Store
+func (s *InstrumentedStore) EnqueueDelivery(ctx context.Context, delivery AlertDelivery) error {
+	return s.measure(ctx, "EnqueueDelivery", func() error {
+		return s.Store.EnqueueDelivery(ctx, delivery)
+	})
+}
+
+// GetDueDeliveries implements Store
+func (s *InstrumentedStore) GetDueDeliveries(ctx context.Context, now time.Time, limit int) (result []AlertDelivery, err error) {
+	err = s.measure(ctx, "GetDueDeliveries", func() (err error) {
+		result, err = s.Store.GetDueDeliveries(ctx, now, limit)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDelivery implements Store
+func (s *InstrumentedStore) UpdateDelivery(ctx context.Context, delivery AlertDelivery) error {
+	return s.measure(ctx, "UpdateDelivery", func() error {
+		return s.Store.UpdateDelivery(ctx, delivery)
+	})
+}
+
+// ClaimDeliveryHistory implements Store
+func (s *InstrumentedStore) ClaimDeliveryHistory(ctx context.Context, alertID string) (result bool, err error) {
+	err = s.measure(ctx, "ClaimDeliveryHistory", func() (err error) {
+		result, err = s.Store.ClaimDeliveryHistory(ctx, alertID)
+		return err
+	})
+	return result, err
+}
+
+// ListDeliveries implements Store
+func (s *InstrumentedStore) ListDeliveries(ctx context.Context, query AlertDeliveryQuery) (result []AlertDelivery, total int64, err error) {
+	err = s.measure(ctx, "ListDeliveries", func() (err error) {
+		result, total, err = s.Store.ListDeliveries(ctx, query)
+		return err
+	})
+	return result, total, err
+}
+
+// PruneDeliveries implements Store
+func (s *InstrumentedStore) PruneDeliveries(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = s.measure(ctx, "PruneDeliveries", func() (err error) {
+		result, err = s.Store.PruneDeliveries(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// SaveAlertState implements Store
+func (s *InstrumentedStore) SaveAlertState(ctx context.Context, state AlertState) error {
+	return s.measure(ctx, "SaveAlertState", func() error {
+		return s.Store.SaveAlertState(ctx, state)
+	})
+}
+
+// DeleteAlertStates implements Store
+func (s *InstrumentedStore) DeleteAlertStates(ctx context.Context, alertKeys []string) error {
+	return s.measure(ctx, "DeleteAlertStates", func() error {
+		return s.Store.DeleteAlertStates(ctx, alertKeys)
+	})
+}
+
+// ListAlertStates implements Store
+func (s *InstrumentedStore) ListAlertStates(ctx context.Context, since time.Time) (result []AlertState, err error) {
+	err = s.measure(ctx, "ListAlertStates", func() (err error) {
+		result, err = s.Store.ListAlertStates(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// PruneAlertStates implements Store
+func (s *InstrumentedStore) PruneAlertStates(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = s.measure(ctx, "PruneAlertStates", func() (err error) {
+		result, err = s.Store.PruneAlertStates(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// ClaimAlert implements Store
+func (s *InstrumentedStore) ClaimAlert(ctx context.Context, claim AlertClaim, window time.Duration) (result bool, err error) {
+	err = s.measure(ctx, "ClaimAlert", func() (err error) {
+		result, err = s.Store.ClaimAlert(ctx, claim, window)
+		return err
+	})
+	return result, err
+}
+
+// DeleteAlertClaims implements Store
+func (s *InstrumentedStore) DeleteAlertClaims(ctx context.Context, alertKeys []string) error {
+	return s.measure(ctx, "DeleteAlertClaims", func() error {
+		return s.Store.DeleteAlertClaims(ctx, alertKeys)
+	})
+}
+
+// PruneAlertClaims implements Store
+func (s *InstrumentedStore) PruneAlertClaims(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = s.measure(ctx, "PruneAlertClaims", func() (err error) {
+		result, err = s.Store.PruneAlertClaims(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// RecordReceipt implements Store
+func (s *InstrumentedStore) RecordReceipt(ctx context.Context, receipt AlertReceipt) error {
+	return s.measure(ctx, "RecordReceipt", func() error {
+		return s.Store.RecordReceipt(ctx, receipt)
+	})
+}
+
+// ListReceipts implements Store
+func (s *InstrumentedStore) ListReceipts(ctx context.Context, alertIDs []string) (result []AlertReceipt, err error) {
+	err = s.measure(ctx, "ListReceipts", func() (err error) {
+		result, err = s.Store.ListReceipts(ctx, alertIDs)
+		return err
+	})
+	return result, err
+}
+
+// SaveView implements Store
+func (s *InstrumentedStore) SaveView(ctx context.Context, view SavedView) error {
+	return s.measure(ctx, "SaveView", func() error {
+		return s.Store.SaveView(ctx, view)
+	})
+}
+
+// GetView implements Store
+func (s *InstrumentedStore) GetView(ctx context.Context, name string) (result *SavedView, err error) {
+	err = s.measure(ctx, "GetView", func() (err error) {
+		result, err = s.Store.GetView(ctx, name)
+		return err
+	})
+	return result, err
+}
+
+// ListViews implements Store
+func (s *InstrumentedStore) ListViews(ctx context.Context) (result []SavedView, err error) {
+	err = s.measure(ctx, "ListViews", func() (err error) {
+		result, err = s.Store.ListViews(ctx)
+		return err
+	})
+	return result, err
+}
+
+// DeleteView implements Store
+func (s *InstrumentedStore) DeleteView(ctx context.Context, name string) (result bool, err error) {
+	err = s.measure(ctx, "DeleteView", func() (err error) {
+		result, err = s.Store.DeleteView(ctx, name)
+		return err
+	})
+	return result, err
+}
+
+// SetLegalHold implements Store
+func (s *InstrumentedStore) SetLegalHold(ctx context.Context, hold LegalHold) error {
+	return s.measure(ctx, "SetLegalHold", func() error {
+		return s.Store.SetLegalHold(ctx, hold)
+	})
+}
+
+// ReleaseLegalHold implements Store
+func (s *InstrumentedStore) ReleaseLegalHold(ctx context.Context, cronJob types.NamespacedName) (result bool, err error) {
+	err = s.measure(ctx, "ReleaseLegalHold", func() (err error) {
+		result, err = s.Store.ReleaseLegalHold(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// ListLegalHolds implements Store
+func (s *InstrumentedStore) ListLegalHolds(ctx context.Context) (result []LegalHold, err error) {
+	err = s.measure(ctx, "ListLegalHolds", func() (err error) {
+		result, err = s.Store.ListLegalHolds(ctx)
+		return err
+	})
+	return result, err
+}
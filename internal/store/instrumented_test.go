@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// slowStore takes delay to answer GetMetrics
+type slowStore struct {
+	*GormStore
+	delay time.Duration
+	err   error
+}
+
+func (s *slowStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (*Metrics, error) {
+	time.Sleep(s.delay)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.GormStore.GetMetrics(ctx, cronJob, windowDays)
+}
+
+// capturedLogs returns a context logging into the returned slice
+func capturedLogs() (context.Context, *[]string) {
+	var lines []string
+	logger := funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{})
+	return log.IntoContext(context.Background(), logger), &lines
+}
+
+func TestInstrumentedStore_SlowQueryLog(t *testing.T) {
+	base := newFlakyStore(t, nil, 0).GormStore
+	cronJob := types.NamespacedName{Namespace: "default", Name: "report"}
+
+	ctx, lines := capturedLogs()
+	s := NewInstrumentedStore(&slowStore{GormStore: base, delay: 20 * time.Millisecond}, 10*time.Millisecond)
+	_, err := s.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	require.Len(t, *lines, 1)
+	assert.Contains(t, (*lines)[0], `"msg"="slow store query"`)
+	assert.Contains(t, (*lines)[0], `"method"="GetMetrics"`)
+
+	// Fast calls are not logged
+	_, err = s.GetLastExecution(ctx, cronJob)
+	require.NoError(t, err)
+	assert.Len(t, *lines, 1)
+
+	// Failed slow calls log their error
+	s = NewInstrumentedStore(&slowStore{GormStore: base, delay: 20 * time.Millisecond, err: errors.New("statement timeout")}, 10*time.Millisecond)
+	_, err = s.GetMetrics(ctx, cronJob, 7)
+	require.Error(t, err)
+	require.Len(t, *lines, 2)
+	assert.Contains(t, (*lines)[1], `"error"="statement timeout"`)
+
+	// A zero threshold disables the log
+	s = NewInstrumentedStore(&slowStore{GormStore: base, delay: 20 * time.Millisecond}, 0)
+	_, err = s.GetMetrics(ctx, cronJob, 7)
+	require.NoError(t, err)
+	assert.Len(t, *lines, 2)
+}
+
+func TestInstrumentedStore_RecordsDurations(t *testing.T) {
+	s := NewInstrumentedStore(newFlakyStore(t, nil, 0), 0)
+
+	_, err := s.GetExecutionCount(context.Background())
+	require.NoError(t, err)
+	assert.Positive(t, testutil.CollectAndCount(metrics.StoreQuerySeconds, "cronjob_guardian_store_query_seconds"))
+}
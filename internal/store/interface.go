@@ -19,6 +19,9 @@ type Store interface {
 	// RecordExecution stores a new execution record
 	RecordExecution(ctx context.Context, exec Execution) error
 
+	// RecordExecutions stores multiple execution records in a single transaction
+	RecordExecutions(ctx context.Context, execs []Execution) error
+
 	// GetExecutions returns executions for a CronJob since a given time
 	GetExecutions(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]Execution, error)
 
@@ -35,6 +38,23 @@ type Store interface {
 	// GetLastSuccessfulExecution returns the most recent successful execution
 	GetLastSuccessfulExecution(ctx context.Context, cronJob types.NamespacedName) (*Execution, error)
 
+	// GetFailureStreak returns the number of consecutive failed executions
+	// started before a given time, back to the last success
+	GetFailureStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (int, error)
+
+	// GetOutcomeChanges returns the executions of a CronJob since a given
+	// time that failed after a success or succeeded after a failure, plus
+	// the first one, newest first. Only the timing and outcome are loaded.
+	GetOutcomeChanges(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]Execution, error)
+
+	// GetSuccessStreak returns the consecutive successful executions started
+	// before a given time, back to the last failure
+	GetSuccessStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (SuccessStreak, error)
+
+	// GetOutputSizes returns the output sizes of the most recent successful
+	// executions that reported one, newest first
+	GetOutputSizes(ctx context.Context, cronJob types.NamespacedName, limit int) ([]float64, error)
+
 	// GetExecutionByJobName returns an execution by its job name
 	GetExecutionByJobName(ctx context.Context, namespace, jobName string) (*Execution, error)
 
@@ -47,12 +67,39 @@ type Store interface {
 	// GetSuccessRate calculates success rate
 	GetSuccessRate(ctx context.Context, cronJob types.NamespacedName, windowDays int) (float64, error)
 
-	// Prune removes old execution records
-	Prune(ctx context.Context, olderThan time.Time) (int64, error)
+	// GetExecutionAnalytics aggregates exit codes, failure reasons, daily duration
+	// percentiles and a day-of-week/hour success heatmap for a CronJob
+	GetExecutionAnalytics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (*ExecutionAnalytics, error)
+
+	// RollUpExecutions writes the daily roll-ups of the UTC days from from to
+	// to, inclusive, for every cluster and CronJob, replacing existing ones
+	RollUpExecutions(ctx context.Context, from, to time.Time) (int, error)
+
+	// GetDailyRollups returns a CronJob's daily roll-ups since a given time, oldest first
+	GetDailyRollups(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]DailyRollup, error)
+
+	// LatestRollupDay returns the most recent day with roll-ups, zero if there are none
+	LatestRollupDay(ctx context.Context) (time.Time, error)
+
+	// PruneRollups removes roll-ups of days before the given time
+	PruneRollups(ctx context.Context, olderThan time.Time) (int64, error)
+
+	// GetCronJobUsage returns a CronJob's runtime and requested CPU and memory
+	// multiplied by duration for executions since a given time
+	GetCronJobUsage(ctx context.Context, cronJob types.NamespacedName, since time.Time) (*CronJobUsage, error)
+
+	// ListCronJobUsage returns the usage of every CronJob with executions since
+	// a given time, highest CPU usage first
+	ListCronJobUsage(ctx context.Context, since time.Time) ([]CronJobUsage, error)
+
+	// Prune removes execution records older than the given time. CronJobs
+	// with an override are pruned at the override's cutoff instead.
+	Prune(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (int64, error)
 
 	// PruneLogs removes logs from executions older than the given time
-	// This allows separate retention for logs vs execution metadata
-	PruneLogs(ctx context.Context, olderThan time.Time) (int64, error)
+	// This allows separate retention for logs vs execution metadata.
+	// CronJobs with an override are pruned at the override's cutoff instead.
+	PruneLogs(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (int64, error)
 
 	// DeleteExecutionsByCronJob deletes all executions for a specific CronJob
 	DeleteExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error)
@@ -61,6 +108,17 @@ type Store interface {
 	// Used for cleaning up after CronJob recreation when onRecreation=reset
 	DeleteExecutionsByUID(ctx context.Context, cronJob types.NamespacedName, uid string) (int64, error)
 
+	// TrashExecutionsByCronJob moves all executions of a CronJob to the trash,
+	// from which RestoreExecutionsByCronJob brings them back until PurgeTrash
+	// removes them
+	TrashExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error)
+
+	// RestoreExecutionsByCronJob moves the trashed executions of a CronJob back
+	RestoreExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (int64, error)
+
+	// PurgeTrash permanently deletes executions trashed before the given time
+	PurgeTrash(ctx context.Context, olderThan time.Time) (int64, error)
+
 	// GetCronJobUIDs returns distinct UIDs for a CronJob (for recreation detection)
 	GetCronJobUIDs(ctx context.Context, cronJob types.NamespacedName) ([]string, error)
 
@@ -70,12 +128,24 @@ type Store interface {
 	// GetExecutionCountSince returns the count of executions since a given time
 	GetExecutionCountSince(ctx context.Context, since time.Time) (int64, error)
 
+	// GetFailedExecutionsSince returns failed executions across all CronJobs
+	// since a given time, oldest first, without logs or events
+	GetFailedExecutionsSince(ctx context.Context, since time.Time, limit int) ([]Execution, error)
+
+	// ExportExecutions returns up to limit executions with an ID greater than
+	// afterID, in ID order, for backups
+	ExportExecutions(ctx context.Context, afterID int64, limit int) ([]Execution, error)
+
 	// StoreAlert stores an alert in history
 	StoreAlert(ctx context.Context, alert AlertHistory) error
 
 	// ListAlertHistory returns alert history with pagination
 	ListAlertHistory(ctx context.Context, query AlertHistoryQuery) ([]AlertHistory, int64, error)
 
+	// ExportAlertHistory returns up to limit alerts with an ID greater than
+	// afterID, in ID order, for backups
+	ExportAlertHistory(ctx context.Context, afterID int64, limit int) ([]AlertHistory, error)
+
 	// ResolveAlert marks an alert as resolved
 	ResolveAlert(ctx context.Context, alertType, cronJobNs, cronJobName string) error
 
@@ -91,6 +161,80 @@ type Store interface {
 	// GetAllChannelStats retrieves all channel statistics
 	GetAllChannelStats(ctx context.Context) (map[string]*ChannelStatsRecord, error)
 
+	// EnqueueDelivery persists a failed alert delivery for later retry
+	EnqueueDelivery(ctx context.Context, delivery AlertDelivery) error
+
+	// GetDueDeliveries returns pending deliveries whose next attempt is at or before now
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]AlertDelivery, error)
+
+	// UpdateDelivery saves the retry state of a queued delivery
+	UpdateDelivery(ctx context.Context, delivery AlertDelivery) error
+
+	// ClaimDeliveryHistory clears the history flag on all queued deliveries of
+	// an alert. It returns false if no delivery had it set, so only the first
+	// successful retry writes the alert to history.
+	ClaimDeliveryHistory(ctx context.Context, alertID string) (bool, error)
+
+	// ListDeliveries returns queued deliveries with pagination
+	ListDeliveries(ctx context.Context, query AlertDeliveryQuery) ([]AlertDelivery, int64, error)
+
+	// PruneDeliveries deletes delivered and failed deliveries older than the given time
+	PruneDeliveries(ctx context.Context, olderThan time.Time) (int64, error)
+
+	// RecordReceipt stores a downstream system's confirmation of an alert
+	RecordReceipt(ctx context.Context, receipt AlertReceipt) error
+
+	// ListReceipts returns the receipts of the given alert tracking IDs,
+	// oldest first
+	ListReceipts(ctx context.Context, alertIDs []string) ([]AlertReceipt, error)
+
+	// SaveAlertState persists the suppression state of a sent alert (upsert)
+	SaveAlertState(ctx context.Context, state AlertState) error
+
+	// DeleteAlertStates removes the suppression state of cleared alerts
+	DeleteAlertStates(ctx context.Context, alertKeys []string) error
+
+	// ListAlertStates returns the states of alerts last sent at or after since
+	ListAlertStates(ctx context.Context, since time.Time) ([]AlertState, error)
+
+	// PruneAlertStates deletes states of alerts last sent before the given time
+	PruneAlertStates(ctx context.Context, olderThan time.Time) (int64, error)
+
+	// ClaimAlert atomically records that claim.AlertKey is being sent at
+	// claim.WindowStart. It returns false if the alert and signature were
+	// already claimed less than window before that.
+	ClaimAlert(ctx context.Context, claim AlertClaim, window time.Duration) (bool, error)
+
+	// DeleteAlertClaims deletes all claims of the given alert keys, so resolved
+	// alerts can fire again within the same window
+	DeleteAlertClaims(ctx context.Context, alertKeys []string) error
+
+	// PruneAlertClaims deletes claims last taken before the given time
+	PruneAlertClaims(ctx context.Context, olderThan time.Time) (int64, error)
+
+	// SaveView creates a saved view, or replaces the one with the same name
+	SaveView(ctx context.Context, view SavedView) error
+
+	// GetView returns a saved view by name, or nil if there is none
+	GetView(ctx context.Context, name string) (*SavedView, error)
+
+	// ListViews returns all saved views ordered by name
+	ListViews(ctx context.Context) ([]SavedView, error)
+
+	// DeleteView deletes a saved view, returning false if there was none
+	DeleteView(ctx context.Context, name string) (bool, error)
+
+	// SetLegalHold exempts a CronJob from pruning, or updates the reason of
+	// its existing hold
+	SetLegalHold(ctx context.Context, hold LegalHold) error
+
+	// ReleaseLegalHold removes a CronJob's legal hold, returning false if
+	// there was none
+	ReleaseLegalHold(ctx context.Context, cronJob types.NamespacedName) (bool, error)
+
+	// ListLegalHolds returns all legal holds ordered by namespace and name
+	ListLegalHolds(ctx context.Context) ([]LegalHold, error)
+
 	// Health checks if the store is healthy
 	Health(ctx context.Context) error
 }
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ObjectStore holds blobs outside the database
+type ObjectStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// LogResolver is implemented by stores that can keep execution logs outside
+// the database. ResolveLogs loads offloaded logs into exec.Logs.
+type LogResolver interface {
+	ResolveLogs(ctx context.Context, exec *Execution) error
+}
+
+// LogOffloadStore uploads execution logs larger than a threshold to object
+// storage and keeps only the object key (LogsRef) in the database. All other
+// methods pass straight through to the wrapped store.
+type LogOffloadStore struct {
+	Store
+
+	objects        ObjectStore
+	prefix         string
+	thresholdBytes int
+}
+
+// NewLogOffloadStore wraps s so that logs over thresholdBytes are written to objects under prefix
+func NewLogOffloadStore(s Store, objects ObjectStore, prefix string, thresholdBytes int) *LogOffloadStore {
+	return &LogOffloadStore{
+		Store:          s,
+		objects:        objects,
+		prefix:         prefix,
+		thresholdBytes: thresholdBytes,
+	}
+}
+
+// RecordExecution offloads large logs, then stores the execution
+func (l *LogOffloadStore) RecordExecution(ctx context.Context, exec Execution) error {
+	l.offload(ctx, &exec)
+	return l.Store.RecordExecution(ctx, exec)
+}
+
+// RecordExecutions offloads large logs, then stores the executions
+func (l *LogOffloadStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	for i := range execs {
+		l.offload(ctx, &execs[i])
+	}
+	return l.Store.RecordExecutions(ctx, execs)
+}
+
+// ResolveLogs fetches offloaded logs into exec.Logs
+func (l *LogOffloadStore) ResolveLogs(ctx context.Context, exec *Execution) error {
+	if exec.LogsRef == "" {
+		return nil
+	}
+	data, err := l.objects.Get(ctx, exec.LogsRef)
+	if err != nil {
+		return fmt.Errorf("fetch offloaded logs: %w", err)
+	}
+	logs := string(data)
+	exec.Logs = &logs
+	return nil
+}
+
+// offload uploads exec's logs if they exceed the threshold. On failure the
+// logs stay inline so they are not lost.
+func (l *LogOffloadStore) offload(ctx context.Context, exec *Execution) {
+	if exec.Logs == nil || len(*exec.Logs) <= l.thresholdBytes {
+		return
+	}
+
+	key := l.objectKey(exec)
+	if err := l.objects.Put(ctx, key, []byte(*exec.Logs), "text/plain; charset=utf-8"); err != nil {
+		log.FromContext(ctx).Error(err, "failed to offload logs to object storage, storing inline",
+			"cronJob", exec.CronJobNamespace+"/"+exec.CronJobName, "job", exec.JobName)
+		return
+	}
+	exec.LogsRef = key
+	exec.Logs = nil
+}
+
+// objectKey returns the object key for an execution's logs:
+// <prefix>/<namespace>/<cronjob>/<job>-<start unix>.log
+func (l *LogOffloadStore) objectKey(exec *Execution) string {
+	return path.Join(
+		l.prefix,
+		exec.CronJobNamespace,
+		exec.CronJobName,
+		fmt.Sprintf("%s-%d.log", exec.JobName, exec.StartTime.Unix()),
+	)
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type fakeObjectStore struct {
+	objects map[string][]byte
+	putErr  error
+}
+
+func (f *fakeObjectStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	if f.putErr != nil {
+		return f.putErr
+	}
+	f.objects[key] = data
+	return nil
+}
+
+func (f *fakeObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := f.objects[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return data, nil
+}
+
+func TestLogOffloadStore_OffloadsLargeLogs(t *testing.T) {
+	ctx := context.Background()
+	backing := newBatcherTestStore(t)
+	objects := &fakeObjectStore{objects: map[string][]byte{}}
+	s := NewLogOffloadStore(backing, objects, "executions", 16)
+
+	small := "short log"
+	large := strings.Repeat("x", 64)
+	start := time.Unix(1700000000, 0)
+	require.NoError(t, s.RecordExecutions(ctx, []Execution{
+		{CronJobNamespace: "default", CronJobName: "backup", JobName: "backup-1", StartTime: start, Logs: &small},
+		{CronJobNamespace: "default", CronJobName: "backup", JobName: "backup-2", StartTime: start, Logs: &large},
+	}))
+
+	key := "executions/default/backup/backup-2-1700000000.log"
+	assert.Equal(t, large, string(objects.objects[key]))
+	assert.Len(t, objects.objects, 1)
+
+	stored, err := backing.GetExecutionByJobName(ctx, "default", "backup-2")
+	require.NoError(t, err)
+	assert.Nil(t, stored.Logs)
+	assert.Equal(t, key, stored.LogsRef)
+
+	require.NoError(t, s.ResolveLogs(ctx, stored))
+	require.NotNil(t, stored.Logs)
+	assert.Equal(t, large, *stored.Logs)
+
+	inline, err := backing.GetExecutionByJobName(ctx, "default", "backup-1")
+	require.NoError(t, err)
+	require.NotNil(t, inline.Logs)
+	assert.Equal(t, small, *inline.Logs)
+	assert.Empty(t, inline.LogsRef)
+}
+
+func TestLogOffloadStore_KeepsLogsInlineOnUploadFailure(t *testing.T) {
+	ctx := context.Background()
+	backing := newBatcherTestStore(t)
+	objects := &fakeObjectStore{objects: map[string][]byte{}, putErr: errors.New("bucket unavailable")}
+	s := NewLogOffloadStore(backing, objects, "", 4)
+
+	logs := "more than four bytes"
+	require.NoError(t, s.RecordExecution(ctx, Execution{
+		CronJobNamespace: "default", CronJobName: "report", JobName: "report-1", StartTime: time.Now(), Logs: &logs,
+	}))
+
+	last, err := backing.GetLastExecution(ctx, types.NamespacedName{Namespace: "default", Name: "report"})
+	require.NoError(t, err)
+	require.NotNil(t, last.Logs)
+	assert.Equal(t, logs, *last.Logs)
+	assert.Empty(t, last.LogsRef)
+}
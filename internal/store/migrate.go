@@ -0,0 +1,304 @@
+package store
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Schema migrations. Each dialect has its own directory of numbered SQL files
+// (NNNN_name.up.sql / NNNN_name.down.sql) so that the schema is identical on
+// every install of a given version, instead of whatever AutoMigrate derives
+// from the models on that database. Applied versions are recorded in
+// schema_migrations.
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+// migrationLockID is the advisory lock key held while migrating, so replicas
+// starting at the same time don't apply the same migration twice
+const migrationLockID = 7243615201
+
+// ErrSchemaTooNew is returned when the database has migrations applied that
+// this binary does not know about, e.g. after rolling back the operator
+var ErrSchemaTooNew = errors.New("database schema is newer than this version supports")
+
+// SchemaMigration records an applied migration
+type SchemaMigration struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:255;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migration is one versioned schema change
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// migrationDir returns the migrations directory for a dialect
+func migrationDir(dialect string) string {
+	if dialect == "timescale" {
+		return "postgres"
+	}
+	return dialect
+}
+
+// loadMigrations reads the embedded migrations for a dialect, ordered by version
+func loadMigrations(dialect string) ([]migration, error) {
+	dir := path.Join("migrations", migrationDir(dialect))
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for dialect %s: %w", dialect, err)
+	}
+
+	byVersion := make(map[int]*migration)
+	for _, entry := range entries {
+		name := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		prefix, rest, ok := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration file name %q", name)
+		}
+		content, err := fs.ReadFile(migrationFiles, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: strings.TrimSuffix(rest, "."+direction+".sql")}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", m.version, m.name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	for i, m := range migrations {
+		if m.version != i+1 {
+			return nil, fmt.Errorf("migration versions must be consecutive, found %04d after %d", m.version, i)
+		}
+	}
+	return migrations, nil
+}
+
+// splitStatements splits a migration file into statements. Statements end
+// with a semicolon at the end of a line; lines starting with -- are comments.
+func splitStatements(sql string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// LatestSchemaVersion returns the newest migration version for the store's dialect
+func (s *GormStore) LatestSchemaVersion() (int, error) {
+	migrations, err := loadMigrations(s.dialect)
+	if err != nil {
+		return 0, err
+	}
+	return len(migrations), nil
+}
+
+// SchemaVersion returns the highest applied migration version, or 0 if none
+func (s *GormStore) SchemaVersion(ctx context.Context) (int, error) {
+	return schemaVersion(s.conn().WithContext(ctx))
+}
+
+func schemaVersion(db *gorm.DB) (int, error) {
+	if !db.Migrator().HasTable(&SchemaMigration{}) {
+		return 0, nil
+	}
+	var version int
+	if err := db.Model(&SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// Migrate applies or reverts migrations until the schema is at the target
+// version. A target of 0 means the latest version; use a negative target to
+// revert everything.
+func (s *GormStore) Migrate(ctx context.Context, target int) error {
+	migrations, err := loadMigrations(s.dialect)
+	if err != nil {
+		return err
+	}
+	latest := len(migrations)
+	if target == 0 {
+		target = latest
+	}
+	if target < 0 {
+		target = 0
+	}
+	if target > latest {
+		return fmt.Errorf("unknown schema version %d (latest is %d)", target, latest)
+	}
+
+	return s.withMigrationLock(ctx, func(db *gorm.DB) error {
+		if err := db.Migrator().AutoMigrate(&SchemaMigration{}); err != nil {
+			return fmt.Errorf("create schema_migrations table: %w", err)
+		}
+		current, err := schemaVersion(db)
+		if err != nil {
+			return err
+		}
+		if current > latest {
+			return fmt.Errorf("%w: database is at version %d, latest known is %d", ErrSchemaTooNew, current, latest)
+		}
+
+		for v := current + 1; v <= target; v++ {
+			m := migrations[v-1]
+			if err := applyMigration(db, m.up, func(tx *gorm.DB) error {
+				return tx.Create(&SchemaMigration{Version: m.version, Name: m.name, AppliedAt: time.Now()}).Error
+			}); err != nil {
+				return fmt.Errorf("migration %04d_%s up: %w", m.version, m.name, err)
+			}
+		}
+		for v := current; v > target; v-- {
+			m := migrations[v-1]
+			if err := applyMigration(db, m.down, func(tx *gorm.DB) error {
+				return tx.Delete(&SchemaMigration{}, m.version).Error
+			}); err != nil {
+				return fmt.Errorf("migration %04d_%s down: %w", m.version, m.name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// applyMigration runs a migration's statements and records the result in one
+// transaction. MySQL commits DDL implicitly, so a failed migration there can
+// leave earlier statements applied; migrations use IF [NOT] EXISTS where the
+// dialect allows so they can be retried.
+func applyMigration(db *gorm.DB, sql string, record func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(sql) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// withMigrationLock runs fn on a single connection while holding a
+// database-wide lock. SQLite only allows one writer, so it needs no lock.
+func (s *GormStore) withMigrationLock(ctx context.Context, fn func(db *gorm.DB) error) error {
+	db := s.conn().WithContext(ctx)
+	if s.dialect == "sqlite" {
+		return fn(db)
+	}
+
+	return db.Connection(func(conn *gorm.DB) error {
+		var lock, unlock string
+		if s.isPostgres() {
+			lock = fmt.Sprintf("SELECT pg_advisory_lock(%d)", migrationLockID)
+			unlock = fmt.Sprintf("SELECT pg_advisory_unlock(%d)", migrationLockID)
+		} else {
+			lock = fmt.Sprintf("SELECT GET_LOCK('cronjob_guardian_migrate_%d', -1)", migrationLockID)
+			unlock = fmt.Sprintf("SELECT RELEASE_LOCK('cronjob_guardian_migrate_%d')", migrationLockID)
+		}
+		if err := conn.Exec(lock).Error; err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+		defer conn.Exec(unlock)
+		return fn(conn)
+	})
+}
+
+// baselineLegacySchema upgrades a database created by AutoMigrate before
+// versioned migrations existed to the baseline schema and records version 1.
+// Tables it already has gain the baseline columns and indexes that older
+// releases lacked, missing ones are created by the baseline migration, and
+// Migrate then applies every later migration as on a fresh database.
+func (s *GormStore) baselineLegacySchema(ctx context.Context) error {
+	db := s.conn().WithContext(ctx)
+	for _, model := range baselineModels {
+		if !db.Migrator().HasTable(model) {
+			continue
+		}
+		if err := db.AutoMigrate(model); err != nil {
+			return fmt.Errorf("upgrade legacy schema: %w", err)
+		}
+	}
+	migrations, err := loadMigrations(s.dialect)
+	if err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return fmt.Errorf("create schema_migrations table: %w", err)
+	}
+	baseline := migrations[0]
+	if err := applyMigration(db, baseline.up, func(tx *gorm.DB) error {
+		return tx.Create(&SchemaMigration{Version: baseline.version, Name: baseline.name, AppliedAt: time.Now()}).Error
+	}); err != nil {
+		return fmt.Errorf("migration %04d_%s up: %w", baseline.version, baseline.name, err)
+	}
+	return nil
+}
+
+// isLegacySchema reports whether the database has tables from an install that
+// predates versioned migrations
+func (s *GormStore) isLegacySchema(ctx context.Context) (bool, error) {
+	db := s.conn().WithContext(ctx)
+	if !db.Migrator().HasTable(&Execution{}) {
+		return false, nil
+	}
+	version, err := schemaVersion(db)
+	if err != nil {
+		return false, err
+	}
+	return version == 0, nil
+}
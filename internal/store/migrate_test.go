@@ -0,0 +1,318 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var allModels = []any{&Execution{}, &AlertHistory{}, &ChannelStatsRecord{}, &AlertDelivery{}, &AlertState{}, &AlertClaim{}, &SavedView{}, &AlertReceipt{}, &LegalHold{}, &TrashedExecution{}, &DailyRollup{}}
+
+func newFileStore(t *testing.T, name string) *GormStore {
+	t.Helper()
+	st, err := NewGormStore("sqlite", filepath.Join(t.TempDir(), name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = st.Close() })
+	return st
+}
+
+// describeSchema lists the columns and indexes of the models' tables
+func describeSchema(t *testing.T, st *GormStore, models []any) map[string][]string {
+	t.Helper()
+	m := st.conn().Migrator()
+	schema := make(map[string][]string)
+	for _, model := range models {
+		table := st.conn().Model(model).Statement
+		require.NoError(t, table.Parse(model))
+		name := table.Schema.Table
+
+		var columns []struct {
+			Name      string
+			Type      string
+			NotNull   bool
+			DfltValue *string
+			PK        int
+		}
+		require.NoError(t, st.conn().Raw("SELECT name, type, \"notnull\" AS not_null, dflt_value, pk FROM pragma_table_info(?)", name).Scan(&columns).Error)
+		for _, c := range columns {
+			dflt := ""
+			if c.DfltValue != nil {
+				dflt = *c.DfltValue
+			}
+			schema[name] = append(schema[name], fmt.Sprintf("column %s %s notnull=%t default=%s pk=%d", c.Name, strings.ToLower(c.Type), c.NotNull, dflt, c.PK))
+		}
+		indexes, err := m.GetIndexes(model)
+		require.NoError(t, err)
+		for _, idx := range indexes {
+			unique, _ := idx.Unique()
+			schema[name] = append(schema[name], fmt.Sprintf("index %s %s unique=%t", idx.Name(), strings.Join(idx.Columns(), ","), unique))
+		}
+		sort.Strings(schema[name])
+	}
+	return schema
+}
+
+func TestMigrate_MatchesModels(t *testing.T) {
+	migrated := newFileStore(t, "migrated.db")
+	require.NoError(t, migrated.Init())
+
+	auto := newFileStore(t, "auto.db")
+	require.NoError(t, auto.conn().AutoMigrate(allModels...))
+
+	// New model fields need a migration; this fails until one is added
+	assert.Equal(t, describeSchema(t, auto, allModels), describeSchema(t, migrated, allModels))
+}
+
+func TestBaselineModels_MatchBaselineMigration(t *testing.T) {
+	migrated := newFileStore(t, "migrated.db")
+	require.NoError(t, migrated.Migrate(context.Background(), 1))
+
+	auto := newFileStore(t, "auto.db")
+	require.NoError(t, auto.conn().AutoMigrate(baselineModels...))
+
+	assert.Equal(t, describeSchema(t, auto, baselineModels), describeSchema(t, migrated, baselineModels))
+}
+
+func TestMigrate_RecordsVersions(t *testing.T) {
+	st := newFileStore(t, "guardian.db")
+	ctx := context.Background()
+
+	version, err := st.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	require.NoError(t, st.Init())
+	latest, err := st.LatestSchemaVersion()
+	require.NoError(t, err)
+	version, err = st.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+
+	// Init is idempotent
+	require.NoError(t, st.Init())
+	var count int64
+	require.NoError(t, st.conn().Model(&SchemaMigration{}).Count(&count).Error)
+	assert.Equal(t, int64(latest), count)
+}
+
+func TestMigrate_DownAndUp(t *testing.T) {
+	st := newFileStore(t, "guardian.db")
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	require.NoError(t, st.Migrate(ctx, -1))
+	for _, model := range allModels {
+		assert.False(t, st.conn().Migrator().HasTable(model))
+	}
+	version, err := st.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	require.NoError(t, st.Migrate(ctx, 0))
+	for _, model := range allModels {
+		assert.True(t, st.conn().Migrator().HasTable(model))
+	}
+	require.NoError(t, st.RecordExecution(ctx, Execution{
+		CronJobNamespace: "default",
+		CronJobName:      "backup",
+		JobName:          "backup-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+}
+
+func TestMigrate_UnknownTarget(t *testing.T) {
+	st := newFileStore(t, "guardian.db")
+	latest, err := st.LatestSchemaVersion()
+	require.NoError(t, err)
+
+	err = st.Migrate(context.Background(), latest+1)
+	assert.ErrorContains(t, err, "unknown schema version")
+}
+
+// newLegacyStore returns a store whose database was created by AutoMigrate
+// in a release that predates versioned migrations and only had the
+// executions, alert_history and channel_stats tables. It holds one execution
+// of default/backup.
+func newLegacyStore(t *testing.T) *GormStore {
+	t.Helper()
+	st := newFileStore(t, "guardian.db")
+	require.NoError(t, st.conn().AutoMigrate(&baselineExecution{}, &baselineAlertHistory{}, &baselineChannelStats{}))
+	require.NoError(t, st.conn().Create(&baselineExecution{
+		CronJobNamespace: "default",
+		CronJobName:      "backup",
+		JobName:          "backup-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}).Error)
+	return st
+}
+
+func TestInit_BaselinesLegacySchema(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, st.Init())
+
+	var baseline SchemaMigration
+	require.NoError(t, st.conn().First(&baseline, 1).Error)
+	assert.Equal(t, "baseline", baseline.Name)
+	latest, err := st.LatestSchemaVersion()
+	require.NoError(t, err)
+	version, err := st.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
+
+	for _, model := range allModels {
+		assert.True(t, st.conn().Migrator().HasTable(model), "table of %T", model)
+	}
+	fresh := newFileStore(t, "fresh.db")
+	require.NoError(t, fresh.Init())
+	assert.Equal(t, describeSchema(t, fresh, allModels), describeSchema(t, st, allModels))
+
+	last, err := st.GetLastExecution(ctx, types.NamespacedName{Namespace: "default", Name: "backup"})
+	require.NoError(t, err)
+	require.NotNil(t, last)
+	assert.Equal(t, "backup-1", last.JobName)
+}
+
+func TestInit_LegacySchemaCreatesSavedViews(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	assert.True(t, st.conn().Migrator().HasTable(&SavedView{}))
+	require.NoError(t, st.SaveView(ctx, SavedView{Name: "failing", Status: "failing"}))
+	view, err := st.GetView(ctx, "failing")
+	require.NoError(t, err)
+	require.NotNil(t, view)
+	assert.Equal(t, "failing", view.Status)
+}
+
+func TestInit_LegacySchemaCreatesAlertReceipts(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	assert.True(t, st.conn().Migrator().HasTable(&AlertReceipt{}))
+	require.NoError(t, st.RecordReceipt(ctx, AlertReceipt{
+		AlertID:    "7f9c2a1e-0000-4000-8000-000000000001",
+		Receiver:   "pagerduty",
+		Status:     "processed",
+		ReceivedAt: time.Now(),
+	}))
+	receipts, err := st.ListReceipts(ctx, []string{"7f9c2a1e-0000-4000-8000-000000000001"})
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, "pagerduty", receipts[0].Receiver)
+}
+
+func TestInit_LegacySchemaCreatesLegalHolds(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	assert.True(t, st.conn().Migrator().HasTable(&LegalHold{}))
+	require.NoError(t, st.SetLegalHold(ctx, LegalHold{CronJobNamespace: "default", CronJobName: "backup", Reason: "audit"}))
+	holds, err := st.ListLegalHolds(ctx)
+	require.NoError(t, err)
+	require.Len(t, holds, 1)
+	assert.Equal(t, "audit", holds[0].Reason)
+}
+
+func TestInit_LegacySchemaCreatesExecutionTrash(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	assert.True(t, st.conn().Migrator().HasTable(&TrashedExecution{}))
+	backup := types.NamespacedName{Namespace: "default", Name: "backup"}
+	trashed, err := st.TrashExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), trashed)
+	restored, err := st.RestoreExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), restored)
+}
+
+func TestInit_LegacySchemaCreatesDailyRollups(t *testing.T) {
+	st := newLegacyStore(t)
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	assert.True(t, st.conn().Migrator().HasTable(&DailyRollup{}))
+	now := time.Now()
+	written, err := st.RollUpExecutions(ctx, now.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, written)
+	rollups, err := st.GetDailyRollups(ctx, types.NamespacedName{Namespace: "default", Name: "backup"}, now.Add(-48*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	assert.Equal(t, int64(1), rollups[0].Successes)
+}
+
+func TestInit_SchemaTooNew(t *testing.T) {
+	st := newFileStore(t, "guardian.db")
+	require.NoError(t, st.Init())
+
+	latest, err := st.LatestSchemaVersion()
+	require.NoError(t, err)
+	require.NoError(t, st.conn().Create(&SchemaMigration{Version: latest + 1, Name: "from_the_future", AppliedAt: time.Now()}).Error)
+
+	assert.ErrorIs(t, st.Init(), ErrSchemaTooNew)
+}
+
+func TestLoadMigrations_AllDialects(t *testing.T) {
+	var versions []int
+	for _, dialect := range []string{"sqlite", "postgres", "timescale", "mysql"} {
+		migrations, err := loadMigrations(dialect)
+		require.NoError(t, err, dialect)
+		versions = append(versions, len(migrations))
+		for _, m := range migrations {
+			assert.NotEmpty(t, splitStatements(m.up), "%s %04d up", dialect, m.version)
+			assert.NotEmpty(t, splitStatements(m.down), "%s %04d down", dialect, m.version)
+		}
+	}
+	// Every dialect must ship the same migrations
+	for _, v := range versions {
+		assert.Equal(t, versions[0], v)
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	sql := `-- comment
+CREATE TABLE a (
+	id integer
+);
+
+CREATE INDEX idx_a ON a (id);
+`
+	assert.Equal(t, []string{"CREATE TABLE a (\n\tid integer\n)", "CREATE INDEX idx_a ON a (id)"}, splitStatements(sql))
+}
+
+func TestMissingIndexes(t *testing.T) {
+	st := newFileStore(t, "guardian.db")
+	ctx := context.Background()
+	require.NoError(t, st.Init())
+
+	missing, err := st.MissingIndexes(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	require.NoError(t, st.conn().Exec("DROP INDEX idx_alert_type_time").Error)
+	require.NoError(t, st.conn().Exec("DROP INDEX idx_cluster_cronjob_time").Error)
+	missing, err = st.MissingIndexes(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"executions.idx_cluster_cronjob_time", "alert_history.idx_alert_type_time"}, missing)
+
+	// Init logs missing indexes but still succeeds
+	require.NoError(t, st.Init())
+}
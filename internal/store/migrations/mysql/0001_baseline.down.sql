@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS alert_claims;
+DROP TABLE IF EXISTS alert_states;
+DROP TABLE IF EXISTS alert_deliveries;
+DROP TABLE IF EXISTS channel_stats;
+DROP TABLE IF EXISTS alert_history;
+DROP TABLE IF EXISTS executions;
@@ -0,0 +1,123 @@
+-- Baseline schema, as created by AutoMigrate before versioned migrations.
+
+CREATE TABLE IF NOT EXISTS executions (
+	id bigint AUTO_INCREMENT,
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	cronjob_uid varchar(36),
+	job_name varchar(253) NOT NULL,
+	scheduled_time datetime(3) NULL,
+	start_time datetime(3) NOT NULL,
+	completion_time datetime(3) NULL,
+	duration_secs double,
+	succeeded boolean NOT NULL,
+	exit_code int,
+	reason varchar(255),
+	classification varchar(64),
+	is_retry boolean DEFAULT false,
+	retry_of varchar(253),
+	node_name varchar(253),
+	images varchar(2048),
+	pod_names varchar(2048),
+	cpu_request_milli bigint,
+	memory_request_bytes bigint,
+	logs text,
+	logs_ref varchar(1024),
+	events text,
+	suggested_fix text,
+	created_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	INDEX idx_cronjob_time (cronjob_ns, cronjob_name, start_time DESC),
+	INDEX idx_cronjob_uid (cronjob_ns, cronjob_name, cronjob_uid),
+	INDEX idx_cronjob_duration (cronjob_ns, cronjob_name, start_time, duration_secs),
+	INDEX idx_executions_job_name (job_name),
+	INDEX idx_start_time (start_time)
+);
+
+CREATE TABLE IF NOT EXISTS alert_history (
+	id bigint AUTO_INCREMENT,
+	alert_type varchar(100) NOT NULL,
+	severity varchar(20) NOT NULL,
+	title varchar(500) NOT NULL,
+	message text,
+	cronjob_ns varchar(253),
+	cronjob_name varchar(253),
+	monitor_ns varchar(253),
+	monitor_name varchar(253),
+	channels_notified text,
+	occurred_at datetime(3) NOT NULL,
+	resolved_at datetime(3) NULL,
+	exit_code int,
+	reason varchar(255),
+	suggested_fix text,
+	PRIMARY KEY (id),
+	INDEX idx_alert_resolve (alert_type, cronjob_ns, cronjob_name, resolved_at),
+	INDEX idx_alert_severity (severity),
+	INDEX idx_alert_cronjob (cronjob_ns, cronjob_name),
+	INDEX idx_alert_cronjob_time (cronjob_ns, cronjob_name, occurred_at DESC),
+	INDEX idx_alert_occurred (occurred_at DESC),
+	INDEX idx_alert_unresolved (resolved_at)
+);
+
+CREATE TABLE IF NOT EXISTS channel_stats (
+	id bigint AUTO_INCREMENT,
+	channel_name varchar(253) NOT NULL,
+	alerts_sent_total bigint DEFAULT 0,
+	alerts_failed_total bigint DEFAULT 0,
+	last_alert_time datetime(3) NULL,
+	last_failed_time datetime(3) NULL,
+	last_failed_error text,
+	consecutive_failures int DEFAULT 0,
+	updated_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_channel_stats_channel_name (channel_name)
+);
+
+CREATE TABLE IF NOT EXISTS alert_deliveries (
+	id bigint AUTO_INCREMENT,
+	alert_key varchar(512) NOT NULL,
+	alert_type varchar(100) NOT NULL,
+	severity varchar(20) NOT NULL,
+	cronjob_ns varchar(253),
+	cronjob_name varchar(253),
+	channel_name varchar(253) NOT NULL,
+	payload text,
+	record_history boolean DEFAULT false,
+	status varchar(20) NOT NULL,
+	attempts int DEFAULT 0,
+	next_attempt_at datetime(3) NOT NULL,
+	last_error text,
+	delivered_at datetime(3) NULL,
+	created_at datetime(3) NULL,
+	updated_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	INDEX idx_delivery_alert (alert_key),
+	INDEX idx_delivery_channel (channel_name),
+	INDEX idx_delivery_due (status, next_attempt_at),
+	INDEX idx_delivery_created (created_at DESC)
+);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+	alert_key varchar(512),
+	payload text,
+	last_sent_at datetime(3) NOT NULL,
+	acknowledged_by varchar(253),
+	updated_at datetime(3) NULL,
+	PRIMARY KEY (alert_key),
+	INDEX idx_alert_state_sent (last_sent_at)
+);
+
+CREATE TABLE IF NOT EXISTS alert_claims (
+	id bigint AUTO_INCREMENT,
+	alert_key varchar(512) NOT NULL,
+	signature varchar(255) NOT NULL,
+	window_start datetime(3) NOT NULL,
+	claimed_by varchar(253),
+	created_at datetime(3) NULL,
+	-- alert_key and signature exceed InnoDB's 3072-byte key limit in utf8mb4,
+	-- so the unique index covers a digest of them instead
+	claim_hash binary(32) AS (UNHEX(SHA2(CONCAT(alert_key, '|', signature), 256))) STORED,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_alert_claim (claim_hash, window_start),
+	INDEX idx_alert_claim_window (window_start)
+);
@@ -0,0 +1 @@
+ALTER TABLE alert_history DROP COLUMN runbook_url;
@@ -0,0 +1,2 @@
+ALTER TABLE alert_history DROP COLUMN cluster;
+ALTER TABLE executions DROP COLUMN cluster;
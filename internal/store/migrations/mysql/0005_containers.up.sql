@@ -0,0 +1,2 @@
+-- How each container of an execution's pod terminated, as JSON
+ALTER TABLE executions ADD COLUMN containers text;
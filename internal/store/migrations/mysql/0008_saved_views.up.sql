@@ -0,0 +1,17 @@
+-- Named CronJob list filters saved from the dashboard
+CREATE TABLE IF NOT EXISTS saved_views (
+	id bigint AUTO_INCREMENT,
+	name varchar(253) NOT NULL,
+	description varchar(1024),
+	namespaces text,
+	label_selector varchar(1024),
+	team varchar(253),
+	status varchar(20),
+	search varchar(253),
+	sort_by varchar(64),
+	sort_desc boolean DEFAULT false,
+	created_at datetime(3) NULL,
+	updated_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_saved_views_name (name)
+);
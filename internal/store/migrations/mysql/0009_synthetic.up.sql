@@ -0,0 +1,2 @@
+-- Executions injected through the admin API to rehearse alerting, not real runs
+ALTER TABLE executions ADD COLUMN synthetic boolean DEFAULT false;
@@ -0,0 +1,3 @@
+DROP INDEX idx_alert_id ON alert_history;
+ALTER TABLE alert_history DROP COLUMN job_name;
+ALTER TABLE alert_history DROP COLUMN alert_id;
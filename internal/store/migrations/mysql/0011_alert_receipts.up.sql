@@ -0,0 +1,11 @@
+-- Confirmations from downstream systems that they processed an alert
+CREATE TABLE IF NOT EXISTS alert_receipts (
+	id bigint AUTO_INCREMENT,
+	alert_id varchar(36) NOT NULL,
+	receiver varchar(253) NOT NULL,
+	status varchar(20) NOT NULL,
+	message text,
+	received_at datetime(3) NOT NULL,
+	PRIMARY KEY (id),
+	INDEX idx_receipt_alert (alert_id)
+);
@@ -0,0 +1,5 @@
+DROP INDEX idx_alert_severity_time ON alert_history;
+DROP INDEX idx_alert_type_time ON alert_history;
+DROP INDEX idx_alert_cluster_time ON alert_history;
+DROP INDEX idx_cluster_cronjob_uid ON executions;
+DROP INDEX idx_cluster_cronjob_time ON executions;
@@ -0,0 +1,7 @@
+-- Composite indexes for queries scoped to a cluster: executions of a CronJob
+-- by start time or UID, and alert history filtered by type or severity
+CREATE INDEX idx_cluster_cronjob_time ON executions (cluster, cronjob_ns, cronjob_name, start_time DESC);
+CREATE INDEX idx_cluster_cronjob_uid ON executions (cluster, cronjob_ns, cronjob_name, cronjob_uid);
+CREATE INDEX idx_alert_cluster_time ON alert_history (cluster, occurred_at DESC);
+CREATE INDEX idx_alert_type_time ON alert_history (cluster, alert_type, occurred_at DESC);
+CREATE INDEX idx_alert_severity_time ON alert_history (cluster, severity, occurred_at DESC);
@@ -0,0 +1,11 @@
+-- CronJobs whose history is exempt from pruning
+CREATE TABLE IF NOT EXISTS legal_holds (
+	id bigint AUTO_INCREMENT,
+	cluster varchar(63) NOT NULL DEFAULT '',
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	reason varchar(1024),
+	created_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_legal_hold (cluster, cronjob_ns, cronjob_name)
+);
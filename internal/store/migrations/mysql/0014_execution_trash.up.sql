@@ -0,0 +1,39 @@
+-- Executions whose history was deleted through the API, kept for the undo window
+CREATE TABLE IF NOT EXISTS execution_trash (
+	id bigint AUTO_INCREMENT,
+	cluster varchar(63) NOT NULL DEFAULT '',
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	cronjob_uid varchar(36),
+	job_name varchar(253) NOT NULL,
+	scheduled_time datetime(3) NULL,
+	start_time datetime(3) NOT NULL,
+	completion_time datetime(3) NULL,
+	duration_secs double,
+	succeeded boolean NOT NULL,
+	exit_code int,
+	reason varchar(255),
+	classification varchar(64),
+	is_retry boolean DEFAULT false,
+	retry_of varchar(253),
+	backfilled boolean DEFAULT false,
+	synthetic boolean DEFAULT false,
+	node_name varchar(253),
+	images varchar(2048),
+	pod_names varchar(2048),
+	containers text,
+	output_size double,
+	spec_hash varchar(64),
+	spec text,
+	cpu_request_milli bigint,
+	memory_request_bytes bigint,
+	logs text,
+	logs_ref varchar(1024),
+	events text,
+	suggested_fix text,
+	created_at datetime(3) NULL,
+	deleted_at datetime(3) NOT NULL,
+	PRIMARY KEY (id),
+	INDEX idx_trash_cronjob (cluster, cronjob_ns, cronjob_name),
+	INDEX idx_trash_deleted_at (deleted_at)
+);
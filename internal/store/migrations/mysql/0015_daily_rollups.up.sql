@@ -0,0 +1,18 @@
+-- Daily per-CronJob summaries of executions, kept longer than raw executions
+CREATE TABLE IF NOT EXISTS daily_rollups (
+	id bigint AUTO_INCREMENT,
+	cluster varchar(63) NOT NULL DEFAULT '',
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	day varchar(10) NOT NULL,
+	runs bigint NOT NULL,
+	successes bigint NOT NULL,
+	total_runtime_secs double NOT NULL,
+	p50_secs double,
+	p95_secs double,
+	p99_secs double,
+	updated_at datetime(3) NULL,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_rollup_cronjob_day (cluster, cronjob_ns, cronjob_name, day),
+	INDEX idx_rollup_day (day)
+);
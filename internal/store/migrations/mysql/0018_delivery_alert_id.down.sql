@@ -0,0 +1,2 @@
+DROP INDEX idx_delivery_alert_id ON alert_deliveries;
+ALTER TABLE alert_deliveries DROP COLUMN alert_id;
@@ -0,0 +1,3 @@
+-- Tracking ID shared by the deliveries queued for one alert
+ALTER TABLE alert_deliveries ADD COLUMN alert_id varchar(36) NOT NULL DEFAULT '';
+CREATE INDEX idx_delivery_alert_id ON alert_deliveries (alert_id);
@@ -0,0 +1,2 @@
+DROP INDEX idx_alert_claim ON alert_claims;
+CREATE UNIQUE INDEX idx_alert_claim ON alert_claims (claim_hash, window_start);
@@ -0,0 +1,5 @@
+-- One claim per alert and signature, moved forward on each send, so
+-- suppression windows slide instead of being aligned to the clock
+DELETE FROM alert_claims WHERE id NOT IN (SELECT id FROM (SELECT MAX(id) AS id FROM alert_claims GROUP BY claim_hash) AS latest);
+DROP INDEX idx_alert_claim ON alert_claims;
+CREATE UNIQUE INDEX idx_alert_claim ON alert_claims (claim_hash);
@@ -0,0 +1,122 @@
+-- Baseline schema, as created by AutoMigrate before versioned migrations.
+-- With partitioning or TimescaleDB, executions is created before migrations
+-- run, with a primary key that includes start_time.
+
+CREATE TABLE IF NOT EXISTS executions (
+	id bigserial,
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	cronjob_uid varchar(36),
+	job_name varchar(253) NOT NULL,
+	scheduled_time timestamptz,
+	start_time timestamptz NOT NULL,
+	completion_time timestamptz,
+	duration_secs decimal,
+	succeeded boolean NOT NULL,
+	exit_code integer,
+	reason varchar(255),
+	classification varchar(64),
+	is_retry boolean DEFAULT false,
+	retry_of varchar(253),
+	node_name varchar(253),
+	images varchar(2048),
+	pod_names varchar(2048),
+	cpu_request_milli bigint,
+	memory_request_bytes bigint,
+	logs text,
+	logs_ref varchar(1024),
+	events text,
+	suggested_fix text,
+	created_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_start_time ON executions (start_time);
+CREATE INDEX IF NOT EXISTS idx_executions_job_name ON executions (job_name);
+CREATE INDEX IF NOT EXISTS idx_cronjob_duration ON executions (cronjob_ns, cronjob_name, start_time, duration_secs);
+CREATE INDEX IF NOT EXISTS idx_cronjob_uid ON executions (cronjob_ns, cronjob_name, cronjob_uid);
+CREATE INDEX IF NOT EXISTS idx_cronjob_time ON executions (cronjob_ns, cronjob_name, start_time DESC);
+
+CREATE TABLE IF NOT EXISTS alert_history (
+	id bigserial,
+	alert_type varchar(100) NOT NULL,
+	severity varchar(20) NOT NULL,
+	title varchar(500) NOT NULL,
+	message text,
+	cronjob_ns varchar(253),
+	cronjob_name varchar(253),
+	monitor_ns varchar(253),
+	monitor_name varchar(253),
+	channels_notified text,
+	occurred_at timestamptz NOT NULL,
+	resolved_at timestamptz,
+	exit_code integer,
+	reason varchar(255),
+	suggested_fix text,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_alert_unresolved ON alert_history (resolved_at);
+CREATE INDEX IF NOT EXISTS idx_alert_occurred ON alert_history (occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alert_cronjob_time ON alert_history (cronjob_ns, cronjob_name, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alert_cronjob ON alert_history (cronjob_ns, cronjob_name);
+CREATE INDEX IF NOT EXISTS idx_alert_severity ON alert_history (severity);
+CREATE INDEX IF NOT EXISTS idx_alert_resolve ON alert_history (alert_type, cronjob_ns, cronjob_name, resolved_at);
+
+CREATE TABLE IF NOT EXISTS channel_stats (
+	id bigserial,
+	channel_name varchar(253) NOT NULL,
+	alerts_sent_total bigint DEFAULT 0,
+	alerts_failed_total bigint DEFAULT 0,
+	last_alert_time timestamptz,
+	last_failed_time timestamptz,
+	last_failed_error text,
+	consecutive_failures integer DEFAULT 0,
+	updated_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_channel_stats_channel_name ON channel_stats (channel_name);
+
+CREATE TABLE IF NOT EXISTS alert_deliveries (
+	id bigserial,
+	alert_key varchar(512) NOT NULL,
+	alert_type varchar(100) NOT NULL,
+	severity varchar(20) NOT NULL,
+	cronjob_ns varchar(253),
+	cronjob_name varchar(253),
+	channel_name varchar(253) NOT NULL,
+	payload text,
+	record_history boolean DEFAULT false,
+	status varchar(20) NOT NULL,
+	attempts integer DEFAULT 0,
+	next_attempt_at timestamptz NOT NULL,
+	last_error text,
+	delivered_at timestamptz,
+	created_at timestamptz,
+	updated_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_delivery_created ON alert_deliveries (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_delivery_due ON alert_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_delivery_channel ON alert_deliveries (channel_name);
+CREATE INDEX IF NOT EXISTS idx_delivery_alert ON alert_deliveries (alert_key);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+	alert_key varchar(512),
+	payload text,
+	last_sent_at timestamptz NOT NULL,
+	acknowledged_by varchar(253),
+	updated_at timestamptz,
+	PRIMARY KEY (alert_key)
+);
+CREATE INDEX IF NOT EXISTS idx_alert_state_sent ON alert_states (last_sent_at);
+
+CREATE TABLE IF NOT EXISTS alert_claims (
+	id bigserial,
+	alert_key varchar(512) NOT NULL,
+	signature varchar(255) NOT NULL,
+	window_start timestamptz NOT NULL,
+	claimed_by varchar(253),
+	created_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_alert_claim_window ON alert_claims (window_start);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alert_claim ON alert_claims (alert_key, signature, window_start);
@@ -0,0 +1,3 @@
+DROP MATERIALIZED VIEW IF EXISTS executions_hourly;
+ALTER TABLE alert_history DROP COLUMN cluster;
+ALTER TABLE executions DROP COLUMN cluster;
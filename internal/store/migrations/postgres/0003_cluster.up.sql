@@ -0,0 +1,5 @@
+-- Cluster an execution or alert came from (remote-clusters); empty for the local cluster.
+-- The TimescaleDB hourly aggregate is recreated grouped by cluster.
+DROP MATERIALIZED VIEW IF EXISTS executions_hourly;
+ALTER TABLE executions ADD COLUMN cluster varchar(63) NOT NULL DEFAULT '';
+ALTER TABLE alert_history ADD COLUMN cluster varchar(63) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Output size reported by an execution, see the monitor's spec.output
+ALTER TABLE executions ADD COLUMN output_size decimal;
@@ -0,0 +1,2 @@
+ALTER TABLE executions DROP COLUMN spec;
+ALTER TABLE executions DROP COLUMN spec_hash;
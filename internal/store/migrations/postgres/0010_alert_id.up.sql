@@ -0,0 +1,4 @@
+-- Tracking ID of a delivered alert, and the Job it was raised for
+ALTER TABLE alert_history ADD COLUMN alert_id varchar(36) NOT NULL DEFAULT '';
+ALTER TABLE alert_history ADD COLUMN job_name varchar(253) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_alert_id ON alert_history (alert_id);
@@ -0,0 +1,11 @@
+-- Confirmations from downstream systems that they processed an alert
+CREATE TABLE IF NOT EXISTS alert_receipts (
+	id bigserial,
+	alert_id varchar(36) NOT NULL,
+	receiver varchar(253) NOT NULL,
+	status varchar(20) NOT NULL,
+	message text,
+	received_at timestamptz NOT NULL,
+	PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_receipt_alert ON alert_receipts (alert_id);
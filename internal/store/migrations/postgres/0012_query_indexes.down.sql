@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_alert_severity_time;
+DROP INDEX IF EXISTS idx_alert_type_time;
+DROP INDEX IF EXISTS idx_alert_cluster_time;
+DROP INDEX IF EXISTS idx_cluster_cronjob_uid;
+DROP INDEX IF EXISTS idx_cluster_cronjob_time;
@@ -0,0 +1,11 @@
+-- CronJobs whose history is exempt from pruning
+CREATE TABLE IF NOT EXISTS legal_holds (
+	id bigserial,
+	cluster varchar(63) NOT NULL DEFAULT '',
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	reason varchar(1024),
+	created_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_hold ON legal_holds (cluster, cronjob_ns, cronjob_name);
@@ -0,0 +1,18 @@
+-- Daily per-CronJob summaries of executions, kept longer than raw executions
+CREATE TABLE IF NOT EXISTS daily_rollups (
+	id bigserial,
+	cluster varchar(63) NOT NULL DEFAULT '',
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	day varchar(10) NOT NULL,
+	runs bigint NOT NULL,
+	successes bigint NOT NULL,
+	total_runtime_secs decimal NOT NULL,
+	p50_secs decimal,
+	p95_secs decimal,
+	p99_secs decimal,
+	updated_at timestamptz,
+	PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rollup_cronjob_day ON daily_rollups (cluster, cronjob_ns, cronjob_name, day);
+CREATE INDEX IF NOT EXISTS idx_rollup_day ON daily_rollups (day);
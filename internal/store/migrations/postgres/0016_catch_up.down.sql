@@ -0,0 +1,2 @@
+ALTER TABLE execution_trash DROP COLUMN catch_up;
+ALTER TABLE executions DROP COLUMN catch_up;
@@ -0,0 +1,3 @@
+-- Runs fired late for a schedule missed while the CronJob was suspended
+ALTER TABLE executions ADD COLUMN catch_up boolean DEFAULT false;
+ALTER TABLE execution_trash ADD COLUMN catch_up boolean DEFAULT false;
@@ -0,0 +1,3 @@
+-- Runs that failed because of a node drain or cluster upgrade
+ALTER TABLE executions ADD COLUMN infrastructure_disruption boolean DEFAULT false;
+ALTER TABLE execution_trash ADD COLUMN infrastructure_disruption boolean DEFAULT false;
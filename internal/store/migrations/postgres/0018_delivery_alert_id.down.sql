@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_delivery_alert_id;
+ALTER TABLE alert_deliveries DROP COLUMN alert_id;
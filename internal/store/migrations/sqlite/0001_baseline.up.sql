@@ -0,0 +1,115 @@
+-- Baseline schema, as created by AutoMigrate before versioned migrations.
+
+CREATE TABLE IF NOT EXISTS executions (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	cronjob_ns text NOT NULL,
+	cronjob_name text NOT NULL,
+	cronjob_uid text,
+	job_name text NOT NULL,
+	scheduled_time datetime,
+	start_time datetime NOT NULL,
+	completion_time datetime,
+	duration_secs real,
+	succeeded numeric NOT NULL,
+	exit_code integer,
+	reason text,
+	classification text,
+	is_retry numeric DEFAULT false,
+	retry_of text,
+	node_name text,
+	images text,
+	pod_names text,
+	cpu_request_milli integer,
+	memory_request_bytes integer,
+	logs text,
+	logs_ref text,
+	events text,
+	suggested_fix text,
+	created_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_start_time ON executions (start_time);
+CREATE INDEX IF NOT EXISTS idx_executions_job_name ON executions (job_name);
+CREATE INDEX IF NOT EXISTS idx_cronjob_duration ON executions (cronjob_ns, cronjob_name, start_time, duration_secs);
+CREATE INDEX IF NOT EXISTS idx_cronjob_uid ON executions (cronjob_ns, cronjob_name, cronjob_uid);
+CREATE INDEX IF NOT EXISTS idx_cronjob_time ON executions (cronjob_ns, cronjob_name, start_time DESC);
+
+CREATE TABLE IF NOT EXISTS alert_history (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	alert_type text NOT NULL,
+	severity text NOT NULL,
+	title text NOT NULL,
+	message text,
+	cronjob_ns text,
+	cronjob_name text,
+	monitor_ns text,
+	monitor_name text,
+	channels_notified text,
+	occurred_at datetime NOT NULL,
+	resolved_at datetime,
+	exit_code integer,
+	reason text,
+	suggested_fix text
+);
+CREATE INDEX IF NOT EXISTS idx_alert_unresolved ON alert_history (resolved_at);
+CREATE INDEX IF NOT EXISTS idx_alert_occurred ON alert_history (occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alert_cronjob_time ON alert_history (cronjob_ns, cronjob_name, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alert_cronjob ON alert_history (cronjob_ns, cronjob_name);
+CREATE INDEX IF NOT EXISTS idx_alert_severity ON alert_history (severity);
+CREATE INDEX IF NOT EXISTS idx_alert_resolve ON alert_history (alert_type, cronjob_ns, cronjob_name, resolved_at);
+
+CREATE TABLE IF NOT EXISTS channel_stats (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	channel_name text NOT NULL,
+	alerts_sent_total integer DEFAULT 0,
+	alerts_failed_total integer DEFAULT 0,
+	last_alert_time datetime,
+	last_failed_time datetime,
+	last_failed_error text,
+	consecutive_failures integer DEFAULT 0,
+	updated_at datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_channel_stats_channel_name ON channel_stats (channel_name);
+
+CREATE TABLE IF NOT EXISTS alert_deliveries (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	alert_key text NOT NULL,
+	alert_type text NOT NULL,
+	severity text NOT NULL,
+	cronjob_ns text,
+	cronjob_name text,
+	channel_name text NOT NULL,
+	payload text,
+	record_history numeric DEFAULT false,
+	status text NOT NULL,
+	attempts integer DEFAULT 0,
+	next_attempt_at datetime NOT NULL,
+	last_error text,
+	delivered_at datetime,
+	created_at datetime,
+	updated_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_delivery_created ON alert_deliveries (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_delivery_due ON alert_deliveries (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_delivery_channel ON alert_deliveries (channel_name);
+CREATE INDEX IF NOT EXISTS idx_delivery_alert ON alert_deliveries (alert_key);
+
+CREATE TABLE IF NOT EXISTS alert_states (
+	alert_key text,
+	payload text,
+	last_sent_at datetime NOT NULL,
+	acknowledged_by text,
+	updated_at datetime,
+	PRIMARY KEY (alert_key)
+);
+CREATE INDEX IF NOT EXISTS idx_alert_state_sent ON alert_states (last_sent_at);
+
+CREATE TABLE IF NOT EXISTS alert_claims (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	alert_key text NOT NULL,
+	signature text NOT NULL,
+	window_start datetime NOT NULL,
+	claimed_by text,
+	created_at datetime
+);
+CREATE INDEX IF NOT EXISTS idx_alert_claim_window ON alert_claims (window_start);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alert_claim ON alert_claims (alert_key, signature, window_start);
@@ -0,0 +1,2 @@
+-- Runbook links of alerts (alerting.runbookURLs and the CronJob runbook annotation)
+ALTER TABLE alert_history ADD COLUMN runbook_url text;
@@ -0,0 +1,3 @@
+-- Cluster an execution or alert came from (remote-clusters); empty for the local cluster
+ALTER TABLE executions ADD COLUMN cluster text NOT NULL DEFAULT "";
+ALTER TABLE alert_history ADD COLUMN cluster text NOT NULL DEFAULT "";
@@ -0,0 +1,2 @@
+-- Executions recorded after the fact for runs that completed while guardian was not running
+ALTER TABLE executions ADD COLUMN backfilled numeric DEFAULT false;
@@ -0,0 +1,3 @@
+-- Snapshot of the Job's spec at each execution, to tell what changed between runs
+ALTER TABLE executions ADD COLUMN spec_hash text;
+ALTER TABLE executions ADD COLUMN spec text;
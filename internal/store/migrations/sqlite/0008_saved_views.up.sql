@@ -0,0 +1,16 @@
+-- Named CronJob list filters saved from the dashboard
+CREATE TABLE IF NOT EXISTS saved_views (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	name text NOT NULL,
+	description text,
+	namespaces text,
+	label_selector text,
+	team text,
+	status text,
+	search text,
+	sort_by text,
+	sort_desc numeric DEFAULT false,
+	created_at datetime,
+	updated_at datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_saved_views_name ON saved_views (name);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_alert_id;
+ALTER TABLE alert_history DROP COLUMN job_name;
+ALTER TABLE alert_history DROP COLUMN alert_id;
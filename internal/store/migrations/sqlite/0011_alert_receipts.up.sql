@@ -0,0 +1,10 @@
+-- Confirmations from downstream systems that they processed an alert
+CREATE TABLE IF NOT EXISTS alert_receipts (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	alert_id text NOT NULL,
+	receiver text NOT NULL,
+	status text NOT NULL,
+	message text,
+	received_at datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_receipt_alert ON alert_receipts (alert_id);
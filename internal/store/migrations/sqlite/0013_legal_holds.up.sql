@@ -0,0 +1,10 @@
+-- CronJobs whose history is exempt from pruning
+CREATE TABLE IF NOT EXISTS legal_holds (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	cluster text NOT NULL DEFAULT "",
+	cronjob_ns text NOT NULL,
+	cronjob_name text NOT NULL,
+	reason text,
+	created_at datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_legal_hold ON legal_holds (cluster, cronjob_ns, cronjob_name);
@@ -0,0 +1,38 @@
+-- Executions whose history was deleted through the API, kept for the undo window
+CREATE TABLE IF NOT EXISTS execution_trash (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	cluster text NOT NULL DEFAULT "",
+	cronjob_ns text NOT NULL,
+	cronjob_name text NOT NULL,
+	cronjob_uid text,
+	job_name text NOT NULL,
+	scheduled_time datetime,
+	start_time datetime NOT NULL,
+	completion_time datetime,
+	duration_secs real,
+	succeeded numeric NOT NULL,
+	exit_code integer,
+	reason text,
+	classification text,
+	is_retry numeric DEFAULT false,
+	retry_of text,
+	backfilled numeric DEFAULT false,
+	synthetic numeric DEFAULT false,
+	node_name text,
+	images text,
+	pod_names text,
+	containers text,
+	output_size real,
+	spec_hash text,
+	spec text,
+	cpu_request_milli integer,
+	memory_request_bytes integer,
+	logs text,
+	logs_ref text,
+	events text,
+	suggested_fix text,
+	created_at datetime,
+	deleted_at datetime NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_trash_cronjob ON execution_trash (cluster, cronjob_ns, cronjob_name);
+CREATE INDEX IF NOT EXISTS idx_trash_deleted_at ON execution_trash (deleted_at);
@@ -0,0 +1,17 @@
+-- Daily per-CronJob summaries of executions, kept longer than raw executions
+CREATE TABLE IF NOT EXISTS daily_rollups (
+	id integer PRIMARY KEY AUTOINCREMENT,
+	cluster text NOT NULL DEFAULT "",
+	cronjob_ns text NOT NULL,
+	cronjob_name text NOT NULL,
+	day text NOT NULL,
+	runs integer NOT NULL,
+	successes integer NOT NULL,
+	total_runtime_secs real NOT NULL,
+	p50_secs real,
+	p95_secs real,
+	p99_secs real,
+	updated_at datetime
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rollup_cronjob_day ON daily_rollups (cluster, cronjob_ns, cronjob_name, day);
+CREATE INDEX IF NOT EXISTS idx_rollup_day ON daily_rollups (day);
@@ -0,0 +1,2 @@
+ALTER TABLE execution_trash DROP COLUMN infrastructure_disruption;
+ALTER TABLE executions DROP COLUMN infrastructure_disruption;
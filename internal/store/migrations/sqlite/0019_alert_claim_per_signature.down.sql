@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_alert_claim;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alert_claim ON alert_claims (alert_key, signature, window_start);
@@ -0,0 +1,5 @@
+-- One claim per alert and signature, moved forward on each send, so
+-- suppression windows slide instead of being aligned to the clock
+DELETE FROM alert_claims WHERE id NOT IN (SELECT id FROM (SELECT MAX(id) AS id FROM alert_claims GROUP BY alert_key, signature) AS latest);
+DROP INDEX IF EXISTS idx_alert_claim;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alert_claim ON alert_claims (alert_key, signature);
@@ -1,27 +1,48 @@
 package store
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"strings"
 	"time"
+
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // Execution represents a CronJob execution record (GORM model)
 type Execution struct {
 	ID               int64      `gorm:"primaryKey;autoIncrement"`
-	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;not null;index:idx_cronjob_time,priority:1;index:idx_cronjob_uid,priority:1;index:idx_cronjob_duration,priority:1"`
-	CronJobName      string     `gorm:"column:cronjob_name;size:253;not null;index:idx_cronjob_time,priority:2;index:idx_cronjob_uid,priority:2;index:idx_cronjob_duration,priority:2"`
-	CronJobUID       string     `gorm:"column:cronjob_uid;size:36;index:idx_cronjob_uid,priority:3"`
+	Cluster          string     `gorm:"column:cluster;size:63;not null;default:'';index:idx_cluster_cronjob_time,priority:1;index:idx_cluster_cronjob_uid,priority:1"` // Empty for the local cluster
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;not null;index:idx_cronjob_time,priority:1;index:idx_cronjob_uid,priority:1;index:idx_cronjob_duration,priority:1;index:idx_cluster_cronjob_time,priority:2;index:idx_cluster_cronjob_uid,priority:2"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253;not null;index:idx_cronjob_time,priority:2;index:idx_cronjob_uid,priority:2;index:idx_cronjob_duration,priority:2;index:idx_cluster_cronjob_time,priority:3;index:idx_cluster_cronjob_uid,priority:3"`
+	CronJobUID       string     `gorm:"column:cronjob_uid;size:36;index:idx_cronjob_uid,priority:3;index:idx_cluster_cronjob_uid,priority:4"`
 	JobName          string     `gorm:"column:job_name;size:253;not null;index"`
 	ScheduledTime    *time.Time `gorm:"column:scheduled_time"`
-	StartTime        time.Time  `gorm:"column:start_time;not null;index:idx_cronjob_time,priority:3,sort:desc;index:idx_start_time;index:idx_cronjob_duration,priority:3"`
+	StartTime        time.Time  `gorm:"column:start_time;not null;index:idx_cronjob_time,priority:3,sort:desc;index:idx_start_time;index:idx_cronjob_duration,priority:3;index:idx_cluster_cronjob_time,priority:4,sort:desc"`
 	CompletionTime   time.Time  `gorm:"column:completion_time"`
 	DurationSecs     *float64   `gorm:"column:duration_secs;index:idx_cronjob_duration,priority:4"`
 	Succeeded        bool       `gorm:"column:succeeded;not null"`
 	ExitCode         int32      `gorm:"column:exit_code"`
 	Reason           string     `gorm:"column:reason;size:255"`
+	Classification   string     `gorm:"column:classification;size:64"` // Log-based failure classification
 	IsRetry          bool       `gorm:"column:is_retry;default:false"`
 	RetryOf          string     `gorm:"column:retry_of;size:253"`
+	Backfilled       bool       `gorm:"column:backfilled;default:false"`
+	InfraDisruption  bool       `gorm:"column:infrastructure_disruption;default:false"`
+	Synthetic        bool       `gorm:"column:synthetic;default:false"` // Injected through the admin API, not a real run
+	CatchUp          bool       `gorm:"column:catch_up;default:false"`  // Fired late for a schedule missed while suspended
+	NodeName         string     `gorm:"column:node_name;size:253"`      // Node of the pod the outcome was taken from
+	Images           string     `gorm:"column:images;size:2048"`        // Comma-separated container images
+	PodNames         string     `gorm:"column:pod_names;size:2048"`     // Comma-separated pod names
+	Containers       string     `gorm:"column:containers;type:text"`    // JSON-encoded []ContainerResult
+	OutputSize       *float64   `gorm:"column:output_size"`             // Output size reported by the run, see spec.output
+	SpecHash         string     `gorm:"column:spec_hash;size:64"`       // SHA-256 of Spec
+	Spec             string     `gorm:"column:spec;type:text"`          // JSON-encoded JobSpecSnapshot
+	CPURequestMilli  int64      `gorm:"column:cpu_request_milli"`       // CPU requested by the Job's pods, in millicores
+	MemoryRequest    int64      `gorm:"column:memory_request_bytes"`    // Memory requested by the Job's pods, in bytes
 	Logs             *string    `gorm:"column:logs;type:text"`
+	LogsRef          string     `gorm:"column:logs_ref;size:1024"` // Object storage key when logs are offloaded
 	Events           *string    `gorm:"column:events;type:text"`
 	SuggestedFix     string     `gorm:"column:suggested_fix;type:text"` // Generated fix suggestion for failures
 	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime"`
@@ -40,17 +61,122 @@ func (e *Execution) Duration() time.Duration {
 	return time.Duration(*e.DurationSecs * float64(time.Second))
 }
 
+// GetImages returns the container images as a slice
+func (e *Execution) GetImages() []string {
+	if e.Images == "" {
+		return nil
+	}
+	return strings.Split(e.Images, ",")
+}
+
+// SetImages sets the container images from a slice
+func (e *Execution) SetImages(images []string) {
+	e.Images = strings.Join(images, ",")
+}
+
+// GetPodNames returns the pod names as a slice
+func (e *Execution) GetPodNames() []string {
+	if e.PodNames == "" {
+		return nil
+	}
+	return strings.Split(e.PodNames, ",")
+}
+
+// SetPodNames sets the pod names from a slice
+func (e *Execution) SetPodNames(pods []string) {
+	e.PodNames = strings.Join(pods, ",")
+}
+
+// GetContainers returns how the containers of the execution's pod terminated
+func (e *Execution) GetContainers() []ContainerResult {
+	if e.Containers == "" {
+		return nil
+	}
+	var containers []ContainerResult
+	if err := json.Unmarshal([]byte(e.Containers), &containers); err != nil {
+		return nil
+	}
+	return containers
+}
+
+// SetContainers sets how the containers of the execution's pod terminated
+func (e *Execution) SetContainers(containers []ContainerResult) {
+	if len(containers) == 0 {
+		e.Containers = ""
+		return
+	}
+	data, _ := json.Marshal(containers)
+	e.Containers = string(data)
+}
+
+// GetSpec returns the snapshot of the Job's spec, or nil if none was taken
+func (e *Execution) GetSpec() *JobSpecSnapshot {
+	if e.Spec == "" {
+		return nil
+	}
+	var spec JobSpecSnapshot
+	if err := json.Unmarshal([]byte(e.Spec), &spec); err != nil {
+		return nil
+	}
+	return &spec
+}
+
+// SetSpec sets the snapshot of the Job's spec and its hash
+func (e *Execution) SetSpec(spec JobSpecSnapshot) {
+	data, _ := json.Marshal(spec)
+	sum := sha256.Sum256(data)
+	e.Spec = string(data)
+	e.SpecHash = hex.EncodeToString(sum[:])
+}
+
 // SetDuration sets the duration from time.Duration
 func (e *Execution) SetDuration(d time.Duration) {
 	secs := d.Seconds()
 	e.DurationSecs = &secs
 }
 
+// ContainerResult is how one container of an execution's pod terminated
+type ContainerResult struct {
+	Name       string    `json:"name"`
+	Init       bool      `json:"init,omitempty"` // Init container, including native sidecars
+	ExitCode   int32     `json:"exitCode"`
+	Reason     string    `json:"reason,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// Duration returns how long the container ran
+func (c ContainerResult) Duration() time.Duration {
+	if c.StartedAt.IsZero() || c.FinishedAt.Before(c.StartedAt) {
+		return 0
+	}
+	return c.FinishedAt.Sub(c.StartedAt)
+}
+
+// JobSpecSnapshot is the part of a Job's pod template that deploys change,
+// to tell what changed between two executions
+type JobSpecSnapshot struct {
+	ServiceAccountName string          `json:"serviceAccountName,omitempty"`
+	Containers         []ContainerSpec `json:"containers"`
+}
+
+// ContainerSpec is a container of a JobSpecSnapshot
+type ContainerSpec struct {
+	Name    string   `json:"name"`
+	Init    bool     `json:"init,omitempty"`
+	Image   string   `json:"image"`
+	Command []string `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	Env     []string `json:"env,omitempty"` // Sorted names only, since values may be secrets
+}
+
 // AlertHistory represents an alert event record (GORM model)
 type AlertHistory struct {
 	ID               int64      `gorm:"primaryKey;autoIncrement"`
-	Type             string     `gorm:"column:alert_type;size:100;not null;index:idx_alert_resolve,priority:1"`
-	Severity         string     `gorm:"column:severity;size:20;not null;index:idx_alert_severity"`
+	AlertID          string     `gorm:"column:alert_id;size:36;not null;default:'';index:idx_alert_id"`                                                                                                   // Tracking ID sent in channel payloads
+	Cluster          string     `gorm:"column:cluster;size:63;not null;default:'';index:idx_alert_cluster_time,priority:1;index:idx_alert_type_time,priority:1;index:idx_alert_severity_time,priority:1"` // Empty for the local cluster
+	Type             string     `gorm:"column:alert_type;size:100;not null;index:idx_alert_resolve,priority:1;index:idx_alert_type_time,priority:2"`
+	Severity         string     `gorm:"column:severity;size:20;not null;index:idx_alert_severity;index:idx_alert_severity_time,priority:2"`
 	Title            string     `gorm:"column:title;size:500;not null"`
 	Message          string     `gorm:"column:message;type:text"`
 	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;index:idx_alert_cronjob,priority:1;index:idx_alert_cronjob_time,priority:1;index:idx_alert_resolve,priority:2"`
@@ -58,12 +184,14 @@ type AlertHistory struct {
 	MonitorNamespace string     `gorm:"column:monitor_ns;size:253"`
 	MonitorName      string     `gorm:"column:monitor_name;size:253"`
 	ChannelsNotified string     `gorm:"column:channels_notified;type:text"` // Comma-separated
-	OccurredAt       time.Time  `gorm:"column:occurred_at;not null;index:idx_alert_occurred,sort:desc;index:idx_alert_cronjob_time,priority:3,sort:desc"`
+	OccurredAt       time.Time  `gorm:"column:occurred_at;not null;index:idx_alert_occurred,sort:desc;index:idx_alert_cronjob_time,priority:3,sort:desc;index:idx_alert_cluster_time,priority:2,sort:desc;index:idx_alert_type_time,priority:3,sort:desc;index:idx_alert_severity_time,priority:3,sort:desc"`
 	ResolvedAt       *time.Time `gorm:"column:resolved_at;index:idx_alert_unresolved;index:idx_alert_resolve,priority:4"`
 	// Context fields for failure alerts
+	JobName      string `gorm:"column:job_name;size:253;not null;default:''"`
 	ExitCode     int32  `gorm:"column:exit_code"`
 	Reason       string `gorm:"column:reason;size:255"`
 	SuggestedFix string `gorm:"column:suggested_fix;type:text"`
+	RunbookURL   string `gorm:"column:runbook_url;type:text"`
 }
 
 // TableName specifies the table name for AlertHistory
@@ -97,6 +225,70 @@ type Metrics struct {
 	P99DurationSeconds float64
 }
 
+// SuccessStreak is a run of consecutive successful executions (query result, not a GORM model)
+type SuccessStreak struct {
+	Runs  int       // Successful executions in the streak
+	Since time.Time // Start of the first execution in the streak, zero if Runs is 0
+}
+
+// ExecutionAnalytics is an aggregated breakdown of a CronJob's executions over a window
+type ExecutionAnalytics struct {
+	WindowDays     int
+	ExitCodes      []ExitCodeCount
+	FailureReasons []ReasonCount
+	DurationTrend  []DailyDuration
+	Heatmap        []HeatmapCell
+}
+
+// ExitCodeCount is the number of executions that ended with an exit code
+type ExitCodeCount struct {
+	ExitCode int32
+	Count    int64
+}
+
+// ReasonCount is the number of failed executions with a failure reason
+type ReasonCount struct {
+	Reason string
+	Count  int64
+}
+
+// DailyDuration holds duration percentiles for one UTC day
+type DailyDuration struct {
+	Day        string // YYYY-MM-DD
+	Runs       int64
+	P50Seconds float64
+	P95Seconds float64
+}
+
+// CronJobUsage is the compute a CronJob's executions consumed over a window
+// (query result). Resource-seconds are the requests of the Job's pods
+// multiplied by the run duration.
+type CronJobUsage struct {
+	CronJobNamespace string  `gorm:"column:cronjob_ns"`
+	CronJobName      string  `gorm:"column:cronjob_name"`
+	Runs             int64   `gorm:"column:runs"`
+	RuntimeSeconds   float64 `gorm:"column:runtime_seconds"`
+	CPUCoreSeconds   float64 `gorm:"column:cpu_core_seconds"`
+	MemoryGiBSeconds float64 `gorm:"column:memory_gib_seconds"`
+}
+
+// HeatmapCell holds run counts for a day of week (0 = Sunday) and UTC hour
+type HeatmapCell struct {
+	DayOfWeek int
+	Hour      int
+	Total     int64
+	Succeeded int64
+}
+
+// RetentionOverride prunes a CronJob at its own cutoff instead of the one
+// passed to Prune or PruneLogs, for monitors overriding the global retention
+type RetentionOverride struct {
+	CronJob types.NamespacedName
+	// OlderThan is the cutoff for the CronJob's executions (zero = keep
+	// everything, for a legal hold)
+	OlderThan time.Time
+}
+
 // AlertHistoryQuery contains parameters for querying alert history
 type AlertHistoryQuery struct {
 	Limit    int
@@ -104,6 +296,7 @@ type AlertHistoryQuery struct {
 	Since    *time.Time
 	Severity string
 	Type     string // Filter by alert type (e.g., "JobFailed", "SLABreached")
+	AlertID  string // Filter by alert tracking ID
 }
 
 // ChannelAlertStats contains alert statistics for a channel (query result)
@@ -129,3 +322,219 @@ type ChannelStatsRecord struct {
 func (*ChannelStatsRecord) TableName() string {
 	return "channel_stats"
 }
+
+// Alert delivery status values
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+)
+
+// AlertDelivery is a failed channel send queued for retry (GORM model)
+type AlertDelivery struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement"`
+	AlertKey         string     `gorm:"column:alert_key;size:512;not null;index:idx_delivery_alert"`
+	AlertID          string     `gorm:"column:alert_id;size:36;not null;default:'';index:idx_delivery_alert_id"` // Tracking ID shared by the alert's deliveries
+	AlertType        string     `gorm:"column:alert_type;size:100;not null"`
+	Severity         string     `gorm:"column:severity;size:20;not null"`
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253"`
+	ChannelName      string     `gorm:"column:channel_name;size:253;not null;index:idx_delivery_channel"`
+	Payload          string     `gorm:"column:payload;type:text"` // JSON-encoded alert
+	RecordHistory    bool       `gorm:"column:record_history;default:false"`
+	Status           string     `gorm:"column:status;size:20;not null;index:idx_delivery_due,priority:1"`
+	Attempts         int32      `gorm:"column:attempts;default:0"`
+	NextAttemptAt    time.Time  `gorm:"column:next_attempt_at;not null;index:idx_delivery_due,priority:2"`
+	LastError        string     `gorm:"column:last_error;type:text"`
+	DeliveredAt      *time.Time `gorm:"column:delivered_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at;autoCreateTime;index:idx_delivery_created,sort:desc"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for AlertDelivery
+func (*AlertDelivery) TableName() string {
+	return "alert_deliveries"
+}
+
+// AlertState persists the dispatcher's duplicate-suppression state for an
+// alert so it survives restarts and leader failover (GORM model)
+type AlertState struct {
+	AlertKey       string    `gorm:"column:alert_key;size:512;primaryKey"`
+	Payload        string    `gorm:"column:payload;type:text"` // JSON-encoded alert
+	LastSentAt     time.Time `gorm:"column:last_sent_at;not null;index:idx_alert_state_sent"`
+	AcknowledgedBy string    `gorm:"column:acknowledged_by;size:253"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for AlertState
+func (*AlertState) TableName() string {
+	return "alert_states"
+}
+
+// AlertClaim records when an alert was last sent, so only one replica sends
+// it within its suppression window even across leader failover (GORM model)
+type AlertClaim struct {
+	ID       int64  `gorm:"primaryKey;autoIncrement"`
+	AlertKey string `gorm:"column:alert_key;size:512;not null;uniqueIndex:idx_alert_claim,priority:1"`
+	// Signature distinguishes error types, which bypass duplicate suppression
+	Signature string `gorm:"column:signature;size:255;not null;uniqueIndex:idx_alert_claim,priority:2"`
+	// WindowStart is when the alert was sent; the suppression window follows it
+	WindowStart time.Time `gorm:"column:window_start;not null;index:idx_alert_claim_window"`
+	ClaimedBy   string    `gorm:"column:claimed_by;size:253"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for AlertClaim
+func (*AlertClaim) TableName() string {
+	return "alert_claims"
+}
+
+// SavedView is a named CronJob list filter, saved so a team can return to
+// the same set of CronJobs without filtering again (GORM model)
+type SavedView struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	Name          string    `gorm:"column:name;size:253;not null;uniqueIndex"`
+	Description   string    `gorm:"column:description;size:1024"`
+	Namespaces    string    `gorm:"column:namespaces;type:text"` // Comma-separated
+	LabelSelector string    `gorm:"column:label_selector;size:1024"`
+	Team          string    `gorm:"column:team;size:253"`
+	Status        string    `gorm:"column:status;size:20"`
+	Search        string    `gorm:"column:search;size:253"`
+	SortBy        string    `gorm:"column:sort_by;size:64"`
+	SortDesc      bool      `gorm:"column:sort_desc;default:false"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for SavedView
+func (*SavedView) TableName() string {
+	return "saved_views"
+}
+
+// GetNamespaces returns the view's namespaces as a slice
+func (v *SavedView) GetNamespaces() []string {
+	if v.Namespaces == "" {
+		return nil
+	}
+	return strings.Split(v.Namespaces, ",")
+}
+
+// SetNamespaces sets the view's namespaces from a slice
+func (v *SavedView) SetNamespaces(namespaces []string) {
+	v.Namespaces = strings.Join(namespaces, ",")
+}
+
+// Alert receipt status values
+const (
+	ReceiptStatusProcessed = "processed"
+	ReceiptStatusFailed    = "failed"
+)
+
+// AlertReceipt is a downstream system's confirmation that it processed an
+// alert, reported by the alert's tracking ID (GORM model)
+type AlertReceipt struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement"`
+	AlertID    string    `gorm:"column:alert_id;size:36;not null;index:idx_receipt_alert"`
+	Receiver   string    `gorm:"column:receiver;size:253;not null"` // System that processed the alert
+	Status     string    `gorm:"column:status;size:20;not null"`
+	Message    string    `gorm:"column:message;type:text"`
+	ReceivedAt time.Time `gorm:"column:received_at;not null"`
+}
+
+// TableName specifies the table name for AlertReceipt
+func (*AlertReceipt) TableName() string {
+	return "alert_receipts"
+}
+
+// DailyRollup summarizes a CronJob's executions started on one UTC day. Roll-ups
+// outlive raw executions, so long-range trends don't need a year of raw rows
+// (GORM model).
+type DailyRollup struct {
+	ID               int64     `gorm:"primaryKey;autoIncrement"`
+	Cluster          string    `gorm:"column:cluster;size:63;not null;default:'';uniqueIndex:idx_rollup_cronjob_day,priority:1"` // Empty for the local cluster
+	CronJobNamespace string    `gorm:"column:cronjob_ns;size:253;not null;uniqueIndex:idx_rollup_cronjob_day,priority:2"`
+	CronJobName      string    `gorm:"column:cronjob_name;size:253;not null;uniqueIndex:idx_rollup_cronjob_day,priority:3"`
+	Day              string    `gorm:"column:day;size:10;not null;uniqueIndex:idx_rollup_cronjob_day,priority:4;index:idx_rollup_day"` // YYYY-MM-DD
+	Runs             int64     `gorm:"column:runs;not null"`
+	Successes        int64     `gorm:"column:successes;not null"`
+	TotalRuntimeSecs float64   `gorm:"column:total_runtime_secs;not null"`
+	P50Secs          float64   `gorm:"column:p50_secs"`
+	P95Secs          float64   `gorm:"column:p95_secs"`
+	P99Secs          float64   `gorm:"column:p99_secs"`
+	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName specifies the table name for DailyRollup
+func (*DailyRollup) TableName() string {
+	return "daily_rollups"
+}
+
+// TrashedExecution is an execution whose history was deleted through the API,
+// kept until the undo window passes so the deletion can be reverted (GORM
+// model). Its columns mirror Execution's, without the query indexes.
+type TrashedExecution struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement"`
+	Cluster          string     `gorm:"column:cluster;size:63;not null;default:'';index:idx_trash_cronjob,priority:1"`
+	CronJobNamespace string     `gorm:"column:cronjob_ns;size:253;not null;index:idx_trash_cronjob,priority:2"`
+	CronJobName      string     `gorm:"column:cronjob_name;size:253;not null;index:idx_trash_cronjob,priority:3"`
+	CronJobUID       string     `gorm:"column:cronjob_uid;size:36"`
+	JobName          string     `gorm:"column:job_name;size:253;not null"`
+	ScheduledTime    *time.Time `gorm:"column:scheduled_time"`
+	StartTime        time.Time  `gorm:"column:start_time;not null"`
+	CompletionTime   time.Time  `gorm:"column:completion_time"`
+	DurationSecs     *float64   `gorm:"column:duration_secs"`
+	Succeeded        bool       `gorm:"column:succeeded;not null"`
+	ExitCode         int32      `gorm:"column:exit_code"`
+	Reason           string     `gorm:"column:reason;size:255"`
+	Classification   string     `gorm:"column:classification;size:64"`
+	IsRetry          bool       `gorm:"column:is_retry;default:false"`
+	RetryOf          string     `gorm:"column:retry_of;size:253"`
+	Backfilled       bool       `gorm:"column:backfilled;default:false"`
+	InfraDisruption  bool       `gorm:"column:infrastructure_disruption;default:false"`
+	Synthetic        bool       `gorm:"column:synthetic;default:false"`
+	CatchUp          bool       `gorm:"column:catch_up;default:false"`
+	NodeName         string     `gorm:"column:node_name;size:253"`
+	Images           string     `gorm:"column:images;size:2048"`
+	PodNames         string     `gorm:"column:pod_names;size:2048"`
+	Containers       string     `gorm:"column:containers;type:text"`
+	OutputSize       *float64   `gorm:"column:output_size"`
+	SpecHash         string     `gorm:"column:spec_hash;size:64"`
+	Spec             string     `gorm:"column:spec;type:text"`
+	CPURequestMilli  int64      `gorm:"column:cpu_request_milli"`
+	MemoryRequest    int64      `gorm:"column:memory_request_bytes"`
+	Logs             *string    `gorm:"column:logs;type:text"`
+	LogsRef          string     `gorm:"column:logs_ref;size:1024"`
+	Events           *string    `gorm:"column:events;type:text"`
+	SuggestedFix     string     `gorm:"column:suggested_fix;type:text"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	DeletedAt        time.Time  `gorm:"column:deleted_at;not null;index:idx_trash_deleted_at"`
+}
+
+// TableName specifies the table name for TrashedExecution
+func (*TrashedExecution) TableName() string {
+	return "execution_trash"
+}
+
+// LegalHold exempts a CronJob's execution history and logs from pruning,
+// e.g. while they are evidence in an audit or a dispute (GORM model)
+type LegalHold struct {
+	ID               int64     `gorm:"primaryKey;autoIncrement"`
+	Cluster          string    `gorm:"column:cluster;size:63;not null;default:'';uniqueIndex:idx_legal_hold,priority:1"` // Empty for the local cluster
+	CronJobNamespace string    `gorm:"column:cronjob_ns;size:253;not null;uniqueIndex:idx_legal_hold,priority:2"`
+	CronJobName      string    `gorm:"column:cronjob_name;size:253;not null;uniqueIndex:idx_legal_hold,priority:3"`
+	Reason           string    `gorm:"column:reason;size:1024"`
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName specifies the table name for LegalHold
+func (*LegalHold) TableName() string {
+	return "legal_holds"
+}
+
+// AlertDeliveryQuery contains parameters for listing alert deliveries
+type AlertDeliveryQuery struct {
+	Limit       int
+	Offset      int
+	Status      string // Filter by status ("pending", "delivered", "failed")
+	ChannelName string
+}
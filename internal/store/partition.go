@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Monthly range partitioning of the executions table (PostgreSQL only).
+//
+// When enabled on a fresh database, executions is created as a partitioned
+// table keyed on start_time with one partition per month plus a default
+// partition for rows outside the pre-created range. Prune drops whole monthly
+// partitions that fall entirely before the cutoff instead of deleting rows,
+// which avoids table bloat on large clusters.
+
+const (
+	// partitionPrefix is the name prefix for monthly executions partitions
+	partitionPrefix = "executions_p"
+	// partitionLayout formats the month suffix of a partition name
+	partitionLayout = "200601"
+	// partitionMonthsAhead is how many future months get a partition in advance
+	partitionMonthsAhead = 3
+)
+
+// executionsTableSQL creates the executions table with a composite primary
+// key on (id, start_time), as required by both range partitioning and
+// TimescaleDB hypertables. Columns match the baseline migration, which then
+// only adds the indexes.
+const executionsTableSQL = `CREATE TABLE IF NOT EXISTS executions (
+	id bigserial NOT NULL,
+	cronjob_ns varchar(253) NOT NULL,
+	cronjob_name varchar(253) NOT NULL,
+	cronjob_uid varchar(36),
+	job_name varchar(253) NOT NULL,
+	scheduled_time timestamptz,
+	start_time timestamptz NOT NULL,
+	completion_time timestamptz,
+	duration_secs decimal,
+	succeeded boolean NOT NULL,
+	exit_code integer,
+	reason varchar(255),
+	classification varchar(64),
+	is_retry boolean DEFAULT false,
+	retry_of varchar(253),
+	node_name varchar(253),
+	images varchar(2048),
+	pod_names varchar(2048),
+	cpu_request_milli bigint,
+	memory_request_bytes bigint,
+	logs text,
+	logs_ref varchar(1024),
+	events text,
+	suggested_fix text,
+	created_at timestamptz,
+	PRIMARY KEY (id, start_time)
+)`
+
+// createPartitionedExecutionsSQL creates the executions parent table
+const createPartitionedExecutionsSQL = executionsTableSQL + " PARTITION BY RANGE (start_time)"
+
+// SetPartitioning enables monthly partitioning of the executions table.
+// Only honored for PostgreSQL; must be called before Init. Existing
+// non-partitioned tables are left as they are.
+func (s *GormStore) SetPartitioning(enabled bool) {
+	s.partitioning = enabled && s.dialect == "postgres"
+}
+
+// Partitioned reports whether the executions table is range-partitioned
+func (s *GormStore) Partitioned() bool {
+	return s.partitioned
+}
+
+// initPartitioning creates the partitioned executions table if it does not
+// exist yet and makes sure partitions exist for the coming months.
+func (s *GormStore) initPartitioning(ctx context.Context) error {
+	relkind, err := s.executionsRelkind(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch relkind {
+	case "":
+		if err := s.conn().WithContext(ctx).Exec(createPartitionedExecutionsSQL).Error; err != nil {
+			return fmt.Errorf("create partitioned executions table: %w", err)
+		}
+	case "p":
+		// Already partitioned
+	default:
+		// Plain table from an earlier install: keep row-based pruning
+		return nil
+	}
+
+	s.partitioned = true
+
+	if err := s.conn().WithContext(ctx).
+		Exec("CREATE TABLE IF NOT EXISTS executions_default PARTITION OF executions DEFAULT").Error; err != nil {
+		return fmt.Errorf("create default partition: %w", err)
+	}
+
+	return s.ensurePartitions(ctx, time.Now())
+}
+
+// executionsRelkind returns the pg_class relkind of the executions table
+// ("r" plain, "p" partitioned) or "" if it does not exist.
+func (s *GormStore) executionsRelkind(ctx context.Context) (string, error) {
+	var relkind string
+	err := s.conn().WithContext(ctx).
+		Raw("SELECT relkind FROM pg_class WHERE relname = ? AND relnamespace = current_schema()::regnamespace", "executions").
+		Scan(&relkind).Error
+	if err != nil {
+		return "", fmt.Errorf("inspect executions table: %w", err)
+	}
+	return relkind, nil
+}
+
+// ensurePartitions creates monthly partitions from the month of now through
+// partitionMonthsAhead months into the future.
+func (s *GormStore) ensurePartitions(ctx context.Context, now time.Time) error {
+	month := monthStart(now)
+	for i := 0; i <= partitionMonthsAhead; i++ {
+		from := month.AddDate(0, i, 0)
+		to := from.AddDate(0, 1, 0)
+		stmt := fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF executions FOR VALUES FROM ('%s') TO ('%s')",
+			partitionName(from), from.Format(time.RFC3339), to.Format(time.RFC3339),
+		)
+		if err := s.conn().WithContext(ctx).Exec(stmt).Error; err != nil {
+			return fmt.Errorf("create partition %s: %w", partitionName(from), err)
+		}
+	}
+	return nil
+}
+
+// dropExpiredPartitions drops monthly partitions whose entire range lies
+// before olderThan and returns the number of rows they held.
+func (s *GormStore) dropExpiredPartitions(ctx context.Context, olderThan time.Time) (int64, error) {
+	var names []string
+	err := s.conn().WithContext(ctx).
+		Raw(`SELECT c.relname FROM pg_inherits i
+			JOIN pg_class c ON c.oid = i.inhrelid
+			JOIN pg_class p ON p.oid = i.inhparent
+			WHERE p.relname = ?`, "executions").
+		Scan(&names).Error
+	if err != nil {
+		return 0, fmt.Errorf("list executions partitions: %w", err)
+	}
+
+	var dropped int64
+	for _, name := range names {
+		month, ok := parsePartitionName(name)
+		if !ok || month.AddDate(0, 1, 0).After(olderThan) {
+			continue
+		}
+
+		var rows int64
+		if err := s.conn().WithContext(ctx).Raw(fmt.Sprintf("SELECT COUNT(*) FROM %s", name)).Scan(&rows).Error; err != nil {
+			return dropped, fmt.Errorf("count rows in partition %s: %w", name, err)
+		}
+		if err := s.conn().WithContext(ctx).Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", name)).Error; err != nil {
+			return dropped, fmt.Errorf("drop partition %s: %w", name, err)
+		}
+		dropped += rows
+	}
+	return dropped, nil
+}
+
+// partitionName returns the partition table name for the month containing t
+func partitionName(t time.Time) string {
+	return partitionPrefix + t.UTC().Format(partitionLayout)
+}
+
+// parsePartitionName returns the month a partition covers, or false if the
+// name is not a monthly executions partition (e.g. the default partition).
+func parsePartitionName(name string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, partitionPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	month, err := time.ParseInLocation(partitionLayout, suffix, time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// monthStart returns midnight UTC on the first day of t's month
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Duration percentiles computed in the database. PostgreSQL (and TimescaleDB)
+// use the native percentile_cont aggregate. MySQL has no percentile aggregate,
+// so it ranks durations with window functions (MySQL 8.0+/MariaDB 10.2+) and
+// interpolates between neighbouring rows the same way percentile_cont does.
+// SQLite keeps the in-memory calculation.
+
+// durationStats holds the average and standard percentiles of run durations in seconds
+type durationStats struct {
+	Avg float64
+	P50 float64
+	P95 float64
+	P99 float64
+}
+
+// rankedDurationsSQL ranks the durations of one CronJob's executions within a
+// window. Each row carries the next larger duration for interpolation.
+const rankedDurationsSQL = `SELECT duration_secs AS d,
+		LEAD(duration_secs) OVER (ORDER BY duration_secs) AS next_d,
+		ROW_NUMBER() OVER (ORDER BY duration_secs) AS rn,
+		COUNT(*) OVER () AS cnt
+	FROM executions
+	WHERE cluster = ? AND cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL`
+
+// supportsSQLPercentiles reports whether duration percentiles can be computed in the database
+func (s *GormStore) supportsSQLPercentiles() bool {
+	return s.isPostgres() || s.dialect == "mysql"
+}
+
+// windowedPercentileExpr returns an aggregate over rankedDurationsSQL that
+// yields the p-th percentile with linear interpolation (percentile_cont).
+func windowedPercentileExpr(p int) string {
+	pos := fmt.Sprintf("(%g * (cnt - 1))", float64(p)/100)
+	return fmt.Sprintf(
+		"COALESCE(MAX(CASE WHEN rn = FLOOR(%[1]s) + 1 THEN d + (COALESCE(next_d, d) - d) * (%[1]s - FLOOR(%[1]s)) END), 0)",
+		pos,
+	)
+}
+
+// getDurationStatsSQL computes the average, p50, p95 and p99 durations in a single query
+func (s *GormStore) getDurationStatsSQL(ctx context.Context, cronJob types.NamespacedName, since time.Time) (durationStats, error) {
+	var stats durationStats
+	var err error
+	if s.isPostgres() {
+		err = s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
+				cronJob.Namespace, cronJob.Name, since).
+			Select(`
+				COALESCE(AVG(duration_secs), 0) as avg,
+				COALESCE(PERCENTILE_CONT(0.50) WITHIN GROUP (ORDER BY duration_secs), 0) as p50,
+				COALESCE(PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY duration_secs), 0) as p95,
+				COALESCE(PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY duration_secs), 0) as p99
+			`).
+			Scan(&stats).Error
+	} else {
+		err = s.getDurationStatsWindowed(ctx, cronJob, since, &stats)
+	}
+	return stats, err
+}
+
+// getDurationStatsWindowed computes duration statistics with window functions
+func (s *GormStore) getDurationStatsWindowed(ctx context.Context, cronJob types.NamespacedName, since time.Time, stats *durationStats) error {
+	query := fmt.Sprintf(
+		"SELECT COALESCE(AVG(d), 0) AS avg, %s AS p50, %s AS p95, %s AS p99 FROM (%s) ranked",
+		windowedPercentileExpr(50), windowedPercentileExpr(95), windowedPercentileExpr(99), rankedDurationsSQL,
+	)
+	return s.conn().WithContext(ctx).
+		Raw(query, s.cluster, cronJob.Namespace, cronJob.Name, since).
+		Scan(stats).Error
+}
+
+// getDurationPercentileSQL computes a single duration percentile in the database
+func (s *GormStore) getDurationPercentileSQL(ctx context.Context, cronJob types.NamespacedName, p int, since time.Time) (time.Duration, error) {
+	var seconds float64
+	var err error
+	if s.isPostgres() {
+		err = s.scoped(ctx).Model(&Execution{}).
+			Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND duration_secs IS NOT NULL",
+				cronJob.Namespace, cronJob.Name, since).
+			Select("COALESCE(PERCENTILE_CONT(?) WITHIN GROUP (ORDER BY duration_secs), 0)", float64(p)/100).
+			Scan(&seconds).Error
+	} else {
+		err = s.getDurationPercentileWindowed(ctx, cronJob, p, since, &seconds)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// getDurationPercentileWindowed computes a single percentile with window functions
+func (s *GormStore) getDurationPercentileWindowed(ctx context.Context, cronJob types.NamespacedName, p int, since time.Time, seconds *float64) error {
+	query := fmt.Sprintf("SELECT %s FROM (%s) ranked", windowedPercentileExpr(p), rankedDurationsSQL)
+	return s.conn().WithContext(ctx).
+		Raw(query, s.cluster, cronJob.Namespace, cronJob.Name, since).
+		Scan(seconds).Error
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// BackendOptions configures a store opened by a registered backend
+type BackendOptions struct {
+	// DSN is the backend's connection string
+	DSN string
+
+	// Pool holds connection pool settings; backends without a pool ignore it
+	Pool ConnectionPoolConfig
+
+	// Partitioning requests monthly partitioning of the executions table;
+	// backends that can't partition ignore it
+	Partitioning bool
+
+	// Replica is a read replica for read-heavy queries; backends without
+	// replica support fail to open when one is set
+	Replica ReplicaConfig
+}
+
+// Factory opens a store for a backend. The operator calls Init on the
+// returned store before using it.
+type Factory func(opts BackendOptions) (Store, error)
+
+// ClusterScoper is implemented by stores that can monitor remote clusters.
+// ForCluster returns a store sharing the connection of the receiver whose
+// executions and alerts are scoped to the named cluster.
+type ClusterScoper interface {
+	ForCluster(name string) Store
+}
+
+// Migrator is implemented by stores with a versioned schema, which
+// --migrate-only with storage.migrate-to steps through
+type Migrator interface {
+	Migrate(ctx context.Context, target int) error
+	SchemaVersion(ctx context.Context) (int, error)
+}
+
+// Reconnector is implemented by stores that can swap their connection, e.g.
+// when the database password Secret rotates
+type Reconnector interface {
+	Reconnect(ctx context.Context, dsn string) error
+}
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]Factory{}
+)
+
+// Register makes a storage backend available under name, the value of
+// storage.type. Backends register themselves from an init function, so a
+// backend is compiled in by importing its package, typically from a file in
+// cmd/ behind a build tag. Register panics if name is already registered.
+func Register(name string, factory Factory) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if factory == nil {
+		panic("store: Register factory is nil for backend " + name)
+	}
+	if _, dup := backends[name]; dup {
+		panic("store: Register called twice for backend " + name)
+	}
+	backends[name] = factory
+}
+
+// Backends returns the names of the registered backends, sorted
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Open opens a store with the backend registered under name. The store is
+// not initialized yet.
+func Open(name string, opts BackendOptions) (Store, error) {
+	backendsMu.RLock()
+	factory, ok := backends[name]
+	backendsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported storage type %q (registered: %s)", name, strings.Join(Backends(), ", "))
+	}
+	return factory(opts)
+}
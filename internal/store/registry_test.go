@@ -0,0 +1,41 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_BuiltinBackends(t *testing.T) {
+	for _, name := range gormDialects {
+		assert.Contains(t, Backends(), name)
+	}
+
+	_, err := Open("clickhouse", BackendOptions{})
+	assert.ErrorContains(t, err, `unsupported storage type "clickhouse" (registered: mysql, postgres, sqlite, timescale)`)
+
+	st, err := Open("sqlite", BackendOptions{DSN: "file::memory:", Partitioning: true})
+	require.NoError(t, err)
+	defer func() { _ = st.Close() }()
+	require.IsType(t, &GormStore{}, st)
+	assert.False(t, st.(*GormStore).partitioning, "partitioning is postgres only")
+
+	assert.Implements(t, (*ClusterScoper)(nil), st)
+	assert.Implements(t, (*Migrator)(nil), st)
+	assert.Implements(t, (*Reconnector)(nil), st)
+}
+
+func TestRegister(t *testing.T) {
+	factory := func(BackendOptions) (Store, error) { return nil, nil }
+	Register("test-backend", factory)
+	t.Cleanup(func() {
+		backendsMu.Lock()
+		delete(backends, "test-backend")
+		backendsMu.Unlock()
+	})
+	assert.Contains(t, Backends(), "test-backend")
+
+	assert.Panics(t, func() { Register("test-backend", factory) }, "names are registered once")
+	assert.Panics(t, func() { Register("nil-backend", nil) })
+}
@@ -0,0 +1,213 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ReplicaConfig configures a read replica
+type ReplicaConfig struct {
+	// DSN of the replica (empty = no replica)
+	DSN string
+
+	// MaxLag is the replication lag beyond which reads go to the primary
+	// (0 = any lag)
+	MaxLag time.Duration
+}
+
+// DefaultReplicaCheckInterval is how often ReplicaMonitor checks the replica
+// when no interval is given
+const DefaultReplicaCheckInterval = 10 * time.Second
+
+// replicaCheckTimeout bounds the replica check of Init
+const replicaCheckTimeout = 10 * time.Second
+
+// replicaLagSQL returns the replica's replay lag in seconds. A replica that
+// replayed everything it received has no lag, even if the primary has been
+// idle since the last transaction. The primary itself reports 0.
+const replicaLagSQL = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// readReplica is a PostgreSQL replica that read-heavy queries run on while
+// it is healthy. Shared by the stores returned by ForCluster.
+type readReplica struct {
+	db      *atomic.Pointer[gorm.DB]
+	maxLag  time.Duration
+	healthy atomic.Bool
+}
+
+// SetReadReplica routes read-heavy queries (execution lists, metrics,
+// analytics, usage, alert history) to a replica. Reads fall back to the
+// primary while the replica is unreachable or lags more than cfg.MaxLag.
+// Only honored for PostgreSQL; must be called before Init.
+func (s *GormStore) SetReadReplica(cfg ReplicaConfig) error {
+	if cfg.DSN == "" {
+		return nil
+	}
+	if !s.isPostgres() {
+		return fmt.Errorf("read replicas are not supported for storage type %q", s.dialect)
+	}
+	db, err := s.openReplica(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("read replica: %w", err)
+	}
+	s.replica = &readReplica{db: new(atomic.Pointer[gorm.DB]), maxLag: cfg.MaxLag}
+	s.replica.db.Store(db)
+	return nil
+}
+
+// openReplica opens a connection pool to a replica without connecting, so
+// an unreachable replica doesn't stop the operator. Queries failing with a
+// connection error take the replica out of rotation until the next check.
+func (s *GormStore) openReplica(dsn string) (*gorm.DB, error) {
+	db, err := openDB(s.dialect, dsn, s.pool, false)
+	if err != nil {
+		return nil, err
+	}
+	markDown := func(tx *gorm.DB) {
+		if s.replica != nil && isTransient(tx.Error) {
+			s.replica.setHealthy(tx.Statement.Context, false, tx.Error)
+		}
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("guardian:replica_health", markDown); err != nil {
+		return nil, err
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("guardian:replica_health", markDown); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// ReconnectReplica replaces the replica's connection pool with one opened
+// with dsn, like Reconnect does for the primary
+func (s *GormStore) ReconnectReplica(ctx context.Context, dsn string) error {
+	if s.replica == nil {
+		return nil
+	}
+	db, err := s.openReplica(dsn)
+	if err != nil {
+		return err
+	}
+	old := s.replica.db.Swap(db)
+	if oldDB, err := old.DB(); err == nil {
+		go func() { _ = oldDB.Close() }()
+	}
+	s.CheckReplica(ctx)
+	return nil
+}
+
+// setHealthy puts the replica in or out of rotation, logging changes
+func (r *readReplica) setHealthy(ctx context.Context, healthy bool, reason error) {
+	if r.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		log.FromContext(ctx).Info("read replica in sync, serving reads from it")
+	} else {
+		log.FromContext(ctx).Info("read replica unavailable, serving reads from the primary", "reason", reason.Error())
+	}
+}
+
+// CheckReplica pings the replica and compares its lag with the maximum,
+// taking it in or out of rotation. It reports whether reads go to the replica.
+func (s *GormStore) CheckReplica(ctx context.Context) bool {
+	if s.replica == nil {
+		return false
+	}
+	err := s.replica.check(ctx)
+	s.replica.setHealthy(ctx, err == nil, err)
+	return err == nil
+}
+
+// check returns why the replica can't serve reads, if it can't
+func (r *readReplica) check(ctx context.Context) error {
+	db := r.db.Load()
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return err
+	}
+	if r.maxLag <= 0 {
+		return nil
+	}
+	var lagSecs float64
+	if err := db.WithContext(ctx).Raw(replicaLagSQL).Scan(&lagSecs).Error; err != nil {
+		return fmt.Errorf("reading replication lag: %w", err)
+	}
+	if lag := time.Duration(lagSecs * float64(time.Second)); lag > r.maxLag {
+		return fmt.Errorf("replication lag %s exceeds %s", lag.Round(time.Second), r.maxLag)
+	}
+	return nil
+}
+
+// forRead returns the store read-heavy queries run on: a copy of s reading
+// from the replica while it is healthy, s otherwise
+func (s *GormStore) forRead() *GormStore {
+	if s.replica == nil || !s.replica.healthy.Load() {
+		return s
+	}
+	reader := *s
+	reader.db = s.replica.db
+	return &reader
+}
+
+// closeReplica closes the replica's connection pool
+func (s *GormStore) closeReplica() error {
+	if s.replica == nil {
+		return nil
+	}
+	sqlDB, err := s.replica.db.Load().DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// ReplicaMonitor periodically checks the read replica of a store, so reads
+// move back to it once it has caught up
+type ReplicaMonitor struct {
+	store    *GormStore
+	interval time.Duration
+}
+
+// NewReplicaMonitor returns a monitor checking the replica of s every
+// interval (0 = DefaultReplicaCheckInterval)
+func NewReplicaMonitor(s *GormStore, interval time.Duration) *ReplicaMonitor {
+	if interval <= 0 {
+		interval = DefaultReplicaCheckInterval
+	}
+	return &ReplicaMonitor{store: s, interval: interval}
+}
+
+// Start checks the replica until ctx is cancelled
+func (m *ReplicaMonitor) Start(ctx context.Context) error {
+	log.FromContext(ctx).Info("starting read replica monitor", "interval", m.interval)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, m.interval)
+			m.store.CheckReplica(checkCtx)
+			cancel()
+		}
+	}
+}
+
+// NeedLeaderElection returns false: every replica of the operator serves
+// API reads
+func (m *ReplicaMonitor) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// replicaStores returns a primary store reading from a replica, each with
+// one execution of the backup CronJob
+func replicaStores(t *testing.T) (primary, replica *GormStore) {
+	t.Helper()
+	ctx := context.Background()
+	open := func(name, jobName string) *GormStore {
+		st, err := NewGormStore("sqlite", filepath.Join(t.TempDir(), name))
+		require.NoError(t, err)
+		require.NoError(t, st.Init())
+		require.NoError(t, st.RecordExecution(ctx, Execution{
+			CronJobNamespace: "default", CronJobName: "backup", JobName: jobName,
+			StartTime: time.Now().Add(-time.Minute), Succeeded: true,
+		}))
+		return st
+	}
+	primary = open("primary.db", "backup-primary")
+	replica = open("replica.db", "backup-replica")
+	t.Cleanup(func() {
+		_ = primary.Close()
+		_ = replica.Close()
+	})
+
+	primary.replica = &readReplica{db: replica.db}
+	return primary, replica
+}
+
+func TestSetReadReplica_PostgresOnly(t *testing.T) {
+	st, err := NewGormStore("sqlite", "file::memory:")
+	require.NoError(t, err)
+	defer func() { _ = st.Close() }()
+
+	require.NoError(t, st.SetReadReplica(ReplicaConfig{}), "no replica configured")
+	assert.ErrorContains(t, st.SetReadReplica(ReplicaConfig{DSN: "host=replica"}), "not supported")
+}
+
+func TestReadReplica_RoutesReads(t *testing.T) {
+	ctx := context.Background()
+	primary, replica := replicaStores(t)
+	backup := types.NamespacedName{Namespace: "default", Name: "backup"}
+
+	execs, err := primary.GetExecutions(ctx, backup, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, execs, 1)
+	assert.Equal(t, "backup-primary", execs[0].JobName, "reads stay on the primary until the replica is checked")
+
+	require.True(t, primary.CheckReplica(ctx))
+	execs, err = primary.GetExecutions(ctx, backup, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, execs, 1)
+	assert.Equal(t, "backup-replica", execs[0].JobName)
+
+	remote := primary.ForCluster("prod-eu").(*GormStore)
+	assert.Same(t, replica.db, remote.forRead().db, "cluster stores share the replica")
+
+	last, err := primary.GetLastExecution(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, "backup-primary", last.JobName, "reads following writes stay on the primary")
+
+	require.NoError(t, replica.Close())
+	assert.False(t, primary.CheckReplica(ctx))
+	execs, err = primary.GetExecutions(ctx, backup, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, execs, 1)
+	assert.Equal(t, "backup-primary", execs[0].JobName, "reads fall back to the primary")
+}
+
+func TestReadReplica_MarkedDownOnConnectionError(t *testing.T) {
+	primary, _ := replicaStores(t)
+	db, err := primary.openReplica(filepath.Join(t.TempDir(), "replica.db"))
+	require.NoError(t, err)
+	primary.replica = &readReplica{db: new(atomic.Pointer[gorm.DB])}
+	primary.replica.db.Store(db)
+	primary.replica.healthy.Store(true)
+
+	_ = db.Callback().Query().Before("gorm:query").Register("test:drop_connection", func(tx *gorm.DB) {
+		_ = tx.AddError(errors.New("write: broken pipe"))
+	})
+	_, err = primary.GetExecutionCount(context.Background())
+	require.Error(t, err)
+	assert.False(t, primary.replica.healthy.Load(), "a connection error takes the replica out of rotation")
+}
@@ -0,0 +1,662 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/metrics"
+)
+
+// RetryConfig configures retries of transient database errors
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt (0 = no retries)
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, doubled for each retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries
+	MaxBackoff time.Duration
+}
+
+// RetryStore retries store calls that fail with a transient database error,
+// such as a deadlock, a serialization failure or a dropped connection, so a
+// database hiccup does not lose execution records. Every call is retried as a
+// whole, so transactions are restarted from the beginning.
+type RetryStore struct {
+	Store
+
+	config RetryConfig
+}
+
+// NewRetryStore wraps s with retries using exponential backoff
+func NewRetryStore(s Store, config RetryConfig) *RetryStore {
+	return &RetryStore{Store: s, config: config}
+}
+
+// do calls fn until it succeeds, fails with a permanent error or runs out of retries
+func (r *RetryStore) do(ctx context.Context, op string, fn func() error) error {
+	backoff := r.config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.config.MaxRetries || !isTransient(err) {
+			return err
+		}
+
+		log.FromContext(ctx).V(1).Info("retrying store call after transient error",
+			"operation", op, "attempt", attempt+1, "backoff", backoff, "error", err.Error())
+		metrics.RecordStoreRetry(op)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if r.config.MaxBackoff > 0 && backoff > r.config.MaxBackoff {
+			backoff = r.config.MaxBackoff
+		}
+	}
+}
+
+// transientMessages are error message fragments of transient database errors.
+// Drivers are matched by message so the store does not depend on their error types.
+var transientMessages = []string{
+	"deadlock",                           // postgres 40P01, mysql 1213
+	"could not serialize access",         // postgres 40001
+	"sqlstate 40001",                     // postgres serialization failure
+	"sqlstate 40p01",                     // postgres deadlock
+	"sqlstate 08",                        // postgres connection exceptions
+	"sqlstate 57p01",                     // postgres admin shutdown
+	"lock wait timeout exceeded",         // mysql 1205
+	"database is locked",                 // sqlite busy
+	"server closed the connection",       // postgres
+	"connection reset",                   // any
+	"broken pipe",                        // any
+	"bad connection",                     // database/sql
+	"invalid connection",                 // mysql
+	"conn closed",                        // pgx
+	"unexpected eof",                     // any
+	"the database system is starting up", // postgres restart
+}
+
+// isTransient reports whether err is a database error worth retrying.
+// Context cancellation and timeouts are never retried.
+func isTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range transientMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordExecution implements Store
+func (r *RetryStore) RecordExecution(ctx context.Context, exec Execution) error {
+	return r.do(ctx, "RecordExecution", func() error {
+		return r.Store.RecordExecution(ctx, exec)
+	})
+}
+
+// RecordExecutions implements Store
+func (r *RetryStore) RecordExecutions(ctx context.Context, execs []Execution) error {
+	return r.do(ctx, "RecordExecutions", func() error {
+		return r.Store.RecordExecutions(ctx, execs)
+	})
+}
+
+// GetExecutions implements Store
+func (r *RetryStore) GetExecutions(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []Execution, err error) {
+	err = r.do(ctx, "GetExecutions", func() (err error) {
+		result, err = r.Store.GetExecutions(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionsPaginated implements Store
+func (r *RetryStore) GetExecutionsPaginated(ctx context.Context, cronJob types.NamespacedName, since time.Time, limit, offset int) (result []Execution, total int64, err error) {
+	err = r.do(ctx, "GetExecutionsPaginated", func() (err error) {
+		result, total, err = r.Store.GetExecutionsPaginated(ctx, cronJob, since, limit, offset)
+		return err
+	})
+	return result, total, err
+}
+
+// GetExecutionsFiltered implements Store
+func (r *RetryStore) GetExecutionsFiltered(ctx context.Context, cronJob types.NamespacedName, since time.Time, status string, limit, offset int) (result []Execution, total int64, err error) {
+	err = r.do(ctx, "GetExecutionsFiltered", func() (err error) {
+		result, total, err = r.Store.GetExecutionsFiltered(ctx, cronJob, since, status, limit, offset)
+		return err
+	})
+	return result, total, err
+}
+
+// GetLastExecution implements Store
+func (r *RetryStore) GetLastExecution(ctx context.Context, cronJob types.NamespacedName) (result *Execution, err error) {
+	err = r.do(ctx, "GetLastExecution", func() (err error) {
+		result, err = r.Store.GetLastExecution(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetLastSuccessfulExecution implements Store
+func (r *RetryStore) GetLastSuccessfulExecution(ctx context.Context, cronJob types.NamespacedName) (result *Execution, err error) {
+	err = r.do(ctx, "GetLastSuccessfulExecution", func() (err error) {
+		result, err = r.Store.GetLastSuccessfulExecution(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetFailureStreak implements Store
+func (r *RetryStore) GetFailureStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (result int, err error) {
+	err = r.do(ctx, "GetFailureStreak", func() (err error) {
+		result, err = r.Store.GetFailureStreak(ctx, cronJob, before)
+		return err
+	})
+	return result, err
+}
+
+// GetOutcomeChanges implements Store
+func (r *RetryStore) GetOutcomeChanges(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []Execution, err error) {
+	err = r.do(ctx, "GetOutcomeChanges", func() (err error) {
+		result, err = r.Store.GetOutcomeChanges(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// GetSuccessStreak implements Store
+func (r *RetryStore) GetSuccessStreak(ctx context.Context, cronJob types.NamespacedName, before time.Time) (result SuccessStreak, err error) {
+	err = r.do(ctx, "GetSuccessStreak", func() (err error) {
+		result, err = r.Store.GetSuccessStreak(ctx, cronJob, before)
+		return err
+	})
+	return result, err
+}
+
+// GetOutputSizes implements Store
+func (r *RetryStore) GetOutputSizes(ctx context.Context, cronJob types.NamespacedName, limit int) (result []float64, err error) {
+	err = r.do(ctx, "GetOutputSizes", func() (err error) {
+		result, err = r.Store.GetOutputSizes(ctx, cronJob, limit)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionByJobName implements Store
+func (r *RetryStore) GetExecutionByJobName(ctx context.Context, namespace, jobName string) (result *Execution, err error) {
+	err = r.do(ctx, "GetExecutionByJobName", func() (err error) {
+		result, err = r.Store.GetExecutionByJobName(ctx, namespace, jobName)
+		return err
+	})
+	return result, err
+}
+
+// GetMetrics implements Store
+func (r *RetryStore) GetMetrics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result *Metrics, err error) {
+	err = r.do(ctx, "GetMetrics", func() (err error) {
+		result, err = r.Store.GetMetrics(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetDurationPercentile implements Store
+func (r *RetryStore) GetDurationPercentile(ctx context.Context, cronJob types.NamespacedName, percentile int, windowDays int) (result time.Duration, err error) {
+	err = r.do(ctx, "GetDurationPercentile", func() (err error) {
+		result, err = r.Store.GetDurationPercentile(ctx, cronJob, percentile, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetSuccessRate implements Store
+func (r *RetryStore) GetSuccessRate(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result float64, err error) {
+	err = r.do(ctx, "GetSuccessRate", func() (err error) {
+		result, err = r.Store.GetSuccessRate(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionAnalytics implements Store
+func (r *RetryStore) GetExecutionAnalytics(ctx context.Context, cronJob types.NamespacedName, windowDays int) (result *ExecutionAnalytics, err error) {
+	err = r.do(ctx, "GetExecutionAnalytics", func() (err error) {
+		result, err = r.Store.GetExecutionAnalytics(ctx, cronJob, windowDays)
+		return err
+	})
+	return result, err
+}
+
+// RollUpExecutions implements Store
+func (r *RetryStore) RollUpExecutions(ctx context.Context, from, to time.Time) (result int, err error) {
+	err = r.do(ctx, "RollUpExecutions", func() (err error) {
+		result, err = r.Store.RollUpExecutions(ctx, from, to)
+		return err
+	})
+	return result, err
+}
+
+// GetDailyRollups implements Store
+func (r *RetryStore) GetDailyRollups(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result []DailyRollup, err error) {
+	err = r.do(ctx, "GetDailyRollups", func() (err error) {
+		result, err = r.Store.GetDailyRollups(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// LatestRollupDay implements Store
+func (r *RetryStore) LatestRollupDay(ctx context.Context) (result time.Time, err error) {
+	err = r.do(ctx, "LatestRollupDay", func() (err error) {
+		result, err = r.Store.LatestRollupDay(ctx)
+		return err
+	})
+	return result, err
+}
+
+// PruneRollups implements Store
+func (r *RetryStore) PruneRollups(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = r.do(ctx, "PruneRollups", func() (err error) {
+		result, err = r.Store.PruneRollups(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// GetCronJobUsage implements Store
+func (r *RetryStore) GetCronJobUsage(ctx context.Context, cronJob types.NamespacedName, since time.Time) (result *CronJobUsage, err error) {
+	err = r.do(ctx, "GetCronJobUsage", func() (err error) {
+		result, err = r.Store.GetCronJobUsage(ctx, cronJob, since)
+		return err
+	})
+	return result, err
+}
+
+// ListCronJobUsage implements Store
+func (r *RetryStore) ListCronJobUsage(ctx context.Context, since time.Time) (result []CronJobUsage, err error) {
+	err = r.do(ctx, "ListCronJobUsage", func() (err error) {
+		result, err = r.Store.ListCronJobUsage(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// Prune implements Store
+func (r *RetryStore) Prune(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (result int64, err error) {
+	err = r.do(ctx, "Prune", func() (err error) {
+		result, err = r.Store.Prune(ctx, olderThan, overrides...)
+		return err
+	})
+	return result, err
+}
+
+// PruneLogs implements Store
+func (r *RetryStore) PruneLogs(ctx context.Context, olderThan time.Time, overrides ...RetentionOverride) (result int64, err error) {
+	err = r.do(ctx, "PruneLogs", func() (err error) {
+		result, err = r.Store.PruneLogs(ctx, olderThan, overrides...)
+		return err
+	})
+	return result, err
+}
+
+// DeleteExecutionsByCronJob implements Store
+func (r *RetryStore) DeleteExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = r.do(ctx, "DeleteExecutionsByCronJob", func() (err error) {
+		result, err = r.Store.DeleteExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// DeleteExecutionsByUID implements Store
+func (r *RetryStore) DeleteExecutionsByUID(ctx context.Context, cronJob types.NamespacedName, uid string) (result int64, err error) {
+	err = r.do(ctx, "DeleteExecutionsByUID", func() (err error) {
+		result, err = r.Store.DeleteExecutionsByUID(ctx, cronJob, uid)
+		return err
+	})
+	return result, err
+}
+
+// TrashExecutionsByCronJob implements Store
+func (r *RetryStore) TrashExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = r.do(ctx, "TrashExecutionsByCronJob", func() (err error) {
+		result, err = r.Store.TrashExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// RestoreExecutionsByCronJob implements Store
+func (r *RetryStore) RestoreExecutionsByCronJob(ctx context.Context, cronJob types.NamespacedName) (result int64, err error) {
+	err = r.do(ctx, "RestoreExecutionsByCronJob", func() (err error) {
+		result, err = r.Store.RestoreExecutionsByCronJob(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// PurgeTrash implements Store
+func (r *RetryStore) PurgeTrash(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = r.do(ctx, "PurgeTrash", func() (err error) {
+		result, err = r.Store.PurgeTrash(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// GetCronJobUIDs implements Store
+func (r *RetryStore) GetCronJobUIDs(ctx context.Context, cronJob types.NamespacedName) (result []string, err error) {
+	err = r.do(ctx, "GetCronJobUIDs", func() (err error) {
+		result, err = r.Store.GetCronJobUIDs(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionCount implements Store
+func (r *RetryStore) GetExecutionCount(ctx context.Context) (result int64, err error) {
+	err = r.do(ctx, "GetExecutionCount", func() (err error) {
+		result, err = r.Store.GetExecutionCount(ctx)
+		return err
+	})
+	return result, err
+}
+
+// GetExecutionCountSince implements Store
+func (r *RetryStore) GetExecutionCountSince(ctx context.Context, since time.Time) (result int64, err error) {
+	err = r.do(ctx, "GetExecutionCountSince", func() (err error) {
+		result, err = r.Store.GetExecutionCountSince(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// GetFailedExecutionsSince implements Store
+func (r *RetryStore) GetFailedExecutionsSince(ctx context.Context, since time.Time, limit int) (result []Execution, err error) {
+	err = r.do(ctx, "GetFailedExecutionsSince", func() (err error) {
+		result, err = r.Store.GetFailedExecutionsSince(ctx, since, limit)
+		return err
+	})
+	return result, err
+}
+
+// ExportExecutions implements Store
+func (r *RetryStore) ExportExecutions(ctx context.Context, afterID int64, limit int) (result []Execution, err error) {
+	err = r.do(ctx, "ExportExecutions", func() (err error) {
+		result, err = r.Store.ExportExecutions(ctx, afterID, limit)
+		return err
+	})
+	return result, err
+}
+
+// StoreAlert implements Store
+func (r *RetryStore) StoreAlert(ctx context.Context, alert AlertHistory) error {
+	return r.do(ctx, "StoreAlert", func() error {
+		return r.Store.StoreAlert(ctx, alert)
+	})
+}
+
+// ListAlertHistory implements Store
+func (r *RetryStore) ListAlertHistory(ctx context.Context, query AlertHistoryQuery) (result []AlertHistory, total int64, err error) {
+	err = r.do(ctx, "ListAlertHistory", func() (err error) {
+		result, total, err = r.Store.ListAlertHistory(ctx, query)
+		return err
+	})
+	return result, total, err
+}
+
+// ExportAlertHistory implements Store
+func (r *RetryStore) ExportAlertHistory(ctx context.Context, afterID int64, limit int) (result []AlertHistory, err error) {
+	err = r.do(ctx, "ExportAlertHistory", func() (err error) {
+		result, err = r.Store.ExportAlertHistory(ctx, afterID, limit)
+		return err
+	})
+	return result, err
+}
+
+// ResolveAlert implements Store
+func (r *RetryStore) ResolveAlert(ctx context.Context, alertType, cronJobNs, cronJobName string) error {
+	return r.do(ctx, "ResolveAlert", func() error {
+		return r.Store.ResolveAlert(ctx, alertType, cronJobNs, cronJobName)
+	})
+}
+
+// GetChannelAlertStats implements Store
+func (r *RetryStore) GetChannelAlertStats(ctx context.Context) (result map[string]ChannelAlertStats, err error) {
+	err = r.do(ctx, "GetChannelAlertStats", func() (err error) {
+		result, err = r.Store.GetChannelAlertStats(ctx)
+		return err
+	})
+	return result, err
+}
+
+// SaveChannelStats implements Store
+func (r *RetryStore) SaveChannelStats(ctx context.Context, stats ChannelStatsRecord) error {
+	return r.do(ctx, "SaveChannelStats", func() error {
+		return r.Store.SaveChannelStats(ctx, stats)
+	})
+}
+
+// GetChannelStats implements Store
+func (r *RetryStore) GetChannelStats(ctx context.Context, channelName string) (result *ChannelStatsRecord, err error) {
+	err = r.do(ctx, "GetChannelStats", func() (err error) {
+		result, err = r.Store.GetChannelStats(ctx, channelName)
+		return err
+	})
+	return result, err
+}
+
+// GetAllChannelStats implements Store
+func (r *RetryStore) GetAllChannelStats(ctx context.Context) (result map[string]*ChannelStatsRecord, err error) {
+	err = r.do(ctx, "GetAllChannelStats", func() (err error) {
+		result, err = r.Store.GetAllChannelStats(ctx)
+		return err
+	})
+	return result, err
+}
+
+// EnqueueDelivery implements Store
+func (r *RetryStore) EnqueueDelivery(ctx context.Context, delivery AlertDelivery) error {
+	return r.do(ctx, "EnqueueDelivery", func() error {
+		return r.Store.EnqueueDelivery(ctx, delivery)
+	})
+}
+
+// GetDueDeliveries implements Store
+func (r *RetryStore) GetDueDeliveries(ctx context.Context, now time.Time, limit int) (result []AlertDelivery, err error) {
+	err = r.do(ctx, "GetDueDeliveries", func() (err error) {
+		result, err = r.Store.GetDueDeliveries(ctx, now, limit)
+		return err
+	})
+	return result, err
+}
+
+// UpdateDelivery implements Store
+func (r *RetryStore) UpdateDelivery(ctx context.Context, delivery AlertDelivery) error {
+	return r.do(ctx, "UpdateDelivery", func() error {
+		return r.Store.UpdateDelivery(ctx, delivery)
+	})
+}
+
+// ClaimDeliveryHistory implements Store
+func (r *RetryStore) ClaimDeliveryHistory(ctx context.Context, alertID string) (result bool, err error) {
+	err = r.do(ctx, "ClaimDeliveryHistory", func() (err error) {
+		result, err = r.Store.ClaimDeliveryHistory(ctx, alertID)
+		return err
+	})
+	return result, err
+}
+
+// ListDeliveries implements Store
+func (r *RetryStore) ListDeliveries(ctx context.Context, query AlertDeliveryQuery) (result []AlertDelivery, total int64, err error) {
+	err = r.do(ctx, "ListDeliveries", func() (err error) {
+		result, total, err = r.Store.ListDeliveries(ctx, query)
+		return err
+	})
+	return result, total, err
+}
+
+// PruneDeliveries implements Store
+func (r *RetryStore) PruneDeliveries(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = r.do(ctx, "PruneDeliveries", func() (err error) {
+		result, err = r.Store.PruneDeliveries(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// SaveAlertState implements Store
+func (r *RetryStore) SaveAlertState(ctx context.Context, state AlertState) error {
+	return r.do(ctx, "SaveAlertState", func() error {
+		return r.Store.SaveAlertState(ctx, state)
+	})
+}
+
+// DeleteAlertStates implements Store
+func (r *RetryStore) DeleteAlertStates(ctx context.Context, alertKeys []string) error {
+	return r.do(ctx, "DeleteAlertStates", func() error {
+		return r.Store.DeleteAlertStates(ctx, alertKeys)
+	})
+}
+
+// ListAlertStates implements Store
+func (r *RetryStore) ListAlertStates(ctx context.Context, since time.Time) (result []AlertState, err error) {
+	err = r.do(ctx, "ListAlertStates", func() (err error) {
+		result, err = r.Store.ListAlertStates(ctx, since)
+		return err
+	})
+	return result, err
+}
+
+// PruneAlertStates implements Store
+func (r *RetryStore) PruneAlertStates(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = r.do(ctx, "PruneAlertStates", func() (err error) {
+		result, err = r.Store.PruneAlertStates(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// ClaimAlert implements Store
+func (r *RetryStore) ClaimAlert(ctx context.Context, claim AlertClaim, window time.Duration) (result bool, err error) {
+	err = r.do(ctx, "ClaimAlert", func() (err error) {
+		result, err = r.Store.ClaimAlert(ctx, claim, window)
+		return err
+	})
+	return result, err
+}
+
+// DeleteAlertClaims implements Store
+func (r *RetryStore) DeleteAlertClaims(ctx context.Context, alertKeys []string) error {
+	return r.do(ctx, "DeleteAlertClaims", func() error {
+		return r.Store.DeleteAlertClaims(ctx, alertKeys)
+	})
+}
+
+// PruneAlertClaims implements Store
+func (r *RetryStore) PruneAlertClaims(ctx context.Context, olderThan time.Time) (result int64, err error) {
+	err = r.do(ctx, "PruneAlertClaims", func() (err error) {
+		result, err = r.Store.PruneAlertClaims(ctx, olderThan)
+		return err
+	})
+	return result, err
+}
+
+// RecordReceipt implements Store
+func (r *RetryStore) RecordReceipt(ctx context.Context, receipt AlertReceipt) error {
+	return r.do(ctx, "RecordReceipt", func() error {
+		return r.Store.RecordReceipt(ctx, receipt)
+	})
+}
+
+// ListReceipts implements Store
+func (r *RetryStore) ListReceipts(ctx context.Context, alertIDs []string) (result []AlertReceipt, err error) {
+	err = r.do(ctx, "ListReceipts", func() (err error) {
+		result, err = r.Store.ListReceipts(ctx, alertIDs)
+		return err
+	})
+	return result, err
+}
+
+// SaveView implements Store
+func (r *RetryStore) SaveView(ctx context.Context, view SavedView) error {
+	return r.do(ctx, "SaveView", func() error {
+		return r.Store.SaveView(ctx, view)
+	})
+}
+
+// GetView implements Store
+func (r *RetryStore) GetView(ctx context.Context, name string) (result *SavedView, err error) {
+	err = r.do(ctx, "GetView", func() (err error) {
+		result, err = r.Store.GetView(ctx, name)
+		return err
+	})
+	return result, err
+}
+
+// ListViews implements Store
+func (r *RetryStore) ListViews(ctx context.Context) (result []SavedView, err error) {
+	err = r.do(ctx, "ListViews", func() (err error) {
+		result, err = r.Store.ListViews(ctx)
+		return err
+	})
+	return result, err
+}
+
+// DeleteView implements Store
+func (r *RetryStore) DeleteView(ctx context.Context, name string) (result bool, err error) {
+	err = r.do(ctx, "DeleteView", func() (err error) {
+		result, err = r.Store.DeleteView(ctx, name)
+		return err
+	})
+	return result, err
+}
+
+// SetLegalHold implements Store
+func (r *RetryStore) SetLegalHold(ctx context.Context, hold LegalHold) error {
+	return r.do(ctx, "SetLegalHold", func() error {
+		return r.Store.SetLegalHold(ctx, hold)
+	})
+}
+
+// ReleaseLegalHold implements Store
+func (r *RetryStore) ReleaseLegalHold(ctx context.Context, cronJob types.NamespacedName) (result bool, err error) {
+	err = r.do(ctx, "ReleaseLegalHold", func() (err error) {
+		result, err = r.Store.ReleaseLegalHold(ctx, cronJob)
+		return err
+	})
+	return result, err
+}
+
+// ListLegalHolds implements Store
+func (r *RetryStore) ListLegalHolds(ctx context.Context) (result []LegalHold, err error) {
+	err = r.do(ctx, "ListLegalHolds", func() (err error) {
+		result, err = r.Store.ListLegalHolds(ctx)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// flakyStore fails RecordExecution with err for the first failures calls
+type flakyStore struct {
+	*GormStore
+	err      error
+	failures int
+	calls    int
+}
+
+func (f *flakyStore) RecordExecution(ctx context.Context, exec Execution) error {
+	f.calls++
+	if f.calls <= f.failures {
+		return f.err
+	}
+	return f.GormStore.RecordExecution(ctx, exec)
+}
+
+func newFlakyStore(t *testing.T, err error, failures int) *flakyStore {
+	t.Helper()
+	s, openErr := NewGormStore("sqlite", "file::memory:")
+	require.NoError(t, openErr)
+	require.NoError(t, s.Init())
+	t.Cleanup(func() { _ = s.Close() })
+	return &flakyStore{GormStore: s, err: err, failures: failures}
+}
+
+var testRetryConfig = RetryConfig{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func TestRetryStore_RetriesTransientErrors(t *testing.T) {
+	ctx := context.Background()
+	flaky := newFlakyStore(t, errors.New("ERROR: deadlock detected (SQLSTATE 40P01)"), 2)
+	s := NewRetryStore(flaky, testRetryConfig)
+
+	cronJob := types.NamespacedName{Namespace: "default", Name: "flaky-cron"}
+	require.NoError(t, s.RecordExecution(ctx, Execution{
+		CronJobNamespace: cronJob.Namespace,
+		CronJobName:      cronJob.Name,
+		JobName:          "flaky-cron-1",
+		StartTime:        time.Now(),
+		Succeeded:        true,
+	}))
+	assert.Equal(t, 3, flaky.calls)
+
+	last, err := s.GetLastExecution(ctx, cronJob)
+	require.NoError(t, err)
+	assert.Equal(t, "flaky-cron-1", last.JobName)
+}
+
+func TestRetryStore_GivesUp(t *testing.T) {
+	ctx := context.Background()
+
+	// Out of retries
+	flaky := newFlakyStore(t, driver.ErrBadConn, 10)
+	err := NewRetryStore(flaky, testRetryConfig).RecordExecution(ctx, Execution{JobName: "job"})
+	assert.ErrorIs(t, err, driver.ErrBadConn)
+	assert.Equal(t, 4, flaky.calls)
+
+	// Permanent errors are not retried
+	flaky = newFlakyStore(t, errors.New("ERROR: column \"foo\" does not exist (SQLSTATE 42703)"), 10)
+	err = NewRetryStore(flaky, testRetryConfig).RecordExecution(ctx, Execution{JobName: "job"})
+	assert.ErrorContains(t, err, "42703")
+	assert.Equal(t, 1, flaky.calls)
+
+	// Nor are cancelled calls
+	flaky = newFlakyStore(t, fmt.Errorf("query failed: %w", context.Canceled), 10)
+	err = NewRetryStore(flaky, testRetryConfig).RecordExecution(ctx, Execution{JobName: "job"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, flaky.calls)
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("Error 1213 (40001): Deadlock found when trying to get lock; try restarting transaction"), true},
+		{errors.New("Error 1205 (HY000): Lock wait timeout exceeded; try restarting transaction"), true},
+		{errors.New("ERROR: could not serialize access due to concurrent update (SQLSTATE 40001)"), true},
+		{errors.New("FATAL: terminating connection due to administrator command (SQLSTATE 57P01)"), true},
+		{errors.New("database is locked (5) (SQLITE_BUSY)"), true},
+		{fmt.Errorf("failed to insert: %w", driver.ErrBadConn), true},
+		{errors.New("read tcp 10.0.0.1:5432: read: connection reset by peer"), true},
+		{errors.New("record not found"), false},
+		{errors.New("ERROR: duplicate key value violates unique constraint (SQLSTATE 23505)"), false},
+		{context.DeadlineExceeded, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isTransient(tt.err), "%v", tt.err)
+	}
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// rollupDayLayout is the format of DailyRollup.Day
+const rollupDayLayout = "2006-01-02"
+
+// rollupKey identifies a CronJob's roll-up of one day
+type rollupKey struct {
+	cluster, namespace, name, day string
+}
+
+// RollUpExecutions writes the daily roll-ups of the UTC days from from to to,
+// inclusive, for every cluster and CronJob, replacing existing roll-ups of
+// those days. It returns the number of roll-ups written.
+func (s *GormStore) RollUpExecutions(ctx context.Context, from, to time.Time) (int, error) {
+	start := from.UTC().Truncate(24 * time.Hour)
+	end := to.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	if !start.Before(end) {
+		return 0, nil
+	}
+
+	day := s.dayExpr()
+	var rollups []DailyRollup
+	if err := s.conn().WithContext(ctx).Model(&Execution{}).
+		Where("start_time >= ? AND start_time < ?", start, end).
+		Select(day + ` AS day, cluster, cronjob_ns, cronjob_name,
+			COUNT(*) AS runs,
+			SUM(CASE WHEN succeeded THEN 1 ELSE 0 END) AS successes,
+			COALESCE(SUM(duration_secs), 0) AS total_runtime_secs`).
+		Group("cluster, cronjob_ns, cronjob_name, " + day).
+		Scan(&rollups).Error; err != nil {
+		return 0, fmt.Errorf("roll-up counts: %w", err)
+	}
+
+	percentiles, err := s.rollupPercentiles(ctx, start, end)
+	if err != nil {
+		return 0, fmt.Errorf("roll-up percentiles: %w", err)
+	}
+	for i := range rollups {
+		r := &rollups[i]
+		if p, ok := percentiles[rollupKey{r.Cluster, r.CronJobNamespace, r.CronJobName, r.Day}]; ok {
+			r.P50Secs, r.P95Secs, r.P99Secs = p.P50Secs, p.P95Secs, p.P99Secs
+		}
+	}
+
+	err = s.conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("day >= ? AND day < ?", start.Format(rollupDayLayout), end.Format(rollupDayLayout)).
+			Delete(&DailyRollup{}).Error; err != nil {
+			return err
+		}
+		if len(rollups) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&rollups, 500).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(rollups), nil
+}
+
+// rollupPercentiles computes p50, p95 and p99 run durations per cluster,
+// CronJob and UTC day of executions started between start and end
+func (s *GormStore) rollupPercentiles(ctx context.Context, start, end time.Time) (map[rollupKey]DailyRollup, error) {
+	day := s.dayExpr()
+	var rows []DailyRollup
+
+	if s.isPostgres() {
+		if err := s.conn().WithContext(ctx).Model(&Execution{}).
+			Where("start_time >= ? AND start_time < ? AND duration_secs IS NOT NULL", start, end).
+			Select(day + ` AS day, cluster, cronjob_ns, cronjob_name,
+				PERCENTILE_CONT(0.50) WITHIN GROUP (ORDER BY duration_secs) AS p50_secs,
+				PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY duration_secs) AS p95_secs,
+				PERCENTILE_CONT(0.99) WITHIN GROUP (ORDER BY duration_secs) AS p99_secs`).
+			Group("cluster, cronjob_ns, cronjob_name, " + day).
+			Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		partition := "cluster, cronjob_ns, cronjob_name, " + day
+		query := fmt.Sprintf(`SELECT day, cluster, cronjob_ns, cronjob_name,
+				%[1]s AS p50_secs, %[2]s AS p95_secs, %[3]s AS p99_secs
+			FROM (
+				SELECT %[4]s AS day, cluster, cronjob_ns, cronjob_name,
+					duration_secs AS d,
+					LEAD(duration_secs) OVER (PARTITION BY %[5]s ORDER BY duration_secs) AS next_d,
+					ROW_NUMBER() OVER (PARTITION BY %[5]s ORDER BY duration_secs) AS rn,
+					COUNT(*) OVER (PARTITION BY %[5]s) AS cnt
+				FROM executions
+				WHERE start_time >= ? AND start_time < ? AND duration_secs IS NOT NULL
+			) ranked
+			GROUP BY cluster, cronjob_ns, cronjob_name, day`,
+			windowedPercentileExpr(50), windowedPercentileExpr(95), windowedPercentileExpr(99), day, partition,
+		)
+		if err := s.conn().WithContext(ctx).Raw(query, start, end).Scan(&rows).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	percentiles := make(map[rollupKey]DailyRollup, len(rows))
+	for _, r := range rows {
+		percentiles[rollupKey{r.Cluster, r.CronJobNamespace, r.CronJobName, r.Day}] = r
+	}
+	return percentiles, nil
+}
+
+// GetDailyRollups returns a CronJob's daily roll-ups since a given time, oldest first
+func (s *GormStore) GetDailyRollups(ctx context.Context, cronJob types.NamespacedName, since time.Time) ([]DailyRollup, error) {
+	s = s.forRead()
+	var rollups []DailyRollup
+	err := s.scoped(ctx).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND day >= ?",
+			cronJob.Namespace, cronJob.Name, since.UTC().Format(rollupDayLayout)).
+		Order("day").
+		Find(&rollups).Error
+	return rollups, err
+}
+
+// LatestRollupDay returns the most recent day with roll-ups, zero if there are none
+func (s *GormStore) LatestRollupDay(ctx context.Context) (time.Time, error) {
+	var day *string
+	if err := s.conn().WithContext(ctx).Model(&DailyRollup{}).
+		Select("MAX(day)").
+		Scan(&day).Error; err != nil {
+		return time.Time{}, err
+	}
+	if day == nil || *day == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(rollupDayLayout, *day)
+}
+
+// PruneRollups removes roll-ups of days before the given time
+func (s *GormStore) PruneRollups(ctx context.Context, olderThan time.Time) (int64, error) {
+	result := s.conn().WithContext(ctx).
+		Where("day < ?", olderThan.UTC().Format(rollupDayLayout)).
+		Delete(&DailyRollup{})
+	return result.RowsAffected, result.Error
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 )
 
 // StoreTestSuite runs all store tests against SQLite
@@ -203,6 +206,34 @@ func (s *StoreTestSuite) TestRecordExecution_DuplicateJobName() {
 	assert.Len(s.T(), execs, 2)
 }
 
+func (s *StoreTestSuite) TestRecordExecutions_Batch() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "batched-cron"}
+
+	execs := make([]Execution, 0, 250)
+	for i := 0; i < 250; i++ {
+		execs = append(execs, Execution{
+			CronJobNamespace: cronJob.Namespace,
+			CronJobName:      cronJob.Name,
+			JobName:          "batched-cron-" + string(rune('A'+i)),
+			StartTime:        time.Now().Add(time.Duration(-i) * time.Minute),
+			Succeeded:        i%5 != 0,
+		})
+	}
+	require.NoError(s.T(), s.store.RecordExecutions(s.ctx, execs))
+
+	count, err := s.store.GetExecutionCount(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(250), count)
+
+	rate, err := s.store.GetSuccessRate(s.ctx, cronJob, 7)
+	require.NoError(s.T(), err)
+	assert.InDelta(s.T(), 80.0, rate, 0.01)
+}
+
+func (s *StoreTestSuite) TestRecordExecutions_Empty() {
+	require.NoError(s.T(), s.store.RecordExecutions(s.ctx, nil))
+}
+
 // =============================================================================
 // GetExecutions Tests
 // =============================================================================
@@ -362,6 +393,35 @@ func (s *StoreTestSuite) TestGetLastSuccessfulExecution() {
 	assert.True(s.T(), last.Succeeded)
 }
 
+func (s *StoreTestSuite) TestGetOutputSizes() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "output-cron"}
+	now := time.Now()
+
+	record := func(name string, age time.Duration, succeeded bool, size *float64) {
+		require.NoError(s.T(), s.store.RecordExecution(s.ctx, Execution{
+			CronJobNamespace: cronJob.Namespace,
+			CronJobName:      cronJob.Name,
+			JobName:          name,
+			StartTime:        now.Add(-age),
+			Succeeded:        succeeded,
+			OutputSize:       size,
+		}))
+	}
+	record("output-cron-1", 4*time.Hour, true, ptr.To(100.0))
+	record("output-cron-2", 3*time.Hour, true, ptr.To(200.0))
+	record("output-cron-3", 2*time.Hour, false, ptr.To(5.0)) // Failed runs are left out
+	record("output-cron-4", time.Hour, true, nil)            // As are runs without an output size
+	record("output-cron-5", 0, true, ptr.To(300.5))
+
+	sizes, err := s.store.GetOutputSizes(s.ctx, cronJob, 10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []float64{300.5, 200, 100}, sizes)
+
+	sizes, err = s.store.GetOutputSizes(s.ctx, cronJob, 2)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []float64{300.5, 200}, sizes)
+}
+
 func (s *StoreTestSuite) TestGetExecutionByJobName() {
 	cronJob := types.NamespacedName{Namespace: "default", Name: "job-lookup-cron"}
 
@@ -602,6 +662,221 @@ func (s *StoreTestSuite) TestGetDurationPercentile_NoExecutions() {
 	assert.Equal(s.T(), time.Duration(0), p50)
 }
 
+// The window-function queries used for MySQL are portable enough to run on SQLite
+func (s *StoreTestSuite) TestDurationStatsWindowed_Interpolates() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "windowed-cron"}
+
+	for i := 1; i <= 100; i++ {
+		duration := float64(i)
+		exec := Execution{
+			CronJobNamespace: cronJob.Namespace,
+			CronJobName:      cronJob.Name,
+			JobName:          "windowed-cron-" + string(rune('A'+i)),
+			StartTime:        time.Now().Add(time.Duration(-i) * time.Minute),
+			DurationSecs:     &duration,
+			Succeeded:        true,
+		}
+		require.NoError(s.T(), s.store.RecordExecution(s.ctx, exec))
+	}
+	since := time.Now().Add(-24 * time.Hour)
+
+	var stats durationStats
+	require.NoError(s.T(), s.store.getDurationStatsWindowed(s.ctx, cronJob, since, &stats))
+	assert.InDelta(s.T(), 50.5, stats.Avg, 0.001)
+	assert.InDelta(s.T(), 50.5, stats.P50, 0.001)
+	assert.InDelta(s.T(), 95.05, stats.P95, 0.001)
+	assert.InDelta(s.T(), 99.01, stats.P99, 0.001)
+
+	var p90 float64
+	require.NoError(s.T(), s.store.getDurationPercentileWindowed(s.ctx, cronJob, 90, since, &p90))
+	assert.InDelta(s.T(), 90.1, p90, 0.001)
+}
+
+func (s *StoreTestSuite) TestDurationStatsWindowed_NoExecutions() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "windowed-empty"}
+
+	var stats durationStats
+	require.NoError(s.T(), s.store.getDurationStatsWindowed(s.ctx, cronJob, time.Now().Add(-time.Hour), &stats))
+	assert.Equal(s.T(), durationStats{}, stats)
+}
+
+func (s *StoreTestSuite) TestGetExecutionAnalytics() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "analytics-cron"}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	day1 := today.AddDate(0, 0, -2).Add(10 * time.Hour)
+	day2 := today.AddDate(0, 0, -1).Add(14 * time.Hour)
+
+	runs := []struct {
+		start    time.Time
+		duration float64
+		ok       bool
+		exitCode int32
+		reason   string
+	}{
+		{day1, 10, true, 0, ""},
+		{day1.Add(time.Minute), 20, true, 0, ""},
+		{day1.Add(2 * time.Minute), 30, true, 0, ""},
+		{day2, 40, true, 0, ""},
+		{day2.Add(time.Minute), 50, false, 1, "BackoffLimitExceeded"},
+		{day2.Add(2 * time.Minute), 60, false, 137, "OOMKilled"},
+		{day2.Add(3 * time.Minute), 70, false, 1, "BackoffLimitExceeded"},
+	}
+	for i, run := range runs {
+		duration := run.duration
+		exec := Execution{
+			CronJobNamespace: cronJob.Namespace,
+			CronJobName:      cronJob.Name,
+			JobName:          "analytics-cron-" + string(rune('A'+i)),
+			StartTime:        run.start,
+			DurationSecs:     &duration,
+			Succeeded:        run.ok,
+			ExitCode:         run.exitCode,
+			Reason:           run.reason,
+		}
+		require.NoError(s.T(), s.store.RecordExecution(s.ctx, exec))
+	}
+
+	a, err := s.store.GetExecutionAnalytics(s.ctx, cronJob, 7)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 7, a.WindowDays)
+
+	assert.Equal(s.T(), []ExitCodeCount{{ExitCode: 0, Count: 4}, {ExitCode: 1, Count: 2}, {ExitCode: 137, Count: 1}}, a.ExitCodes)
+	assert.Equal(s.T(), []ReasonCount{{Reason: "BackoffLimitExceeded", Count: 2}, {Reason: "OOMKilled", Count: 1}}, a.FailureReasons)
+
+	require.Len(s.T(), a.DurationTrend, 2)
+	assert.Equal(s.T(), day1.Format("2006-01-02"), a.DurationTrend[0].Day)
+	assert.Equal(s.T(), int64(3), a.DurationTrend[0].Runs)
+	assert.InDelta(s.T(), 20, a.DurationTrend[0].P50Seconds, 0.001)
+	assert.InDelta(s.T(), 29, a.DurationTrend[0].P95Seconds, 0.001)
+	assert.Equal(s.T(), day2.Format("2006-01-02"), a.DurationTrend[1].Day)
+	assert.Equal(s.T(), int64(4), a.DurationTrend[1].Runs)
+	assert.InDelta(s.T(), 55, a.DurationTrend[1].P50Seconds, 0.001)
+	assert.InDelta(s.T(), 68.5, a.DurationTrend[1].P95Seconds, 0.001)
+
+	assert.ElementsMatch(s.T(), []HeatmapCell{
+		{DayOfWeek: int(day1.Weekday()), Hour: 10, Total: 3, Succeeded: 3},
+		{DayOfWeek: int(day2.Weekday()), Hour: 14, Total: 4, Succeeded: 1},
+	}, a.Heatmap)
+}
+
+func (s *StoreTestSuite) TestGetExecutionAnalytics_NoExecutions() {
+	a, err := s.store.GetExecutionAnalytics(s.ctx, types.NamespacedName{Namespace: "default", Name: "none"}, 30)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), a.ExitCodes)
+	assert.Empty(s.T(), a.FailureReasons)
+	assert.Empty(s.T(), a.DurationTrend)
+	assert.Empty(s.T(), a.Heatmap)
+}
+
+func (s *StoreTestSuite) TestCronJobUsage() {
+	now := time.Now()
+	record := func(name string, start time.Time, duration *float64, cpuMilli, memory int64) {
+		require.NoError(s.T(), s.store.RecordExecution(s.ctx, Execution{
+			CronJobNamespace: "default",
+			CronJobName:      name,
+			JobName:          fmt.Sprintf("%s-%d", name, start.UnixNano()),
+			StartTime:        start,
+			DurationSecs:     duration,
+			Succeeded:        true,
+			CPURequestMilli:  cpuMilli,
+			MemoryRequest:    memory,
+		}))
+	}
+	secs := func(v float64) *float64 { return &v }
+
+	// 2 runs of 60s and 120s at 500m / 1Gi
+	record("etl", now.Add(-2*time.Hour), secs(60), 500, 1<<30)
+	record("etl", now.Add(-time.Hour), secs(120), 500, 1<<30)
+	// Still running, no duration
+	record("etl", now.Add(-time.Minute), nil, 500, 1<<30)
+	// Outside the window
+	record("etl", now.AddDate(0, 0, -10), secs(1000), 500, 1<<30)
+	// Long runtime without requests
+	record("report", now.Add(-time.Hour), secs(600), 0, 0)
+
+	since := now.AddDate(0, 0, -7)
+	usage, err := s.store.GetCronJobUsage(s.ctx, types.NamespacedName{Namespace: "default", Name: "etl"}, since)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "etl", usage.CronJobName)
+	assert.Equal(s.T(), int64(2), usage.Runs)
+	assert.InDelta(s.T(), 180, usage.RuntimeSeconds, 0.001)
+	assert.InDelta(s.T(), 90, usage.CPUCoreSeconds, 0.001)
+	assert.InDelta(s.T(), 180, usage.MemoryGiBSeconds, 0.001)
+
+	all, err := s.store.ListCronJobUsage(s.ctx, since)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), all, 2)
+	assert.Equal(s.T(), "etl", all[0].CronJobName)
+	assert.Equal(s.T(), "report", all[1].CronJobName)
+	assert.InDelta(s.T(), 600, all[1].RuntimeSeconds, 0.001)
+	assert.Zero(s.T(), all[1].CPUCoreSeconds)
+
+	none, err := s.store.GetCronJobUsage(s.ctx, types.NamespacedName{Namespace: "default", Name: "none"}, since)
+	require.NoError(s.T(), err)
+	assert.Zero(s.T(), none.Runs)
+	assert.Zero(s.T(), none.RuntimeSeconds)
+}
+
+func (s *StoreTestSuite) TestGetFailedExecutionsSince() {
+	now := time.Now()
+	logs := "boom"
+	execs := []Execution{
+		{CronJobNamespace: "ns-a", CronJobName: "a", JobName: "a-1", StartTime: now.Add(-2 * time.Hour), Succeeded: false},
+		{CronJobNamespace: "ns-a", CronJobName: "a", JobName: "a-2", StartTime: now.Add(-20 * time.Minute), Succeeded: false, Logs: &logs},
+		{CronJobNamespace: "ns-b", CronJobName: "b", JobName: "b-1", StartTime: now.Add(-30 * time.Minute), Succeeded: false},
+		{CronJobNamespace: "ns-b", CronJobName: "b", JobName: "b-2", StartTime: now.Add(-10 * time.Minute), Succeeded: true},
+	}
+	require.NoError(s.T(), s.store.RecordExecutions(s.ctx, execs))
+
+	failures, err := s.store.GetFailedExecutionsSince(s.ctx, now.Add(-time.Hour), 100)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), failures, 2)
+	assert.Equal(s.T(), "b-1", failures[0].JobName)
+	assert.Equal(s.T(), "a-2", failures[1].JobName)
+	assert.Nil(s.T(), failures[1].Logs)
+
+	failures, err = s.store.GetFailedExecutionsSince(s.ctx, now.Add(-time.Hour), 1)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), failures, 1)
+}
+
+func (s *StoreTestSuite) TestExportExecutions_PagesByID() {
+	now := time.Now()
+	var execs []Execution
+	for i := 0; i < 5; i++ {
+		execs = append(execs, Execution{CronJobNamespace: "default", CronJobName: "export", JobName: fmt.Sprintf("export-%d", i), StartTime: now.Add(-time.Duration(i) * time.Hour), Succeeded: true})
+	}
+	require.NoError(s.T(), s.store.RecordExecutions(s.ctx, execs))
+
+	page, err := s.store.ExportExecutions(s.ctx, 0, 3)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page, 3)
+	assert.Equal(s.T(), "export-0", page[0].JobName)
+
+	rest, err := s.store.ExportExecutions(s.ctx, page[2].ID, 3)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rest, 2)
+	assert.Equal(s.T(), "export-3", rest[0].JobName)
+	assert.Greater(s.T(), rest[0].ID, page[2].ID)
+}
+
+func (s *StoreTestSuite) TestExportAlertHistory_PagesByID() {
+	for i := 0; i < 3; i++ {
+		require.NoError(s.T(), s.store.StoreAlert(s.ctx, AlertHistory{Type: "JobFailed", Severity: "warning", Title: fmt.Sprintf("alert %d", i), OccurredAt: time.Now()}))
+	}
+
+	page, err := s.store.ExportAlertHistory(s.ctx, 0, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page, 2)
+	assert.Equal(s.T(), "alert 0", page[0].Title)
+
+	rest, err := s.store.ExportAlertHistory(s.ctx, page[1].ID, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rest, 1)
+	assert.Equal(s.T(), "alert 2", rest[0].Title)
+}
+
 func (s *StoreTestSuite) TestGetSuccessRate_WindowBoundary() {
 	cronJob := types.NamespacedName{Namespace: "default", Name: "boundary-cron"}
 
@@ -1001,6 +1276,52 @@ func (s *StoreTestSuite) TestListAlertHistory_FilterByType() {
 	}
 }
 
+func (s *StoreTestSuite) TestListAlertHistory_FilterByAlertID() {
+	for _, id := range []string{"a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", ""} {
+		alert := AlertHistory{
+			AlertID:          id,
+			Type:             "JobFailed",
+			Severity:         "critical",
+			Title:            "Alert",
+			CronJobNamespace: "default",
+			CronJobName:      "test-cron",
+			JobName:          "test-cron-29384720",
+			OccurredAt:       time.Now(),
+		}
+		require.NoError(s.T(), s.store.StoreAlert(s.ctx, alert))
+	}
+
+	alerts, total, err := s.store.ListAlertHistory(s.ctx, AlertHistoryQuery{AlertID: "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", Limit: 100})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), total)
+	require.Len(s.T(), alerts, 1)
+	assert.Equal(s.T(), "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", alerts[0].AlertID)
+	assert.Equal(s.T(), "test-cron-29384720", alerts[0].JobName)
+}
+
+func (s *StoreTestSuite) TestAlertReceipts() {
+	now := time.Now().UTC().Truncate(time.Second)
+	receipts := []AlertReceipt{
+		{AlertID: "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", Receiver: "siem", Status: ReceiptStatusProcessed, ReceivedAt: now},
+		{AlertID: "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", Receiver: "pagerduty", Status: ReceiptStatusFailed, Message: "no service", ReceivedAt: now.Add(-time.Minute)},
+		{AlertID: "5e7d2b18-9c43-4a0f-b6e2-8f1d3a9c4b72", Receiver: "siem", Status: ReceiptStatusProcessed, ReceivedAt: now},
+	}
+	for _, r := range receipts {
+		require.NoError(s.T(), s.store.RecordReceipt(s.ctx, r))
+	}
+
+	got, err := s.store.ListReceipts(s.ctx, []string{"a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), got, 2)
+	assert.Equal(s.T(), "pagerduty", got[0].Receiver, "oldest first")
+	assert.Equal(s.T(), "no service", got[0].Message)
+	assert.Equal(s.T(), "siem", got[1].Receiver)
+
+	got, err = s.store.ListReceipts(s.ctx, nil)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), got)
+}
+
 func (s *StoreTestSuite) TestResolveAlert() {
 	cronJob := types.NamespacedName{Namespace: "default", Name: "resolve-cron"}
 
@@ -1120,6 +1441,43 @@ func (s *StoreTestSuite) TestGetAllChannelStats() {
 	assert.Equal(s.T(), int64(30), allStats["channel-c"].AlertsSentTotal)
 }
 
+func (s *StoreTestSuite) TestSavedViews() {
+	view := SavedView{Name: "nightly", Team: "payments", LabelSelector: "schedule=nightly"}
+	view.SetNamespaces([]string{"payments", "billing"})
+	require.NoError(s.T(), s.store.SaveView(s.ctx, view))
+	require.NoError(s.T(), s.store.SaveView(s.ctx, SavedView{Name: "critical", Status: "critical"}))
+
+	retrieved, err := s.store.GetView(s.ctx, "nightly")
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), retrieved)
+	assert.Equal(s.T(), []string{"payments", "billing"}, retrieved.GetNamespaces())
+	created := retrieved.CreatedAt
+
+	// Saving under the same name replaces the view but keeps its creation time
+	view.Team = "finance"
+	require.NoError(s.T(), s.store.SaveView(s.ctx, view))
+	retrieved, err = s.store.GetView(s.ctx, "nightly")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "finance", retrieved.Team)
+	assert.True(s.T(), retrieved.CreatedAt.Equal(created))
+
+	views, err := s.store.ListViews(s.ctx)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), views, 2)
+	assert.Equal(s.T(), "critical", views[0].Name)
+
+	deleted, err := s.store.DeleteView(s.ctx, "nightly")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), deleted)
+	deleted, err = s.store.DeleteView(s.ctx, "nightly")
+	require.NoError(s.T(), err)
+	assert.False(s.T(), deleted)
+
+	retrieved, err = s.store.GetView(s.ctx, "nightly")
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), retrieved)
+}
+
 // =============================================================================
 // Multi-Backend & Health Tests
 // =============================================================================
@@ -1216,6 +1574,211 @@ func (s *StoreTestSuite) TestGetExecutionCountSince() {
 	assert.Equal(s.T(), int64(3), count)
 }
 
+// =============================================================================
+// Alert Delivery Queue Tests
+// =============================================================================
+
+func (s *StoreTestSuite) TestDeliveryQueue_DueAndUpdate() {
+	now := time.Now()
+
+	require.NoError(s.T(), s.store.EnqueueDelivery(s.ctx, AlertDelivery{
+		AlertKey:      "default/due-cron/JobFailed",
+		AlertType:     "JobFailed",
+		Severity:      "critical",
+		ChannelName:   "slack",
+		Payload:       "{}",
+		Attempts:      1,
+		NextAttemptAt: now.Add(-time.Minute),
+	}))
+	require.NoError(s.T(), s.store.EnqueueDelivery(s.ctx, AlertDelivery{
+		AlertKey:      "default/later-cron/JobFailed",
+		AlertType:     "JobFailed",
+		Severity:      "critical",
+		ChannelName:   "slack",
+		Payload:       "{}",
+		Attempts:      1,
+		NextAttemptAt: now.Add(time.Hour),
+	}))
+
+	due, err := s.store.GetDueDeliveries(s.ctx, now, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), due, 1)
+	assert.Equal(s.T(), "default/due-cron/JobFailed", due[0].AlertKey)
+	assert.Equal(s.T(), DeliveryStatusPending, due[0].Status)
+
+	delivered := due[0]
+	delivered.Status = DeliveryStatusDelivered
+	delivered.Attempts = 2
+	delivered.DeliveredAt = &now
+	require.NoError(s.T(), s.store.UpdateDelivery(s.ctx, delivered))
+
+	due, err = s.store.GetDueDeliveries(s.ctx, now, 10)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), due)
+
+	items, total, err := s.store.ListDeliveries(s.ctx, AlertDeliveryQuery{Status: DeliveryStatusDelivered})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), total)
+	require.Len(s.T(), items, 1)
+	assert.Equal(s.T(), int32(2), items[0].Attempts)
+	assert.NotNil(s.T(), items[0].DeliveredAt)
+}
+
+func (s *StoreTestSuite) TestPruneDeliveries_KeepsPending() {
+	for _, status := range []string{DeliveryStatusPending, DeliveryStatusDelivered, DeliveryStatusFailed} {
+		require.NoError(s.T(), s.store.EnqueueDelivery(s.ctx, AlertDelivery{
+			AlertKey:      "default/prune-cron/JobFailed",
+			AlertType:     "JobFailed",
+			Severity:      "critical",
+			ChannelName:   "slack",
+			Status:        status,
+			NextAttemptAt: time.Now(),
+		}))
+	}
+
+	count, err := s.store.PruneDeliveries(s.ctx, time.Now().Add(time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), count)
+
+	items, total, err := s.store.ListDeliveries(s.ctx, AlertDeliveryQuery{})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), total)
+	assert.Equal(s.T(), DeliveryStatusPending, items[0].Status)
+}
+
+// =============================================================================
+// Alert State Tests
+// =============================================================================
+
+func (s *StoreTestSuite) TestAlertState_SaveListDelete() {
+	now := time.Now()
+	require.NoError(s.T(), s.store.SaveAlertState(s.ctx, AlertState{
+		AlertKey:   "default/cron-a/JobFailed",
+		Payload:    `{"key":"default/cron-a/JobFailed"}`,
+		LastSentAt: now.Add(-time.Hour),
+	}))
+	require.NoError(s.T(), s.store.SaveAlertState(s.ctx, AlertState{
+		AlertKey:   "default/cron-b/JobFailed",
+		LastSentAt: now.Add(-48 * time.Hour),
+	}))
+
+	// Upsert keeps one row per alert key
+	require.NoError(s.T(), s.store.SaveAlertState(s.ctx, AlertState{
+		AlertKey:       "default/cron-a/JobFailed",
+		Payload:        `{"key":"default/cron-a/JobFailed"}`,
+		LastSentAt:     now.Add(-time.Hour),
+		AcknowledgedBy: "alice",
+	}))
+
+	states, err := s.store.ListAlertStates(s.ctx, now.Add(-24*time.Hour))
+	require.NoError(s.T(), err)
+	require.Len(s.T(), states, 1)
+	assert.Equal(s.T(), "alice", states[0].AcknowledgedBy)
+
+	pruned, err := s.store.PruneAlertStates(s.ctx, now.Add(-24*time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), pruned)
+
+	require.NoError(s.T(), s.store.DeleteAlertStates(s.ctx, []string{"default/cron-a/JobFailed"}))
+	states, err = s.store.ListAlertStates(s.ctx, time.Time{})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), states)
+}
+
+func (s *StoreTestSuite) TestAlertClaim_ClaimOncePerWindow() {
+	now := time.Now().Truncate(time.Second).UTC()
+	claim := AlertClaim{AlertKey: "default/cron-a/JobFailed", Signature: "app-error|", WindowStart: now, ClaimedBy: "pod-a"}
+
+	claimed, err := s.store.ClaimAlert(s.ctx, claim, time.Hour)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), claimed)
+
+	// A second replica loses the claim within the window, even past the hour
+	claim.ClaimedBy = "pod-b"
+	claim.WindowStart = now.Add(30 * time.Minute)
+	claimed, err = s.store.ClaimAlert(s.ctx, claim, time.Hour)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), claimed)
+
+	// A different error signature is a separate claim
+	other := claim
+	other.Signature = "oom|"
+	claimed, err = s.store.ClaimAlert(s.ctx, other, time.Hour)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), claimed)
+
+	// Once the window has passed the claim is taken over
+	claim.WindowStart = now.Add(time.Hour)
+	claimed, err = s.store.ClaimAlert(s.ctx, claim, time.Hour)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), claimed)
+
+	var claims []AlertClaim
+	require.NoError(s.T(), s.store.conn().Where("signature = ?", claim.Signature).Find(&claims).Error)
+	require.Len(s.T(), claims, 1)
+	assert.Equal(s.T(), "pod-b", claims[0].ClaimedBy)
+
+	pruned, err := s.store.PruneAlertClaims(s.ctx, now.Add(45*time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), pruned)
+
+	// Clearing the alert releases its claims
+	require.NoError(s.T(), s.store.DeleteAlertClaims(s.ctx, []string{claim.AlertKey}))
+	claimed, err = s.store.ClaimAlert(s.ctx, claim, time.Hour)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), claimed)
+}
+
+// =============================================================================
+// Remote Cluster Tests
+// =============================================================================
+
+func (s *StoreTestSuite) TestForCluster_ScopesExecutionsAndAlerts() {
+	cronJob := types.NamespacedName{Namespace: "default", Name: "report"}
+	remote := s.store.ForCluster("prod-eu")
+	now := time.Now()
+
+	require.NoError(s.T(), s.store.RecordExecution(s.ctx, Execution{
+		CronJobNamespace: cronJob.Namespace, CronJobName: cronJob.Name, JobName: "report-1",
+		StartTime: now.Add(-time.Hour), Succeeded: true,
+	}))
+	require.NoError(s.T(), remote.RecordExecution(s.ctx, Execution{
+		CronJobNamespace: cronJob.Namespace, CronJobName: cronJob.Name, JobName: "report-1",
+		StartTime: now, Succeeded: false,
+	}))
+	require.NoError(s.T(), remote.StoreAlert(s.ctx, AlertHistory{
+		Type: "JobFailed", Severity: "critical", Title: "failed",
+		CronJobNamespace: cronJob.Namespace, CronJobName: cronJob.Name, OccurredAt: now,
+	}))
+
+	local, err := s.store.GetLastExecution(s.ctx, cronJob)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), local.Succeeded)
+	assert.Empty(s.T(), local.Cluster)
+
+	last, err := remote.GetLastExecution(s.ctx, cronJob)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), last.Succeeded)
+	assert.Equal(s.T(), "prod-eu", last.Cluster)
+
+	metrics, err := remote.GetMetrics(s.ctx, cronJob, 7)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(1), metrics.TotalRuns)
+
+	alerts, _, err := s.store.ListAlertHistory(s.ctx, AlertHistoryQuery{Limit: 10})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), alerts)
+	alerts, _, err = remote.ListAlertHistory(s.ctx, AlertHistoryQuery{Limit: 10})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), alerts, 1)
+	assert.Equal(s.T(), "prod-eu", alerts[0].Cluster)
+
+	// Counts stay global
+	count, err := s.store.GetExecutionCount(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), count)
+}
+
 // =============================================================================
 // Model Method Tests
 // =============================================================================
@@ -1251,6 +1814,60 @@ func TestAlertHistory_ChannelsNotified(t *testing.T) {
 	assert.Equal(t, channels, alert.GetChannelsNotified())
 }
 
+func TestExecution_ImagesAndPodNames(t *testing.T) {
+	exec := &Execution{}
+	assert.Nil(t, exec.GetImages())
+	assert.Nil(t, exec.GetPodNames())
+
+	exec.SetImages([]string{"app:1.0", "sidecar:2.1"})
+	exec.SetPodNames([]string{"job-abc12"})
+	assert.Equal(t, "app:1.0,sidecar:2.1", exec.Images)
+	assert.Equal(t, []string{"app:1.0", "sidecar:2.1"}, exec.GetImages())
+	assert.Equal(t, []string{"job-abc12"}, exec.GetPodNames())
+}
+
+func TestPartitionName(t *testing.T) {
+	ts := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, "executions_p202403", partitionName(ts))
+
+	month, ok := parsePartitionName("executions_p202403")
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), month)
+
+	_, ok = parsePartitionName("executions_default")
+	assert.False(t, ok)
+	_, ok = parsePartitionName("executions_pbogus")
+	assert.False(t, ok)
+}
+
+func TestMonthStart(t *testing.T) {
+	ts := time.Date(2024, time.December, 31, 23, 59, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), monthStart(ts))
+}
+
+func TestSetPartitioning_PostgresOnly(t *testing.T) {
+	s, err := NewGormStore("sqlite", "file::memory:")
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	s.SetPartitioning(true)
+	require.NoError(t, s.Init())
+	assert.False(t, s.Partitioned())
+}
+
+func TestIsPostgres(t *testing.T) {
+	assert.True(t, (&GormStore{dialect: "postgres"}).isPostgres())
+	assert.True(t, (&GormStore{dialect: "timescale"}).isPostgres())
+	assert.False(t, (&GormStore{dialect: "sqlite"}).isPostgres())
+	assert.False(t, (&GormStore{dialect: "mysql"}).isPostgres())
+}
+
+func TestSetPartitioning_IgnoredForTimescale(t *testing.T) {
+	s := &GormStore{dialect: "timescale"}
+	s.SetPartitioning(true)
+	assert.False(t, s.partitioning)
+}
+
 func TestNewGormStore_UnsupportedDialect(t *testing.T) {
 	_, err := NewGormStore("unsupported", "some-dsn")
 	assert.Error(t, err)
@@ -1269,3 +1886,26 @@ func TestPercentile(t *testing.T) {
 	assert.InDelta(t, 5.0, percentile(data, 50), 1.0)
 	assert.InDelta(t, 9.0, percentile(data, 90), 1.0)
 }
+
+func TestGormStore_Reconnect(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "guardian.db")
+	st, err := NewGormStore("sqlite", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = st.Close() })
+	require.NoError(t, st.Init())
+	require.NoError(t, st.RecordExecution(ctx, Execution{CronJobNamespace: "default", CronJobName: "report", JobName: "report-1", StartTime: time.Now()}))
+
+	old := st.conn()
+	require.NoError(t, st.Reconnect(ctx, path))
+	assert.NotSame(t, old, st.conn())
+
+	count, err := st.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// A DSN that cannot connect keeps the current pool
+	current := st.conn()
+	assert.Error(t, st.Reconnect(ctx, filepath.Join(t.TempDir(), "missing", "guardian.db")))
+	assert.Same(t, current, st.conn())
+}
@@ -0,0 +1,742 @@
+// Package storetest is a conformance suite for store.Store implementations.
+// A storage backend registered with store.Register runs it from its own
+// tests to check it behaves like the built-in backends:
+//
+//	func TestConformance(t *testing.T) {
+//		storetest.Run(t, func(t *testing.T) store.Store {
+//			st, err := store.Open("clickhouse", store.BackendOptions{DSN: testDSN(t)})
+//			require.NoError(t, err)
+//			return st
+//		})
+//	}
+package storetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+// OpenFunc returns a new, empty and uninitialized store. Run initializes
+// and closes it.
+type OpenFunc func(t *testing.T) store.Store
+
+// Run runs the conformance suite, each test against a store from open
+func Run(t *testing.T, open OpenFunc) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, ctx context.Context, st store.Store)
+	}{
+		{"Executions", testExecutions},
+		{"ExecutionFilters", testExecutionFilters},
+		{"Metrics", testMetrics},
+		{"FailureStreak", testFailureStreak},
+		{"SuccessStreak", testSuccessStreak},
+		{"OutcomeChanges", testOutcomeChanges},
+		{"DeleteExecutions", testDeleteExecutions},
+		{"Prune", testPrune},
+		{"PruneOverrides", testPruneOverrides},
+		{"LegalHolds", testLegalHolds},
+		{"Trash", testTrash},
+		{"Rollups", testRollups},
+		{"Export", testExport},
+		{"AlertHistory", testAlertHistory},
+		{"ChannelStats", testChannelStats},
+		{"Deliveries", testDeliveries},
+		{"DeliveryHistoryClaim", testDeliveryHistoryClaim},
+		{"AlertStates", testAlertStates},
+		{"AlertClaims", testAlertClaims},
+		{"Receipts", testReceipts},
+		{"Views", testViews},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := open(t)
+			require.NoError(t, st.Init())
+			t.Cleanup(func() { _ = st.Close() })
+
+			ctx := context.Background()
+			require.NoError(t, st.Health(ctx))
+			tt.fn(t, ctx, st)
+		})
+	}
+}
+
+var backup = types.NamespacedName{Namespace: "default", Name: "backup"}
+
+// execution returns an execution of the backup CronJob that started ago
+// before now and ran for a minute
+func execution(jobName string, ago time.Duration, succeeded bool) store.Execution {
+	start := time.Now().Add(-ago).Truncate(time.Second)
+	duration := 60.0
+	return store.Execution{
+		CronJobNamespace: backup.Namespace,
+		CronJobName:      backup.Name,
+		CronJobUID:       "uid-1",
+		JobName:          jobName,
+		StartTime:        start,
+		CompletionTime:   start.Add(time.Minute),
+		DurationSecs:     &duration,
+		Succeeded:        succeeded,
+	}
+}
+
+func testExecutions(t *testing.T, ctx context.Context, st store.Store) {
+	last, err := st.GetLastExecution(ctx, backup)
+	require.NoError(t, err)
+	assert.Nil(t, last, "no executions yet")
+
+	require.NoError(t, st.RecordExecution(ctx, execution("backup-1", 3*time.Hour, true)))
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{
+		execution("backup-2", 2*time.Hour, true),
+		execution("backup-3", time.Hour, false),
+	}))
+	require.NoError(t, st.RecordExecutions(ctx, nil))
+
+	execs, err := st.GetExecutions(ctx, backup, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, execs, 3)
+	assert.Equal(t, "backup-3", execs[0].JobName, "newest first")
+	assert.Equal(t, "backup-1", execs[2].JobName)
+
+	last, err = st.GetLastExecution(ctx, backup)
+	require.NoError(t, err)
+	require.NotNil(t, last)
+	assert.Equal(t, "backup-3", last.JobName)
+	assert.False(t, last.Succeeded)
+
+	lastSuccess, err := st.GetLastSuccessfulExecution(ctx, backup)
+	require.NoError(t, err)
+	require.NotNil(t, lastSuccess)
+	assert.Equal(t, "backup-2", lastSuccess.JobName)
+
+	byName, err := st.GetExecutionByJobName(ctx, backup.Namespace, "backup-1")
+	require.NoError(t, err)
+	require.NotNil(t, byName)
+	assert.Equal(t, "uid-1", byName.CronJobUID)
+
+	count, err := st.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	count, err = st.GetExecutionCountSince(ctx, time.Now().Add(-90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	failed, err := st.GetFailedExecutionsSince(ctx, time.Now().Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "backup-3", failed[0].JobName)
+
+	uids, err := st.GetCronJobUIDs(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uid-1"}, uids)
+}
+
+func testFailureStreak(t *testing.T, ctx context.Context, st store.Store) {
+	streak, err := st.GetFailureStreak(ctx, backup, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, streak, "no executions yet")
+
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{
+		execution("backup-1", 5*time.Hour, false),
+		execution("backup-2", 4*time.Hour, true),
+		execution("backup-3", 3*time.Hour, false),
+		execution("backup-4", 2*time.Hour, false),
+		execution("backup-5", time.Hour, false),
+	}))
+
+	streak, err = st.GetFailureStreak(ctx, backup, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 3, streak)
+
+	// Runs started at or after before are not counted
+	streak, err = st.GetFailureStreak(ctx, backup, time.Now().Add(-90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, streak)
+
+	// Without an earlier success, every failure counts
+	streak, err = st.GetFailureStreak(ctx, backup, time.Now().Add(-270*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, streak)
+}
+
+func testSuccessStreak(t *testing.T, ctx context.Context, st store.Store) {
+	streak, err := st.GetSuccessStreak(ctx, backup, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, streak.Runs, "no executions yet")
+
+	first := execution("backup-2", 4*time.Hour, true)
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{
+		execution("backup-1", 5*time.Hour, false),
+		first,
+		execution("backup-3", 3*time.Hour, true),
+		execution("backup-4", 2*time.Hour, true),
+		execution("backup-5", time.Hour, false),
+	}))
+
+	// The last run failed, so there is no streak before now
+	streak, err = st.GetSuccessStreak(ctx, backup, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, streak.Runs)
+
+	streak, err = st.GetSuccessStreak(ctx, backup, time.Now().Add(-90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 3, streak.Runs)
+	assert.True(t, first.StartTime.Equal(streak.Since), "streak starts at %s, got %s", first.StartTime, streak.Since)
+}
+
+func testOutcomeChanges(t *testing.T, ctx context.Context, st store.Store) {
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{
+		execution("backup-1", 6*time.Hour, true),
+		execution("backup-2", 5*time.Hour, true),
+		execution("backup-3", 4*time.Hour, false),
+		execution("backup-4", 3*time.Hour, false),
+		execution("backup-5", 2*time.Hour, true),
+		execution("backup-6", time.Hour, true),
+	}))
+
+	changes, err := st.GetOutcomeChanges(ctx, backup, time.Now().Add(-7*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	for i, want := range []store.Execution{execution("backup-5", 2*time.Hour, true), execution("backup-3", 4*time.Hour, false), execution("backup-1", 6*time.Hour, true)} {
+		assert.True(t, want.StartTime.Equal(changes[i].StartTime), "change %d started at %s, got %s", i, want.StartTime, changes[i].StartTime)
+		assert.True(t, want.CompletionTime.Equal(changes[i].CompletionTime))
+		assert.Equal(t, want.Succeeded, changes[i].Succeeded)
+	}
+
+	// The first run of the window always counts
+	changes, err = st.GetOutcomeChanges(ctx, backup, time.Now().Add(-210*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.False(t, changes[1].Succeeded)
+}
+
+func testExecutionFilters(t *testing.T, ctx context.Context, st store.Store) {
+	for i, succeeded := range []bool{true, false, true, true, false} {
+		exec := execution("backup-"+string(rune('a'+i)), time.Duration(5-i)*time.Hour, succeeded)
+		require.NoError(t, st.RecordExecution(ctx, exec))
+	}
+	since := time.Now().Add(-24 * time.Hour)
+
+	page, total, err := st.GetExecutionsPaginated(ctx, backup, since, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	require.Len(t, page, 2)
+	assert.Equal(t, "backup-d", page[0].JobName)
+
+	failed, total, err := st.GetExecutionsFiltered(ctx, backup, since, "failed", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, failed, 2)
+	assert.Equal(t, "backup-e", failed[0].JobName)
+
+	_, total, err = st.GetExecutionsFiltered(ctx, backup, since, "success", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+
+	other := types.NamespacedName{Namespace: "default", Name: "other"}
+	execs, err := st.GetExecutions(ctx, other, since)
+	require.NoError(t, err)
+	assert.Empty(t, execs, "executions are per CronJob")
+}
+
+func testMetrics(t *testing.T, ctx context.Context, st store.Store) {
+	rate, err := st.GetSuccessRate(ctx, backup, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, rate, "no executions means healthy")
+
+	for i, succeeded := range []bool{true, true, true, false} {
+		require.NoError(t, st.RecordExecution(ctx, execution("backup-"+string(rune('a'+i)), time.Duration(i+1)*time.Hour, succeeded)))
+	}
+
+	rate, err = st.GetSuccessRate(ctx, backup, 7)
+	require.NoError(t, err)
+	assert.InDelta(t, 75.0, rate, 0.01)
+
+	metrics, err := st.GetMetrics(ctx, backup, 7)
+	require.NoError(t, err)
+	require.NotNil(t, metrics)
+	assert.Equal(t, int32(4), metrics.TotalRuns)
+	assert.Equal(t, int32(3), metrics.SuccessfulRuns)
+	assert.Equal(t, int32(1), metrics.FailedRuns)
+	assert.InDelta(t, 60.0, metrics.AvgDurationSeconds, 0.01)
+
+	p95, err := st.GetDurationPercentile(ctx, backup, 95, 7)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, p95)
+}
+
+func testDeleteExecutions(t *testing.T, ctx context.Context, st store.Store) {
+	recreated := execution("backup-2", time.Hour, true)
+	recreated.CronJobUID = "uid-2"
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{execution("backup-1", 2*time.Hour, true), recreated}))
+
+	deleted, err := st.DeleteExecutionsByUID(ctx, backup, "uid-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	deleted, err = st.DeleteExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	count, err := st.GetExecutionCount(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func testPrune(t *testing.T, ctx context.Context, st store.Store) {
+	logs := "line 1\nline 2"
+	old := execution("backup-1", 48*time.Hour, true)
+	old.Logs = &logs
+	recent := execution("backup-2", time.Hour, true)
+	recent.Logs = &logs
+	require.NoError(t, st.RecordExecutions(ctx, []store.Execution{old, recent}))
+
+	pruned, err := st.PruneLogs(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+	exec, err := st.GetExecutionByJobName(ctx, backup.Namespace, "backup-1")
+	require.NoError(t, err)
+	require.NotNil(t, exec)
+	assert.Nil(t, exec.Logs, "logs of old executions are pruned")
+
+	pruned, err = st.Prune(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	exec, err = st.GetExecutionByJobName(ctx, backup.Namespace, "backup-2")
+	require.NoError(t, err)
+	require.NotNil(t, exec)
+	require.NotNil(t, exec.Logs)
+	assert.Equal(t, logs, *exec.Logs)
+}
+
+func testPruneOverrides(t *testing.T, ctx context.Context, st store.Store) {
+	billing := types.NamespacedName{Namespace: "finance", Name: "billing"}
+	dev := types.NamespacedName{Namespace: "dev", Name: "nightly"}
+	logs := "output"
+	for _, cronJob := range []types.NamespacedName{backup, billing, dev} {
+		for i, ago := range []time.Duration{72 * time.Hour, 36 * time.Hour, 12 * time.Hour} {
+			exec := execution(cronJob.Name+"-"+string(rune('a'+i)), ago, true)
+			exec.CronJobNamespace, exec.CronJobName = cronJob.Namespace, cronJob.Name
+			exec.Logs = &logs
+			require.NoError(t, st.RecordExecution(ctx, exec))
+		}
+	}
+	remaining := func(cronJob types.NamespacedName) int {
+		execs, err := st.GetExecutions(ctx, cronJob, time.Time{})
+		require.NoError(t, err)
+		return len(execs)
+	}
+	withLogs := func(cronJob types.NamespacedName) int {
+		execs, err := st.GetExecutions(ctx, cronJob, time.Time{})
+		require.NoError(t, err)
+		n := 0
+		for _, e := range execs {
+			if e.Logs != nil {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Billing keeps four days of logs, dev only six hours, the rest one day
+	pruned, err := st.PruneLogs(ctx, time.Now().Add(-24*time.Hour),
+		store.RetentionOverride{CronJob: billing, OlderThan: time.Now().Add(-96 * time.Hour)},
+		store.RetentionOverride{CronJob: dev, OlderThan: time.Now().Add(-6 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), pruned)
+	assert.Equal(t, 1, withLogs(backup))
+	assert.Equal(t, 3, withLogs(billing))
+	assert.Equal(t, 0, withLogs(dev))
+
+	// Billing keeps four days of history, dev only a day, the rest two days
+	pruned, err = st.Prune(ctx, time.Now().Add(-48*time.Hour),
+		store.RetentionOverride{CronJob: billing, OlderThan: time.Now().Add(-96 * time.Hour)},
+		store.RetentionOverride{CronJob: dev, OlderThan: time.Now().Add(-24 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+	assert.Equal(t, 2, remaining(backup))
+	assert.Equal(t, 3, remaining(billing))
+	assert.Equal(t, 1, remaining(dev))
+}
+
+func testLegalHolds(t *testing.T, ctx context.Context, st store.Store) {
+	require.NoError(t, st.SetLegalHold(ctx, store.LegalHold{CronJobNamespace: backup.Namespace, CronJobName: backup.Name, Reason: "audit"}))
+	require.NoError(t, st.SetLegalHold(ctx, store.LegalHold{CronJobNamespace: "a", CronJobName: "first"}))
+	require.NoError(t, st.SetLegalHold(ctx, store.LegalHold{CronJobNamespace: backup.Namespace, CronJobName: backup.Name, Reason: "dispute"}))
+
+	holds, err := st.ListLegalHolds(ctx)
+	require.NoError(t, err)
+	require.Len(t, holds, 2, "setting a hold again updates it")
+	assert.Equal(t, "first", holds[0].CronJobName)
+	assert.Equal(t, backup.Name, holds[1].CronJobName)
+	assert.Equal(t, "dispute", holds[1].Reason)
+	assert.False(t, holds[1].CreatedAt.IsZero())
+
+	// A zero cutoff keeps all of a held CronJob's history
+	require.NoError(t, st.RecordExecution(ctx, execution("backup-1", 72*time.Hour, true)))
+	pruned, err := st.Prune(ctx, time.Now(), store.RetentionOverride{CronJob: backup})
+	require.NoError(t, err)
+	assert.Zero(t, pruned)
+
+	released, err := st.ReleaseLegalHold(ctx, backup)
+	require.NoError(t, err)
+	assert.True(t, released)
+	released, err = st.ReleaseLegalHold(ctx, backup)
+	require.NoError(t, err)
+	assert.False(t, released)
+	holds, err = st.ListLegalHolds(ctx)
+	require.NoError(t, err)
+	assert.Len(t, holds, 1)
+}
+
+func testTrash(t *testing.T, ctx context.Context, st store.Store) {
+	logs := "done"
+	exec := execution("backup-1", time.Hour, false)
+	exec.Logs = &logs
+	require.NoError(t, st.RecordExecution(ctx, exec))
+	require.NoError(t, st.RecordExecution(ctx, execution("backup-2", 2*time.Hour, true)))
+
+	trashed, err := st.TrashExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), trashed)
+	execs, err := st.GetExecutions(ctx, backup, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, execs, "trashed executions are hidden")
+
+	restored, err := st.RestoreExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), restored)
+	execs, err = st.GetExecutions(ctx, backup, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, execs, 2)
+	last, err := st.GetLastExecution(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, "backup-1", last.JobName)
+	assert.False(t, last.Succeeded)
+	require.NotNil(t, last.Logs)
+	assert.Equal(t, logs, *last.Logs)
+
+	restored, err = st.RestoreExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Zero(t, restored, "the trash is empty after a restore")
+
+	_, err = st.TrashExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	purged, err := st.PurgeTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, purged, "executions trashed within the window are kept")
+	purged, err = st.PurgeTrash(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	restored, err = st.RestoreExecutionsByCronJob(ctx, backup)
+	require.NoError(t, err)
+	assert.Zero(t, restored)
+}
+
+func testRollups(t *testing.T, ctx context.Context, st store.Store) {
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -3)
+	for i, secs := range []float64{10, 20, 40, 30} {
+		exec := execution(fmt.Sprintf("backup-%d", i), 0, i != 1)
+		// The last execution starts on the next day
+		exec.StartTime = day.Add(time.Duration(i+1)*time.Hour).AddDate(0, 0, i/3)
+		exec.DurationSecs = &secs
+		require.NoError(t, st.RecordExecution(ctx, exec))
+	}
+
+	for range 2 {
+		n, err := st.RollUpExecutions(ctx, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	}
+
+	rollups, err := st.GetDailyRollups(ctx, backup, day)
+	require.NoError(t, err)
+	require.Len(t, rollups, 2, "rolling up a day again replaces its roll-ups")
+	first := rollups[0]
+	assert.Equal(t, day.Format("2006-01-02"), first.Day)
+	assert.Equal(t, int64(3), first.Runs)
+	assert.Equal(t, int64(2), first.Successes)
+	assert.InDelta(t, 70, first.TotalRuntimeSecs, 0.001)
+	assert.InDelta(t, 20, first.P50Secs, 0.001)
+	assert.InDelta(t, 38, first.P95Secs, 0.001)
+	assert.InDelta(t, 39.6, first.P99Secs, 0.001)
+	assert.Equal(t, int64(1), rollups[1].Runs)
+
+	latest, err := st.LatestRollupDay(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, day.AddDate(0, 0, 1), latest)
+
+	pruned, err := st.PruneRollups(ctx, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+}
+
+func testExport(t *testing.T, ctx context.Context, st store.Store) {
+	for i := range 5 {
+		require.NoError(t, st.RecordExecution(ctx, execution("backup-"+string(rune('a'+i)), time.Duration(i+1)*time.Hour, true)))
+		require.NoError(t, st.StoreAlert(ctx, store.AlertHistory{
+			Type: "JobFailed", Severity: "critical", Title: "failed", OccurredAt: time.Now(),
+		}))
+	}
+
+	var exported int
+	var afterID int64
+	for {
+		execs, err := st.ExportExecutions(ctx, afterID, 2)
+		require.NoError(t, err)
+		if len(execs) == 0 {
+			break
+		}
+		assert.LessOrEqual(t, len(execs), 2)
+		for _, exec := range execs {
+			assert.Greater(t, exec.ID, afterID, "exported in ID order")
+			afterID = exec.ID
+		}
+		exported += len(execs)
+	}
+	assert.Equal(t, 5, exported)
+
+	alerts, err := st.ExportAlertHistory(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, alerts, 5)
+}
+
+func testAlertHistory(t *testing.T, ctx context.Context, st store.Store) {
+	now := time.Now().Truncate(time.Second)
+	alerts := []store.AlertHistory{
+		{AlertID: "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30", Type: "JobFailed", Severity: "critical", Title: "failed",
+			CronJobNamespace: backup.Namespace, CronJobName: backup.Name, ChannelsNotified: "slack,pagerduty", OccurredAt: now.Add(-time.Hour)},
+		{Type: "SLABreached", Severity: "warning", Title: "breached",
+			CronJobNamespace: backup.Namespace, CronJobName: backup.Name, ChannelsNotified: "slack", OccurredAt: now},
+	}
+	for _, alert := range alerts {
+		require.NoError(t, st.StoreAlert(ctx, alert))
+	}
+
+	all, total, err := st.ListAlertHistory(ctx, store.AlertHistoryQuery{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, all, 2)
+	assert.Equal(t, "SLABreached", all[0].Type, "newest first")
+
+	critical, total, err := st.ListAlertHistory(ctx, store.AlertHistoryQuery{Limit: 10, Severity: "critical"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, critical, 1)
+	assert.Equal(t, []string{"slack", "pagerduty"}, critical[0].GetChannelsNotified())
+
+	byID, _, err := st.ListAlertHistory(ctx, store.AlertHistoryQuery{AlertID: alerts[0].AlertID})
+	require.NoError(t, err)
+	require.Len(t, byID, 1)
+	assert.Equal(t, "JobFailed", byID[0].Type)
+
+	require.NoError(t, st.ResolveAlert(ctx, "JobFailed", backup.Namespace, backup.Name))
+	byID, _, err = st.ListAlertHistory(ctx, store.AlertHistoryQuery{Type: "JobFailed"})
+	require.NoError(t, err)
+	require.Len(t, byID, 1)
+	assert.NotNil(t, byID[0].ResolvedAt)
+
+	stats, err := st.GetChannelAlertStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats["slack"].AlertsSentTotal)
+	assert.Equal(t, int64(1), stats["pagerduty"].AlertsSentTotal)
+}
+
+func testChannelStats(t *testing.T, ctx context.Context, st store.Store) {
+	missing, err := st.GetChannelStats(ctx, "slack")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, st.SaveChannelStats(ctx, store.ChannelStatsRecord{ChannelName: "slack", AlertsSentTotal: 1}))
+	require.NoError(t, st.SaveChannelStats(ctx, store.ChannelStatsRecord{ChannelName: "slack", AlertsSentTotal: 5, ConsecutiveFailures: 2}))
+	require.NoError(t, st.SaveChannelStats(ctx, store.ChannelStatsRecord{ChannelName: "pagerduty", AlertsSentTotal: 1}))
+
+	stats, err := st.GetChannelStats(ctx, "slack")
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	assert.Equal(t, int64(5), stats.AlertsSentTotal, "saving again replaces the stats")
+	assert.Equal(t, int32(2), stats.ConsecutiveFailures)
+
+	all, err := st.GetAllChannelStats(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func testDeliveries(t *testing.T, ctx context.Context, st store.Store) {
+	now := time.Now().Truncate(time.Second)
+	for i, channel := range []string{"slack", "pagerduty"} {
+		require.NoError(t, st.EnqueueDelivery(ctx, store.AlertDelivery{
+			AlertKey: "default/backup/JobFailed", AlertType: "JobFailed", Severity: "critical",
+			ChannelName: channel, NextAttemptAt: now.Add(time.Duration(i) * time.Hour),
+		}))
+	}
+
+	due, err := st.GetDueDeliveries(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "slack", due[0].ChannelName)
+	assert.Equal(t, store.DeliveryStatusPending, due[0].Status, "status defaults to pending")
+
+	delivered := due[0]
+	delivered.Status = store.DeliveryStatusDelivered
+	delivered.Attempts = 2
+	delivered.DeliveredAt = &now
+	require.NoError(t, st.UpdateDelivery(ctx, delivered))
+
+	due, err = st.GetDueDeliveries(ctx, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "pagerduty", due[0].ChannelName, "delivered deliveries are not due")
+
+	list, total, err := st.ListDeliveries(ctx, store.AlertDeliveryQuery{Status: store.DeliveryStatusDelivered, Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, list, 1)
+	assert.Equal(t, int32(2), list[0].Attempts)
+
+	pruned, err := st.PruneDeliveries(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned, "pending deliveries are kept")
+}
+
+func testDeliveryHistoryClaim(t *testing.T, ctx context.Context, st store.Store) {
+	for _, channel := range []string{"slack", "pagerduty"} {
+		require.NoError(t, st.EnqueueDelivery(ctx, store.AlertDelivery{
+			AlertKey: "default/backup/JobFailed", AlertID: "alert-1", AlertType: "JobFailed", Severity: "critical",
+			ChannelName: channel, RecordHistory: true, NextAttemptAt: time.Now(),
+		}))
+	}
+
+	claimed, err := st.ClaimDeliveryHistory(ctx, "alert-1")
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = st.ClaimDeliveryHistory(ctx, "alert-1")
+	require.NoError(t, err)
+	assert.False(t, claimed, "the flag is cleared on every delivery of the alert")
+
+	claimed, err = st.ClaimDeliveryHistory(ctx, "alert-2")
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func testAlertStates(t *testing.T, ctx context.Context, st store.Store) {
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, st.SaveAlertState(ctx, store.AlertState{AlertKey: "a", LastSentAt: now.Add(-2 * time.Hour)}))
+	require.NoError(t, st.SaveAlertState(ctx, store.AlertState{AlertKey: "b", LastSentAt: now.Add(-time.Hour)}))
+	require.NoError(t, st.SaveAlertState(ctx, store.AlertState{AlertKey: "a", LastSentAt: now, AcknowledgedBy: "oncall"}))
+
+	states, err := st.ListAlertStates(ctx, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.Equal(t, "b", states[0].AlertKey, "oldest first")
+	assert.Equal(t, "oncall", states[1].AcknowledgedBy, "saving again replaces the state")
+
+	pruned, err := st.PruneAlertStates(ctx, now.Add(-30*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+
+	require.NoError(t, st.DeleteAlertStates(ctx, []string{"a"}))
+	require.NoError(t, st.DeleteAlertStates(ctx, nil))
+	states, err = st.ListAlertStates(ctx, time.Time{})
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
+
+func testAlertClaims(t *testing.T, ctx context.Context, st store.Store) {
+	now := time.Now().Truncate(time.Second)
+	claim := store.AlertClaim{AlertKey: "default/backup/JobFailed", Signature: "exit-1", WindowStart: now, ClaimedBy: "replica-a"}
+
+	claimed, err := st.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The window follows the claim rather than the clock
+	claim.ClaimedBy = "replica-b"
+	claim.WindowStart = now.Add(59 * time.Minute)
+	claimed, err = st.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, claimed, "claimed within the window")
+
+	claim.WindowStart = now.Add(time.Hour)
+	claimed, err = st.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed, "the window has passed")
+
+	claim.WindowStart = now.Add(90 * time.Minute)
+	claimed, err = st.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, claimed, "the window restarts at the last claim")
+
+	other := claim
+	other.Signature = "exit-2"
+	claimed, err = st.ClaimAlert(ctx, other, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed, "another signature has its own claim")
+
+	require.NoError(t, st.DeleteAlertClaims(ctx, []string{claim.AlertKey}))
+	claimed, err = st.ClaimAlert(ctx, claim, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, claimed, "deleted claims can be claimed again")
+
+	pruned, err := st.PruneAlertClaims(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pruned)
+}
+
+func testReceipts(t *testing.T, ctx context.Context, st store.Store) {
+	const alertID = "a3c1e9d2-0f5b-4b7e-9a61-2d8f4c7b1e30"
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, st.RecordReceipt(ctx, store.AlertReceipt{AlertID: alertID, Receiver: "bridge", Status: store.ReceiptStatusFailed, ReceivedAt: now}))
+	require.NoError(t, st.RecordReceipt(ctx, store.AlertReceipt{AlertID: alertID, Receiver: "pagerduty", Status: store.ReceiptStatusProcessed, ReceivedAt: now.Add(-time.Minute)}))
+	require.NoError(t, st.RecordReceipt(ctx, store.AlertReceipt{AlertID: "other", Receiver: "bridge", Status: store.ReceiptStatusProcessed, ReceivedAt: now}))
+
+	receipts, err := st.ListReceipts(ctx, []string{alertID})
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, "pagerduty", receipts[0].Receiver, "oldest first")
+
+	receipts, err = st.ListReceipts(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, receipts)
+}
+
+func testViews(t *testing.T, ctx context.Context, st store.Store) {
+	missing, err := st.GetView(ctx, "backups")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	view := store.SavedView{Name: "backups", Search: "backup"}
+	view.SetNamespaces([]string{"prod", "staging"})
+	require.NoError(t, st.SaveView(ctx, view))
+	require.NoError(t, st.SaveView(ctx, store.SavedView{Name: "audit", Status: "failing"}))
+	view.Search = "backup-"
+	require.NoError(t, st.SaveView(ctx, view))
+
+	got, err := st.GetView(ctx, "backups")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "backup-", got.Search, "saving again replaces the view")
+	assert.Equal(t, []string{"prod", "staging"}, got.GetNamespaces())
+
+	views, err := st.ListViews(ctx)
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+	assert.Equal(t, "audit", views[0].Name, "ordered by name")
+
+	deleted, err := st.DeleteView(ctx, "audit")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = st.DeleteView(ctx, "audit")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
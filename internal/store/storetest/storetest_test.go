@@ -0,0 +1,18 @@
+package storetest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iLLeniumStudios/cronjob-guardian/internal/store"
+)
+
+func TestRun_SQLite(t *testing.T) {
+	Run(t, func(t *testing.T) store.Store {
+		st, err := store.Open("sqlite", store.BackendOptions{DSN: filepath.Join(t.TempDir(), "guardian.db")})
+		require.NoError(t, err)
+		return st
+	})
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TimescaleDB support. The "timescale" dialect is PostgreSQL with the
+// timescaledb extension: executions becomes a hypertable chunked on
+// start_time, and an hourly continuous aggregate backs run counts so that
+// success-rate queries don't scan raw rows. Percentiles stay on raw rows:
+// exact percentiles cannot be combined from hourly buckets, and approximate
+// sketches would need the separate timescaledb_toolkit extension. They are
+// computed with percentile_cont on the hypertable, where chunk exclusion
+// limits the scan to the requested window.
+
+const (
+	// executionsChunkInterval is the time range covered by each hypertable chunk
+	executionsChunkInterval = "7 days"
+	// executionsHourlyView is the continuous aggregate of run counts per cluster and CronJob per hour
+	executionsHourlyView = "executions_hourly"
+)
+
+// createExecutionsHourlySQL materializes existing rows on creation, so buckets
+// older than the refresh policy's window are filled in when the view is
+// recreated by a migration.
+const createExecutionsHourlySQL = `CREATE MATERIALIZED VIEW IF NOT EXISTS executions_hourly
+WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+SELECT cluster,
+	cronjob_ns,
+	cronjob_name,
+	time_bucket(INTERVAL '1 hour', start_time) AS bucket,
+	COUNT(*) AS total_runs,
+	SUM(CASE WHEN succeeded THEN 1 ELSE 0 END) AS successful_runs
+FROM executions
+GROUP BY cluster, cronjob_ns, cronjob_name, bucket
+WITH DATA`
+
+// isPostgres reports whether the store speaks the PostgreSQL dialect
+func (s *GormStore) isPostgres() bool {
+	return s.dialect == "postgres" || s.dialect == "timescale"
+}
+
+// initTimescale enables the extension and converts executions to a hypertable.
+// Runs before migrations so the table is created with a primary key that
+// includes the time column.
+func (s *GormStore) initTimescale(ctx context.Context) error {
+	db := s.conn().WithContext(ctx)
+
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS timescaledb").Error; err != nil {
+		return fmt.Errorf("enable timescaledb extension: %w", err)
+	}
+
+	relkind, err := s.executionsRelkind(ctx)
+	if err != nil {
+		return err
+	}
+	if relkind == "" {
+		if err := db.Exec(executionsTableSQL).Error; err != nil {
+			return fmt.Errorf("create executions table: %w", err)
+		}
+	}
+
+	err = db.Exec(
+		fmt.Sprintf(
+			"SELECT create_hypertable('executions', 'start_time', chunk_time_interval => INTERVAL '%s', if_not_exists => TRUE, migrate_data => TRUE)",
+			executionsChunkInterval,
+		),
+	).Error
+	if err != nil {
+		return fmt.Errorf("convert executions to hypertable (existing tables must have a primary key that includes start_time): %w", err)
+	}
+	return nil
+}
+
+// initTimescaleAggregates creates the continuous aggregate and its refresh
+// policy. Runs after migrations so column changes are applied before the view
+// starts depending on them.
+func (s *GormStore) initTimescaleAggregates(ctx context.Context) error {
+	db := s.conn().WithContext(ctx)
+
+	if err := db.Exec(createExecutionsHourlySQL).Error; err != nil {
+		return fmt.Errorf("create %s continuous aggregate: %w", executionsHourlyView, err)
+	}
+
+	err := db.Exec(
+		"SELECT add_continuous_aggregate_policy(?, start_offset => INTERVAL '3 days', end_offset => INTERVAL '1 hour', schedule_interval => INTERVAL '30 minutes', if_not_exists => TRUE)",
+		executionsHourlyView,
+	).Error
+	if err != nil {
+		return fmt.Errorf("add refresh policy for %s: %w", executionsHourlyView, err)
+	}
+	return nil
+}
+
+// getRunCountsFromAggregate reads total and successful run counts since the
+// given time. Whole hours come from the hourly continuous aggregate; runs in
+// the partial hour at the start of the window are counted from the hypertable,
+// so the window is not widened to the start of that hour.
+func (s *GormStore) getRunCountsFromAggregate(ctx context.Context, cronJob types.NamespacedName, since time.Time) (total, succeeded int64, err error) {
+	var hours, partial struct {
+		Total     int64
+		Succeeded int64
+	}
+
+	firstBucket := since.Truncate(time.Hour)
+	if firstBucket.Before(since) {
+		firstBucket = firstBucket.Add(time.Hour)
+	}
+	err = s.scoped(ctx).Table(executionsHourlyView).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND bucket >= ?",
+			cronJob.Namespace, cronJob.Name, firstBucket).
+		Select("COALESCE(SUM(total_runs), 0) as total, COALESCE(SUM(successful_runs), 0) as succeeded").
+		Scan(&hours).Error
+	if err != nil || !firstBucket.After(since) {
+		return hours.Total, hours.Succeeded, err
+	}
+
+	err = s.scoped(ctx).Model(&Execution{}).
+		Where("cronjob_ns = ? AND cronjob_name = ? AND start_time >= ? AND start_time < ?",
+			cronJob.Namespace, cronJob.Name, since, firstBucket).
+		Select("COUNT(*) as total, COALESCE(SUM(CASE WHEN succeeded THEN 1 ELSE 0 END), 0) as succeeded").
+		Scan(&partial).Error
+	return hours.Total + partial.Total, hours.Succeeded + partial.Succeeded, err
+}
+
+// dropExpiredChunks drops hypertable chunks that lie entirely before
+// olderThan. drop_chunks does not report row counts, so rows before the
+// cutoff are counted first and the caller deletes whatever remains in the
+// partially expired chunk.
+func (s *GormStore) dropExpiredChunks(ctx context.Context, olderThan time.Time) (int64, error) {
+	var expired int64
+	if err := s.conn().WithContext(ctx).Model(&Execution{}).
+		Where("start_time < ?", olderThan).
+		Count(&expired).Error; err != nil {
+		return 0, fmt.Errorf("count expired executions: %w", err)
+	}
+	if expired == 0 {
+		return 0, nil
+	}
+
+	if err := s.conn().WithContext(ctx).
+		Exec("SELECT drop_chunks('executions', older_than => ?::timestamptz)", olderThan).Error; err != nil {
+		return 0, fmt.Errorf("drop executions chunks: %w", err)
+	}
+
+	var remaining int64
+	if err := s.conn().WithContext(ctx).Model(&Execution{}).
+		Where("start_time < ?", olderThan).
+		Count(&remaining).Error; err != nil {
+		return 0, fmt.Errorf("count expired executions: %w", err)
+	}
+	return expired - remaining, nil
+}
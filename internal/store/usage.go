@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// usageSelect aggregates runtime and requested resources multiplied by
+// duration. Executions without a duration (still running or missing a
+// completion time) do not count towards usage.
+const usageSelect = `COUNT(*) AS runs,
+	COALESCE(SUM(duration_secs), 0) AS runtime_seconds,
+	COALESCE(SUM(duration_secs * cpu_request_milli), 0) / 1000.0 AS cpu_core_seconds,
+	COALESCE(SUM(duration_secs * memory_request_bytes), 0) / 1073741824.0 AS memory_gib_seconds`
+
+// usageScope selects executions with a duration that started at or after since
+func (s *GormStore) usageScope(ctx context.Context, since time.Time) *gorm.DB {
+	return s.scoped(ctx).Model(&Execution{}).
+		Where("start_time >= ? AND duration_secs IS NOT NULL", since)
+}
+
+// GetCronJobUsage returns the runtime and resource usage of a CronJob since a given time
+func (s *GormStore) GetCronJobUsage(ctx context.Context, cronJob types.NamespacedName, since time.Time) (*CronJobUsage, error) {
+	s = s.forRead()
+	usage := &CronJobUsage{}
+	if err := s.usageScope(ctx, since).
+		Where("cronjob_ns = ? AND cronjob_name = ?", cronJob.Namespace, cronJob.Name).
+		Select(usageSelect).
+		Scan(usage).Error; err != nil {
+		return nil, fmt.Errorf("cronjob usage: %w", err)
+	}
+	usage.CronJobNamespace = cronJob.Namespace
+	usage.CronJobName = cronJob.Name
+	return usage, nil
+}
+
+// ListCronJobUsage returns the usage of every CronJob with executions since a
+// given time, highest CPU usage first
+func (s *GormStore) ListCronJobUsage(ctx context.Context, since time.Time) ([]CronJobUsage, error) {
+	s = s.forRead()
+	var usage []CronJobUsage
+	if err := s.usageScope(ctx, since).
+		Select("cronjob_ns, cronjob_name, " + usageSelect).
+		Group("cronjob_ns, cronjob_name").
+		Order("cpu_core_seconds DESC, runtime_seconds DESC, cronjob_ns, cronjob_name").
+		Scan(&usage).Error; err != nil {
+		return nil, fmt.Errorf("usage by cronjob: %w", err)
+	}
+	return usage, nil
+}
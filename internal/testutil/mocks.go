@@ -4,6 +4,8 @@ package testutil
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -39,6 +41,7 @@ type MockStore struct {
 	ExecutionByJobName  *store.Execution
 	ExecutionCount      int64
 	ExecutionCountSince int64
+	FailedExecutions    []store.Execution
 
 	// Metrics
 	Metrics            *store.Metrics
@@ -49,6 +52,7 @@ type MockStore struct {
 	PrunedCount     int64
 	PrunedLogsCount int64
 	DeletedCount    int64
+	RestoredCount   int64
 
 	// UIDs - map key: "namespace/name", value: list of UIDs
 	CronJobUIDsMap map[string][]string
@@ -58,11 +62,43 @@ type MockStore struct {
 	AlertHistory      []store.AlertHistory
 	AlertHistoryTotal int64
 
+	// Analytics
+	Analytics *store.ExecutionAnalytics
+
+	// Usage, by CronJob (highest CPU usage first)
+	Usage []store.CronJobUsage
+
 	// Channel Stats
 	ChannelAlertStats map[string]store.ChannelAlertStats
 	AllChannelStats   map[string]*store.ChannelStatsRecord
 	SingleChannelStat *store.ChannelStatsRecord
 
+	// Alert deliveries
+	Deliveries       []store.AlertDelivery
+	DeliveriesTotal  int64
+	PrunedDeliveries int64
+
+	// Alert suppression state, by alert key
+	AlertStates map[string]store.AlertState
+
+	// Alert dedup claims, by alert key and window start
+	AlertClaims []store.AlertClaim
+
+	// Saved views, by name
+	Views map[string]store.SavedView
+
+	// Alert receipts, in the order they were recorded
+	Receipts []store.AlertReceipt
+
+	// Legal holds, in the order they were set
+	LegalHolds []store.LegalHold
+
+	// Daily roll-ups
+	DailyRollups  []store.DailyRollup
+	LatestRollup  time.Time
+	RollUpCalls   [][2]time.Time // from and to of every RollUpExecutions call
+	RollupsPruned time.Time      // cutoff of the last PruneRollups call
+
 	// Error injection - set these to simulate errors
 	InitError                       error
 	RecordExecutionError            error
@@ -72,6 +108,7 @@ type MockStore struct {
 	GetLastExecutionError           error
 	GetLastSuccessfulExecutionError error
 	GetDurationPercentileError      error
+	GetExecutionAnalyticsError      error
 	PruneError                      error
 	PruneLogsError                  error
 	StoreAlertError                 error
@@ -82,6 +119,8 @@ type MockStore struct {
 	GetAllChannelStatsError         error
 	DeleteExecutionsByCronJobError  error
 	DeleteExecutionsByUIDError      error
+	EnqueueDeliveryError            error
+	ListDeliveriesError             error
 
 	// For duration percentile tests that need different values per window
 	DurationPercentileMap map[int]time.Duration // percentile -> duration
@@ -93,9 +132,15 @@ type MockStore struct {
 	DeleteByUIDCalled     int
 	PruneCalled           int
 	PruneCutoff           time.Time
+	PruneOverrides        []store.RetentionOverride
 	PruneLogsCalled       int
 	LogPruneCutoff        time.Time
+	LogPruneOverrides     []store.RetentionOverride
 	ResolveAlertCalls     int
+	PruneDeliveriesCalled int
+	TrashCalled           int
+	RestoreCalled         int
+	PurgeTrashCutoff      time.Time
 }
 
 // Init implements store.Store
@@ -125,6 +170,18 @@ func (m *MockStore) RecordExecution(_ context.Context, exec store.Execution) err
 	return nil
 }
 
+// RecordExecutions implements store.Store
+func (m *MockStore) RecordExecutions(_ context.Context, execs []store.Execution) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.RecordExecutionError != nil {
+		return m.RecordExecutionError
+	}
+	m.RecordedExecutions = append(m.RecordedExecutions, execs...)
+	m.Executions = append(m.Executions, execs...)
+	return nil
+}
+
 // GetExecutions implements store.Store
 func (m *MockStore) GetExecutions(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
 	if m.GetExecutionsError != nil {
@@ -173,6 +230,57 @@ func (m *MockStore) GetLastSuccessfulExecution(_ context.Context, _ types.Namesp
 	return nil, nil
 }
 
+// GetFailureStreak implements store.Store, counting Executions newest first
+func (m *MockStore) GetFailureStreak(_ context.Context, _ types.NamespacedName, before time.Time) (int, error) {
+	streak := 0
+	for _, exec := range m.Executions {
+		if !exec.StartTime.Before(before) {
+			continue
+		}
+		if exec.Succeeded {
+			break
+		}
+		streak++
+	}
+	return streak, nil
+}
+
+// GetOutcomeChanges implements store.Store. ComputeReliability gives the same
+// result for all executions, so they are returned unfiltered.
+func (m *MockStore) GetOutcomeChanges(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.Execution, error) {
+	if m.GetExecutionsError != nil {
+		return nil, m.GetExecutionsError
+	}
+	return m.Executions, nil
+}
+
+// GetSuccessStreak implements store.Store, counting Executions newest first
+func (m *MockStore) GetSuccessStreak(_ context.Context, _ types.NamespacedName, before time.Time) (store.SuccessStreak, error) {
+	var streak store.SuccessStreak
+	for _, exec := range m.Executions {
+		if !exec.StartTime.Before(before) {
+			continue
+		}
+		if !exec.Succeeded {
+			break
+		}
+		streak.Runs++
+		streak.Since = exec.StartTime
+	}
+	return streak, nil
+}
+
+// GetOutputSizes implements store.Store
+func (m *MockStore) GetOutputSizes(_ context.Context, _ types.NamespacedName, limit int) ([]float64, error) {
+	var sizes []float64
+	for _, exec := range m.Executions {
+		if exec.Succeeded && exec.OutputSize != nil && len(sizes) < limit {
+			sizes = append(sizes, *exec.OutputSize)
+		}
+	}
+	return sizes, nil
+}
+
 // GetExecutionByJobName implements store.Store
 func (m *MockStore) GetExecutionByJobName(_ context.Context, _, _ string) (*store.Execution, error) {
 	if m.ExecutionByJobName != nil {
@@ -205,6 +313,32 @@ func (m *MockStore) GetDurationPercentile(_ context.Context, _ types.NamespacedN
 	return m.DurationPercentile, nil
 }
 
+// GetExecutionAnalytics implements store.Store
+func (m *MockStore) GetExecutionAnalytics(_ context.Context, _ types.NamespacedName, windowDays int) (*store.ExecutionAnalytics, error) {
+	if m.GetExecutionAnalyticsError != nil {
+		return nil, m.GetExecutionAnalyticsError
+	}
+	if m.Analytics != nil {
+		return m.Analytics, nil
+	}
+	return &store.ExecutionAnalytics{WindowDays: windowDays}, nil
+}
+
+// GetCronJobUsage implements store.Store
+func (m *MockStore) GetCronJobUsage(_ context.Context, cronJob types.NamespacedName, _ time.Time) (*store.CronJobUsage, error) {
+	for _, u := range m.Usage {
+		if u.CronJobNamespace == cronJob.Namespace && u.CronJobName == cronJob.Name {
+			return &u, nil
+		}
+	}
+	return &store.CronJobUsage{CronJobNamespace: cronJob.Namespace, CronJobName: cronJob.Name}, nil
+}
+
+// ListCronJobUsage implements store.Store
+func (m *MockStore) ListCronJobUsage(_ context.Context, _ time.Time) ([]store.CronJobUsage, error) {
+	return m.Usage, nil
+}
+
 // GetSuccessRate implements store.Store
 func (m *MockStore) GetSuccessRate(_ context.Context, _ types.NamespacedName, _ int) (float64, error) {
 	if m.GetSuccessRateError != nil {
@@ -214,11 +348,12 @@ func (m *MockStore) GetSuccessRate(_ context.Context, _ types.NamespacedName, _
 }
 
 // Prune implements store.Store
-func (m *MockStore) Prune(_ context.Context, cutoff time.Time) (int64, error) {
+func (m *MockStore) Prune(_ context.Context, cutoff time.Time, overrides ...store.RetentionOverride) (int64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.PruneCalled++
 	m.PruneCutoff = cutoff
+	m.PruneOverrides = overrides
 	if m.PruneError != nil {
 		return 0, m.PruneError
 	}
@@ -226,11 +361,12 @@ func (m *MockStore) Prune(_ context.Context, cutoff time.Time) (int64, error) {
 }
 
 // PruneLogs implements store.Store
-func (m *MockStore) PruneLogs(_ context.Context, cutoff time.Time) (int64, error) {
+func (m *MockStore) PruneLogs(_ context.Context, cutoff time.Time, overrides ...store.RetentionOverride) (int64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.PruneLogsCalled++
 	m.LogPruneCutoff = cutoff
+	m.LogPruneOverrides = overrides
 	if m.PruneLogsError != nil {
 		return 0, m.PruneLogsError
 	}
@@ -260,6 +396,64 @@ func (m *MockStore) DeleteExecutionsByUID(_ context.Context, _ types.NamespacedN
 	return m.DeletedCount, nil
 }
 
+// RollUpExecutions implements store.Store
+func (m *MockStore) RollUpExecutions(_ context.Context, from, to time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RollUpCalls = append(m.RollUpCalls, [2]time.Time{from, to})
+	m.LatestRollup = to
+	return len(m.DailyRollups), nil
+}
+
+// GetDailyRollups implements store.Store
+func (m *MockStore) GetDailyRollups(_ context.Context, _ types.NamespacedName, _ time.Time) ([]store.DailyRollup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.DailyRollups), nil
+}
+
+// LatestRollupDay implements store.Store
+func (m *MockStore) LatestRollupDay(_ context.Context) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.LatestRollup, nil
+}
+
+// PruneRollups implements store.Store
+func (m *MockStore) PruneRollups(_ context.Context, olderThan time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RollupsPruned = olderThan
+	return 0, nil
+}
+
+// TrashExecutionsByCronJob implements store.Store
+func (m *MockStore) TrashExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.TrashCalled++
+	if m.DeleteExecutionsByCronJobError != nil {
+		return 0, m.DeleteExecutionsByCronJobError
+	}
+	return m.DeletedCount, nil
+}
+
+// RestoreExecutionsByCronJob implements store.Store
+func (m *MockStore) RestoreExecutionsByCronJob(_ context.Context, _ types.NamespacedName) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RestoreCalled++
+	return m.RestoredCount, nil
+}
+
+// PurgeTrash implements store.Store
+func (m *MockStore) PurgeTrash(_ context.Context, olderThan time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.PurgeTrashCutoff = olderThan
+	return 0, nil
+}
+
 // GetCronJobUIDs implements store.Store
 func (m *MockStore) GetCronJobUIDs(_ context.Context, cronJob types.NamespacedName) ([]string, error) {
 	// Check map first for specific namespace/name lookup
@@ -283,6 +477,22 @@ func (m *MockStore) GetExecutionCountSince(_ context.Context, _ time.Time) (int6
 	return m.ExecutionCountSince, nil
 }
 
+// GetFailedExecutionsSince implements store.Store
+func (m *MockStore) GetFailedExecutionsSince(_ context.Context, _ time.Time, _ int) ([]store.Execution, error) {
+	return m.FailedExecutions, nil
+}
+
+// ExportExecutions implements store.Store, paging through Executions by ID
+func (m *MockStore) ExportExecutions(_ context.Context, afterID int64, limit int) ([]store.Execution, error) {
+	var page []store.Execution
+	for _, e := range m.Executions {
+		if e.ID > afterID && len(page) < limit {
+			page = append(page, e)
+		}
+	}
+	return page, nil
+}
+
 // StoreAlert implements store.Store
 func (m *MockStore) StoreAlert(_ context.Context, _ store.AlertHistory) error {
 	return m.StoreAlertError
@@ -296,6 +506,20 @@ func (m *MockStore) ListAlertHistory(_ context.Context, _ store.AlertHistoryQuer
 	return m.AlertHistory, m.AlertHistoryTotal, nil
 }
 
+// ExportAlertHistory implements store.Store, paging through AlertHistory by ID
+func (m *MockStore) ExportAlertHistory(_ context.Context, afterID int64, limit int) ([]store.AlertHistory, error) {
+	if m.ListAlertHistoryError != nil {
+		return nil, m.ListAlertHistoryError
+	}
+	var page []store.AlertHistory
+	for _, a := range m.AlertHistory {
+		if a.ID > afterID && len(page) < limit {
+			page = append(page, a)
+		}
+	}
+	return page, nil
+}
+
 // ResolveAlert implements store.Store
 func (m *MockStore) ResolveAlert(_ context.Context, _, _, _ string) error {
 	m.mu.Lock()
@@ -339,6 +563,272 @@ func (m *MockStore) GetAllChannelStats(_ context.Context) (map[string]*store.Cha
 	return m.AllChannelStats, nil
 }
 
+// EnqueueDelivery implements store.Store
+func (m *MockStore) EnqueueDelivery(_ context.Context, delivery store.AlertDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.EnqueueDeliveryError != nil {
+		return m.EnqueueDeliveryError
+	}
+	m.Deliveries = append(m.Deliveries, delivery)
+	return nil
+}
+
+// GetDueDeliveries implements store.Store
+func (m *MockStore) GetDueDeliveries(_ context.Context, now time.Time, _ int) ([]store.AlertDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var due []store.AlertDelivery
+	for _, d := range m.Deliveries {
+		if d.Status == store.DeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+// UpdateDelivery implements store.Store
+func (m *MockStore) UpdateDelivery(_ context.Context, delivery store.AlertDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.Deliveries {
+		if m.Deliveries[i].ID == delivery.ID {
+			// Like the store, keep the history flag: it is only cleared by a claim
+			delivery.RecordHistory = m.Deliveries[i].RecordHistory
+			m.Deliveries[i] = delivery
+		}
+	}
+	return nil
+}
+
+// ClaimDeliveryHistory implements store.Store
+func (m *MockStore) ClaimDeliveryHistory(_ context.Context, alertID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	claimed := false
+	for i := range m.Deliveries {
+		if m.Deliveries[i].AlertID == alertID && m.Deliveries[i].RecordHistory {
+			m.Deliveries[i].RecordHistory = false
+			claimed = true
+		}
+	}
+	return claimed, nil
+}
+
+// ListDeliveries implements store.Store
+func (m *MockStore) ListDeliveries(_ context.Context, _ store.AlertDeliveryQuery) ([]store.AlertDelivery, int64, error) {
+	if m.ListDeliveriesError != nil {
+		return nil, 0, m.ListDeliveriesError
+	}
+	return m.Deliveries, m.DeliveriesTotal, nil
+}
+
+// PruneDeliveries implements store.Store
+func (m *MockStore) PruneDeliveries(_ context.Context, _ time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.PruneDeliveriesCalled++
+	return m.PrunedDeliveries, nil
+}
+
+// SaveAlertState implements store.Store
+func (m *MockStore) SaveAlertState(_ context.Context, state store.AlertState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.AlertStates == nil {
+		m.AlertStates = make(map[string]store.AlertState)
+	}
+	m.AlertStates[state.AlertKey] = state
+	return nil
+}
+
+// DeleteAlertStates implements store.Store
+func (m *MockStore) DeleteAlertStates(_ context.Context, alertKeys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range alertKeys {
+		delete(m.AlertStates, key)
+	}
+	return nil
+}
+
+// ListAlertStates implements store.Store
+func (m *MockStore) ListAlertStates(_ context.Context, since time.Time) ([]store.AlertState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var states []store.AlertState
+	for _, state := range m.AlertStates {
+		if !state.LastSentAt.Before(since) {
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
+
+// PruneAlertStates implements store.Store
+func (m *MockStore) PruneAlertStates(_ context.Context, olderThan time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var pruned int64
+	for key, state := range m.AlertStates {
+		if state.LastSentAt.Before(olderThan) {
+			delete(m.AlertStates, key)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+// ClaimAlert implements store.Store
+func (m *MockStore) ClaimAlert(_ context.Context, claim store.AlertClaim, window time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, c := range m.AlertClaims {
+		if c.AlertKey == claim.AlertKey && c.Signature == claim.Signature {
+			if c.WindowStart.After(claim.WindowStart.Add(-window)) {
+				return false, nil
+			}
+			m.AlertClaims[i] = claim
+			return true, nil
+		}
+	}
+	m.AlertClaims = append(m.AlertClaims, claim)
+	return true, nil
+}
+
+// DeleteAlertClaims implements store.Store
+func (m *MockStore) DeleteAlertClaims(_ context.Context, alertKeys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := m.AlertClaims[:0]
+	for _, c := range m.AlertClaims {
+		if !slices.Contains(alertKeys, c.AlertKey) {
+			kept = append(kept, c)
+		}
+	}
+	m.AlertClaims = kept
+	return nil
+}
+
+// PruneAlertClaims implements store.Store
+func (m *MockStore) PruneAlertClaims(_ context.Context, olderThan time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := m.AlertClaims[:0]
+	for _, c := range m.AlertClaims {
+		if !c.WindowStart.Before(olderThan) {
+			kept = append(kept, c)
+		}
+	}
+	pruned := int64(len(m.AlertClaims) - len(kept))
+	m.AlertClaims = kept
+	return pruned, nil
+}
+
+// SaveView implements store.Store
+func (m *MockStore) SaveView(_ context.Context, view store.SavedView) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Views == nil {
+		m.Views = make(map[string]store.SavedView)
+	}
+	now := time.Now()
+	if existing, ok := m.Views[view.Name]; ok {
+		view.CreatedAt = existing.CreatedAt
+	} else {
+		view.CreatedAt = now
+	}
+	view.UpdatedAt = now
+	m.Views[view.Name] = view
+	return nil
+}
+
+// GetView implements store.Store
+func (m *MockStore) GetView(_ context.Context, name string) (*store.SavedView, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	view, ok := m.Views[name]
+	if !ok {
+		return nil, nil
+	}
+	return &view, nil
+}
+
+// ListViews implements store.Store
+func (m *MockStore) ListViews(_ context.Context) ([]store.SavedView, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	views := make([]store.SavedView, 0, len(m.Views))
+	for _, name := range slices.Sorted(maps.Keys(m.Views)) {
+		views = append(views, m.Views[name])
+	}
+	return views, nil
+}
+
+// DeleteView implements store.Store
+func (m *MockStore) DeleteView(_ context.Context, name string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.Views[name]
+	delete(m.Views, name)
+	return ok, nil
+}
+
+// RecordReceipt implements store.Store
+func (m *MockStore) RecordReceipt(_ context.Context, receipt store.AlertReceipt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Receipts = append(m.Receipts, receipt)
+	return nil
+}
+
+// ListReceipts implements store.Store
+func (m *MockStore) ListReceipts(_ context.Context, alertIDs []string) ([]store.AlertReceipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var receipts []store.AlertReceipt
+	for _, r := range m.Receipts {
+		if slices.Contains(alertIDs, r.AlertID) {
+			receipts = append(receipts, r)
+		}
+	}
+	return receipts, nil
+}
+
+// SetLegalHold implements store.Store
+func (m *MockStore) SetLegalHold(_ context.Context, hold store.LegalHold) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, h := range m.LegalHolds {
+		if h.CronJobNamespace == hold.CronJobNamespace && h.CronJobName == hold.CronJobName {
+			m.LegalHolds[i].Reason = hold.Reason
+			return nil
+		}
+	}
+	m.LegalHolds = append(m.LegalHolds, hold)
+	return nil
+}
+
+// ReleaseLegalHold implements store.Store
+func (m *MockStore) ReleaseLegalHold(_ context.Context, cronJob types.NamespacedName) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, h := range m.LegalHolds {
+		if h.CronJobNamespace == cronJob.Namespace && h.CronJobName == cronJob.Name {
+			m.LegalHolds = slices.Delete(m.LegalHolds, i, i+1)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListLegalHolds implements store.Store
+func (m *MockStore) ListLegalHolds(_ context.Context) ([]store.LegalHold, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.LegalHolds), nil
+}
+
 // Lock acquires the mutex for external synchronization in tests
 func (m *MockStore) Lock() {
 	m.mu.Lock()
@@ -363,18 +853,26 @@ type MockDispatcher struct {
 	SentToChannel         map[string][]alerting.Alert
 	SentChannelNames      []string
 	SentAlerts            []alerting.Alert // All alerts sent via SendToChannel
+	PreviewedAlerts       []alerting.Alert
 	ClearedAlerts         []string
 	CancelledAlerts       []string
+	AcknowledgedAlerts    []string
 	RegisteredChannels    []*guardianv1alpha1.AlertChannel
 	RegisteredChannelsMap map[string]*guardianv1alpha1.AlertChannel // Map by channel name
 	RemovedChannels       []string
+	GlobalRateLimits      *config.RateLimitsConfig // Last limits set, nil if never set
+	StartupGracePeriod    time.Duration
 
 	// Configuration
 	Suppressed            bool
 	SuppressionReason     string
 	PendingAlertCancelled bool
+	AlertNotActive        bool              // Acknowledge reports the alert as not active
+	ActiveAlertIDs        map[string]string // Returned by ActiveAlertID, by alert key
 	AlertCount24h         int32
 	ChannelStats          map[string]*alerting.ChannelStats
+	DiagnosticsResult     alerting.DispatcherDiagnostics
+	Previews              []alerting.ChannelPreview // Returned by Preview
 
 	// Error injection
 	DispatchError        error
@@ -442,6 +940,14 @@ func (m *MockDispatcher) SendToChannel(_ context.Context, channelName string, al
 	return nil
 }
 
+// Preview implements alerting.Dispatcher
+func (m *MockDispatcher) Preview(_ context.Context, alert alerting.Alert, _ *guardianv1alpha1.AlertingConfig) []alerting.ChannelPreview {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.PreviewedAlerts = append(m.PreviewedAlerts, alert)
+	return m.Previews
+}
+
 // IsSuppressed implements alerting.Dispatcher
 func (m *MockDispatcher) IsSuppressed(_ alerting.Alert, _ *guardianv1alpha1.AlertingConfig) (bool, string) {
 	m.mu.Lock()
@@ -467,6 +973,30 @@ func (m *MockDispatcher) ClearAlertsForMonitor(namespace, name string) {
 	m.ClearedAlerts = append(m.ClearedAlerts, namespace+"/"+name)
 }
 
+// Acknowledge implements alerting.Dispatcher
+func (m *MockDispatcher) Acknowledge(alertKey, _ string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.AlertNotActive {
+		return false
+	}
+	m.AcknowledgedAlerts = append(m.AcknowledgedAlerts, alertKey)
+	return true
+}
+
+// ActiveAlertID implements alerting.Dispatcher
+func (m *MockDispatcher) ActiveAlertID(alertKey string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ActiveAlertIDs[alertKey]
+}
+
+// ReloadAlertState implements alerting.Dispatcher
+func (m *MockDispatcher) ReloadAlertState(_ context.Context) {}
+
+// SetElected implements alerting.Dispatcher
+func (m *MockDispatcher) SetElected(_ <-chan struct{}) {}
+
 // CancelPendingAlert implements alerting.Dispatcher
 func (m *MockDispatcher) CancelPendingAlert(alertKey string) bool {
 	m.mu.Lock()
@@ -487,13 +1017,31 @@ func (m *MockDispatcher) CancelPendingAlertsForCronJob(namespace, name string) i
 }
 
 // SetGlobalRateLimits implements alerting.Dispatcher
-func (m *MockDispatcher) SetGlobalRateLimits(_ config.RateLimitsConfig) {}
+func (m *MockDispatcher) SetGlobalRateLimits(limits config.RateLimitsConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.GlobalRateLimits = &limits
+}
+
+// SetStartupGracePeriod implements alerting.Dispatcher
+func (m *MockDispatcher) SetStartupGracePeriod(period time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.StartupGracePeriod = period
+}
 
 // GetAlertCount24h implements alerting.Dispatcher
 func (m *MockDispatcher) GetAlertCount24h() int32 {
 	return m.AlertCount24h
 }
 
+// Diagnostics implements alerting.Dispatcher
+func (m *MockDispatcher) Diagnostics() alerting.DispatcherDiagnostics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.DiagnosticsResult
+}
+
 // GetChannelStats implements alerting.Dispatcher
 func (m *MockDispatcher) GetChannelStats(name string) *alerting.ChannelStats {
 	m.mu.Lock()
@@ -537,20 +1085,30 @@ type MockAnalyzer struct {
 	// Regression results
 	RegressionResult *analyzer.RegressionResult
 
+	// Schedule budget results
+	BudgetResult *analyzer.BudgetResult
+
 	// Metrics
 	Metrics *guardianv1alpha1.CronJobMetrics
 
+	// Limit recommendation
+	Recommendation *guardianv1alpha1.LimitRecommendation
+
 	// Error injection
-	SLAError        error
-	DeadManError    error
-	RegressionError error
-	MetricsError    error
+	SLAError            error
+	DeadManError        error
+	RegressionError     error
+	BudgetError         error
+	MetricsError        error
+	RecommendationError error
 
 	// Call tracking
 	GetMetricsCalled         int
 	CheckSLACalled           int
 	CheckDeadManSwitchCalled int
 	CheckRegressionCalled    int
+	CheckBudgetCalled        int
+	RecommendLimitsCalled    int
 }
 
 // GetMetrics implements analyzer.SLAAnalyzer
@@ -609,6 +1167,31 @@ func (m *MockAnalyzer) CheckDurationRegression(_ context.Context, _ types.Namesp
 	return &analyzer.RegressionResult{Detected: false}, nil
 }
 
+// CheckScheduleBudget implements analyzer.SLAAnalyzer
+func (m *MockAnalyzer) CheckScheduleBudget(_ context.Context, _ *batchv1.CronJob, _ *guardianv1alpha1.SLAConfig) (*analyzer.BudgetResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.CheckBudgetCalled++
+	if m.BudgetError != nil {
+		return nil, m.BudgetError
+	}
+	if m.BudgetResult != nil {
+		return m.BudgetResult, nil
+	}
+	return &analyzer.BudgetResult{}, nil
+}
+
+// RecommendLimits implements analyzer.SLAAnalyzer
+func (m *MockAnalyzer) RecommendLimits(_ context.Context, _ types.NamespacedName, _ *guardianv1alpha1.RecommendationConfig) (*guardianv1alpha1.LimitRecommendation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RecommendLimitsCalled++
+	if m.RecommendationError != nil {
+		return nil, m.RecommendationError
+	}
+	return m.Recommendation, nil
+}
+
 // Lock acquires the mutex for external synchronization in tests
 func (m *MockAnalyzer) Lock() {
 	m.mu.Lock()
@@ -0,0 +1,32 @@
+// Package webhook registers the operator's admission and conversion webhooks.
+package webhook
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+)
+
+// ConvertibleKinds are the kinds served in more than one API version. Their
+// v1alpha1 types are the conversion hub and storage version.
+func ConvertibleKinds() []client.Object {
+	return []client.Object{
+		&guardianv1alpha1.CronJobMonitor{},
+		&guardianv1alpha1.AlertChannel{},
+	}
+}
+
+// SetupConversionWebhooks registers the /convert endpoint the API server
+// calls to convert CronJobMonitors and AlertChannels between API versions.
+// Both API versions must be registered in the manager's scheme.
+func SetupConversionWebhooks(mgr ctrl.Manager) error {
+	for _, obj := range ConvertibleKinds() {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(obj).Complete(); err != nil {
+			return fmt.Errorf("failed to set up conversion webhook for %T: %w", obj, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	guardianv1alpha1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1alpha1"
+	guardianv1beta1 "github.com/iLLeniumStudios/cronjob-guardian/api/v1beta1"
+)
+
+func TestConvertibleKinds(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, guardianv1alpha1.AddToScheme(scheme))
+	require.NoError(t, guardianv1beta1.AddToScheme(scheme))
+
+	for _, obj := range ConvertibleKinds() {
+		ok, err := conversion.IsConvertible(scheme, obj)
+		require.NoError(t, err)
+		assert.True(t, ok, "%T should be convertible", obj)
+	}
+}
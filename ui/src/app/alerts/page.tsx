@@ -2,7 +2,7 @@
 
 import { useCallback, useMemo } from "react";
 import Link from "next/link";
-import { Bell, AlertCircle, History } from "lucide-react";
+import { Bell, AlertCircle, History, BookOpen, CheckCircle2, XCircle } from "lucide-react";
 import { Header } from "@/components/header";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import { Badge } from "@/components/ui/badge";
@@ -13,6 +13,7 @@ import { EmptyState } from "@/components/empty-state";
 import { StatCard } from "@/components/stat-card";
 import { PageSkeleton } from "@/components/page-skeleton";
 import { SuggestedFix } from "@/components/suggested-fix";
+import { SuccessStreakBadge } from "@/components/success-streak-badge";
 import { useFetchData } from "@/hooks/use-fetch-data";
 import {
   listAlerts,
@@ -21,6 +22,7 @@ import {
   type AlertHistoryResponse,
   type Alert,
   type AlertHistoryItem,
+  type AlertReceipt,
 } from "@/lib/api";
 import { cn, sortAlerts } from "@/lib/utils";
 import { SEVERITY_STYLES, type Severity } from "@/lib/constants";
@@ -187,6 +189,7 @@ function ActiveAlertCard({ alert }: { alert: Alert }) {
                     {alert.context.reason}
                   </Badge>
                 )}
+                <SuccessStreakBadge context={alert.context} failedAt={alert.since} />
               </div>
               <p className="mt-1 font-medium">{alert.title}</p>
               <p className="mt-1 text-sm text-muted-foreground">{alert.message}</p>
@@ -201,6 +204,7 @@ function ActiveAlertCard({ alert }: { alert: Alert }) {
           </div>
         </div>
       </Link>
+      {alert.runbookURL && <RunbookLink url={alert.runbookURL} />}
       {/* Suggested fix displayed below the link area */}
       {alert.context?.suggestedFix && (
         <div className="mt-3 pt-3 border-t">
@@ -217,6 +221,41 @@ function ActiveAlertCard({ alert }: { alert: Alert }) {
   );
 }
 
+function RunbookLink({ url }: { url: string }) {
+  return (
+    <a
+      href={url}
+      target="_blank"
+      rel="noopener noreferrer"
+      className="mt-2 inline-flex items-center gap-1 text-xs text-primary hover:underline"
+    >
+      <BookOpen className="h-3 w-3" />
+      Open runbook
+    </a>
+  );
+}
+
+function DeliveryReceipts({ receipts }: { receipts: AlertReceipt[] }) {
+  return (
+    <ul className="mt-2 space-y-1">
+      {receipts.map((receipt, i) => {
+        const processed = receipt.status === "processed";
+        const Icon = processed ? CheckCircle2 : XCircle;
+        return (
+          <li key={i} className="flex items-center gap-1.5 text-xs text-muted-foreground">
+            <Icon className={cn("h-3.5 w-3.5", processed ? "text-emerald-600 dark:text-emerald-400" : "text-red-600 dark:text-red-400")} />
+            <span>
+              {processed ? "Processed" : "Failed"} by {receipt.receiver}
+              {receipt.message && <>: {receipt.message}</>}
+            </span>
+            <RelativeTime date={receipt.receivedAt} showTooltip={false} />
+          </li>
+        );
+      })}
+    </ul>
+  );
+}
+
 function HistoryAlertCard({ alert }: { alert: AlertHistoryItem }) {
   const severity = (alert.severity || "info") as Severity;
   const styles = SEVERITY_STYLES[severity] || SEVERITY_STYLES.info;
@@ -254,7 +293,11 @@ function HistoryAlertCard({ alert }: { alert: AlertHistoryItem }) {
             <p className="mt-1 text-sm text-muted-foreground">{alert.message}</p>
             <p className="mt-2 text-xs text-muted-foreground">
               {alert.cronjob.namespace}/{alert.cronjob.name}
+              {alert.jobName && <> &middot; {alert.jobName}</>}
             </p>
+            {alert.alertId && (
+              <p className="mt-1 font-mono text-xs text-muted-foreground">Alert ID {alert.alertId}</p>
+            )}
             {alert.channelsNotified.length > 0 && (
               <div className="mt-2 flex flex-wrap gap-1">
                 {alert.channelsNotified.map((channel) => (
@@ -264,6 +307,8 @@ function HistoryAlertCard({ alert }: { alert: AlertHistoryItem }) {
                 ))}
               </div>
             )}
+            {alert.receipts && alert.receipts.length > 0 && <DeliveryReceipts receipts={alert.receipts} />}
+            {alert.runbookURL && <RunbookLink url={alert.runbookURL} />}
             {/* Show suggested fix if present (compact mode for history) */}
             {alert.suggestedFix && (
               <div className="mt-3">
@@ -11,6 +11,8 @@ import {
   Send,
   Loader2,
   AlertTriangle,
+  Cloud,
+  Smartphone,
 } from "lucide-react";
 import { toast } from "sonner";
 import { Header } from "@/components/header";
@@ -29,6 +31,12 @@ const channelIcons: Record<string, typeof MessageSquare> = {
   pagerduty: Bell,
   webhook: Webhook,
   email: Mail,
+  telegram: Send,
+  sns: Cloud,
+  pubsub: Cloud,
+  eventgrid: Cloud,
+  ntfy: Smartphone,
+  pushover: Smartphone,
 };
 
 const channelTypeLabels: Record<string, string> = {
@@ -36,9 +44,15 @@ const channelTypeLabels: Record<string, string> = {
   pagerduty: "PagerDuty",
   webhook: "Webhook",
   email: "Email",
+  telegram: "Telegram",
+  sns: "AWS SNS",
+  pubsub: "GCP Pub/Sub",
+  eventgrid: "Azure Event Grid",
+  ntfy: "ntfy",
+  pushover: "Pushover",
 };
 
-const channelTypeOrder = ["slack", "pagerduty", "webhook", "email"];
+const channelTypeOrder = ["slack", "pagerduty", "webhook", "email", "telegram", "sns", "pubsub", "eventgrid", "ntfy", "pushover"];
 
 export default function ChannelsPage() {
   const { data: channels, isLoading, isRefreshing, refetch } = useFetchData(listChannels);
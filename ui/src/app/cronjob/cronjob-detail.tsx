@@ -39,6 +39,7 @@ import { HealthHeatmap } from "@/components/cronjob/health-heatmap";
 import { ExecutionHistory } from "@/components/cronjob/execution-history";
 import { ExportButton } from "@/components/export/export-button";
 import { SuggestedFix } from "@/components/suggested-fix";
+import { SuccessStreakBadge } from "@/components/success-streak-badge";
 import { CronSchedule } from "@/components/cron-schedule";
 import { exportExecutionsToCSV } from "@/lib/export/csv";
 import { generateCronJobPDFReport } from "@/lib/export/pdf";
@@ -49,6 +50,7 @@ import {
   suspendCronJob,
   resumeCronJob,
   deleteHistory,
+  restoreHistory,
   type CronJobDetail,
   type ExecutionHistoryResponse,
 } from "@/lib/api";
@@ -175,7 +177,14 @@ export function CronJobDetailClient() {
     try {
       const result = await deleteHistory(namespace, name);
       if (result.success) {
-        toast.success(`Deleted ${result.recordsDeleted} execution records`);
+        toast.success(`Deleted ${result.recordsDeleted} execution records`, {
+          description: result.restorableUntil
+            ? `Restorable until ${new Date(result.restorableUntil).toLocaleString()}`
+            : undefined,
+          action: result.restorableUntil
+            ? { label: "Undo", onClick: handleRestoreHistory }
+            : undefined,
+        });
         setDeleteDialogOpen(false);
         fetchData(true);
       } else {
@@ -188,6 +197,16 @@ export function CronJobDetailClient() {
     }
   };
 
+  const handleRestoreHistory = async () => {
+    try {
+      const result = await restoreHistory(namespace, name);
+      toast.success(`Restored ${result.recordsRestored} execution records`);
+      fetchData(true);
+    } catch {
+      toast.error("Failed to restore execution history");
+    }
+  };
+
   const handleExportCSV = () => {
     if (executions?.items && executions.items.length > 0) {
       exportExecutionsToCSV(executions.items, cronJob?.name || name);
@@ -507,7 +526,10 @@ export function CronJobDetailClient() {
                         {alert.severity}
                       </Badge>
                       <div className="flex-1 min-w-0">
-                        <p className="font-medium text-sm">{alert.title}</p>
+                        <div className="flex items-center gap-2 flex-wrap">
+                          <p className="font-medium text-sm">{alert.title}</p>
+                          <SuccessStreakBadge context={alert.context} failedAt={alert.since} />
+                        </div>
                         <p className="text-sm text-muted-foreground mt-0.5 break-words">
                           {alert.message}
                         </p>
@@ -567,7 +589,7 @@ export function CronJobDetailClient() {
             <DialogDescription>
               Are you sure you want to delete all execution history for{" "}
               <span className="font-semibold">{namespace}/{name}</span>?
-              This action cannot be undone.
+              It can be restored within the configured undo window.
             </DialogDescription>
           </DialogHeader>
           <DialogFooter>
@@ -339,6 +339,29 @@ export default function SettingsPage() {
                 <p className="mt-1 text-sm text-muted-foreground">Log Storage</p>
               </div>
             </div>
+            {storageStats?.legalHolds && storageStats.legalHolds.length > 0 && (
+              <div className="mt-6 border-t pt-4">
+                <p className="mb-2 text-sm font-medium">Legal Holds</p>
+                <p className="mb-3 text-xs text-muted-foreground">
+                  History and logs of these CronJobs are never pruned
+                </p>
+                <div className="space-y-1">
+                  {storageStats.legalHolds.map((hold) => (
+                    <div
+                      key={`${hold.namespace}/${hold.name}/${hold.monitor ?? ""}`}
+                      className="flex items-center justify-between text-sm"
+                    >
+                      <span className="font-mono">
+                        {hold.namespace}/{hold.name}
+                      </span>
+                      <span className="text-muted-foreground">
+                        {hold.monitor ? `Monitor ${hold.monitor}` : hold.reason || "Set through the API"}
+                      </span>
+                    </div>
+                  ))}
+                </div>
+              </div>
+            )}
           </CardContent>
         </Card>
 
@@ -0,0 +1,34 @@
+import { Badge } from "@/components/ui/badge";
+import type { AlertContext } from "@/lib/api/types";
+
+interface SuccessStreakBadgeProps {
+  context?: AlertContext;
+  // When the failure happened, to measure the streak it ended
+  failedAt: string;
+}
+
+// SuccessStreakBadge highlights a failure that ended an unusually long run of
+// successes, e.g. the first failure in 60 days
+export function SuccessStreakBadge({ context, failedAt }: SuccessStreakBadgeProps) {
+  if (!context?.successStreakRuns || !context.successStreakSince) {
+    return null;
+  }
+
+  const days = Math.round(
+    (new Date(failedAt).getTime() - new Date(context.successStreakSince).getTime()) / 86_400_000
+  );
+  const label =
+    days >= 2
+      ? `First failure in ${days} days`
+      : `First failure after ${context.successStreakRuns} runs`;
+
+  return (
+    <Badge
+      variant="outline"
+      className="text-xs bg-purple-100 dark:bg-purple-900/30 text-purple-700 dark:text-purple-400"
+      title={`Ended a streak of ${context.successStreakRuns} successful runs`}
+    >
+      {label}
+    </Badge>
+  );
+}
@@ -2,22 +2,36 @@ import type {
   CronJobListResponse,
   CronJobDetail,
   ExecutionHistoryResponse,
+  CronJobAnalytics,
+  CronJobTrend,
+  CorrelationReport,
   LogsResponse,
   AlertsResponse,
   AlertHistoryResponse,
+  AlertPreviewRequest,
+  AlertPreviewResponse,
   MonitorsResponse,
   MonitorDetail,
+  MonitorInput,
   ChannelsResponse,
   ChannelDetail,
+  ChannelInput,
   Config,
   HealthResponse,
   StatsResponse,
   ActionResponse,
   DeleteHistoryResponse,
+  RestoreHistoryResponse,
   StorageStatsResponse,
   PruneRequest,
   PruneResponse,
+  LegalHold,
+  LegalHoldListResponse,
+  InjectExecutionRequest,
+  InjectExecutionResponse,
   ExecutionDetail,
+  SavedView,
+  SavedViewListResponse,
 } from "./types";
 
 // Use relative URLs - the API is served on the same host/port as the UI
@@ -79,11 +93,17 @@ export async function listCronJobs(params?: {
   namespace?: string;
   status?: string;
   search?: string;
+  sortBy?: string;
+  order?: "asc" | "desc";
+  view?: string;
 }): Promise<CronJobListResponse> {
   const searchParams = new URLSearchParams();
   if (params?.namespace) searchParams.set("namespace", params.namespace);
   if (params?.status) searchParams.set("status", params.status);
   if (params?.search) searchParams.set("search", params.search);
+  if (params?.sortBy) searchParams.set("sortBy", params.sortBy);
+  if (params?.order) searchParams.set("order", params.order);
+  if (params?.view) searchParams.set("view", params.view);
 
   const query = searchParams.toString();
   return fetchAPI<CronJobListResponse>(`/cronjobs${query ? `?${query}` : ""}`);
@@ -120,6 +140,42 @@ export async function getExecutions(
   );
 }
 
+export async function getCronJobAnalytics(
+  namespace: string,
+  name: string,
+  days?: number
+): Promise<CronJobAnalytics> {
+  const query = days ? `?days=${days}` : "";
+  return fetchAPI<CronJobAnalytics>(
+    `/cronjobs/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}/analytics${query}`
+  );
+}
+
+export async function getCronJobTrend(
+  namespace: string,
+  name: string,
+  days?: number
+): Promise<CronJobTrend> {
+  const query = days ? `?days=${days}` : "";
+  return fetchAPI<CronJobTrend>(
+    `/cronjobs/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}/trend${query}`
+  );
+}
+
+export async function getCorrelations(params?: {
+  lookback?: string;
+  window?: string;
+  minCronJobs?: number;
+}): Promise<CorrelationReport> {
+  const searchParams = new URLSearchParams();
+  if (params?.lookback) searchParams.set("lookback", params.lookback);
+  if (params?.window) searchParams.set("window", params.window);
+  if (params?.minCronJobs) searchParams.set("minCronJobs", String(params.minCronJobs));
+
+  const query = searchParams.toString();
+  return fetchAPI<CorrelationReport>(`/correlations${query ? `?${query}` : ""}`);
+}
+
 export async function getLogs(
   namespace: string,
   cronjobName: string,
@@ -204,6 +260,15 @@ export async function getAlertHistory(params?: {
   );
 }
 
+export async function previewAlert(
+  request: AlertPreviewRequest
+): Promise<AlertPreviewResponse> {
+  return fetchAPI<AlertPreviewResponse>("/alerts/preview", {
+    method: "POST",
+    body: JSON.stringify(request),
+  });
+}
+
 // Monitors
 export async function listMonitors(params?: {
   namespace?: string;
@@ -224,6 +289,40 @@ export async function getMonitor(
   );
 }
 
+export async function createMonitor(
+  monitor: MonitorInput
+): Promise<MonitorDetail> {
+  return fetchAPI<MonitorDetail>("/monitors", {
+    method: "POST",
+    body: JSON.stringify(monitor),
+  });
+}
+
+export async function updateMonitor(
+  namespace: string,
+  name: string,
+  monitor: MonitorInput
+): Promise<MonitorDetail> {
+  return fetchAPI<MonitorDetail>(
+    `/monitors/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}`,
+    { method: "PUT", body: JSON.stringify(monitor) }
+  );
+}
+
+export async function deleteMonitor(
+  namespace: string,
+  name: string
+): Promise<ActionResponse> {
+  return fetchAPI<ActionResponse>(
+    `/monitors/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}`,
+    { method: "DELETE" }
+  );
+}
+
+export function getMonitorManifestURL(namespace: string, name: string): string {
+  return `${API_BASE}/monitors/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}/manifest`;
+}
+
 // Channels
 export async function listChannels(): Promise<ChannelsResponse> {
   return fetchAPI<ChannelsResponse>("/channels");
@@ -233,6 +332,81 @@ export async function getChannel(name: string): Promise<ChannelDetail> {
   return fetchAPI<ChannelDetail>(`/channels/${encodeURIComponent(name)}`);
 }
 
+export async function createChannel(
+  channel: ChannelInput
+): Promise<ChannelDetail> {
+  return fetchAPI<ChannelDetail>("/channels", {
+    method: "POST",
+    body: JSON.stringify(channel),
+  });
+}
+
+export async function updateChannel(
+  name: string,
+  channel: ChannelInput
+): Promise<ChannelDetail> {
+  return fetchAPI<ChannelDetail>(`/channels/${encodeURIComponent(name)}`, {
+    method: "PUT",
+    body: JSON.stringify(channel),
+  });
+}
+
+export async function deleteChannel(name: string): Promise<ActionResponse> {
+  return fetchAPI<ActionResponse>(`/channels/${encodeURIComponent(name)}`, {
+    method: "DELETE",
+  });
+}
+
+export function getChannelManifestURL(name: string): string {
+  return `${API_BASE}/channels/${encodeURIComponent(name)}/manifest`;
+}
+
+// Reports
+export function getSLAReportURL(params?: {
+  period?: "weekly" | "monthly";
+  monitor?: string;
+  team?: string;
+  format?: "html" | "pdf";
+  before?: string;
+}): string {
+  const searchParams = new URLSearchParams();
+  if (params?.period) searchParams.set("period", params.period);
+  if (params?.monitor) searchParams.set("monitor", params.monitor);
+  if (params?.team) searchParams.set("team", params.team);
+  if (params?.format) searchParams.set("format", params.format);
+  if (params?.before) searchParams.set("before", params.before);
+  const query = searchParams.toString();
+  return `${API_BASE}/reports/sla${query ? `?${query}` : ""}`;
+}
+
+// Saved views
+export async function listViews(): Promise<SavedViewListResponse> {
+  return fetchAPI<SavedViewListResponse>("/views");
+}
+
+export async function createView(view: SavedView): Promise<SavedView> {
+  return fetchAPI<SavedView>("/views", {
+    method: "POST",
+    body: JSON.stringify(view),
+  });
+}
+
+export async function updateView(
+  name: string,
+  view: SavedView
+): Promise<SavedView> {
+  return fetchAPI<SavedView>(`/views/${encodeURIComponent(name)}`, {
+    method: "PUT",
+    body: JSON.stringify(view),
+  });
+}
+
+export async function deleteView(name: string): Promise<ActionResponse> {
+  return fetchAPI<ActionResponse>(`/views/${encodeURIComponent(name)}`, {
+    method: "DELETE",
+  });
+}
+
 export async function testChannel(name: string): Promise<ActionResponse> {
   return fetchAPI<ActionResponse>(
     `/channels/${encodeURIComponent(name)}/test`,
@@ -256,6 +430,16 @@ export async function deleteHistory(
   );
 }
 
+export async function restoreHistory(
+  namespace: string,
+  name: string
+): Promise<RestoreHistoryResponse> {
+  return fetchAPI<RestoreHistoryResponse>(
+    `/cronjobs/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}/history/restore`,
+    { method: "POST" }
+  );
+}
+
 export async function getStorageStats(): Promise<StorageStatsResponse> {
   return fetchAPI<StorageStatsResponse>("/admin/storage-stats");
 }
@@ -269,6 +453,43 @@ export async function triggerPrune(
   });
 }
 
+export async function listLegalHolds(): Promise<LegalHoldListResponse> {
+  return fetchAPI<LegalHoldListResponse>("/admin/legal-holds");
+}
+
+export async function setLegalHold(
+  namespace: string,
+  name: string,
+  reason?: string
+): Promise<LegalHold> {
+  return fetchAPI<LegalHold>(
+    `/admin/legal-holds/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}`,
+    {
+      method: "PUT",
+      body: JSON.stringify({ reason }),
+    }
+  );
+}
+
+export async function releaseLegalHold(
+  namespace: string,
+  name: string
+): Promise<ActionResponse> {
+  return fetchAPI<ActionResponse>(
+    `/admin/legal-holds/${encodeURIComponent(namespace)}/${encodeURIComponent(name)}`,
+    { method: "DELETE" }
+  );
+}
+
+export async function injectSyntheticExecution(
+  request: InjectExecutionRequest
+): Promise<InjectExecutionResponse> {
+  return fetchAPI<InjectExecutionResponse>("/admin/executions/synthetic", {
+    method: "POST",
+    body: JSON.stringify(request),
+  });
+}
+
 export async function getExecutionDetail(
   namespace: string,
   cronJobName: string,
@@ -35,6 +35,7 @@ export interface CronJob {
     name: string;
     namespace: string;
   };
+  team?: string;
 }
 
 export interface CronJobListResponse {
@@ -52,6 +53,9 @@ export interface CronJobMetrics {
   p50DurationSeconds: number;
   p95DurationSeconds: number;
   p99DurationSeconds: number;
+  incidents7d: number;
+  mttrSeconds: number;
+  mtbfSeconds: number;
 }
 
 export interface CronJobExecution {
@@ -62,6 +66,14 @@ export interface CronJobExecution {
   duration: string;
   exitCode: number;
   reason: string;
+  classification?: string;
+  backfilled?: boolean;
+  synthetic?: boolean;
+  catchUp?: boolean;
+  infrastructureDisruption?: boolean;
+  outputSize?: number;
+  nodeName?: string;
+  images?: string[];
 }
 
 export interface CronJobDetail extends Omit<CronJob, 'activeAlerts'> {
@@ -81,6 +93,59 @@ export interface ExecutionHistoryResponse {
   };
 }
 
+export interface CronJobAnalytics {
+  cronJob: { namespace: string; name: string };
+  windowDays: number;
+  exitCodes: { exitCode: number; count: number }[];
+  failureReasons: { reason: string; count: number }[];
+  durationTrend: {
+    date: string;
+    runs: number;
+    p50Seconds: number;
+    p95Seconds: number;
+  }[];
+  heatmap: {
+    dayOfWeek: number;
+    hour: number;
+    total: number;
+    succeeded: number;
+    successRate: number;
+  }[];
+}
+
+export interface CronJobTrend {
+  cronJob: { namespace: string; name: string };
+  windowDays: number;
+  points: {
+    date: string;
+    runs: number;
+    successes: number;
+    successRate: number;
+    p50Seconds: number;
+    p95Seconds: number;
+    p99Seconds: number;
+    totalRuntimeSeconds: number;
+  }[];
+}
+
+export interface CorrelationGroup {
+  dimension: "time" | "namespace" | "reason" | "node" | "image";
+  value?: string;
+  cronJobs: { namespace: string; name: string }[];
+  failures: number;
+  firstFailure: string;
+  lastFailure: string;
+  likelyCause: string;
+}
+
+export interface CorrelationReport {
+  generatedAt: string;
+  lookback: string;
+  window: string;
+  totalFailures: number;
+  groups: CorrelationGroup[];
+}
+
 export interface LogsResponse {
   jobName: string;
   container: string;
@@ -93,10 +158,14 @@ export interface AlertContext {
   exitCode?: number;
   reason?: string;
   suggestedFix?: string;
+  // Set when the failure ended an unusually long success streak
+  successStreakRuns?: number;
+  successStreakSince?: string;
 }
 
 export interface Alert {
   id: string;
+  alertId?: string;
   type: string;
   severity: "critical" | "warning" | "info";
   title: string;
@@ -112,6 +181,7 @@ export interface Alert {
   since: string;
   lastNotified: string;
   context?: AlertContext;
+  runbookURL?: string;
 }
 
 export interface AlertsResponse {
@@ -129,9 +199,21 @@ export interface AlertHistoryItem extends Alert {
   resolvedAt: string | null;
   channelsNotified: string[];
   // Context fields for failure alerts (stored at alert time)
+  jobName?: string;
   exitCode?: number;
   reason?: string;
   suggestedFix?: string;
+  runbookURL?: string;
+  // Confirmations downstream systems posted for the alert's tracking ID
+  receipts?: AlertReceipt[];
+}
+
+export interface AlertReceipt {
+  alertId: string;
+  receiver: string;
+  status: "processed" | "failed";
+  message?: string;
+  receivedAt: string;
 }
 
 export interface AlertHistoryResponse {
@@ -143,6 +225,29 @@ export interface AlertHistoryResponse {
   };
 }
 
+export interface AlertPreviewRequest {
+  type: string;
+  cronJob: { namespace: string; name: string };
+  monitor?: { namespace: string; name: string };
+}
+
+export interface ChannelPreview {
+  channel: string;
+  type: string;
+  contentType?: string;
+  payload?: string;
+  note?: string;
+  error?: string;
+}
+
+export interface AlertPreviewResponse {
+  monitor: { namespace: string; name: string };
+  type: string;
+  severity: string;
+  channels: ChannelPreview[];
+  warnings?: string[];
+}
+
 export interface Monitor {
   name: string;
   namespace: string;
@@ -156,6 +261,8 @@ export interface Monitor {
   activeAlerts: number;
   lastReconcile: string;
   phase: string;
+  mode: "observe" | "enforce";
+  silencedUntil?: string;
 }
 
 export interface MonitorsResponse {
@@ -167,6 +274,7 @@ export interface MonitorDetail {
     name: string;
     namespace: string;
     creationTimestamp: string;
+    resourceVersion?: string;
   };
   spec: {
     selector: {
@@ -186,6 +294,7 @@ export interface MonitorDetail {
       minSuccessRate: number;
       windowDays: number;
     };
+    mode?: "observe" | "enforce";
   };
   status: {
     phase: string;
@@ -212,6 +321,9 @@ export interface MonitorDetail {
         p50DurationSeconds?: number;
         p95DurationSeconds?: number;
         p99DurationSeconds?: number;
+        incidents?: number;
+        mttrSeconds?: number;
+        mtbfSeconds?: number;
       } | null;
     }>;
     lastReconcileTime: string;
@@ -220,7 +332,7 @@ export interface MonitorDetail {
 
 export interface Channel {
   name: string;
-  type: "slack" | "pagerduty" | "webhook" | "email";
+  type: "slack" | "pagerduty" | "webhook" | "email" | "telegram" | "sns" | "pubsub" | "eventgrid" | "ntfy" | "pushover";
   ready: boolean;
   config: Record<string, string>;
   stats: {
@@ -250,6 +362,7 @@ export interface ChannelDetail {
   metadata: {
     name: string;
     creationTimestamp: string;
+    resourceVersion?: string;
   };
   spec: {
     type: string;
@@ -260,6 +373,7 @@ export interface ChannelDetail {
         key: string;
       };
       defaultChannel: string;
+      interactive?: boolean;
     };
     pagerduty?: {
       routingKeySecretRef: {
@@ -284,11 +398,96 @@ export interface ChannelDetail {
       };
       from: string;
       to: string[];
+      format?: "text" | "html";
+      digest?: {
+        schedule: "daily" | "weekly";
+        time?: string;
+        weekday?: string;
+        timezone?: string;
+      };
+      slaReport?: {
+        schedule: "weekly" | "monthly";
+        team?: string;
+        attachPDF?: boolean;
+        time?: string;
+        timezone?: string;
+      };
+    };
+    telegram?: {
+      secretRef: {
+        name: string;
+        namespace: string;
+      };
+      silentInfo?: boolean;
+    };
+    sns?: {
+      topicARN: string;
+      credentialsSecretRef: {
+        name: string;
+        namespace: string;
+      };
+      endpoint?: string;
+    };
+    pubsub?: {
+      topic: string;
+      credentialsSecretRef: {
+        name: string;
+        namespace: string;
+        key: string;
+      };
+      endpoint?: string;
+    };
+    eventGrid?: {
+      topicEndpoint: string;
+      accessKeySecretRef: {
+        name: string;
+        namespace: string;
+        key: string;
+      };
+    };
+    ntfy?: {
+      serverURL?: string;
+      topic: string;
+      authSecretRef?: {
+        name: string;
+        namespace: string;
+      };
+    };
+    pushover?: {
+      secretRef: {
+        name: string;
+        namespace: string;
+      };
+      devices?: string[];
+      sound?: string;
+      emergencyCritical?: boolean;
     };
     rateLimiting?: {
       maxAlertsPerHour: number;
       burstLimit: number;
     };
+    sendTimeout?: string;
+    retryCount?: number;
+    retryBackoff?: string;
+    http?: {
+      proxyURL?: string;
+      noProxy?: boolean;
+      caBundle?: {
+        secretRef?: {
+          name: string;
+          namespace: string;
+          key: string;
+        };
+        configMapRef?: {
+          name: string;
+          namespace: string;
+          key: string;
+        };
+      };
+      disableKeepAlives?: boolean;
+      ipFamily?: "IPv4" | "IPv6";
+      nameserver?: string;
+    };
   };
   status: {
     ready: boolean;
@@ -299,6 +498,45 @@ export interface ChannelDetail {
   };
 }
 
+// Request bodies for creating and updating monitors and channels.
+// Set resourceVersion when updating to reject changes made since the read.
+export interface MonitorInput {
+  metadata: {
+    name: string;
+    namespace?: string;
+    labels?: Record<string, string>;
+    resourceVersion?: string;
+  };
+  spec: Partial<MonitorDetail["spec"]>;
+}
+
+export interface ChannelInput {
+  metadata: {
+    name: string;
+    labels?: Record<string, string>;
+    resourceVersion?: string;
+  };
+  spec: ChannelDetail["spec"];
+}
+
+export interface SavedView {
+  name: string;
+  description?: string;
+  namespaces?: string[];
+  labelSelector?: string;
+  team?: string;
+  status?: string;
+  search?: string;
+  sortBy?: string;
+  sortDesc?: boolean;
+  createdAt?: string;
+  updatedAt?: string;
+}
+
+export interface SavedViewListResponse {
+  items: SavedView[];
+}
+
 // Config matches the actual API response from /api/v1/config
 export interface Config {
   logLevel: string;
@@ -372,6 +610,13 @@ export interface ActionResponse {
 export interface DeleteHistoryResponse {
   success: boolean;
   recordsDeleted: number;
+  restorableUntil?: string; // Unset when deleted immediately
+  message: string;
+}
+
+export interface RestoreHistoryResponse {
+  success: boolean;
+  recordsRestored: number;
   message: string;
 }
 
@@ -381,6 +626,19 @@ export interface StorageStatsResponse {
   healthy: boolean;
   retentionDays: number;
   logStorageEnabled: boolean;
+  legalHolds: LegalHold[];
+}
+
+export interface LegalHold {
+  namespace: string;
+  name: string;
+  reason?: string;
+  monitor?: string; // Monitor holding it through dataRetention.legalHold
+  since?: string; // When the hold was set through the API
+}
+
+export interface LegalHoldListResponse {
+  items: LegalHold[];
 }
 
 export interface PruneRequest {
@@ -398,6 +656,31 @@ export interface PruneResponse {
   message: string;
 }
 
+export interface InjectExecutionRequest {
+  namespace: string;
+  cronJob: string;
+  status: "success" | "failed";
+  exitCode?: number;
+  reason?: string;
+  duration?: string;
+}
+
+export interface InjectExecutionResponse {
+  jobName: string;
+  status: string;
+  monitors: { namespace: string; name: string }[];
+}
+
+export interface ContainerResult {
+  name: string;
+  init?: boolean;
+  exitCode: number;
+  reason?: string;
+  startedAt?: string;
+  finishedAt?: string;
+  duration?: string;
+}
+
 export interface ExecutionDetail extends CronJobExecution {
   id: number;
   cronJobNamespace: string;
@@ -405,12 +688,16 @@ export interface ExecutionDetail extends CronJobExecution {
   cronJobUID?: string;
   isRetry: boolean;
   retryOf?: string;
+  podNames?: string[];
+  containers?: ContainerResult[];
   storedLogs?: string;
   storedEvents?: string;
+  specHash?: string;
 }
 
 // Pattern Testing Types (for pattern tester component)
 export interface PatternMatch {
+  container?: string;
   exitCode?: number;
   exitCodeRange?: { min: number; max: number };
   reason?: string;
@@ -431,6 +718,7 @@ export interface PatternTestRequest {
   testData: {
     exitCode: number;
     reason: string;
+    containers?: { name: string; exitCode: number; reason?: string }[];
     logs: string;
     events: string[];
     namespace: string;